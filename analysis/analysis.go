@@ -13,12 +13,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/metricproviders"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 const (
@@ -308,6 +311,16 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 	return tasks, secrets, nil
 }
 
+// newTracingProvider returns the provider of the metric, which records a span for every call to the
+// external system as a child of the span in ctx
+func (c *Controller) newTracingProvider(ctx context.Context, logger log.Entry, m v1alpha1.Metric) (metric.Provider, error) {
+	provider, err := c.newProvider(logger, m)
+	if err != nil {
+		return nil, err
+	}
+	return metric.NewTracingProvider(ctx, metricproviders.Type(m), provider), nil
+}
+
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
 func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
 	var wg sync.WaitGroup
@@ -323,6 +336,12 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 		return err
 	}
 
+	ctx, span := tracing.StartSpan(tracing.ObjectContext(context.Background(), run), "analysisRun.runMeasurements",
+		tracing.AttributeNamespace.String(run.Namespace),
+		tracing.AttributeAnalysisRun.String(run.Name),
+	)
+	defer span.End()
+
 	for _, task := range tasks {
 		wg.Add(1)
 
@@ -332,7 +351,7 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 			logger := logutil.WithRedactor(*logutil.WithAnalysisRun(run).WithField("metric", t.metric.Name), secrets)
 
 			var newMeasurement v1alpha1.Measurement
-			provider, providerErr := c.newProvider(*logger, t.metric)
+			provider, providerErr := c.newTracingProvider(ctx, *logger, t.metric)
 			if providerErr != nil {
 				log.Errorf("Error in getting metric provider :%v", providerErr)
 				if t.incompleteMeasurement != nil {
@@ -345,7 +364,11 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				newMeasurement.Message = providerErr.Error()
 			} else {
 				if t.incompleteMeasurement == nil {
-					newMeasurement = provider.Run(run, t.metric)
+					if t.metric.BurnRate != nil {
+						newMeasurement = runBurnRateMeasurement(provider, run, t.metric)
+					} else {
+						newMeasurement = provider.Run(run, t.metric)
+					}
 				} else {
 					// metric is incomplete. either terminate or resume it
 					if terminating {
@@ -400,6 +423,15 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 					metricResult.ConsecutiveError++
 					logger.Warnf("Measurement had error: %s", newMeasurement.Message)
 				}
+
+				if t.metric.BurnRate != nil && newMeasurement.Phase != v1alpha1.AnalysisPhaseError {
+					// surface the latest burn rates, which the metric is assessed by
+					if metricResult.Metadata == nil {
+						metricResult.Metadata = map[string]string{}
+					}
+					metricResult.Metadata[FastBurnRateKey] = newMeasurement.Metadata[FastBurnRateKey]
+					metricResult.Metadata[SlowBurnRateKey] = newMeasurement.Metadata[SlowBurnRateKey]
+				}
 			}
 
 			//redact secret values from measurement message
@@ -570,7 +602,7 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 
 // assessMetricStatus assesses the status of a single metric based on:
 // * current or latest measurement status
-// * parameters given by the metric (failureLimit, count, etc...)
+// * parameters given by the metric (failureLimit, count, burnRate, etc...)
 // * whether we are terminating (e.g. due to failing run, or termination request)
 func assessMetricStatus(metric v1alpha1.Metric, result v1alpha1.MetricResult, terminating bool) v1alpha1.AnalysisPhase {
 	if result.Phase.Completed() {
@@ -627,6 +659,12 @@ func assessMetricFailureInconclusiveOrError(metric v1alpha1.Metric, result v1alp
 		phase = v1alpha1.AnalysisPhaseFailed
 		message = fmt.Sprintf("failed (%d) > failureLimit (%d)", result.Failed, failureLimit)
 	}
+	if metric.BurnRate != nil {
+		if burnRatePhase, burnRateMessage := assessBurnRate(metric, result); burnRatePhase != "" {
+			phase = burnRatePhase
+			message = burnRateMessage
+		}
+	}
 
 	inconclusiveLimit := int32(0)
 	if metric.InconclusiveLimit != nil {
@@ -744,7 +782,7 @@ func (c *Controller) garbageCollectMeasurements(run *v1alpha1.AnalysisRun, measu
 				continue
 			}
 			logger := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
-			provider, err := c.newProvider(*logger, metric)
+			provider, err := c.newTracingProvider(tracing.ObjectContext(context.Background(), run), *logger, metric)
 			if err != nil {
 				errors = append(errors, err)
 				continue
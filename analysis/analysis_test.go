@@ -14,6 +14,11 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +32,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 func timePtr(t metav1.Time) *metav1.Time {
@@ -2401,3 +2407,61 @@ func TestMaybeGarbageCollectAnalysisRunNoGCIfNoCompletedAt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, f.client.Fake.Actions())
 }
+
+func TestRunMeasurementsTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previous)
+
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-abc-2",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "5678",
+			Labels:    map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "abc"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Rollout",
+				Name:       "guestbook",
+				UID:        "1234",
+				Controller: pointer.BoolPtr(true),
+			}},
+		},
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name: "success-rate",
+				Provider: v1alpha1.MetricProvider{
+					Job: &v1alpha1.JobMetric{},
+				},
+			}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+		},
+	}
+	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseError), nil)
+	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+	c.reconcileAnalysisRun(run)
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	// the measurements are in the trace of the rollout revision the run was created for
+	traceID := trace.SpanContextFromContext(tracing.RevisionContext(context.TODO(), "1234", "abc")).TraceID()
+	measurements, ok := spans["analysisRun.runMeasurements"]
+	assert.True(t, ok)
+	assert.Equal(t, traceID, measurements.SpanContext.TraceID())
+
+	providerRun, ok := spans["metric.Run"]
+	assert.True(t, ok)
+	assert.Equal(t, measurements.SpanContext.SpanID(), providerRun.Parent.SpanID())
+	assert.Contains(t, providerRun.Attributes, tracing.AttributeMetric.String("success-rate"))
+	assert.Contains(t, providerRun.Attributes, tracing.AttributeMetricProvider.String("Job"))
+	assert.Equal(t, codes.Error, providerRun.Status.Code)
+}
@@ -0,0 +1,143 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// FastBurnRateKey is the metadata key of the burn rate over the short window of a burn rate metric
+	FastBurnRateKey = "fastBurnRate"
+	// SlowBurnRateKey is the metadata key of the burn rate over the long window of a burn rate metric
+	SlowBurnRateKey = "slowBurnRate"
+	// ShortWindowErrorRatioKey is the measurement metadata key of the error ratio over the short window
+	ShortWindowErrorRatioKey = "shortWindowErrorRatio"
+	// LongWindowErrorRatioKey is the measurement metadata key of the error ratio over the long window
+	LongWindowErrorRatioKey = "longWindowErrorRatio"
+)
+
+// runBurnRateMeasurement takes a measurement of a burn rate metric, by querying the error ratio over
+// the short and the long windows and computing the fast and slow burn rates of the error budget
+func runBurnRateMeasurement(provider metric.Provider, run *v1alpha1.AnalysisRun, m v1alpha1.Metric) v1alpha1.Measurement {
+	burnRate := m.BurnRate
+	startedAt := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startedAt,
+		Metadata:  map[string]string{},
+	}
+	errorMeasurement := func(err error) v1alpha1.Measurement {
+		measurement.Phase = v1alpha1.AnalysisPhaseError
+		measurement.Message = err.Error()
+		return measurement
+	}
+
+	objective, err := strconv.ParseFloat(burnRate.Objective, 64)
+	if err != nil {
+		return errorMeasurement(fmt.Errorf("invalid burnRate objective '%s'", burnRate.Objective))
+	}
+	fastBurnMultiplier, err := strconv.ParseFloat(defaults.GetFastBurnMultiplierOrDefault(burnRate), 64)
+	if err != nil {
+		return errorMeasurement(fmt.Errorf("invalid burnRate fastBurnMultiplier '%s'", burnRate.FastBurnMultiplier))
+	}
+	slowBurnMultiplier, err := strconv.ParseFloat(defaults.GetSlowBurnMultiplierOrDefault(burnRate), 64)
+	if err != nil {
+		return errorMeasurement(fmt.Errorf("invalid burnRate slowBurnMultiplier '%s'", burnRate.SlowBurnMultiplier))
+	}
+
+	shortErrorRatio, err := measureErrorRatio(provider, run, m, burnRate.ShortWindow)
+	if err != nil {
+		return errorMeasurement(err)
+	}
+	longErrorRatio, err := measureErrorRatio(provider, run, m, burnRate.LongWindow)
+	if err != nil {
+		return errorMeasurement(err)
+	}
+
+	errorBudget := 1 - objective
+	fastBurnRate := roundBurnRate(shortErrorRatio / errorBudget)
+	slowBurnRate := roundBurnRate(longErrorRatio / errorBudget)
+	measurement.Metadata[ShortWindowErrorRatioKey] = formatFloat(shortErrorRatio)
+	measurement.Metadata[LongWindowErrorRatioKey] = formatFloat(longErrorRatio)
+	measurement.Metadata[FastBurnRateKey] = formatFloat(fastBurnRate)
+	measurement.Metadata[SlowBurnRateKey] = formatFloat(slowBurnRate)
+	measurement.Value = fmt.Sprintf("[%s,%s]", formatFloat(fastBurnRate), formatFloat(slowBurnRate))
+	if fastBurnRate > fastBurnMultiplier && slowBurnRate > slowBurnMultiplier {
+		measurement.Phase = v1alpha1.AnalysisPhaseFailed
+	} else {
+		measurement.Phase = v1alpha1.AnalysisPhaseSuccessful
+	}
+	finishedAt := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedAt
+	return measurement
+}
+
+// measureErrorRatio queries the error ratio of a burn rate metric over the window
+func measureErrorRatio(provider metric.Provider, run *v1alpha1.AnalysisRun, m v1alpha1.Metric, window v1alpha1.DurationString) (float64, error) {
+	windowMetric, err := analysisutil.ResolveBurnRateWindow(m, window)
+	if err != nil {
+		return 0, err
+	}
+	measurement := provider.Run(run, *windowMetric)
+	switch measurement.Phase {
+	case v1alpha1.AnalysisPhaseError:
+		return 0, fmt.Errorf("error ratio over %s: %s", window, measurement.Message)
+	case v1alpha1.AnalysisPhaseSuccessful:
+	default:
+		return 0, fmt.Errorf("error ratio over %s could not be measured (phase: %s)", window, measurement.Phase)
+	}
+	return parseErrorRatio(measurement.Value)
+}
+
+// parseErrorRatio parses the value of a measurement of an error ratio, which providers return either
+// as a number or as a vector with a single number (e.g. "0.01" or "[0.01]"). An undefined ratio,
+// when there were no events in the window, means no errors.
+func parseErrorRatio(value string) (float64, error) {
+	trimmed := strings.Trim(strings.TrimSpace(value), "[]")
+	errorRatio, err := strconv.ParseFloat(strings.TrimSpace(trimmed), 64)
+	if err != nil {
+		return 0, fmt.Errorf("error ratio '%s' is not a number", value)
+	}
+	if math.IsNaN(errorRatio) {
+		return 0, nil
+	}
+	return errorRatio, nil
+}
+
+// assessBurnRate returns Failed, with a message, when both the latest fast and slow burn rates of a
+// burn rate metric exceed their multipliers. A burn rate spends the error budget regardless of how
+// many measurements were taken, so the failure limit does not apply.
+func assessBurnRate(m v1alpha1.Metric, result v1alpha1.MetricResult) (v1alpha1.AnalysisPhase, string) {
+	fastBurnRate, err := strconv.ParseFloat(result.Metadata[FastBurnRateKey], 64)
+	if err != nil {
+		return "", ""
+	}
+	slowBurnRate, err := strconv.ParseFloat(result.Metadata[SlowBurnRateKey], 64)
+	if err != nil {
+		return "", ""
+	}
+	fastBurnMultiplier, _ := strconv.ParseFloat(defaults.GetFastBurnMultiplierOrDefault(m.BurnRate), 64)
+	slowBurnMultiplier, _ := strconv.ParseFloat(defaults.GetSlowBurnMultiplierOrDefault(m.BurnRate), 64)
+	if fastBurnRate > fastBurnMultiplier && slowBurnRate > slowBurnMultiplier {
+		message := fmt.Sprintf("fast burn rate (%s) > fastBurnMultiplier (%s) and slow burn rate (%s) > slowBurnMultiplier (%s)",
+			result.Metadata[FastBurnRateKey], formatFloat(fastBurnMultiplier), result.Metadata[SlowBurnRateKey], formatFloat(slowBurnMultiplier))
+		return v1alpha1.AnalysisPhaseFailed, message
+	}
+	return "", ""
+}
+
+// roundBurnRate rounds off the floating point error of dividing by the error budget (e.g. 1 - 0.999)
+func roundBurnRate(burnRate float64) float64 {
+	return math.Round(burnRate*1e6) / 1e6
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,142 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newBurnRateRun() *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name: "error-budget",
+				BurnRate: &v1alpha1.BurnRateMetric{
+					Objective:   "0.999",
+					ShortWindow: "5m",
+					LongWindow:  "1h",
+				},
+				Provider: v1alpha1.MetricProvider{
+					Prometheus: &v1alpha1.PrometheusMetric{
+						Query: "sum(rate(errors[{{args.window}}])) / sum(rate(requests[{{args.window}}]))",
+					},
+				},
+			}},
+		},
+	}
+}
+
+func errorRatioMeasurement(value string) v1alpha1.Measurement {
+	measurement := newMeasurement(v1alpha1.AnalysisPhaseSuccessful)
+	measurement.Value = value
+	return measurement
+}
+
+func queryWindow(window string) any {
+	return mock.MatchedBy(func(metric v1alpha1.Metric) bool {
+		return metric.Provider.Prometheus.Query == "sum(rate(errors["+window+"])) / sum(rate(requests["+window+"]))"
+	})
+}
+
+func TestReconcileAnalysisRunBurnRateFailed(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	f.provider.On("Run", mock.Anything, queryWindow("5m")).Return(errorRatioMeasurement("[0.02]"))
+	f.provider.On("Run", mock.Anything, queryWindow("1h")).Return(errorRatioMeasurement("0.007"))
+	f.provider.On("GetMetadata", mock.Anything).Return(map[string]string{})
+	newRun := c.reconcileAnalysisRun(newBurnRateRun())
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, newRun.Status.Phase)
+	assert.Equal(t, `Metric "error-budget" assessed Failed due to fast burn rate (20) > fastBurnMultiplier (14.4) and slow burn rate (7) > slowBurnMultiplier (6)`, newRun.Status.Message)
+	result := newRun.Status.MetricResults[0]
+	assert.Equal(t, map[string]string{FastBurnRateKey: "20", SlowBurnRateKey: "7"}, result.Metadata)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, result.Measurements[0].Phase)
+	assert.Equal(t, "[20,7]", result.Measurements[0].Value)
+	assert.Equal(t, "0.02", result.Measurements[0].Metadata[ShortWindowErrorRatioKey])
+	assert.Equal(t, "0.007", result.Measurements[0].Metadata[LongWindowErrorRatioKey])
+}
+
+func TestReconcileAnalysisRunBurnRateSuccessful(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	// a spike in the short window alone does not spend enough of the error budget to fail
+	f.provider.On("Run", mock.Anything, queryWindow("5m")).Return(errorRatioMeasurement("[0.02]"))
+	f.provider.On("Run", mock.Anything, queryWindow("1h")).Return(errorRatioMeasurement("[NaN]"))
+	f.provider.On("GetMetadata", mock.Anything).Return(map[string]string{})
+	newRun := c.reconcileAnalysisRun(newBurnRateRun())
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
+	assert.Equal(t, map[string]string{FastBurnRateKey: "20", SlowBurnRateKey: "0"}, newRun.Status.MetricResults[0].Metadata)
+}
+
+func TestReconcileAnalysisRunBurnRateError(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	f.provider.On("Run", mock.Anything, queryWindow("5m")).Return(errorRatioMeasurement("map[errors:1]"))
+	f.provider.On("GetMetadata", mock.Anything).Return(map[string]string{})
+	newRun := c.reconcileAnalysisRun(newBurnRateRun())
+
+	result := newRun.Status.MetricResults[0]
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, result.Measurements[0].Phase)
+	assert.Equal(t, "error ratio 'map[errors:1]' is not a number", result.Measurements[0].Message)
+	assert.Empty(t, result.Metadata)
+}
+
+func TestAssessMetricStatusBurnRate(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Name:     "error-budget",
+		Interval: "60s",
+		BurnRate: &v1alpha1.BurnRateMetric{
+			Objective:          "0.99",
+			ShortWindow:        "5m",
+			LongWindow:         "1h",
+			FastBurnMultiplier: "10",
+			SlowBurnMultiplier: "2",
+		},
+	}
+	result := v1alpha1.MetricResult{
+		Successful: 1,
+		Count:      1,
+		Measurements: []v1alpha1.Measurement{{
+			Phase:      v1alpha1.AnalysisPhaseSuccessful,
+			StartedAt:  timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
+		}},
+		Metadata: map[string]string{FastBurnRateKey: "12", SlowBurnRateKey: "1.5"},
+	}
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
+	result.Metadata[SlowBurnRateKey] = "2.5"
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
+	phase, message := assessMetricFailureInconclusiveOrError(metric, result)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, phase)
+	assert.Equal(t, "fast burn rate (12) > fastBurnMultiplier (10) and slow burn rate (2.5) > slowBurnMultiplier (2)", message)
+}
+
+func TestParseErrorRatio(t *testing.T) {
+	for value, expected := range map[string]float64{
+		"0.01":     0.01,
+		"[0.01]":   0.01,
+		" [1e-3] ": 0.001,
+		"NaN":      0,
+		"[NaN]":    0,
+	} {
+		errorRatio, err := parseErrorRatio(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, errorRatio, value)
+	}
+	for _, value := range []string{"", "[0.01,0.02]", "{\"ratio\":0.01}"} {
+		_, err := parseErrorRatio(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -82,6 +83,10 @@ type ControllerConfig struct {
 	AnalysisRunWorkQueue workqueue.RateLimitingInterface
 	MetricsServer        *metrics.MetricsServer
 	Recorder             record.EventRecorder
+	// RolloutLister and StrategyTemplateGetter resolve the rollouts which own the analysis runs. They are nil
+	// when the controller does not watch rollouts.
+	RolloutLister          listers.RolloutLister
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 // NewController returns a new analysis controller
@@ -107,8 +112,12 @@ func NewController(cfg ControllerConfig) *Controller {
 	}
 
 	providerFactory := metricproviders.ProviderFactory{
-		KubeClient: controller.kubeclientset,
-		JobLister:  cfg.JobInformer.Lister(),
+		KubeClient:        controller.kubeclientset,
+		ArgoProjClientset: controller.argoProjClientset,
+		JobLister:         cfg.JobInformer.Lister(),
+
+		RolloutLister:          cfg.RolloutLister,
+		StrategyTemplateGetter: cfg.StrategyTemplateGetter,
 	}
 	controller.newProvider = providerFactory.NewProvider
 
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-rollouts/pkg/signals"
+	"github.com/argoproj/argo-rollouts/responsediff"
+	"github.com/argoproj/argo-rollouts/utils/version"
+)
+
+const (
+	cliName = "response-diff"
+
+	defaultPort      = 8080
+	defaultAdminPort = 8090
+)
+
+func newCommand() *cobra.Command {
+	var (
+		cfg          responsediff.ProxyConfig
+		port         int
+		adminPort    int
+		logLevel     string
+		printVersion bool
+	)
+	var command = cobra.Command{
+		Use:   cliName,
+		Short: "response-diff compares the responses of the stable and the canary to the requests mirrored by a shadow step",
+		RunE: func(c *cobra.Command, args []string) error {
+			if printVersion {
+				fmt.Println(version.GetVersion())
+				return nil
+			}
+			level, err := log.ParseLevel(logLevel)
+			if err != nil {
+				return err
+			}
+			log.SetLevel(level)
+
+			proxy, err := responsediff.NewProxy(cfg)
+			if err != nil {
+				return err
+			}
+			servers := []*http.Server{
+				{Addr: fmt.Sprintf(":%d", port), Handler: proxy},
+				{Addr: fmt.Sprintf(":%d", adminPort), Handler: proxy.AdminHandler()},
+			}
+			errCh := make(chan error, len(servers))
+			for _, server := range servers {
+				go func(server *http.Server) {
+					log.Infof("Listening on %s", server.Addr)
+					if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						errCh <- err
+					}
+				}(server)
+			}
+			log.WithField("version", version.GetVersion()).Infof("Comparing the responses of %s with %s", cfg.CanaryURL, cfg.StableURL)
+
+			ctx := signals.SetupSignalHandlerContext()
+			select {
+			case err = <-errCh:
+			case <-ctx.Done():
+			}
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			for _, server := range servers {
+				_ = server.Shutdown(shutdownCtx)
+			}
+			return err
+		},
+	}
+	command.Flags().StringVar(&cfg.StableURL, "stable-url", "", "URL of the stable service, e.g. http://guestbook-stable:8080")
+	command.Flags().StringVar(&cfg.CanaryURL, "canary-url", "", "URL of the canary service, e.g. http://guestbook-canary:8080")
+	command.Flags().StringArrayVar(&cfg.IgnorePaths, "ignore-path", nil, "Path of a field of the JSON bodies which is not compared, e.g. $.items[*].updatedAt. Can be repeated")
+	command.Flags().DurationVar(&cfg.Timeout, "timeout", responsediff.DefaultTimeout, "Timeout of the requests sent to the stable and the canary")
+	command.Flags().DurationVar(&cfg.StatsWindow, "stats-window", responsediff.DefaultStatsWindow, "Duration of the window the statistics served on /stats are computed over")
+	command.Flags().Int64Var(&cfg.MaxBodyBytes, "max-body-bytes", responsediff.DefaultMaxBodyBytes, "Maximum size of the bodies of the requests and the responses")
+	command.Flags().IntVar(&port, "port", defaultPort, "Port the mirrored requests are received on")
+	command.Flags().IntVar(&adminPort, "admin-port", defaultAdminPort, "Port the /stats, /metrics and /healthz endpoints are served on")
+	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().BoolVar(&printVersion, "version", false, "Print version")
+	return &command
+}
+
+func main() {
+	if err := newCommand().Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -35,6 +36,7 @@ import (
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/tolerantinformer"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	"github.com/argoproj/argo-rollouts/utils/version"
 )
 
@@ -70,17 +72,25 @@ func newCommand() *cobra.Command {
 		trafficSplitVersion            string
 		traefikAPIGroup                string
 		traefikVersion                 string
+		gatewayAPIVersion              string
+		clusterName                    string
 		ambassadorVersion              string
 		ingressVersion                 string
 		appmeshCRDVersion              string
 		albIngressClasses              []string
 		nginxIngressClasses            []string
 		awsVerifyTargetGroup           bool
+		verifyTrafficRouterWeight      bool
+		weightVerifyTimeout            time.Duration
+		istioConfigDumpURL             string
+		nginxBackendsURL               string
 		namespaced                     bool
 		printVersion                   bool
 		selfServiceNotificationEnabled bool
 		controllersEnabled             []string
 		pprofAddress                   string
+		otlpAddress                    string
+		otlpInsecure                   bool
 	)
 	electOpts := controller.NewLeaderElectionOptions()
 	var command = cobra.Command{
@@ -105,12 +115,18 @@ func newCommand() *cobra.Command {
 			ctx := signals.SetupSignalHandlerContext()
 
 			defaults.SetVerifyTargetGroup(awsVerifyTargetGroup)
+			defaults.SetVerifyTrafficRouterWeight(verifyTrafficRouterWeight)
+			defaults.SetWeightVerifyTimeout(weightVerifyTimeout)
+			defaults.SetIstioConfigDumpURL(istioConfigDumpURL)
+			defaults.SetNginxBackendsURL(nginxBackendsURL)
 			defaults.SetIstioAPIVersion(istioVersion)
 			defaults.SetAmbassadorAPIVersion(ambassadorVersion)
 			defaults.SetSMIAPIVersion(trafficSplitVersion)
 			defaults.SetAppMeshCRDVersion(appmeshCRDVersion)
 			defaults.SetTraefikAPIGroup(traefikAPIGroup)
 			defaults.SetTraefikVersion(traefikVersion)
+			defaults.SetGatewayAPIVersion(gatewayAPIVersion)
+			defaults.SetClusterName(clusterName)
 
 			config, err := clientConfig.ClientConfig()
 			checkError(err)
@@ -211,6 +227,20 @@ func newCommand() *cobra.Command {
 				go func() { log.Println(http.ListenAndServe(pprofAddress, mux)) }()
 			}
 
+			if otlpAddress != "" {
+				shutdownTracing, err := tracing.InitTracerProvider(ctx, otlpAddress, otlpInsecure)
+				checkError(err)
+				log.Infof("Exporting traces to %s", otlpAddress)
+				defer func() {
+					// the signal handler context is already done, flush the pending spans with a fresh one
+					shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+					defer cancel()
+					if err := shutdownTracing(shutdownCtx); err != nil {
+						log.Warnf("Failed to shutdown trace exporter: %v", err)
+					}
+				}()
+			}
+
 			var cm *controller.Manager
 
 			enabledControllers, err := getEnabledControllers(controllersEnabled)
@@ -253,6 +283,8 @@ func newCommand() *cobra.Command {
 					tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantRolloutStrategyTemplateInformer(dynamicInformerFactory),
+					tolerantinformer.NewTolerantClusterRolloutStrategyTemplateInformer(clusterDynamicInformerFactory),
 					istioPrimaryDynamicClient,
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
@@ -283,6 +315,7 @@ func newCommand() *cobra.Command {
 	defaultNGINXIngressClass := []string{"nginx"}
 
 	clientConfig = addKubectlFlagsToCmd(&command)
+	command.AddCommand(newWebhookCommand(clientConfig))
 	command.Flags().Int64Var(&rolloutResyncPeriod, "rollout-resync", controller.DefaultRolloutResyncPeriod, "Time period in seconds for rollouts resync.")
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "runs controller in namespaced mode (does not require cluster RBAC)")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
@@ -303,6 +336,8 @@ func newCommand() *cobra.Command {
 	command.Flags().StringVar(&trafficSplitVersion, "traffic-split-api-version", defaults.DefaultSMITrafficSplitVersion, "Set the default TrafficSplit apiVersion that controller uses when creating TrafficSplits.")
 	command.Flags().StringVar(&traefikAPIGroup, "traefik-api-group", defaults.DefaultTraefikAPIGroup, "Set the default Traerfik apiGroup that controller uses.")
 	command.Flags().StringVar(&traefikVersion, "traefik-api-version", defaults.DefaultTraefikVersion, "Set the default Traerfik apiVersion that controller uses.")
+	command.Flags().StringVar(&gatewayAPIVersion, "gatewayapi-api-version", defaults.DefaultGatewayAPIVersion, "Set the default Gateway API apiVersion that controller uses when manipulating HTTPRoutes and GRPCRoutes.")
+	command.Flags().StringVar(&clusterName, "cluster-name", "", "Set the name of the cluster the controller is running in, as referenced by the waves of multi-cluster rollouts.")
 	command.Flags().StringVar(&ingressVersion, "ingress-api-version", "", "Set the Ingress apiVersion that the controller should use.")
 	command.Flags().StringVar(&appmeshCRDVersion, "appmesh-crd-version", defaults.DefaultAppMeshCRDVersion, "Set the default AppMesh CRD Version that controller uses when manipulating resources.")
 	command.Flags().StringArrayVar(&albIngressClasses, "alb-ingress-classes", defaultALBIngressClass, "Defines all the ingress class annotations that the alb ingress controller operates on. Defaults to alb")
@@ -310,6 +345,10 @@ func newCommand() *cobra.Command {
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "alb-verify-weight", false, "Verify ALB target group weights before progressing through steps (requires AWS privileges)")
 	command.Flags().MarkDeprecated("alb-verify-weight", "Use --aws-verify-target-group instead")
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "aws-verify-target-group", false, "Verify ALB target group before progressing through steps (requires AWS privileges)")
+	command.Flags().BoolVar(&verifyTrafficRouterWeight, "traffic-router-verify-weight", false, "Verify Istio, Nginx and SMI traffic weights before progressing through steps")
+	command.Flags().DurationVar(&weightVerifyTimeout, "weight-verify-timeout", 0, "Report traffic weights not verified within this duration in the WeightVerified condition of the rollout. Disabled if 0")
+	command.Flags().StringVar(&istioConfigDumpURL, "istio-config-dump-url", "", "URL of the Envoy config dump the Istio traffic weights are verified against, e.g. the /debug/config_dump?proxyID=<pod>.<namespace> endpoint of istiod")
+	command.Flags().StringVar(&nginxBackendsURL, "nginx-backends-url", "", "URL of the ingress-nginx backends configuration the Nginx traffic weights are verified against, e.g. the /configuration/backends endpoint of the controller")
 	command.Flags().BoolVar(&printVersion, "version", false, "Print version")
 	command.Flags().BoolVar(&electOpts.LeaderElect, "leader-elect", controller.DefaultLeaderElect, "If true, controller will perform leader election between instances to ensure no more than one instance of controller operates at a time")
 	command.Flags().DurationVar(&electOpts.LeaderElectionLeaseDuration, "leader-election-lease-duration", controller.DefaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot. This is effectively the maximum duration that a leader can be stopped before it is replaced by another candidate. This is only applicable if leader election is enabled.")
@@ -318,6 +357,8 @@ func newCommand() *cobra.Command {
 	command.Flags().BoolVar(&selfServiceNotificationEnabled, "self-service-notification-enabled", false, "Allows rollouts controller to pull notification config from the namespace that the rollout resource is in. This is useful for self-service notification.")
 	command.Flags().StringSliceVar(&controllersEnabled, "controllers", nil, "Explicitly specify the list of controllers to run, currently only supports 'analysis', eg. --controller=analysis. Default: all controllers are enabled")
 	command.Flags().StringVar(&pprofAddress, "enable-pprof-address", "", "Enable pprof profiling on controller by providing a server address.")
+	command.Flags().StringVar(&otlpAddress, "otlp-address", "", "Export traces of the reconciliations with OTLP over gRPC to the collector at the given address (host:port). Tracing is disabled if empty.")
+	command.Flags().BoolVar(&otlpInsecure, "otlp-insecure", false, "Export traces to the OTLP collector without TLS")
 	return &command
 }
 
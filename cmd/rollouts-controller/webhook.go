@@ -0,0 +1,86 @@
+package main
+
+import (
+	"crypto/tls"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/signals"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/version"
+	"github.com/argoproj/argo-rollouts/webhook"
+)
+
+func newWebhookCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	var (
+		logLevel                 string
+		logFormat                string
+		port                     int
+		dryRun                   bool
+		tlsCertFile              string
+		tlsKeyFile               string
+		serviceName              string
+		certSecretName           string
+		webhookConfigurationName string
+	)
+	var command = cobra.Command{
+		Use:   "webhook",
+		Short: "Run a validating admission webhook server for Rollouts, AnalysisTemplates and Experiments",
+		RunE: func(c *cobra.Command, args []string) error {
+			setLogLevel(logLevel)
+			if logFormat != "" {
+				log.SetFormatter(createFormatter(logFormat))
+			}
+			log.WithField("version", version.GetVersion()).Info("Argo Rollouts webhook starting")
+
+			ctx := signals.SetupSignalHandlerContext()
+
+			config, err := clientConfig.ClientConfig()
+			checkError(err)
+			kubeClient, err := kubernetes.NewForConfig(config)
+			checkError(err)
+			argoprojClient, err := clientset.NewForConfig(config)
+			checkError(err)
+			dynamicClient, err := dynamic.NewForConfig(config)
+			checkError(err)
+
+			var certificate tls.Certificate
+			if tlsCertFile != "" {
+				certificate, err = tls.LoadX509KeyPair(tlsCertFile, tlsKeyFile)
+				checkError(err)
+			} else {
+				bootstrapped, err := webhook.BootstrapCertificate(ctx, kubeClient, webhook.CertificateOptions{
+					Namespace:                defaults.Namespace(),
+					ServiceName:              serviceName,
+					SecretName:               certSecretName,
+					WebhookConfigurationName: webhookConfigurationName,
+				})
+				checkError(err)
+				certificate = *bootstrapped
+			}
+
+			server := webhook.NewServer(webhook.ServerOptions{
+				KubeClientset:     kubeClient,
+				RolloutsClientset: argoprojClient,
+				DynamicClientset:  dynamicClient,
+				DryRun:            dryRun,
+			})
+			return server.Run(ctx, port, certificate)
+		},
+	}
+	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&logFormat, "logformat", "", "Set the logging format. One of: text|json")
+	command.Flags().IntVar(&port, "port", webhook.DefaultPort, "Set the port the webhook server listens on")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Admit invalid objects with a warning instead of rejecting them")
+	command.Flags().StringVar(&tlsCertFile, "tls-cert-file", "", "File containing the serving certificate. If omitted, a self-signed certificate is generated and stored in the certificate secret")
+	command.Flags().StringVar(&tlsKeyFile, "tls-key-file", "", "File containing the private key of the serving certificate")
+	command.Flags().StringVar(&serviceName, "service-name", "argo-rollouts-webhook", "Name of the service the API server calls the webhook through")
+	command.Flags().StringVar(&certSecretName, "cert-secret-name", "argo-rollouts-webhook-certs", "Name of the secret which stores the generated certificate")
+	command.Flags().StringVar(&webhookConfigurationName, "webhook-configuration-name", "argo-rollouts-validating-webhook", "Name of the ValidatingWebhookConfiguration to inject the CA of the generated certificate into")
+	return &command
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
+	"github.com/argoproj/argo-rollouts/multicluster"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	rolloutscheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
@@ -57,6 +58,7 @@ import (
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 const (
@@ -128,6 +130,7 @@ type Manager struct {
 	analysisController      *analysis.Controller
 	serviceController       *service.Controller
 	ingressController       *ingress.Controller
+	multiClusterController  *multicluster.Controller
 	notificationsController notificationcontroller.NotificationController
 
 	rolloutSynced                 cache.InformerSynced
@@ -142,6 +145,9 @@ type Manager struct {
 	configMapSynced               cache.InformerSynced
 	secretSynced                  cache.InformerSynced
 
+	rolloutStrategyTemplateSynced        cache.InformerSynced
+	clusterRolloutStrategyTemplateSynced cache.InformerSynced
+
 	rolloutWorkqueue     workqueue.RateLimitingInterface
 	serviceWorkqueue     workqueue.RateLimitingInterface
 	ingressWorkqueue     workqueue.RateLimitingInterface
@@ -263,6 +269,8 @@ func NewManager(
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	rolloutStrategyTemplateInformer informers.RolloutStrategyTemplateInformer,
+	clusterRolloutStrategyTemplateInformer informers.ClusterRolloutStrategyTemplateInformer,
 	istioPrimaryDynamicClient dynamic.Interface,
 	istioVirtualServiceInformer cache.SharedIndexInformer,
 	istioDestinationRuleInformer cache.SharedIndexInformer,
@@ -285,10 +293,14 @@ func NewManager(
 	runtime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
 	log.Info("Creating event broadcaster")
 
+	// the controllers reading the strategy of the rollouts resolve the strategy references from the same templates
+	strategyTemplateGetter := strategytemplate.NewListerGetter(rolloutStrategyTemplateInformer.Lister(), clusterRolloutStrategyTemplateInformer.Lister())
+
 	metricsAddr := fmt.Sprintf(listenAddr, metricsPort)
 	metricsServer := metrics.NewMetricsServer(metrics.ServerConfig{
 		Addr:                          metricsAddr,
 		RolloutLister:                 rolloutsInformer.Lister(),
+		StrategyTemplateGetter:        strategyTemplateGetter,
 		AnalysisRunLister:             analysisRunInformer.Lister(),
 		AnalysisTemplateLister:        analysisTemplateInformer.Lister(),
 		ClusterAnalysisTemplateLister: clusterAnalysisTemplateInformer.Lister(),
@@ -321,6 +333,13 @@ func NewManager(
 		}),
 	)
 
+	multiClusterController := multicluster.NewController(multicluster.ControllerConfig{
+		KubeClientSet:    kubeclientset,
+		RolloutsInformer: rolloutsInformer,
+		RolloutWorkQueue: rolloutWorkqueue,
+		ResyncPeriod:     resyncPeriod,
+	})
+
 	rolloutController := rollout.NewController(rollout.ControllerConfig{
 		Namespace:                       namespace,
 		KubeClientSet:                   kubeclientset,
@@ -345,6 +364,10 @@ func NewManager(
 		IngressWorkQueue:                ingressWorkqueue,
 		MetricsServer:                   metricsServer,
 		Recorder:                        recorder,
+		MemberClusterStatusLister:       multiClusterController,
+
+		RolloutStrategyTemplateInformer:        rolloutStrategyTemplateInformer,
+		ClusterRolloutStrategyTemplateInformer: clusterRolloutStrategyTemplateInformer,
 	})
 
 	experimentController := experiments.NewController(experiments.ControllerConfig{
@@ -372,6 +395,9 @@ func NewManager(
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        metricsServer,
 		Recorder:             recorder,
+
+		RolloutLister:          rolloutsInformer.Lister(),
+		StrategyTemplateGetter: strategyTemplateGetter,
 	})
 
 	serviceController := service.NewController(service.ControllerConfig{
@@ -383,6 +409,8 @@ func NewManager(
 		ServiceWorkqueue:  serviceWorkqueue,
 		ResyncPeriod:      resyncPeriod,
 		MetricsServer:     metricsServer,
+
+		StrategyTemplateGetter: strategyTemplateGetter,
 	})
 
 	ingressController := ingress.NewController(ingress.ControllerConfig{
@@ -397,6 +425,8 @@ func NewManager(
 
 		ALBClasses:   albIngressClasses,
 		NGINXClasses: nginxIngressClasses,
+
+		StrategyTemplateGetter: strategyTemplateGetter,
 	})
 
 	cm := &Manager{
@@ -414,6 +444,8 @@ func NewManager(
 		replicasSetSynced:                    replicaSetInformer.Informer().HasSynced,
 		configMapSynced:                      notificationConfigMapInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced,
 		secretSynced:                         notificationSecretInformerFactory.Core().V1().Secrets().Informer().HasSynced,
+		rolloutStrategyTemplateSynced:        rolloutStrategyTemplateInformer.Informer().HasSynced,
+		clusterRolloutStrategyTemplateSynced: clusterRolloutStrategyTemplateInformer.Informer().HasSynced,
 		rolloutWorkqueue:                     rolloutWorkqueue,
 		experimentWorkqueue:                  experimentWorkqueue,
 		analysisRunWorkqueue:                 analysisRunWorkqueue,
@@ -422,6 +454,7 @@ func NewManager(
 		rolloutController:                    rolloutController,
 		serviceController:                    serviceController,
 		ingressController:                    ingressController,
+		multiClusterController:               multiClusterController,
 		experimentController:                 experimentController,
 		analysisController:                   analysisController,
 		notificationsController:              notificationsController,
@@ -583,12 +616,12 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 
 		// Wait for the caches to be synced before starting workers
 		log.Info("Waiting for controller's informer caches to sync")
-		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced, c.rolloutStrategyTemplateSynced); !ok {
 			log.Fatalf("failed to wait for caches to sync, exiting")
 		}
 		// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
 		if c.namespace == metav1.NamespaceAll {
-			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced); !ok {
+			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced, c.clusterRolloutStrategyTemplateSynced); !ok {
 				log.Fatalf("failed to wait for cluster-scoped caches to sync, exiting")
 			}
 		}
@@ -599,6 +632,11 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 		go wait.Until(func() { c.wg.Add(1); c.experimentController.Run(ctx, experimentThreadiness); c.wg.Done() }, time.Second, ctx.Done())
 		go wait.Until(func() { c.wg.Add(1); c.analysisController.Run(ctx, analysisThreadiness); c.wg.Done() }, time.Second, ctx.Done())
 		go wait.Until(func() { c.wg.Add(1); c.notificationsController.Run(rolloutThreadiness, ctx.Done()); c.wg.Done() }, time.Second, ctx.Done())
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			wait.Until(func() { c.multiClusterController.Run(ctx) }, time.Second, ctx.Done())
+		}()
 
 	}
 	log.Info("Started controller")
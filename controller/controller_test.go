@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	experimentsController "github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
+	"github.com/argoproj/argo-rollouts/multicluster"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
@@ -80,6 +81,8 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		replicasSetSynced:                    alwaysReady,
 		configMapSynced:                      alwaysReady,
 		secretSynced:                         alwaysReady,
+		rolloutStrategyTemplateSynced:        alwaysReady,
+		clusterRolloutStrategyTemplateSynced: alwaysReady,
 		rolloutWorkqueue:                     rolloutWorkqueue,
 		serviceWorkqueue:                     serviceWorkqueue,
 		ingressWorkqueue:                     ingressWorkqueue,
@@ -153,6 +156,9 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		IngressWorkQueue:                ingressWorkqueue,
 		MetricsServer:                   cm.metricsServer,
 		Recorder:                        record.NewFakeEventRecorder(),
+
+		RolloutStrategyTemplateInformer:        i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		ClusterRolloutStrategyTemplateInformer: i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
 	})
 
 	cm.analysisController = analysis.NewController(analysis.ControllerConfig{
@@ -178,6 +184,12 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		MetricsServer:    cm.metricsServer,
 	})
 
+	cm.multiClusterController = multicluster.NewController(multicluster.ControllerConfig{
+		KubeClientSet:    f.kubeclient,
+		RolloutsInformer: i.Argoproj().V1alpha1().Rollouts(),
+		RolloutWorkQueue: rolloutWorkqueue,
+	})
+
 	cm.serviceController = service.NewController(service.ControllerConfig{
 		Kubeclientset:     f.kubeclient,
 		Argoprojclientset: f.client,
@@ -251,6 +263,8 @@ func TestNewManager(t *testing.T) {
 		i.Argoproj().V1alpha1().AnalysisRuns(),
 		i.Argoproj().V1alpha1().AnalysisTemplates(),
 		i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
 		dynamicClient,
 		istioVirtualServiceInformer,
 		istioDestinationRuleInformer,
@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	rolloutlister "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 type MetricsServer struct {
@@ -47,6 +48,8 @@ type ServerConfig struct {
 	ClusterAnalysisTemplateLister rolloutlister.ClusterAnalysisTemplateLister
 	ExperimentLister              rolloutlister.ExperimentLister
 	K8SRequestProvider            *K8sRequestsCountProvider
+	// StrategyTemplateGetter resolves the strategy of the rollouts referencing a strategy template
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 // NewMetricsServer returns a new prometheus server which collects rollout metrics
@@ -56,7 +59,7 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 	reg := prometheus.NewRegistry()
 
 	if cfg.RolloutLister != nil {
-		reg.MustRegister(NewRolloutCollector(cfg.RolloutLister))
+		reg.MustRegister(NewRolloutCollector(cfg.RolloutLister, cfg.StrategyTemplateGetter))
 	}
 	if cfg.ExperimentLister != nil {
 		reg.MustRegister(NewExperimentCollector(cfg.ExperimentLister))
@@ -178,7 +178,7 @@ rollout_info_replicas_updated{name="guestbook-canary",namespace="default"} 0`,
 func testRolloutDescribe(t *testing.T, fakeRollout string, cond *v1alpha1.RolloutCondition, expectedResponse string) {
 	registry := prometheus.NewRegistry()
 	config := newFakeServerConfig(newFakeRollout(fakeRollout, cond))
-	registry.MustRegister(NewRolloutCollector(config.RolloutLister))
+	registry.MustRegister(NewRolloutCollector(config.RolloutLister, nil))
 	mux := http.NewServeMux()
 	mux.Handle(MetricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
 	testHttpResponse(t, mux, expectedResponse, assert.Contains)
@@ -9,6 +9,7 @@ import (
 	rolloutlister "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 // RolloutPhase the phases of a reconcile can have
@@ -33,13 +34,15 @@ const (
 )
 
 type rolloutCollector struct {
-	store rolloutlister.RolloutLister
+	store                  rolloutlister.RolloutLister
+	strategyTemplateGetter strategytemplate.Getter
 }
 
 // NewRolloutCollector returns a prometheus collector for rollout metrics
-func NewRolloutCollector(rolloutLister rolloutlister.RolloutLister) prometheus.Collector {
+func NewRolloutCollector(rolloutLister rolloutlister.RolloutLister, strategyTemplateGetter strategytemplate.Getter) prometheus.Collector {
 	return &rolloutCollector{
-		store: rolloutLister,
+		store:                  rolloutLister,
+		strategyTemplateGetter: strategyTemplateGetter,
 	}
 }
 
@@ -56,7 +59,7 @@ func (c *rolloutCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 	for _, rollout := range rollouts {
-		collectRollouts(ch, rollout)
+		collectRollouts(ch, strategytemplate.ResolvedRollout(rollout, c.strategyTemplateGetter))
 	}
 }
 
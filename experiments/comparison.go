@@ -0,0 +1,172 @@
+package experiments
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	experimentutil "github.com/argoproj/argo-rollouts/utils/experiment"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const (
+	// maxTemplateScore is the score of a template which has the best values of every metric
+	maxTemplateScore = 100
+
+	experimentWinnerReason   = "ExperimentWinner"
+	experimentNoWinnerReason = "ExperimentNoWinner"
+)
+
+// reconcileComparison scores the templates on the latest measurements of the metrics of the comparison. Once the
+// experiment completed successfully, the template with the highest score is selected as the winner
+func (ec *experimentContext) reconcileComparison() {
+	comparison := ec.ex.Spec.Comparison
+	if comparison == nil || ec.newStatus.Winner != "" {
+		return
+	}
+
+	// values of the metrics by template
+	values := map[string]map[string]float64{}
+	for _, analysis := range comparison.Analyses {
+		runStatus := experimentutil.GetAnalysisRunStatus(*ec.newStatus, analysis.AnalysisName)
+		if runStatus == nil || runStatus.AnalysisRun == "" {
+			continue
+		}
+		run, err := ec.analysisRunLister.AnalysisRuns(ec.ex.Namespace).Get(runStatus.AnalysisRun)
+		if err != nil {
+			ec.log.Warnf("Failed to get AnalysisRun '%s' of template '%s' to compare: %v", runStatus.AnalysisRun, analysis.TemplateName, err)
+			continue
+		}
+		values[analysis.TemplateName] = latestMetricValues(run)
+	}
+
+	scores := scoreTemplates(comparison, values)
+	for _, analysis := range comparison.Analyses {
+		templateStatus := experimentutil.GetTemplateStatus(*ec.newStatus, analysis.TemplateName)
+		if templateStatus == nil {
+			continue
+		}
+		templateStatus.Score = nil
+		if score, ok := scores[analysis.TemplateName]; ok {
+			templateStatus.Score = pointer.Int32(score)
+		}
+		experimentutil.SetTemplateStatus(ec.newStatus, *templateStatus)
+	}
+
+	if ec.newStatus.Phase != v1alpha1.AnalysisPhaseSuccessful || ec.ex.Status.Phase == v1alpha1.AnalysisPhaseSuccessful {
+		return
+	}
+	winner := selectWinner(comparison, scores)
+	if winner == "" {
+		ec.log.Warn("Experiment completed without a winner")
+		ec.recorder.Eventf(ec.ex, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: experimentNoWinnerReason}, "Experiment completed without a winner: the templates were not measured or have the same score")
+		return
+	}
+	ec.newStatus.Winner = winner
+	ec.log.Infof("Template '%s' won the experiment with a score of %d", winner, scores[winner])
+	ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: experimentWinnerReason}, "Template '%s' won the experiment with a score of %d", winner, scores[winner])
+}
+
+// latestMetricValues returns the values of the latest measurements of the metrics of the AnalysisRun which have a
+// numeric value
+func latestMetricValues(run *v1alpha1.AnalysisRun) map[string]float64 {
+	values := map[string]float64{}
+	for _, metricResult := range run.Status.MetricResults {
+		for i := len(metricResult.Measurements) - 1; i >= 0; i-- {
+			measurement := metricResult.Measurements[i]
+			if !measurement.Phase.Completed() || measurement.Phase == v1alpha1.AnalysisPhaseError {
+				continue
+			}
+			if value, ok := parseMeasurementValue(measurement.Value); ok {
+				values[metricResult.Name] = value
+				break
+			}
+		}
+	}
+	return values
+}
+
+// parseMeasurementValue parses the value of a measurement which is a number, or a vector of a single number
+func parseMeasurementValue(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// scoreTemplates scores the compared templates from 0 to 100. Every metric ranks the templates linearly between the
+// worst value, which scores 0, and the best value, which scores 1, and the score of a template is the weighted
+// average of its ranks. Templates are only scored once every compared template has a value for every metric
+func scoreTemplates(comparison *v1alpha1.ExperimentComparison, values map[string]map[string]float64) map[string]int32 {
+	if len(comparison.Analyses) == 0 || len(comparison.Metrics) == 0 {
+		return nil
+	}
+	ranks := map[string]float64{}
+	var totalWeight float64
+	for _, metric := range comparison.Metrics {
+		minValue, maxValue := math.Inf(1), math.Inf(-1)
+		for _, analysis := range comparison.Analyses {
+			value, ok := values[analysis.TemplateName][metric.Name]
+			if !ok {
+				return nil
+			}
+			minValue = math.Min(minValue, value)
+			maxValue = math.Max(maxValue, value)
+		}
+		weight := float64(1)
+		if metric.Weight != nil {
+			weight = float64(*metric.Weight)
+		}
+		totalWeight += weight
+		for _, analysis := range comparison.Analyses {
+			rank := float64(1)
+			if maxValue > minValue {
+				value := values[analysis.TemplateName][metric.Name]
+				rank = (value - minValue) / (maxValue - minValue)
+				if metric.Goal == v1alpha1.ComparisonGoalMinimize {
+					rank = 1 - rank
+				}
+			}
+			ranks[analysis.TemplateName] += weight * rank
+		}
+	}
+	if totalWeight <= 0 {
+		return nil
+	}
+	scores := map[string]int32{}
+	for template, rank := range ranks {
+		scores[template] = int32(math.Round(maxTemplateScore * rank / totalWeight))
+	}
+	return scores
+}
+
+// selectWinner returns the template with the highest score, or an empty string if the templates were not scored or
+// several templates have the highest score
+func selectWinner(comparison *v1alpha1.ExperimentComparison, scores map[string]int32) string {
+	winner := ""
+	highest := int32(-1)
+	tied := false
+	for _, analysis := range comparison.Analyses {
+		score, ok := scores[analysis.TemplateName]
+		if !ok {
+			return ""
+		}
+		switch {
+		case score > highest:
+			winner, highest, tied = analysis.TemplateName, score, false
+		case score == highest:
+			tied = true
+		}
+	}
+	if tied {
+		return ""
+	}
+	return winner
+}
@@ -0,0 +1,146 @@
+package experiments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newComparison(metrics ...v1alpha1.ComparisonMetric) *v1alpha1.ExperimentComparison {
+	return &v1alpha1.ExperimentComparison{
+		Analyses: []v1alpha1.ComparisonAnalysis{
+			{TemplateName: "a", AnalysisName: "a-metrics"},
+			{TemplateName: "b", AnalysisName: "b-metrics"},
+		},
+		Metrics: metrics,
+	}
+}
+
+func TestScoreTemplates(t *testing.T) {
+	t.Run("Maximize", func(t *testing.T) {
+		comparison := newComparison(v1alpha1.ComparisonMetric{Name: "conversion"})
+		scores := scoreTemplates(comparison, map[string]map[string]float64{
+			"a": {"conversion": 0.1},
+			"b": {"conversion": 0.2},
+		})
+		assert.Equal(t, map[string]int32{"a": 0, "b": 100}, scores)
+		assert.Equal(t, "b", selectWinner(comparison, scores))
+	})
+	t.Run("Minimize", func(t *testing.T) {
+		comparison := newComparison(v1alpha1.ComparisonMetric{Name: "latency", Goal: v1alpha1.ComparisonGoalMinimize})
+		scores := scoreTemplates(comparison, map[string]map[string]float64{
+			"a": {"latency": 120},
+			"b": {"latency": 200},
+		})
+		assert.Equal(t, map[string]int32{"a": 100, "b": 0}, scores)
+		assert.Equal(t, "a", selectWinner(comparison, scores))
+	})
+	t.Run("Weighted", func(t *testing.T) {
+		comparison := newComparison(
+			v1alpha1.ComparisonMetric{Name: "conversion", Weight: pointer.Int32(3)},
+			v1alpha1.ComparisonMetric{Name: "latency", Goal: v1alpha1.ComparisonGoalMinimize},
+		)
+		scores := scoreTemplates(comparison, map[string]map[string]float64{
+			"a": {"conversion": 0.1, "latency": 120},
+			"b": {"conversion": 0.2, "latency": 200},
+		})
+		assert.Equal(t, map[string]int32{"a": 25, "b": 75}, scores)
+		assert.Equal(t, "b", selectWinner(comparison, scores))
+	})
+	t.Run("Tie", func(t *testing.T) {
+		comparison := newComparison(v1alpha1.ComparisonMetric{Name: "conversion"})
+		scores := scoreTemplates(comparison, map[string]map[string]float64{
+			"a": {"conversion": 0.2},
+			"b": {"conversion": 0.2},
+		})
+		assert.Equal(t, map[string]int32{"a": 100, "b": 100}, scores)
+		assert.Equal(t, "", selectWinner(comparison, scores))
+	})
+	t.Run("MissingValue", func(t *testing.T) {
+		comparison := newComparison(v1alpha1.ComparisonMetric{Name: "conversion"})
+		scores := scoreTemplates(comparison, map[string]map[string]float64{
+			"a": {"conversion": 0.2},
+		})
+		assert.Nil(t, scores)
+		assert.Equal(t, "", selectWinner(comparison, scores))
+	})
+}
+
+func TestParseMeasurementValue(t *testing.T) {
+	for value, expected := range map[string]float64{"0.5": 0.5, "[0.25]": 0.25, " 3 ": 3} {
+		f, ok := parseMeasurementValue(value)
+		assert.True(t, ok)
+		assert.Equal(t, expected, f)
+	}
+	for _, value := range []string{"", "[0.1, 0.2]", "NaN", "true"} {
+		_, ok := parseMeasurementValue(value)
+		assert.False(t, ok)
+	}
+}
+
+func TestLatestMetricValues(t *testing.T) {
+	run := &v1alpha1.AnalysisRun{
+		Status: v1alpha1.AnalysisRunStatus{
+			MetricResults: []v1alpha1.MetricResult{{
+				Name: "conversion",
+				Measurements: []v1alpha1.Measurement{
+					{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "0.1"},
+					{Phase: v1alpha1.AnalysisPhaseFailed, Value: "0.2"},
+					{Phase: v1alpha1.AnalysisPhaseError, Value: "0.3"},
+					{Phase: v1alpha1.AnalysisPhaseRunning},
+				},
+			}, {
+				Name:         "errors",
+				Measurements: []v1alpha1.Measurement{{Phase: v1alpha1.AnalysisPhaseError}},
+			}},
+		},
+	}
+	assert.Equal(t, map[string]float64{"conversion": 0.2}, latestMetricValues(run))
+}
+
+func TestSelectExperimentWinner(t *testing.T) {
+	templates := generateTemplates("a", "b")
+	e := newExperiment("foo", templates, "")
+	e.Spec.Analyses = []v1alpha1.ExperimentAnalysisTemplateRef{
+		{Name: "a-metrics", TemplateName: "metrics", RequiredForCompletion: true},
+		{Name: "b-metrics", TemplateName: "metrics", RequiredForCompletion: true},
+	}
+	e.Spec.Comparison = newComparison(v1alpha1.ComparisonMetric{Name: "conversion"})
+	e.Status.Phase = v1alpha1.AnalysisPhaseRunning
+	e.Status.AvailableAt = secondsAgo(60)
+	rsA := templateToRS(e, templates[0], 0)
+	rsB := templateToRS(e, templates[1], 0)
+
+	var runs []*v1alpha1.AnalysisRun
+	for i, value := range []string{"0.1", "0.2"} {
+		analysis := e.Spec.Analyses[i]
+		ar := analysisTemplateToRun(analysis.Name, e, &v1alpha1.AnalysisTemplateSpec{})
+		ar.Status = v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseSuccessful,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:         "conversion",
+				Phase:        v1alpha1.AnalysisPhaseSuccessful,
+				Measurements: []v1alpha1.Measurement{{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: value}},
+			}},
+		}
+		e.Status.AnalysisRuns = append(e.Status.AnalysisRuns, v1alpha1.ExperimentAnalysisRunStatus{
+			Name:        analysis.Name,
+			Phase:       v1alpha1.AnalysisPhaseRunning,
+			AnalysisRun: ar.Name,
+		})
+		runs = append(runs, ar)
+	}
+
+	f := newFixture(t, e, rsA, rsB, runs[0], runs[1])
+	defer f.Close()
+	patchIndex := f.expectPatchExperimentAction(e)
+	f.run(getKey(e, t))
+	patchedEx := f.getPatchedExperimentAsObj(patchIndex)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, patchedEx.Status.Phase)
+	assert.Equal(t, "b", patchedEx.Status.Winner)
+	assert.Equal(t, pointer.Int32(0), patchedEx.Status.TemplateStatuses[0].Score)
+	assert.Equal(t, pointer.Int32(100), patchedEx.Status.TemplateStatuses[1].Score)
+}
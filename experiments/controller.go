@@ -34,6 +34,7 @@ import (
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
@@ -243,7 +244,7 @@ func (ec *Controller) Run(ctx context.Context, threadiness int) error {
 	return nil
 }
 
-func (ec *Controller) syncHandler(ctx context.Context, key string) error {
+func (ec *Controller) syncHandler(ctx context.Context, key string) (err error) {
 	startTime := timeutil.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -260,6 +261,14 @@ func (ec *Controller) syncHandler(ctx context.Context, key string) error {
 		return err
 	}
 
+	_, span := tracing.StartSpan(tracing.ObjectContext(ctx, experiment), "experiment.reconcile",
+		tracing.AttributeNamespace.String(experiment.Namespace),
+		tracing.AttributeExperiment.String(experiment.Name),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	defer func() {
 		duration := time.Since(startTime)
 		ec.metricsServer.IncExperimentReconcile(experiment, duration)
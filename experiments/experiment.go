@@ -105,6 +105,7 @@ func (ec *experimentContext) reconcile() *v1alpha1.ExperimentStatus {
 	}
 
 	newStatus := ec.calculateStatus()
+	ec.reconcileComparison()
 	if duration := calculateEnqueueDuration(ec.ex, newStatus); duration != nil {
 		ec.log.Infof("Enqueueing Experiment in %s seconds", duration.String())
 		ec.enqueueExperimentAfter(ec.ex, *duration)
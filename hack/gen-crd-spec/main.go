@@ -41,6 +41,9 @@ var crdPaths = map[string]string{
 	"AnalysisTemplate":        "manifests/crds/analysis-template-crd.yaml",
 	"ClusterAnalysisTemplate": "manifests/crds/cluster-analysis-template-crd.yaml",
 	"AnalysisRun":             "manifests/crds/analysis-run-crd.yaml",
+
+	"RolloutStrategyTemplate":        "manifests/crds/rollout-strategy-template-crd.yaml",
+	"ClusterRolloutStrategyTemplate": "manifests/crds/cluster-rollout-strategy-template-crd.yaml",
 }
 
 func setValidationOverride(un *unstructured.Unstructured, fieldOverride map[string]any, path string) {
@@ -101,6 +104,8 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 	deleteFile("config/crd/argoproj.io_clusteranalysistemplates.yaml")
 	deleteFile("config/crd/argoproj.io_experiments.yaml")
 	deleteFile("config/crd/argoproj.io_rollouts.yaml")
+	deleteFile("config/crd/argoproj.io_rolloutstrategytemplates.yaml")
+	deleteFile("config/crd/argoproj.io_clusterrolloutstrategytemplates.yaml")
 	deleteFile("config/crd")
 	deleteFile("config")
 
@@ -216,6 +221,8 @@ func createMetadataValidation(un *unstructured.Unstructured) {
 			analysisJobValidated = append(analysisJobValidated, v)
 		}
 		unstructured.SetNestedSlice(un.Object, analysisJobValidated, prePath...)
+	case "ClusterRolloutStrategyTemplate", "RolloutStrategyTemplate":
+		// the strategies hold no pod template metadata
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
@@ -254,6 +261,8 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.metrics[].provider.job.spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.metrics[].provider.job.spec.template.spec.volumes")
+	case "ClusterRolloutStrategyTemplate", "RolloutStrategyTemplate":
+		// the strategies hold no pod templates
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
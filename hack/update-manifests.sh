@@ -17,6 +17,7 @@ fi
 if [ ! -z "${SET_IMAGE_NAMESPACE}" ] || [ ! -z "${SET_IMAGE_TAG}" ]; then
   (cd ${SRCROOT}/manifests/base && kustomize edit set image quay.io/argoproj/argo-rollouts${SET_IMAGE_NAMESPACE}${SET_IMAGE_TAG})
   (cd ${SRCROOT}/manifests/dashboard-install && kustomize edit set image quay.io/argoproj/kubectl-argo-rollouts${SET_IMAGE_NAMESPACE}${SET_IMAGE_TAG})
+  (cd ${SRCROOT}/manifests/webhook-install && kustomize edit set image quay.io/argoproj/argo-rollouts${SET_IMAGE_NAMESPACE}${SET_IMAGE_TAG})
 fi
 
 kust_cmd="kustomize build --load-restrictor LoadRestrictionsNone"
@@ -29,5 +30,8 @@ ${kust_cmd} "${SRCROOT}/manifests/namespace-install" >> "${SRCROOT}/manifests/na
 echo "${AUTOGENMSG}" > "${SRCROOT}/manifests/dashboard-install.yaml"
 ${kust_cmd} "${SRCROOT}/manifests/dashboard-install" >> "${SRCROOT}/manifests/dashboard-install.yaml"
 
+echo "${AUTOGENMSG}" > "${SRCROOT}/manifests/webhook-install.yaml"
+${kust_cmd} "${SRCROOT}/manifests/webhook-install" >> "${SRCROOT}/manifests/webhook-install.yaml"
+
 echo "${AUTOGENMSG}" > "${SRCROOT}/manifests/notifications-install.yaml"
 ${kust_cmd} "${SRCROOT}/manifests/notifications" >> "${SRCROOT}/manifests/notifications-install.yaml"
@@ -21,7 +21,7 @@ import (
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
-	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 const (
@@ -41,6 +41,9 @@ type ControllerConfig struct {
 	MetricsServer *metrics.MetricsServer
 	ALBClasses    []string
 	NGINXClasses  []string
+
+	// StrategyTemplateGetter resolves the strategy of the rollouts referencing a strategy template
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 // Controller describes an ingress controller
@@ -54,6 +57,8 @@ type Controller struct {
 	enqueueRollout func(obj any)
 	albClasses     []string
 	nginxClasses   []string
+
+	strategyTemplateGetter strategytemplate.Getter
 }
 
 type IngressWrapper interface {
@@ -73,15 +78,12 @@ func NewController(cfg ControllerConfig) *Controller {
 		metricServer:     cfg.MetricsServer,
 		albClasses:       cfg.ALBClasses,
 		nginxClasses:     cfg.NGINXClasses,
+
+		strategyTemplateGetter: cfg.StrategyTemplateGetter,
 	}
 
 	util.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
-		ingressIndexName: func(obj any) ([]string, error) {
-			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-				return ingressutil.GetRolloutIngressKeys(ro), nil
-			}
-			return []string{}, nil
-		},
+		ingressIndexName: strategytemplate.IndexFunc(ingressutil.GetRolloutIngressKeys),
 	}))
 
 	cfg.IngressWrap.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -176,15 +178,6 @@ func (c *Controller) syncNginxIngress(name, namespace string, rollouts []*v1alph
 
 // getRolloutsByIngress returns all rollouts which are referencing specified ingress
 func (c *Controller) getRolloutsByIngress(namespace string, ingressName string) ([]*v1alpha1.Rollout, error) {
-	objs, err := c.rolloutsIndexer.ByIndex(ingressIndexName, fmt.Sprintf("%s/%s", namespace, ingressName))
-	if err != nil {
-		return nil, err
-	}
-	var rollouts []*v1alpha1.Rollout
-	for _, obj := range objs {
-		if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-			rollouts = append(rollouts, ro)
-		}
-	}
-	return rollouts, nil
+	key := fmt.Sprintf("%s/%s", namespace, ingressName)
+	return strategytemplate.ByIndex(c.rolloutsIndexer, ingressIndexName, key, c.strategyTemplateGetter, ingressutil.GetRolloutIngressKeys)
 }
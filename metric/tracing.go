@@ -0,0 +1,84 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// tracingProvider records a span for every call of a provider which queries the external system
+type tracingProvider struct {
+	Provider
+	ctx          context.Context
+	providerType string
+}
+
+// NewTracingProvider returns a Provider which records a span, as a child of the span in ctx, for
+// every Run, Resume, Terminate and GarbageCollect call of the given provider
+func NewTracingProvider(ctx context.Context, providerType string, provider Provider) Provider {
+	return &tracingProvider{
+		Provider:     provider,
+		ctx:          ctx,
+		providerType: providerType,
+	}
+}
+
+func (p *tracingProvider) startSpan(name string, metric v1alpha1.Metric) trace.Span {
+	attrs := []attribute.KeyValue{
+		tracing.AttributeMetric.String(metric.Name),
+		tracing.AttributeMetricProvider.String(p.providerType),
+	}
+	if len(metric.Provider.Plugin) > 0 {
+		plugins := make([]string, 0, len(metric.Provider.Plugin))
+		for name := range metric.Provider.Plugin {
+			plugins = append(plugins, name)
+		}
+		sort.Strings(plugins)
+		attrs = append(attrs, tracing.AttributePlugin.StringSlice(plugins))
+	}
+	_, span := tracing.StartSpan(p.ctx, name, attrs...)
+	return span
+}
+
+func endMeasurementSpan(span trace.Span, measurement v1alpha1.Measurement) {
+	span.SetAttributes(tracing.AttributeMeasurement.String(string(measurement.Phase)))
+	if measurement.Phase == v1alpha1.AnalysisPhaseError {
+		tracing.EndSpan(span, errors.New(measurement.Message))
+		return
+	}
+	span.End()
+}
+
+func (p *tracingProvider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	span := p.startSpan("metric.Run", metric)
+	measurement := p.Provider.Run(run, metric)
+	endMeasurementSpan(span, measurement)
+	return measurement
+}
+
+func (p *tracingProvider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	span := p.startSpan("metric.Resume", metric)
+	measurement = p.Provider.Resume(run, metric, measurement)
+	endMeasurementSpan(span, measurement)
+	return measurement
+}
+
+func (p *tracingProvider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	span := p.startSpan("metric.Terminate", metric)
+	measurement = p.Provider.Terminate(run, metric, measurement)
+	endMeasurementSpan(span, measurement)
+	return measurement
+}
+
+func (p *tracingProvider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	span := p.startSpan("metric.GarbageCollect", metric)
+	err := p.Provider.GarbageCollect(run, metric, limit)
+	tracing.EndSpan(span, err)
+	return err
+}
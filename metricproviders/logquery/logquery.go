@@ -0,0 +1,325 @@
+package logquery
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is a log query
+	ProviderType = "Log"
+	// DefaultTimeoutSeconds is the timeout of the request when the metric does not specify it
+	DefaultTimeoutSeconds = 30
+	// LokiQueryPath is the path of the instant query endpoint of Loki
+	LokiQueryPath = "/loki/api/v1/query"
+	// LokiTenantHeader is the header which sets the tenant of a multi-tenant Loki
+	LokiTenantHeader = "X-Scope-OrgID"
+	// ResolvedLogQuery is the metadata key of the query of the measurement
+	ResolvedLogQuery = "ResolvedLogQuery"
+)
+
+// Provider contains all the required components to run a log query
+// Implements the Provider Interface
+type Provider struct {
+	logCtx log.Entry
+	client *http.Client
+}
+
+// Type indicates provider is a log query provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	metricsMetadata := make(map[string]string)
+	if metric.Provider.Log.Loki != nil && metric.Provider.Log.Loki.Query != "" {
+		metricsMetadata[ResolvedLogQuery] = metric.Provider.Log.Loki.Query
+	} else if metric.Provider.Log.Elasticsearch != nil && metric.Provider.Log.Elasticsearch.Query != "" {
+		metricsMetadata[ResolvedLogQuery] = metric.Provider.Log.Elasticsearch.Query
+	}
+	return metricsMetadata
+}
+
+// Run queries Loki or Elasticsearch and evaluates the result
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	var result any
+	var err error
+	if metric.Provider.Log.Loki != nil {
+		result, err = p.queryLoki(metric.Provider.Log)
+	} else if metric.Provider.Log.Elasticsearch != nil {
+		result, err = p.queryElasticsearch(metric.Provider.Log)
+	} else {
+		err = errors.New("log metric must specify either loki or elasticsearch")
+	}
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	phase, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	measurement.Value = string(value)
+	measurement.Phase = phase
+	finishedTime := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+// lokiResponse is the response of an instant query of Loki
+type lokiResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// queryLoki runs the LogQL metric query and returns the value of each series of a vector, like the
+// Prometheus provider does, or the value of a scalar
+func (p *Provider) queryLoki(logMetric *v1alpha1.LogMetric) (any, error) {
+	query := url.Values{}
+	query.Set("query", logMetric.Loki.Query)
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(logMetric.Address, "/")+LokiQueryPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if logMetric.Loki.TenantID != "" {
+		request.Header.Set(LokiTenantHeader, logMetric.Loki.TenantID)
+	}
+	body, err := p.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := lokiResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("could not parse Loki response: %w", err)
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("Loki query failed: %s", response.Error)
+	}
+	switch response.Data.ResultType {
+	case "vector":
+		var samples []struct {
+			Value []any `json:"value"`
+		}
+		if err := json.Unmarshal(response.Data.Result, &samples); err != nil {
+			return nil, err
+		}
+		values := make([]float64, 0, len(samples))
+		for _, sample := range samples {
+			value, err := parseSampleValue(sample.Value)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case "scalar":
+		var sample []any
+		if err := json.Unmarshal(response.Data.Result, &sample); err != nil {
+			return nil, err
+		}
+		return parseSampleValue(sample)
+	default:
+		return nil, fmt.Errorf("Loki result type '%s' is not supported, the query must be a metric query", response.Data.ResultType)
+	}
+}
+
+// parseSampleValue parses the value of a [<timestamp>, "<value>"] sample
+func parseSampleValue(sample []any) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("invalid Loki sample: %v", sample)
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid Loki sample: %v", sample)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// elasticsearchResponse is the response of a search of Elasticsearch
+type elasticsearchResponse struct {
+	Hits struct {
+		Total struct {
+			Value float64 `json:"value"`
+		} `json:"total"`
+	} `json:"hits"`
+	Aggregations map[string]any `json:"aggregations"`
+}
+
+// queryElasticsearch runs the search and returns its aggregations, or the count of matching
+// documents if it has none
+func (p *Provider) queryElasticsearch(logMetric *v1alpha1.LogMetric) (any, error) {
+	search := map[string]any{}
+	if err := json.Unmarshal([]byte(logMetric.Elasticsearch.Query), &search); err != nil {
+		return nil, fmt.Errorf("elasticsearch query is not valid JSON: %w", err)
+	}
+	// only the count and the aggregations are used, not the documents
+	if _, ok := search["size"]; !ok {
+		search["size"] = 0
+	}
+	if _, ok := search["track_total_hits"]; !ok {
+		search["track_total_hits"] = true
+	}
+	searchBody, err := json.Marshal(search)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL := fmt.Sprintf("%s/%s/_search", strings.TrimSuffix(logMetric.Address, "/"), url.PathEscape(logMetric.Elasticsearch.Index))
+	request, err := http.NewRequest(http.MethodPost, searchURL, bytes.NewReader(searchBody))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	body, err := p.do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := elasticsearchResponse{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("could not parse Elasticsearch response: %w", err)
+	}
+	if len(response.Aggregations) > 0 {
+		return response.Aggregations, nil
+	}
+	return response.Hits.Total.Value, nil
+}
+
+// do sends the request and returns the body of a 2xx response
+func (p *Provider) do(request *http.Request) ([]byte, error) {
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("received non 2xx response code: %v: %s", response.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// Resume should not be used the log query provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Log provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the log query provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Log provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the log query provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+var insecureTransport *http.Transport = &http.Transport{
+	Proxy:           http.ProxyFromEnvironment,
+	TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+}
+
+// NewLogQueryHttpClient creates the HTTP client of the metric, which sets its headers and
+// authenticates its requests
+func NewLogQueryHttpClient(metric v1alpha1.Metric) (*http.Client, error) {
+	logMetric := metric.Provider.Log
+	timeout := time.Duration(DefaultTimeoutSeconds) * time.Second
+	if logMetric.TimeoutSeconds > 0 {
+		timeout = time.Duration(logMetric.TimeoutSeconds) * time.Second
+	}
+
+	var roundTripper http.RoundTripper = http.DefaultTransport
+	if logMetric.Insecure {
+		roundTripper = insecureTransport
+	}
+	if len(logMetric.Headers) > 0 {
+		roundTripper = headersRoundTripper{headers: logMetric.Headers, roundTripper: roundTripper}
+	}
+	if (v1alpha1.Sigv4Config{}) != logMetric.Authentication.Sigv4 {
+		var err error
+		roundTripper, err = newSigv4RoundTripper(logMetric.Authentication.Sigv4, roundTripper)
+		if err != nil {
+			return nil, err
+		}
+	}
+	client := &http.Client{
+		Transport: roundTripper,
+		Timeout:   timeout,
+	}
+
+	oauth2Config := logMetric.Authentication.OAuth2
+	if oauth2Config.TokenURL != "" {
+		if oauth2Config.ClientID == "" || oauth2Config.ClientSecret == "" {
+			return nil, errors.New("missing mandatory parameter in metric for OAuth2 setup")
+		}
+		oauthCfg := clientcredentials.Config{
+			ClientID:     oauth2Config.ClientID,
+			ClientSecret: oauth2Config.ClientSecret,
+			TokenURL:     oauth2Config.TokenURL,
+			Scopes:       oauth2Config.Scopes,
+		}
+		return oauthCfg.Client(context.WithValue(context.Background(), oauth2.HTTPClient, client)), nil
+	}
+	return client, nil
+}
+
+// headersRoundTripper sets the headers of the metric on every request
+type headersRoundTripper struct {
+	headers      []v1alpha1.WebMetricHeader
+	roundTripper http.RoundTripper
+}
+
+func (rt headersRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for _, header := range rt.headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+	return rt.roundTripper.RoundTrip(req)
+}
+
+// NewLogQueryProvider creates a new log query provider
+func NewLogQueryProvider(logCtx log.Entry, client *http.Client) *Provider {
+	return &Provider{
+		logCtx: logCtx,
+		client: client,
+	}
+}
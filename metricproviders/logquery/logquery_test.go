@@ -0,0 +1,255 @@
+package logquery
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newProvider(t *testing.T, metric v1alpha1.Metric) *Provider {
+	client, err := NewLogQueryHttpClient(metric)
+	assert.NoError(t, err)
+	return NewLogQueryProvider(*log.WithField("test", "log"), client)
+}
+
+func TestRunLoki(t *testing.T) {
+	query := `sum(count_over_time({app="guestbook", rollouts_pod_template_hash="abc123"} |= "error" [5m]))`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, LokiQueryPath, r.URL.Path)
+		assert.Equal(t, query, r.URL.Query().Get("query"))
+		assert.Equal(t, "team-a", r.Header.Get(LokiTenantHeader))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000.000,"12"]}]}}`))
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "error-logs",
+		SuccessCondition: "result[0] < 10",
+		Provider: v1alpha1.MetricProvider{
+			Log: &v1alpha1.LogMetric{
+				Address: server.URL,
+				Loki:    &v1alpha1.LokiQuery{Query: query, TenantID: "team-a"},
+				Headers: []v1alpha1.WebMetricHeader{{Key: "Authorization", Value: "Bearer token"}},
+			},
+		},
+	}
+	p := newProvider(t, metric)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "[12]", measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.Equal(t, map[string]string{ResolvedLogQuery: query}, p.GetMetadata(metric))
+	assert.Equal(t, ProviderType, p.Type())
+
+	metric.SuccessCondition = "result[0] < 20"
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunLokiErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+		message  string
+	}{
+		{
+			name:     "non 2xx response",
+			status:   http.StatusBadRequest,
+			response: "parse error",
+			message:  "received non 2xx response code: 400: parse error",
+		},
+		{
+			name:     "log query",
+			status:   http.StatusOK,
+			response: `{"status":"success","data":{"resultType":"streams","result":[]}}`,
+			message:  "Loki result type 'streams' is not supported, the query must be a metric query",
+		},
+		{
+			name:     "failed query",
+			status:   http.StatusOK,
+			response: `{"status":"error","error":"timeout"}`,
+			message:  "Loki query failed: timeout",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			metric := v1alpha1.Metric{
+				Name:     "error-logs",
+				Provider: v1alpha1.MetricProvider{Log: &v1alpha1.LogMetric{Address: server.URL, Loki: &v1alpha1.LokiQuery{Query: "vector(1)"}}},
+			}
+			measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+			assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+			assert.Equal(t, test.message, measurement.Message)
+		})
+	}
+}
+
+func TestRunLokiScalar(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1700000000.000,"0.5"]}}`))
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "error-logs",
+		SuccessCondition: "result < 1",
+		Provider:         v1alpha1.MetricProvider{Log: &v1alpha1.LogMetric{Address: server.URL + "/", Loki: &v1alpha1.LokiQuery{Query: "scalar(vector(0.5))"}}},
+	}
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "0.5", measurement.Value)
+}
+
+func TestRunElasticsearch(t *testing.T) {
+	response := `{"hits":{"total":{"value":42,"relation":"eq"},"hits":[]}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/logs-guestbook-*/_search", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		search := map[string]any{}
+		assert.NoError(t, json.Unmarshal(body, &search))
+		assert.Equal(t, float64(0), search["size"])
+		assert.Equal(t, true, search["track_total_hits"])
+		assert.Contains(t, search, "query")
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "error-logs",
+		SuccessCondition: "result < 50",
+		Provider: v1alpha1.MetricProvider{
+			Log: &v1alpha1.LogMetric{
+				Address: server.URL,
+				Elasticsearch: &v1alpha1.ElasticsearchQuery{
+					Index: "logs-guestbook-*",
+					Query: `{"query": {"bool": {"filter": [{"term": {"level": "error"}}, {"term": {"kubernetes.labels.rollouts-pod-template-hash": "abc123"}}]}}}`,
+				},
+			},
+		},
+	}
+	p := newProvider(t, metric)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "42", measurement.Value)
+
+	// the aggregations are the result of a search with aggregations
+	response = `{"hits":{"total":{"value":100}},"aggregations":{"errors":{"doc_count":7}}}`
+	metric.SuccessCondition = "result.errors.doc_count < 5"
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `{"errors":{"doc_count":7}}`, measurement.Value)
+
+	metric.Provider.Log.Elasticsearch.Query = "{"
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "elasticsearch query is not valid JSON: unexpected end of JSON input", measurement.Message)
+}
+
+func TestNewLogQueryHttpClient(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			Log: &v1alpha1.LogMetric{
+				Address:        "http://loki:3100",
+				Loki:           &v1alpha1.LokiQuery{Query: "vector(1)"},
+				TimeoutSeconds: 5,
+				Insecure:       true,
+			},
+		},
+	}
+	client, err := NewLogQueryHttpClient(metric)
+	assert.NoError(t, err)
+	assert.Equal(t, insecureTransport, client.Transport)
+	assert.Equal(t, "5s", client.Timeout.String())
+
+	metric.Provider.Log.Authentication.OAuth2 = v1alpha1.OAuth2Config{TokenURL: "http://token"}
+	_, err = NewLogQueryHttpClient(metric)
+	assert.EqualError(t, err, "missing mandatory parameter in metric for OAuth2 setup")
+}
+
+func TestRunOAuth2(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"secret","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"hits":{"total":{"value":1}}}`))
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "error-logs",
+		SuccessCondition: "result == 1",
+		Provider: v1alpha1.MetricProvider{
+			Log: &v1alpha1.LogMetric{
+				Address:       server.URL,
+				Elasticsearch: &v1alpha1.ElasticsearchQuery{Index: "logs", Query: `{}`},
+				Authentication: v1alpha1.Authentication{
+					OAuth2: v1alpha1.OAuth2Config{TokenURL: server.URL + "/token", ClientID: "id", ClientSecret: "secret"},
+				},
+			},
+		},
+	}
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunSigv4(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/")
+		assert.Contains(t, r.Header.Get("Authorization"), "/eu-west-1/es/aws4_request")
+		assert.NotEmpty(t, r.Header.Get("X-Amz-Date"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "track_total_hits")
+		w.Write([]byte(`{"hits":{"total":{"value":3}}}`))
+	}))
+	defer server.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "error-logs",
+		SuccessCondition: "result < 5",
+		Provider: v1alpha1.MetricProvider{
+			Log: &v1alpha1.LogMetric{
+				Address:        server.URL,
+				Elasticsearch:  &v1alpha1.ElasticsearchQuery{Index: "logs", Query: `{"query": {"match_all": {}}}`},
+				Authentication: v1alpha1.Authentication{Sigv4: v1alpha1.Sigv4Config{Region: "eu-west-1"}},
+			},
+		},
+	}
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunNoQuery(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Name:     "error-logs",
+		Provider: v1alpha1.MetricProvider{Log: &v1alpha1.LogMetric{Address: "http://loki:3100"}},
+	}
+	p := newProvider(t, metric)
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "log metric must specify either loki or elasticsearch", measurement.Message)
+	assert.Empty(t, p.GetMetadata(metric))
+}
@@ -0,0 +1,79 @@
+package logquery
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// openSearchSigningName is the name the requests to Amazon OpenSearch Service are signed for
+const openSearchSigningName = "es"
+
+// sigv4RoundTripper signs the requests to Amazon OpenSearch Service with AWS SigV4
+type sigv4RoundTripper struct {
+	signer       *v4.Signer
+	credentials  aws.CredentialsProvider
+	region       string
+	roundTripper http.RoundTripper
+}
+
+func newSigv4RoundTripper(sigv4Config v1alpha1.Sigv4Config, roundTripper http.RoundTripper) (http.RoundTripper, error) {
+	optFns := []func(*config.LoadOptions) error{}
+	if sigv4Config.Region != "" {
+		optFns = append(optFns, config.WithRegion(sigv4Config.Region))
+	}
+	if sigv4Config.Profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(sigv4Config.Profile))
+	}
+	cfg, err := config.LoadDefaultConfig(context.TODO(), optFns...)
+	if err != nil {
+		return nil, err
+	}
+	credentials := cfg.Credentials
+	if sigv4Config.RoleARN != "" {
+		credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), sigv4Config.RoleARN))
+	}
+	return &sigv4RoundTripper{
+		signer:       v4.NewSigner(),
+		credentials:  credentials,
+		region:       cfg.Region,
+		roundTripper: roundTripper,
+	}, nil
+}
+
+func (rt *sigv4RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	payloadHash := sha256.Sum256(body)
+
+	credentials, err := rt.credentials.Retrieve(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	err = rt.signer.SignHTTP(req.Context(), credentials, signed, hex.EncodeToString(payloadHash[:]), openSearchSigningName, rt.region, timeutil.Now())
+	if err != nil {
+		return nil, err
+	}
+	return rt.roundTripper.RoundTrip(signed)
+}
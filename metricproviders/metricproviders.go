@@ -6,7 +6,10 @@ import (
 
 	"github.com/argoproj/argo-rollouts/metric"
 	"github.com/argoproj/argo-rollouts/metricproviders/influxdb"
+	"github.com/argoproj/argo-rollouts/metricproviders/logquery"
+	"github.com/argoproj/argo-rollouts/metricproviders/probe"
 	"github.com/argoproj/argo-rollouts/metricproviders/skywalking"
+	"github.com/argoproj/argo-rollouts/metricproviders/statistical"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -27,6 +30,9 @@ import (
 	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 const (
@@ -36,8 +42,13 @@ const (
 )
 
 type ProviderFactory struct {
-	KubeClient kubernetes.Interface
-	JobLister  batchlisters.JobLister
+	KubeClient        kubernetes.Interface
+	ArgoProjClientset clientset.Interface
+	JobLister         batchlisters.JobLister
+	// RolloutLister and StrategyTemplateGetter resolve the rollouts which own the analysis runs. They are nil
+	// when the controller does not watch rollouts.
+	RolloutLister          listers.RolloutLister
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 type ProviderFactoryFunc func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error)
@@ -109,6 +120,16 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, metric v1alpha1.Metric)
 			return nil, err
 		}
 		return skywalking.NewSkyWalkingProvider(client, logCtx), nil
+	case statistical.ProviderType:
+		return statistical.NewStatisticalProvider(logCtx, f.NewProvider), nil
+	case probe.ProviderType:
+		return probe.NewProbeProvider(logCtx, f.ArgoProjClientset, f.RolloutLister, f.StrategyTemplateGetter), nil
+	case logquery.ProviderType:
+		client, err := logquery.NewLogQueryHttpClient(metric)
+		if err != nil {
+			return nil, err
+		}
+		return logquery.NewLogQueryProvider(logCtx, client), nil
 	case plugin.ProviderType:
 		plugin, err := plugin.NewRpcPlugin(metric)
 		if err != nil {
@@ -143,6 +164,12 @@ func Type(metric v1alpha1.Metric) string {
 		return influxdb.ProviderType
 	} else if metric.Provider.SkyWalking != nil {
 		return skywalking.ProviderType
+	} else if metric.Provider.Statistical != nil {
+		return statistical.ProviderType
+	} else if metric.Provider.Probe != nil {
+		return probe.ProviderType
+	} else if metric.Provider.Log != nil {
+		return logquery.ProviderType
 	} else if metric.Provider.Plugin != nil {
 		return plugin.ProviderType
 	}
@@ -0,0 +1,321 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is a probe
+	ProviderType = "Probe"
+	// DefaultRequests is the number of requests fired per measurement when the metric does not specify it
+	DefaultRequests = 10
+	// DefaultTimeoutSeconds is the timeout of each request when the metric does not specify it
+	DefaultTimeoutSeconds = 5
+	// StatusError is the status of a request which did not get a response
+	StatusError = "Error"
+	// MeasurementTimeout is the duration after which no more requests are fired for a measurement, and the
+	// measurement is evaluated against the requests fired so far
+	MeasurementTimeout = time.Minute
+)
+
+// Provider contains all the required components to probe a service
+// Implements the Provider Interface
+type Provider struct {
+	logCtx         log.Entry
+	rolloutsClient clientset.Interface
+	// rolloutLister and strategyTemplateGetter resolve the rollout which owns the analysis run. They are nil
+	// when the controller does not watch rollouts, in which case the rollout is read with the rolloutsClient
+	rolloutLister          listers.RolloutLister
+	strategyTemplateGetter strategytemplate.Getter
+	// serviceAddress returns the host:port the requests to the service are sent to. Used for unit testing
+	serviceAddress func(namespace, service string, port int32) string
+}
+
+// probeResult is the outcome of a single request
+type probeResult struct {
+	status  string
+	success bool
+	latency time.Duration
+}
+
+// Type indicates provider is a probe provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run fires the requests at the service and evaluates their success ratio and latency percentiles
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	measurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+	probe := metric.Provider.Probe
+
+	service, err := p.resolveService(run, probe)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	address := p.serviceAddress(run.Namespace, service, probe.Port)
+
+	requests := DefaultRequests
+	if probe.Requests != nil {
+		requests = int(*probe.Requests)
+	}
+	timeout := time.Duration(DefaultTimeoutSeconds) * time.Second
+	if probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), MeasurementTimeout)
+	defer cancel()
+	var results []probeResult
+	if probe.GRPC != nil {
+		results, err = probeGRPC(ctx, address, probe.GRPC, requests, timeout)
+	} else {
+		results, err = probeHTTP(ctx, address, probe.HTTP, requests, timeout)
+	}
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+
+	result := summarize(results)
+	value, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	phase, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(measurement, err)
+	}
+	measurement.Value = string(value)
+	measurement.Phase = phase
+	finishedTime := timeutil.MetaNow()
+	measurement.FinishedAt = &finishedTime
+	return measurement
+}
+
+// resolveService returns the service of the metric, or else the canary service of the rollout which
+// owns the analysis run
+func (p *Provider) resolveService(run *v1alpha1.AnalysisRun, probe *v1alpha1.ProbeMetric) (string, error) {
+	if probe.Service != "" {
+		return probe.Service, nil
+	}
+	ownerRef := metav1.GetControllerOf(run)
+	if ownerRef == nil || ownerRef.Kind != "Rollout" {
+		return "", fmt.Errorf("probe service must be specified when the analysis run is not owned by a rollout")
+	}
+	var rollout *v1alpha1.Rollout
+	var err error
+	if p.rolloutLister != nil {
+		rollout, err = p.rolloutLister.Rollouts(run.Namespace).Get(ownerRef.Name)
+		if err != nil {
+			return "", err
+		}
+		rollout = strategytemplate.ResolvedRollout(rollout, p.strategyTemplateGetter)
+	} else {
+		rollout, err = p.rolloutsClient.ArgoprojV1alpha1().Rollouts(run.Namespace).Get(context.TODO(), ownerRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		rollout = strategytemplate.ResolvedRollout(rollout, strategytemplate.NewClientGetter(context.TODO(), p.rolloutsClient))
+	}
+	var service string
+	if rollout.Spec.Strategy.Canary != nil {
+		service = rollout.Spec.Strategy.Canary.CanaryService
+	} else if rollout.Spec.Strategy.BlueGreen != nil {
+		service = rollout.Spec.Strategy.BlueGreen.PreviewService
+	}
+	if service == "" {
+		return "", fmt.Errorf("rollout '%s' has no canary or preview service to probe", rollout.Name)
+	}
+	return service, nil
+}
+
+func probeHTTP(ctx context.Context, address string, httpProbe *v1alpha1.HTTPProbe, requests int, timeout time.Duration) ([]probeResult, error) {
+	scheme := "http"
+	method := v1alpha1.WebMetricMethodGet
+	path := "/"
+	if httpProbe != nil {
+		if httpProbe.Scheme == "HTTPS" {
+			scheme = "https"
+		}
+		if httpProbe.Method != "" {
+			method = httpProbe.Method
+		}
+		if httpProbe.Path != "" {
+			path = httpProbe.Path
+		}
+	}
+	client := &http.Client{Timeout: timeout}
+	if httpProbe != nil && httpProbe.Insecure {
+		// keep the proxy, dial and idle connection settings of the default transport
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true
+		client.Transport = transport
+	}
+	defer client.CloseIdleConnections()
+
+	url := fmt.Sprintf("%s://%s%s", scheme, address, path)
+	results := make([]probeResult, 0, requests)
+	for i := 0; i < requests && ctx.Err() == nil; i++ {
+		request, err := http.NewRequestWithContext(ctx, string(method), url, nil)
+		if err != nil {
+			return nil, err
+		}
+		if httpProbe != nil {
+			for _, header := range httpProbe.Headers {
+				request.Header.Set(header.Key, header.Value)
+			}
+		}
+		start := time.Now()
+		response, err := client.Do(request)
+		latency := time.Since(start)
+		if err != nil {
+			results = append(results, probeResult{status: StatusError, latency: latency})
+			continue
+		}
+		response.Body.Close()
+		results = append(results, probeResult{
+			status:  strconv.Itoa(response.StatusCode),
+			success: isSuccessStatusCode(httpProbe, response.StatusCode),
+			latency: latency,
+		})
+	}
+	return results, nil
+}
+
+func isSuccessStatusCode(httpProbe *v1alpha1.HTTPProbe, statusCode int) bool {
+	if httpProbe == nil || len(httpProbe.SuccessStatusCodes) == 0 {
+		return statusCode >= 200 && statusCode < 400
+	}
+	return slices.Contains(httpProbe.SuccessStatusCodes, int32(statusCode))
+}
+
+func probeGRPC(ctx context.Context, address string, grpcProbe *v1alpha1.GRPCProbe, requests int, timeout time.Duration) ([]probeResult, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	results := make([]probeResult, 0, requests)
+	for i := 0; i < requests && ctx.Err() == nil; i++ {
+		requestCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		response, err := client.Check(requestCtx, &healthpb.HealthCheckRequest{Service: grpcProbe.Service})
+		latency := time.Since(start)
+		cancel()
+		if err != nil {
+			// the code of the failed call, e.g. Unavailable or DeadlineExceeded
+			results = append(results, probeResult{status: status.Code(err).String(), latency: latency})
+			continue
+		}
+		results = append(results, probeResult{
+			status:  response.Status.String(),
+			success: response.Status == healthpb.HealthCheckResponse_SERVING,
+			latency: latency,
+		})
+	}
+	return results, nil
+}
+
+// summarize returns the result the conditions of the metric are evaluated against
+func summarize(results []probeResult) map[string]any {
+	statusCodes := map[string]int{}
+	latencies := make([]float64, 0, len(results))
+	successes := 0
+	for _, result := range results {
+		statusCodes[result.status]++
+		latencies = append(latencies, float64(result.latency.Microseconds())/1000)
+		if result.success {
+			successes++
+		}
+	}
+	slices.Sort(latencies)
+	successRatio := 0.0
+	if len(results) > 0 {
+		successRatio = float64(successes) / float64(len(results))
+	}
+	return map[string]any{
+		"successRatio": successRatio,
+		"p50":          percentile(latencies, 50),
+		"p95":          percentile(latencies, 95),
+		"p99":          percentile(latencies, 99),
+		"statusCodes":  statusCodes,
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Resume should not be used the probe provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Probe provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the probe provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Probe provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the probe provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+// NewProbeProvider creates a new probe provider. The rolloutLister and strategyTemplateGetter may be nil when
+// the controller does not watch rollouts.
+func NewProbeProvider(logCtx log.Entry, rolloutsClient clientset.Interface, rolloutLister listers.RolloutLister, strategyTemplateGetter strategytemplate.Getter) *Provider {
+	return &Provider{
+		logCtx:                 logCtx,
+		rolloutsClient:         rolloutsClient,
+		rolloutLister:          rolloutLister,
+		strategyTemplateGetter: strategyTemplateGetter,
+		serviceAddress: func(namespace, service string, port int32) string {
+			return fmt.Sprintf("%s.%s.svc:%d", service, namespace, port)
+		},
+	}
+}
@@ -0,0 +1,296 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
+)
+
+func newAnalysisRun(ownerKind string) *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-abc123-2",
+			Namespace: metav1.NamespaceDefault,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       ownerKind,
+				Name:       "guestbook",
+				Controller: pointer.Bool(true),
+			}},
+		},
+	}
+}
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{CanaryService: "guestbook-canary"},
+			},
+		},
+	}
+}
+
+// newTestProvider returns a provider which sends the requests to the given address, and records the
+// services the requests were meant for
+func newTestProvider(address string, services *[]string, rollouts ...*v1alpha1.Rollout) *Provider {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, ro := range rollouts {
+		_ = indexer.Add(ro)
+	}
+	getter := strategytemplate.MapGetter{"RolloutStrategyTemplate/default/standard": {
+		Strategy: v1alpha1.RolloutStrategy{
+			Canary: &v1alpha1.CanaryStrategy{CanaryService: "standard-canary"},
+		},
+	}}
+	p := NewProbeProvider(*log.WithField("test", "probe"), fake.NewSimpleClientset(), listers.NewRolloutLister(indexer), getter)
+	p.serviceAddress = func(namespace, service string, port int32) string {
+		*services = append(*services, service)
+		return address
+	}
+	return p
+}
+
+func parseResult(t *testing.T, measurement v1alpha1.Measurement) map[string]any {
+	result := map[string]any{}
+	assert.NoError(t, json.Unmarshal([]byte(measurement.Value), &result))
+	return result
+}
+
+func TestRunHTTP(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/healthz", r.URL.Path)
+		assert.Equal(t, "probe", r.Header.Get("X-Source"))
+		// every fourth request fails
+		if requests%4 == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	var services []string
+	p := newTestProvider(strings.TrimPrefix(server.URL, "http://"), &services, newRollout())
+	metric := v1alpha1.Metric{
+		Name:             "health",
+		SuccessCondition: "result.successRatio >= 0.75 && result.p99 < 1000",
+		Provider: v1alpha1.MetricProvider{
+			Probe: &v1alpha1.ProbeMetric{
+				Port:     8080,
+				Requests: pointer.Int32(8),
+				HTTP: &v1alpha1.HTTPProbe{
+					Path:    "/healthz",
+					Headers: []v1alpha1.WebMetricHeader{{Key: "X-Source", Value: "probe"}},
+				},
+			},
+		},
+	}
+	measurement := p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.Equal(t, 8, requests)
+	assert.Equal(t, []string{"guestbook-canary"}, services)
+
+	result := parseResult(t, measurement)
+	assert.Equal(t, 0.75, result["successRatio"])
+	assert.Equal(t, map[string]any{"200": float64(6), "503": float64(2)}, result["statusCodes"])
+	assert.Contains(t, result, "p50")
+	assert.Contains(t, result, "p95")
+
+	// the 503 responses are successful
+	metric.SuccessCondition = "result.successRatio == 1"
+	metric.Provider.Probe.HTTP.SuccessStatusCodes = []int32{200, 503}
+	measurement = p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	metric.Provider.Probe.HTTP.SuccessStatusCodes = nil
+	measurement = p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
+func TestRunHTTPUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	address := strings.TrimPrefix(server.URL, "http://")
+	server.Close()
+
+	var services []string
+	p := newTestProvider(address, &services)
+	metric := v1alpha1.Metric{
+		Name:             "health",
+		SuccessCondition: "result.successRatio >= 0.9",
+		Provider: v1alpha1.MetricProvider{
+			Probe: &v1alpha1.ProbeMetric{Service: "guestbook-preview", Port: 8080, Requests: pointer.Int32(2)},
+		},
+	}
+	// requests which get no response count as failed requests, not as a measurement error
+	measurement := p.Run(newAnalysisRun("Experiment"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, []string{"guestbook-preview"}, services)
+	result := parseResult(t, measurement)
+	assert.Equal(t, float64(0), result["successRatio"])
+	assert.Equal(t, map[string]any{StatusError: float64(2)}, result["statusCodes"])
+}
+
+func TestRunGRPC(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("guestbook", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("cart", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	var services []string
+	p := newTestProvider(listener.Addr().String(), &services, newRollout())
+	metric := v1alpha1.Metric{
+		Name:             "health",
+		SuccessCondition: "result.successRatio == 1",
+		Provider: v1alpha1.MetricProvider{
+			Probe: &v1alpha1.ProbeMetric{
+				Port:     9090,
+				Requests: pointer.Int32(3),
+				GRPC:     &v1alpha1.GRPCProbe{Service: "guestbook"},
+			},
+		},
+	}
+	measurement := p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, map[string]any{"SERVING": float64(3)}, parseResult(t, measurement)["statusCodes"])
+
+	metric.Provider.Probe.GRPC.Service = "cart"
+	measurement = p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, map[string]any{"NOT_SERVING": float64(3)}, parseResult(t, measurement)["statusCodes"])
+
+	metric.Provider.Probe.GRPC.Service = "unknown"
+	measurement = p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, map[string]any{"NotFound": float64(3)}, parseResult(t, measurement)["statusCodes"])
+}
+
+func TestResolveService(t *testing.T) {
+	var services []string
+	probe := &v1alpha1.ProbeMetric{Port: 8080}
+
+	p := newTestProvider("", &services)
+	_, err := p.resolveService(newAnalysisRun("Experiment"), probe)
+	assert.EqualError(t, err, "probe service must be specified when the analysis run is not owned by a rollout")
+	_, err = p.resolveService(newAnalysisRun("Rollout"), probe)
+	assert.EqualError(t, err, "rollout.argoproj.io \"guestbook\" not found")
+
+	ro := newRollout()
+	ro.Spec.Strategy.Canary = nil
+	ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{ActiveService: "guestbook-active", PreviewService: "guestbook-preview"}
+	p = newTestProvider("", &services, ro)
+	service, err := p.resolveService(newAnalysisRun("Rollout"), probe)
+	assert.NoError(t, err)
+	assert.Equal(t, "guestbook-preview", service)
+
+	ro.Spec.Strategy.BlueGreen.PreviewService = ""
+	p = newTestProvider("", &services, ro)
+	_, err = p.resolveService(newAnalysisRun("Rollout"), probe)
+	assert.EqualError(t, err, "rollout 'guestbook' has no canary or preview service to probe")
+
+	ro.Spec.Strategy = v1alpha1.RolloutStrategy{}
+	ro.Spec.StrategyRef = &v1alpha1.RolloutStrategyRef{Name: "standard"}
+	p = newTestProvider("", &services, ro)
+	service, err = p.resolveService(newAnalysisRun("Rollout"), probe)
+	assert.NoError(t, err)
+	assert.Equal(t, "standard-canary", service)
+
+	// without a lister, the rollout is read from the API server
+	p = NewProbeProvider(*log.WithField("test", "probe"), fake.NewSimpleClientset(newRollout()), nil, nil)
+	service, err = p.resolveService(newAnalysisRun("Rollout"), probe)
+	assert.NoError(t, err)
+	assert.Equal(t, "guestbook-canary", service)
+}
+
+func TestRunHTTPSInsecure(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	var services []string
+	p := newTestProvider(strings.TrimPrefix(server.URL, "https://"), &services, newRollout())
+	metric := v1alpha1.Metric{
+		Name:             "health",
+		SuccessCondition: "result.successRatio == 1",
+		Provider: v1alpha1.MetricProvider{
+			Probe: &v1alpha1.ProbeMetric{
+				Port:     8443,
+				Requests: pointer.Int32(2),
+				HTTP:     &v1alpha1.HTTPProbe{Scheme: "HTTPS"},
+			},
+		},
+	}
+	// the certificate of the test server is not trusted
+	measurement := p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+
+	metric.Provider.Probe.HTTP.Insecure = true
+	measurement = p.Run(newAnalysisRun("Rollout"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	if tlsConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig; tlsConfig != nil {
+		assert.False(t, tlsConfig.InsecureSkipVerify, "the default transport must not be modified")
+	}
+}
+
+func TestProbeHTTPStopsAtMeasurementDeadline(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := probeHTTP(ctx, strings.TrimPrefix(server.URL, "http://"), nil, 10, time.Second)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Equal(t, 0, requests)
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, float64(0), percentile(nil, 50))
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Equal(t, float64(5), percentile(values, 50))
+	assert.Equal(t, float64(10), percentile(values, 95))
+	assert.Equal(t, float64(10), percentile(values, 99))
+	assert.Equal(t, float64(1), percentile(values, 0))
+}
+
+func TestRunError(t *testing.T) {
+	var services []string
+	p := newTestProvider("", &services)
+	metric := v1alpha1.Metric{
+		Name:     "health",
+		Provider: v1alpha1.MetricProvider{Probe: &v1alpha1.ProbeMetric{Port: 8080}},
+	}
+	measurement := p.Run(newAnalysisRun("Experiment"), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "probe service must be specified when the analysis run is not owned by a rollout", measurement.Message)
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(metric))
+}
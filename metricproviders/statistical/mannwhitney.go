@@ -0,0 +1,108 @@
+package statistical
+
+import (
+	"math"
+	"sort"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// ComparisonResult is the outcome of comparing a canary series against a baseline series
+type ComparisonResult struct {
+	// U is the Mann-Whitney U statistic of the canary series
+	U float64
+	// PValue is the probability of observing the difference if both series had the same distribution
+	PValue float64
+	// Classification is one of ComparisonPass, ComparisonHigh or ComparisonLow
+	Classification string
+}
+
+// Compare runs a Mann-Whitney U test between the canary and baseline series. The canary is classified
+// as High or Low when it differs from the baseline in the given direction with a p-value lower than alpha.
+func Compare(canary, baseline []float64, direction v1alpha1.StatisticalDirection, alpha float64) ComparisonResult {
+	u, z := mannWhitneyU(canary, baseline)
+	result := ComparisonResult{U: u, Classification: ComparisonPass}
+
+	// One-sided p-values of the canary being stochastically greater or smaller than the baseline
+	pHigh := 0.5 * math.Erfc(z/math.Sqrt2)
+	pLow := 0.5 * math.Erfc(-z/math.Sqrt2)
+	switch direction {
+	case v1alpha1.StatisticalDirectionIncrease:
+		result.PValue = pHigh
+		if pHigh < alpha {
+			result.Classification = ComparisonHigh
+		}
+	case v1alpha1.StatisticalDirectionDecrease:
+		result.PValue = pLow
+		if pLow < alpha {
+			result.Classification = ComparisonLow
+		}
+	default:
+		result.PValue = math.Min(1, 2*math.Min(pHigh, pLow))
+		if result.PValue < alpha {
+			if z > 0 {
+				result.Classification = ComparisonHigh
+			} else {
+				result.Classification = ComparisonLow
+			}
+		}
+	}
+	return result
+}
+
+// mannWhitneyU returns the U statistic of x and its z-score under the normal approximation,
+// corrected for ties and continuity. A positive z-score means x tends to be greater than y.
+func mannWhitneyU(x, y []float64) (float64, float64) {
+	type sample struct {
+		value float64
+		fromX bool
+	}
+	n1, n2 := float64(len(x)), float64(len(y))
+	samples := make([]sample, 0, len(x)+len(y))
+	for _, v := range x {
+		samples = append(samples, sample{value: v, fromX: true})
+	}
+	for _, v := range y {
+		samples = append(samples, sample{value: v})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].value < samples[j].value
+	})
+
+	// Assign average ranks to tied values and accumulate the tie correction term
+	var rankSumX, tieCorrection float64
+	for i := 0; i < len(samples); {
+		j := i
+		for j < len(samples) && samples[j].value == samples[i].value {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if samples[k].fromX {
+				rankSumX += rank
+			}
+		}
+		t := float64(j - i)
+		tieCorrection += t*t*t - t
+		i = j
+	}
+
+	u := rankSumX - n1*(n1+1)/2
+	n := n1 + n2
+	mean := n1 * n2 / 2
+	variance := n1 * n2 / 12 * ((n + 1) - tieCorrection/(n*(n-1)))
+	if variance <= 0 {
+		// Every data point has the same value
+		return u, 0
+	}
+	diff := u - mean
+	switch {
+	case diff > 0.5:
+		diff -= 0.5
+	case diff < -0.5:
+		diff += 0.5
+	default:
+		diff = 0
+	}
+	return u, diff / math.Sqrt(variance)
+}
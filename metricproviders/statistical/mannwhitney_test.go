@@ -0,0 +1,50 @@
+package statistical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	u, z := mannWhitneyU([]float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.Equal(t, 0.0, u)
+	assert.InDelta(t, -1.7457, z, 0.0001)
+
+	u, z = mannWhitneyU([]float64{4, 5, 6}, []float64{1, 2, 3})
+	assert.Equal(t, 9.0, u)
+	assert.InDelta(t, 1.7457, z, 0.0001)
+
+	// ties are given the average rank
+	u, z = mannWhitneyU([]float64{1, 2, 2}, []float64{2, 3, 3})
+	assert.Equal(t, 1.0, u)
+	assert.InDelta(t, -1.4142, z, 0.0001)
+
+	// identical values have no variance
+	u, z = mannWhitneyU([]float64{1, 1, 1}, []float64{1, 1, 1})
+	assert.Equal(t, 4.5, u)
+	assert.Equal(t, 0.0, z)
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []float64{1, 2, 3}
+	canary := []float64{4, 5, 6}
+
+	result := Compare(canary, baseline, v1alpha1.StatisticalDirectionIncrease, 0.05)
+	assert.Equal(t, ComparisonHigh, result.Classification)
+	assert.InDelta(t, 0.0404, result.PValue, 0.0001)
+
+	result = Compare(canary, baseline, v1alpha1.StatisticalDirectionDecrease, 0.05)
+	assert.Equal(t, ComparisonPass, result.Classification)
+	assert.InDelta(t, 0.9596, result.PValue, 0.0001)
+
+	// the two-sided p-value is not significant for three data points per series
+	result = Compare(canary, baseline, v1alpha1.StatisticalDirectionEither, 0.05)
+	assert.Equal(t, ComparisonPass, result.Classification)
+	assert.InDelta(t, 0.0809, result.PValue, 0.0001)
+
+	result = Compare(baseline, canary, v1alpha1.StatisticalDirectionEither, 0.1)
+	assert.Equal(t, ComparisonLow, result.Classification)
+}
@@ -0,0 +1,218 @@
+package statistical
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is statistical
+	ProviderType = "Statistical"
+	// DefaultConfidenceLevel is the confidence level used when the metric does not specify one
+	DefaultConfidenceLevel = 95
+	// MinSamples is the minimum number of data points each series needs for the comparison to be meaningful
+	MinSamples = 3
+
+	// ComparisonPass indicates the canary series is not worse than the baseline series
+	ComparisonPass = "Pass"
+	// ComparisonHigh indicates the canary series is significantly higher than the baseline series
+	ComparisonHigh = "High"
+	// ComparisonLow indicates the canary series is significantly lower than the baseline series
+	ComparisonLow = "Low"
+)
+
+// ProviderFactoryFunc creates the provider used to query a baseline or canary series
+type ProviderFactoryFunc func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error)
+
+// Provider contains all the required components to run a statistical comparison
+type Provider struct {
+	logCtx      log.Entry
+	newProvider ProviderFactoryFunc
+}
+
+// Type indicates provider is a statistical provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run queries the baseline and canary series of every comparison and scores the canary
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+	stat := metric.Provider.Statistical
+
+	alpha := 1 - float64(DefaultConfidenceLevel)/100
+	if stat.ConfidenceLevel != nil {
+		alpha = 1 - float64(*stat.ConfidenceLevel)/100
+	}
+
+	metadata := map[string]string{}
+	var totalWeight, passedWeight int64
+	for _, comparison := range stat.Comparisons {
+		baseline, err := p.querySeries(run, metric, comparison.Name+"-baseline", comparison.Baseline)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, fmt.Errorf("comparison '%s' baseline: %w", comparison.Name, err))
+		}
+		canary, err := p.querySeries(run, metric, comparison.Name+"-canary", comparison.Canary)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, fmt.Errorf("comparison '%s' canary: %w", comparison.Name, err))
+		}
+		if len(baseline) < MinSamples || len(canary) < MinSamples {
+			err := fmt.Errorf("comparison '%s' requires at least %d data points per series, got %d baseline and %d canary", comparison.Name, MinSamples, len(baseline), len(canary))
+			return metricutil.MarkMeasurementError(newMeasurement, err)
+		}
+
+		result := Compare(canary, baseline, comparison.Direction, alpha)
+		weight := int64(1)
+		if comparison.Weight != nil {
+			weight = *comparison.Weight
+		}
+		totalWeight += weight
+		if result.Classification == ComparisonPass {
+			passedWeight += weight
+		}
+		metadata[comparison.Name+".result"] = result.Classification
+		metadata[comparison.Name+".pValue"] = strconv.FormatFloat(result.PValue, 'f', 4, 64)
+		metadata[comparison.Name+".u"] = strconv.FormatFloat(result.U, 'f', 1, 64)
+		p.logCtx.Infof("Comparison '%s': %s (U=%.1f, p=%.4f)", comparison.Name, result.Classification, result.U, result.PValue)
+	}
+	if totalWeight <= 0 {
+		return metricutil.MarkMeasurementError(newMeasurement, errors.New("total weight of the comparisons must be greater than 0"))
+	}
+
+	score := int(math.Round(float64(passedWeight) * 100 / float64(totalWeight)))
+	metadata["score"] = strconv.Itoa(score)
+	newMeasurement.Value = strconv.Itoa(score)
+	newMeasurement.Metadata = metadata
+	newMeasurement.Phase = evaluateResult(score, int(stat.Threshold.Pass), int(stat.Threshold.Marginal))
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+// querySeries runs the query using the provider configured for it and parses the series out of the measurement value
+func (p *Provider) querySeries(run *v1alpha1.AnalysisRun, parent v1alpha1.Metric, name string, query v1alpha1.StatisticalQuery) ([]float64, error) {
+	queryMetric := v1alpha1.Metric{
+		Name: parent.Name + "-" + name,
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: query.Prometheus,
+			Datadog:    query.Datadog,
+			Wavefront:  query.Wavefront,
+			NewRelic:   query.NewRelic,
+			Graphite:   query.Graphite,
+			Influxdb:   query.Influxdb,
+		},
+	}
+	provider, err := p.newProvider(*p.logCtx.WithField("query", name), queryMetric)
+	if err != nil {
+		return nil, err
+	}
+	measurement := provider.Run(run, queryMetric)
+	switch measurement.Phase {
+	case v1alpha1.AnalysisPhaseError:
+		return nil, errors.New(measurement.Message)
+	case v1alpha1.AnalysisPhaseRunning, v1alpha1.AnalysisPhasePending:
+		return nil, fmt.Errorf("%s provider did not complete synchronously", provider.Type())
+	}
+	return parseSeries(measurement.Value)
+}
+
+// parseSeries extracts all the numeric data points out of the value of a measurement. Providers
+// report series either as a list of numbers (e.g. `[1.2,3.4]`) or as JSON documents
+func parseSeries(value string) ([]float64, error) {
+	value = strings.TrimSpace(value)
+	var doc any
+	if err := json.Unmarshal([]byte(value), &doc); err == nil {
+		return collectNumbers(doc, []float64{}), nil
+	}
+	series := []float64{}
+	for _, s := range strings.Split(strings.Trim(value, "[]"), ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse '%s' as a series of numbers", value)
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			continue
+		}
+		series = append(series, f)
+	}
+	return series, nil
+}
+
+func collectNumbers(doc any, series []float64) []float64 {
+	switch v := doc.(type) {
+	case float64:
+		series = append(series, v)
+	case []any:
+		for _, item := range v {
+			series = collectNumbers(item, series)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			series = collectNumbers(v[k], series)
+		}
+	}
+	return series
+}
+
+func evaluateResult(score int, pass int, marginal int) v1alpha1.AnalysisPhase {
+	if score >= pass {
+		return v1alpha1.AnalysisPhaseSuccessful
+	} else if score >= marginal {
+		return v1alpha1.AnalysisPhaseInconclusive
+	}
+	return v1alpha1.AnalysisPhaseFailed
+}
+
+// Resume should not be used the statistical provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("statistical provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the statistical provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("statistical provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the statistical provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+// NewStatisticalProvider creates a new statistical provider which queries the series using the providers created by newProvider
+func NewStatisticalProvider(logCtx log.Entry, newProvider ProviderFactoryFunc) *Provider {
+	return &Provider{
+		logCtx:      logCtx,
+		newProvider: newProvider,
+	}
+}
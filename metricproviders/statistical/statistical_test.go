@@ -0,0 +1,235 @@
+package statistical
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+)
+
+// fakeProvider returns the measurement configured for the query of the metric it runs
+type fakeProvider struct {
+	measurements map[string]v1alpha1.Measurement
+}
+
+func (f *fakeProvider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	return f.measurements[metric.Provider.Prometheus.Query]
+}
+
+func (f *fakeProvider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	return measurement
+}
+
+func (f *fakeProvider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	return measurement
+}
+
+func (f *fakeProvider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+func (f *fakeProvider) Type() string {
+	return "Fake"
+}
+
+func (f *fakeProvider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+func newProvider(values map[string]string) *Provider {
+	fake := &fakeProvider{measurements: map[string]v1alpha1.Measurement{}}
+	for query, value := range values {
+		fake.measurements[query] = v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: value}
+	}
+	e := log.NewEntry(log.New())
+	return NewStatisticalProvider(*e, func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error) {
+		return fake, nil
+	})
+}
+
+func comparison(name string, direction v1alpha1.StatisticalDirection) v1alpha1.StatisticalComparison {
+	return v1alpha1.StatisticalComparison{
+		Name:      name,
+		Baseline:  v1alpha1.StatisticalQuery{Prometheus: &v1alpha1.PrometheusMetric{Query: name + "-baseline"}},
+		Canary:    v1alpha1.StatisticalQuery{Prometheus: &v1alpha1.PrometheusMetric{Query: name + "-canary"}},
+		Direction: direction,
+	}
+}
+
+func newMetric(comparisons ...v1alpha1.StatisticalComparison) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name: "judgement",
+		Provider: v1alpha1.MetricProvider{
+			Statistical: &v1alpha1.StatisticalMetric{
+				Comparisons: comparisons,
+				Threshold: v1alpha1.KayentaThreshold{
+					Pass:     90,
+					Marginal: 50,
+				},
+			},
+		},
+	}
+}
+
+func TestType(t *testing.T) {
+	p := newProvider(nil)
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newMetric()))
+}
+
+func TestRunSuccessful(t *testing.T) {
+	p := newProvider(map[string]string{
+		"latency-baseline": "[10,11,12,10,11,12,10,11]",
+		"latency-canary":   "[10,12,11,11,10,12,11,10]",
+	})
+	m := newMetric(comparison("latency", v1alpha1.StatisticalDirectionIncrease))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, m)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "100", measurement.Value)
+	assert.Equal(t, "Pass", measurement.Metadata["latency.result"])
+	assert.Equal(t, "100", measurement.Metadata["score"])
+	assert.NotNil(t, measurement.StartedAt)
+	assert.NotNil(t, measurement.FinishedAt)
+}
+
+func TestRunScoresWeightedComparisons(t *testing.T) {
+	p := newProvider(map[string]string{
+		"latency-baseline": "[10,11,12,10,11,12,10,11]",
+		"latency-canary":   "[20,21,22,20,21,22,20,21]",
+		"errors-baseline":  "[1,2,1,2,1,2]",
+		"errors-canary":    "[1,2,1,2,1,2]",
+	})
+	latency := comparison("latency", v1alpha1.StatisticalDirectionIncrease)
+	latency.Weight = pointer.Int64(1)
+	errorsComparison := comparison("errors", v1alpha1.StatisticalDirectionIncrease)
+	errorsComparison.Weight = pointer.Int64(3)
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(latency, errorsComparison))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, measurement.Phase)
+	assert.Equal(t, "75", measurement.Value)
+	assert.Equal(t, "High", measurement.Metadata["latency.result"])
+	assert.Equal(t, "Pass", measurement.Metadata["errors.result"])
+}
+
+func TestRunDirection(t *testing.T) {
+	values := map[string]string{
+		"throughput-baseline": "[100,101,102,100,101,102]",
+		"throughput-canary":   "[50,51,52,50,51,52]",
+	}
+	// A decrease does not fail a comparison which only cares about increases
+	measurement := newProvider(values).Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("throughput", v1alpha1.StatisticalDirectionIncrease)))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	measurement = newProvider(values).Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("throughput", v1alpha1.StatisticalDirectionDecrease)))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "0", measurement.Value)
+	assert.Equal(t, "Low", measurement.Metadata["throughput.result"])
+
+	measurement = newProvider(values).Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("throughput", "")))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
+func TestRunConfidenceLevel(t *testing.T) {
+	values := map[string]string{
+		"latency-baseline": "[1,2,3]",
+		"latency-canary":   "[4,5,6]",
+	}
+	m := newMetric(comparison("latency", v1alpha1.StatisticalDirectionIncrease))
+	measurement := newProvider(values).Run(&v1alpha1.AnalysisRun{}, m)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+
+	// p-value of ~0.04 is not significant when requiring 99% confidence
+	m.Provider.Statistical.ConfidenceLevel = pointer.Int64(99)
+	measurement = newProvider(values).Run(&v1alpha1.AnalysisRun{}, m)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Run("NotEnoughDataPoints", func(t *testing.T) {
+		p := newProvider(map[string]string{
+			"latency-baseline": "[1,2]",
+			"latency-canary":   "[1,2,3]",
+		})
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("latency", "")))
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Equal(t, "comparison 'latency' requires at least 3 data points per series, got 2 baseline and 3 canary", measurement.Message)
+	})
+	t.Run("QueryError", func(t *testing.T) {
+		p := newProvider(nil)
+		fake := &fakeProvider{measurements: map[string]v1alpha1.Measurement{
+			"latency-baseline": metricutil.MarkMeasurementError(v1alpha1.Measurement{}, errors.New("query failed")),
+		}}
+		p.newProvider = func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error) {
+			return fake, nil
+		}
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("latency", "")))
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Equal(t, "comparison 'latency' baseline: query failed", measurement.Message)
+	})
+	t.Run("ProviderError", func(t *testing.T) {
+		p := newProvider(nil)
+		p.newProvider = func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error) {
+			return nil, errors.New("no valid provider")
+		}
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("latency", "")))
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Equal(t, "comparison 'latency' baseline: no valid provider", measurement.Message)
+	})
+	t.Run("AsyncProvider", func(t *testing.T) {
+		p := newProvider(nil)
+		fake := &fakeProvider{measurements: map[string]v1alpha1.Measurement{
+			"latency-baseline": {Phase: v1alpha1.AnalysisPhaseRunning},
+		}}
+		p.newProvider = func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error) {
+			return fake, nil
+		}
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(comparison("latency", "")))
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Equal(t, "comparison 'latency' baseline: Fake provider did not complete synchronously", measurement.Message)
+	})
+	t.Run("ZeroWeight", func(t *testing.T) {
+		p := newProvider(map[string]string{
+			"latency-baseline": "[1,2,3]",
+			"latency-canary":   "[1,2,3]",
+		})
+		c := comparison("latency", "")
+		c.Weight = pointer.Int64(0)
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric(c))
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	})
+}
+
+func TestParseSeries(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []float64
+	}{
+		{"[1,2.5,3]", []float64{1, 2.5, 3}},
+		{"[1,NaN,3]", []float64{1, 3}},
+		{"4.2", []float64{4.2}},
+		{"[]", []float64{}},
+		{`[{"count":2,"avg":1.5},{"count":3,"avg":2.5}]`, []float64{1.5, 2, 2.5, 3}},
+	}
+	for _, test := range tests {
+		series, err := parseSeries(test.value)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, series, test.value)
+	}
+	_, err := parseSeries("[1,foo]")
+	assert.EqualError(t, err, "could not parse '[1,foo]' as a series of numbers")
+}
+
+func TestResumeTerminateGarbageCollect(t *testing.T) {
+	p := newProvider(nil)
+	m := newMetric()
+	measurement := v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseRunning}
+	assert.Equal(t, measurement, p.Resume(&v1alpha1.AnalysisRun{}, m, measurement))
+	assert.Equal(t, measurement, p.Terminate(&v1alpha1.AnalysisRun{}, m, measurement))
+	assert.NoError(t, p.GarbageCollect(&v1alpha1.AnalysisRun{}, m, 0))
+}
@@ -0,0 +1,244 @@
+package multicluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	multiclusterutil "github.com/argoproj/argo-rollouts/utils/multicluster"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which the rollouts in the member clusters are polled
+	DefaultPollInterval = 10 * time.Second
+	// RequestTimeout bounds the time spent reading a rollout from a single member cluster, so that an
+	// unreachable member cluster does not stall the polling of the other ones
+	RequestTimeout = 5 * time.Second
+)
+
+// ControllerConfig describes the data required to instantiate a new multi-cluster controller
+type ControllerConfig struct {
+	KubeClientSet    kubernetes.Interface
+	RolloutsInformer informers.RolloutInformer
+	RolloutWorkQueue workqueue.RateLimitingInterface
+	PollInterval     time.Duration
+	ResyncPeriod     time.Duration
+}
+
+// memberCluster is a member cluster along with the kubeconfig its client was built from
+type memberCluster struct {
+	kubeConfig []byte
+	client     clientset.Interface
+}
+
+// Controller polls the state of the rollouts of the member clusters and queues the local rollouts
+// when the state of their counterparts in the member clusters changes. The member cluster secrets
+// are only read from the namespace of the controller, so that tenants creating secrets in their own
+// namespaces cannot add or override member clusters.
+type Controller struct {
+	secretInformerFactory kubeinformers.SharedInformerFactory
+	secretsLister         corelisters.SecretLister
+	secretsSynced         cache.InformerSynced
+	rolloutsLister        listers.RolloutLister
+	pollInterval          time.Duration
+	requestTimeout        time.Duration
+
+	mutex    sync.RWMutex
+	clusters map[string]*memberCluster
+	statuses map[string][]v1alpha1.MemberClusterStatus
+
+	// used for unit testing
+	enqueueRollout func(obj any)
+	newClientset   func(config *rest.Config) (clientset.Interface, error)
+}
+
+// NewController returns a new multi-cluster controller
+func NewController(cfg ControllerConfig) *Controller {
+	pollInterval := cfg.PollInterval
+	if pollInterval == 0 {
+		pollInterval = DefaultPollInterval
+	}
+	secretInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
+		cfg.KubeClientSet,
+		cfg.ResyncPeriod,
+		kubeinformers.WithNamespace(defaults.Namespace()),
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = multiclusterutil.MemberClusterSecretSelector().String()
+		}),
+	)
+	secretInformer := secretInformerFactory.Core().V1().Secrets()
+	controller := &Controller{
+		secretInformerFactory: secretInformerFactory,
+		secretsLister:         secretInformer.Lister(),
+		secretsSynced:         secretInformer.Informer().HasSynced,
+		rolloutsLister:        cfg.RolloutsInformer.Lister(),
+		pollInterval:          pollInterval,
+		requestTimeout:        RequestTimeout,
+		clusters:              map[string]*memberCluster{},
+		statuses:              map[string][]v1alpha1.MemberClusterStatus{},
+		newClientset: func(config *rest.Config) (clientset.Interface, error) {
+			return clientset.NewForConfig(config)
+		},
+	}
+	controller.enqueueRollout = func(obj any) {
+		controllerutil.EnqueueRateLimited(obj, cfg.RolloutWorkQueue)
+	}
+	return controller
+}
+
+// Run polls the member clusters until the context is done
+func (c *Controller) Run(ctx context.Context) error {
+	log.Info("Starting multi-cluster poller")
+	c.secretInformerFactory.Start(ctx.Done())
+	if ok := cache.WaitForCacheSync(ctx.Done(), c.secretsSynced); !ok {
+		return fmt.Errorf("failed to wait for member cluster secret caches to sync")
+	}
+	wait.UntilWithContext(ctx, c.syncMemberClusters, c.pollInterval)
+	log.Info("Multi-cluster poller has stopped")
+	return nil
+}
+
+// MemberClusterStatuses returns the last observed state of the rollout in the member clusters
+func (c *Controller) MemberClusterStatuses(namespace, name string) []v1alpha1.MemberClusterStatus {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	statuses := c.statuses[namespace+"/"+name]
+	if statuses == nil {
+		return nil
+	}
+	return append([]v1alpha1.MemberClusterStatus{}, statuses...)
+}
+
+// syncMemberClusters refreshes the state of the member clusters of every multi-cluster rollout
+// and queues the rollouts whose member clusters changed
+func (c *Controller) syncMemberClusters(ctx context.Context) {
+	rollouts, err := c.rolloutsLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("Failed to list rollouts: %v", err)
+		return
+	}
+	var multiClusterRollouts []*v1alpha1.Rollout
+	for _, ro := range rollouts {
+		if ro.Spec.MultiCluster != nil {
+			multiClusterRollouts = append(multiClusterRollouts, ro)
+		}
+	}
+
+	statuses := map[string][]v1alpha1.MemberClusterStatus{}
+	if len(multiClusterRollouts) > 0 {
+		if err := c.refreshClusters(); err != nil {
+			log.Errorf("Failed to read member cluster secrets: %v", err)
+			return
+		}
+	}
+	for _, ro := range multiClusterRollouts {
+		key := ro.Namespace + "/" + ro.Name
+		statuses[key] = c.getMemberClusterStatuses(ctx, ro)
+	}
+
+	c.mutex.Lock()
+	previous := c.statuses
+	c.statuses = statuses
+	c.mutex.Unlock()
+
+	for _, ro := range multiClusterRollouts {
+		key := ro.Namespace + "/" + ro.Name
+		if !reflect.DeepEqual(previous[key], statuses[key]) {
+			logutil.WithRollout(ro).Info("Member clusters changed")
+			c.enqueueRollout(ro)
+		}
+	}
+}
+
+// refreshClusters builds the clients of the member clusters out of the member cluster secrets.
+// Clients are only rebuilt when the kubeconfig of the cluster changes.
+func (c *Controller) refreshClusters() error {
+	secrets, err := c.secretsLister.Secrets(defaults.Namespace()).List(multiclusterutil.MemberClusterSecretSelector())
+	if err != nil {
+		return err
+	}
+	kubeConfigs := multiclusterutil.GetMemberClusterKubeConfigs(secrets)
+	clusters := map[string]*memberCluster{}
+	for name, kubeConfig := range kubeConfigs {
+		if existing, ok := c.clusters[name]; ok && bytes.Equal(existing.kubeConfig, kubeConfig) {
+			clusters[name] = existing
+			continue
+		}
+		config, err := multiclusterutil.NewRestConfig(kubeConfig)
+		if err != nil {
+			log.Errorf("Error building kubeconfig for member cluster %s: %v", name, err)
+			continue
+		}
+		client, err := c.newClientset(config)
+		if err != nil {
+			log.Errorf("Error building client for member cluster %s: %v", name, err)
+			continue
+		}
+		clusters[name] = &memberCluster{kubeConfig: kubeConfig, client: client}
+	}
+	c.clusters = clusters
+	return nil
+}
+
+func (c *Controller) getMemberClusterStatuses(ctx context.Context, ro *v1alpha1.Rollout) []v1alpha1.MemberClusterStatus {
+	members := multiclusterutil.GetMemberClusters(ro.Spec.MultiCluster, defaults.GetClusterName())
+	var wg sync.WaitGroup
+	for i := range members {
+		member := &members[i]
+		cluster, ok := c.clusters[member.Cluster]
+		if !ok {
+			member.Message = fmt.Sprintf("No member cluster secret for cluster '%s'", member.Cluster)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.getMemberClusterStatus(ctx, cluster, ro, member)
+		}()
+	}
+	wg.Wait()
+	return members
+}
+
+// getMemberClusterStatus fills in the status of the member from the rollout in its member cluster
+func (c *Controller) getMemberClusterStatus(ctx context.Context, cluster *memberCluster, ro *v1alpha1.Rollout, member *v1alpha1.MemberClusterStatus) {
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+	remote, err := cluster.client.ArgoprojV1alpha1().Rollouts(ro.Namespace).Get(ctx, ro.Name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			member.Message = "Rollout not found"
+		} else {
+			member.Message = err.Error()
+		}
+		return
+	}
+	phase, message := rolloututil.GetRolloutPhase(remote)
+	member.Phase = phase
+	member.Message = message
+	member.CurrentPodHash = remote.Status.CurrentPodHash
+	member.Aborted = remote.Status.Abort
+}
@@ -0,0 +1,195 @@
+package multicluster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	multiclusterutil "github.com/argoproj/argo-rollouts/utils/multicluster"
+)
+
+func newKubeConfig(server string) []byte {
+	return []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: member
+  cluster:
+    server: ` + server + `
+contexts:
+- name: member
+  context:
+    cluster: member
+    user: member
+current-context: member
+users:
+- name: member
+  user:
+    token: abc
+`)
+}
+
+func newRollout(name string, podHash string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			MultiCluster: &v1alpha1.MultiClusterPromotion{
+				Waves: []v1alpha1.ClusterWave{
+					{Name: "staging", Clusters: []string{"staging-1", "staging-2"}},
+					{Name: "prod", Clusters: []string{"prod-1"}},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentPodHash: podHash,
+			StableRS:       podHash,
+		},
+	}
+}
+
+func newController(t *testing.T, local []*v1alpha1.Rollout, remote map[string]*v1alpha1.Rollout) (*Controller, *[]*v1alpha1.Rollout) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "member-clusters",
+			Namespace: defaults.Namespace(),
+			Labels:    map[string]string{multiclusterutil.MemberClusterSecretLabel: "true"},
+		},
+		Data: map[string][]byte{},
+	}
+	// the server of every kubeconfig is the name of the cluster, which selects the fake clientset
+	remoteClients := map[string]clientset.Interface{}
+	for cluster, ro := range remote {
+		secret.Data[cluster] = newKubeConfig("https://" + cluster)
+		remoteClients["https://"+cluster] = fake.NewSimpleClientset(ro)
+	}
+	kubeclient := k8sfake.NewSimpleClientset()
+
+	client := fake.NewSimpleClientset()
+	i := informers.NewSharedInformerFactory(client, 0)
+	for _, ro := range local {
+		assert.NoError(t, i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(ro))
+	}
+
+	c := NewController(ControllerConfig{
+		KubeClientSet:    kubeclient,
+		RolloutsInformer: i.Argoproj().V1alpha1().Rollouts(),
+		RolloutWorkQueue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	})
+	assert.NoError(t, c.secretInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(secret))
+	// secrets of other namespaces are never read, even if the informer were to hold them
+	tenantSecret := secret.DeepCopy()
+	tenantSecret.Namespace = metav1.NamespaceDefault
+	tenantSecret.Data = map[string][]byte{"staging-2": newKubeConfig("https://staging-2")}
+	assert.NoError(t, c.secretInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(tenantSecret))
+	c.newClientset = func(config *rest.Config) (clientset.Interface, error) {
+		return remoteClients[config.Host], nil
+	}
+	enqueued := []*v1alpha1.Rollout{}
+	c.enqueueRollout = func(obj any) {
+		enqueued = append(enqueued, obj.(*v1alpha1.Rollout))
+	}
+	return c, &enqueued
+}
+
+func TestSyncMemberClusters(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+
+	local := newRollout("guestbook", "abc")
+	plain := newRollout("plain", "abc")
+	plain.Spec.MultiCluster = nil
+	staging1 := newRollout("guestbook", "def")
+	staging1.Status.StableRS = "abc"
+	staging1.Status.Abort = true
+	c, enqueued := newController(t, []*v1alpha1.Rollout{local, plain}, map[string]*v1alpha1.Rollout{
+		"staging-1": staging1,
+		"prod-1":    newRollout("other", "abc"),
+	})
+
+	c.syncMemberClusters(context.TODO())
+
+	statuses := c.MemberClusterStatuses(metav1.NamespaceDefault, "guestbook")
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "staging-1", statuses[0].Cluster)
+	assert.Equal(t, "staging", statuses[0].Wave)
+	assert.Equal(t, "def", statuses[0].CurrentPodHash)
+	assert.True(t, statuses[0].Aborted)
+	assert.NotEmpty(t, statuses[0].Phase)
+	assert.Equal(t, v1alpha1.MemberClusterStatus{
+		Cluster: "staging-2",
+		Wave:    "staging",
+		Message: "No member cluster secret for cluster 'staging-2'",
+	}, statuses[1])
+	assert.Nil(t, c.MemberClusterStatuses(metav1.NamespaceDefault, "plain"))
+	assert.Equal(t, []*v1alpha1.Rollout{local}, *enqueued)
+
+	// nothing changed in the member clusters, the rollout is not queued again
+	c.syncMemberClusters(context.TODO())
+	assert.Len(t, *enqueued, 1)
+}
+
+func TestSyncMemberClustersRolloutNotFound(t *testing.T) {
+	defaults.SetClusterName("staging-2")
+	defer defaults.SetClusterName("")
+
+	c, _ := newController(t, []*v1alpha1.Rollout{newRollout("guestbook", "abc")}, map[string]*v1alpha1.Rollout{
+		"prod-1": newRollout("other", "abc"),
+	})
+	c.syncMemberClusters(context.TODO())
+	firstClient := c.clusters["prod-1"].client
+
+	statuses := c.MemberClusterStatuses(metav1.NamespaceDefault, "guestbook")
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "prod-1", statuses[1].Cluster)
+	assert.Equal(t, "Rollout not found", statuses[1].Message)
+
+	// the client of the member cluster is reused as long as its kubeconfig does not change
+	c.syncMemberClusters(context.TODO())
+	assert.Same(t, firstClient, c.clusters["prod-1"].client)
+}
+
+func TestSyncMemberClustersUnreachableCluster(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+
+	// the member cluster never answers, the request is only ended by the request timeout
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c, _ := newController(t, []*v1alpha1.Rollout{newRollout("guestbook", "abc")}, map[string]*v1alpha1.Rollout{
+		"staging-1": newRollout("guestbook", "def"),
+	})
+	unreachable, err := multiclusterutil.NewRestConfig(newKubeConfig(server.URL))
+	assert.NoError(t, err)
+	unreachableClient, err := clientset.NewForConfig(unreachable)
+	assert.NoError(t, err)
+	c.requestTimeout = 100 * time.Millisecond
+
+	c.syncMemberClusters(context.TODO())
+	c.clusters["staging-2"] = &memberCluster{client: unreachableClient}
+	statuses := c.getMemberClusterStatuses(context.TODO(), newRollout("guestbook", "abc"))
+
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "def", statuses[0].CurrentPodHash)
+	assert.Equal(t, "staging-2", statuses[1].Cluster)
+	assert.Empty(t, statuses[1].Phase)
+	assert.NotEmpty(t, statuses[1].Message)
+}
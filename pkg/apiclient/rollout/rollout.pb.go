@@ -333,6 +333,7 @@ type PromoteRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Full                 bool     `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	IgnoreWindow         bool     `protobuf:"varint,4,opt,name=ignoreWindow,proto3" json:"ignoreWindow,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -392,6 +393,13 @@ func (m *PromoteRolloutRequest) GetFull() bool {
 	return false
 }
 
+func (m *PromoteRolloutRequest) GetIgnoreWindow() bool {
+	if m != nil {
+		return m.IgnoreWindow
+	}
+	return false
+}
+
 type AbortRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -707,30 +715,32 @@ func (m *VersionInfo) GetRolloutsVersion() string {
 }
 
 type RolloutInfo struct {
-	ObjectMeta           *v1.ObjectMeta         `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Status               string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message              string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Icon                 string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Strategy             string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
-	Step                 string                 `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
-	SetWeight            string                 `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
-	ActualWeight         string                 `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
-	Ready                int32                  `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
-	Current              int32                  `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
-	Desired              int32                  `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
-	Updated              int32                  `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
-	Available            int32                  `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
-	RestartedAt          string                 `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
-	Generation           string                 `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
-	ReplicaSets          []*ReplicaSetInfo      `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
-	Experiments          []*ExperimentInfo      `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
-	AnalysisRuns         []*AnalysisRunInfo     `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
-	Containers           []*ContainerInfo       `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
-	Steps                []*v1alpha1.CanaryStep `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
-	InitContainers       []*ContainerInfo       `protobuf:"bytes,21,rep,name=initContainers,proto3" json:"initContainers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	ObjectMeta           *v1.ObjectMeta                      `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Status               string                              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                              `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Icon                 string                              `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Strategy             string                              `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Step                 string                              `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	SetWeight            string                              `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
+	ActualWeight         string                              `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
+	Ready                int32                               `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
+	Current              int32                               `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	Desired              int32                               `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
+	Updated              int32                               `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
+	Available            int32                               `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
+	RestartedAt          string                              `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
+	Generation           string                              `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
+	ReplicaSets          []*ReplicaSetInfo                   `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
+	Experiments          []*ExperimentInfo                   `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	AnalysisRuns         []*AnalysisRunInfo                  `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
+	Containers           []*ContainerInfo                    `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
+	Steps                []*v1alpha1.CanaryStep              `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
+	InitContainers       []*ContainerInfo                    `protobuf:"bytes,21,rep,name=initContainers,proto3" json:"initContainers,omitempty"`
+	MultiCluster         *v1alpha1.MultiClusterStatus        `protobuf:"bytes,22,opt,name=multiCluster,proto3" json:"multiCluster,omitempty"`
+	Dependencies         []*v1alpha1.RolloutDependencyStatus `protobuf:"bytes,23,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
 func (m *RolloutInfo) Reset()         { *m = RolloutInfo{} }
@@ -913,6 +923,20 @@ func (m *RolloutInfo) GetInitContainers() []*ContainerInfo {
 	return nil
 }
 
+func (m *RolloutInfo) GetMultiCluster() *v1alpha1.MultiClusterStatus {
+	if m != nil {
+		return m.MultiCluster
+	}
+	return nil
+}
+
+func (m *RolloutInfo) GetDependencies() []*v1alpha1.RolloutDependencyStatus {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
 type ExperimentInfo struct {
 	ObjectMeta           *v1.ObjectMeta     `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
 	Icon                 string             `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
@@ -1739,1059 +1763,1381 @@ func (m *Metrics) GetFailureLimit() int32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*RolloutInfoQuery)(nil), "rollout.RolloutInfoQuery")
-	proto.RegisterType((*RolloutInfoListQuery)(nil), "rollout.RolloutInfoListQuery")
-	proto.RegisterType((*SetImageRequest)(nil), "rollout.SetImageRequest")
-	proto.RegisterType((*UndoRolloutRequest)(nil), "rollout.UndoRolloutRequest")
-	proto.RegisterType((*RestartRolloutRequest)(nil), "rollout.RestartRolloutRequest")
-	proto.RegisterType((*PromoteRolloutRequest)(nil), "rollout.PromoteRolloutRequest")
-	proto.RegisterType((*AbortRolloutRequest)(nil), "rollout.AbortRolloutRequest")
-	proto.RegisterType((*RetryRolloutRequest)(nil), "rollout.RetryRolloutRequest")
-	proto.RegisterType((*RolloutWatchEvent)(nil), "rollout.RolloutWatchEvent")
-	proto.RegisterType((*NamespaceInfo)(nil), "rollout.NamespaceInfo")
-	proto.RegisterType((*RolloutInfoList)(nil), "rollout.RolloutInfoList")
-	proto.RegisterType((*VersionInfo)(nil), "rollout.VersionInfo")
-	proto.RegisterType((*RolloutInfo)(nil), "rollout.RolloutInfo")
-	proto.RegisterType((*ExperimentInfo)(nil), "rollout.ExperimentInfo")
-	proto.RegisterType((*ReplicaSetInfo)(nil), "rollout.ReplicaSetInfo")
-	proto.RegisterType((*PodInfo)(nil), "rollout.PodInfo")
-	proto.RegisterType((*ContainerInfo)(nil), "rollout.ContainerInfo")
-	proto.RegisterType((*JobInfo)(nil), "rollout.JobInfo")
-	proto.RegisterType((*AnalysisRunSpecAndStatus)(nil), "rollout.AnalysisRunSpecAndStatus")
-	proto.RegisterType((*AnalysisRunInfo)(nil), "rollout.AnalysisRunInfo")
-	proto.RegisterType((*NonJobInfo)(nil), "rollout.NonJobInfo")
-	proto.RegisterType((*Metrics)(nil), "rollout.Metrics")
+type RolloutHistoryQuery struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("pkg/apiclient/rollout/rollout.proto", fileDescriptor_99101d942e8912a7)
+func (m *RolloutHistoryQuery) Reset()         { *m = RolloutHistoryQuery{} }
+func (m *RolloutHistoryQuery) String() string { return proto.CompactTextString(m) }
+func (*RolloutHistoryQuery) ProtoMessage()    {}
+func (*RolloutHistoryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{22}
 }
-
-var fileDescriptor_99101d942e8912a7 = []byte{
-	// 1848 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5f, 0x6f, 0x1c, 0x49,
-	0x11, 0xd7, 0x78, 0xbd, 0xf6, 0xba, 0xd6, 0x7f, 0xdb, 0x49, 0x6e, 0x6e, 0x2f, 0x58, 0xbe, 0x39,
-	0x24, 0x1c, 0x03, 0x33, 0x8e, 0x2f, 0xca, 0x71, 0xfc, 0x93, 0x8c, 0x63, 0xf9, 0x82, 0x92, 0xbb,
-	0x30, 0x06, 0x4e, 0x20, 0x41, 0xd4, 0x9e, 0x6d, 0xaf, 0x27, 0x99, 0x9d, 0x1e, 0xa6, 0x7b, 0x36,
-	0xac, 0x2c, 0x3f, 0xc0, 0x17, 0xe0, 0x81, 0xaf, 0xc0, 0x03, 0x3c, 0x21, 0x24, 0x5e, 0x78, 0xe0,
-	0x15, 0xf1, 0x88, 0xc4, 0x17, 0x40, 0x11, 0x02, 0xf1, 0xc0, 0x03, 0xdf, 0x00, 0x75, 0x4d, 0x4f,
-	0xcf, 0x1f, 0xaf, 0x1d, 0x47, 0x36, 0xe4, 0x9e, 0x76, 0xaa, 0xaa, 0xab, 0xea, 0xd7, 0xdd, 0x55,
-	0xd5, 0xdd, 0xb5, 0xf0, 0x5e, 0xf2, 0x7c, 0xe0, 0xd1, 0x24, 0x0c, 0xa2, 0x90, 0xc5, 0xd2, 0x4b,
-	0x79, 0x14, 0xf1, 0xcc, 0xfc, 0xba, 0x49, 0xca, 0x25, 0x27, 0xb3, 0x9a, 0xec, 0xdd, 0x1e, 0x70,
-	0x3e, 0x88, 0x98, 0x52, 0xf0, 0x68, 0x1c, 0x73, 0x49, 0x65, 0xc8, 0x63, 0x91, 0x0f, 0xeb, 0x3d,
-	0x1a, 0x84, 0xf2, 0x38, 0x3b, 0x74, 0x03, 0x3e, 0xf4, 0x68, 0x3a, 0xe0, 0x49, 0xca, 0x9f, 0xe1,
-	0xc7, 0x97, 0xb5, 0xbe, 0xf0, 0xb4, 0x37, 0xe1, 0x19, 0xce, 0xe8, 0x2e, 0x8d, 0x92, 0x63, 0x7a,
-	0xd7, 0x1b, 0xb0, 0x98, 0xa5, 0x54, 0xb2, 0xbe, 0xb6, 0x76, 0xef, 0xf9, 0x57, 0x84, 0x1b, 0x72,
-	0x35, 0x7c, 0x48, 0x83, 0xe3, 0x30, 0x66, 0xe9, 0xb8, 0xd4, 0x1f, 0x32, 0x49, 0xbd, 0xd1, 0x59,
-	0xad, 0x77, 0x34, 0x42, 0xa4, 0x0e, 0xb3, 0x23, 0x8f, 0x0d, 0x13, 0x39, 0xce, 0x85, 0xce, 0x03,
-	0x58, 0xf6, 0x73, 0xbf, 0x0f, 0xe3, 0x23, 0xfe, 0x9d, 0x8c, 0xa5, 0x63, 0x42, 0x60, 0x3a, 0xa6,
-	0x43, 0x66, 0x5b, 0xeb, 0xd6, 0xc6, 0x9c, 0x8f, 0xdf, 0xe4, 0x36, 0xcc, 0xa9, 0x5f, 0x91, 0xd0,
-	0x80, 0xd9, 0x53, 0x28, 0x28, 0x19, 0xce, 0x3d, 0xb8, 0x51, 0xb1, 0xf2, 0x28, 0x14, 0x32, 0xb7,
-	0x54, 0xd3, 0xb2, 0x9a, 0x5a, 0xbf, 0xb0, 0x60, 0xe9, 0x80, 0xc9, 0x87, 0x43, 0x3a, 0x60, 0x3e,
-	0xfb, 0x49, 0xc6, 0x84, 0x24, 0x36, 0x14, 0x2b, 0xab, 0xc7, 0x17, 0xa4, 0xb2, 0x15, 0xf0, 0x58,
-	0x52, 0x35, 0xeb, 0x02, 0x81, 0x61, 0x90, 0x1b, 0xd0, 0x0e, 0x95, 0x1d, 0xbb, 0x85, 0x92, 0x9c,
-	0x20, 0xcb, 0xd0, 0x92, 0x74, 0x60, 0x4f, 0x23, 0x4f, 0x7d, 0xd6, 0x11, 0xb5, 0x9b, 0x88, 0x8e,
-	0x81, 0x7c, 0x2f, 0xee, 0x73, 0x3d, 0x97, 0x57, 0x63, 0xea, 0x41, 0x27, 0x65, 0xa3, 0x50, 0x84,
-	0x3c, 0x46, 0x48, 0x2d, 0xdf, 0xd0, 0x75, 0x4f, 0xad, 0xa6, 0xa7, 0x87, 0x70, 0xd3, 0x67, 0x42,
-	0xd2, 0x54, 0x36, 0x9c, 0xbd, 0xfe, 0xe2, 0xff, 0x08, 0x6e, 0x3e, 0x49, 0xf9, 0x90, 0x4b, 0x76,
-	0x55, 0x53, 0x4a, 0xe3, 0x28, 0x8b, 0x22, 0x84, 0xdb, 0xf1, 0xf1, 0xdb, 0xd9, 0x87, 0xd5, 0x9d,
-	0x43, 0x7e, 0x0d, 0x38, 0xf7, 0x61, 0xd5, 0x67, 0x32, 0x1d, 0x5f, 0xd9, 0xd0, 0x53, 0x58, 0xd1,
-	0x36, 0x3e, 0xa5, 0x32, 0x38, 0xde, 0x1b, 0xb1, 0x18, 0xcd, 0xc8, 0x71, 0x62, 0xcc, 0xa8, 0x6f,
-	0x72, 0x1f, 0xba, 0x69, 0x19, 0x96, 0x68, 0xa8, 0xbb, 0x7d, 0xc3, 0x2d, 0x32, 0xb9, 0x12, 0xb2,
-	0x7e, 0x75, 0xa0, 0xf3, 0x14, 0x16, 0x3e, 0x2e, 0xbc, 0x29, 0xc6, 0xc5, 0x71, 0x4c, 0xb6, 0x60,
-	0x95, 0x8e, 0x68, 0x18, 0xd1, 0xc3, 0x88, 0x19, 0x3d, 0x61, 0x4f, 0xad, 0xb7, 0x36, 0xe6, 0xfc,
-	0x49, 0x22, 0x67, 0x17, 0x96, 0x1a, 0xf9, 0x42, 0xb6, 0xa0, 0x53, 0x14, 0x00, 0xdb, 0x5a, 0x6f,
-	0x9d, 0x0b, 0xd4, 0x8c, 0x72, 0x3e, 0x80, 0xee, 0xf7, 0x59, 0xaa, 0x62, 0x0d, 0x31, 0x6e, 0xc0,
-	0x52, 0x21, 0xd2, 0x6c, 0x8d, 0xb4, 0xc9, 0x76, 0xfe, 0x39, 0x03, 0xdd, 0x8a, 0x49, 0xf2, 0x04,
-	0x80, 0x1f, 0x3e, 0x63, 0x81, 0x7c, 0xcc, 0x24, 0x45, 0xa5, 0xee, 0xf6, 0x96, 0x9b, 0xd7, 0x1a,
-	0xb7, 0x5a, 0x6b, 0xdc, 0xe4, 0xf9, 0x40, 0x31, 0x84, 0xab, 0x6a, 0x8d, 0x3b, 0xba, 0xeb, 0x7e,
-	0x62, 0xf4, 0xfc, 0x8a, 0x0d, 0x72, 0x0b, 0x66, 0x84, 0xa4, 0x32, 0x13, 0x7a, 0xf3, 0x34, 0xa5,
-	0x32, 0x69, 0xc8, 0x84, 0x28, 0xf3, 0xb4, 0x20, 0xd5, 0xf6, 0x85, 0x01, 0x8f, 0x75, 0xaa, 0xe2,
-	0xb7, 0xca, 0x2e, 0x21, 0x55, 0x25, 0x1b, 0x8c, 0x75, 0xaa, 0x1a, 0x5a, 0x8d, 0x17, 0x92, 0x25,
-	0xf6, 0x4c, 0x3e, 0x5e, 0x7d, 0xab, 0x5d, 0x12, 0x4c, 0x7e, 0xca, 0xc2, 0xc1, 0xb1, 0xb4, 0x67,
-	0xf3, 0x5d, 0x32, 0x0c, 0xe2, 0xc0, 0x3c, 0x0d, 0x64, 0x46, 0x23, 0x3d, 0xa0, 0x83, 0x03, 0x6a,
-	0x3c, 0x55, 0x45, 0x52, 0x46, 0xfb, 0x63, 0x7b, 0x6e, 0xdd, 0xda, 0x68, 0xfb, 0x39, 0xa1, 0x50,
-	0x07, 0x59, 0x9a, 0xb2, 0x58, 0xda, 0x80, 0xfc, 0x82, 0x54, 0x92, 0x3e, 0x13, 0x61, 0xca, 0xfa,
-	0x76, 0x37, 0x97, 0x68, 0x52, 0x49, 0xb2, 0xa4, 0xaf, 0xaa, 0xb0, 0x3d, 0x9f, 0x4b, 0x34, 0xa9,
-	0x50, 0x9a, 0x90, 0xb0, 0x17, 0x50, 0x56, 0x32, 0xc8, 0x3a, 0x74, 0xd3, 0xbc, 0x2e, 0xb0, 0xfe,
-	0x8e, 0xb4, 0x17, 0x11, 0x64, 0x95, 0x45, 0xd6, 0x00, 0x74, 0x85, 0x57, 0x5b, 0xbc, 0x84, 0x03,
-	0x2a, 0x1c, 0xf2, 0xa1, 0xb2, 0x90, 0x44, 0x61, 0x40, 0x0f, 0x98, 0x14, 0xf6, 0x32, 0xc6, 0xd2,
-	0x5b, 0x65, 0x2c, 0x19, 0x99, 0x8e, 0xfb, 0x72, 0xac, 0x52, 0x65, 0x3f, 0x4d, 0x58, 0x1a, 0x0e,
-	0x59, 0x2c, 0x85, 0xbd, 0xd2, 0x50, 0xdd, 0x33, 0xb2, 0x5c, 0xb5, 0x32, 0x96, 0x7c, 0x1d, 0xe6,
-	0x69, 0x4c, 0xa3, 0xb1, 0x08, 0x85, 0x9f, 0xc5, 0xc2, 0x26, 0xa8, 0x6b, 0x1b, 0xdd, 0x9d, 0x52,
-	0x88, 0xca, 0xb5, 0xd1, 0xe4, 0x3e, 0x80, 0x29, 0xe5, 0xc2, 0x5e, 0x45, 0xdd, 0x5b, 0x46, 0x77,
-	0xb7, 0x10, 0xa1, 0x66, 0x65, 0x24, 0xf9, 0x31, 0xb4, 0xd5, 0xce, 0x0b, 0xfb, 0x06, 0xaa, 0x7c,
-	0xe4, 0x96, 0xc7, 0xad, 0x5b, 0x1c, 0xb7, 0xf8, 0xf1, 0xb4, 0xc8, 0x81, 0x32, 0x84, 0x0d, 0xa7,
-	0x38, 0x6e, 0xdd, 0x5d, 0x1a, 0xd3, 0x74, 0x7c, 0x20, 0x59, 0xe2, 0xe7, 0x66, 0xc9, 0x37, 0x61,
-	0x31, 0x8c, 0x43, 0xb9, 0x5b, 0x62, 0xbb, 0x79, 0x21, 0xb6, 0xc6, 0x68, 0xe7, 0x8f, 0x53, 0xb0,
-	0x58, 0x5f, 0xb5, 0xff, 0x41, 0xb2, 0x15, 0xa9, 0x33, 0x55, 0x4f, 0x1d, 0x73, 0x30, 0xb5, 0x1a,
-	0x07, 0x53, 0x99, 0x9c, 0xd3, 0xe7, 0x25, 0x67, 0xbb, 0x9e, 0x9c, 0x8d, 0x90, 0x9a, 0x79, 0x8d,
-	0x90, 0x6a, 0xc6, 0xc5, 0xec, 0xeb, 0xc4, 0x85, 0xf3, 0xeb, 0x69, 0x58, 0xac, 0x5b, 0xff, 0x3f,
-	0x16, 0xab, 0x62, 0x5d, 0x5b, 0xe7, 0xac, 0xeb, 0xf4, 0xc4, 0x75, 0x55, 0x59, 0xdd, 0xc6, 0xe3,
-	0x53, 0x53, 0x8a, 0x1f, 0x60, 0x64, 0x61, 0xb1, 0xea, 0xf8, 0x9a, 0x52, 0x7c, 0x1a, 0xc8, 0x70,
-	0xc4, 0xb0, 0x56, 0x75, 0x7c, 0x4d, 0xa9, 0x7d, 0x48, 0x94, 0x51, 0xf6, 0x02, 0x6b, 0x54, 0xc7,
-	0x2f, 0xc8, 0xdc, 0x3b, 0xae, 0x86, 0xd0, 0x15, 0xca, 0xd0, 0xf5, 0xb2, 0x02, 0xcd, 0xb2, 0xd2,
-	0x83, 0x8e, 0x64, 0xc3, 0x24, 0xa2, 0x92, 0x61, 0xa5, 0x9a, 0xf3, 0x0d, 0x4d, 0xbe, 0x04, 0x2b,
-	0x22, 0xa0, 0x11, 0x7b, 0xc0, 0x5f, 0xc4, 0x0f, 0x18, 0xed, 0x47, 0x61, 0xcc, 0xb0, 0x68, 0xcd,
-	0xf9, 0x67, 0x05, 0x0a, 0x35, 0xde, 0xad, 0x84, 0xbd, 0x80, 0xe7, 0x9b, 0xa6, 0xc8, 0xe7, 0x61,
-	0x3a, 0xe1, 0x7d, 0x61, 0x2f, 0xe2, 0x06, 0x2f, 0x9b, 0x0d, 0x7e, 0xc2, 0xfb, 0xb8, 0xb1, 0x28,
-	0x55, 0x6b, 0x9a, 0x84, 0xf1, 0x00, 0xcb, 0x56, 0xc7, 0xc7, 0x6f, 0xe4, 0xf1, 0x78, 0x60, 0x2f,
-	0x6b, 0x1e, 0x8f, 0x07, 0xea, 0x48, 0xad, 0xa5, 0xd2, 0xc3, 0xdc, 0xe5, 0x4a, 0x7e, 0xa4, 0x4e,
-	0x10, 0x39, 0x7f, 0xb0, 0x60, 0x56, 0xfb, 0x7a, 0xc3, 0x31, 0x62, 0x0e, 0x91, 0x3c, 0xbd, 0xf4,
-	0x21, 0x82, 0x7b, 0x87, 0x55, 0x5c, 0x60, 0x7c, 0xe0, 0xde, 0xe5, 0xb4, 0xf3, 0x21, 0x2c, 0xd4,
-	0xea, 0xc8, 0xc4, 0x3b, 0x91, 0xb9, 0xe1, 0x4e, 0x55, 0x6e, 0xb8, 0xce, 0x7f, 0x2c, 0x98, 0xfd,
-	0x36, 0x3f, 0xfc, 0x0c, 0x4c, 0x7b, 0x0d, 0x60, 0xc8, 0x64, 0x1a, 0x06, 0xea, 0x9e, 0xa3, 0xe7,
-	0x5e, 0xe1, 0x90, 0x8f, 0x60, 0xae, 0x3c, 0xd7, 0xda, 0x08, 0x6e, 0xf3, 0x72, 0xe0, 0xbe, 0x1b,
-	0x0e, 0x99, 0x5f, 0x2a, 0x3b, 0xff, 0xb0, 0xc0, 0xae, 0xd4, 0x8d, 0x83, 0x84, 0x05, 0x3b, 0x71,
-	0xff, 0x20, 0x87, 0x46, 0x61, 0x5a, 0x24, 0x2c, 0xd0, 0xd3, 0x7f, 0x7c, 0xb5, 0x13, 0xa1, 0xe1,
-	0xc5, 0x47, 0xd3, 0x64, 0x50, 0x5b, 0x95, 0xee, 0xf6, 0x27, 0xd7, 0xe7, 0x04, 0xcd, 0x16, 0xcb,
-	0xec, 0xfc, 0xbb, 0x05, 0x4b, 0x8d, 0x02, 0xf9, 0x19, 0x3e, 0x3f, 0xd6, 0x00, 0x44, 0x16, 0x04,
-	0x4c, 0x88, 0xa3, 0x2c, 0xd2, 0x31, 0x5e, 0xe1, 0x28, 0xbd, 0x23, 0x1a, 0x46, 0xac, 0x8f, 0x75,
-	0xb0, 0xed, 0x6b, 0x4a, 0x5d, 0xcc, 0xc2, 0x38, 0xe0, 0x71, 0x10, 0x65, 0xa2, 0xa8, 0x86, 0x6d,
-	0xbf, 0xc6, 0x53, 0xc1, 0xcf, 0xd2, 0x94, 0xa7, 0x58, 0x11, 0xdb, 0x7e, 0x4e, 0xa8, 0x9a, 0xf3,
-	0x8c, 0x1f, 0xaa, 0x5a, 0x58, 0xaf, 0x39, 0x3a, 0x21, 0x7c, 0x94, 0x92, 0xf7, 0x01, 0x62, 0x1e,
-	0x6b, 0x9e, 0x0d, 0x38, 0x76, 0xd5, 0x8c, 0xfd, 0xd8, 0x88, 0xfc, 0xca, 0x30, 0xb2, 0xa9, 0x0e,
-	0x43, 0x15, 0xbb, 0xc2, 0xee, 0x36, 0xac, 0x3f, 0xce, 0xf9, 0x7e, 0x31, 0x80, 0xec, 0xc3, 0x82,
-	0xa8, 0xc6, 0x20, 0x16, 0xcf, 0xee, 0xf6, 0xbb, 0x93, 0x0e, 0xb9, 0x5a, 0xb0, 0xfa, 0x75, 0x3d,
-	0xe7, 0x57, 0x16, 0x40, 0x89, 0x47, 0x4d, 0x7a, 0x44, 0xa3, 0xac, 0x28, 0x03, 0x39, 0x71, 0x6e,
-	0x4e, 0xd6, 0xf3, 0xaf, 0x75, 0x71, 0xfe, 0x4d, 0x5f, 0x25, 0xff, 0x7e, 0x67, 0xc1, 0xac, 0x5e,
-	0x84, 0x89, 0x95, 0x6a, 0x13, 0x96, 0xf5, 0xb6, 0xef, 0xf2, 0xb8, 0x1f, 0xca, 0xd0, 0x04, 0xd7,
-	0x19, 0xbe, 0x9a, 0x63, 0xc0, 0xb3, 0x58, 0x22, 0xe0, 0xb6, 0x9f, 0x13, 0xea, 0x48, 0xaa, 0x6e,
-	0xff, 0xa3, 0x70, 0x18, 0xe6, 0x98, 0xdb, 0xfe, 0x59, 0x81, 0x0a, 0x20, 0x15, 0x4a, 0x59, 0xaa,
-	0x07, 0xe6, 0xa1, 0x57, 0xe3, 0x6d, 0xff, 0x6b, 0x01, 0x16, 0xf5, 0x9b, 0xe7, 0x80, 0xa5, 0xa3,
-	0x30, 0x60, 0x44, 0xc0, 0xe2, 0x3e, 0x93, 0xd5, 0x87, 0xd0, 0xdb, 0x93, 0x5e, 0x5c, 0xd8, 0xc9,
-	0xe8, 0x4d, 0x7c, 0x8c, 0x39, 0x5b, 0x3f, 0xff, 0xeb, 0xdf, 0x7f, 0x39, 0xb5, 0x49, 0x36, 0xb0,
-	0xfd, 0x33, 0xba, 0x5b, 0xf6, 0x70, 0x4e, 0xcc, 0xf3, 0xf0, 0x34, 0xff, 0x3e, 0xf5, 0x42, 0xe5,
-	0xe2, 0x14, 0x96, 0xf1, 0xd1, 0x7a, 0x25, 0xb7, 0xf7, 0xd1, 0xed, 0x16, 0x71, 0x2f, 0xeb, 0xd6,
-	0x7b, 0xa1, 0x7c, 0x6e, 0x59, 0x64, 0x04, 0xcb, 0xea, 0xb5, 0x59, 0x31, 0x26, 0xc8, 0xe7, 0x26,
-	0xf9, 0x30, 0x3d, 0x9c, 0x9e, 0x7d, 0x9e, 0xd8, 0xb9, 0x83, 0x30, 0xde, 0x23, 0xef, 0x5e, 0x08,
-	0x03, 0xa7, 0xfd, 0x33, 0x0b, 0x56, 0x9a, 0xf3, 0x7e, 0xa5, 0xe7, 0x5e, 0x53, 0x5c, 0x3e, 0xf7,
-	0x1d, 0x0f, 0x7d, 0xdf, 0x21, 0x5f, 0x78, 0xa5, 0x6f, 0x33, 0xf7, 0x1f, 0xc0, 0xfc, 0x3e, 0x93,
-	0xe6, 0x15, 0x4e, 0x6e, 0xb9, 0x79, 0x63, 0xcc, 0x2d, 0x1a, 0x63, 0xee, 0xde, 0x30, 0x91, 0xe3,
-	0x5e, 0x79, 0xb9, 0xaf, 0x35, 0x01, 0x9c, 0xb7, 0xd1, 0xe5, 0x2a, 0x59, 0x29, 0x5c, 0x96, 0x1d,
-	0x80, 0xdf, 0x5a, 0xea, 0x9e, 0x5a, 0x6d, 0xe7, 0x90, 0xb5, 0xca, 0xf5, 0x78, 0x42, 0x9f, 0xa7,
-	0xb7, 0x77, 0xb5, 0x43, 0x43, 0x5b, 0x2b, 0x42, 0xa1, 0xf7, 0xc5, 0xcb, 0x84, 0x82, 0xbe, 0x70,
-	0x7c, 0xd5, 0xda, 0x44, 0xc4, 0xf5, 0xae, 0x51, 0x05, 0xf1, 0xc4, 0x76, 0xd2, 0x1b, 0x41, 0x9c,
-	0xe4, 0x48, 0x14, 0xe2, 0xdf, 0x58, 0x30, 0x5f, 0x6d, 0x44, 0x91, 0xdb, 0x65, 0x7d, 0x3d, 0xdb,
-	0x9f, 0xba, 0x2e, 0xb4, 0xf7, 0x10, 0xad, 0xdb, 0xbb, 0x73, 0x19, 0xb4, 0x54, 0xe1, 0x50, 0x58,
-	0xff, 0x94, 0x77, 0x36, 0x8b, 0xa8, 0xc6, 0x5e, 0x64, 0x99, 0x47, 0x8d, 0x9e, 0xe7, 0x75, 0x41,
-	0xf5, 0x11, 0xea, 0xa3, 0xde, 0xfe, 0xc5, 0x50, 0x35, 0xf7, 0xd4, 0x13, 0x4c, 0x7a, 0x27, 0xe6,
-	0x31, 0x7d, 0xea, 0x9d, 0xe0, 0x8d, 0xf2, 0x1b, 0x9b, 0x9b, 0xa7, 0xde, 0x89, 0xa4, 0x83, 0x53,
-	0x35, 0x91, 0xdf, 0x5b, 0xd0, 0xad, 0x74, 0x44, 0xc9, 0x3b, 0x66, 0x12, 0x67, 0xfb, 0xa4, 0xd7,
-	0x35, 0x8f, 0x1d, 0x9c, 0xc7, 0xd7, 0x7a, 0xf7, 0x2f, 0x39, 0x8f, 0x2c, 0xee, 0x73, 0xef, 0xa4,
-	0xb8, 0x9e, 0x9c, 0x16, 0xb1, 0x52, 0xed, 0x35, 0x56, 0x62, 0x65, 0x42, 0x0b, 0xf2, 0x8d, 0xc4,
-	0x4a, 0xaa, 0x70, 0x28, 0xac, 0x4f, 0x60, 0x56, 0x37, 0xe6, 0xce, 0xad, 0x48, 0xe5, 0x29, 0x50,
-	0x69, 0xf8, 0x39, 0x6f, 0xa1, 0xbb, 0x15, 0xb2, 0x54, 0xb8, 0x1b, 0xe5, 0xc2, 0x6f, 0xed, 0xfd,
-	0xf9, 0xe5, 0x9a, 0xf5, 0x97, 0x97, 0x6b, 0xd6, 0xdf, 0x5e, 0xae, 0x59, 0x3f, 0xfc, 0xe0, 0xd2,
-	0x7f, 0x41, 0xd4, 0xff, 0xf0, 0x38, 0x9c, 0x41, 0x14, 0xef, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0x69, 0x4c, 0x07, 0x10, 0x19, 0x00, 0x00,
+func (m *RolloutHistoryQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutHistoryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutHistoryQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RolloutHistoryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHistoryQuery.Merge(m, src)
+}
+func (m *RolloutHistoryQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHistoryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHistoryQuery.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RolloutHistoryQuery proto.InternalMessageInfo
 
-// RolloutServiceClient is the client API for RolloutService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RolloutServiceClient interface {
-	GetRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (*RolloutInfo, error)
-	WatchRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfoClient, error)
-	ListRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (*RolloutInfoList, error)
-	WatchRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfosClient, error)
-	GetNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NamespaceInfo, error)
-	RestartRollout(ctx context.Context, in *RestartRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	PromoteRollout(ctx context.Context, in *PromoteRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	SetRolloutImage(ctx context.Context, in *SetImageRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	UndoRollout(ctx context.Context, in *UndoRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	RetryRollout(ctx context.Context, in *RetryRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
-	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error)
+func (m *RolloutHistoryQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type rolloutServiceClient struct {
-	cc *grpc.ClientConn
+func (m *RolloutHistoryQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-func NewRolloutServiceClient(cc *grpc.ClientConn) RolloutServiceClient {
-	return &rolloutServiceClient{cc}
+type RevisionDiffQuery struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	FromRevision         int64    `protobuf:"varint,3,opt,name=fromRevision,proto3" json:"fromRevision,omitempty"`
+	ToRevision           int64    `protobuf:"varint,4,opt,name=toRevision,proto3" json:"toRevision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *rolloutServiceClient) GetRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (*RolloutInfo, error) {
-	out := new(RolloutInfo)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetRolloutInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionDiffQuery) Reset()         { *m = RevisionDiffQuery{} }
+func (m *RevisionDiffQuery) String() string { return proto.CompactTextString(m) }
+func (*RevisionDiffQuery) ProtoMessage()    {}
+func (*RevisionDiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{23}
+}
+func (m *RevisionDiffQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionDiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionDiffQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RevisionDiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionDiffQuery.Merge(m, src)
+}
+func (m *RevisionDiffQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionDiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionDiffQuery.DiscardUnknown(m)
 }
 
-func (c *rolloutServiceClient) WatchRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RolloutService_serviceDesc.Streams[0], "/rollout.RolloutService/WatchRolloutInfo", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &rolloutServiceWatchRolloutInfoClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_RevisionDiffQuery proto.InternalMessageInfo
+
+func (m *RevisionDiffQuery) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return x, nil
+	return ""
 }
 
-type RolloutService_WatchRolloutInfoClient interface {
-	Recv() (*RolloutInfo, error)
-	grpc.ClientStream
+func (m *RevisionDiffQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-type rolloutServiceWatchRolloutInfoClient struct {
-	grpc.ClientStream
+func (m *RevisionDiffQuery) GetFromRevision() int64 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
 }
 
-func (x *rolloutServiceWatchRolloutInfoClient) Recv() (*RolloutInfo, error) {
-	m := new(RolloutInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RevisionDiffQuery) GetToRevision() int64 {
+	if m != nil {
+		return m.ToRevision
 	}
-	return m, nil
+	return 0
 }
 
-func (c *rolloutServiceClient) ListRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (*RolloutInfoList, error) {
-	out := new(RolloutInfoList)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/ListRolloutInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RolloutHistory struct {
+	Revisions            []*RevisionInfo `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (c *rolloutServiceClient) WatchRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfosClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RolloutService_serviceDesc.Streams[1], "/rollout.RolloutService/WatchRolloutInfos", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &rolloutServiceWatchRolloutInfosClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *RolloutHistory) Reset()         { *m = RolloutHistory{} }
+func (m *RolloutHistory) String() string { return proto.CompactTextString(m) }
+func (*RolloutHistory) ProtoMessage()    {}
+func (*RolloutHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{24}
+}
+func (m *RolloutHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+}
+func (m *RolloutHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHistory.Merge(m, src)
+}
+func (m *RolloutHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHistory proto.InternalMessageInfo
+
+func (m *RolloutHistory) GetRevisions() []*RevisionInfo {
+	if m != nil {
+		return m.Revisions
 	}
-	return x, nil
+	return nil
 }
 
-type RolloutService_WatchRolloutInfosClient interface {
-	Recv() (*RolloutWatchEvent, error)
-	grpc.ClientStream
+type RevisionInfo struct {
+	Revision             int64              `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	PodTemplateHash      string             `protobuf:"bytes,2,opt,name=podTemplateHash,proto3" json:"podTemplateHash,omitempty"`
+	Images               []string           `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	CreatedAt            *v1.Time           `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Status               string             `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Step                 string             `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	AnalysisRuns         []*AnalysisRunInfo `protobuf:"bytes,7,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
+	ChangeCause          string             `protobuf:"bytes,8,opt,name=changeCause,proto3" json:"changeCause,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-type rolloutServiceWatchRolloutInfosClient struct {
-	grpc.ClientStream
+func (m *RevisionInfo) Reset()         { *m = RevisionInfo{} }
+func (m *RevisionInfo) String() string { return proto.CompactTextString(m) }
+func (*RevisionInfo) ProtoMessage()    {}
+func (*RevisionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{25}
+}
+func (m *RevisionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevisionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionInfo.Merge(m, src)
+}
+func (m *RevisionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionInfo.DiscardUnknown(m)
 }
 
-func (x *rolloutServiceWatchRolloutInfosClient) Recv() (*RolloutWatchEvent, error) {
-	m := new(RolloutWatchEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_RevisionInfo proto.InternalMessageInfo
+
+func (m *RevisionInfo) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return m, nil
+	return 0
 }
 
-func (c *rolloutServiceClient) GetNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NamespaceInfo, error) {
-	out := new(NamespaceInfo)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetPodTemplateHash() string {
+	if m != nil {
+		return m.PodTemplateHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *rolloutServiceClient) RestartRollout(ctx context.Context, in *RestartRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/RestartRollout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetImages() []string {
+	if m != nil {
+		return m.Images
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rolloutServiceClient) PromoteRollout(ctx context.Context, in *PromoteRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/PromoteRollout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetCreatedAt() *v1.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rolloutServiceClient) AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/AbortRollout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-func (c *rolloutServiceClient) SetRolloutImage(ctx context.Context, in *SetImageRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/SetRolloutImage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetStep() string {
+	if m != nil {
+		return m.Step
 	}
-	return out, nil
+	return ""
 }
 
-func (c *rolloutServiceClient) UndoRollout(ctx context.Context, in *UndoRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/UndoRollout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetAnalysisRuns() []*AnalysisRunInfo {
+	if m != nil {
+		return m.AnalysisRuns
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rolloutServiceClient) RetryRollout(ctx context.Context, in *RetryRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
-	out := new(v1alpha1.Rollout)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/RetryRollout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevisionInfo) GetChangeCause() string {
+	if m != nil {
+		return m.ChangeCause
 	}
-	return out, nil
+	return ""
 }
 
-func (c *rolloutServiceClient) Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error) {
-	out := new(VersionInfo)
-	err := c.cc.Invoke(ctx, "/rollout.RolloutService/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RevisionDiff struct {
+	FromRevision         int64    `protobuf:"varint,1,opt,name=fromRevision,proto3" json:"fromRevision,omitempty"`
+	ToRevision           int64    `protobuf:"varint,2,opt,name=toRevision,proto3" json:"toRevision,omitempty"`
+	Patch                string   `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// RolloutServiceServer is the server API for RolloutService service.
-type RolloutServiceServer interface {
-	GetRolloutInfo(context.Context, *RolloutInfoQuery) (*RolloutInfo, error)
-	WatchRolloutInfo(*RolloutInfoQuery, RolloutService_WatchRolloutInfoServer) error
-	ListRolloutInfos(context.Context, *RolloutInfoListQuery) (*RolloutInfoList, error)
-	WatchRolloutInfos(*RolloutInfoListQuery, RolloutService_WatchRolloutInfosServer) error
-	GetNamespace(context.Context, *emptypb.Empty) (*NamespaceInfo, error)
-	RestartRollout(context.Context, *RestartRolloutRequest) (*v1alpha1.Rollout, error)
-	PromoteRollout(context.Context, *PromoteRolloutRequest) (*v1alpha1.Rollout, error)
-	AbortRollout(context.Context, *AbortRolloutRequest) (*v1alpha1.Rollout, error)
-	SetRolloutImage(context.Context, *SetImageRequest) (*v1alpha1.Rollout, error)
-	UndoRollout(context.Context, *UndoRolloutRequest) (*v1alpha1.Rollout, error)
-	RetryRollout(context.Context, *RetryRolloutRequest) (*v1alpha1.Rollout, error)
-	Version(context.Context, *emptypb.Empty) (*VersionInfo, error)
+func (m *RevisionDiff) Reset()         { *m = RevisionDiff{} }
+func (m *RevisionDiff) String() string { return proto.CompactTextString(m) }
+func (*RevisionDiff) ProtoMessage()    {}
+func (*RevisionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{26}
 }
-
-// UnimplementedRolloutServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedRolloutServiceServer struct {
+func (m *RevisionDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedRolloutServiceServer) GetRolloutInfo(ctx context.Context, req *RolloutInfoQuery) (*RolloutInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRolloutInfo not implemented")
+func (m *RevisionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRolloutServiceServer) WatchRolloutInfo(req *RolloutInfoQuery, srv RolloutService_WatchRolloutInfoServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchRolloutInfo not implemented")
+func (m *RevisionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionDiff.Merge(m, src)
 }
-func (*UnimplementedRolloutServiceServer) ListRolloutInfos(ctx context.Context, req *RolloutInfoListQuery) (*RolloutInfoList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRolloutInfos not implemented")
+func (m *RevisionDiff) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedRolloutServiceServer) WatchRolloutInfos(req *RolloutInfoListQuery, srv RolloutService_WatchRolloutInfosServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchRolloutInfos not implemented")
+func (m *RevisionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionDiff.DiscardUnknown(m)
 }
-func (*UnimplementedRolloutServiceServer) GetNamespace(ctx context.Context, req *emptypb.Empty) (*NamespaceInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
+
+var xxx_messageInfo_RevisionDiff proto.InternalMessageInfo
+
+func (m *RevisionDiff) GetFromRevision() int64 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
 }
-func (*UnimplementedRolloutServiceServer) RestartRollout(ctx context.Context, req *RestartRolloutRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestartRollout not implemented")
+
+func (m *RevisionDiff) GetToRevision() int64 {
+	if m != nil {
+		return m.ToRevision
+	}
+	return 0
 }
-func (*UnimplementedRolloutServiceServer) PromoteRollout(ctx context.Context, req *PromoteRolloutRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+
+func (m *RevisionDiff) GetPatch() string {
+	if m != nil {
+		return m.Patch
+	}
+	return ""
 }
-func (*UnimplementedRolloutServiceServer) AbortRollout(ctx context.Context, req *AbortRolloutRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+
+type RolloutAuditQuery struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedRolloutServiceServer) SetRolloutImage(ctx context.Context, req *SetImageRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRolloutImage not implemented")
+
+func (m *RolloutAuditQuery) Reset()         { *m = RolloutAuditQuery{} }
+func (m *RolloutAuditQuery) String() string { return proto.CompactTextString(m) }
+func (*RolloutAuditQuery) ProtoMessage()    {}
+func (*RolloutAuditQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{27}
 }
-func (*UnimplementedRolloutServiceServer) UndoRollout(ctx context.Context, req *UndoRolloutRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoRollout not implemented")
+func (m *RolloutAuditQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRolloutServiceServer) RetryRollout(ctx context.Context, req *RetryRolloutRequest) (*v1alpha1.Rollout, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryRollout not implemented")
+func (m *RolloutAuditQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutAuditQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRolloutServiceServer) Version(ctx context.Context, req *emptypb.Empty) (*VersionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+func (m *RolloutAuditQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAuditQuery.Merge(m, src)
 }
-
-func RegisterRolloutServiceServer(s *grpc.Server, srv RolloutServiceServer) {
-	s.RegisterService(&_RolloutService_serviceDesc, srv)
+func (m *RolloutAuditQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAuditQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAuditQuery.DiscardUnknown(m)
 }
 
-func _RolloutService_GetRolloutInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RolloutInfoQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).GetRolloutInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/GetRolloutInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).GetRolloutInfo(ctx, req.(*RolloutInfoQuery))
+var xxx_messageInfo_RolloutAuditQuery proto.InternalMessageInfo
+
+func (m *RolloutAuditQuery) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RolloutService_WatchRolloutInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RolloutInfoQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *RolloutAuditQuery) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return srv.(RolloutServiceServer).WatchRolloutInfo(m, &rolloutServiceWatchRolloutInfoServer{stream})
+	return ""
 }
 
-type RolloutService_WatchRolloutInfoServer interface {
-	Send(*RolloutInfo) error
-	grpc.ServerStream
+type RolloutAudit struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-type rolloutServiceWatchRolloutInfoServer struct {
-	grpc.ServerStream
+func (m *RolloutAudit) Reset()         { *m = RolloutAudit{} }
+func (m *RolloutAudit) String() string { return proto.CompactTextString(m) }
+func (*RolloutAudit) ProtoMessage()    {}
+func (*RolloutAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{28}
 }
-
-func (x *rolloutServiceWatchRolloutInfoServer) Send(m *RolloutInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *RolloutAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _RolloutService_ListRolloutInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RolloutInfoListQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).ListRolloutInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/ListRolloutInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).ListRolloutInfos(ctx, req.(*RolloutInfoListQuery))
+func (m *RolloutAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RolloutAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RolloutAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAudit.Merge(m, src)
+}
+func (m *RolloutAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAudit.DiscardUnknown(m)
 }
 
-func _RolloutService_WatchRolloutInfos_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RolloutInfoListQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_RolloutAudit proto.InternalMessageInfo
+
+func (m *RolloutAudit) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return srv.(RolloutServiceServer).WatchRolloutInfos(m, &rolloutServiceWatchRolloutInfosServer{stream})
+	return nil
 }
 
-type RolloutService_WatchRolloutInfosServer interface {
-	Send(*RolloutWatchEvent) error
-	grpc.ServerStream
+type AuditEntry struct {
+	Time                 *v1.Time `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Actor                string   `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Revision             string   `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Step                 string   `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	Message              string   `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type rolloutServiceWatchRolloutInfosServer struct {
-	grpc.ServerStream
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{29}
 }
-
-func (x *rolloutServiceWatchRolloutInfosServer) Send(m *RolloutWatchEvent) error {
-	return x.ServerStream.SendMsg(m)
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _RolloutService_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).GetNamespace(ctx, in)
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/GetNamespace",
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetTime() *v1.Time {
+	if m != nil {
+		return m.Time
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).GetNamespace(ctx, req.(*emptypb.Empty))
+	return nil
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RolloutService_RestartRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartRolloutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AuditEntry) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).RestartRollout(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/RestartRollout",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).RestartRollout(ctx, req.(*RestartRolloutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RolloutService_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PromoteRolloutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).PromoteRollout(ctx, in)
+	return ""
+}
+
+func (m *AuditEntry) GetRevision() string {
+	if m != nil {
+		return m.Revision
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/PromoteRollout",
+	return ""
+}
+
+func (m *AuditEntry) GetStep() string {
+	if m != nil {
+		return m.Step
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).PromoteRollout(ctx, req.(*PromoteRolloutRequest))
+	return ""
+}
+
+func (m *AuditEntry) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RolloutService_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbortRolloutRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*RolloutInfoQuery)(nil), "rollout.RolloutInfoQuery")
+	proto.RegisterType((*RolloutInfoListQuery)(nil), "rollout.RolloutInfoListQuery")
+	proto.RegisterType((*SetImageRequest)(nil), "rollout.SetImageRequest")
+	proto.RegisterType((*UndoRolloutRequest)(nil), "rollout.UndoRolloutRequest")
+	proto.RegisterType((*RestartRolloutRequest)(nil), "rollout.RestartRolloutRequest")
+	proto.RegisterType((*PromoteRolloutRequest)(nil), "rollout.PromoteRolloutRequest")
+	proto.RegisterType((*AbortRolloutRequest)(nil), "rollout.AbortRolloutRequest")
+	proto.RegisterType((*RetryRolloutRequest)(nil), "rollout.RetryRolloutRequest")
+	proto.RegisterType((*RolloutWatchEvent)(nil), "rollout.RolloutWatchEvent")
+	proto.RegisterType((*NamespaceInfo)(nil), "rollout.NamespaceInfo")
+	proto.RegisterType((*RolloutInfoList)(nil), "rollout.RolloutInfoList")
+	proto.RegisterType((*VersionInfo)(nil), "rollout.VersionInfo")
+	proto.RegisterType((*RolloutInfo)(nil), "rollout.RolloutInfo")
+	proto.RegisterType((*ExperimentInfo)(nil), "rollout.ExperimentInfo")
+	proto.RegisterType((*ReplicaSetInfo)(nil), "rollout.ReplicaSetInfo")
+	proto.RegisterType((*PodInfo)(nil), "rollout.PodInfo")
+	proto.RegisterType((*ContainerInfo)(nil), "rollout.ContainerInfo")
+	proto.RegisterType((*JobInfo)(nil), "rollout.JobInfo")
+	proto.RegisterType((*AnalysisRunSpecAndStatus)(nil), "rollout.AnalysisRunSpecAndStatus")
+	proto.RegisterType((*AnalysisRunInfo)(nil), "rollout.AnalysisRunInfo")
+	proto.RegisterType((*NonJobInfo)(nil), "rollout.NonJobInfo")
+	proto.RegisterType((*Metrics)(nil), "rollout.Metrics")
+	proto.RegisterType((*RolloutHistoryQuery)(nil), "rollout.RolloutHistoryQuery")
+	proto.RegisterType((*RevisionDiffQuery)(nil), "rollout.RevisionDiffQuery")
+	proto.RegisterType((*RolloutHistory)(nil), "rollout.RolloutHistory")
+	proto.RegisterType((*RevisionInfo)(nil), "rollout.RevisionInfo")
+	proto.RegisterType((*RevisionDiff)(nil), "rollout.RevisionDiff")
+	proto.RegisterType((*RolloutAuditQuery)(nil), "rollout.RolloutAuditQuery")
+	proto.RegisterType((*RolloutAudit)(nil), "rollout.RolloutAudit")
+	proto.RegisterType((*AuditEntry)(nil), "rollout.AuditEntry")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/rollout/rollout.proto", fileDescriptor_99101d942e8912a7)
+}
+
+var fileDescriptor_99101d942e8912a7 = []byte{
+	// 2282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x57, 0x7b, 0x3c, 0xf1, 0xf8, 0x8d, 0xe3, 0x1f, 0xe5, 0xfc, 0xe8, 0x9d, 0xcd, 0xd7, 0xca,
+	0xf6, 0x7e, 0x25, 0x1c, 0x2f, 0x99, 0x71, 0x7e, 0x28, 0xcb, 0x02, 0xbb, 0x92, 0x71, 0x2c, 0x27,
+	0xc8, 0xc9, 0x86, 0x76, 0x96, 0x08, 0x0e, 0x44, 0xe5, 0x9e, 0xf2, 0x4c, 0x27, 0x3d, 0x5d, 0x4d,
+	0x57, 0xf5, 0x84, 0x91, 0x65, 0xc1, 0x72, 0xe3, 0xc4, 0x81, 0x1b, 0x67, 0x0e, 0x70, 0x42, 0x48,
+	0x5c, 0x38, 0x70, 0xe1, 0x80, 0x38, 0x22, 0x71, 0xe1, 0xc0, 0x01, 0x45, 0x88, 0x1b, 0x07, 0xfe,
+	0x03, 0x54, 0xaf, 0xab, 0xbb, 0xab, 0x7b, 0xc6, 0x89, 0x1d, 0x1b, 0xb2, 0xa7, 0xee, 0xf7, 0x5e,
+	0xbd, 0x7a, 0xaf, 0xba, 0xde, 0xfb, 0xd4, 0xeb, 0x57, 0xf0, 0x7e, 0xf4, 0xbc, 0xd7, 0xa1, 0x91,
+	0xef, 0x05, 0x3e, 0x0b, 0x65, 0x27, 0xe6, 0x41, 0xc0, 0x93, 0xfc, 0xd9, 0x8e, 0x62, 0x2e, 0x39,
+	0x99, 0xd1, 0x64, 0xeb, 0x4a, 0x8f, 0xf3, 0x5e, 0xc0, 0x94, 0x42, 0x87, 0x86, 0x21, 0x97, 0x54,
+	0xfa, 0x3c, 0x14, 0xe9, 0xb0, 0xd6, 0x4e, 0xcf, 0x97, 0xfd, 0x64, 0xaf, 0xed, 0xf1, 0x41, 0x87,
+	0xc6, 0x3d, 0x1e, 0xc5, 0xfc, 0x19, 0xbe, 0x5c, 0xd7, 0xfa, 0xa2, 0xa3, 0xad, 0x89, 0x4e, 0xce,
+	0x19, 0xde, 0xa0, 0x41, 0xd4, 0xa7, 0x37, 0x3a, 0x3d, 0x16, 0xb2, 0x98, 0x4a, 0xd6, 0xd5, 0xb3,
+	0xdd, 0x7e, 0xfe, 0x15, 0xd1, 0xf6, 0xb9, 0x1a, 0x3e, 0xa0, 0x5e, 0xdf, 0x0f, 0x59, 0x3c, 0x2a,
+	0xf4, 0x07, 0x4c, 0xd2, 0xce, 0x70, 0x5c, 0xeb, 0x5d, 0xed, 0x21, 0x52, 0x7b, 0xc9, 0x7e, 0x87,
+	0x0d, 0x22, 0x39, 0x4a, 0x85, 0xce, 0x5d, 0x58, 0x74, 0x53, 0xbb, 0xf7, 0xc3, 0x7d, 0xfe, 0xad,
+	0x84, 0xc5, 0x23, 0x42, 0x60, 0x3a, 0xa4, 0x03, 0x66, 0x5b, 0x57, 0xad, 0xd5, 0x59, 0x17, 0xdf,
+	0xc9, 0x15, 0x98, 0x55, 0x4f, 0x11, 0x51, 0x8f, 0xd9, 0x53, 0x28, 0x28, 0x18, 0xce, 0x6d, 0xb8,
+	0x60, 0xcc, 0xb2, 0xe3, 0x0b, 0x99, 0xce, 0x54, 0xd2, 0xb2, 0xaa, 0x5a, 0x3f, 0xb5, 0x60, 0x61,
+	0x97, 0xc9, 0xfb, 0x03, 0xda, 0x63, 0x2e, 0xfb, 0x7e, 0xc2, 0x84, 0x24, 0x36, 0x64, 0x5f, 0x56,
+	0x8f, 0xcf, 0x48, 0x35, 0x97, 0xc7, 0x43, 0x49, 0xd5, 0xaa, 0x33, 0x0f, 0x72, 0x06, 0xb9, 0x00,
+	0x75, 0x5f, 0xcd, 0x63, 0xd7, 0x50, 0x92, 0x12, 0x64, 0x11, 0x6a, 0x92, 0xf6, 0xec, 0x69, 0xe4,
+	0xa9, 0xd7, 0xb2, 0x47, 0xf5, 0xaa, 0x47, 0x7d, 0x20, 0x9f, 0x85, 0x5d, 0xae, 0xd7, 0xf2, 0x7a,
+	0x9f, 0x5a, 0xd0, 0x88, 0xd9, 0xd0, 0x17, 0x3e, 0x0f, 0xd1, 0xa5, 0x9a, 0x9b, 0xd3, 0x65, 0x4b,
+	0xb5, 0xaa, 0xa5, 0xfb, 0x70, 0xd1, 0x65, 0x42, 0xd2, 0x58, 0x56, 0x8c, 0x9d, 0xfc, 0xe3, 0x7f,
+	0x6e, 0xc1, 0xc5, 0x47, 0x31, 0x1f, 0x70, 0xc9, 0x4e, 0x3b, 0x97, 0xd2, 0xd8, 0x4f, 0x82, 0x00,
+	0xfd, 0x6d, 0xb8, 0xf8, 0x4e, 0x1c, 0x98, 0xf3, 0x7b, 0x21, 0x8f, 0xd9, 0x13, 0x3f, 0xec, 0xf2,
+	0x17, 0xf8, 0x35, 0x1b, 0x6e, 0x89, 0xe7, 0x6c, 0xc3, 0xf2, 0xc6, 0x1e, 0x3f, 0x83, 0xc5, 0x6c,
+	0xc3, 0xb2, 0xcb, 0x64, 0x3c, 0x3a, 0xf5, 0x44, 0x4f, 0x61, 0x49, 0xcf, 0xf1, 0x84, 0x4a, 0xaf,
+	0xbf, 0x35, 0x64, 0x21, 0x4e, 0x23, 0x47, 0x51, 0x3e, 0x8d, 0x7a, 0x27, 0x77, 0xa0, 0x19, 0x17,
+	0xb1, 0x8b, 0x13, 0x35, 0x6f, 0x5e, 0x68, 0x67, 0xe9, 0x6e, 0xc4, 0xb5, 0x6b, 0x0e, 0x74, 0x9e,
+	0xc2, 0xf9, 0x87, 0x99, 0x35, 0xc5, 0x78, 0x75, 0xb0, 0x93, 0x75, 0x58, 0xa6, 0x43, 0xea, 0x07,
+	0x74, 0x2f, 0x60, 0xb9, 0x9e, 0xb0, 0xa7, 0xae, 0xd6, 0x56, 0x67, 0xdd, 0x49, 0x22, 0x67, 0x13,
+	0x16, 0x2a, 0x49, 0x45, 0xd6, 0xa1, 0x91, 0xa1, 0x84, 0x6d, 0x5d, 0xad, 0x1d, 0xe9, 0x68, 0x3e,
+	0xca, 0xf9, 0x10, 0x9a, 0xdf, 0x66, 0xb1, 0x0a, 0x48, 0xf4, 0x71, 0x15, 0x16, 0x32, 0x91, 0x66,
+	0x6b, 0x4f, 0xab, 0x6c, 0xe7, 0xaf, 0x0d, 0x68, 0x1a, 0x53, 0x92, 0x47, 0x00, 0x7c, 0xef, 0x19,
+	0xf3, 0xe4, 0x03, 0x26, 0x29, 0x2a, 0x35, 0x6f, 0xae, 0xb7, 0x53, 0x40, 0x6a, 0x9b, 0x80, 0xd4,
+	0x8e, 0x9e, 0xf7, 0x14, 0x43, 0xb4, 0x15, 0x20, 0xb5, 0x87, 0x37, 0xda, 0x9f, 0xe6, 0x7a, 0xae,
+	0x31, 0x07, 0xb9, 0x04, 0xe7, 0x84, 0xa4, 0x32, 0x11, 0x7a, 0xf3, 0x34, 0xa5, 0xd2, 0x6d, 0xc0,
+	0x84, 0x28, 0x92, 0x39, 0x23, 0xd5, 0xf6, 0xf9, 0x1e, 0x0f, 0x75, 0x3e, 0xe3, 0xbb, 0x4a, 0x41,
+	0x21, 0x15, 0xdc, 0xf5, 0x46, 0x3a, 0x9f, 0x73, 0x5a, 0x8d, 0x17, 0x92, 0x45, 0xf6, 0xb9, 0x74,
+	0xbc, 0x7a, 0x57, 0xbb, 0x24, 0x98, 0x7c, 0xc2, 0xfc, 0x5e, 0x5f, 0xda, 0x33, 0xe9, 0x2e, 0xe5,
+	0x0c, 0x15, 0xeb, 0xd4, 0x93, 0x09, 0x0d, 0xf4, 0x80, 0x06, 0x0e, 0x28, 0xf1, 0x14, 0xd4, 0xc4,
+	0x8c, 0x76, 0x47, 0xf6, 0xec, 0x55, 0x6b, 0xb5, 0xee, 0xa6, 0x84, 0xf2, 0xda, 0x4b, 0xe2, 0x98,
+	0x85, 0xd2, 0x06, 0xe4, 0x67, 0xa4, 0x92, 0x74, 0x99, 0xf0, 0x63, 0xd6, 0xb5, 0x9b, 0xa9, 0x44,
+	0x93, 0x4a, 0x92, 0x44, 0x5d, 0x05, 0xd5, 0xf6, 0x5c, 0x2a, 0xd1, 0xa4, 0xf2, 0x32, 0x0f, 0x09,
+	0xfb, 0x3c, 0xca, 0x0a, 0x06, 0xb9, 0x0a, 0xcd, 0x38, 0x05, 0x0f, 0xd6, 0xdd, 0x90, 0xf6, 0x3c,
+	0x3a, 0x69, 0xb2, 0xc8, 0x0a, 0x80, 0x3e, 0x06, 0xd4, 0x16, 0x2f, 0xe0, 0x00, 0x83, 0x43, 0x3e,
+	0x52, 0x33, 0x44, 0x81, 0xef, 0xd1, 0x5d, 0x26, 0x85, 0xbd, 0x88, 0xb1, 0x74, 0xb9, 0x88, 0xa5,
+	0x5c, 0xa6, 0xe3, 0xbe, 0x18, 0xab, 0x54, 0xd9, 0x0f, 0x22, 0x16, 0xfb, 0x03, 0x16, 0x4a, 0x61,
+	0x2f, 0x55, 0x54, 0xb7, 0x72, 0x59, 0xaa, 0x6a, 0x8c, 0x25, 0x5f, 0x87, 0x39, 0x1a, 0xd2, 0x60,
+	0x24, 0x7c, 0xe1, 0x26, 0xa1, 0xb0, 0x09, 0xea, 0xda, 0xb9, 0xee, 0x46, 0x21, 0x44, 0xe5, 0xd2,
+	0x68, 0x72, 0x07, 0x20, 0xc7, 0x7b, 0x61, 0x2f, 0xa3, 0xee, 0xa5, 0x5c, 0x77, 0x33, 0x13, 0xa1,
+	0xa6, 0x31, 0x92, 0x7c, 0x0f, 0xea, 0x6a, 0xe7, 0x85, 0x7d, 0x01, 0x55, 0xee, 0xb5, 0x8b, 0x33,
+	0xb9, 0x9d, 0x9d, 0xc9, 0xf8, 0xf2, 0x34, 0xcb, 0x81, 0x22, 0x84, 0x73, 0x4e, 0x76, 0x26, 0xb7,
+	0x37, 0x69, 0x48, 0xe3, 0xd1, 0xae, 0x64, 0x91, 0x9b, 0x4e, 0x4b, 0x3e, 0x81, 0x79, 0x3f, 0xf4,
+	0xe5, 0x66, 0xe1, 0xdb, 0xc5, 0x57, 0xfa, 0x56, 0x19, 0x4d, 0x24, 0xcc, 0x0d, 0x92, 0x40, 0xfa,
+	0x9b, 0x41, 0x22, 0x24, 0x8b, 0xed, 0x4b, 0x98, 0x5b, 0x8f, 0x4e, 0xe7, 0xe6, 0x03, 0x63, 0xc6,
+	0x5d, 0xcc, 0x2b, 0xb7, 0x64, 0x85, 0x8c, 0x60, 0xae, 0xcb, 0x22, 0x16, 0x76, 0x59, 0xe8, 0xf9,
+	0x4c, 0xd8, 0x97, 0xd1, 0xe7, 0xcf, 0x4e, 0x67, 0x55, 0x03, 0xc6, 0xdd, 0x6c, 0xe2, 0x51, 0x66,
+	0xda, 0x34, 0xe5, 0xfc, 0x7e, 0x0a, 0xe6, 0xcb, 0x61, 0xf2, 0x5f, 0x40, 0x97, 0x0c, 0x2b, 0xa6,
+	0xca, 0x58, 0x91, 0x1f, 0xd7, 0xb5, 0xca, 0x71, 0x5d, 0xa0, 0xd1, 0xf4, 0x51, 0x68, 0x54, 0x2f,
+	0xa3, 0x51, 0x25, 0x87, 0xce, 0x9d, 0x20, 0x87, 0xaa, 0x89, 0x30, 0x73, 0x92, 0x44, 0x70, 0x7e,
+	0x39, 0x0d, 0xf3, 0xe5, 0xd9, 0xff, 0x87, 0xe8, 0x9c, 0x7d, 0xd7, 0xda, 0x11, 0xdf, 0x75, 0x7a,
+	0xe2, 0x77, 0x55, 0x30, 0x56, 0xc7, 0xba, 0x41, 0x53, 0x8a, 0xef, 0x61, 0x2a, 0x21, 0x3a, 0x37,
+	0x5c, 0x4d, 0x29, 0x3e, 0xf5, 0xa4, 0x3f, 0x64, 0x08, 0xce, 0x0d, 0x57, 0x53, 0x6a, 0x1f, 0x22,
+	0x35, 0x29, 0x7b, 0x81, 0xa0, 0xdc, 0x70, 0x33, 0x32, 0xb5, 0x8e, 0x5f, 0x43, 0x68, 0x48, 0xce,
+	0xe9, 0x32, 0x8e, 0x42, 0x15, 0x47, 0x5b, 0xd0, 0x90, 0x6c, 0x10, 0x05, 0x54, 0x32, 0x84, 0xe6,
+	0x59, 0x37, 0xa7, 0xc9, 0x97, 0x61, 0x49, 0x78, 0x34, 0x60, 0x77, 0xf9, 0x8b, 0xf0, 0x2e, 0xa3,
+	0xdd, 0xc0, 0x0f, 0x19, 0xa2, 0xf4, 0xac, 0x3b, 0x2e, 0x50, 0x5e, 0x63, 0xc5, 0x29, 0xec, 0xf3,
+	0x78, 0xa0, 0x6b, 0x8a, 0xfc, 0x3f, 0x4c, 0x47, 0xbc, 0x2b, 0xec, 0x79, 0xdc, 0xe0, 0xc5, 0x7c,
+	0x83, 0x1f, 0xf1, 0x2e, 0x6e, 0x2c, 0x4a, 0xd5, 0x37, 0x8d, 0xfc, 0xb0, 0x87, 0x38, 0xdd, 0x70,
+	0xf1, 0x1d, 0x79, 0x3c, 0xec, 0xd9, 0x8b, 0x9a, 0xc7, 0xc3, 0x9e, 0xaa, 0x21, 0x4a, 0xd8, 0x71,
+	0x3f, 0x35, 0xb9, 0x94, 0xd6, 0x10, 0x13, 0x44, 0xce, 0xef, 0x2c, 0x98, 0xd1, 0xb6, 0xde, 0x72,
+	0x8c, 0xe4, 0xa7, 0x66, 0x9a, 0x5e, 0xfa, 0xd4, 0xc4, 0xbd, 0xc3, 0x63, 0x4b, 0x60, 0x7c, 0xe0,
+	0xde, 0xa5, 0xb4, 0xf3, 0x11, 0x9c, 0x2f, 0x01, 0xe7, 0xc4, 0x22, 0x30, 0xaf, 0xfb, 0xa7, 0x8c,
+	0xba, 0xdf, 0xf9, 0xb7, 0x05, 0x33, 0xdf, 0xe4, 0x7b, 0x5f, 0x80, 0x65, 0xaf, 0x00, 0x0c, 0x98,
+	0x8c, 0x7d, 0x4f, 0x15, 0x76, 0x7a, 0xed, 0x06, 0x87, 0xdc, 0x83, 0xd9, 0xe2, 0x20, 0xaf, 0xa3,
+	0x73, 0x6b, 0xc7, 0x73, 0xee, 0xb1, 0x3f, 0x60, 0x6e, 0xa1, 0xec, 0xfc, 0xd3, 0x02, 0xdb, 0xc0,
+	0x8d, 0xdd, 0x88, 0x79, 0x1b, 0x61, 0x37, 0x05, 0x60, 0x42, 0x61, 0x5a, 0x44, 0xcc, 0xd3, 0xcb,
+	0x7f, 0x70, 0x3a, 0x94, 0xaf, 0x58, 0x71, 0x71, 0x6a, 0xd2, 0x2b, 0x7d, 0x95, 0xe6, 0xcd, 0x4f,
+	0xcf, 0xce, 0x48, 0x7a, 0x88, 0xe8, 0xe9, 0x9d, 0x7f, 0xd5, 0x60, 0xa1, 0x02, 0x90, 0x5f, 0xe0,
+	0xf3, 0x63, 0x05, 0x40, 0x24, 0x9e, 0xc7, 0x84, 0xd8, 0x4f, 0x02, 0x1d, 0xe3, 0x06, 0x47, 0xe9,
+	0xed, 0x53, 0x3f, 0x60, 0x5d, 0xc4, 0xc1, 0xba, 0xab, 0x29, 0xfc, 0xeb, 0x0a, 0x3d, 0x1e, 0x7a,
+	0x41, 0x22, 0x32, 0x34, 0xac, 0xbb, 0x25, 0x9e, 0x0a, 0x7e, 0x16, 0xc7, 0x3c, 0x46, 0x44, 0xac,
+	0xbb, 0x29, 0xa1, 0x30, 0xe7, 0x19, 0xdf, 0x53, 0x58, 0x58, 0xc6, 0x1c, 0x9d, 0x10, 0x2e, 0x4a,
+	0xc9, 0x2d, 0x80, 0x90, 0x87, 0x9a, 0x67, 0x03, 0x8e, 0x5d, 0xce, 0xc7, 0x3e, 0xcc, 0x45, 0xae,
+	0x31, 0x8c, 0xac, 0xa9, 0xc3, 0x50, 0xc5, 0xae, 0xb0, 0x9b, 0x95, 0xd9, 0x1f, 0xa4, 0x7c, 0x37,
+	0x1b, 0x40, 0xb6, 0xe1, 0xbc, 0x30, 0x63, 0x10, 0xc1, 0xb3, 0x79, 0xf3, 0xbd, 0x49, 0x87, 0x5c,
+	0x29, 0x58, 0xdd, 0xb2, 0x9e, 0xf3, 0x0b, 0x0b, 0xa0, 0xf0, 0x47, 0x2d, 0x7a, 0x48, 0x83, 0x24,
+	0x83, 0x81, 0x94, 0x38, 0x32, 0x27, 0xcb, 0xf9, 0x57, 0x7b, 0x75, 0xfe, 0x4d, 0x9f, 0x26, 0xff,
+	0x7e, 0x63, 0xc1, 0x8c, 0xfe, 0x08, 0x13, 0x91, 0x6a, 0x0d, 0x16, 0xf5, 0xb6, 0x6f, 0xf2, 0xb0,
+	0xeb, 0x4b, 0x3f, 0x0f, 0xae, 0x31, 0xbe, 0x5a, 0xa3, 0xc7, 0x93, 0x50, 0xa2, 0xc3, 0x75, 0x37,
+	0x25, 0xd4, 0x91, 0x64, 0x6e, 0xff, 0x8e, 0x3f, 0xf0, 0x53, 0x9f, 0xeb, 0xee, 0xb8, 0x40, 0x05,
+	0x90, 0x0a, 0xa5, 0x24, 0xd6, 0x03, 0xd3, 0xd0, 0x2b, 0xf1, 0xf0, 0x6f, 0x3b, 0xdd, 0x8d, 0x7b,
+	0xbe, 0x90, 0x3c, 0x1e, 0xbd, 0x69, 0x03, 0xe8, 0x27, 0x16, 0x2c, 0xb9, 0x3a, 0x15, 0xee, 0xfa,
+	0xfb, 0xfb, 0x6f, 0x38, 0x0f, 0x3a, 0x1d, 0xf3, 0x81, 0x5b, 0xce, 0xb2, 0x12, 0x4f, 0x6d, 0xa9,
+	0xe4, 0x6e, 0xb9, 0xde, 0x30, 0x38, 0xce, 0x16, 0xcc, 0x97, 0x17, 0x45, 0x6e, 0xc1, 0x6c, 0x96,
+	0xa7, 0xd9, 0x7f, 0xf3, 0x45, 0xa3, 0x4e, 0x4b, 0x25, 0x18, 0xec, 0xc5, 0x38, 0xe7, 0x0f, 0x53,
+	0x30, 0x67, 0xca, 0x4a, 0xd9, 0x6f, 0x55, 0xb2, 0x7f, 0x15, 0x16, 0x22, 0xde, 0x7d, 0xac, 0x8b,
+	0x87, 0x7b, 0x54, 0xf4, 0xf5, 0xda, 0xaa, 0x6c, 0xa3, 0x52, 0xa8, 0x95, 0x2a, 0x85, 0x7b, 0x30,
+	0xeb, 0xc5, 0x8c, 0xbe, 0x71, 0x20, 0xe6, 0xca, 0x46, 0x2a, 0xd4, 0xab, 0xc7, 0xd3, 0xd8, 0xdf,
+	0xf0, 0xa9, 0x0a, 0x51, 0xf5, 0x1f, 0xea, 0xf5, 0x69, 0xd8, 0x63, 0x9b, 0x34, 0x11, 0x4c, 0xff,
+	0x2c, 0x9b, 0x2c, 0xa7, 0x5f, 0x7c, 0x43, 0x15, 0x16, 0x63, 0xfb, 0x6b, 0xbd, 0x76, 0x7f, 0xa7,
+	0xaa, 0xfb, 0xab, 0x92, 0x23, 0xa2, 0xd2, 0xeb, 0x67, 0xad, 0x3e, 0x24, 0x9c, 0xad, 0xbc, 0xdf,
+	0xb3, 0x91, 0x74, 0x7d, 0xf9, 0xa6, 0x81, 0xfc, 0x31, 0xcc, 0x99, 0xd3, 0x90, 0xeb, 0x30, 0xc3,
+	0x42, 0x19, 0xab, 0x3f, 0x24, 0xab, 0x82, 0x91, 0x38, 0x60, 0x2b, 0x94, 0xf1, 0xc8, 0xcd, 0xc6,
+	0x38, 0x7f, 0xb3, 0x00, 0x0a, 0x3e, 0xf9, 0x04, 0xa6, 0xa5, 0xaf, 0xed, 0x9f, 0x6c, 0x3f, 0x51,
+	0x4f, 0x2d, 0x95, 0x7a, 0x92, 0x67, 0xfd, 0xce, 0x94, 0xc0, 0x0d, 0xe6, 0x49, 0x9c, 0xb7, 0x15,
+	0x35, 0x95, 0x95, 0xce, 0x79, 0x83, 0x44, 0x53, 0xa5, 0xc0, 0xd5, 0x2d, 0x92, 0x3c, 0x70, 0x27,
+	0x05, 0x85, 0xf1, 0xcb, 0x33, 0x53, 0xfa, 0xe5, 0xb9, 0xf9, 0xa3, 0xa5, 0x3c, 0xb7, 0x76, 0x59,
+	0x3c, 0xf4, 0x3d, 0x46, 0x04, 0xcc, 0x6f, 0x33, 0x69, 0x76, 0x8a, 0xde, 0x99, 0xd4, 0x92, 0xc2,
+	0xfd, 0x68, 0x4d, 0xec, 0x56, 0x39, 0xeb, 0x3f, 0xfe, 0xcb, 0x3f, 0x7e, 0x36, 0xb5, 0x46, 0x56,
+	0xb1, 0x89, 0x3e, 0xbc, 0x51, 0x74, 0xc2, 0x0f, 0xf2, 0x8d, 0x39, 0x4c, 0xdf, 0x0f, 0x3b, 0xbe,
+	0x32, 0x71, 0x08, 0x8b, 0xd8, 0xd5, 0x3b, 0x95, 0xd9, 0x3b, 0x68, 0x76, 0x9d, 0xb4, 0x8f, 0x6b,
+	0xb6, 0xf3, 0x42, 0xd9, 0x5c, 0xb7, 0xc8, 0x10, 0x16, 0x77, 0x7c, 0x61, 0x2e, 0x5a, 0x90, 0xff,
+	0x9b, 0x64, 0x23, 0xef, 0x84, 0xb7, 0xec, 0xa3, 0xc4, 0xce, 0x35, 0x74, 0xe3, 0x7d, 0xf2, 0xde,
+	0x2b, 0xdd, 0xc0, 0x65, 0x7f, 0x6e, 0xc1, 0x52, 0x75, 0xdd, 0xaf, 0xb5, 0xdc, 0xaa, 0x8a, 0x8b,
+	0x7e, 0xa8, 0xd3, 0x41, 0xdb, 0xd7, 0xc8, 0x97, 0x5e, 0x6b, 0x3b, 0x5f, 0xfb, 0x77, 0x60, 0x6e,
+	0x9b, 0xc9, 0xbc, 0x4d, 0x49, 0x2e, 0xb5, 0xd3, 0xeb, 0x85, 0x76, 0x76, 0xbd, 0xd0, 0xde, 0x1a,
+	0x44, 0x72, 0xd4, 0x2a, 0xba, 0x1f, 0xa5, 0x2e, 0xa9, 0xf3, 0x0e, 0x9a, 0x5c, 0x26, 0x4b, 0x99,
+	0xc9, 0x02, 0xfc, 0x7f, 0x6d, 0xa9, 0xff, 0x5a, 0xb3, 0x29, 0x4e, 0x56, 0x0c, 0x98, 0x9e, 0xd0,
+	0x2d, 0x6f, 0x6d, 0x9d, 0x49, 0xbf, 0x22, 0x0b, 0x85, 0xd6, 0x07, 0xc7, 0x09, 0x05, 0xfd, 0x83,
+	0xf2, 0x55, 0x6b, 0x0d, 0x3d, 0x2e, 0xb7, 0xde, 0x0d, 0x8f, 0x27, 0xf6, 0xe4, 0xdf, 0x8a, 0xc7,
+	0x51, 0xea, 0x89, 0xf2, 0xf8, 0x57, 0x16, 0xcc, 0x99, 0x9d, 0x7a, 0x72, 0xa5, 0xc0, 0xb3, 0xf1,
+	0x06, 0xfe, 0x59, 0x79, 0x7b, 0x1b, 0xbd, 0x6d, 0xb7, 0xae, 0x1d, 0xc7, 0x5b, 0xaa, 0xfc, 0x50,
+	0xbe, 0xfe, 0x31, 0xbd, 0x1f, 0xca, 0xa2, 0x1a, 0x6f, 0x74, 0x8a, 0x3c, 0xaa, 0xdc, 0x1c, 0x9d,
+	0x95, 0xab, 0x2e, 0xba, 0xba, 0xd3, 0xda, 0x7e, 0xb5, 0xab, 0x9a, 0x7b, 0xd8, 0x11, 0x4c, 0x76,
+	0x0e, 0xf2, 0x6e, 0xe3, 0x61, 0xe7, 0x00, 0xcf, 0xf3, 0x8f, 0xd7, 0xd6, 0x0e, 0x3b, 0x07, 0x92,
+	0xf6, 0x0e, 0xd5, 0x42, 0x7e, 0x6b, 0x41, 0xd3, 0xb8, 0x57, 0x22, 0xef, 0xe6, 0x8b, 0x18, 0xbf,
+	0x6d, 0x3a, 0xab, 0x75, 0x6c, 0xe0, 0x3a, 0xbe, 0xd6, 0xba, 0x73, 0xcc, 0x75, 0x24, 0x61, 0x97,
+	0x77, 0x0e, 0xb2, 0x73, 0xe1, 0x30, 0x8b, 0x15, 0xf3, 0x32, 0xc6, 0x88, 0x95, 0x09, 0x77, 0x34,
+	0x6f, 0x25, 0x56, 0x62, 0xe5, 0x87, 0xf2, 0xf5, 0x87, 0xb0, 0x54, 0x1c, 0x43, 0x59, 0xdd, 0x77,
+	0xa5, 0x0a, 0x7d, 0x66, 0x95, 0xdb, 0xba, 0x7c, 0x84, 0xd4, 0xb9, 0x85, 0x1e, 0x5c, 0x27, 0xc7,
+	0xca, 0xad, 0xbe, 0xb6, 0xf5, 0x73, 0x0b, 0x16, 0x94, 0x07, 0x66, 0xb5, 0xd3, 0x1a, 0x2b, 0x32,
+	0xf3, 0xda, 0xb8, 0x75, 0x71, 0xa2, 0xcc, 0x79, 0x8c, 0xb6, 0x1f, 0x92, 0x9d, 0x13, 0xd8, 0xee,
+	0x74, 0xfd, 0xfd, 0xfd, 0xce, 0x81, 0x59, 0x44, 0xa9, 0xe0, 0xcb, 0x2b, 0xa6, 0x43, 0xf2, 0x22,
+	0xf5, 0xcd, 0x2c, 0x6c, 0xc6, 0x8e, 0x85, 0xa2, 0x6c, 0x32, 0x7d, 0x33, 0x64, 0xce, 0x0d, 0xf4,
+	0xed, 0x03, 0x72, 0xbc, 0x2c, 0x46, 0x2b, 0x8f, 0x60, 0x46, 0x5f, 0x28, 0x1d, 0x79, 0x50, 0x14,
+	0x87, 0xb3, 0x71, 0x51, 0xe5, 0x5c, 0x46, 0x5b, 0x4b, 0x64, 0x21, 0xb3, 0x35, 0x4c, 0x85, 0xdf,
+	0xd8, 0xfa, 0xd3, 0xcb, 0x15, 0xeb, 0xcf, 0x2f, 0x57, 0xac, 0xbf, 0xbf, 0x5c, 0xb1, 0xbe, 0xfb,
+	0xe1, 0xb1, 0xef, 0xd7, 0xcb, 0xb7, 0xf9, 0x7b, 0xe7, 0xd0, 0x8b, 0x5b, 0xff, 0x09, 0x00, 0x00,
+	0xff, 0xff, 0x57, 0x51, 0xd1, 0x72, 0xed, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RolloutServiceClient is the client API for RolloutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RolloutServiceClient interface {
+	GetRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (*RolloutInfo, error)
+	WatchRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfoClient, error)
+	ListRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (*RolloutInfoList, error)
+	WatchRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfosClient, error)
+	GetNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NamespaceInfo, error)
+	RestartRollout(ctx context.Context, in *RestartRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	PromoteRollout(ctx context.Context, in *PromoteRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	SetRolloutImage(ctx context.Context, in *SetImageRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	UndoRollout(ctx context.Context, in *UndoRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	RetryRollout(ctx context.Context, in *RetryRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	GetRolloutHistory(ctx context.Context, in *RolloutHistoryQuery, opts ...grpc.CallOption) (*RolloutHistory, error)
+	GetRevisionDiff(ctx context.Context, in *RevisionDiffQuery, opts ...grpc.CallOption) (*RevisionDiff, error)
+	GetRolloutAudit(ctx context.Context, in *RolloutAuditQuery, opts ...grpc.CallOption) (*RolloutAudit, error)
+	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error)
+}
+
+type rolloutServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRolloutServiceClient(cc *grpc.ClientConn) RolloutServiceClient {
+	return &rolloutServiceClient{cc}
+}
+
+func (c *rolloutServiceClient) GetRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (*RolloutInfo, error) {
+	out := new(RolloutInfo)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetRolloutInfo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).AbortRollout(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/AbortRollout",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).AbortRollout(ctx, req.(*AbortRolloutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _RolloutService_SetRolloutImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetImageRequest)
-	if err := dec(in); err != nil {
+func (c *rolloutServiceClient) WatchRolloutInfo(ctx context.Context, in *RolloutInfoQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RolloutService_serviceDesc.Streams[0], "/rollout.RolloutService/WatchRolloutInfo", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).SetRolloutImage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/SetRolloutImage",
+	x := &rolloutServiceWatchRolloutInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).SetRolloutImage(ctx, req.(*SetImageRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _RolloutService_UndoRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoRolloutRequest)
-	if err := dec(in); err != nil {
+type RolloutService_WatchRolloutInfoClient interface {
+	Recv() (*RolloutInfo, error)
+	grpc.ClientStream
+}
+
+type rolloutServiceWatchRolloutInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *rolloutServiceWatchRolloutInfoClient) Recv() (*RolloutInfo, error) {
+	m := new(RolloutInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).UndoRollout(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/UndoRollout",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).UndoRollout(ctx, req.(*UndoRolloutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _RolloutService_RetryRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryRolloutRequest)
-	if err := dec(in); err != nil {
+func (c *rolloutServiceClient) ListRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (*RolloutInfoList, error) {
+	out := new(RolloutInfoList)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/ListRolloutInfos", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).RetryRollout(ctx, in)
+	return out, nil
+}
+
+func (c *rolloutServiceClient) WatchRolloutInfos(ctx context.Context, in *RolloutInfoListQuery, opts ...grpc.CallOption) (RolloutService_WatchRolloutInfosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RolloutService_serviceDesc.Streams[1], "/rollout.RolloutService/WatchRolloutInfos", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/RetryRollout",
+	x := &rolloutServiceWatchRolloutInfosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).RetryRollout(ctx, req.(*RetryRolloutRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _RolloutService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
+type RolloutService_WatchRolloutInfosClient interface {
+	Recv() (*RolloutWatchEvent, error)
+	grpc.ClientStream
+}
+
+type rolloutServiceWatchRolloutInfosClient struct {
+	grpc.ClientStream
+}
+
+func (x *rolloutServiceWatchRolloutInfosClient) Recv() (*RolloutWatchEvent, error) {
+	m := new(RolloutWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RolloutServiceServer).Version(ctx, in)
+	return m, nil
+}
+
+func (c *rolloutServiceClient) GetNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NamespaceInfo, error) {
+	out := new(NamespaceInfo)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rollout.RolloutService/Version",
+	return out, nil
+}
+
+func (c *rolloutServiceClient) RestartRollout(ctx context.Context, in *RestartRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/RestartRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RolloutServiceServer).Version(ctx, req.(*emptypb.Empty))
+	return out, nil
+}
+
+func (c *rolloutServiceClient) PromoteRollout(ctx context.Context, in *PromoteRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/PromoteRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _RolloutService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rollout.RolloutService",
-	HandlerType: (*RolloutServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRolloutInfo",
-			Handler:    _RolloutService_GetRolloutInfo_Handler,
-		},
-		{
-			MethodName: "ListRolloutInfos",
-			Handler:    _RolloutService_ListRolloutInfos_Handler,
-		},
-		{
-			MethodName: "GetNamespace",
-			Handler:    _RolloutService_GetNamespace_Handler,
-		},
-		{
-			MethodName: "RestartRollout",
-			Handler:    _RolloutService_RestartRollout_Handler,
-		},
-		{
-			MethodName: "PromoteRollout",
-			Handler:    _RolloutService_PromoteRollout_Handler,
-		},
-		{
-			MethodName: "AbortRollout",
-			Handler:    _RolloutService_AbortRollout_Handler,
-		},
-		{
-			MethodName: "SetRolloutImage",
-			Handler:    _RolloutService_SetRolloutImage_Handler,
-		},
-		{
-			MethodName: "UndoRollout",
-			Handler:    _RolloutService_UndoRollout_Handler,
-		},
-		{
-			MethodName: "RetryRollout",
-			Handler:    _RolloutService_RetryRollout_Handler,
-		},
-		{
-			MethodName: "Version",
-			Handler:    _RolloutService_Version_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WatchRolloutInfo",
-			Handler:       _RolloutService_WatchRolloutInfo_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WatchRolloutInfos",
-			Handler:       _RolloutService_WatchRolloutInfos_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/apiclient/rollout/rollout.proto",
-}
-
-func (m *RolloutInfoQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rolloutServiceClient) AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/AbortRollout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RolloutInfoQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *RolloutInfoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *rolloutServiceClient) SetRolloutImage(ctx context.Context, in *SetImageRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/SetRolloutImage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RolloutInfoListQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rolloutServiceClient) UndoRollout(ctx context.Context, in *UndoRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/UndoRollout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RolloutInfoListQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *RolloutInfoListQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+func (c *rolloutServiceClient) RetryRollout(ctx context.Context, in *RetryRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error) {
+	out := new(v1alpha1.Rollout)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/RetryRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SetImageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rolloutServiceClient) GetRolloutHistory(ctx context.Context, in *RolloutHistoryQuery, opts ...grpc.CallOption) (*RolloutHistory, error) {
+	out := new(RolloutHistory)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetRolloutHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SetImageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rolloutServiceClient) GetRevisionDiff(ctx context.Context, in *RevisionDiffQuery, opts ...grpc.CallOption) (*RevisionDiff, error) {
+	out := new(RevisionDiff)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetRevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SetImageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Container) > 0 {
-		i -= len(m.Container)
-		copy(dAtA[i:], m.Container)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Container)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rollout) > 0 {
-		i -= len(m.Rollout)
-		copy(dAtA[i:], m.Rollout)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Rollout)))
-		i--
-		dAtA[i] = 0xa
+func (c *rolloutServiceClient) GetRolloutAudit(ctx context.Context, in *RolloutAuditQuery, opts ...grpc.CallOption) (*RolloutAudit, error) {
+	out := new(RolloutAudit)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/GetRolloutAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UndoRolloutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *rolloutServiceClient) Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error) {
+	out := new(VersionInfo)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/Version", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UndoRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RolloutServiceServer is the server API for RolloutService service.
+type RolloutServiceServer interface {
+	GetRolloutInfo(context.Context, *RolloutInfoQuery) (*RolloutInfo, error)
+	WatchRolloutInfo(*RolloutInfoQuery, RolloutService_WatchRolloutInfoServer) error
+	ListRolloutInfos(context.Context, *RolloutInfoListQuery) (*RolloutInfoList, error)
+	WatchRolloutInfos(*RolloutInfoListQuery, RolloutService_WatchRolloutInfosServer) error
+	GetNamespace(context.Context, *emptypb.Empty) (*NamespaceInfo, error)
+	RestartRollout(context.Context, *RestartRolloutRequest) (*v1alpha1.Rollout, error)
+	PromoteRollout(context.Context, *PromoteRolloutRequest) (*v1alpha1.Rollout, error)
+	AbortRollout(context.Context, *AbortRolloutRequest) (*v1alpha1.Rollout, error)
+	SetRolloutImage(context.Context, *SetImageRequest) (*v1alpha1.Rollout, error)
+	UndoRollout(context.Context, *UndoRolloutRequest) (*v1alpha1.Rollout, error)
+	RetryRollout(context.Context, *RetryRolloutRequest) (*v1alpha1.Rollout, error)
+	GetRolloutHistory(context.Context, *RolloutHistoryQuery) (*RolloutHistory, error)
+	GetRevisionDiff(context.Context, *RevisionDiffQuery) (*RevisionDiff, error)
+	GetRolloutAudit(context.Context, *RolloutAuditQuery) (*RolloutAudit, error)
+	Version(context.Context, *emptypb.Empty) (*VersionInfo, error)
 }
 
-func (m *UndoRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Revision != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Rollout) > 0 {
-		i -= len(m.Rollout)
-		copy(dAtA[i:], m.Rollout)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Rollout)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedRolloutServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRolloutServiceServer struct {
 }
 
-func (m *RestartRolloutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedRolloutServiceServer) GetRolloutInfo(ctx context.Context, req *RolloutInfoQuery) (*RolloutInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolloutInfo not implemented")
+}
+func (*UnimplementedRolloutServiceServer) WatchRolloutInfo(req *RolloutInfoQuery, srv RolloutService_WatchRolloutInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRolloutInfo not implemented")
+}
+func (*UnimplementedRolloutServiceServer) ListRolloutInfos(ctx context.Context, req *RolloutInfoListQuery) (*RolloutInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRolloutInfos not implemented")
+}
+func (*UnimplementedRolloutServiceServer) WatchRolloutInfos(req *RolloutInfoListQuery, srv RolloutService_WatchRolloutInfosServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRolloutInfos not implemented")
+}
+func (*UnimplementedRolloutServiceServer) GetNamespace(ctx context.Context, req *emptypb.Empty) (*NamespaceInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
+}
+func (*UnimplementedRolloutServiceServer) RestartRollout(ctx context.Context, req *RestartRolloutRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartRollout not implemented")
+}
+func (*UnimplementedRolloutServiceServer) PromoteRollout(ctx context.Context, req *PromoteRolloutRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+}
+func (*UnimplementedRolloutServiceServer) AbortRollout(ctx context.Context, req *AbortRolloutRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortRollout not implemented")
+}
+func (*UnimplementedRolloutServiceServer) SetRolloutImage(ctx context.Context, req *SetImageRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRolloutImage not implemented")
+}
+func (*UnimplementedRolloutServiceServer) UndoRollout(ctx context.Context, req *UndoRolloutRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoRollout not implemented")
+}
+func (*UnimplementedRolloutServiceServer) RetryRollout(ctx context.Context, req *RetryRolloutRequest) (*v1alpha1.Rollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryRollout not implemented")
+}
+func (*UnimplementedRolloutServiceServer) GetRolloutHistory(ctx context.Context, req *RolloutHistoryQuery) (*RolloutHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolloutHistory not implemented")
+}
+func (*UnimplementedRolloutServiceServer) GetRevisionDiff(ctx context.Context, req *RevisionDiffQuery) (*RevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisionDiff not implemented")
+}
+func (*UnimplementedRolloutServiceServer) GetRolloutAudit(ctx context.Context, req *RolloutAuditQuery) (*RolloutAudit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolloutAudit not implemented")
+}
+func (*UnimplementedRolloutServiceServer) Version(ctx context.Context, req *emptypb.Empty) (*VersionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
 
-func (m *RestartRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterRolloutServiceServer(s *grpc.Server, srv RolloutServiceServer) {
+	s.RegisterService(&_RolloutService_serviceDesc, srv)
 }
 
-func (m *RestartRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _RolloutService_GetRolloutInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutInfoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetRolloutInfo(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/GetRolloutInfo",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetRolloutInfo(ctx, req.(*RolloutInfoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PromoteRolloutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _RolloutService_WatchRolloutInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RolloutInfoQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(RolloutServiceServer).WatchRolloutInfo(m, &rolloutServiceWatchRolloutInfoServer{stream})
 }
 
-func (m *PromoteRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RolloutService_WatchRolloutInfoServer interface {
+	Send(*RolloutInfo) error
+	grpc.ServerStream
 }
 
-func (m *PromoteRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type rolloutServiceWatchRolloutInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *rolloutServiceWatchRolloutInfoServer) Send(m *RolloutInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RolloutService_ListRolloutInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutInfoListQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Full {
-		i--
-		if m.Full {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ListRolloutInfos(ctx, in)
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/ListRolloutInfos",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ListRolloutInfos(ctx, req.(*RolloutInfoListQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AbortRolloutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _RolloutService_WatchRolloutInfos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RolloutInfoListQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(RolloutServiceServer).WatchRolloutInfos(m, &rolloutServiceWatchRolloutInfosServer{stream})
 }
 
-func (m *AbortRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RolloutService_WatchRolloutInfosServer interface {
+	Send(*RolloutWatchEvent) error
+	grpc.ServerStream
 }
 
-func (m *AbortRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type rolloutServiceWatchRolloutInfosServer struct {
+	grpc.ServerStream
+}
+
+func (x *rolloutServiceWatchRolloutInfosServer) Send(m *RolloutWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RolloutService_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetNamespace(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/GetNamespace",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetNamespace(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RetryRolloutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RolloutService_RestartRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartRolloutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RetryRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RetryRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).RestartRollout(ctx, in)
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/RestartRollout",
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).RestartRollout(ctx, req.(*RestartRolloutRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RolloutWatchEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func _RolloutService_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).PromoteRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/PromoteRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).PromoteRollout(ctx, req.(*PromoteRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/AbortRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).AbortRollout(ctx, req.(*AbortRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_SetRolloutImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).SetRolloutImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/SetRolloutImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).SetRolloutImage(ctx, req.(*SetImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_UndoRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).UndoRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/UndoRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).UndoRollout(ctx, req.(*UndoRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_RetryRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).RetryRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/RetryRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).RetryRollout(ctx, req.(*RetryRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_GetRolloutHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutHistoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetRolloutHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/GetRolloutHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetRolloutHistory(ctx, req.(*RolloutHistoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_GetRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionDiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetRevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/GetRevisionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetRevisionDiff(ctx, req.(*RevisionDiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_GetRolloutAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutAuditQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).GetRolloutAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/GetRolloutAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).GetRolloutAudit(ctx, req.(*RolloutAuditQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).Version(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RolloutService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rollout.RolloutService",
+	HandlerType: (*RolloutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRolloutInfo",
+			Handler:    _RolloutService_GetRolloutInfo_Handler,
+		},
+		{
+			MethodName: "ListRolloutInfos",
+			Handler:    _RolloutService_ListRolloutInfos_Handler,
+		},
+		{
+			MethodName: "GetNamespace",
+			Handler:    _RolloutService_GetNamespace_Handler,
+		},
+		{
+			MethodName: "RestartRollout",
+			Handler:    _RolloutService_RestartRollout_Handler,
+		},
+		{
+			MethodName: "PromoteRollout",
+			Handler:    _RolloutService_PromoteRollout_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _RolloutService_AbortRollout_Handler,
+		},
+		{
+			MethodName: "SetRolloutImage",
+			Handler:    _RolloutService_SetRolloutImage_Handler,
+		},
+		{
+			MethodName: "UndoRollout",
+			Handler:    _RolloutService_UndoRollout_Handler,
+		},
+		{
+			MethodName: "RetryRollout",
+			Handler:    _RolloutService_RetryRollout_Handler,
+		},
+		{
+			MethodName: "GetRolloutHistory",
+			Handler:    _RolloutService_GetRolloutHistory_Handler,
+		},
+		{
+			MethodName: "GetRevisionDiff",
+			Handler:    _RolloutService_GetRevisionDiff_Handler,
+		},
+		{
+			MethodName: "GetRolloutAudit",
+			Handler:    _RolloutService_GetRolloutAudit_Handler,
+		},
+		{
+			MethodName: "Version",
+			Handler:    _RolloutService_Version_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRolloutInfo",
+			Handler:       _RolloutService_WatchRolloutInfo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRolloutInfos",
+			Handler:       _RolloutService_WatchRolloutInfos_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/apiclient/rollout/rollout.proto",
+}
+
+func (m *RolloutInfoQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *RolloutWatchEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutInfoQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutInfoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2800,29 +3146,24 @@ func (m *RolloutWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RolloutInfo != nil {
-		{
-			size, err := m.RolloutInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Type)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceInfo) Marshal() (dAtA []byte, err error) {
+func (m *RolloutInfoListQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2832,12 +3173,12 @@ func (m *NamespaceInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutInfoListQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutInfoListQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2846,15 +3187,6 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AvailableNamespaces) > 0 {
-		for iNdEx := len(m.AvailableNamespaces) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AvailableNamespaces[iNdEx])
-			copy(dAtA[i:], m.AvailableNamespaces[iNdEx])
-			i = encodeVarintRollout(dAtA, i, uint64(len(m.AvailableNamespaces[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2865,7 +3197,7 @@ func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutInfoList) Marshal() (dAtA []byte, err error) {
+func (m *SetImageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2875,12 +3207,12 @@ func (m *RolloutInfoList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutInfoList) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetImageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutInfoList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetImageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2889,24 +3221,45 @@ func (m *RolloutInfoList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Rollouts) > 0 {
-		for iNdEx := len(m.Rollouts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rollouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Container) > 0 {
+		i -= len(m.Container)
+		copy(dAtA[i:], m.Container)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Container)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rollout) > 0 {
+		i -= len(m.Rollout)
+		copy(dAtA[i:], m.Rollout)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Rollout)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VersionInfo) Marshal() (dAtA []byte, err error) {
+func (m *UndoRolloutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2916,12 +3269,12 @@ func (m *VersionInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *UndoRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UndoRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2930,17 +3283,29 @@ func (m *VersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RolloutsVersion) > 0 {
-		i -= len(m.RolloutsVersion)
-		copy(dAtA[i:], m.RolloutsVersion)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.RolloutsVersion)))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rollout) > 0 {
+		i -= len(m.Rollout)
+		copy(dAtA[i:], m.Rollout)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Rollout)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutInfo) Marshal() (dAtA []byte, err error) {
+func (m *RestartRolloutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2950,12 +3315,12 @@ func (m *RolloutInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestartRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestartRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2964,193 +3329,193 @@ func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.InitContainers) > 0 {
-		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
-	}
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Containers) > 0 {
-		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.AnalysisRuns) > 0 {
-		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AnalysisRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Experiments) > 0 {
-		for iNdEx := len(m.Experiments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Experiments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReplicaSets) > 0 {
-		for iNdEx := len(m.ReplicaSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicaSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
+	if m.IgnoreWindow {
+		i--
+		if m.IgnoreWindow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-	}
-	if len(m.Generation) > 0 {
-		i -= len(m.Generation)
-		copy(dAtA[i:], m.Generation)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Generation)))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x20
 	}
-	if len(m.RestartedAt) > 0 {
-		i -= len(m.RestartedAt)
-		copy(dAtA[i:], m.RestartedAt)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.RestartedAt)))
+	if m.Full {
 		i--
-		dAtA[i] = 0x72
-	}
-	if m.Available != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Available))
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x18
 	}
-	if m.Updated != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Updated))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x12
 	}
-	if m.Desired != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Desired))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0xa
 	}
-	if m.Current != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Current))
-		i--
-		dAtA[i] = 0x50
+	return len(dAtA) - i, nil
+}
+
+func (m *AbortRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Ready != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Ready))
-		i--
-		dAtA[i] = 0x48
+	return dAtA[:n], nil
+}
+
+func (m *AbortRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActualWeight) > 0 {
-		i -= len(m.ActualWeight)
-		copy(dAtA[i:], m.ActualWeight)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.ActualWeight)))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x12
 	}
-	if len(m.SetWeight) > 0 {
-		i -= len(m.SetWeight)
-		copy(dAtA[i:], m.SetWeight)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.SetWeight)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0xa
 	}
-	if len(m.Step) > 0 {
-		i -= len(m.Step)
-		copy(dAtA[i:], m.Step)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Step)))
-		i--
-		dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Strategy) > 0 {
-		i -= len(m.Strategy)
-		copy(dAtA[i:], m.Strategy)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Strategy)))
-		i--
-		dAtA[i] = 0x2a
+	return dAtA[:n], nil
+}
+
+func (m *RetryRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutWatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ObjectMeta != nil {
+	return dAtA[:n], nil
+}
+
+func (m *RolloutWatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RolloutInfo != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RolloutInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3158,12 +3523,19 @@ func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRollout(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Type)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExperimentInfo) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3173,12 +3545,12 @@ func (m *ExperimentInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExperimentInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExperimentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3187,76 +3559,26 @@ func (m *ExperimentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AnalysisRuns) > 0 {
-		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AnalysisRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.ReplicaSets) > 0 {
-		for iNdEx := len(m.ReplicaSets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicaSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
+	if len(m.AvailableNamespaces) > 0 {
+		for iNdEx := len(m.AvailableNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AvailableNamespaces[iNdEx])
+			copy(dAtA[i:], m.AvailableNamespaces[iNdEx])
+			i = encodeVarintRollout(dAtA, i, uint64(len(m.AvailableNamespaces[iNdEx])))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Revision != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicaSetInfo) Marshal() (dAtA []byte, err error) {
+func (m *RolloutInfoList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3266,12 +3588,12 @@ func (m *ReplicaSetInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicaSetInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutInfoList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicaSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutInfoList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3280,161 +3602,24 @@ func (m *ReplicaSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.InitContainerImages) > 0 {
-		for iNdEx := len(m.InitContainerImages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.InitContainerImages[iNdEx])
-			copy(dAtA[i:], m.InitContainerImages[iNdEx])
-			i = encodeVarintRollout(dAtA, i, uint64(len(m.InitContainerImages[iNdEx])))
-			i--
-			dAtA[i] = 0x1
+	if len(m.Rollouts) > 0 {
+		for iNdEx := len(m.Rollouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rollouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pong {
-		i--
-		if m.Pong {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.Ping {
-		i--
-		if m.Ping {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.Pods) > 0 {
-		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if len(m.Images) > 0 {
-		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Images[iNdEx])
-			copy(dAtA[i:], m.Images[iNdEx])
-			i = encodeVarintRollout(dAtA, i, uint64(len(m.Images[iNdEx])))
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.ScaleDownDeadline) > 0 {
-		i -= len(m.ScaleDownDeadline)
-		copy(dAtA[i:], m.ScaleDownDeadline)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.ScaleDownDeadline)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Template) > 0 {
-		i -= len(m.Template)
-		copy(dAtA[i:], m.Template)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Template)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Available != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Available))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Replicas != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Replicas))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Preview {
-		i--
-		if m.Preview {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Canary {
-		i--
-		if m.Canary {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Stable {
-		i--
-		if m.Stable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Revision != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PodInfo) Marshal() (dAtA []byte, err error) {
+func (m *VersionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3444,12 +3629,12 @@ func (m *PodInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3458,48 +3643,17 @@ func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Restarts != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Restarts))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Ready) > 0 {
-		i -= len(m.Ready)
-		copy(dAtA[i:], m.Ready)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Ready)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
-		}
+	if len(m.RolloutsVersion) > 0 {
+		i -= len(m.RolloutsVersion)
+		copy(dAtA[i:], m.RolloutsVersion)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.RolloutsVersion)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
+func (m *RolloutInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3509,12 +3663,12 @@ func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3523,50 +3677,25 @@ func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
 	}
-	if m.StartedAt != nil {
+	if m.MultiCluster != nil {
 		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MultiCluster.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3574,21 +3703,186 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRollout(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MetricName) > 0 {
-		i -= len(m.MetricName)
-		copy(dAtA[i:], m.MetricName)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.MetricName)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xb2
 	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.InitContainers) > 0 {
+		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AnalysisRuns) > 0 {
+		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Experiments) > 0 {
+		for iNdEx := len(m.Experiments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Experiments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ReplicaSets) > 0 {
+		for iNdEx := len(m.ReplicaSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Generation) > 0 {
+		i -= len(m.Generation)
+		copy(dAtA[i:], m.Generation)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Generation)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RestartedAt) > 0 {
+		i -= len(m.RestartedAt)
+		copy(dAtA[i:], m.RestartedAt)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.RestartedAt)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Available != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Updated != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Updated))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Desired != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Desired))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Current != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Ready != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Ready))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ActualWeight) > 0 {
+		i -= len(m.ActualWeight)
+		copy(dAtA[i:], m.ActualWeight)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.ActualWeight)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SetWeight) > 0 {
+		i -= len(m.SetWeight)
+		copy(dAtA[i:], m.SetWeight)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.SetWeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
@@ -3612,7 +3906,7 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunSpecAndStatus) Marshal() (dAtA []byte, err error) {
+func (m *ExperimentInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3622,12 +3916,12 @@ func (m *AnalysisRunSpecAndStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AnalysisRunSpecAndStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExperimentInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunSpecAndStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExperimentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3636,21 +3930,63 @@ func (m *AnalysisRunSpecAndStatus) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AnalysisRuns) > 0 {
+		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReplicaSets) > 0 {
+		for iNdEx := len(m.ReplicaSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Revision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Spec != nil {
+	if m.ObjectMeta != nil {
 		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3663,7 +3999,7 @@ func (m *AnalysisRunSpecAndStatus) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunInfo) Marshal() (dAtA []byte, err error) {
+func (m *ReplicaSetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3673,12 +4009,12 @@ func (m *AnalysisRunInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AnalysisRunInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicaSetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicaSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3687,36 +4023,43 @@ func (m *AnalysisRunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SpecAndStatus != nil {
-		{
-			size, err := m.SpecAndStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
+	if len(m.InitContainerImages) > 0 {
+		for iNdEx := len(m.InitContainerImages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InitContainerImages[iNdEx])
+			copy(dAtA[i:], m.InitContainerImages[iNdEx])
+			i = encodeVarintRollout(dAtA, i, uint64(len(m.InitContainerImages[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
+	}
+	if m.Pong {
 		i--
-		dAtA[i] = 0x62
+		if m.Pong {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.Metrics) > 0 {
-		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	if m.Ping {
+		i--
+		if m.Ping {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if len(m.NonJobInfo) > 0 {
-		for iNdEx := len(m.NonJobInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pods) > 0 {
+		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NonJobInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3724,60 +4067,99 @@ func (m *AnalysisRunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintRollout(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.Jobs) > 0 {
-		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRollout(dAtA, i, uint64(size))
-			}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Images[iNdEx])
+			copy(dAtA[i:], m.Images[iNdEx])
+			i = encodeVarintRollout(dAtA, i, uint64(len(m.Images[iNdEx])))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.Error != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Error))
+	if len(m.ScaleDownDeadline) > 0 {
+		i -= len(m.ScaleDownDeadline)
+		copy(dAtA[i:], m.ScaleDownDeadline)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.ScaleDownDeadline)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Template) > 0 {
+		i -= len(m.Template)
+		copy(dAtA[i:], m.Template)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Template)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Available != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Preview {
+		i--
+		if m.Preview {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.Inconclusive != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Inconclusive))
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x38
 	}
-	if m.Failed != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Failed))
+	if m.Canary {
+		i--
+		if m.Canary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Successful != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Successful))
+	if m.Stable {
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		if m.Stable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x28
 	}
 	if m.Revision != 0 {
 		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
 	if len(m.Icon) > 0 {
 		i -= len(m.Icon)
 		copy(dAtA[i:], m.Icon)
 		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.ObjectMeta != nil {
@@ -3795,7 +4177,7 @@ func (m *AnalysisRunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NonJobInfo) Marshal() (dAtA []byte, err error) {
+func (m *PodInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3805,12 +4187,12 @@ func (m *NonJobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NonJobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NonJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3819,22 +4201,22 @@ func (m *NonJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StartedAt != nil {
-		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRollout(dAtA, i, uint64(size))
-		}
+	if m.Restarts != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Restarts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ready) > 0 {
+		i -= len(m.Ready)
+		copy(dAtA[i:], m.Ready)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Ready)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.MetricName) > 0 {
-		i -= len(m.MetricName)
-		copy(dAtA[i:], m.MetricName)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.MetricName)))
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3845,17 +4227,22 @@ func (m *NonJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.Value)))
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metrics) Marshal() (dAtA []byte, err error) {
+func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3865,12 +4252,12 @@ func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3879,25 +4266,10 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FailureLimit != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.FailureLimit))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.InconclusiveLimit != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.InconclusiveLimit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Count != 0 {
-		i = encodeVarintRollout(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SuccessCondition) > 0 {
-		i -= len(m.SuccessCondition)
-		copy(dAtA[i:], m.SuccessCondition)
-		i = encodeVarintRollout(dAtA, i, uint64(len(m.SuccessCondition)))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Image)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3911,532 +4283,850 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRollout(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRollout(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RolloutInfoQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RolloutInfoListQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SetImageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Rollout)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Container)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.MetricName) > 0 {
+		i -= len(m.MetricName)
+		copy(dAtA[i:], m.MetricName)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.MetricName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UndoRolloutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Rollout)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovRollout(uint64(m.Revision))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AnalysisRunSpecAndStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RestartRolloutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AnalysisRunSpecAndStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromoteRolloutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AnalysisRunSpecAndStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.Full {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *AbortRolloutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryRolloutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AnalysisRunInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RolloutWatchEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.RolloutInfo != nil {
-		l = m.RolloutInfo.Size()
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *AnalysisRunInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AnalysisRunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AvailableNamespaces) > 0 {
-		for _, s := range m.AvailableNamespaces {
-			l = len(s)
-			n += 1 + l + sovRollout(uint64(l))
+	if m.SpecAndStatus != nil {
+		{
+			size, err := m.SpecAndStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	return n
-}
-
-func (m *RolloutInfoList) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NonJobInfo) > 0 {
+		for iNdEx := len(m.NonJobInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonJobInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Rollouts) > 0 {
-		for _, e := range m.Rollouts {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Error != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Error))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *VersionInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Inconclusive != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Inconclusive))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	l = len(m.RolloutsVersion)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.Failed != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Successful != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Successful))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Revision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RolloutInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NonJobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NonJobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Icon)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.MetricName) > 0 {
+		i -= len(m.MetricName)
+		copy(dAtA[i:], m.MetricName)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.MetricName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Strategy)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Step)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.SetWeight)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ActualWeight)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Ready != 0 {
-		n += 1 + sovRollout(uint64(m.Ready))
+	if m.FailureLimit != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.FailureLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Current != 0 {
-		n += 1 + sovRollout(uint64(m.Current))
+	if m.InconclusiveLimit != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.InconclusiveLimit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Desired != 0 {
-		n += 1 + sovRollout(uint64(m.Desired))
+	if m.Count != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Updated != 0 {
-		n += 1 + sovRollout(uint64(m.Updated))
+	if len(m.SuccessCondition) > 0 {
+		i -= len(m.SuccessCondition)
+		copy(dAtA[i:], m.SuccessCondition)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.SuccessCondition)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Available != 0 {
-		n += 1 + sovRollout(uint64(m.Available))
-	}
-	l = len(m.RestartedAt)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Generation)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	if len(m.ReplicaSets) > 0 {
-		for _, e := range m.ReplicaSets {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
-	}
-	if len(m.Experiments) > 0 {
-		for _, e := range m.Experiments {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
-	}
-	if len(m.AnalysisRuns) > 0 {
-		for _, e := range m.AnalysisRuns {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHistoryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHistoryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHistoryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.InitContainers) > 0 {
-		for _, e := range m.InitContainers {
-			l = e.Size()
-			n += 2 + l + sovRollout(uint64(l))
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExperimentInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevisionDiffQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionDiffQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Icon)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Revision != 0 {
-		n += 1 + sovRollout(uint64(m.Revision))
+	if m.ToRevision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.ToRevision))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if m.FromRevision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.FromRevision))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReplicaSets) > 0 {
-		for _, e := range m.ReplicaSets {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.AnalysisRuns) > 0 {
-		for _, e := range m.AnalysisRuns {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicaSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevisionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovRollout(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.ChangeCause) > 0 {
+		i -= len(m.ChangeCause)
+		copy(dAtA[i:], m.ChangeCause)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.ChangeCause)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Icon)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.AnalysisRuns) > 0 {
+		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.Revision != 0 {
-		n += 1 + sovRollout(uint64(m.Revision))
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Stable {
-		n += 2
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Canary {
-		n += 2
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Active {
-		n += 2
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Images[iNdEx])
+			copy(dAtA[i:], m.Images[iNdEx])
+			i = encodeVarintRollout(dAtA, i, uint64(len(m.Images[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Preview {
-		n += 2
+	if len(m.PodTemplateHash) > 0 {
+		i -= len(m.PodTemplateHash)
+		copy(dAtA[i:], m.PodTemplateHash)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.PodTemplateHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Replicas != 0 {
-		n += 1 + sovRollout(uint64(m.Replicas))
+	if m.Revision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Available != 0 {
-		n += 1 + sovRollout(uint64(m.Available))
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Template)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RevisionDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ScaleDownDeadline)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.Patch) > 0 {
+		i -= len(m.Patch)
+		copy(dAtA[i:], m.Patch)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Patch)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Images) > 0 {
-		for _, s := range m.Images {
-			l = len(s)
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	if m.ToRevision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.ToRevision))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Pods) > 0 {
-		for _, e := range m.Pods {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	if m.FromRevision != 0 {
+		i = encodeVarintRollout(dAtA, i, uint64(m.FromRevision))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Ping {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutAuditQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pong {
-		n += 3
+	return dAtA[:n], nil
+}
+
+func (m *RolloutAuditQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutAuditQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.InitContainerImages) > 0 {
-		for _, s := range m.InitContainerImages {
-			l = len(s)
-			n += 2 + l + sovRollout(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PodInfo) Size() (n int) {
+func encodeVarintRollout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRollout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RolloutInfoQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Icon)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.Ready)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.Restarts != 0 {
-		n += 1 + sovRollout(uint64(m.Restarts))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContainerInfo) Size() (n int) {
+func (m *RolloutInfoListQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
-	}
-	l = len(m.Image)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
@@ -4446,30 +5136,30 @@ func (m *ContainerInfo) Size() (n int) {
 	return n
 }
 
-func (m *JobInfo) Size() (n int) {
+func (m *SetImageRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
+	l = len(m.Rollout)
+	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Container)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.Icon)
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.MetricName)
+	l = len(m.Tag)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
+	l = len(m.Namespace)
+	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -4478,18 +5168,21 @@ func (m *JobInfo) Size() (n int) {
 	return n
 }
 
-func (m *AnalysisRunSpecAndStatus) Size() (n int) {
+func (m *UndoRolloutRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Spec != nil {
-		l = m.Spec.Size()
-		n += 1 + l + sovRollout(uint64(l))
+	l = len(m.Rollout)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
+	if m.Revision != 0 {
+		n += 1 + sovRollout(uint64(m.Revision))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -4498,59 +5191,64 @@ func (m *AnalysisRunSpecAndStatus) Size() (n int) {
 	return n
 }
 
-func (m *AnalysisRunInfo) Size() (n int) {
+func (m *RestartRolloutRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.Icon)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.Revision != 0 {
-		n += 1 + sovRollout(uint64(m.Revision))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.Status)
+	return n
+}
+
+func (m *PromoteRolloutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.Successful != 0 {
-		n += 1 + sovRollout(uint64(m.Successful))
-	}
-	if m.Failed != 0 {
-		n += 1 + sovRollout(uint64(m.Failed))
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.Inconclusive != 0 {
-		n += 1 + sovRollout(uint64(m.Inconclusive))
+	if m.Full {
+		n += 2
 	}
-	if m.Error != 0 {
-		n += 1 + sovRollout(uint64(m.Error))
+	if m.IgnoreWindow {
+		n += 2
 	}
-	if len(m.Jobs) > 0 {
-		for _, e := range m.Jobs {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.NonJobInfo) > 0 {
-		for _, e := range m.NonJobInfo {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	return n
+}
+
+func (m *AbortRolloutRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Metrics) > 0 {
-		for _, e := range m.Metrics {
-			l = e.Size()
-			n += 1 + l + sovRollout(uint64(l))
-		}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.SpecAndStatus != nil {
-		l = m.SpecAndStatus.Size()
+	l = len(m.Namespace)
+	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -4559,26 +5257,38 @@ func (m *AnalysisRunInfo) Size() (n int) {
 	return n
 }
 
-func (m *NonJobInfo) Size() (n int) {
+func (m *RetryRolloutRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Value)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.Status)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.MetricName)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutWatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
+	if m.RolloutInfo != nil {
+		l = m.RolloutInfo.Size()
 		n += 1 + l + sovRollout(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -4587,28 +5297,39 @@ func (m *NonJobInfo) Size() (n int) {
 	return n
 }
 
-func (m *Metrics) Size() (n int) {
+func (m *NamespaceInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
-	l = len(m.SuccessCondition)
-	if l > 0 {
-		n += 1 + l + sovRollout(uint64(l))
+	if len(m.AvailableNamespaces) > 0 {
+		for _, s := range m.AvailableNamespaces {
+			l = len(s)
+			n += 1 + l + sovRollout(uint64(l))
+		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovRollout(uint64(m.Count))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.InconclusiveLimit != 0 {
-		n += 1 + sovRollout(uint64(m.InconclusiveLimit))
+	return n
+}
+
+func (m *RolloutInfoList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.FailureLimit != 0 {
-		n += 1 + sovRollout(uint64(m.FailureLimit))
+	var l int
+	_ = l
+	if len(m.Rollouts) > 0 {
+		for _, e := range m.Rollouts {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -4616,13 +5337,1971 @@ func (m *Metrics) Size() (n int) {
 	return n
 }
 
-func sovRollout(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *VersionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RolloutsVersion)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozRollout(x uint64) (n int) {
-	return sovRollout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RolloutInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.SetWeight)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.ActualWeight)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Ready != 0 {
+		n += 1 + sovRollout(uint64(m.Ready))
+	}
+	if m.Current != 0 {
+		n += 1 + sovRollout(uint64(m.Current))
+	}
+	if m.Desired != 0 {
+		n += 1 + sovRollout(uint64(m.Desired))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovRollout(uint64(m.Updated))
+	}
+	if m.Available != 0 {
+		n += 1 + sovRollout(uint64(m.Available))
+	}
+	l = len(m.RestartedAt)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Generation)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if len(m.ReplicaSets) > 0 {
+		for _, e := range m.ReplicaSets {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.Experiments) > 0 {
+		for _, e := range m.Experiments {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.AnalysisRuns) > 0 {
+		for _, e := range m.AnalysisRuns {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.InitContainers) > 0 {
+		for _, e := range m.InitContainers {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.MultiCluster != nil {
+		l = m.MultiCluster.Size()
+		n += 2 + l + sovRollout(uint64(l))
+	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExperimentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRollout(uint64(m.Revision))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if len(m.ReplicaSets) > 0 {
+		for _, e := range m.ReplicaSets {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.AnalysisRuns) > 0 {
+		for _, e := range m.AnalysisRuns {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRollout(uint64(m.Revision))
+	}
+	if m.Stable {
+		n += 2
+	}
+	if m.Canary {
+		n += 2
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Preview {
+		n += 2
+	}
+	if m.Replicas != 0 {
+		n += 1 + sovRollout(uint64(m.Replicas))
+	}
+	if m.Available != 0 {
+		n += 1 + sovRollout(uint64(m.Available))
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.ScaleDownDeadline)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.Pods) > 0 {
+		for _, e := range m.Pods {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.Ping {
+		n += 2
+	}
+	if m.Pong {
+		n += 3
+	}
+	if len(m.InitContainerImages) > 0 {
+		for _, s := range m.InitContainerImages {
+			l = len(s)
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PodInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Ready)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovRollout(uint64(m.Restarts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ContainerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.MetricName)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnalysisRunSpecAndStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AnalysisRunInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRollout(uint64(m.Revision))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Successful != 0 {
+		n += 1 + sovRollout(uint64(m.Successful))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovRollout(uint64(m.Failed))
+	}
+	if m.Inconclusive != 0 {
+		n += 1 + sovRollout(uint64(m.Inconclusive))
+	}
+	if m.Error != 0 {
+		n += 1 + sovRollout(uint64(m.Error))
+	}
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.NonJobInfo) > 0 {
+		for _, e := range m.NonJobInfo {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.SpecAndStatus != nil {
+		l = m.SpecAndStatus.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NonJobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.MetricName)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.SuccessCondition)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRollout(uint64(m.Count))
+	}
+	if m.InconclusiveLimit != 0 {
+		n += 1 + sovRollout(uint64(m.InconclusiveLimit))
+	}
+	if m.FailureLimit != 0 {
+		n += 1 + sovRollout(uint64(m.FailureLimit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutHistoryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionDiffQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.FromRevision != 0 {
+		n += 1 + sovRollout(uint64(m.FromRevision))
+	}
+	if m.ToRevision != 0 {
+		n += 1 + sovRollout(uint64(m.ToRevision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovRollout(uint64(m.Revision))
+	}
+	l = len(m.PodTemplateHash)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, s := range m.Images {
+			l = len(s)
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if len(m.AnalysisRuns) > 0 {
+		for _, e := range m.AnalysisRuns {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	l = len(m.ChangeCause)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromRevision != 0 {
+		n += 1 + sovRollout(uint64(m.FromRevision))
+	}
+	if m.ToRevision != 0 {
+		n += 1 + sovRollout(uint64(m.ToRevision))
+	}
+	l = len(m.Patch)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutAuditQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RolloutAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRollout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRollout(x uint64) (n int) {
+	return sovRollout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RolloutInfoQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutInfoQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutInfoQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutInfoListQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutInfoListQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutInfoListQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetImageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndoRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndoRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreWindow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreWindow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RolloutInfo == nil {
+				m.RolloutInfo = &RolloutInfo{}
+			}
+			if err := m.RolloutInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableNamespaces = append(m.AvailableNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RolloutInfoQuery) Unmarshal(dAtA []byte) error {
+func (m *RolloutInfoList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4645,17 +7324,17 @@ func (m *RolloutInfoQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutInfoQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutInfoList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutInfoQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutInfoList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollouts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -4665,55 +7344,25 @@ func (m *RolloutInfoQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Rollouts = append(m.Rollouts, &RolloutInfo{})
+			if err := m.Rollouts[len(m.Rollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4737,7 +7386,7 @@ func (m *RolloutInfoQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutInfoListQuery) Unmarshal(dAtA []byte) error {
+func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4760,15 +7409,15 @@ func (m *RolloutInfoListQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutInfoListQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutInfoListQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutsVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4796,7 +7445,7 @@ func (m *RolloutInfoListQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.RolloutsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4820,7 +7469,7 @@ func (m *RolloutInfoListQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
+func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4843,17 +7492,17 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetImageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -4863,27 +7512,31 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollout = string(dAtA[iNdEx:postIndex])
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &v1.ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4911,11 +7564,11 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Container = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4943,11 +7596,11 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4975,11 +7628,11 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5007,62 +7660,75 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Strategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UndoRolloutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndoRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5090,13 +7756,51 @@ func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollout = string(dAtA[iNdEx:postIndex])
+			m.ActualWeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			m.Ready = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ready |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
 			}
-			m.Revision = 0
+			m.Desired = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5106,16 +7810,16 @@ func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				m.Desired |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			m.Updated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5125,78 +7829,33 @@ func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Updated |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestartRolloutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestartRolloutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5224,11 +7883,11 @@ func (m *RestartRolloutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RestartedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5256,64 +7915,13 @@ func (m *RestartRolloutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Generation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromoteRolloutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromoteRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5323,29 +7931,31 @@ func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ReplicaSets = append(m.ReplicaSets, &ReplicaSetInfo{})
+			if err := m.ReplicaSets[len(m.ReplicaSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Experiments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5355,29 +7965,31 @@ func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Experiments = append(m.Experiments, &ExperimentInfo{})
+			if err := m.Experiments[len(m.Experiments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5387,68 +7999,31 @@ func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbortRolloutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbortRolloutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbortRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AnalysisRuns = append(m.AnalysisRuns, &AnalysisRunInfo{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5458,29 +8033,31 @@ func (m *AbortRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, &ContainerInfo{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5490,80 +8067,65 @@ func (m *AbortRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
+			m.Steps = append(m.Steps, &v1alpha1.CanaryStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InitContainers = append(m.InitContainers, &ContainerInfo{})
+			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryRolloutRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCluster", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5573,29 +8135,33 @@ func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.MultiCluster == nil {
+				m.MultiCluster = &v1alpha1.MultiClusterStatus{}
+			}
+			if err := m.MultiCluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5605,23 +8171,25 @@ func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, &v1alpha1.RolloutDependencyStatus{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5645,7 +8213,7 @@ func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5668,17 +8236,17 @@ func (m *RolloutWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5688,29 +8256,33 @@ func (m *RolloutWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &v1.ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -5720,82 +8292,46 @@ func (m *RolloutWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RolloutInfo == nil {
-				m.RolloutInfo = &RolloutInfo{}
-			}
-			if err := m.RolloutInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5823,11 +8359,11 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableNamespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5855,62 +8391,11 @@ func (m *NamespaceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableNamespaces = append(m.AvailableNamespaces, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutInfoList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutInfoList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutInfoList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollouts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5937,67 +8422,16 @@ func (m *RolloutInfoList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollouts = append(m.Rollouts, &RolloutInfo{})
-			if err := m.Rollouts[len(m.Rollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicaSets = append(m.ReplicaSets, &ReplicaSetInfo{})
+			if err := m.ReplicaSets[len(m.ReplicaSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VersionInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRollout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VersionInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6007,23 +8441,25 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RolloutsVersion = string(dAtA[iNdEx:postIndex])
+			m.AnalysisRuns = append(m.AnalysisRuns, &AnalysisRunInfo{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6047,7 +8483,7 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
+func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6070,10 +8506,10 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaSetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6146,7 +8582,7 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6174,13 +8610,13 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6190,29 +8626,115 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Stable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canary = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preview = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6222,27 +8744,14 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Available |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Strategy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6270,11 +8779,11 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDeadline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6302,11 +8811,11 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SetWeight = string(dAtA[iNdEx:postIndex])
+			m.ScaleDownDeadline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6334,13 +8843,13 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActualWeight = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
 			}
-			m.Ready = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6350,54 +8859,31 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ready |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			m.Current = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Current |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Desired = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Desired |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Pods = append(m.Pods, &PodInfo{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 12:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
 			}
-			m.Updated = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6407,16 +8893,17 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Updated |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			m.Ping = bool(v != 0)
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pong", wireType)
 			}
-			m.Available = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6426,14 +8913,15 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Available |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			m.Pong = bool(v != 0)
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6461,13 +8949,64 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RestartedAt = string(dAtA[iNdEx:postIndex])
+			m.InitContainerImages = append(m.InitContainerImages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6477,29 +9016,33 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generation = string(dAtA[iNdEx:postIndex])
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &v1.ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6509,31 +9052,29 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicaSets = append(m.ReplicaSets, &ReplicaSetInfo{})
-			if err := m.ReplicaSets[len(m.ReplicaSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experiments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6543,31 +9084,29 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Experiments = append(m.Experiments, &ExperimentInfo{})
-			if err := m.Experiments[len(m.Experiments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6577,31 +9116,29 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRuns = append(m.AnalysisRuns, &AnalysisRunInfo{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ready = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
 			}
-			var msglen int
+			m.Restarts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6611,31 +9148,67 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restarts |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, &ContainerInfo{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 20:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6645,31 +9218,29 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, &v1alpha1.CanaryStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6679,25 +9250,23 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainers = append(m.InitContainers, &ContainerInfo{})
-			if err := m.InitContainers[len(m.InitContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6721,7 +9290,7 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6744,10 +9313,10 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6788,7 +9357,7 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6816,13 +9385,13 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
-			m.Revision = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6832,14 +9401,27 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Icon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6867,13 +9449,13 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -6883,27 +9465,82 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunSpecAndStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunSpecAndStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunSpecAndStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6930,14 +9567,16 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicaSets = append(m.ReplicaSets, &ReplicaSetInfo{})
-			if err := m.ReplicaSets[len(m.ReplicaSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Spec == nil {
+				m.Spec = &v1alpha1.AnalysisRunSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6964,8 +9603,10 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRuns = append(m.AnalysisRuns, &AnalysisRunInfo{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &v1alpha1.AnalysisRunStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6991,7 +9632,7 @@ func (m *ExperimentInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
+func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7014,10 +9655,10 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnalysisRunInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnalysisRunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7042,53 +9683,21 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &v1.ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &v1.ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
 			}
@@ -7120,7 +9729,7 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Icon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
@@ -7139,11 +9748,11 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7153,17 +9762,29 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stable = bool(v != 0)
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			var v int
+			m.Successful = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7173,17 +9794,16 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Successful |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Canary = bool(v != 0)
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var v int
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7193,17 +9813,16 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 8:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
 			}
-			var v int
+			m.Inconclusive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7213,17 +9832,16 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Inconclusive |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Preview = bool(v != 0)
-		case 9:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Replicas = 0
+			m.Error = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7233,16 +9851,16 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				m.Error |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			m.Available = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7252,16 +9870,31 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Available |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &JobInfo{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonJobInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7271,29 +9904,31 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.NonJobInfo = append(m.NonJobInfo, &NonJobInfo{})
+			if err := m.NonJobInfo[len(m.NonJobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDeadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7303,29 +9938,31 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScaleDownDeadline = string(dAtA[iNdEx:postIndex])
+			m.Metrics = append(m.Metrics, &Metrics{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecAndStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7335,29 +9972,84 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			if m.SpecAndStatus == nil {
+				m.SpecAndStatus = &AnalysisRunSpecAndStatus{}
+			}
+			if err := m.SpecAndStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonJobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7367,31 +10059,29 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = append(m.Pods, &PodInfo{})
-			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7401,17 +10091,29 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ping = bool(v != 0)
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pong", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7421,17 +10123,29 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Pong = bool(v != 0)
-		case 17:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7441,23 +10155,27 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerImages = append(m.InitContainerImages, string(dAtA[iNdEx:postIndex]))
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7481,7 +10199,7 @@ func (m *ReplicaSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodInfo) Unmarshal(dAtA []byte) error {
+func (m *Metrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7504,17 +10222,17 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7524,31 +10242,27 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &v1.ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7576,13 +10290,13 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7592,29 +10306,16 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
 			}
-			var stringLen uint64
+			m.InconclusiveLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7624,29 +10325,16 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InconclusiveLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ready = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
 			}
-			m.Restarts = 0
+			m.FailureLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7656,7 +10344,7 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restarts |= int32(b&0x7F) << shift
+				m.FailureLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7683,7 +10371,7 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
+func (m *RolloutHistoryQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7706,10 +10394,10 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutHistoryQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutHistoryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7746,7 +10434,7 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7774,7 +10462,7 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7798,7 +10486,7 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *RevisionDiffQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7821,51 +10509,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionDiffQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &v1.ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7893,11 +10545,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7925,13 +10577,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevision", wireType)
 			}
-			var stringLen uint64
+			m.FromRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7941,29 +10593,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRevision", wireType)
 			}
-			var msglen int
+			m.ToRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -7973,28 +10612,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -8017,7 +10639,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunSpecAndStatus) Unmarshal(dAtA []byte) error {
+func (m *RolloutHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8027,64 +10649,28 @@ func (m *AnalysisRunSpecAndStatus) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRollout
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunSpecAndStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunSpecAndStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &v1alpha1.AnalysisRunSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8111,10 +10697,8 @@ func (m *AnalysisRunSpecAndStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &v1alpha1.AnalysisRunStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revisions = append(m.Revisions, &RevisionInfo{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8140,7 +10724,7 @@ func (m *AnalysisRunSpecAndStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
+func (m *RevisionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8163,17 +10747,17 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8183,31 +10767,14 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &v1.ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8235,30 +10802,11 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8286,13 +10834,13 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Successful = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8302,35 +10850,33 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			m.Failed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Inconclusive = 0
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8340,16 +10886,29 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			m.Error = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8359,14 +10918,27 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8393,16 +10965,16 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jobs = append(m.Jobs, &JobInfo{})
-			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnalysisRuns = append(m.AnalysisRuns, &AnalysisRunInfo{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonJobInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeCause", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8412,31 +10984,80 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NonJobInfo = append(m.NonJobInfo, &NonJobInfo{})
-			if err := m.NonJobInfo[len(m.NonJobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChangeCause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRevision", wireType)
+			}
+			m.FromRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8446,31 +11067,35 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRollout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRevision", wireType)
 			}
-			m.Metrics = append(m.Metrics, &Metrics{})
-			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ToRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecAndStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8480,27 +11105,23 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRollout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecAndStatus == nil {
-				m.SpecAndStatus = &AnalysisRunSpecAndStatus{}
-			}
-			if err := m.SpecAndStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8524,7 +11145,7 @@ func (m *AnalysisRunInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
+func (m *RolloutAuditQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8547,15 +11168,15 @@ func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NonJobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAuditQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NonJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAuditQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8583,11 +11204,11 @@ func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8615,43 +11236,62 @@ func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRollout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRollout
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRollout
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8678,10 +11318,8 @@ func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &AuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8707,7 +11345,7 @@ func (m *NonJobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metrics) Unmarshal(dAtA []byte) error {
+func (m *AuditEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8730,15 +11368,51 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &v1.Time{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8766,11 +11440,11 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8798,13 +11472,13 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8814,16 +11488,29 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			m.InconclusiveLimit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8833,16 +11520,29 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InconclusiveLimit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
 			}
-			m.FailureLimit = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRollout
@@ -8852,11 +11552,56 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureLimit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
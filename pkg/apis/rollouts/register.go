@@ -43,4 +43,14 @@ const (
 	AnalysisRunSingular string = "analysisrun"
 	AnalysisRunPlural   string = "analysisruns"
 	AnalysisRunFullName string = AnalysisRunPlural + "." + Group
+
+	RolloutStrategyTemplateKind     string = "RolloutStrategyTemplate"
+	RolloutStrategyTemplateSingular string = "rolloutstrategytemplate"
+	RolloutStrategyTemplatePlural   string = "rolloutstrategytemplates"
+	RolloutStrategyTemplateFullName string = RolloutStrategyTemplatePlural + "." + Group
+
+	ClusterRolloutStrategyTemplateKind     string = "ClusterRolloutStrategyTemplate"
+	ClusterRolloutStrategyTemplateSingular string = "clusterrolloutstrategytemplate"
+	ClusterRolloutStrategyTemplatePlural   string = "clusterrolloutstrategytemplates"
+	ClusterRolloutStrategyTemplateFullName string = ClusterRolloutStrategyTemplatePlural + "." + Group
 )
@@ -121,6 +121,28 @@ type Metric struct {
 	ConsecutiveErrorLimit *intstrutil.IntOrString `json:"consecutiveErrorLimit,omitempty" protobuf:"bytes,9,opt,name=consecutiveErrorLimit"`
 	// Provider configuration to the external system to use to verify the analysis
 	Provider MetricProvider `json:"provider" protobuf:"bytes,10,opt,name=provider"`
+	// BurnRate measures the metric as the burn rate of the error budget of an SLO instead of
+	// evaluating the success and failure conditions. The query of the provider returns the error
+	// ratio over the window referenced by the `{{args.window}}` argument.
+	BurnRate *BurnRateMetric `json:"burnRate,omitempty" protobuf:"bytes,11,opt,name=burnRate"`
+}
+
+// BurnRateMetric defines a multi-window burn rate alert on the error budget of an SLO. A measurement
+// fails when both the fast burn rate, over the short window, and the slow burn rate, over the long
+// window, exceed their multipliers.
+type BurnRateMetric struct {
+	// Objective is the SLO target as a ratio of good events (e.g. 0.999)
+	Objective string `json:"objective" protobuf:"bytes,1,opt,name=objective"`
+	// ShortWindow is the window (e.g. 5m) over which the fast burn rate is measured
+	ShortWindow DurationString `json:"shortWindow" protobuf:"bytes,2,opt,name=shortWindow,casttype=DurationString"`
+	// LongWindow is the window (e.g. 1h) over which the slow burn rate is measured
+	LongWindow DurationString `json:"longWindow" protobuf:"bytes,3,opt,name=longWindow,casttype=DurationString"`
+	// FastBurnMultiplier is the fast burn rate above which a measurement can fail (default: 14.4)
+	// +optional
+	FastBurnMultiplier string `json:"fastBurnMultiplier,omitempty" protobuf:"bytes,4,opt,name=fastBurnMultiplier"`
+	// SlowBurnMultiplier is the slow burn rate above which a measurement can fail (default: 6)
+	// +optional
+	SlowBurnMultiplier string `json:"slowBurnMultiplier,omitempty" protobuf:"bytes,5,opt,name=slowBurnMultiplier"`
 }
 
 // DryRun defines the settings for running the analysis in Dry-Run mode.
@@ -189,6 +211,12 @@ type MetricProvider struct {
 	Influxdb *InfluxdbMetric `json:"influxdb,omitempty" protobuf:"bytes,10,opt,name=influxdb"`
 	// SkyWalking specifies the skywalking metric to query
 	SkyWalking *SkyWalkingMetric `json:"skywalking,omitempty" protobuf:"bytes,11,opt,name=skywalking"`
+	// Statistical compares baseline and canary series queried from other providers
+	Statistical *StatisticalMetric `json:"statistical,omitempty" protobuf:"bytes,13,opt,name=statistical"`
+	// Probe fires synthetic HTTP or gRPC health check requests at a service
+	Probe *ProbeMetric `json:"probe,omitempty" protobuf:"bytes,14,opt,name=probe"`
+	// Log queries the count of matching log lines, or an aggregation of them, from Loki or Elasticsearch
+	Log *LogMetric `json:"log,omitempty" protobuf:"bytes,15,opt,name=log"`
 	// +kubebuilder:validation:Schemaless
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Type=object
@@ -561,6 +589,64 @@ type ScopeDetail struct {
 	End    string `json:"end" protobuf:"bytes,5,opt,name=end"`
 }
 
+// StatisticalMetric defines a canary judgement which compares series returned by the baseline and canary
+// queries with a Mann-Whitney U test, scoring the comparisons like Kayenta does
+type StatisticalMetric struct {
+	// Comparisons is the list of baseline and canary series to compare
+	Comparisons []StatisticalComparison `json:"comparisons" protobuf:"bytes,1,rep,name=comparisons"`
+	// ConfidenceLevel is the confidence, in percent, required to consider a canary series different
+	// from the baseline series. Defaults to 95
+	// +optional
+	ConfidenceLevel *int64 `json:"confidenceLevel,omitempty" protobuf:"varint,2,opt,name=confidenceLevel"`
+	// Threshold is the minimum score required for the measurement to be successful or inconclusive
+	Threshold KayentaThreshold `json:"threshold" protobuf:"bytes,3,opt,name=threshold"`
+}
+
+// StatisticalDirection is the direction of a change that causes a comparison to fail
+type StatisticalDirection string
+
+const (
+	// StatisticalDirectionIncrease fails the comparison when the canary is higher than the baseline
+	StatisticalDirectionIncrease StatisticalDirection = "Increase"
+	// StatisticalDirectionDecrease fails the comparison when the canary is lower than the baseline
+	StatisticalDirectionDecrease StatisticalDirection = "Decrease"
+	// StatisticalDirectionEither fails the comparison when the canary differs from the baseline
+	StatisticalDirectionEither StatisticalDirection = "Either"
+)
+
+// StatisticalComparison defines a single comparison between a baseline and a canary series
+type StatisticalComparison struct {
+	// Name is the name of the comparison
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Baseline is the query returning the series of the baseline (control) version
+	Baseline StatisticalQuery `json:"baseline" protobuf:"bytes,2,opt,name=baseline"`
+	// Canary is the query returning the series of the canary (experiment) version
+	Canary StatisticalQuery `json:"canary" protobuf:"bytes,3,opt,name=canary"`
+	// Direction is the direction of a change which fails the comparison. Defaults to Either
+	// +kubebuilder:validation:Enum=Increase;Decrease;Either
+	// +optional
+	Direction StatisticalDirection `json:"direction,omitempty" protobuf:"bytes,4,opt,name=direction,casttype=StatisticalDirection"`
+	// Weight is the weight of the comparison in the overall score. Defaults to 1
+	// +optional
+	Weight *int64 `json:"weight,omitempty" protobuf:"varint,5,opt,name=weight"`
+}
+
+// StatisticalQuery defines the provider used to query a series. Exactly one provider must be set
+type StatisticalQuery struct {
+	// Prometheus specifies the prometheus metric to query
+	Prometheus *PrometheusMetric `json:"prometheus,omitempty" protobuf:"bytes,1,opt,name=prometheus"`
+	// Datadog specifies a datadog metric to query
+	Datadog *DatadogMetric `json:"datadog,omitempty" protobuf:"bytes,2,opt,name=datadog"`
+	// Wavefront specifies the wavefront metric to query
+	Wavefront *WavefrontMetric `json:"wavefront,omitempty" protobuf:"bytes,3,opt,name=wavefront"`
+	// NewRelic specifies the newrelic metric to query
+	NewRelic *NewRelicMetric `json:"newRelic,omitempty" protobuf:"bytes,4,opt,name=newRelic"`
+	// Graphite specifies the Graphite metric to query
+	Graphite *GraphiteMetric `json:"graphite,omitempty" protobuf:"bytes,5,opt,name=graphite"`
+	// Influxdb specifies the influxdb metric to query
+	Influxdb *InfluxdbMetric `json:"influxdb,omitempty" protobuf:"bytes,6,opt,name=influxdb"`
+}
+
 type WebMetric struct {
 	// Method is the method of the web metric (empty defaults to GET)
 	Method WebMetricMethod `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
@@ -603,6 +689,104 @@ type WebMetricHeader struct {
 	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
 }
 
+// ProbeMetric fires a number of synthetic requests at a service, and measures the ratio of successful
+// requests and their latency. The result of the measurement has the fields successRatio, p50, p95 and
+// p99 (in milliseconds) and statusCodes, e.g. `result.successRatio >= 0.99 && result.p95 < 300`
+type ProbeMetric struct {
+	// Service is the name of the service to probe. Defaults to the canary service of the rollout
+	// (the preview service of a blue-green rollout) which owns the analysis run
+	// +optional
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Port is the port of the service to probe
+	Port int32 `json:"port" protobuf:"varint,2,opt,name=port"`
+	// Requests is the number of requests fired per measurement (default: 10)
+	// +optional
+	Requests *int32 `json:"requests,omitempty" protobuf:"varint,3,opt,name=requests"`
+	// TimeoutSeconds is the timeout of each request in seconds (default: 5)
+	// +optional
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,4,opt,name=timeoutSeconds"`
+	// HTTP probes an HTTP endpoint of the service
+	// +optional
+	HTTP *HTTPProbe `json:"http,omitempty" protobuf:"bytes,5,opt,name=http"`
+	// GRPC probes the gRPC health checking service (grpc.health.v1) of the service
+	// +optional
+	GRPC *GRPCProbe `json:"grpc,omitempty" protobuf:"bytes,6,opt,name=grpc"`
+}
+
+// HTTPProbe defines the HTTP requests fired by a probe
+type HTTPProbe struct {
+	// Scheme is the scheme of the requests, HTTP or HTTPS (default: HTTP)
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Scheme string `json:"scheme,omitempty" protobuf:"bytes,1,opt,name=scheme"`
+	// Method is the method of the requests (empty defaults to GET)
+	// +optional
+	Method WebMetricMethod `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Path is the path of the requests (default: /)
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	// Headers are optional HTTP headers to use in the requests
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,4,rep,name=headers"`
+	// SuccessStatusCodes are the status codes of a successful request (default: 2xx and 3xx)
+	// +optional
+	SuccessStatusCodes []int32 `json:"successStatusCodes,omitempty" protobuf:"varint,5,rep,name=successStatusCodes"`
+	// Insecure skips host TLS verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
+}
+
+// LogMetric defines a query of the logs stored in Loki, or in Elasticsearch or OpenSearch
+type LogMetric struct {
+	// Address is the HTTP address of the Loki or Elasticsearch server
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Loki queries Loki with a LogQL metric query
+	// +optional
+	Loki *LokiQuery `json:"loki,omitempty" protobuf:"bytes,2,opt,name=loki"`
+	// Elasticsearch queries Elasticsearch or OpenSearch with a search request
+	// +optional
+	Elasticsearch *ElasticsearchQuery `json:"elasticsearch,omitempty" protobuf:"bytes,3,opt,name=elasticsearch"`
+	// Authentication details. SigV4 signs the requests to Amazon OpenSearch Service
+	// +optional
+	Authentication Authentication `json:"authentication,omitempty" protobuf:"bytes,4,opt,name=authentication"`
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	// Headers are optional HTTP headers to use in the request
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,5,rep,name=headers"`
+	// TimeoutSeconds is the timeout for the request in seconds (default: 30)
+	// +optional
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,6,opt,name=timeoutSeconds"`
+	// Insecure skips host TLS verification
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,7,opt,name=insecure"`
+}
+
+// LokiQuery defines a LogQL query
+type LokiQuery struct {
+	// Query is a LogQL metric query, e.g. sum(count_over_time({app="guestbook"} |= "error" [5m]))
+	Query string `json:"query" protobuf:"bytes,1,opt,name=query"`
+	// TenantID is the tenant of a multi-tenant Loki, sent as the X-Scope-OrgID header
+	// +optional
+	TenantID string `json:"tenantId,omitempty" protobuf:"bytes,2,opt,name=tenantId"`
+}
+
+// ElasticsearchQuery defines an Elasticsearch search request
+type ElasticsearchQuery struct {
+	// Index is the index, index pattern or data stream to search
+	Index string `json:"index" protobuf:"bytes,1,opt,name=index"`
+	// Query is the body of the search request in the query DSL, as JSON. The result is the
+	// aggregations of the response if the search has aggregations, or else the count of matching documents
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+}
+
+// GRPCProbe defines the gRPC health check requests fired by a probe
+type GRPCProbe struct {
+	// Service is the name of the service whose health is checked (empty checks the overall health of the server)
+	// +optional
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+}
+
 type DatadogMetric struct {
 	// +kubebuilder:default="5m"
 	// Interval refers to the Interval time window in Datadog (default: 5m). Not to be confused with the polling rate for the metric.
@@ -68,6 +68,48 @@ type ExperimentSpec struct {
 	// AnalysisRunMetadata labels and annotations that will be added to the AnalysisRuns
 	// +optional
 	AnalysisRunMetadata AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,9,opt,name=analysisRunMetadata"`
+	// Comparison compares the templates on the measurements of their analyses to select the winner of the experiment
+	// +optional
+	Comparison *ExperimentComparison `json:"comparison,omitempty" protobuf:"bytes,10,opt,name=comparison"`
+}
+
+// ExperimentComparison defines how the templates of an experiment are scored against each other
+type ExperimentComparison struct {
+	// Analyses are the analyses measuring the metrics of the templates, one per compared template
+	Analyses []ComparisonAnalysis `json:"analyses" protobuf:"bytes,1,rep,name=analyses"`
+	// Metrics are the metrics of the analyses the templates are scored on
+	Metrics []ComparisonMetric `json:"metrics" protobuf:"bytes,2,rep,name=metrics"`
+}
+
+// ComparisonAnalysis maps a template to the analysis of the experiment which measures it
+type ComparisonAnalysis struct {
+	// TemplateName is the name of the template
+	TemplateName string `json:"templateName" protobuf:"bytes,1,opt,name=templateName"`
+	// AnalysisName is the name of the analysis of the experiment measuring the template
+	AnalysisName string `json:"analysisName" protobuf:"bytes,2,opt,name=analysisName"`
+}
+
+// ComparisonGoal is whether the greater or the lower values of a metric are better
+type ComparisonGoal string
+
+const (
+	// ComparisonGoalMaximize scores the templates with the greater values higher
+	ComparisonGoalMaximize ComparisonGoal = "Maximize"
+	// ComparisonGoalMinimize scores the templates with the lower values higher
+	ComparisonGoalMinimize ComparisonGoal = "Minimize"
+)
+
+// ComparisonMetric defines a metric the templates are scored on
+type ComparisonMetric struct {
+	// Name is the name of the metric in the AnalysisRuns of the analyses
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Goal is whether the greater or the lower values of the metric are better. Defaults to Maximize
+	// +kubebuilder:validation:Enum=Maximize;Minimize
+	// +optional
+	Goal ComparisonGoal `json:"goal,omitempty" protobuf:"bytes,2,opt,name=goal,casttype=ComparisonGoal"`
+	// Weight is the weight of the metric in the scores of the templates. Defaults to 1
+	// +optional
+	Weight *int32 `json:"weight,omitempty" protobuf:"varint,3,opt,name=weight"`
 }
 
 type TemplateSpec struct {
@@ -143,6 +185,9 @@ type TemplateStatus struct {
 	ServiceName string `json:"serviceName,omitempty" protobuf:"bytes,10,opt,name=serviceName"`
 	// PodTemplateHash is the value of the Replicas' PodTemplateHash
 	PodTemplateHash string `json:"podTemplateHash,omitempty" protobuf:"bytes,11,opt,name=podTemplateHash"`
+	// Score is the score of the template, from 0 to 100, when the experiment compares its templates
+	// +optional
+	Score *int32 `json:"score,omitempty" protobuf:"varint,12,opt,name=score"`
 }
 
 // ExperimentStatus is the status for a Experiment resource
@@ -166,6 +211,9 @@ type ExperimentStatus struct {
 	// AnalysisRuns tracks the status of AnalysisRuns associated with this Experiment
 	// +optional
 	AnalysisRuns []ExperimentAnalysisRunStatus `json:"analysisRuns,omitempty" protobuf:"bytes,6,rep,name=analysisRuns"`
+	// Winner is the name of the template with the highest score once a comparing experiment completed successfully
+	// +optional
+	Winner string `json:"winner,omitempty" protobuf:"bytes,7,opt,name=winner"`
 }
 
 // ExperimentConditionType defines the conditions of Experiment
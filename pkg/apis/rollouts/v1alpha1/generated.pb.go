@@ -26,6 +26,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
@@ -803,10 +804,66 @@ func (m *BlueGreenStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlueGreenStrategy proto.InternalMessageInfo
 
+func (m *BlueGreenTrafficRouting) Reset()      { *m = BlueGreenTrafficRouting{} }
+func (*BlueGreenTrafficRouting) ProtoMessage() {}
+func (*BlueGreenTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{27}
+}
+func (m *BlueGreenTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlueGreenTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlueGreenTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlueGreenTrafficRouting.Merge(m, src)
+}
+func (m *BlueGreenTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlueGreenTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlueGreenTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlueGreenTrafficRouting proto.InternalMessageInfo
+
+func (m *BurnRateMetric) Reset()      { *m = BurnRateMetric{} }
+func (*BurnRateMetric) ProtoMessage() {}
+func (*BurnRateMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{28}
+}
+func (m *BurnRateMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnRateMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BurnRateMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnRateMetric.Merge(m, src)
+}
+func (m *BurnRateMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnRateMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnRateMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnRateMetric proto.InternalMessageInfo
+
 func (m *CanaryStatus) Reset()      { *m = CanaryStatus{} }
 func (*CanaryStatus) ProtoMessage() {}
 func (*CanaryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{27}
+	return fileDescriptor_e0e705f843545fab, []int{29}
 }
 func (m *CanaryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +891,7 @@ var xxx_messageInfo_CanaryStatus proto.InternalMessageInfo
 func (m *CanaryStep) Reset()      { *m = CanaryStep{} }
 func (*CanaryStep) ProtoMessage() {}
 func (*CanaryStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{28}
+	return fileDescriptor_e0e705f843545fab, []int{30}
 }
 func (m *CanaryStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +919,7 @@ var xxx_messageInfo_CanaryStep proto.InternalMessageInfo
 func (m *CanaryStrategy) Reset()      { *m = CanaryStrategy{} }
 func (*CanaryStrategy) ProtoMessage() {}
 func (*CanaryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{29}
+	return fileDescriptor_e0e705f843545fab, []int{31}
 }
 func (m *CanaryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +947,7 @@ var xxx_messageInfo_CanaryStrategy proto.InternalMessageInfo
 func (m *CloudWatchMetric) Reset()      { *m = CloudWatchMetric{} }
 func (*CloudWatchMetric) ProtoMessage() {}
 func (*CloudWatchMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{30}
+	return fileDescriptor_e0e705f843545fab, []int{32}
 }
 func (m *CloudWatchMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +975,7 @@ var xxx_messageInfo_CloudWatchMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricDataQuery) Reset()      { *m = CloudWatchMetricDataQuery{} }
 func (*CloudWatchMetricDataQuery) ProtoMessage() {}
 func (*CloudWatchMetricDataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{31}
+	return fileDescriptor_e0e705f843545fab, []int{33}
 }
 func (m *CloudWatchMetricDataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1003,7 @@ var xxx_messageInfo_CloudWatchMetricDataQuery proto.InternalMessageInfo
 func (m *CloudWatchMetricStat) Reset()      { *m = CloudWatchMetricStat{} }
 func (*CloudWatchMetricStat) ProtoMessage() {}
 func (*CloudWatchMetricStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{32}
+	return fileDescriptor_e0e705f843545fab, []int{34}
 }
 func (m *CloudWatchMetricStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1031,7 @@ var xxx_messageInfo_CloudWatchMetricStat proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetric) Reset()      { *m = CloudWatchMetricStatMetric{} }
 func (*CloudWatchMetricStatMetric) ProtoMessage() {}
 func (*CloudWatchMetricStatMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{33}
+	return fileDescriptor_e0e705f843545fab, []int{35}
 }
 func (m *CloudWatchMetricStatMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1059,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetricDimension) Reset()      { *m = CloudWatchMetricStatMetricDimension{} }
 func (*CloudWatchMetricStatMetricDimension) ProtoMessage() {}
 func (*CloudWatchMetricStatMetricDimension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{34}
+	return fileDescriptor_e0e705f843545fab, []int{36}
 }
 func (m *CloudWatchMetricStatMetricDimension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1087,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetricDimension proto.InternalMessageInf
 func (m *ClusterAnalysisTemplate) Reset()      { *m = ClusterAnalysisTemplate{} }
 func (*ClusterAnalysisTemplate) ProtoMessage() {}
 func (*ClusterAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{35}
+	return fileDescriptor_e0e705f843545fab, []int{37}
 }
 func (m *ClusterAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1115,7 @@ var xxx_messageInfo_ClusterAnalysisTemplate proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplateList) Reset()      { *m = ClusterAnalysisTemplateList{} }
 func (*ClusterAnalysisTemplateList) ProtoMessage() {}
 func (*ClusterAnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{36}
+	return fileDescriptor_e0e705f843545fab, []int{38}
 }
 func (m *ClusterAnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,10 +1140,150 @@ func (m *ClusterAnalysisTemplateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 
+func (m *ClusterRolloutStrategyTemplate) Reset()      { *m = ClusterRolloutStrategyTemplate{} }
+func (*ClusterRolloutStrategyTemplate) ProtoMessage() {}
+func (*ClusterRolloutStrategyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{39}
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutStrategyTemplate.Merge(m, src)
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutStrategyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutStrategyTemplate proto.InternalMessageInfo
+
+func (m *ClusterRolloutStrategyTemplateList) Reset()      { *m = ClusterRolloutStrategyTemplateList{} }
+func (*ClusterRolloutStrategyTemplateList) ProtoMessage() {}
+func (*ClusterRolloutStrategyTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{40}
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutStrategyTemplateList.Merge(m, src)
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutStrategyTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutStrategyTemplateList proto.InternalMessageInfo
+
+func (m *ClusterWave) Reset()      { *m = ClusterWave{} }
+func (*ClusterWave) ProtoMessage() {}
+func (*ClusterWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{41}
+}
+func (m *ClusterWave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterWave.Merge(m, src)
+}
+func (m *ClusterWave) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterWave proto.InternalMessageInfo
+
+func (m *ComparisonAnalysis) Reset()      { *m = ComparisonAnalysis{} }
+func (*ComparisonAnalysis) ProtoMessage() {}
+func (*ComparisonAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{42}
+}
+func (m *ComparisonAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComparisonAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ComparisonAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComparisonAnalysis.Merge(m, src)
+}
+func (m *ComparisonAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComparisonAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComparisonAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComparisonAnalysis proto.InternalMessageInfo
+
+func (m *ComparisonMetric) Reset()      { *m = ComparisonMetric{} }
+func (*ComparisonMetric) ProtoMessage() {}
+func (*ComparisonMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{43}
+}
+func (m *ComparisonMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComparisonMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ComparisonMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComparisonMetric.Merge(m, src)
+}
+func (m *ComparisonMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComparisonMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComparisonMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComparisonMetric proto.InternalMessageInfo
+
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{37}
+	return fileDescriptor_e0e705f843545fab, []int{44}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,10 +1308,38 @@ func (m *DatadogMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 
+func (m *DeployWindow) Reset()      { *m = DeployWindow{} }
+func (*DeployWindow) ProtoMessage() {}
+func (*DeployWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{45}
+}
+func (m *DeployWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeployWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeployWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployWindow.Merge(m, src)
+}
+func (m *DeployWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeployWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployWindow proto.InternalMessageInfo
+
 func (m *DryRun) Reset()      { *m = DryRun{} }
 func (*DryRun) ProtoMessage() {}
 func (*DryRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{46}
 }
 func (m *DryRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1139,10 +1364,38 @@ func (m *DryRun) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DryRun proto.InternalMessageInfo
 
+func (m *ElasticsearchQuery) Reset()      { *m = ElasticsearchQuery{} }
+func (*ElasticsearchQuery) ProtoMessage() {}
+func (*ElasticsearchQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{47}
+}
+func (m *ElasticsearchQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElasticsearchQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ElasticsearchQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElasticsearchQuery.Merge(m, src)
+}
+func (m *ElasticsearchQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElasticsearchQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElasticsearchQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElasticsearchQuery proto.InternalMessageInfo
+
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1423,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1451,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1223,10 +1476,38 @@ func (m *ExperimentAnalysisTemplateRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 
+func (m *ExperimentComparison) Reset()      { *m = ExperimentComparison{} }
+func (*ExperimentComparison) ProtoMessage() {}
+func (*ExperimentComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{51}
+}
+func (m *ExperimentComparison) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExperimentComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExperimentComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExperimentComparison.Merge(m, src)
+}
+func (m *ExperimentComparison) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExperimentComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExperimentComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExperimentComparison proto.InternalMessageInfo
+
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1535,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1563,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1591,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1619,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{56}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1363,10 +1644,66 @@ func (m *FieldRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 
+func (m *GRPCProbe) Reset()      { *m = GRPCProbe{} }
+func (*GRPCProbe) ProtoMessage() {}
+func (*GRPCProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{57}
+}
+func (m *GRPCProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProbe.Merge(m, src)
+}
+func (m *GRPCProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProbe proto.InternalMessageInfo
+
+func (m *GatewayAPITrafficRouting) Reset()      { *m = GatewayAPITrafficRouting{} }
+func (*GatewayAPITrafficRouting) ProtoMessage() {}
+func (*GatewayAPITrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{58}
+}
+func (m *GatewayAPITrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAPITrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GatewayAPITrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAPITrafficRouting.Merge(m, src)
+}
+func (m *GatewayAPITrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAPITrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAPITrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAPITrafficRouting proto.InternalMessageInfo
+
 func (m *GraphiteMetric) Reset()      { *m = GraphiteMetric{} }
 func (*GraphiteMetric) ProtoMessage() {}
 func (*GraphiteMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{59}
 }
 func (m *GraphiteMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,10 +1728,38 @@ func (m *GraphiteMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GraphiteMetric proto.InternalMessageInfo
 
+func (m *HTTPProbe) Reset()      { *m = HTTPProbe{} }
+func (*HTTPProbe) ProtoMessage() {}
+func (*HTTPProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{60}
+}
+func (m *HTTPProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPProbe.Merge(m, src)
+}
+func (m *HTTPProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPProbe proto.InternalMessageInfo
+
 func (m *HeaderRoutingMatch) Reset()      { *m = HeaderRoutingMatch{} }
 func (*HeaderRoutingMatch) ProtoMessage() {}
 func (*HeaderRoutingMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+	return fileDescriptor_e0e705f843545fab, []int{61}
 }
 func (m *HeaderRoutingMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1787,7 @@ var xxx_messageInfo_HeaderRoutingMatch proto.InternalMessageInfo
 func (m *InfluxdbMetric) Reset()      { *m = InfluxdbMetric{} }
 func (*InfluxdbMetric) ProtoMessage() {}
 func (*InfluxdbMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+	return fileDescriptor_e0e705f843545fab, []int{62}
 }
 func (m *InfluxdbMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1815,7 @@ var xxx_messageInfo_InfluxdbMetric proto.InternalMessageInfo
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,7 +1843,7 @@ var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+	return fileDescriptor_e0e705f843545fab, []int{64}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1871,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{65}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1899,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{66}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1927,7 @@ var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{67}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1955,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{68}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1983,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{69}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,10 +2008,66 @@ func (m *KayentaThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 
+func (m *LogMetric) Reset()      { *m = LogMetric{} }
+func (*LogMetric) ProtoMessage() {}
+func (*LogMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{70}
+}
+func (m *LogMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogMetric.Merge(m, src)
+}
+func (m *LogMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogMetric proto.InternalMessageInfo
+
+func (m *LokiQuery) Reset()      { *m = LokiQuery{} }
+func (*LokiQuery) ProtoMessage() {}
+func (*LokiQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{71}
+}
+func (m *LokiQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LokiQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LokiQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LokiQuery.Merge(m, src)
+}
+func (m *LokiQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *LokiQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_LokiQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LokiQuery proto.InternalMessageInfo
+
 func (m *MangedRoutes) Reset()      { *m = MangedRoutes{} }
 func (*MangedRoutes) ProtoMessage() {}
 func (*MangedRoutes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+	return fileDescriptor_e0e705f843545fab, []int{72}
 }
 func (m *MangedRoutes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +2095,7 @@ var xxx_messageInfo_MangedRoutes proto.InternalMessageInfo
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+	return fileDescriptor_e0e705f843545fab, []int{73}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2123,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *MeasurementRetention) Reset()      { *m = MeasurementRetention{} }
 func (*MeasurementRetention) ProtoMessage() {}
 func (*MeasurementRetention) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+	return fileDescriptor_e0e705f843545fab, []int{74}
 }
 func (m *MeasurementRetention) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1727,10 +2148,38 @@ func (m *MeasurementRetention) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MeasurementRetention proto.InternalMessageInfo
 
+func (m *MemberClusterStatus) Reset()      { *m = MemberClusterStatus{} }
+func (*MemberClusterStatus) ProtoMessage() {}
+func (*MemberClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{75}
+}
+func (m *MemberClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MemberClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberClusterStatus.Merge(m, src)
+}
+func (m *MemberClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberClusterStatus proto.InternalMessageInfo
+
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+	return fileDescriptor_e0e705f843545fab, []int{76}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2207,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+	return fileDescriptor_e0e705f843545fab, []int{77}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2235,7 @@ var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+	return fileDescriptor_e0e705f843545fab, []int{78}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1811,10 +2260,66 @@ func (m *MetricResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 
+func (m *MultiClusterPromotion) Reset()      { *m = MultiClusterPromotion{} }
+func (*MultiClusterPromotion) ProtoMessage() {}
+func (*MultiClusterPromotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{79}
+}
+func (m *MultiClusterPromotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiClusterPromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultiClusterPromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiClusterPromotion.Merge(m, src)
+}
+func (m *MultiClusterPromotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiClusterPromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiClusterPromotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiClusterPromotion proto.InternalMessageInfo
+
+func (m *MultiClusterStatus) Reset()      { *m = MultiClusterStatus{} }
+func (*MultiClusterStatus) ProtoMessage() {}
+func (*MultiClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{80}
+}
+func (m *MultiClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultiClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiClusterStatus.Merge(m, src)
+}
+func (m *MultiClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiClusterStatus proto.InternalMessageInfo
+
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{81}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,7 +2347,7 @@ var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{82}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1870,7 +2375,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *OAuth2Config) Reset()      { *m = OAuth2Config{} }
 func (*OAuth2Config) ProtoMessage() {}
 func (*OAuth2Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+	return fileDescriptor_e0e705f843545fab, []int{83}
 }
 func (m *OAuth2Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,7 +2403,7 @@ var xxx_messageInfo_OAuth2Config proto.InternalMessageInfo
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{84}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1926,7 +2431,7 @@ var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{85}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1954,7 +2459,7 @@ var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 func (m *PingPongSpec) Reset()      { *m = PingPongSpec{} }
 func (*PingPongSpec) ProtoMessage() {}
 func (*PingPongSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{86}
 }
 func (m *PingPongSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1982,7 +2487,7 @@ var xxx_messageInfo_PingPongSpec proto.InternalMessageInfo
 func (m *PluginStep) Reset()      { *m = PluginStep{} }
 func (*PluginStep) ProtoMessage() {}
 func (*PluginStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{87}
 }
 func (m *PluginStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2515,7 @@ var xxx_messageInfo_PluginStep proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2040,7 +2545,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2065,10 +2570,38 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.InternalMessageInfo
 
+func (m *ProbeMetric) Reset()      { *m = ProbeMetric{} }
+func (*ProbeMetric) ProtoMessage() {}
+func (*ProbeMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{90}
+}
+func (m *ProbeMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProbeMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeMetric.Merge(m, src)
+}
+func (m *ProbeMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeMetric proto.InternalMessageInfo
+
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{91}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2096,7 +2629,7 @@ var xxx_messageInfo_PrometheusMetric proto.InternalMessageInfo
 func (m *PrometheusRangeQueryArgs) Reset()      { *m = PrometheusRangeQueryArgs{} }
 func (*PrometheusRangeQueryArgs) ProtoMessage() {}
 func (*PrometheusRangeQueryArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{73}
+	return fileDescriptor_e0e705f843545fab, []int{92}
 }
 func (m *PrometheusRangeQueryArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2126,7 +2659,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{74}
+	return fileDescriptor_e0e705f843545fab, []int{93}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2154,7 +2687,7 @@ var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.Interna
 func (m *RollbackWindowSpec) Reset()      { *m = RollbackWindowSpec{} }
 func (*RollbackWindowSpec) ProtoMessage() {}
 func (*RollbackWindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{75}
+	return fileDescriptor_e0e705f843545fab, []int{94}
 }
 func (m *RollbackWindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2715,7 @@ var xxx_messageInfo_RollbackWindowSpec proto.InternalMessageInfo
 func (m *Rollout) Reset()      { *m = Rollout{} }
 func (*Rollout) ProtoMessage() {}
 func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{76}
+	return fileDescriptor_e0e705f843545fab, []int{95}
 }
 func (m *Rollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2743,7 @@ var xxx_messageInfo_Rollout proto.InternalMessageInfo
 func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
 func (*RolloutAnalysis) ProtoMessage() {}
 func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{77}
+	return fileDescriptor_e0e705f843545fab, []int{96}
 }
 func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2238,7 +2771,7 @@ var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
 func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
 func (*RolloutAnalysisBackground) ProtoMessage() {}
 func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{78}
+	return fileDescriptor_e0e705f843545fab, []int{97}
 }
 func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2799,7 @@ var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
 func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
 func (*RolloutAnalysisRunStatus) ProtoMessage() {}
 func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{79}
+	return fileDescriptor_e0e705f843545fab, []int{98}
 }
 func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2827,7 @@ var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{80}
+	return fileDescriptor_e0e705f843545fab, []int{99}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2319,10 +2852,66 @@ func (m *RolloutCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 
+func (m *RolloutDependency) Reset()      { *m = RolloutDependency{} }
+func (*RolloutDependency) ProtoMessage() {}
+func (*RolloutDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{100}
+}
+func (m *RolloutDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutDependency.Merge(m, src)
+}
+func (m *RolloutDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutDependency proto.InternalMessageInfo
+
+func (m *RolloutDependencyStatus) Reset()      { *m = RolloutDependencyStatus{} }
+func (*RolloutDependencyStatus) ProtoMessage() {}
+func (*RolloutDependencyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{101}
+}
+func (m *RolloutDependencyStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutDependencyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutDependencyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutDependencyStatus.Merge(m, src)
+}
+func (m *RolloutDependencyStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutDependencyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutDependencyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutDependencyStatus proto.InternalMessageInfo
+
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{81}
+	return fileDescriptor_e0e705f843545fab, []int{102}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2941,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{82}
+	return fileDescriptor_e0e705f843545fab, []int{103}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2969,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{83}
+	return fileDescriptor_e0e705f843545fab, []int{104}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2408,7 +2997,7 @@ var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 func (m *RolloutList) Reset()      { *m = RolloutList{} }
 func (*RolloutList) ProtoMessage() {}
 func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{84}
+	return fileDescriptor_e0e705f843545fab, []int{105}
 }
 func (m *RolloutList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +3025,7 @@ var xxx_messageInfo_RolloutList proto.InternalMessageInfo
 func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
 func (*RolloutPause) ProtoMessage() {}
 func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{85}
+	return fileDescriptor_e0e705f843545fab, []int{106}
 }
 func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,10 +3050,38 @@ func (m *RolloutPause) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
 
+func (m *RolloutShadowStep) Reset()      { *m = RolloutShadowStep{} }
+func (*RolloutShadowStep) ProtoMessage() {}
+func (*RolloutShadowStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{107}
+}
+func (m *RolloutShadowStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutShadowStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutShadowStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutShadowStep.Merge(m, src)
+}
+func (m *RolloutShadowStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutShadowStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutShadowStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutShadowStep proto.InternalMessageInfo
+
 func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
 func (*RolloutSpec) ProtoMessage() {}
 func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{86}
+	return fileDescriptor_e0e705f843545fab, []int{108}
 }
 func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +3109,7 @@ var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
 func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
 func (*RolloutStatus) ProtoMessage() {}
 func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{87}
+	return fileDescriptor_e0e705f843545fab, []int{109}
 }
 func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +3137,7 @@ var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
 func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
 func (*RolloutStrategy) ProtoMessage() {}
 func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{88}
+	return fileDescriptor_e0e705f843545fab, []int{110}
 }
 func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,10 +3162,122 @@ func (m *RolloutStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
 
+func (m *RolloutStrategyRef) Reset()      { *m = RolloutStrategyRef{} }
+func (*RolloutStrategyRef) ProtoMessage() {}
+func (*RolloutStrategyRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{111}
+}
+func (m *RolloutStrategyRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyRef.Merge(m, src)
+}
+func (m *RolloutStrategyRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyRef proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplate) Reset()      { *m = RolloutStrategyTemplate{} }
+func (*RolloutStrategyTemplate) ProtoMessage() {}
+func (*RolloutStrategyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{112}
+}
+func (m *RolloutStrategyTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplate.Merge(m, src)
+}
+func (m *RolloutStrategyTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplate proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplateList) Reset()      { *m = RolloutStrategyTemplateList{} }
+func (*RolloutStrategyTemplateList) ProtoMessage() {}
+func (*RolloutStrategyTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{113}
+}
+func (m *RolloutStrategyTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplateList.Merge(m, src)
+}
+func (m *RolloutStrategyTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplateList proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplateSpec) Reset()      { *m = RolloutStrategyTemplateSpec{} }
+func (*RolloutStrategyTemplateSpec) ProtoMessage() {}
+func (*RolloutStrategyTemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{114}
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplateSpec.Merge(m, src)
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplateSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplateSpec proto.InternalMessageInfo
+
 func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
 func (*RolloutTrafficRouting) ProtoMessage() {}
 func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{89}
+	return fileDescriptor_e0e705f843545fab, []int{115}
 }
 func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +3305,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *RouteMatch) Reset()      { *m = RouteMatch{} }
 func (*RouteMatch) ProtoMessage() {}
 func (*RouteMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{90}
+	return fileDescriptor_e0e705f843545fab, []int{116}
 }
 func (m *RouteMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +3333,7 @@ var xxx_messageInfo_RouteMatch proto.InternalMessageInfo
 func (m *RunSummary) Reset()      { *m = RunSummary{} }
 func (*RunSummary) ProtoMessage() {}
 func (*RunSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{91}
+	return fileDescriptor_e0e705f843545fab, []int{117}
 }
 func (m *RunSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +3361,7 @@ var xxx_messageInfo_RunSummary proto.InternalMessageInfo
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{92}
+	return fileDescriptor_e0e705f843545fab, []int{118}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +3389,7 @@ var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{93}
+	return fileDescriptor_e0e705f843545fab, []int{119}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +3417,7 @@ var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{94}
+	return fileDescriptor_e0e705f843545fab, []int{120}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +3445,7 @@ var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{95}
+	return fileDescriptor_e0e705f843545fab, []int{121}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2744,7 +3473,7 @@ var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 func (m *SetHeaderRoute) Reset()      { *m = SetHeaderRoute{} }
 func (*SetHeaderRoute) ProtoMessage() {}
 func (*SetHeaderRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{96}
+	return fileDescriptor_e0e705f843545fab, []int{122}
 }
 func (m *SetHeaderRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +3501,7 @@ var xxx_messageInfo_SetHeaderRoute proto.InternalMessageInfo
 func (m *SetMirrorRoute) Reset()      { *m = SetMirrorRoute{} }
 func (*SetMirrorRoute) ProtoMessage() {}
 func (*SetMirrorRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{97}
+	return fileDescriptor_e0e705f843545fab, []int{123}
 }
 func (m *SetMirrorRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +3529,7 @@ var xxx_messageInfo_SetMirrorRoute proto.InternalMessageInfo
 func (m *Sigv4Config) Reset()      { *m = Sigv4Config{} }
 func (*Sigv4Config) ProtoMessage() {}
 func (*Sigv4Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{98}
+	return fileDescriptor_e0e705f843545fab, []int{124}
 }
 func (m *Sigv4Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +3557,7 @@ var xxx_messageInfo_Sigv4Config proto.InternalMessageInfo
 func (m *SkyWalkingMetric) Reset()      { *m = SkyWalkingMetric{} }
 func (*SkyWalkingMetric) ProtoMessage() {}
 func (*SkyWalkingMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{99}
+	return fileDescriptor_e0e705f843545fab, []int{125}
 }
 func (m *SkyWalkingMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,10 +3582,122 @@ func (m *SkyWalkingMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SkyWalkingMetric proto.InternalMessageInfo
 
+func (m *StatisticalComparison) Reset()      { *m = StatisticalComparison{} }
+func (*StatisticalComparison) ProtoMessage() {}
+func (*StatisticalComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{126}
+}
+func (m *StatisticalComparison) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatisticalComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatisticalComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticalComparison.Merge(m, src)
+}
+func (m *StatisticalComparison) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatisticalComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticalComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticalComparison proto.InternalMessageInfo
+
+func (m *StatisticalMetric) Reset()      { *m = StatisticalMetric{} }
+func (*StatisticalMetric) ProtoMessage() {}
+func (*StatisticalMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{127}
+}
+func (m *StatisticalMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatisticalMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatisticalMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticalMetric.Merge(m, src)
+}
+func (m *StatisticalMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatisticalMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticalMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticalMetric proto.InternalMessageInfo
+
+func (m *StatisticalQuery) Reset()      { *m = StatisticalQuery{} }
+func (*StatisticalQuery) ProtoMessage() {}
+func (*StatisticalQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{128}
+}
+func (m *StatisticalQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatisticalQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StatisticalQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticalQuery.Merge(m, src)
+}
+func (m *StatisticalQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatisticalQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticalQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticalQuery proto.InternalMessageInfo
+
+func (m *StepBranch) Reset()      { *m = StepBranch{} }
+func (*StepBranch) ProtoMessage() {}
+func (*StepBranch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{129}
+}
+func (m *StepBranch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepBranch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepBranch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepBranch.Merge(m, src)
+}
+func (m *StepBranch) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepBranch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepBranch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepBranch proto.InternalMessageInfo
+
 func (m *StepPluginStatus) Reset()      { *m = StepPluginStatus{} }
 func (*StepPluginStatus) ProtoMessage() {}
 func (*StepPluginStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{100}
+	return fileDescriptor_e0e705f843545fab, []int{130}
 }
 func (m *StepPluginStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,10 +3722,66 @@ func (m *StepPluginStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StepPluginStatus proto.InternalMessageInfo
 
+func (m *StepTimeout) Reset()      { *m = StepTimeout{} }
+func (*StepTimeout) ProtoMessage() {}
+func (*StepTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{131}
+}
+func (m *StepTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepTimeout.Merge(m, src)
+}
+func (m *StepTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepTimeout proto.InternalMessageInfo
+
+func (m *StepTimeoutStatus) Reset()      { *m = StepTimeoutStatus{} }
+func (*StepTimeoutStatus) ProtoMessage() {}
+func (*StepTimeoutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{132}
+}
+func (m *StepTimeoutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepTimeoutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepTimeoutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepTimeoutStatus.Merge(m, src)
+}
+func (m *StepTimeoutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepTimeoutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepTimeoutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepTimeoutStatus proto.InternalMessageInfo
+
 func (m *StickinessConfig) Reset()      { *m = StickinessConfig{} }
 func (*StickinessConfig) ProtoMessage() {}
 func (*StickinessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{101}
+	return fileDescriptor_e0e705f843545fab, []int{133}
 }
 func (m *StickinessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,10 +3806,38 @@ func (m *StickinessConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StickinessConfig proto.InternalMessageInfo
 
+func (m *StickinessCookie) Reset()      { *m = StickinessCookie{} }
+func (*StickinessCookie) ProtoMessage() {}
+func (*StickinessCookie) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{134}
+}
+func (m *StickinessCookie) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StickinessCookie) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StickinessCookie) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickinessCookie.Merge(m, src)
+}
+func (m *StickinessCookie) XXX_Size() int {
+	return m.Size()
+}
+func (m *StickinessCookie) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickinessCookie.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StickinessCookie proto.InternalMessageInfo
+
 func (m *StringMatch) Reset()      { *m = StringMatch{} }
 func (*StringMatch) ProtoMessage() {}
 func (*StringMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{102}
+	return fileDescriptor_e0e705f843545fab, []int{135}
 }
 func (m *StringMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3865,7 @@ var xxx_messageInfo_StringMatch proto.InternalMessageInfo
 func (m *TCPRoute) Reset()      { *m = TCPRoute{} }
 func (*TCPRoute) ProtoMessage() {}
 func (*TCPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{103}
+	return fileDescriptor_e0e705f843545fab, []int{136}
 }
 func (m *TCPRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3893,7 @@ var xxx_messageInfo_TCPRoute proto.InternalMessageInfo
 func (m *TLSRoute) Reset()      { *m = TLSRoute{} }
 func (*TLSRoute) ProtoMessage() {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{104}
+	return fileDescriptor_e0e705f843545fab, []int{137}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3921,7 @@ var xxx_messageInfo_TLSRoute proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{105}
+	return fileDescriptor_e0e705f843545fab, []int{138}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3021,10 +3946,38 @@ func (m *TTLStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 
+func (m *TemplateContainer) Reset()      { *m = TemplateContainer{} }
+func (*TemplateContainer) ProtoMessage() {}
+func (*TemplateContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{139}
+}
+func (m *TemplateContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateContainer.Merge(m, src)
+}
+func (m *TemplateContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateContainer proto.InternalMessageInfo
+
 func (m *TemplateService) Reset()      { *m = TemplateService{} }
 func (*TemplateService) ProtoMessage() {}
 func (*TemplateService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{106}
+	return fileDescriptor_e0e705f843545fab, []int{140}
 }
 func (m *TemplateService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +4005,7 @@ var xxx_messageInfo_TemplateService proto.InternalMessageInfo
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{107}
+	return fileDescriptor_e0e705f843545fab, []int{141}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +4033,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{108}
+	return fileDescriptor_e0e705f843545fab, []int{142}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +4061,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *TraefikTrafficRouting) Reset()      { *m = TraefikTrafficRouting{} }
 func (*TraefikTrafficRouting) ProtoMessage() {}
 func (*TraefikTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{109}
+	return fileDescriptor_e0e705f843545fab, []int{143}
 }
 func (m *TraefikTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3133,10 +4086,66 @@ func (m *TraefikTrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TraefikTrafficRouting proto.InternalMessageInfo
 
+func (m *TrafficRoutingChange) Reset()      { *m = TrafficRoutingChange{} }
+func (*TrafficRoutingChange) ProtoMessage() {}
+func (*TrafficRoutingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{144}
+}
+func (m *TrafficRoutingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficRoutingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficRoutingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoutingChange.Merge(m, src)
+}
+func (m *TrafficRoutingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficRoutingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoutingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoutingChange proto.InternalMessageInfo
+
+func (m *TrafficStickiness) Reset()      { *m = TrafficStickiness{} }
+func (*TrafficStickiness) ProtoMessage() {}
+func (*TrafficStickiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{145}
+}
+func (m *TrafficStickiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficStickiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficStickiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficStickiness.Merge(m, src)
+}
+func (m *TrafficStickiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficStickiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficStickiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficStickiness proto.InternalMessageInfo
+
 func (m *TrafficWeights) Reset()      { *m = TrafficWeights{} }
 func (*TrafficWeights) ProtoMessage() {}
 func (*TrafficWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{110}
+	return fileDescriptor_e0e705f843545fab, []int{146}
 }
 func (m *TrafficWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +4173,7 @@ var xxx_messageInfo_TrafficWeights proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{111}
+	return fileDescriptor_e0e705f843545fab, []int{147}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +4201,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{112}
+	return fileDescriptor_e0e705f843545fab, []int{148}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +4229,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{113}
+	return fileDescriptor_e0e705f843545fab, []int{149}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +4257,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{114}
+	return fileDescriptor_e0e705f843545fab, []int{150}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +4285,7 @@ var xxx_messageInfo_WebMetricHeader proto.InternalMessageInfo
 func (m *WeightDestination) Reset()      { *m = WeightDestination{} }
 func (*WeightDestination) ProtoMessage() {}
 func (*WeightDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{115}
+	return fileDescriptor_e0e705f843545fab, []int{151}
 }
 func (m *WeightDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3331,6 +4340,8 @@ func init() {
 	proto.RegisterType((*AwsResourceRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AwsResourceRef")
 	proto.RegisterType((*BlueGreenStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStatus")
 	proto.RegisterType((*BlueGreenStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStrategy")
+	proto.RegisterType((*BlueGreenTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenTrafficRouting")
+	proto.RegisterType((*BurnRateMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BurnRateMetric")
 	proto.RegisterType((*CanaryStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStatus")
 	proto.RegisterType((*CanaryStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStep")
 	proto.RegisterType((*CanaryStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStrategy")
@@ -3341,18 +4352,29 @@ func init() {
 	proto.RegisterType((*CloudWatchMetricStatMetricDimension)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetricStatMetricDimension")
 	proto.RegisterType((*ClusterAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplate")
 	proto.RegisterType((*ClusterAnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplateList")
+	proto.RegisterType((*ClusterRolloutStrategyTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutStrategyTemplate")
+	proto.RegisterType((*ClusterRolloutStrategyTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutStrategyTemplateList")
+	proto.RegisterType((*ClusterWave)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterWave")
+	proto.RegisterType((*ComparisonAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ComparisonAnalysis")
+	proto.RegisterType((*ComparisonMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ComparisonMetric")
 	proto.RegisterType((*DatadogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric.QueriesEntry")
+	proto.RegisterType((*DeployWindow)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DeployWindow")
 	proto.RegisterType((*DryRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DryRun")
+	proto.RegisterType((*ElasticsearchQuery)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ElasticsearchQuery")
 	proto.RegisterType((*Experiment)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Experiment")
 	proto.RegisterType((*ExperimentAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisRunStatus")
 	proto.RegisterType((*ExperimentAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisTemplateRef")
+	proto.RegisterType((*ExperimentComparison)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentComparison")
 	proto.RegisterType((*ExperimentCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentCondition")
 	proto.RegisterType((*ExperimentList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentList")
 	proto.RegisterType((*ExperimentSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentSpec")
 	proto.RegisterType((*ExperimentStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentStatus")
 	proto.RegisterType((*FieldRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FieldRef")
+	proto.RegisterType((*GRPCProbe)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GRPCProbe")
+	proto.RegisterType((*GatewayAPITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GatewayAPITrafficRouting")
 	proto.RegisterType((*GraphiteMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GraphiteMetric")
+	proto.RegisterType((*HTTPProbe)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.HTTPProbe")
 	proto.RegisterType((*HeaderRoutingMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.HeaderRoutingMatch")
 	proto.RegisterType((*InfluxdbMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InfluxdbMetric")
 	proto.RegisterType((*IstioDestinationRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioDestinationRule")
@@ -3362,15 +4384,20 @@ func init() {
 	proto.RegisterType((*KayentaMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaMetric")
 	proto.RegisterType((*KayentaScope)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaScope")
 	proto.RegisterType((*KayentaThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaThreshold")
+	proto.RegisterType((*LogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.LogMetric")
+	proto.RegisterType((*LokiQuery)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.LokiQuery")
 	proto.RegisterType((*MangedRoutes)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MangedRoutes")
 	proto.RegisterType((*Measurement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement.MetadataEntry")
 	proto.RegisterType((*MeasurementRetention)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MeasurementRetention")
+	proto.RegisterType((*MemberClusterStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MemberClusterStatus")
 	proto.RegisterType((*Metric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Metric")
 	proto.RegisterType((*MetricProvider)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProvider")
 	proto.RegisterMapType((map[string]encoding_json.RawMessage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProvider.PluginEntry")
 	proto.RegisterType((*MetricResult)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult.MetadataEntry")
+	proto.RegisterType((*MultiClusterPromotion)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MultiClusterPromotion")
+	proto.RegisterType((*MultiClusterStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MultiClusterStatus")
 	proto.RegisterType((*NewRelicMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NewRelicMetric")
 	proto.RegisterType((*NginxTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.AdditionalIngressAnnotationsEntry")
@@ -3384,6 +4411,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.LabelsEntry")
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
+	proto.RegisterType((*ProbeMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ProbeMetric")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
 	proto.RegisterType((*PrometheusRangeQueryArgs)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusRangeQueryArgs")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
@@ -3393,14 +4421,21 @@ func init() {
 	proto.RegisterType((*RolloutAnalysisBackground)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisBackground")
 	proto.RegisterType((*RolloutAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisRunStatus")
 	proto.RegisterType((*RolloutCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCondition")
+	proto.RegisterType((*RolloutDependency)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutDependency")
+	proto.RegisterType((*RolloutDependencyStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutDependencyStatus")
 	proto.RegisterType((*RolloutExperimentStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStep")
 	proto.RegisterType((*RolloutExperimentStepAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStepAnalysisTemplateRef")
 	proto.RegisterType((*RolloutExperimentTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentTemplate")
 	proto.RegisterType((*RolloutList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutList")
 	proto.RegisterType((*RolloutPause)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPause")
+	proto.RegisterType((*RolloutShadowStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutShadowStep")
 	proto.RegisterType((*RolloutSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSpec")
 	proto.RegisterType((*RolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStatus")
 	proto.RegisterType((*RolloutStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategy")
+	proto.RegisterType((*RolloutStrategyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyRef")
+	proto.RegisterType((*RolloutStrategyTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplate")
+	proto.RegisterType((*RolloutStrategyTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplateList")
+	proto.RegisterType((*RolloutStrategyTemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplateSpec")
 	proto.RegisterType((*RolloutTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTrafficRouting")
 	proto.RegisterMapType((map[string]encoding_json.RawMessage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTrafficRouting.PluginsEntry")
 	proto.RegisterType((*RouteMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RouteMatch")
@@ -3414,16 +4449,26 @@ func init() {
 	proto.RegisterType((*SetMirrorRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetMirrorRoute")
 	proto.RegisterType((*Sigv4Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Sigv4Config")
 	proto.RegisterType((*SkyWalkingMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SkyWalkingMetric")
+	proto.RegisterType((*StatisticalComparison)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StatisticalComparison")
+	proto.RegisterType((*StatisticalMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StatisticalMetric")
+	proto.RegisterType((*StatisticalQuery)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StatisticalQuery")
+	proto.RegisterType((*StepBranch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepBranch")
 	proto.RegisterType((*StepPluginStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepPluginStatus")
+	proto.RegisterType((*StepTimeout)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepTimeout")
+	proto.RegisterType((*StepTimeoutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepTimeoutStatus")
 	proto.RegisterType((*StickinessConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StickinessConfig")
+	proto.RegisterType((*StickinessCookie)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StickinessCookie")
 	proto.RegisterType((*StringMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StringMatch")
 	proto.RegisterType((*TCPRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TCPRoute")
 	proto.RegisterType((*TLSRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TLSRoute")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TTLStrategy")
+	proto.RegisterType((*TemplateContainer)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateContainer")
 	proto.RegisterType((*TemplateService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateService")
 	proto.RegisterType((*TemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateSpec")
 	proto.RegisterType((*TemplateStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateStatus")
 	proto.RegisterType((*TraefikTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TraefikTrafficRouting")
+	proto.RegisterType((*TrafficRoutingChange)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficRoutingChange")
+	proto.RegisterType((*TrafficStickiness)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficStickiness")
 	proto.RegisterType((*TrafficWeights)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficWeights")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ValueFrom")
 	proto.RegisterType((*WavefrontMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WavefrontMetric")
@@ -3437,565 +4482,707 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 8922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x64, 0xd7,
-	0x75, 0x98, 0x1e, 0x87, 0x43, 0xce, 0x9c, 0xe1, 0x92, 0xdc, 0xbb, 0xbb, 0x12, 0x45, 0x69, 0x97,
-	0xeb, 0xa7, 0x54, 0x5d, 0xc5, 0x32, 0x69, 0xaf, 0xa4, 0x54, 0xb6, 0x5c, 0xb5, 0x33, 0xe4, 0xae,
-	0x96, 0x2b, 0x72, 0x97, 0x3a, 0xc3, 0xd5, 0xc6, 0x1f, 0x4a, 0xfc, 0x38, 0x73, 0x39, 0x7c, 0xcb,
-	0x99, 0xf7, 0xc6, 0xef, 0xbd, 0xe1, 0x2e, 0x65, 0x21, 0x96, 0x6d, 0x28, 0x76, 0x5c, 0x1b, 0x71,
-	0x93, 0x18, 0x45, 0x3f, 0x50, 0xb8, 0x41, 0x8a, 0xb4, 0x4d, 0x7f, 0x14, 0x81, 0x8b, 0xf6, 0x47,
-	0x80, 0x16, 0x75, 0x53, 0xd8, 0x40, 0x5d, 0x38, 0x3f, 0x5a, 0xa7, 0x05, 0xc2, 0xd4, 0x4c, 0xff,
-	0x34, 0x68, 0x61, 0xa4, 0x70, 0x11, 0x54, 0x3f, 0x8a, 0xe2, 0x7e, 0xbe, 0xfb, 0xde, 0xbc, 0xe1,
-	0xd7, 0x3c, 0xae, 0x94, 0x26, 0xff, 0x66, 0xee, 0x39, 0xf7, 0x9c, 0xf3, 0xee, 0xe7, 0xb9, 0xe7,
-	0x9e, 0x73, 0x2e, 0xac, 0xb4, 0xdc, 0x68, 0xab, 0xb7, 0x31, 0xdf, 0xf0, 0x3b, 0x0b, 0x4e, 0xd0,
-	0xf2, 0xbb, 0x81, 0x7f, 0x8f, 0xff, 0xf8, 0x50, 0xe0, 0xb7, 0xdb, 0x7e, 0x2f, 0x0a, 0x17, 0xba,
-	0xdb, 0xad, 0x05, 0xa7, 0xeb, 0x86, 0x0b, 0xba, 0x64, 0xe7, 0x23, 0x4e, 0xbb, 0xbb, 0xe5, 0x7c,
-	0x64, 0xa1, 0x45, 0x3d, 0x1a, 0x38, 0x11, 0x6d, 0xce, 0x77, 0x03, 0x3f, 0xf2, 0xc9, 0xc7, 0x63,
-	0x6a, 0xf3, 0x8a, 0x1a, 0xff, 0xf1, 0xf3, 0xaa, 0xee, 0x7c, 0x77, 0xbb, 0x35, 0xcf, 0xa8, 0xcd,
-	0xeb, 0x12, 0x45, 0x6d, 0xf6, 0x43, 0x86, 0x2c, 0x2d, 0xbf, 0xe5, 0x2f, 0x70, 0xa2, 0x1b, 0xbd,
-	0x4d, 0xfe, 0x8f, 0xff, 0xe1, 0xbf, 0x04, 0xb3, 0xd9, 0xa7, 0xb6, 0x5f, 0x0c, 0xe7, 0x5d, 0x9f,
-	0xc9, 0xb6, 0xb0, 0xe1, 0x44, 0x8d, 0xad, 0x85, 0x9d, 0x3e, 0x89, 0x66, 0x6d, 0x03, 0xa9, 0xe1,
-	0x07, 0x34, 0x0b, 0xe7, 0xf9, 0x18, 0xa7, 0xe3, 0x34, 0xb6, 0x5c, 0x8f, 0x06, 0xbb, 0xf1, 0x57,
-	0x77, 0x68, 0xe4, 0x64, 0xd5, 0x5a, 0x18, 0x54, 0x2b, 0xe8, 0x79, 0x91, 0xdb, 0xa1, 0x7d, 0x15,
-	0x7e, 0xe6, 0xb0, 0x0a, 0x61, 0x63, 0x8b, 0x76, 0x9c, 0xbe, 0x7a, 0xcf, 0x0d, 0xaa, 0xd7, 0x8b,
-	0xdc, 0xf6, 0x82, 0xeb, 0x45, 0x61, 0x14, 0xa4, 0x2b, 0xd9, 0x3f, 0x2e, 0x40, 0xb9, 0xba, 0x52,
-	0xab, 0x47, 0x4e, 0xd4, 0x0b, 0xc9, 0x2f, 0x5a, 0x30, 0xd1, 0xf6, 0x9d, 0x66, 0xcd, 0x69, 0x3b,
-	0x5e, 0x83, 0x06, 0x33, 0xd6, 0x65, 0xeb, 0x4a, 0xe5, 0xea, 0xca, 0xfc, 0x30, 0xfd, 0x35, 0x5f,
-	0xbd, 0x1f, 0x22, 0x0d, 0xfd, 0x5e, 0xd0, 0xa0, 0x48, 0x37, 0x6b, 0xe7, 0xbf, 0xbb, 0x37, 0xf7,
-	0xc8, 0xfe, 0xde, 0xdc, 0xc4, 0x8a, 0xc1, 0x09, 0x13, 0x7c, 0xc9, 0x37, 0x2d, 0x38, 0xdb, 0x70,
-	0x3c, 0x27, 0xd8, 0x5d, 0x77, 0x82, 0x16, 0x8d, 0x5e, 0x09, 0xfc, 0x5e, 0x77, 0x66, 0xe4, 0x14,
-	0xa4, 0x79, 0x5c, 0x4a, 0x73, 0x76, 0x31, 0xcd, 0x0e, 0xfb, 0x25, 0xe0, 0x72, 0x85, 0x91, 0xb3,
-	0xd1, 0xa6, 0xa6, 0x5c, 0x85, 0xd3, 0x94, 0xab, 0x9e, 0x66, 0x87, 0xfd, 0x12, 0x90, 0x67, 0x60,
-	0xdc, 0xf5, 0x5a, 0x01, 0x0d, 0xc3, 0x99, 0xd1, 0xcb, 0xd6, 0x95, 0x72, 0x6d, 0x4a, 0x56, 0x1f,
-	0x5f, 0x16, 0xc5, 0xa8, 0xe0, 0xf6, 0x6f, 0x17, 0xe0, 0x6c, 0x75, 0xa5, 0xb6, 0x1e, 0x38, 0x9b,
-	0x9b, 0x6e, 0x03, 0xfd, 0x5e, 0xe4, 0x7a, 0x2d, 0x93, 0x80, 0x75, 0x30, 0x01, 0xf2, 0x02, 0x54,
-	0x42, 0x1a, 0xec, 0xb8, 0x0d, 0xba, 0xe6, 0x07, 0x11, 0xef, 0x94, 0x62, 0xed, 0x9c, 0x44, 0xaf,
-	0xd4, 0x63, 0x10, 0x9a, 0x78, 0xac, 0x5a, 0xe0, 0xfb, 0x91, 0x84, 0xf3, 0x36, 0x2b, 0xc7, 0xd5,
-	0x30, 0x06, 0xa1, 0x89, 0x47, 0x96, 0x60, 0xda, 0xf1, 0x3c, 0x3f, 0x72, 0x22, 0xd7, 0xf7, 0xd6,
-	0x02, 0xba, 0xe9, 0x3e, 0x90, 0x9f, 0x38, 0x23, 0xeb, 0x4e, 0x57, 0x53, 0x70, 0xec, 0xab, 0x41,
-	0xbe, 0x61, 0xc1, 0x74, 0x18, 0xb9, 0x8d, 0x6d, 0xd7, 0xa3, 0x61, 0xb8, 0xe8, 0x7b, 0x9b, 0x6e,
-	0x6b, 0xa6, 0xc8, 0xbb, 0xed, 0xd6, 0x70, 0xdd, 0x56, 0x4f, 0x51, 0xad, 0x9d, 0x67, 0x22, 0xa5,
-	0x4b, 0xb1, 0x8f, 0x3b, 0xf9, 0x20, 0x94, 0x65, 0x8b, 0xd2, 0x70, 0x66, 0xec, 0x72, 0xe1, 0x4a,
-	0xb9, 0x76, 0x66, 0x7f, 0x6f, 0xae, 0xbc, 0xac, 0x0a, 0x31, 0x86, 0xdb, 0x4b, 0x30, 0x53, 0xed,
-	0x6c, 0x38, 0x61, 0xe8, 0x34, 0xfd, 0x20, 0xd5, 0x75, 0x57, 0xa0, 0xd4, 0x71, 0xba, 0x5d, 0xd7,
-	0x6b, 0xb1, 0xbe, 0x63, 0x74, 0x26, 0xf6, 0xf7, 0xe6, 0x4a, 0xab, 0xb2, 0x0c, 0x35, 0xd4, 0xfe,
-	0xcf, 0x23, 0x50, 0xa9, 0x7a, 0x4e, 0x7b, 0x37, 0x74, 0x43, 0xec, 0x79, 0xe4, 0x33, 0x50, 0x62,
-	0xab, 0x56, 0xd3, 0x89, 0x1c, 0x39, 0xd3, 0x3f, 0x3c, 0x2f, 0x16, 0x91, 0x79, 0x73, 0x11, 0x89,
-	0x3f, 0x9f, 0x61, 0xcf, 0xef, 0x7c, 0x64, 0xfe, 0xf6, 0xc6, 0x3d, 0xda, 0x88, 0x56, 0x69, 0xe4,
-	0xd4, 0x88, 0xec, 0x05, 0x88, 0xcb, 0x50, 0x53, 0x25, 0x3e, 0x8c, 0x86, 0x5d, 0xda, 0x90, 0x33,
-	0x77, 0x75, 0xc8, 0x19, 0x12, 0x8b, 0x5e, 0xef, 0xd2, 0x46, 0x6d, 0x42, 0xb2, 0x1e, 0x65, 0xff,
-	0x90, 0x33, 0x22, 0xf7, 0x61, 0x2c, 0xe4, 0x6b, 0x99, 0x9c, 0x94, 0xb7, 0xf3, 0x63, 0xc9, 0xc9,
-	0xd6, 0x26, 0x25, 0xd3, 0x31, 0xf1, 0x1f, 0x25, 0x3b, 0xfb, 0xbf, 0x58, 0x70, 0xce, 0xc0, 0xae,
-	0x06, 0xad, 0x5e, 0x87, 0x7a, 0x11, 0xb9, 0x0c, 0xa3, 0x9e, 0xd3, 0xa1, 0x72, 0x56, 0x69, 0x91,
-	0x6f, 0x39, 0x1d, 0x8a, 0x1c, 0x42, 0x9e, 0x82, 0xe2, 0x8e, 0xd3, 0xee, 0x51, 0xde, 0x48, 0xe5,
-	0xda, 0x19, 0x89, 0x52, 0x7c, 0x9d, 0x15, 0xa2, 0x80, 0x91, 0xb7, 0xa0, 0xcc, 0x7f, 0x5c, 0x0f,
-	0xfc, 0x4e, 0x4e, 0x9f, 0x26, 0x25, 0x7c, 0x5d, 0x91, 0x15, 0xc3, 0x4f, 0xff, 0xc5, 0x98, 0xa1,
-	0xfd, 0x87, 0x16, 0x4c, 0x19, 0x1f, 0xb7, 0xe2, 0x86, 0x11, 0xf9, 0x74, 0xdf, 0xe0, 0x99, 0x3f,
-	0xda, 0xe0, 0x61, 0xb5, 0xf9, 0xd0, 0x99, 0x96, 0x5f, 0x5a, 0x52, 0x25, 0xc6, 0xc0, 0xf1, 0xa0,
-	0xe8, 0x46, 0xb4, 0x13, 0xce, 0x8c, 0x5c, 0x2e, 0x5c, 0xa9, 0x5c, 0x5d, 0xce, 0xad, 0x1b, 0xe3,
-	0xf6, 0x5d, 0x66, 0xf4, 0x51, 0xb0, 0xb1, 0xbf, 0x5d, 0x48, 0x74, 0xdf, 0xaa, 0x92, 0xe3, 0x1d,
-	0x0b, 0xc6, 0xda, 0xce, 0x06, 0x6d, 0x8b, 0xb9, 0x55, 0xb9, 0xfa, 0x46, 0x6e, 0x92, 0x28, 0x1e,
-	0xf3, 0x2b, 0x9c, 0xfe, 0x35, 0x2f, 0x0a, 0x76, 0xe3, 0xe1, 0x25, 0x0a, 0x51, 0x32, 0x27, 0x7f,
-	0xdb, 0x82, 0x4a, 0xbc, 0xaa, 0xa9, 0x66, 0xd9, 0xc8, 0x5f, 0x98, 0x78, 0x31, 0x95, 0x12, 0xe9,
-	0x25, 0xda, 0x80, 0xa0, 0x29, 0xcb, 0xec, 0x47, 0xa1, 0x62, 0x7c, 0x02, 0x99, 0x86, 0xc2, 0x36,
-	0xdd, 0x15, 0x03, 0x1e, 0xd9, 0x4f, 0x72, 0x3e, 0x31, 0xc2, 0xe5, 0x90, 0xfe, 0xd8, 0xc8, 0x8b,
-	0xd6, 0xec, 0xcb, 0x30, 0x9d, 0x66, 0x78, 0x9c, 0xfa, 0xf6, 0x3f, 0x2b, 0x26, 0x06, 0x26, 0x5b,
-	0x08, 0x88, 0x0f, 0xe3, 0x1d, 0x1a, 0x05, 0x6e, 0x43, 0x75, 0xd9, 0xd2, 0x70, 0xad, 0xb4, 0xca,
-	0x89, 0xc5, 0x1b, 0xa2, 0xf8, 0x1f, 0xa2, 0xe2, 0x42, 0xb6, 0x60, 0xd4, 0x09, 0x5a, 0xaa, 0x4f,
-	0xae, 0xe7, 0x33, 0x2d, 0xe3, 0xa5, 0xa2, 0x1a, 0xb4, 0x42, 0xe4, 0x1c, 0xc8, 0x02, 0x94, 0x23,
-	0x1a, 0x74, 0x5c, 0xcf, 0x89, 0xc4, 0x0e, 0x5a, 0xaa, 0x9d, 0x95, 0x68, 0xe5, 0x75, 0x05, 0xc0,
-	0x18, 0x87, 0xb4, 0x61, 0xac, 0x19, 0xec, 0x62, 0xcf, 0x9b, 0x19, 0xcd, 0xa3, 0x29, 0x96, 0x38,
-	0xad, 0x78, 0x90, 0x8a, 0xff, 0x28, 0x79, 0x90, 0xdf, 0xb0, 0xe0, 0x7c, 0x87, 0x3a, 0x61, 0x2f,
-	0xa0, 0xec, 0x13, 0x90, 0x46, 0xd4, 0x63, 0x1d, 0x3b, 0x53, 0xe4, 0xcc, 0x71, 0xd8, 0x7e, 0xe8,
-	0xa7, 0x5c, 0x7b, 0x52, 0x8a, 0x72, 0x3e, 0x0b, 0x8a, 0x99, 0xd2, 0x90, 0xb7, 0xa0, 0x12, 0x45,
-	0xed, 0x7a, 0xc4, 0xf4, 0xe0, 0xd6, 0xee, 0xcc, 0x18, 0x5f, 0xbc, 0x86, 0x5c, 0x61, 0xd6, 0xd7,
-	0x57, 0x14, 0xc1, 0xda, 0x14, 0x9b, 0x2d, 0x46, 0x01, 0x9a, 0xec, 0xec, 0x7f, 0x59, 0x84, 0xb3,
-	0x7d, 0xdb, 0x0a, 0x79, 0x1e, 0x8a, 0xdd, 0x2d, 0x27, 0x54, 0xfb, 0xc4, 0x25, 0xb5, 0x48, 0xad,
-	0xb1, 0xc2, 0x77, 0xf7, 0xe6, 0xce, 0xa8, 0x2a, 0xbc, 0x00, 0x05, 0x32, 0xd3, 0xda, 0x3a, 0x34,
-	0x0c, 0x9d, 0x96, 0xda, 0x3c, 0x8c, 0x41, 0xca, 0x8b, 0x51, 0xc1, 0xc9, 0x97, 0x2d, 0x38, 0x23,
-	0x06, 0x2c, 0xd2, 0xb0, 0xd7, 0x8e, 0xd8, 0x06, 0xc9, 0x3a, 0xe5, 0x66, 0x1e, 0x93, 0x43, 0x90,
-	0xac, 0x5d, 0x90, 0xdc, 0xcf, 0x98, 0xa5, 0x21, 0x26, 0xf9, 0x92, 0xbb, 0x50, 0x0e, 0x23, 0x27,
-	0x88, 0x68, 0xb3, 0x1a, 0x71, 0x55, 0xae, 0x72, 0xf5, 0xa7, 0x8f, 0xb6, 0x73, 0xac, 0xbb, 0x1d,
-	0x2a, 0x76, 0xa9, 0xba, 0x22, 0x80, 0x31, 0x2d, 0xf2, 0x16, 0x40, 0xd0, 0xf3, 0xea, 0xbd, 0x4e,
-	0xc7, 0x09, 0x76, 0xa5, 0x76, 0x77, 0x63, 0xb8, 0xcf, 0x43, 0x4d, 0x2f, 0x56, 0x74, 0xe2, 0x32,
-	0x34, 0xf8, 0x91, 0x2f, 0x58, 0x70, 0x46, 0xcc, 0x03, 0x25, 0xc1, 0x58, 0xce, 0x12, 0x9c, 0x65,
-	0x4d, 0xbb, 0x64, 0xb2, 0xc0, 0x24, 0x47, 0xf2, 0x06, 0x54, 0x1a, 0x7e, 0xa7, 0xdb, 0xa6, 0xa2,
-	0x71, 0xc7, 0x8f, 0xdd, 0xb8, 0x7c, 0xe8, 0x2e, 0xc6, 0x24, 0xd0, 0xa4, 0x67, 0xff, 0xc7, 0xa4,
-	0x8e, 0xa3, 0x86, 0x34, 0xf9, 0x14, 0x3c, 0x1e, 0xf6, 0x1a, 0x0d, 0x1a, 0x86, 0x9b, 0xbd, 0x36,
-	0xf6, 0xbc, 0x1b, 0x6e, 0x18, 0xf9, 0xc1, 0xee, 0x8a, 0xdb, 0x71, 0x23, 0x3e, 0xa0, 0x8b, 0xb5,
-	0x8b, 0xfb, 0x7b, 0x73, 0x8f, 0xd7, 0x07, 0x21, 0xe1, 0xe0, 0xfa, 0xc4, 0x81, 0x27, 0x7a, 0xde,
-	0x60, 0xf2, 0xe2, 0xf8, 0x31, 0xb7, 0xbf, 0x37, 0xf7, 0xc4, 0x9d, 0xc1, 0x68, 0x78, 0x10, 0x0d,
-	0xfb, 0x8f, 0x2d, 0xb6, 0x0d, 0x89, 0xef, 0x5a, 0xa7, 0x9d, 0x6e, 0x9b, 0x2d, 0x9d, 0xa7, 0xaf,
-	0x1c, 0x47, 0x09, 0xe5, 0x18, 0xf3, 0xd9, 0xcb, 0x95, 0xfc, 0x83, 0x34, 0x64, 0xfb, 0xbf, 0x5b,
-	0x70, 0x3e, 0x8d, 0xfc, 0x10, 0x14, 0xba, 0x30, 0xa9, 0xd0, 0xdd, 0xca, 0xf7, 0x6b, 0x07, 0x68,
-	0x75, 0xbf, 0x64, 0x0c, 0x58, 0x85, 0x8a, 0x74, 0x93, 0xbc, 0x08, 0x13, 0x91, 0xfc, 0x7b, 0x2b,
-	0x56, 0xce, 0xb5, 0x61, 0x62, 0xdd, 0x80, 0x61, 0x02, 0x93, 0xd5, 0x6c, 0xb4, 0x7b, 0x61, 0x44,
-	0x83, 0x7a, 0xc3, 0xef, 0x8a, 0x65, 0xb7, 0x14, 0xd7, 0x5c, 0x34, 0x60, 0x98, 0xc0, 0xb4, 0xff,
-	0x46, 0xb1, 0xbf, 0xdd, 0xff, 0x7f, 0xd7, 0x57, 0x62, 0xf5, 0xa3, 0xf0, 0x5e, 0xaa, 0x1f, 0xa3,
-	0xef, 0x2b, 0xf5, 0xe3, 0x8b, 0x16, 0xd3, 0xe2, 0xc4, 0x00, 0x08, 0xa5, 0x6a, 0xf4, 0x5a, 0xbe,
-	0xd3, 0x01, 0xe9, 0xa6, 0xa9, 0x18, 0x4a, 0x5e, 0x18, 0xb3, 0xb5, 0xff, 0xd1, 0x28, 0x4c, 0x54,
-	0xbd, 0xc8, 0xad, 0x6e, 0x6e, 0xba, 0x9e, 0x1b, 0xed, 0x92, 0xaf, 0x8d, 0xc0, 0x42, 0x37, 0xa0,
-	0x9b, 0x34, 0x08, 0x68, 0x73, 0xa9, 0x17, 0xb8, 0x5e, 0xab, 0xde, 0xd8, 0xa2, 0xcd, 0x5e, 0xdb,
-	0xf5, 0x5a, 0xcb, 0x2d, 0xcf, 0xd7, 0xc5, 0xd7, 0x1e, 0xd0, 0x46, 0x8f, 0xb7, 0xab, 0x58, 0x25,
-	0x3a, 0xc3, 0xc9, 0xbe, 0x76, 0x3c, 0xa6, 0xb5, 0xe7, 0xf6, 0xf7, 0xe6, 0x16, 0x8e, 0x59, 0x09,
-	0x8f, 0xfb, 0x69, 0xe4, 0x2b, 0x23, 0x30, 0x1f, 0xd0, 0xcf, 0xf6, 0xdc, 0xa3, 0xb7, 0x86, 0x58,
-	0xc6, 0xdb, 0x43, 0x6e, 0xf7, 0xc7, 0xe2, 0x59, 0xbb, 0xba, 0xbf, 0x37, 0x77, 0xcc, 0x3a, 0x78,
-	0xcc, 0xef, 0xb2, 0xd7, 0xa0, 0x52, 0xed, 0xba, 0xa1, 0xfb, 0x00, 0xfd, 0x5e, 0x44, 0x8f, 0x60,
-	0xd0, 0x98, 0x83, 0x62, 0xd0, 0x6b, 0x53, 0xb1, 0xc0, 0x94, 0x6b, 0x65, 0xb6, 0x2c, 0x23, 0x2b,
-	0x40, 0x51, 0x6e, 0x7f, 0x91, 0x6d, 0x41, 0x9c, 0x64, 0xca, 0x94, 0x75, 0x0f, 0x8a, 0x01, 0x63,
-	0x22, 0x47, 0xd6, 0xb0, 0xa7, 0xfe, 0x58, 0x6a, 0x29, 0x04, 0xfb, 0x89, 0x82, 0x85, 0xfd, 0x9d,
-	0x11, 0xb8, 0x50, 0xed, 0x76, 0x57, 0x69, 0xb8, 0x95, 0x92, 0xe2, 0x97, 0x2d, 0x98, 0xdc, 0x71,
-	0x83, 0xa8, 0xe7, 0xb4, 0x95, 0xb5, 0x52, 0xc8, 0x53, 0x1f, 0x56, 0x1e, 0xce, 0xed, 0xf5, 0x04,
-	0xe9, 0x1a, 0xd9, 0xdf, 0x9b, 0x9b, 0x4c, 0x96, 0x61, 0x8a, 0x3d, 0xf9, 0x5b, 0x16, 0x4c, 0xcb,
-	0xa2, 0x5b, 0x7e, 0x93, 0x9a, 0xd6, 0xf0, 0x3b, 0x79, 0xca, 0xa4, 0x89, 0x0b, 0x2b, 0x66, 0xba,
-	0x14, 0xfb, 0x84, 0xb0, 0xff, 0xe7, 0x08, 0x3c, 0x36, 0x80, 0x06, 0xf9, 0x4d, 0x0b, 0xce, 0x0b,
-	0x13, 0xba, 0x01, 0x42, 0xba, 0x29, 0x5b, 0xf3, 0x13, 0x79, 0x4b, 0x8e, 0x6c, 0x8a, 0x53, 0xaf,
-	0x41, 0x6b, 0x33, 0x6c, 0x49, 0x5e, 0xcc, 0x60, 0x8d, 0x99, 0x02, 0x71, 0x49, 0x85, 0x51, 0x3d,
-	0x25, 0xe9, 0xc8, 0x43, 0x91, 0xb4, 0x9e, 0xc1, 0x1a, 0x33, 0x05, 0xb2, 0xff, 0x1a, 0x3c, 0x71,
-	0x00, 0xb9, 0xc3, 0x27, 0xa7, 0xfd, 0x86, 0x1e, 0xf5, 0xc9, 0x31, 0x77, 0x84, 0x79, 0x6d, 0xc3,
-	0x18, 0x9f, 0x3a, 0x6a, 0x62, 0x03, 0xdb, 0x83, 0xf9, 0x9c, 0x0a, 0x51, 0x42, 0xec, 0xef, 0x58,
-	0x50, 0x3a, 0x86, 0xed, 0x73, 0x2e, 0x69, 0xfb, 0x2c, 0xf7, 0xd9, 0x3d, 0xa3, 0x7e, 0xbb, 0xe7,
-	0x2b, 0xc3, 0xf5, 0xc6, 0x51, 0xec, 0x9d, 0x3f, 0xb6, 0xe0, 0x6c, 0x9f, 0x7d, 0x94, 0x6c, 0xc1,
-	0xf9, 0xae, 0xdf, 0x54, 0xdb, 0xe9, 0x0d, 0x27, 0xdc, 0xe2, 0x30, 0xf9, 0x79, 0xcf, 0xb3, 0x9e,
-	0x5c, 0xcb, 0x80, 0xbf, 0xbb, 0x37, 0x37, 0xa3, 0x89, 0xa4, 0x10, 0x30, 0x93, 0x22, 0xe9, 0x42,
-	0x69, 0xd3, 0xa5, 0xed, 0x66, 0x3c, 0x04, 0x87, 0xd4, 0xd2, 0xae, 0x4b, 0x6a, 0xe2, 0x6a, 0x40,
-	0xfd, 0x43, 0xcd, 0xc5, 0xfe, 0x89, 0x05, 0x93, 0xd5, 0x5e, 0xb4, 0xc5, 0x74, 0x94, 0x06, 0xb7,
-	0xc6, 0x11, 0x0f, 0x8a, 0xa1, 0xdb, 0xda, 0x79, 0x3e, 0x9f, 0xc5, 0xb8, 0xce, 0x48, 0xc9, 0x2b,
-	0x12, 0xad, 0xac, 0xf3, 0x42, 0x14, 0x6c, 0x48, 0x00, 0x63, 0xbe, 0xd3, 0x8b, 0xb6, 0xae, 0xca,
-	0x4f, 0x1e, 0xd2, 0x32, 0x71, 0x9b, 0x7d, 0xce, 0x55, 0xc9, 0x51, 0xab, 0x8c, 0xa2, 0x14, 0x25,
-	0x27, 0xfb, 0xf3, 0x30, 0x99, 0xbc, 0x77, 0x3b, 0xc2, 0x98, 0xbd, 0x08, 0x05, 0x27, 0xf0, 0xe4,
-	0x88, 0xad, 0x48, 0x84, 0x42, 0x15, 0x6f, 0x21, 0x2b, 0x27, 0xcf, 0x42, 0x69, 0xb3, 0xd7, 0x6e,
-	0xf3, 0x73, 0x85, 0xb8, 0xe4, 0xd2, 0xc7, 0xa2, 0xeb, 0xb2, 0x1c, 0x35, 0x86, 0xfd, 0x7f, 0x46,
-	0x61, 0xaa, 0xd6, 0xee, 0xd1, 0x57, 0x02, 0x4a, 0x95, 0x2d, 0xa8, 0x0a, 0x53, 0xdd, 0x80, 0xee,
-	0xb8, 0xf4, 0x7e, 0x9d, 0xb6, 0x69, 0x23, 0xf2, 0x03, 0x29, 0xcd, 0x63, 0x92, 0xd0, 0xd4, 0x5a,
-	0x12, 0x8c, 0x69, 0x7c, 0xf2, 0x32, 0x4c, 0x3a, 0x8d, 0xc8, 0xdd, 0xa1, 0x9a, 0x82, 0x10, 0xf7,
-	0x51, 0x49, 0x61, 0xb2, 0x9a, 0x80, 0x62, 0x0a, 0x9b, 0x7c, 0x1a, 0x66, 0xc2, 0x86, 0xd3, 0xa6,
-	0x77, 0xba, 0x92, 0xd5, 0xe2, 0x16, 0x6d, 0x6c, 0xaf, 0xf9, 0xae, 0x17, 0x49, 0xbb, 0xe3, 0x65,
-	0x49, 0x69, 0xa6, 0x3e, 0x00, 0x0f, 0x07, 0x52, 0x20, 0xff, 0xca, 0x82, 0x8b, 0xdd, 0x80, 0xae,
-	0x05, 0x7e, 0xc7, 0x67, 0x43, 0xad, 0xcf, 0x1c, 0x26, 0xcd, 0x42, 0xaf, 0x0f, 0xa9, 0x4b, 0x89,
-	0x92, 0xfe, 0x3b, 0x9c, 0x0f, 0xec, 0xef, 0xcd, 0x5d, 0x5c, 0x3b, 0x48, 0x00, 0x3c, 0x58, 0x3e,
-	0xf2, 0x6f, 0x2c, 0xb8, 0xd4, 0xf5, 0xc3, 0xe8, 0x80, 0x4f, 0x28, 0x9e, 0xea, 0x27, 0xd8, 0xfb,
-	0x7b, 0x73, 0x97, 0xd6, 0x0e, 0x94, 0x00, 0x0f, 0x91, 0xd0, 0xde, 0xaf, 0xc0, 0x59, 0x63, 0xec,
-	0x49, 0x63, 0xce, 0x4b, 0x70, 0x46, 0x0d, 0x86, 0x58, 0xf7, 0x29, 0xc7, 0xb6, 0xbd, 0xaa, 0x09,
-	0xc4, 0x24, 0x2e, 0x1b, 0x77, 0x7a, 0x28, 0x8a, 0xda, 0xa9, 0x71, 0xb7, 0x96, 0x80, 0x62, 0x0a,
-	0x9b, 0x2c, 0xc3, 0x39, 0x59, 0x82, 0xb4, 0xdb, 0x76, 0x1b, 0xce, 0xa2, 0xdf, 0x93, 0x43, 0xae,
-	0x58, 0x7b, 0x6c, 0x7f, 0x6f, 0xee, 0xdc, 0x5a, 0x3f, 0x18, 0xb3, 0xea, 0x90, 0x15, 0x38, 0xef,
-	0xf4, 0x22, 0x5f, 0x7f, 0xff, 0x35, 0x8f, 0x6d, 0xa7, 0x4d, 0x3e, 0xb4, 0x4a, 0x62, 0xdf, 0xad,
-	0x66, 0xc0, 0x31, 0xb3, 0x16, 0x59, 0x4b, 0x51, 0xab, 0xd3, 0x86, 0xef, 0x35, 0x45, 0x2f, 0x17,
-	0xe3, 0x63, 0x60, 0x35, 0x03, 0x07, 0x33, 0x6b, 0x92, 0x36, 0x4c, 0x76, 0x9c, 0x07, 0x77, 0x3c,
-	0x67, 0xc7, 0x71, 0xdb, 0x8c, 0x89, 0xb4, 0x17, 0x0e, 0xb6, 0x32, 0xf5, 0x22, 0xb7, 0x3d, 0x2f,
-	0xfc, 0x38, 0xe6, 0x97, 0xbd, 0xe8, 0x76, 0x50, 0x8f, 0x98, 0xa6, 0x2e, 0x34, 0xc8, 0xd5, 0x04,
-	0x2d, 0x4c, 0xd1, 0x26, 0xb7, 0xe1, 0x02, 0x9f, 0x8e, 0x4b, 0xfe, 0x7d, 0x6f, 0x89, 0xb6, 0x9d,
-	0x5d, 0xf5, 0x01, 0xe3, 0xfc, 0x03, 0x1e, 0xdf, 0xdf, 0x9b, 0xbb, 0x50, 0xcf, 0x42, 0xc0, 0xec,
-	0x7a, 0xc4, 0x81, 0x27, 0x92, 0x00, 0xa4, 0x3b, 0x6e, 0xe8, 0xfa, 0x9e, 0x30, 0xcb, 0x95, 0x62,
-	0xb3, 0x5c, 0x7d, 0x30, 0x1a, 0x1e, 0x44, 0x83, 0xfc, 0x5d, 0x0b, 0xce, 0x67, 0x4d, 0xc3, 0x99,
-	0x72, 0x1e, 0xb7, 0xc9, 0xa9, 0xa9, 0x25, 0x46, 0x44, 0xe6, 0xa2, 0x90, 0x29, 0x04, 0x79, 0xdb,
-	0x82, 0x09, 0xc7, 0x38, 0x41, 0xcf, 0x40, 0x1e, 0xbb, 0x96, 0x79, 0x26, 0xaf, 0x4d, 0xef, 0xef,
-	0xcd, 0x25, 0x4e, 0xe9, 0x98, 0xe0, 0x48, 0xfe, 0xbe, 0x05, 0x17, 0x32, 0xe7, 0xf8, 0x4c, 0xe5,
-	0x34, 0x5a, 0x88, 0x0f, 0x92, 0xec, 0x35, 0x27, 0x5b, 0x0c, 0xf2, 0x0d, 0x4b, 0x6f, 0x65, 0xea,
-	0x82, 0x71, 0x66, 0x82, 0x8b, 0x36, 0xa4, 0xc1, 0xc3, 0x50, 0xa3, 0x14, 0xe1, 0xda, 0x39, 0x63,
-	0x67, 0x54, 0x85, 0x98, 0x66, 0x4f, 0xbe, 0x6e, 0xa9, 0xad, 0x51, 0x4b, 0x74, 0xe6, 0xb4, 0x24,
-	0x22, 0xf1, 0x4e, 0xab, 0x05, 0x4a, 0x31, 0x27, 0x3f, 0x07, 0xb3, 0xce, 0x86, 0x1f, 0x44, 0x99,
-	0x93, 0x6f, 0x66, 0x92, 0x4f, 0xa3, 0x4b, 0xfb, 0x7b, 0x73, 0xb3, 0xd5, 0x81, 0x58, 0x78, 0x00,
-	0x05, 0xfb, 0x7b, 0x63, 0x30, 0x21, 0x4e, 0x42, 0x72, 0xeb, 0xfa, 0x1d, 0x0b, 0x9e, 0x6c, 0xf4,
-	0x82, 0x80, 0x7a, 0x51, 0x3d, 0xa2, 0xdd, 0xfe, 0x8d, 0xcb, 0x3a, 0xd5, 0x8d, 0xeb, 0xf2, 0xfe,
-	0xde, 0xdc, 0x93, 0x8b, 0x07, 0xf0, 0xc7, 0x03, 0xa5, 0x23, 0xff, 0xc1, 0x02, 0x5b, 0x22, 0xd4,
-	0x9c, 0xc6, 0x76, 0x2b, 0xf0, 0x7b, 0x5e, 0xb3, 0xff, 0x23, 0x46, 0x4e, 0xf5, 0x23, 0x9e, 0xde,
-	0xdf, 0x9b, 0xb3, 0x17, 0x0f, 0x95, 0x02, 0x8f, 0x20, 0x29, 0x79, 0x05, 0xce, 0x4a, 0xac, 0x6b,
-	0x0f, 0xba, 0x34, 0x70, 0xd9, 0x99, 0x43, 0x2a, 0x8e, 0xb1, 0x6f, 0x5a, 0x1a, 0x01, 0xfb, 0xeb,
-	0x90, 0x10, 0xc6, 0xef, 0x53, 0xb7, 0xb5, 0x15, 0x29, 0xf5, 0x69, 0x48, 0x87, 0x34, 0x69, 0x15,
-	0xb9, 0x2b, 0x68, 0xd6, 0x2a, 0xfb, 0x7b, 0x73, 0xe3, 0xf2, 0x0f, 0x2a, 0x4e, 0xe4, 0x16, 0x4c,
-	0x8a, 0x73, 0xea, 0x9a, 0xeb, 0xb5, 0xd6, 0x7c, 0x4f, 0x78, 0x55, 0x95, 0x6b, 0x4f, 0xab, 0x0d,
-	0xbf, 0x9e, 0x80, 0xbe, 0xbb, 0x37, 0x37, 0xa1, 0x7e, 0xaf, 0xef, 0x76, 0x29, 0xa6, 0x6a, 0x93,
-	0xbf, 0x63, 0x01, 0x09, 0x23, 0xda, 0x5d, 0x6b, 0xf7, 0x5a, 0xae, 0x6c, 0x22, 0xe9, 0x1f, 0x95,
-	0x83, 0xab, 0x56, 0x92, 0x6e, 0x6d, 0x56, 0x0a, 0x49, 0xea, 0x7d, 0x1c, 0x31, 0x43, 0x0a, 0xfb,
-	0xdb, 0xe3, 0x00, 0x6a, 0x2e, 0xd1, 0x2e, 0xf9, 0x20, 0x94, 0x43, 0x1a, 0x89, 0x26, 0x91, 0xd7,
-	0x5c, 0xe2, 0x72, 0x52, 0x15, 0x62, 0x0c, 0x27, 0xdb, 0x50, 0xec, 0x3a, 0xbd, 0x90, 0xe6, 0x73,
-	0xb8, 0x91, 0x23, 0x73, 0x8d, 0x51, 0x14, 0xa7, 0x66, 0xfe, 0x13, 0x05, 0x0f, 0xf2, 0x25, 0x0b,
-	0x80, 0x26, 0x47, 0xd3, 0xd0, 0xd6, 0x2b, 0xc9, 0x32, 0x1e, 0x70, 0xac, 0x0d, 0x6a, 0x93, 0xfb,
-	0x7b, 0x73, 0x60, 0x8c, 0x4b, 0x83, 0x2d, 0xb9, 0x0f, 0x25, 0x47, 0x6d, 0x48, 0xa3, 0xa7, 0xb1,
-	0x21, 0xf1, 0xc3, 0xac, 0x9e, 0x51, 0x9a, 0x19, 0xf9, 0x8a, 0x05, 0x93, 0x21, 0x8d, 0x64, 0x57,
-	0xb1, 0x65, 0x51, 0x6a, 0xe3, 0x43, 0xce, 0x88, 0x7a, 0x82, 0xa6, 0x58, 0xde, 0x93, 0x65, 0x98,
-	0xe2, 0xab, 0x44, 0xb9, 0x41, 0x9d, 0x26, 0x0d, 0xb8, 0xad, 0x44, 0xaa, 0x79, 0xc3, 0x8b, 0x62,
-	0xd0, 0xd4, 0xa2, 0x18, 0x65, 0x98, 0xe2, 0xab, 0x44, 0x59, 0x75, 0x83, 0xc0, 0x97, 0xa2, 0x94,
-	0x72, 0x12, 0xc5, 0xa0, 0xa9, 0x45, 0x31, 0xca, 0x30, 0xc5, 0x97, 0xb4, 0x61, 0xac, 0xcb, 0xa7,
-	0x96, 0x54, 0xe5, 0x86, 0xbc, 0x23, 0x57, 0xd3, 0x94, 0x76, 0x85, 0x4d, 0x4a, 0xfc, 0x47, 0xc9,
-	0xc3, 0xfe, 0xd6, 0x19, 0x98, 0x54, 0xd3, 0x36, 0x3e, 0xe4, 0x08, 0x43, 0xe0, 0x80, 0x43, 0xce,
-	0xa2, 0x09, 0xc4, 0x24, 0x2e, 0xab, 0x2c, 0x56, 0xad, 0xe4, 0x19, 0x47, 0x57, 0xae, 0x9b, 0x40,
-	0x4c, 0xe2, 0x92, 0x0e, 0x14, 0xd9, 0xca, 0xa2, 0xdc, 0x2f, 0x86, 0xfc, 0xf2, 0x78, 0x35, 0x32,
-	0x8c, 0x2a, 0x8c, 0x3c, 0x0a, 0x2e, 0xdc, 0x96, 0x1d, 0x25, 0xcc, 0xdb, 0x72, 0x2a, 0xe6, 0xb3,
-	0x1a, 0x24, 0x2d, 0xe7, 0xa2, 0xef, 0x93, 0x65, 0x98, 0x62, 0x9f, 0x71, 0xee, 0x29, 0x9e, 0xe2,
-	0xb9, 0xe7, 0x93, 0x50, 0xea, 0x38, 0x0f, 0xea, 0xbd, 0xa0, 0x75, 0xf2, 0xf3, 0x95, 0x74, 0xa7,
-	0x15, 0x54, 0x50, 0xd3, 0x23, 0x5f, 0xb0, 0x8c, 0x05, 0x4e, 0xf8, 0x5a, 0xdc, 0xcd, 0x77, 0x81,
-	0xd3, 0x6a, 0xc3, 0xc0, 0xa5, 0xae, 0xef, 0x14, 0x52, 0x7a, 0xe8, 0xa7, 0x10, 0xa6, 0x51, 0x8b,
-	0x09, 0xa2, 0x35, 0xea, 0xf2, 0xa9, 0x6a, 0xd4, 0x8b, 0x09, 0x66, 0x98, 0x62, 0xce, 0xe5, 0x11,
-	0x73, 0x4e, 0xcb, 0x03, 0xa7, 0x2a, 0x4f, 0x3d, 0xc1, 0x0c, 0x53, 0xcc, 0x07, 0x1f, 0xbd, 0x2b,
-	0xa7, 0x73, 0xf4, 0x9e, 0xc8, 0xe1, 0xe8, 0x7d, 0xf0, 0xa9, 0xe4, 0xcc, 0xb0, 0xa7, 0x12, 0x72,
-	0x13, 0x48, 0x73, 0xd7, 0x73, 0x3a, 0x6e, 0x43, 0x2e, 0x96, 0x7c, 0x93, 0x9e, 0xe4, 0xa6, 0x19,
-	0xad, 0x95, 0x2d, 0xf5, 0x61, 0x60, 0x46, 0x2d, 0x12, 0x41, 0xa9, 0xab, 0x94, 0xcf, 0xa9, 0x3c,
-	0x46, 0xbf, 0x52, 0x46, 0x85, 0x0b, 0x0d, 0x9b, 0x78, 0xaa, 0x04, 0x35, 0x27, 0xb2, 0x02, 0xe7,
-	0x3b, 0xae, 0xb7, 0xe6, 0x37, 0xc3, 0x35, 0x1a, 0x48, 0xc3, 0x53, 0x9d, 0x46, 0x33, 0xd3, 0xbc,
-	0x6d, 0xb8, 0x31, 0x61, 0x35, 0x03, 0x8e, 0x99, 0xb5, 0xec, 0xff, 0x6d, 0xc1, 0xf4, 0x62, 0xdb,
-	0xef, 0x35, 0xef, 0x3a, 0x51, 0x63, 0x4b, 0x78, 0x6c, 0x90, 0x97, 0xa1, 0xe4, 0x7a, 0x11, 0x0d,
-	0x76, 0x9c, 0xb6, 0xdc, 0x9f, 0x6c, 0x65, 0x49, 0x5e, 0x96, 0xe5, 0xef, 0xee, 0xcd, 0x4d, 0x2e,
-	0xf5, 0x02, 0x6e, 0xb0, 0x17, 0xab, 0x15, 0xea, 0x3a, 0xe4, 0x5b, 0x16, 0x9c, 0x15, 0x3e, 0x1f,
-	0x4b, 0x4e, 0xe4, 0xbc, 0xd6, 0xa3, 0x81, 0x4b, 0x95, 0xd7, 0xc7, 0x90, 0x0b, 0x55, 0x5a, 0x56,
-	0xc5, 0x60, 0x37, 0x3e, 0xb3, 0xac, 0xa6, 0x39, 0x63, 0xbf, 0x30, 0xf6, 0xaf, 0x16, 0xe0, 0xf1,
-	0x81, 0xb4, 0xc8, 0x2c, 0x8c, 0xb8, 0x4d, 0xf9, 0xe9, 0x20, 0xe9, 0x8e, 0x2c, 0x37, 0x71, 0xc4,
-	0x6d, 0x92, 0x79, 0xae, 0xe1, 0x06, 0x34, 0x0c, 0xd5, 0xdd, 0x7b, 0x59, 0x2b, 0xa3, 0xb2, 0x14,
-	0x0d, 0x0c, 0x32, 0x07, 0x45, 0xee, 0x4a, 0x2d, 0x8f, 0x56, 0x5c, 0x67, 0xe6, 0x5e, 0xcb, 0x28,
-	0xca, 0xc9, 0x17, 0x2d, 0x00, 0x21, 0x20, 0xd3, 0xf7, 0xe5, 0x2e, 0x89, 0xf9, 0x36, 0x13, 0xa3,
-	0x2c, 0xa4, 0x8c, 0xff, 0xa3, 0xc1, 0x95, 0xac, 0xc3, 0x18, 0x53, 0x9f, 0xfd, 0xe6, 0x89, 0x37,
-	0x45, 0xa1, 0x00, 0x71, 0x1a, 0x28, 0x69, 0xb1, 0xb6, 0x0a, 0x68, 0xd4, 0x0b, 0x3c, 0xd6, 0xb4,
-	0x7c, 0x1b, 0x2c, 0x09, 0x29, 0x50, 0x97, 0xa2, 0x81, 0x61, 0xff, 0x8b, 0x11, 0x38, 0x9f, 0x25,
-	0x3a, 0xdb, 0x6d, 0xc6, 0x84, 0xb4, 0xd2, 0x4a, 0xf0, 0xb3, 0xf9, 0xb7, 0x8f, 0x74, 0x5f, 0xd2,
-	0x37, 0x36, 0xd2, 0x97, 0x54, 0xf2, 0x25, 0x3f, 0xab, 0x5b, 0x68, 0xe4, 0x84, 0x2d, 0xa4, 0x29,
-	0xa7, 0x5a, 0xe9, 0x32, 0x8c, 0x86, 0xac, 0xe7, 0x0b, 0xc9, 0x9b, 0x1f, 0xde, 0x47, 0x1c, 0xc2,
-	0x30, 0x7a, 0x9e, 0x1b, 0xc9, 0xf8, 0x23, 0x8d, 0x71, 0xc7, 0x73, 0x23, 0xe4, 0x10, 0xfb, 0x9b,
-	0x23, 0x30, 0x3b, 0xf8, 0xa3, 0xc8, 0x37, 0x2d, 0x80, 0x26, 0x3b, 0x1c, 0x85, 0xdc, 0x89, 0x5f,
-	0xb8, 0x7b, 0x39, 0xa7, 0xd5, 0x86, 0x4b, 0x8a, 0x53, 0xec, 0x87, 0xa8, 0x8b, 0x42, 0x34, 0x04,
-	0x21, 0x57, 0xd5, 0xd0, 0xe7, 0xb7, 0x56, 0x62, 0x32, 0xe9, 0x3a, 0xab, 0x1a, 0x82, 0x06, 0x16,
-	0x3b, 0xfd, 0x7a, 0x4e, 0x87, 0x86, 0x5d, 0x47, 0x47, 0x73, 0xf1, 0xd3, 0xef, 0x2d, 0x55, 0x88,
-	0x31, 0xdc, 0x6e, 0xc3, 0x53, 0x47, 0x90, 0x33, 0xa7, 0x60, 0x19, 0xfb, 0x4f, 0x2c, 0x78, 0x4c,
-	0x7a, 0xe2, 0xfd, 0xb9, 0x71, 0xeb, 0xfc, 0x53, 0x0b, 0x9e, 0x18, 0xf0, 0xcd, 0x0f, 0xc1, 0xbb,
-	0xf3, 0xcd, 0xa4, 0x77, 0xe7, 0x9d, 0x61, 0x87, 0x74, 0xe6, 0x77, 0x0c, 0x70, 0xf2, 0xfc, 0x5e,
-	0x01, 0xce, 0xb0, 0x65, 0xab, 0xe9, 0xb7, 0x72, 0xda, 0x38, 0x9f, 0x82, 0xe2, 0x67, 0xd9, 0x06,
-	0x94, 0x1e, 0x64, 0x7c, 0x57, 0x42, 0x01, 0x23, 0x5f, 0xb2, 0x60, 0xfc, 0xb3, 0x72, 0x4f, 0x15,
-	0x67, 0xb9, 0x21, 0x17, 0xc3, 0xc4, 0x37, 0xcc, 0xcb, 0x1d, 0x52, 0xc4, 0xe0, 0x68, 0x5f, 0x4e,
-	0xb5, 0x95, 0x2a, 0xce, 0xe4, 0x19, 0x18, 0xdf, 0xf4, 0x83, 0x4e, 0xaf, 0xed, 0xa4, 0x03, 0x3f,
-	0xaf, 0x8b, 0x62, 0x54, 0x70, 0x36, 0xc9, 0x9d, 0xae, 0xfb, 0x3a, 0x0d, 0x42, 0x11, 0x92, 0x91,
-	0x98, 0xe4, 0x55, 0x0d, 0x41, 0x03, 0x8b, 0xd7, 0x69, 0xb5, 0x02, 0xda, 0x72, 0x22, 0x3f, 0xe0,
-	0x3b, 0x87, 0x59, 0x47, 0x43, 0xd0, 0xc0, 0x9a, 0xfd, 0x18, 0x4c, 0x98, 0xc2, 0x1f, 0x2b, 0x9e,
-	0xe7, 0xe3, 0x20, 0x9d, 0x3a, 0x53, 0x4b, 0x92, 0x75, 0x94, 0x25, 0xc9, 0xfe, 0x4f, 0x23, 0x60,
-	0xd8, 0xa2, 0x1e, 0xc2, 0x54, 0xf7, 0x12, 0x53, 0x7d, 0x48, 0x3b, 0x8a, 0x61, 0x59, 0x1b, 0x14,
-	0xdd, 0xb8, 0x93, 0x8a, 0x6e, 0xbc, 0x95, 0x1b, 0xc7, 0x83, 0x83, 0x1b, 0x7f, 0x68, 0xc1, 0x13,
-	0x31, 0x72, 0xbf, 0x0d, 0xfb, 0xf0, 0x75, 0xfb, 0x05, 0xa8, 0x38, 0x71, 0x35, 0x39, 0xb1, 0x8c,
-	0xd0, 0x32, 0x0d, 0x42, 0x13, 0x2f, 0x0e, 0x8b, 0x29, 0x9c, 0x30, 0x2c, 0x66, 0xf4, 0xe0, 0xb0,
-	0x18, 0xfb, 0x27, 0x23, 0x70, 0xb1, 0xff, 0xcb, 0x4c, 0x5f, 0xf1, 0xc3, 0xbf, 0x2d, 0xed, 0x4d,
-	0x3e, 0x72, 0x62, 0x6f, 0xf2, 0xc2, 0x51, 0xbd, 0xc9, 0xb5, 0x0f, 0xf7, 0xe8, 0xa9, 0xfb, 0x70,
-	0xd7, 0xe1, 0x82, 0x72, 0x18, 0xbd, 0xee, 0x07, 0x32, 0x36, 0x44, 0xad, 0x20, 0xa5, 0xda, 0x45,
-	0x59, 0xe5, 0x02, 0x66, 0x21, 0x61, 0x76, 0x5d, 0xfb, 0x87, 0x05, 0x38, 0x17, 0x37, 0xfb, 0xa2,
-	0xef, 0x35, 0x5d, 0xee, 0x73, 0xf4, 0x12, 0x8c, 0x46, 0xbb, 0x5d, 0xd5, 0xd8, 0x7f, 0x59, 0x89,
-	0xb3, 0xbe, 0xdb, 0x65, 0xbd, 0xfd, 0x58, 0x46, 0x15, 0x7e, 0x8b, 0xc0, 0x2b, 0x91, 0x15, 0x3d,
-	0x3b, 0x44, 0x0f, 0x3c, 0x9f, 0x1c, 0xcd, 0xef, 0xee, 0xcd, 0x65, 0x64, 0x79, 0x98, 0xd7, 0x94,
-	0x92, 0x63, 0x9e, 0xdc, 0x83, 0xc9, 0xb6, 0x13, 0x46, 0x77, 0xba, 0x4d, 0x27, 0xa2, 0xeb, 0xae,
-	0xf4, 0xe6, 0x39, 0x5e, 0x38, 0x8d, 0x76, 0x7b, 0x58, 0x49, 0x50, 0xc2, 0x14, 0x65, 0xb2, 0x03,
-	0x84, 0x95, 0xac, 0x07, 0x8e, 0x17, 0x8a, 0xaf, 0x62, 0xfc, 0x8e, 0x1f, 0x1b, 0xa5, 0x8f, 0xce,
-	0x2b, 0x7d, 0xd4, 0x30, 0x83, 0x03, 0x79, 0x1a, 0xc6, 0x02, 0xea, 0x84, 0x7a, 0x3b, 0xd0, 0xf3,
-	0x1f, 0x79, 0x29, 0x4a, 0xa8, 0x39, 0xa1, 0xc6, 0x0e, 0x99, 0x50, 0x7f, 0x60, 0xc1, 0x64, 0xdc,
-	0x4d, 0x0f, 0x41, 0xf5, 0xe8, 0x24, 0x55, 0x8f, 0x1b, 0x79, 0x2d, 0x89, 0x03, 0xb4, 0x8d, 0x3f,
-	0x1e, 0x37, 0xbf, 0x8f, 0x07, 0x70, 0x7c, 0xce, 0xf4, 0xe7, 0xb7, 0xf2, 0x88, 0xaa, 0x4b, 0x68,
-	0x7b, 0x07, 0x3a, 0xf2, 0x33, 0x5d, 0xa7, 0x29, 0xf5, 0x18, 0x39, 0xec, 0xb5, 0xae, 0xa3, 0xf4,
-	0x9b, 0x2c, 0x5d, 0x47, 0xd5, 0x21, 0x77, 0xe0, 0xb1, 0x6e, 0xe0, 0xf3, 0x3c, 0x03, 0x4b, 0xd4,
-	0x69, 0xb6, 0x5d, 0x8f, 0x2a, 0x33, 0x8f, 0xf0, 0xba, 0x79, 0x62, 0x7f, 0x6f, 0xee, 0xb1, 0xb5,
-	0x6c, 0x14, 0x1c, 0x54, 0x37, 0x19, 0xa9, 0x3a, 0x7a, 0x84, 0x48, 0xd5, 0x5f, 0xd2, 0xc6, 0x54,
-	0x1d, 0x14, 0xf1, 0xa9, 0xbc, 0xba, 0x32, 0x2b, 0x3c, 0x42, 0x0f, 0xa9, 0xaa, 0x64, 0x8a, 0x9a,
-	0xfd, 0x60, 0x8b, 0xdd, 0xd8, 0x09, 0x2d, 0x76, 0x71, 0x1c, 0xcc, 0xf8, 0x7b, 0x19, 0x07, 0x53,
-	0x7a, 0x5f, 0xc5, 0xc1, 0x7c, 0xcb, 0x82, 0x73, 0x4e, 0x7f, 0x04, 0x7a, 0x3e, 0xc6, 0xe3, 0x8c,
-	0xd0, 0xf6, 0xda, 0x13, 0x52, 0xc8, 0xac, 0x40, 0x7f, 0xcc, 0x12, 0xc5, 0x7e, 0xa7, 0x08, 0xd3,
-	0x69, 0x25, 0xe9, 0xf4, 0x43, 0x75, 0x7f, 0xc5, 0x82, 0x69, 0x35, 0xc1, 0xf5, 0x0d, 0xb8, 0x38,
-	0x62, 0xac, 0xe4, 0xb4, 0xae, 0x08, 0x75, 0x4f, 0x67, 0x50, 0x59, 0x4f, 0x71, 0xc3, 0x3e, 0xfe,
-	0xe4, 0x0d, 0xa8, 0xe8, 0x5b, 0x95, 0x13, 0xc5, 0xed, 0xf2, 0xd0, 0xd2, 0x6a, 0x4c, 0x02, 0x4d,
-	0x7a, 0xe4, 0x1d, 0x0b, 0xa0, 0xa1, 0x76, 0xe2, 0x9c, 0xa2, 0xa2, 0x32, 0xb4, 0x85, 0x58, 0x9f,
-	0xd7, 0x45, 0x21, 0x1a, 0x8c, 0xc9, 0xaf, 0xf2, 0xfb, 0x14, 0x3d, 0x12, 0x94, 0xe7, 0xc1, 0x27,
-	0xf2, 0x5e, 0x8a, 0x62, 0x5f, 0x12, 0xad, 0xed, 0x19, 0xa0, 0x10, 0x13, 0x42, 0xd8, 0x2f, 0x81,
-	0xf6, 0xd9, 0x66, 0x2b, 0x2b, 0xf7, 0xda, 0x5e, 0x73, 0xa2, 0x2d, 0x39, 0x04, 0xf5, 0xca, 0x7a,
-	0x5d, 0x01, 0x30, 0xc6, 0xb1, 0x3f, 0x03, 0x93, 0xaf, 0x04, 0x4e, 0x77, 0xcb, 0xe5, 0xf7, 0x16,
-	0xec, 0x7c, 0xfc, 0x0c, 0x8c, 0x3b, 0xcd, 0x66, 0x56, 0xb2, 0x9f, 0xaa, 0x28, 0x46, 0x05, 0x3f,
-	0xd2, 0x51, 0xd8, 0xfe, 0x77, 0x16, 0x90, 0xf8, 0xa6, 0xd9, 0xf5, 0x5a, 0xab, 0x4e, 0xd4, 0xd8,
-	0x62, 0x47, 0xb8, 0x2d, 0x5e, 0x9a, 0x75, 0x84, 0xbb, 0xa1, 0x21, 0x68, 0x60, 0x91, 0xb7, 0xa0,
-	0x22, 0xfe, 0xbd, 0xae, 0x0f, 0x88, 0xc3, 0xbb, 0x9e, 0xf3, 0x3d, 0x8f, 0xcb, 0x24, 0x46, 0xe1,
-	0x8d, 0x98, 0x03, 0x9a, 0xec, 0x58, 0x53, 0x2d, 0x7b, 0x9b, 0xed, 0xde, 0x83, 0xe6, 0x46, 0xdc,
-	0x54, 0xdd, 0xc0, 0xdf, 0x74, 0xdb, 0x34, 0xdd, 0x54, 0x6b, 0xa2, 0x18, 0x15, 0xfc, 0x68, 0x4d,
-	0xf5, 0x6f, 0x2d, 0x38, 0xbf, 0x1c, 0x46, 0xae, 0xbf, 0x44, 0xc3, 0x88, 0xed, 0x7c, 0x6c, 0x7d,
-	0xec, 0xb5, 0x8f, 0x12, 0x7e, 0xb1, 0x04, 0xd3, 0xf2, 0x1e, 0xba, 0xb7, 0x11, 0xd2, 0xc8, 0x38,
-	0x6a, 0xe8, 0x79, 0xbc, 0x98, 0x82, 0x63, 0x5f, 0x0d, 0x46, 0x45, 0x5e, 0x48, 0xc7, 0x54, 0x0a,
-	0x49, 0x2a, 0xf5, 0x14, 0x1c, 0xfb, 0x6a, 0xd8, 0x3f, 0x28, 0xc0, 0x39, 0xfe, 0x19, 0xa9, 0xd0,
-	0xa9, 0xaf, 0x0f, 0x0a, 0x9d, 0x1a, 0x72, 0x2a, 0x73, 0x5e, 0x27, 0x08, 0x9c, 0xfa, 0x9b, 0x16,
-	0x4c, 0x35, 0x93, 0x2d, 0x9d, 0x8f, 0x5d, 0x2e, 0xab, 0x0f, 0x85, 0x07, 0x62, 0xaa, 0x10, 0xd3,
-	0xfc, 0xc9, 0xaf, 0x59, 0x30, 0x95, 0x14, 0x53, 0xad, 0xee, 0xa7, 0xd0, 0x48, 0x3a, 0x64, 0x20,
-	0x59, 0x1e, 0x62, 0x5a, 0x04, 0xfb, 0xfb, 0x23, 0xb2, 0x4b, 0x4f, 0x23, 0x2e, 0x88, 0xdc, 0x87,
-	0x72, 0xd4, 0x0e, 0x45, 0xa1, 0xfc, 0xda, 0x21, 0x0f, 0xad, 0xeb, 0x2b, 0x75, 0xe1, 0x70, 0x12,
-	0xeb, 0x95, 0xb2, 0x84, 0xe9, 0xc7, 0x8a, 0x17, 0x67, 0xdc, 0xe8, 0x4a, 0xc6, 0xb9, 0x9c, 0x96,
-	0xd7, 0x17, 0xd7, 0xd2, 0x8c, 0x65, 0x09, 0x63, 0xac, 0x78, 0xd9, 0xbf, 0x65, 0x41, 0xf9, 0xa6,
-	0xaf, 0xd6, 0x91, 0x9f, 0xcb, 0xc1, 0x16, 0xa5, 0x55, 0x56, 0xad, 0xb4, 0xc4, 0xa7, 0xa0, 0x97,
-	0x13, 0x96, 0xa8, 0x27, 0x0d, 0xda, 0xf3, 0x3c, 0xe7, 0x21, 0x23, 0x75, 0xd3, 0xdf, 0x18, 0x68,
-	0x3e, 0xfe, 0xf5, 0x22, 0x9c, 0x79, 0xd5, 0xd9, 0xa5, 0x5e, 0xe4, 0x1c, 0x7f, 0x93, 0x78, 0x01,
-	0x2a, 0x4e, 0x97, 0xdf, 0x65, 0x1a, 0xc7, 0x90, 0xd8, 0xb8, 0x13, 0x83, 0xd0, 0xc4, 0x8b, 0x17,
-	0x34, 0x11, 0xa4, 0x93, 0xb5, 0x14, 0x2d, 0xa6, 0xe0, 0xd8, 0x57, 0x83, 0xdc, 0x04, 0x22, 0x03,
-	0xdb, 0xab, 0x8d, 0x86, 0xdf, 0xf3, 0xc4, 0x92, 0x26, 0xec, 0x3e, 0xfa, 0x3c, 0xbc, 0xda, 0x87,
-	0x81, 0x19, 0xb5, 0xc8, 0xa7, 0x61, 0xa6, 0xc1, 0x29, 0xcb, 0xd3, 0x91, 0x49, 0x51, 0x9c, 0x90,
-	0x75, 0xd8, 0xcb, 0xe2, 0x00, 0x3c, 0x1c, 0x48, 0x81, 0x49, 0x1a, 0x46, 0x7e, 0xe0, 0xb4, 0xa8,
-	0x49, 0x77, 0x2c, 0x29, 0x69, 0xbd, 0x0f, 0x03, 0x33, 0x6a, 0x91, 0xcf, 0x43, 0x39, 0xda, 0x0a,
-	0x68, 0xb8, 0xe5, 0xb7, 0x9b, 0xd2, 0xf7, 0x64, 0x48, 0x63, 0xa0, 0xec, 0xfd, 0x75, 0x45, 0xd5,
-	0x18, 0xde, 0xaa, 0x08, 0x63, 0x9e, 0x24, 0x80, 0xb1, 0xb0, 0xe1, 0x77, 0x69, 0x28, 0x4f, 0x15,
-	0x37, 0x73, 0xe1, 0xce, 0x8d, 0x5b, 0x86, 0x19, 0x92, 0x73, 0x40, 0xc9, 0xc9, 0xfe, 0xdd, 0x11,
-	0x98, 0x30, 0x11, 0x8f, 0xb0, 0x36, 0x7d, 0xc9, 0x82, 0x89, 0x86, 0xef, 0x45, 0x81, 0xdf, 0x8e,
-	0x13, 0x36, 0x0c, 0xaf, 0x51, 0x30, 0x52, 0x4b, 0x34, 0x72, 0xdc, 0xb6, 0x61, 0xad, 0x33, 0xd8,
-	0x60, 0x82, 0x29, 0xf9, 0x9a, 0x05, 0x53, 0xb1, 0x63, 0x64, 0x6c, 0xeb, 0xcb, 0x55, 0x10, 0xbd,
-	0xd4, 0x5f, 0x4b, 0x72, 0xc2, 0x34, 0x6b, 0x7b, 0x03, 0xa6, 0xd3, 0xbd, 0xcd, 0x9a, 0xb2, 0xeb,
-	0xc8, 0xb9, 0x5e, 0x88, 0x9b, 0x72, 0xcd, 0x09, 0x43, 0xe4, 0x10, 0xf2, 0x2c, 0x94, 0x3a, 0x4e,
-	0xd0, 0x72, 0x3d, 0xa7, 0xcd, 0x5b, 0xb1, 0x60, 0x2c, 0x48, 0xb2, 0x1c, 0x35, 0x86, 0xfd, 0x61,
-	0x98, 0x58, 0x75, 0xbc, 0x16, 0x6d, 0xca, 0x75, 0xf8, 0xf0, 0xc8, 0xd4, 0x3f, 0x1a, 0x85, 0x8a,
-	0x71, 0x7c, 0x3c, 0xfd, 0x73, 0x56, 0x22, 0x11, 0x51, 0x21, 0xc7, 0x44, 0x44, 0x9f, 0x04, 0xd8,
-	0x74, 0x3d, 0x37, 0xdc, 0x3a, 0x61, 0x8a, 0x23, 0x7e, 0x37, 0x7f, 0x5d, 0x53, 0x40, 0x83, 0x5a,
-	0x7c, 0x01, 0x5a, 0x3c, 0x20, 0x5b, 0xe0, 0x3b, 0x96, 0xb1, 0xdd, 0x8c, 0xe5, 0xe1, 0xf0, 0x61,
-	0x74, 0xcc, 0xbc, 0xda, 0x7e, 0xc4, 0xdd, 0xd4, 0x41, 0xbb, 0xd2, 0x3a, 0x94, 0x02, 0x1a, 0xf6,
-	0x3a, 0xf4, 0x44, 0xc9, 0x88, 0xb8, 0xeb, 0x0d, 0xca, 0xfa, 0xa8, 0x29, 0xcd, 0xbe, 0x04, 0x67,
-	0x12, 0x22, 0x1c, 0xeb, 0x86, 0xc9, 0x87, 0x4c, 0x1b, 0xc5, 0x49, 0xee, 0x9b, 0x58, 0x5f, 0xb4,
-	0x8d, 0x24, 0x44, 0xba, 0x2f, 0x84, 0x83, 0x95, 0x80, 0xd9, 0x3f, 0x19, 0x03, 0xe9, 0xc3, 0x70,
-	0x84, 0xe5, 0xca, 0xbc, 0xb9, 0x1c, 0x39, 0xc1, 0xcd, 0xe5, 0x4d, 0x98, 0x70, 0x3d, 0x37, 0x72,
-	0x9d, 0x36, 0xb7, 0x3f, 0xc9, 0xed, 0x54, 0x39, 0xe3, 0x4f, 0x2c, 0x1b, 0xb0, 0x0c, 0x3a, 0x89,
-	0xba, 0xe4, 0x35, 0x28, 0xf2, 0xfd, 0x46, 0x0e, 0xe0, 0xe3, 0x3b, 0x5a, 0x70, 0x1f, 0x1b, 0x11,
-	0xa1, 0x27, 0x28, 0xf1, 0xc3, 0x87, 0xc8, 0xc2, 0xa4, 0x8f, 0xdf, 0x72, 0x1c, 0xc7, 0x87, 0x8f,
-	0x14, 0x1c, 0xfb, 0x6a, 0x30, 0x2a, 0x9b, 0x8e, 0xdb, 0xee, 0x05, 0x34, 0xa6, 0x32, 0x96, 0xa4,
-	0x72, 0x3d, 0x05, 0xc7, 0xbe, 0x1a, 0x64, 0x13, 0x26, 0x64, 0x99, 0x70, 0x9b, 0x1b, 0x3f, 0xe1,
-	0x57, 0x72, 0xf7, 0xc8, 0xeb, 0x06, 0x25, 0x4c, 0xd0, 0x25, 0x3d, 0x38, 0xeb, 0x7a, 0x0d, 0xdf,
-	0x6b, 0xb4, 0x7b, 0xa1, 0xbb, 0x43, 0xe3, 0xf0, 0xb8, 0x93, 0x30, 0xbb, 0xb0, 0xbf, 0x37, 0x77,
-	0x76, 0x39, 0x4d, 0x0e, 0xfb, 0x39, 0x90, 0x2f, 0x58, 0x70, 0xa1, 0xe1, 0x7b, 0x21, 0xcf, 0xe2,
-	0xb1, 0x43, 0xaf, 0x05, 0x81, 0x1f, 0x08, 0xde, 0xe5, 0x13, 0xf2, 0xe6, 0x66, 0xcf, 0xc5, 0x2c,
-	0x92, 0x98, 0xcd, 0x89, 0xbc, 0x09, 0xa5, 0x6e, 0xe0, 0xef, 0xb8, 0x4d, 0x1a, 0x48, 0x17, 0xcc,
-	0x95, 0x3c, 0x52, 0x1b, 0xad, 0x49, 0x9a, 0xf1, 0xd2, 0xa3, 0x4a, 0x50, 0xf3, 0xb3, 0xff, 0x6f,
-	0x05, 0x26, 0x93, 0xe8, 0xe4, 0x17, 0x00, 0xba, 0x81, 0xdf, 0xa1, 0xd1, 0x16, 0xd5, 0x61, 0x4e,
-	0xb7, 0x86, 0x4d, 0x5e, 0xa3, 0xe8, 0x29, 0xb7, 0x25, 0xb6, 0x5c, 0xc4, 0xa5, 0x68, 0x70, 0x24,
-	0x01, 0x8c, 0x6f, 0x8b, 0x6d, 0x57, 0x6a, 0x21, 0xaf, 0xe6, 0xa2, 0x33, 0x49, 0xce, 0x3c, 0x3e,
-	0x47, 0x16, 0xa1, 0x62, 0x44, 0x36, 0xa0, 0x70, 0x9f, 0x6e, 0xe4, 0x93, 0x39, 0xe1, 0x2e, 0x95,
-	0xa7, 0x99, 0xda, 0xf8, 0xfe, 0xde, 0x5c, 0xe1, 0x2e, 0xdd, 0x40, 0x46, 0x9c, 0x7d, 0x57, 0x53,
-	0xf8, 0x2e, 0xc8, 0xa5, 0xe2, 0xd5, 0x1c, 0x1d, 0x21, 0xc4, 0x77, 0xc9, 0x22, 0x54, 0x8c, 0xc8,
-	0x9b, 0x50, 0xbe, 0xef, 0xec, 0xd0, 0xcd, 0xc0, 0xf7, 0x22, 0xe9, 0x2b, 0x37, 0x64, 0x70, 0xc9,
-	0x5d, 0x45, 0x4e, 0xf2, 0xe5, 0xdb, 0xbb, 0x2e, 0xc4, 0x98, 0x1d, 0xd9, 0x81, 0x92, 0x47, 0xef,
-	0x23, 0x6d, 0xbb, 0x8d, 0x7c, 0x82, 0x39, 0x6e, 0x49, 0x6a, 0x92, 0x33, 0xdf, 0xf7, 0x54, 0x19,
-	0x6a, 0x5e, 0xac, 0x2f, 0xef, 0xf9, 0x1b, 0x72, 0xa1, 0x1a, 0xb2, 0x2f, 0xf5, 0xc9, 0x54, 0xf4,
-	0xe5, 0x4d, 0x7f, 0x03, 0x19, 0x71, 0x36, 0x47, 0x1a, 0xda, 0x51, 0x4b, 0x2e, 0x53, 0xb7, 0xf2,
-	0x75, 0x50, 0x13, 0x73, 0x24, 0x2e, 0x45, 0x83, 0x23, 0x6b, 0xdb, 0x96, 0x34, 0x56, 0xca, 0x85,
-	0x6a, 0xc8, 0xb6, 0x4d, 0x9a, 0x3e, 0x45, 0xdb, 0xaa, 0x32, 0xd4, 0xbc, 0x18, 0x5f, 0x57, 0x5a,
-	0xfe, 0xf2, 0x59, 0xaa, 0x92, 0x76, 0x44, 0xc1, 0x57, 0x95, 0xa1, 0xe6, 0xc5, 0xda, 0x3b, 0xdc,
-	0xde, 0xbd, 0xef, 0xb4, 0xb7, 0x5d, 0xaf, 0x25, 0xc3, 0x76, 0x87, 0x0d, 0x73, 0xdb, 0xde, 0xbd,
-	0x2b, 0xe8, 0x99, 0xed, 0x1d, 0x97, 0xa2, 0xc1, 0x91, 0xfc, 0x3d, 0x4b, 0x87, 0xe2, 0x4c, 0xe4,
-	0xe1, 0xc4, 0x94, 0x5c, 0x72, 0x65, 0x64, 0x8e, 0x50, 0x14, 0x7f, 0x5a, 0xfb, 0x5d, 0xf2, 0xc2,
-	0xaf, 0xfe, 0xe1, 0xdc, 0x0c, 0xf5, 0x1a, 0x7e, 0xd3, 0xf5, 0x5a, 0x0b, 0xf7, 0x42, 0xdf, 0x9b,
-	0x47, 0xe7, 0xbe, 0xd2, 0xd1, 0xa5, 0x4c, 0xb3, 0x1f, 0x85, 0x8a, 0x41, 0xe2, 0x30, 0x45, 0x6f,
-	0xc2, 0x54, 0xf4, 0x7e, 0x6b, 0x0c, 0x26, 0xcc, 0x3c, 0xa4, 0x47, 0xd0, 0xbe, 0xf4, 0x89, 0x63,
-	0xe4, 0x38, 0x27, 0x0e, 0x76, 0xc4, 0x34, 0x2e, 0xb8, 0x94, 0x79, 0x6b, 0x39, 0x37, 0x85, 0x3b,
-	0x3e, 0x62, 0x1a, 0x85, 0x21, 0x26, 0x98, 0x1e, 0xc3, 0xe7, 0x85, 0xa9, 0xad, 0x42, 0xb1, 0x2b,
-	0x26, 0xd5, 0xd6, 0x84, 0xaa, 0x76, 0x15, 0x20, 0x4e, 0x98, 0x29, 0x2f, 0x3e, 0xb5, 0x3e, 0x6c,
-	0x24, 0xf2, 0x34, 0xb0, 0xc8, 0xd3, 0x30, 0xc6, 0x54, 0x1f, 0xda, 0x94, 0x59, 0x05, 0xf4, 0x39,
-	0xfe, 0x3a, 0x2f, 0x45, 0x09, 0x25, 0x2f, 0x32, 0x2d, 0x35, 0x56, 0x58, 0x64, 0xb2, 0x80, 0xf3,
-	0xb1, 0x96, 0x1a, 0xc3, 0x30, 0x81, 0xc9, 0x44, 0xa7, 0x4c, 0xbf, 0xe0, 0x6b, 0x83, 0x21, 0x3a,
-	0x57, 0x3a, 0x50, 0xc0, 0xb8, 0x5d, 0x29, 0xa5, 0x8f, 0xf0, 0x39, 0x5d, 0x34, 0xec, 0x4a, 0x29,
-	0x38, 0xf6, 0xd5, 0x60, 0x1f, 0x23, 0xef, 0x6c, 0x2b, 0xc2, 0x61, 0x7a, 0xc0, 0x6d, 0xeb, 0x2f,
-	0x9a, 0x67, 0xad, 0x1c, 0xe7, 0x90, 0x18, 0xb5, 0x47, 0x3f, 0x6c, 0x0d, 0x77, 0x2c, 0xfa, 0xb2,
-	0x05, 0x93, 0xc9, 0x6d, 0x28, 0xef, 0xab, 0x0f, 0xf2, 0x97, 0x60, 0x3c, 0x72, 0x3b, 0xd4, 0xef,
-	0x89, 0xc3, 0x76, 0x41, 0xec, 0xec, 0xeb, 0xa2, 0x08, 0x15, 0xcc, 0xfe, 0x87, 0x63, 0x70, 0xee,
-	0x56, 0xcb, 0xf5, 0xd2, 0xb9, 0xe1, 0xb2, 0x1e, 0x82, 0xb0, 0x8e, 0xfd, 0x10, 0x84, 0x8e, 0xdd,
-	0x93, 0xcf, 0x2c, 0x64, 0xc7, 0xee, 0xa9, 0x37, 0x2f, 0x92, 0xb8, 0xe4, 0x0f, 0x2c, 0x78, 0xd2,
-	0x69, 0x8a, 0xf3, 0x83, 0xd3, 0x96, 0xa5, 0x46, 0xfe, 0x72, 0x39, 0xf3, 0xc3, 0x21, 0xb5, 0x81,
-	0xfe, 0x8f, 0x9f, 0xaf, 0x1e, 0xc0, 0x55, 0x8c, 0x8c, 0x9f, 0x92, 0x5f, 0xf0, 0xe4, 0x41, 0xa8,
-	0x78, 0xa0, 0xf8, 0xe4, 0xaf, 0xc2, 0x54, 0xe2, 0x83, 0xa5, 0xc5, 0xbc, 0x2c, 0x2e, 0x36, 0xea,
-	0x49, 0x10, 0xa6, 0x71, 0xc9, 0xf7, 0x2d, 0x98, 0x11, 0xe6, 0xd9, 0x8c, 0xa6, 0x11, 0x37, 0xba,
-	0x7e, 0xfe, 0x4d, 0xb3, 0x38, 0x80, 0xa3, 0x68, 0x96, 0xd8, 0x5e, 0x3b, 0x00, 0x0d, 0x07, 0x8a,
-	0x3c, 0x7b, 0x1b, 0x3e, 0x70, 0x68, 0xbb, 0x1f, 0x2b, 0xdb, 0xfd, 0xab, 0x70, 0xf1, 0x40, 0x69,
-	0x8f, 0x35, 0x63, 0xbf, 0x6b, 0xc1, 0x84, 0x99, 0xe3, 0x8a, 0x3c, 0x0b, 0xa5, 0xc8, 0xdf, 0xa6,
-	0xde, 0x9d, 0x40, 0x79, 0x3d, 0xeb, 0xd5, 0x62, 0x9d, 0x97, 0xe3, 0x0a, 0x6a, 0x0c, 0x86, 0xdd,
-	0x68, 0xbb, 0xd4, 0x8b, 0x96, 0x9b, 0x72, 0x0e, 0x68, 0xec, 0x45, 0x51, 0xbe, 0x84, 0x1a, 0x43,
-	0x38, 0x2a, 0xb2, 0xdf, 0x75, 0xda, 0x08, 0xa8, 0x8a, 0x91, 0x30, 0x1c, 0x15, 0x63, 0x18, 0x26,
-	0x30, 0x89, 0xad, 0xed, 0xc4, 0xa3, 0xf1, 0xe5, 0x50, 0xca, 0xae, 0xfb, 0x6d, 0x0b, 0xca, 0xe2,
-	0x9e, 0x03, 0xe9, 0x66, 0xca, 0x4f, 0x39, 0x65, 0x89, 0xa9, 0xae, 0x2d, 0x67, 0xf9, 0x29, 0x5f,
-	0x86, 0xd1, 0x6d, 0xd7, 0x53, 0x5f, 0xa2, 0xf7, 0xf6, 0x57, 0x5d, 0xaf, 0x89, 0x1c, 0xa2, 0x77,
-	0xff, 0xc2, 0xc0, 0xdd, 0x7f, 0x01, 0xca, 0xda, 0x7b, 0x47, 0xee, 0xa1, 0xda, 0x04, 0xae, 0xbd,
-	0x7d, 0x30, 0xc6, 0xb1, 0x7f, 0xc3, 0x82, 0x49, 0x1e, 0x76, 0x1f, 0x1b, 0x15, 0x5e, 0xd0, 0x0e,
-	0x75, 0x42, 0xee, 0x8b, 0x49, 0x87, 0xba, 0x77, 0xf7, 0xe6, 0x2a, 0x22, 0x50, 0x3f, 0xe9, 0x5f,
-	0xf7, 0x29, 0x69, 0x89, 0xe4, 0x6e, 0x7f, 0x23, 0xc7, 0x36, 0x94, 0xc5, 0x62, 0x2a, 0x22, 0x18,
-	0xd3, 0xb3, 0xdf, 0x82, 0x09, 0x33, 0xa2, 0x8d, 0xbc, 0x00, 0x95, 0xae, 0xeb, 0xb5, 0x92, 0x91,
-	0xcf, 0xfa, 0xb6, 0x66, 0x2d, 0x06, 0xa1, 0x89, 0xc7, 0xab, 0xf9, 0x71, 0xb5, 0xd4, 0x25, 0xcf,
-	0x9a, 0x6f, 0x56, 0x8b, 0xff, 0xd8, 0x1e, 0x40, 0x1c, 0x9e, 0x7d, 0x24, 0x0b, 0xd8, 0x98, 0xb8,
-	0x40, 0x11, 0x1a, 0x1d, 0x4f, 0xb5, 0x31, 0x26, 0x46, 0xf8, 0xbb, 0x7b, 0x07, 0x69, 0x8c, 0xa2,
-	0x16, 0x7f, 0xc8, 0x23, 0x23, 0x52, 0x33, 0xf7, 0x87, 0x3c, 0x32, 0x78, 0xbc, 0x77, 0x0f, 0x79,
-	0x64, 0x09, 0xf3, 0x67, 0xeb, 0x21, 0x8f, 0x4f, 0xc0, 0x71, 0x73, 0xfa, 0x32, 0x05, 0xed, 0xbe,
-	0x99, 0x7b, 0x43, 0xb7, 0xb8, 0x4c, 0xbe, 0x21, 0xa1, 0xf6, 0xf7, 0x46, 0x61, 0x3a, 0x6d, 0xa7,
-	0xc9, 0xdb, 0x05, 0x86, 0x7c, 0xcd, 0x82, 0x49, 0x27, 0x91, 0x3f, 0x31, 0xa7, 0x57, 0xc1, 0x12,
-	0x34, 0x8d, 0xfc, 0x7d, 0x89, 0x72, 0x4c, 0xf1, 0x36, 0x75, 0xad, 0xd1, 0xc1, 0xba, 0x16, 0xdb,
-	0x04, 0x5c, 0xae, 0xf6, 0x06, 0x54, 0xba, 0x73, 0x4f, 0xc7, 0xe6, 0x66, 0x51, 0x8e, 0x1a, 0x83,
-	0x3c, 0x80, 0x71, 0xe1, 0x2c, 0xa3, 0xbc, 0xa2, 0x56, 0x73, 0xb2, 0x27, 0x09, 0x7f, 0x9c, 0xb8,
-	0x0b, 0xc4, 0xff, 0x10, 0x15, 0x3b, 0xa6, 0x63, 0x43, 0xe0, 0x78, 0x2d, 0xca, 0xdb, 0x5c, 0x5a,
-	0x40, 0x5e, 0xcf, 0xcb, 0x74, 0x87, 0x9a, 0x72, 0x35, 0x68, 0x85, 0x32, 0x32, 0x52, 0x97, 0xa1,
-	0xc1, 0xd9, 0xfe, 0x15, 0x0b, 0x66, 0x06, 0x55, 0x64, 0x03, 0x85, 0xaf, 0xba, 0x72, 0x44, 0x19,
-	0x09, 0x19, 0x9c, 0x20, 0x42, 0x01, 0x23, 0x17, 0xa1, 0x40, 0xf5, 0x46, 0xa5, 0xb3, 0x47, 0x5e,
-	0xf3, 0x9a, 0xc8, 0xca, 0xc9, 0x55, 0x18, 0x0d, 0x23, 0xda, 0x4d, 0xc5, 0x3b, 0x8c, 0xb2, 0xc5,
-	0x33, 0xc3, 0x60, 0xcf, 0x71, 0xed, 0x0f, 0xc3, 0x31, 0x53, 0x40, 0xdb, 0xd7, 0x80, 0xa0, 0xdf,
-	0x6e, 0x6f, 0x38, 0x8d, 0xed, 0xbb, 0xae, 0xd7, 0xf4, 0xef, 0xf3, 0x8d, 0x61, 0x01, 0xca, 0x81,
-	0x8c, 0x02, 0x0f, 0xe5, 0x9c, 0xd2, 0x3b, 0x8b, 0x0a, 0x0f, 0x0f, 0x31, 0xc6, 0xb1, 0xbf, 0x3f,
-	0x02, 0xe3, 0x32, 0x65, 0xc1, 0x43, 0x08, 0xb6, 0xd9, 0x4e, 0xb8, 0x38, 0x2c, 0xe7, 0x92, 0x69,
-	0x61, 0x60, 0xa4, 0x4d, 0x98, 0x8a, 0xb4, 0x79, 0x35, 0x1f, 0x76, 0x07, 0x87, 0xd9, 0x7c, 0xa7,
-	0x08, 0x53, 0xa9, 0x14, 0x10, 0xa9, 0x6c, 0xf1, 0xd6, 0x7b, 0x92, 0x2d, 0x9e, 0x84, 0x89, 0x17,
-	0x03, 0xf2, 0x73, 0xcd, 0xfd, 0x8b, 0xc7, 0x03, 0xf2, 0x72, 0x9a, 0x2e, 0xbe, 0x7f, 0x9c, 0xa6,
-	0xff, 0x9b, 0x05, 0x8f, 0x0f, 0x4c, 0x64, 0xc2, 0x53, 0x02, 0x06, 0x49, 0xa8, 0x5c, 0x2f, 0x72,
-	0x4e, 0x0e, 0xa5, 0xdd, 0x21, 0xd2, 0x59, 0xdc, 0xd2, 0xec, 0xc9, 0xf3, 0x30, 0xc1, 0xd7, 0x66,
-	0xb6, 0x72, 0xb2, 0xb5, 0x57, 0xdc, 0xe6, 0xf2, 0x7b, 0xbd, 0xba, 0x51, 0x8e, 0x09, 0x2c, 0xfb,
-	0x5b, 0x16, 0xcc, 0x0c, 0x4a, 0x10, 0x77, 0x04, 0x3d, 0xf7, 0xaf, 0xa4, 0x82, 0x95, 0xe6, 0xfa,
-	0x82, 0x95, 0x52, 0xd6, 0x46, 0x15, 0x97, 0x64, 0x18, 0xfa, 0x0a, 0x87, 0xc4, 0xe2, 0xfc, 0x5e,
-	0x01, 0xa6, 0xa5, 0x88, 0xf1, 0x11, 0xe5, 0xc5, 0x44, 0x88, 0xd5, 0x4f, 0xa5, 0x42, 0xac, 0xce,
-	0xa7, 0xf1, 0xff, 0x22, 0xbe, 0xea, 0xfd, 0x15, 0x5f, 0xf5, 0xd5, 0x22, 0x5c, 0xc8, 0x4c, 0xc5,
-	0x46, 0xbe, 0x92, 0xb1, 0x53, 0xdc, 0xcd, 0x39, 0xe7, 0x9b, 0x0e, 0xc5, 0x3e, 0xdd, 0xa0, 0xa4,
-	0x5f, 0x33, 0x83, 0x81, 0xc4, 0xea, 0xbf, 0x79, 0x0a, 0xd9, 0xeb, 0x8e, 0x1b, 0x17, 0xf4, 0x70,
-	0x5f, 0xd3, 0xfb, 0x33, 0xb0, 0xd4, 0x7f, 0xb5, 0x00, 0x57, 0x8e, 0xda, 0xb2, 0xef, 0xd3, 0x40,
-	0xda, 0x30, 0x11, 0x48, 0xfb, 0x90, 0x54, 0x9b, 0x53, 0x89, 0xa9, 0xfd, 0x07, 0xa3, 0x7a, 0xdf,
-	0xed, 0x9f, 0xb0, 0x47, 0xb2, 0xbc, 0x8c, 0x33, 0xd5, 0x57, 0xbd, 0x39, 0x10, 0xef, 0x0d, 0xe3,
-	0x75, 0x51, 0xfc, 0xee, 0xde, 0xdc, 0xd9, 0x38, 0x67, 0x91, 0x2c, 0x44, 0x55, 0x89, 0x5c, 0x81,
-	0x52, 0x20, 0xa0, 0x2a, 0x74, 0x50, 0x3a, 0x70, 0x89, 0x32, 0xd4, 0x50, 0xf2, 0x79, 0xe3, 0xac,
-	0x30, 0x7a, 0x5a, 0xa9, 0xb9, 0x0e, 0xf2, 0x4b, 0x7b, 0x03, 0x4a, 0xa1, 0x4a, 0x8c, 0x2f, 0xa6,
-	0xd3, 0x73, 0x47, 0x8c, 0x48, 0x75, 0x36, 0x68, 0x5b, 0x65, 0xc9, 0x17, 0xdf, 0xa7, 0x73, 0xe8,
-	0x6b, 0x92, 0xc4, 0xd6, 0x96, 0x09, 0x71, 0x6f, 0x06, 0xfd, 0x56, 0x09, 0x12, 0xc1, 0xb8, 0x7c,
-	0x1d, 0x5b, 0x1e, 0x67, 0x57, 0x73, 0x0a, 0xed, 0x92, 0x8e, 0xff, 0xfc, 0xc0, 0xaf, 0x2c, 0x72,
-	0x8a, 0x95, 0xfd, 0x43, 0x0b, 0x2a, 0x72, 0x8c, 0x3c, 0x84, 0xd0, 0xdc, 0x7b, 0xc9, 0xd0, 0xdc,
-	0x6b, 0xb9, 0x2c, 0xe1, 0x03, 0xe2, 0x72, 0xef, 0xc1, 0x84, 0x99, 0x14, 0x95, 0x7c, 0xd2, 0xd8,
-	0x82, 0xac, 0x61, 0x12, 0xff, 0xa9, 0x4d, 0x2a, 0xde, 0x9e, 0xec, 0x7f, 0x5a, 0xd6, 0xad, 0xc8,
-	0x0f, 0xce, 0xe6, 0xc8, 0xb7, 0x0e, 0x1c, 0xf9, 0xe6, 0xc0, 0x1b, 0xc9, 0x7f, 0xe0, 0xbd, 0x06,
-	0x25, 0xb5, 0x2c, 0x4a, 0x6d, 0xea, 0x29, 0x33, 0x12, 0x80, 0xa9, 0x64, 0x8c, 0x98, 0x31, 0x5d,
-	0xf8, 0x01, 0x38, 0xbe, 0x27, 0x50, 0xcb, 0xb5, 0x26, 0x43, 0xde, 0x84, 0xca, 0x7d, 0x3f, 0xd8,
-	0x6e, 0xfb, 0x0e, 0x7f, 0x8d, 0x04, 0xf2, 0x70, 0x3e, 0xd1, 0xb6, 0x7e, 0x11, 0x8e, 0x75, 0x37,
-	0xa6, 0x8f, 0x26, 0x33, 0x52, 0x85, 0xa9, 0x8e, 0xeb, 0x21, 0x75, 0x9a, 0x3a, 0x02, 0x77, 0x54,
-	0xbc, 0x04, 0xa0, 0x74, 0xfb, 0xd5, 0x24, 0x18, 0xd3, 0xf8, 0xdc, 0x2e, 0x17, 0x24, 0x4c, 0x1d,
-	0x32, 0xdd, 0xf7, 0xda, 0xf0, 0x83, 0x31, 0x69, 0x3e, 0x11, 0xf1, 0x48, 0xc9, 0x72, 0x4c, 0xf1,
-	0x26, 0x9f, 0x83, 0x52, 0xa8, 0xde, 0x9d, 0x2d, 0xe6, 0x78, 0xea, 0xd1, 0x6f, 0xcf, 0xea, 0xae,
-	0xd4, 0x8f, 0xcf, 0x6a, 0x86, 0x64, 0x05, 0xce, 0x2b, 0xdb, 0x4d, 0xe2, 0x09, 0xcd, 0xb1, 0x38,
-	0x65, 0x1d, 0x66, 0xc0, 0x31, 0xb3, 0x16, 0xd3, 0x6d, 0x79, 0xb2, 0x61, 0x71, 0xd9, 0x6f, 0xdc,
-	0x8f, 0xf3, 0xf9, 0xd7, 0x44, 0x09, 0x3d, 0x28, 0xc0, 0xbc, 0x34, 0x44, 0x80, 0x79, 0x1d, 0x2e,
-	0xa4, 0x41, 0x3c, 0x17, 0x21, 0x4f, 0x7f, 0x68, 0x6c, 0xa1, 0x6b, 0x59, 0x48, 0x98, 0x5d, 0x97,
-	0xdc, 0x85, 0x72, 0x40, 0xf9, 0x29, 0xaf, 0xaa, 0xfc, 0x24, 0x8f, 0xed, 0x11, 0x8e, 0x8a, 0x00,
-	0xc6, 0xb4, 0x58, 0xbf, 0x3b, 0xc9, 0xdc, 0xfc, 0xaf, 0xe5, 0xf8, 0x30, 0xbd, 0xec, 0xfb, 0x01,
-	0x39, 0x42, 0xed, 0x7f, 0x3f, 0x05, 0x67, 0x12, 0x06, 0x28, 0xf2, 0x14, 0x14, 0x79, 0x72, 0x46,
-	0xbe, 0x5a, 0x95, 0xe2, 0x15, 0x55, 0x34, 0x8e, 0x80, 0x91, 0x5f, 0xb6, 0x60, 0xaa, 0x9b, 0xb8,
-	0xde, 0x52, 0x0b, 0xf9, 0x90, 0x36, 0xed, 0xe4, 0x9d, 0x99, 0xf1, 0xaa, 0x4d, 0x92, 0x19, 0xa6,
-	0xb9, 0xb3, 0xf5, 0x40, 0x86, 0x55, 0xb4, 0x69, 0xc0, 0xb1, 0xa5, 0xa2, 0xa7, 0x49, 0x2c, 0x26,
-	0xc1, 0x98, 0xc6, 0x67, 0x3d, 0xcc, 0xbf, 0x6e, 0x98, 0xc7, 0x87, 0xab, 0x8a, 0x00, 0xc6, 0xb4,
-	0xc8, 0xcb, 0x30, 0x29, 0x53, 0xb2, 0xaf, 0xf9, 0xcd, 0x1b, 0x4e, 0xb8, 0x25, 0x8f, 0x7c, 0xfa,
-	0x88, 0xba, 0x98, 0x80, 0x62, 0x0a, 0x9b, 0x7f, 0x5b, 0x9c, 0xf7, 0x9e, 0x13, 0x18, 0x4b, 0x3e,
-	0xfa, 0xb3, 0x98, 0x04, 0x63, 0x1a, 0x9f, 0x3c, 0x6b, 0x6c, 0x43, 0xc2, 0x01, 0x47, 0xaf, 0x06,
-	0x19, 0x5b, 0x51, 0x15, 0xa6, 0x7a, 0xfc, 0x84, 0xdc, 0x54, 0x40, 0x39, 0x1f, 0x35, 0xc3, 0x3b,
-	0x49, 0x30, 0xa6, 0xf1, 0xc9, 0x4b, 0x70, 0x26, 0x60, 0x8b, 0xad, 0x26, 0x20, 0xbc, 0x72, 0xb4,
-	0x33, 0x05, 0x9a, 0x40, 0x4c, 0xe2, 0x92, 0x57, 0xe0, 0x6c, 0x9c, 0xb6, 0x57, 0x11, 0x10, 0x6e,
-	0x3a, 0x3a, 0x87, 0x64, 0x35, 0x8d, 0x80, 0xfd, 0x75, 0xc8, 0x5f, 0x87, 0x69, 0xa3, 0x25, 0x96,
-	0xbd, 0x26, 0x7d, 0x20, 0x53, 0xab, 0xf2, 0x47, 0xec, 0x16, 0x53, 0x30, 0xec, 0xc3, 0x26, 0x1f,
-	0x83, 0xc9, 0x86, 0xdf, 0x6e, 0xf3, 0x35, 0x4e, 0x3c, 0x38, 0x23, 0x72, 0xa8, 0x8a, 0x6c, 0xb3,
-	0x09, 0x08, 0xa6, 0x30, 0xc9, 0x4d, 0x20, 0xfe, 0x06, 0x53, 0xaf, 0x68, 0xf3, 0x15, 0xea, 0x51,
-	0xa9, 0x71, 0x9c, 0x49, 0x06, 0x75, 0xdd, 0xee, 0xc3, 0xc0, 0x8c, 0x5a, 0x3c, 0x05, 0xa5, 0x11,
-	0x04, 0x3f, 0x99, 0x47, 0xd2, 0xfb, 0xb4, 0x3d, 0xe7, 0xd0, 0x08, 0xf8, 0x00, 0xc6, 0x84, 0x47,
-	0x44, 0x3e, 0xc9, 0x54, 0xcd, 0xb7, 0x27, 0xe2, 0x3d, 0x42, 0x94, 0xa2, 0xe4, 0x44, 0x7e, 0x01,
-	0xca, 0x1b, 0xea, 0x21, 0x22, 0x9e, 0x41, 0x75, 0xe8, 0x7d, 0x31, 0xf5, 0xa6, 0x56, 0x6c, 0xaf,
-	0xd0, 0x00, 0x8c, 0x59, 0x92, 0xa7, 0xa1, 0x72, 0x63, 0xad, 0xaa, 0x47, 0xe1, 0x59, 0xde, 0xfb,
-	0xa3, 0xac, 0x0a, 0x9a, 0x00, 0x36, 0xc3, 0xb4, 0xfa, 0x46, 0x92, 0x4e, 0x13, 0x19, 0xda, 0x18,
-	0xc3, 0xe6, 0x2e, 0x32, 0x58, 0x9f, 0x39, 0x97, 0xc2, 0x96, 0xe5, 0xa8, 0x31, 0xc8, 0x1b, 0x50,
-	0x91, 0xfb, 0x05, 0x5f, 0x9b, 0xce, 0x9f, 0x2c, 0xc1, 0x02, 0xc6, 0x24, 0xd0, 0xa4, 0xc7, 0xaf,
-	0xef, 0xf9, 0xfb, 0x2c, 0xf4, 0x7a, 0xaf, 0xdd, 0x9e, 0xb9, 0xc0, 0xd7, 0xcd, 0xf8, 0xfa, 0x3e,
-	0x06, 0xa1, 0x89, 0x47, 0x9e, 0x53, 0x2e, 0x91, 0x8f, 0x26, 0xfc, 0x19, 0xb4, 0x4b, 0xa4, 0x56,
-	0xba, 0x07, 0xc4, 0x60, 0x3d, 0x76, 0x88, 0x2f, 0xe2, 0x06, 0xcc, 0x2a, 0x8d, 0xaf, 0x7f, 0x92,
-	0xcc, 0xcc, 0x24, 0x6c, 0x47, 0xb3, 0x77, 0x07, 0x62, 0xe2, 0x01, 0x54, 0xc8, 0x06, 0x14, 0x9c,
-	0xf6, 0xc6, 0xcc, 0xe3, 0x79, 0xa8, 0xae, 0xd5, 0x95, 0x9a, 0x1c, 0x51, 0xdc, 0x6f, 0xba, 0xba,
-	0x52, 0x43, 0x46, 0x9c, 0xb8, 0x30, 0xea, 0xb4, 0x37, 0xc2, 0x99, 0x59, 0x3e, 0x67, 0x73, 0x63,
-	0x12, 0x1b, 0x0f, 0x56, 0x6a, 0x21, 0x72, 0x16, 0xf6, 0x17, 0x46, 0xf4, 0x2d, 0x91, 0xce, 0x67,
-	0xff, 0x96, 0x39, 0x81, 0xc4, 0x71, 0xe7, 0x76, 0x6e, 0x13, 0x48, 0xaa, 0x17, 0x67, 0x06, 0x4e,
-	0x9f, 0xae, 0x5e, 0x32, 0x72, 0x49, 0x84, 0x97, 0xcc, 0xd5, 0x2f, 0x4e, 0xcf, 0xc9, 0x05, 0xc3,
-	0xfe, 0x62, 0x45, 0x5b, 0x41, 0x53, 0x6e, 0x82, 0x01, 0x14, 0xdd, 0x30, 0x72, 0xfd, 0x1c, 0xf3,
-	0x0e, 0xa4, 0x92, 0xdc, 0xf3, 0xb0, 0x26, 0x0e, 0x40, 0xc1, 0x8a, 0xf1, 0xf4, 0x5a, 0xae, 0xf7,
-	0x40, 0x7e, 0xfe, 0x6b, 0xb9, 0x3b, 0xb9, 0x09, 0x9e, 0x1c, 0x80, 0x82, 0x15, 0xb9, 0x27, 0x06,
-	0x75, 0x21, 0x8f, 0xbe, 0xae, 0xae, 0xd4, 0x52, 0xfc, 0x92, 0x83, 0xfb, 0x1e, 0x14, 0xc2, 0x8e,
-	0x2b, 0xd5, 0xa5, 0x21, 0x79, 0xd5, 0x57, 0x97, 0xb3, 0x78, 0xd5, 0x57, 0x97, 0x91, 0x31, 0xe1,
-	0x57, 0xfd, 0x4e, 0x67, 0xc3, 0x09, 0x43, 0xa7, 0xa9, 0xad, 0x33, 0x43, 0x5e, 0xf5, 0x57, 0x35,
-	0xbd, 0x14, 0x6b, 0x7e, 0xd5, 0x1f, 0x43, 0xd1, 0xe0, 0x4c, 0xde, 0x84, 0x71, 0x47, 0x3c, 0x94,
-	0x2a, 0x83, 0x3c, 0xf2, 0x79, 0xfd, 0x37, 0x25, 0x01, 0x37, 0xd3, 0x48, 0x10, 0x2a, 0x86, 0x8c,
-	0x77, 0x14, 0x38, 0x74, 0xd3, 0xdd, 0x96, 0xc6, 0xa1, 0xfa, 0xd0, 0x4f, 0xf9, 0x30, 0x62, 0x59,
-	0xbc, 0x25, 0x08, 0x15, 0x43, 0xf2, 0x65, 0x0b, 0xce, 0x74, 0x1c, 0xcf, 0xd1, 0xa1, 0xbb, 0xf9,
-	0x04, 0x78, 0x9b, 0xc1, 0xc0, 0xb1, 0x86, 0xb8, 0x6a, 0x32, 0xc2, 0x24, 0x5f, 0xb2, 0x03, 0x63,
-	0x0e, 0x7f, 0xc2, 0x59, 0x1e, 0xc5, 0x30, 0x8f, 0xe7, 0xa0, 0x53, 0x6d, 0xc0, 0x17, 0x17, 0xf9,
-	0x50, 0xb4, 0xe4, 0x46, 0x7e, 0xd3, 0x82, 0x71, 0x11, 0x7f, 0xc0, 0x14, 0x52, 0xf6, 0xed, 0x9f,
-	0x39, 0x85, 0xc7, 0x32, 0x64, 0x6c, 0x84, 0x74, 0xce, 0xfa, 0xa0, 0xf6, 0xad, 0x16, 0xa5, 0x07,
-	0x46, 0x47, 0x28, 0xe9, 0x98, 0xea, 0xdb, 0x71, 0x1e, 0x24, 0x1e, 0x6a, 0x32, 0x55, 0xdf, 0xd5,
-	0x14, 0x0c, 0xfb, 0xb0, 0x67, 0x3f, 0x06, 0x13, 0xa6, 0x1c, 0xc7, 0x8a, 0xb0, 0xf8, 0x71, 0x01,
-	0x80, 0x77, 0x95, 0x48, 0xf7, 0xd3, 0xe1, 0xb9, 0xc1, 0xb7, 0xfc, 0x66, 0x4e, 0x0f, 0xc6, 0x1a,
-	0x59, 0x7b, 0x40, 0x26, 0x02, 0xdf, 0xf2, 0x9b, 0x28, 0x99, 0x90, 0x16, 0x8c, 0x76, 0x9d, 0x68,
-	0x2b, 0xff, 0x14, 0x41, 0x25, 0x11, 0xf7, 0x1e, 0x6d, 0x21, 0x67, 0x40, 0xde, 0xb6, 0x62, 0xbf,
-	0xa7, 0x42, 0x1e, 0xe9, 0x8d, 0xe3, 0x36, 0x9b, 0x97, 0x9e, 0x4e, 0xa9, 0x2c, 0xbf, 0x69, 0xff,
-	0xa7, 0xd9, 0x77, 0x2c, 0x98, 0x30, 0x51, 0x33, 0xba, 0xe9, 0xe7, 0xcd, 0x6e, 0xca, 0xb3, 0x3d,
-	0xcc, 0x1e, 0xff, 0x1f, 0x16, 0x00, 0xf6, 0xbc, 0x7a, 0xaf, 0xd3, 0x61, 0x6a, 0xbb, 0x0e, 0x24,
-	0xb1, 0x8e, 0x1c, 0x48, 0x32, 0x72, 0xcc, 0x40, 0x92, 0xc2, 0xb1, 0x02, 0x49, 0x46, 0x8f, 0x1f,
-	0x48, 0x52, 0x1c, 0x1c, 0x48, 0x62, 0x7f, 0xc3, 0x82, 0xb3, 0x7d, 0xfb, 0x15, 0xd3, 0xa4, 0x03,
-	0xdf, 0x8f, 0x06, 0xf8, 0xcf, 0x62, 0x0c, 0x42, 0x13, 0x8f, 0x2c, 0xc1, 0xb4, 0x7c, 0x09, 0xa7,
-	0xde, 0x6d, 0xbb, 0x99, 0xe9, 0x9b, 0xd6, 0x53, 0x70, 0xec, 0xab, 0x61, 0xff, 0x6b, 0x0b, 0x2a,
-	0x46, 0xd2, 0x07, 0xee, 0x73, 0xc6, 0x6f, 0xbc, 0xd2, 0x3e, 0x67, 0xfc, 0xaa, 0x4b, 0xc0, 0xc4,
-	0x35, 0x74, 0xcb, 0x78, 0x27, 0x21, 0xbe, 0x86, 0x66, 0xa5, 0x28, 0xa1, 0x22, 0x03, 0xbe, 0x74,
-	0x3e, 0x2b, 0x98, 0x19, 0xf0, 0x69, 0x57, 0xb8, 0x9a, 0xc5, 0x2e, 0x6e, 0xa3, 0x87, 0xbb, 0xb8,
-	0x15, 0xb3, 0x5d, 0xdc, 0xec, 0xdb, 0x30, 0x21, 0x7c, 0xc3, 0x5f, 0xa5, 0xbb, 0x47, 0x7e, 0x71,
-	0x99, 0x8d, 0xf6, 0x94, 0xcf, 0x1c, 0xab, 0xce, 0xca, 0xed, 0x7f, 0x62, 0x41, 0xea, 0x19, 0x2e,
-	0xe3, 0x06, 0xc6, 0x1a, 0x78, 0x03, 0x63, 0x5a, 0xed, 0x47, 0x0e, 0xb4, 0xda, 0xdf, 0x04, 0xd2,
-	0x61, 0x53, 0x21, 0xb9, 0xd0, 0x16, 0x92, 0xaf, 0x95, 0xac, 0xf6, 0x61, 0x60, 0x46, 0x2d, 0xfb,
-	0x1f, 0x0b, 0x61, 0xcd, 0x87, 0xb9, 0x0e, 0x6f, 0x80, 0x1e, 0x14, 0x39, 0x29, 0x69, 0x7f, 0x1b,
-	0xd2, 0x76, 0xdd, 0x9f, 0xaa, 0x2d, 0xee, 0x48, 0x39, 0xe5, 0x39, 0x37, 0xfb, 0xf7, 0x84, 0xac,
-	0xe6, 0xcb, 0x5d, 0x87, 0xcb, 0xda, 0x49, 0xca, 0x7a, 0x23, 0xaf, 0xb5, 0x32, 0x5b, 0x46, 0x32,
-	0x0f, 0xd0, 0xa5, 0x41, 0x83, 0x7a, 0x91, 0x0a, 0x7d, 0x2b, 0xca, 0x20, 0x6c, 0x5d, 0x8a, 0x06,
-	0x86, 0xfd, 0x75, 0x36, 0x81, 0xe2, 0xb7, 0xc8, 0xc9, 0x95, 0xb4, 0x23, 0x70, 0x7a, 0x72, 0x68,
-	0x3f, 0x60, 0x23, 0x1e, 0x6a, 0xe4, 0x90, 0x78, 0xa8, 0x67, 0x60, 0x3c, 0xf0, 0xdb, 0xb4, 0x1a,
-	0x78, 0x69, 0x1f, 0x1d, 0x64, 0xc5, 0x78, 0x0b, 0x15, 0xdc, 0xfe, 0x75, 0x0b, 0xa6, 0xd3, 0x11,
-	0x9b, 0xb9, 0x7b, 0x27, 0x9b, 0x69, 0x25, 0x0a, 0xc7, 0x4f, 0x2b, 0x61, 0xff, 0x49, 0x11, 0xa6,
-	0xd3, 0x6f, 0x24, 0x32, 0xce, 0x2e, 0x37, 0xb6, 0xa5, 0x56, 0x7f, 0x61, 0x65, 0x13, 0x30, 0x3d,
-	0x5e, 0x46, 0x06, 0x8e, 0x97, 0xeb, 0x50, 0xf6, 0xbb, 0xea, 0xc0, 0x2f, 0x84, 0xbb, 0xa2, 0x8c,
-	0x35, 0xb7, 0x15, 0xe0, 0xdd, 0xbd, 0xb9, 0x73, 0xb1, 0x00, 0xba, 0x18, 0xe3, 0xaa, 0xe4, 0x67,
-	0x94, 0xa5, 0x62, 0x34, 0x91, 0xa8, 0x49, 0x5b, 0x2a, 0xa6, 0xe2, 0xfa, 0x83, 0x8c, 0x15, 0xc5,
-	0xe3, 0x24, 0x8c, 0x19, 0xcb, 0x31, 0x61, 0xcc, 0x5d, 0x28, 0x4b, 0xdb, 0xea, 0x89, 0x12, 0xa5,
-	0x70, 0xc2, 0x77, 0x14, 0x01, 0x8c, 0x69, 0xa5, 0x32, 0xd1, 0x94, 0x72, 0xcd, 0x44, 0xf3, 0x12,
-	0x8c, 0x6f, 0x38, 0x8d, 0x6d, 0x7f, 0x73, 0x93, 0xeb, 0xe7, 0xe5, 0xda, 0x07, 0x54, 0xc3, 0xd5,
-	0x44, 0x71, 0xc6, 0x90, 0x52, 0x35, 0x98, 0x56, 0x40, 0x95, 0x3b, 0xb2, 0x32, 0xfb, 0x6a, 0xad,
-	0x40, 0x3b, 0x2a, 0x87, 0x68, 0x60, 0x91, 0x67, 0xa1, 0xd4, 0x74, 0x43, 0xf1, 0x8a, 0x77, 0x25,
-	0xe9, 0xad, 0xbe, 0x24, 0xcb, 0x51, 0x63, 0x90, 0x97, 0xb5, 0xb7, 0xda, 0x44, 0x1c, 0x48, 0xa2,
-	0x3d, 0xd5, 0x0e, 0x08, 0x24, 0x91, 0xce, 0xb8, 0x6f, 0xb3, 0x89, 0x19, 0xb9, 0x8d, 0x6d, 0xd7,
-	0x13, 0xd9, 0x47, 0xd8, 0x6a, 0xf1, 0x0c, 0x8c, 0x53, 0xf9, 0x8e, 0xb8, 0xb8, 0x3a, 0xd1, 0x83,
-	0x45, 0x3d, 0x1f, 0xae, 0xe0, 0xa4, 0x0a, 0x53, 0xea, 0xc2, 0x58, 0xdd, 0x77, 0x89, 0xac, 0x49,
-	0xda, 0xbe, 0xbe, 0x94, 0x04, 0x63, 0x1a, 0xdf, 0xfe, 0x3c, 0x54, 0x0c, 0x45, 0x8c, 0xeb, 0x2c,
-	0x0f, 0x9c, 0x46, 0x9f, 0x7f, 0xf9, 0x35, 0x56, 0x88, 0x02, 0xc6, 0xaf, 0xe5, 0x44, 0x70, 0x64,
-	0x6a, 0xaf, 0x97, 0x21, 0x91, 0x12, 0xca, 0x88, 0x05, 0xb4, 0x45, 0x1f, 0xa8, 0xa7, 0x5b, 0x14,
-	0x31, 0x64, 0x85, 0x28, 0x60, 0xf6, 0xb3, 0x50, 0x52, 0xb9, 0xed, 0x78, 0x82, 0x28, 0x75, 0x65,
-	0x64, 0x26, 0x88, 0xf2, 0x83, 0x08, 0x39, 0xc4, 0x7e, 0x1d, 0x4a, 0x2a, 0x05, 0xdf, 0xe1, 0xd8,
-	0x6c, 0xfb, 0x0d, 0x3d, 0xf7, 0x86, 0x1f, 0x46, 0x2a, 0x6f, 0xa0, 0xb8, 0xd5, 0xbe, 0xb5, 0xcc,
-	0xcb, 0x50, 0x43, 0xed, 0x3f, 0xb5, 0xa0, 0xb2, 0xbe, 0xbe, 0xa2, 0x8d, 0x5d, 0x08, 0x8f, 0x86,
-	0xa2, 0x85, 0xaa, 0x9b, 0x11, 0x35, 0xdd, 0x67, 0xc4, 0x4a, 0x34, 0xbb, 0xbf, 0x37, 0xf7, 0x68,
-	0x3d, 0x13, 0x03, 0x07, 0xd4, 0x24, 0xcb, 0x70, 0xce, 0x84, 0xc8, 0x7c, 0x2e, 0x52, 0x2f, 0xe0,
-	0x0f, 0xcf, 0xd7, 0xfb, 0xc1, 0x98, 0x55, 0x27, 0x4d, 0x4a, 0xaa, 0xb8, 0xe6, 0x1b, 0xf6, 0xf5,
-	0x7e, 0x30, 0x66, 0xd5, 0xb1, 0x9f, 0x83, 0xa9, 0x94, 0x5f, 0xc7, 0x11, 0xf2, 0x68, 0xfd, 0x6e,
-	0x01, 0x26, 0xcc, 0xeb, 0xfd, 0x23, 0xec, 0xd9, 0x47, 0x57, 0x85, 0x32, 0xae, 0xe4, 0x0b, 0xc7,
-	0xbc, 0x92, 0x37, 0x7d, 0x20, 0x46, 0x4f, 0xd7, 0x07, 0xa2, 0x98, 0x8f, 0x0f, 0x84, 0xe1, 0xab,
-	0x33, 0xf6, 0xf0, 0x7c, 0x75, 0x7e, 0xa7, 0x08, 0x93, 0xc9, 0xc4, 0xcc, 0x47, 0xe8, 0xc9, 0x67,
-	0xfb, 0x7a, 0xf2, 0x98, 0x77, 0x80, 0x85, 0x61, 0xef, 0x00, 0x47, 0x87, 0xbd, 0x03, 0x2c, 0x9e,
-	0xe0, 0x0e, 0xb0, 0xff, 0x06, 0x6f, 0xec, 0xc8, 0x37, 0x78, 0x1f, 0xd7, 0x1b, 0xc5, 0x78, 0xc2,
-	0xed, 0x2d, 0xde, 0x2c, 0x48, 0xb2, 0x1b, 0x16, 0xfd, 0x66, 0xa6, 0x3b, 0x76, 0xe9, 0x10, 0xf5,
-	0x21, 0xc8, 0xf4, 0x42, 0x3e, 0xbe, 0x9b, 0xc1, 0xa3, 0xc7, 0xf0, 0x40, 0x7e, 0x01, 0x2a, 0x72,
-	0x3c, 0xf1, 0x03, 0x27, 0x24, 0x0f, 0xab, 0xf5, 0x18, 0x84, 0x26, 0x1e, 0x1b, 0x18, 0xdd, 0x78,
-	0x82, 0xf0, 0xdb, 0xe8, 0x4a, 0xf2, 0x36, 0x7a, 0x2d, 0x09, 0xc6, 0x34, 0xbe, 0xfd, 0x39, 0xb8,
-	0x90, 0x69, 0x76, 0xe4, 0x57, 0x3e, 0xfc, 0x2c, 0x44, 0x9b, 0x12, 0xc1, 0x10, 0x23, 0xf5, 0x5e,
-	0xd3, 0xec, 0xdd, 0x81, 0x98, 0x78, 0x00, 0x15, 0xfb, 0xb7, 0x0b, 0x30, 0x99, 0x7c, 0xbf, 0x9c,
-	0xdc, 0xd7, 0x97, 0x14, 0xb9, 0xdc, 0x8f, 0x08, 0xb2, 0x46, 0xb2, 0xdf, 0x81, 0x97, 0x9b, 0xf7,
-	0xf9, 0xf8, 0xda, 0xd0, 0x99, 0x87, 0x4f, 0x8f, 0xb1, 0xbc, 0x55, 0x94, 0xec, 0xf8, 0x2b, 0xe0,
-	0x71, 0xbc, 0xbf, 0xb4, 0x5d, 0xe5, 0xce, 0x3d, 0x0e, 0xcd, 0xd6, 0xac, 0xd0, 0x60, 0xcb, 0xf6,
-	0x96, 0x1d, 0x1a, 0xb8, 0x9b, 0x2e, 0x6d, 0xca, 0x87, 0x20, 0xf8, 0xca, 0xfd, 0xba, 0x2c, 0x43,
-	0x0d, 0xb5, 0xdf, 0x1e, 0x81, 0x32, 0x4f, 0x63, 0x78, 0x3d, 0xf0, 0x3b, 0xfc, 0x65, 0xdb, 0xd0,
-	0xb0, 0x13, 0xc8, 0x6e, 0xbb, 0x39, 0xec, 0x63, 0xd5, 0x31, 0x45, 0x19, 0xe2, 0x61, 0x94, 0x60,
-	0x82, 0x23, 0xe9, 0x42, 0x69, 0x53, 0xa6, 0x5d, 0x97, 0x7d, 0x37, 0x64, 0xea, 0x60, 0x95, 0xc4,
-	0x5d, 0x34, 0x81, 0xfa, 0x87, 0x9a, 0x8b, 0xed, 0xc0, 0x54, 0x2a, 0x0f, 0x55, 0xee, 0xc9, 0xda,
-	0xff, 0xd7, 0x28, 0x94, 0x75, 0xe4, 0x25, 0xf9, 0x68, 0xc2, 0x68, 0x1b, 0xeb, 0xf0, 0xd2, 0xda,
-	0xca, 0xce, 0x4d, 0x1a, 0x39, 0x65, 0x80, 0xbd, 0x08, 0x85, 0x5e, 0xd0, 0x4e, 0x5b, 0x65, 0xee,
-	0xe0, 0x0a, 0xb2, 0x72, 0x33, 0x5a, 0xb4, 0xf0, 0x70, 0xa3, 0x45, 0x2f, 0xc3, 0xe8, 0x86, 0xdf,
-	0xdc, 0x4d, 0x3f, 0xd3, 0x58, 0xf3, 0x9b, 0xbb, 0xc8, 0x21, 0xe4, 0x65, 0x98, 0x94, 0x21, 0xb0,
-	0x4a, 0x89, 0x29, 0x72, 0x3d, 0x55, 0x3b, 0xeb, 0xac, 0x27, 0xa0, 0x98, 0xc2, 0x66, 0xbb, 0x2c,
-	0x3b, 0x36, 0xf0, 0x14, 0xfc, 0x63, 0xc9, 0x9b, 0xfd, 0x9b, 0xf5, 0xdb, 0xb7, 0xb8, 0xf1, 0x58,
-	0x63, 0x24, 0xa2, 0x6c, 0xc7, 0x0f, 0x8d, 0xb2, 0x5d, 0x12, 0xb4, 0x99, 0xb4, 0x7c, 0x47, 0x99,
-	0xa8, 0x5d, 0x51, 0x74, 0x59, 0xd9, 0x81, 0x67, 0x17, 0x5d, 0x33, 0x2b, 0x1e, 0xb9, 0xfc, 0xde,
-	0xc5, 0x23, 0xdb, 0x77, 0x60, 0x2a, 0xd5, 0x7f, 0xca, 0xa8, 0x67, 0x65, 0x1b, 0xf5, 0x8e, 0xf6,
-	0xd0, 0xe3, 0x3f, 0xb7, 0xe0, 0x6c, 0xdf, 0x8a, 0x74, 0xd4, 0xc0, 0xf0, 0xf4, 0xde, 0x38, 0x72,
-	0xf2, 0xbd, 0xb1, 0x70, 0xbc, 0xbd, 0xb1, 0xb6, 0xf1, 0xdd, 0x1f, 0x5d, 0x7a, 0xe4, 0x07, 0x3f,
-	0xba, 0xf4, 0xc8, 0xef, 0xff, 0xe8, 0xd2, 0x23, 0x6f, 0xef, 0x5f, 0xb2, 0xbe, 0xbb, 0x7f, 0xc9,
-	0xfa, 0xc1, 0xfe, 0x25, 0xeb, 0xf7, 0xf7, 0x2f, 0x59, 0xff, 0x75, 0xff, 0x92, 0xf5, 0x8d, 0x3f,
-	0xba, 0xf4, 0xc8, 0x27, 0x3f, 0x1e, 0xf7, 0xd4, 0x82, 0xea, 0x29, 0xfe, 0xe3, 0x43, 0xaa, 0x5f,
-	0x16, 0xba, 0xdb, 0xad, 0x05, 0xd6, 0x53, 0x0b, 0xba, 0x44, 0xf5, 0xd4, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0x4e, 0x36, 0xf6, 0x90, 0xb2, 0xad, 0x00, 0x00,
+	// 11194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0xd9,
+	0x75, 0x98, 0xaa, 0x1f, 0x24, 0xfb, 0xf2, 0x31, 0x64, 0xcd, 0xcc, 0x4e, 0xed, 0xec, 0xce, 0x70,
+	0x5c, 0x6b, 0x2b, 0xbb, 0x8e, 0x4c, 0x5a, 0xab, 0x95, 0x22, 0x69, 0x95, 0x4d, 0xba, 0xc9, 0x79,
+	0x70, 0x97, 0x9c, 0xa1, 0x4e, 0x73, 0x76, 0xac, 0xa7, 0x55, 0xec, 0xbe, 0x6c, 0xd6, 0xb2, 0xbb,
+	0xaa, 0x55, 0x55, 0xcd, 0x19, 0x4a, 0x0b, 0xed, 0xca, 0x8a, 0x9e, 0x96, 0x60, 0x49, 0xb6, 0x10,
+	0xe4, 0x81, 0x44, 0x30, 0x1c, 0xd8, 0x89, 0x20, 0x24, 0x30, 0x9c, 0xc4, 0x1f, 0x01, 0x12, 0x44,
+	0x71, 0x20, 0x23, 0xb1, 0xa2, 0x7c, 0x24, 0x72, 0x0c, 0x88, 0xb6, 0xe8, 0x7c, 0x24, 0x8e, 0x03,
+	0x21, 0x80, 0x1c, 0x07, 0x83, 0x00, 0x09, 0xee, 0xfb, 0xde, 0xea, 0x6a, 0x92, 0xcd, 0x2e, 0x72,
+	0x36, 0xb1, 0x7f, 0x08, 0xf6, 0x39, 0xe7, 0x9e, 0x73, 0xab, 0xea, 0x3e, 0xce, 0x3d, 0xf7, 0x3c,
+	0xd0, 0x6a, 0xcb, 0x4f, 0xb6, 0x7b, 0x9b, 0x0b, 0x8d, 0xb0, 0xb3, 0xe8, 0x45, 0xad, 0xb0, 0x1b,
+	0x85, 0xaf, 0xd0, 0x7f, 0x7e, 0x2a, 0x0a, 0xdb, 0xed, 0xb0, 0x97, 0xc4, 0x8b, 0xdd, 0x9d, 0xd6,
+	0xa2, 0xd7, 0xf5, 0xe3, 0x45, 0x09, 0xd9, 0x7d, 0xab, 0xd7, 0xee, 0x6e, 0x7b, 0x6f, 0x5d, 0x6c,
+	0xe1, 0x00, 0x47, 0x5e, 0x82, 0x9b, 0x0b, 0xdd, 0x28, 0x4c, 0x42, 0xfb, 0x3d, 0x8a, 0xdb, 0x82,
+	0xe0, 0x46, 0xff, 0xf9, 0x59, 0xd1, 0x76, 0xa1, 0xbb, 0xd3, 0x5a, 0x20, 0xdc, 0x16, 0x24, 0x44,
+	0x70, 0xbb, 0xfc, 0x53, 0x5a, 0x5f, 0x5a, 0x61, 0x2b, 0x5c, 0xa4, 0x4c, 0x37, 0x7b, 0x5b, 0xf4,
+	0x17, 0xfd, 0x41, 0xff, 0x63, 0xc2, 0x2e, 0x3f, 0xb5, 0xf3, 0xce, 0x78, 0xc1, 0x0f, 0x49, 0xdf,
+	0x16, 0x37, 0xbd, 0xa4, 0xb1, 0xbd, 0xb8, 0xdb, 0xd7, 0xa3, 0xcb, 0xae, 0x46, 0xd4, 0x08, 0x23,
+	0x9c, 0x45, 0xf3, 0x9c, 0xa2, 0xe9, 0x78, 0x8d, 0x6d, 0x3f, 0xc0, 0xd1, 0x9e, 0x7a, 0xea, 0x0e,
+	0x4e, 0xbc, 0xac, 0x56, 0x8b, 0x83, 0x5a, 0x45, 0xbd, 0x20, 0xf1, 0x3b, 0xb8, 0xaf, 0xc1, 0x3b,
+	0x8e, 0x6a, 0x10, 0x37, 0xb6, 0x71, 0xc7, 0xeb, 0x6b, 0xf7, 0xb6, 0x41, 0xed, 0x7a, 0x89, 0xdf,
+	0x5e, 0xf4, 0x83, 0x24, 0x4e, 0xa2, 0x74, 0x23, 0xf7, 0x87, 0x45, 0x54, 0xa9, 0xae, 0xd6, 0xea,
+	0x89, 0x97, 0xf4, 0x62, 0xfb, 0x33, 0x16, 0x9a, 0x6a, 0x87, 0x5e, 0xb3, 0xe6, 0xb5, 0xbd, 0xa0,
+	0x81, 0x23, 0xc7, 0xba, 0x66, 0x3d, 0x3d, 0xf9, 0xec, 0xea, 0xc2, 0x28, 0xdf, 0x6b, 0xa1, 0x7a,
+	0x3f, 0x06, 0x1c, 0x87, 0xbd, 0xa8, 0x81, 0x01, 0x6f, 0xd5, 0x2e, 0x7c, 0x7b, 0x7f, 0xfe, 0x4d,
+	0x07, 0xfb, 0xf3, 0x53, 0xab, 0x9a, 0x24, 0x30, 0xe4, 0xda, 0x5f, 0xb3, 0xd0, 0x5c, 0xc3, 0x0b,
+	0xbc, 0x68, 0x6f, 0xc3, 0x8b, 0x5a, 0x38, 0xb9, 0x19, 0x85, 0xbd, 0xae, 0x53, 0x38, 0x85, 0xde,
+	0x3c, 0xce, 0x7b, 0x33, 0xb7, 0x94, 0x16, 0x07, 0xfd, 0x3d, 0xa0, 0xfd, 0x8a, 0x13, 0x6f, 0xb3,
+	0x8d, 0xf5, 0x7e, 0x15, 0x4f, 0xb3, 0x5f, 0xf5, 0xb4, 0x38, 0xe8, 0xef, 0x81, 0xfd, 0x0c, 0x1a,
+	0xf7, 0x83, 0x56, 0x84, 0xe3, 0xd8, 0x29, 0x5d, 0xb3, 0x9e, 0xae, 0xd4, 0xce, 0xf1, 0xe6, 0xe3,
+	0x2b, 0x0c, 0x0c, 0x02, 0xef, 0xfe, 0x7a, 0x11, 0xcd, 0x55, 0x57, 0x6b, 0x1b, 0x91, 0xb7, 0xb5,
+	0xe5, 0x37, 0x20, 0xec, 0x25, 0x7e, 0xd0, 0xd2, 0x19, 0x58, 0x87, 0x33, 0xb0, 0xdf, 0x8e, 0x26,
+	0x63, 0x1c, 0xed, 0xfa, 0x0d, 0xbc, 0x1e, 0x46, 0x09, 0xfd, 0x28, 0xe5, 0xda, 0x79, 0x4e, 0x3e,
+	0x59, 0x57, 0x28, 0xd0, 0xe9, 0x48, 0xb3, 0x28, 0x0c, 0x13, 0x8e, 0xa7, 0xef, 0xac, 0xa2, 0x9a,
+	0x81, 0x42, 0x81, 0x4e, 0x67, 0x2f, 0xa3, 0x59, 0x2f, 0x08, 0xc2, 0xc4, 0x4b, 0xfc, 0x30, 0x58,
+	0x8f, 0xf0, 0x96, 0xff, 0x80, 0x3f, 0xa2, 0xc3, 0xdb, 0xce, 0x56, 0x53, 0x78, 0xe8, 0x6b, 0x61,
+	0x7f, 0xd9, 0x42, 0xb3, 0x71, 0xe2, 0x37, 0x76, 0xfc, 0x00, 0xc7, 0xf1, 0x52, 0x18, 0x6c, 0xf9,
+	0x2d, 0xa7, 0x4c, 0x3f, 0xdb, 0xed, 0xd1, 0x3e, 0x5b, 0x3d, 0xc5, 0xb5, 0x76, 0x81, 0x74, 0x29,
+	0x0d, 0x85, 0x3e, 0xe9, 0xf6, 0x5f, 0x44, 0x15, 0xfe, 0x46, 0x71, 0xec, 0x8c, 0x5d, 0x2b, 0x3e,
+	0x5d, 0xa9, 0x4d, 0x1f, 0xec, 0xcf, 0x57, 0x56, 0x04, 0x10, 0x14, 0xde, 0x5d, 0x46, 0x4e, 0xb5,
+	0xb3, 0xe9, 0xc5, 0xb1, 0xd7, 0x0c, 0xa3, 0xd4, 0xa7, 0x7b, 0x1a, 0x4d, 0x74, 0xbc, 0x6e, 0xd7,
+	0x0f, 0x5a, 0xe4, 0xdb, 0x11, 0x3e, 0x53, 0x07, 0xfb, 0xf3, 0x13, 0x6b, 0x1c, 0x06, 0x12, 0xeb,
+	0xfe, 0xa7, 0x02, 0x9a, 0xac, 0x06, 0x5e, 0x7b, 0x2f, 0xf6, 0x63, 0xe8, 0x05, 0xf6, 0x47, 0xd0,
+	0x04, 0x59, 0xb5, 0x9a, 0x5e, 0xe2, 0xf1, 0x99, 0xfe, 0xd3, 0x0b, 0x6c, 0x11, 0x59, 0xd0, 0x17,
+	0x11, 0xf5, 0xf8, 0x84, 0x7a, 0x61, 0xf7, 0xad, 0x0b, 0x77, 0x36, 0x5f, 0xc1, 0x8d, 0x64, 0x0d,
+	0x27, 0x5e, 0xcd, 0xe6, 0x5f, 0x01, 0x29, 0x18, 0x48, 0xae, 0x76, 0x88, 0x4a, 0x71, 0x17, 0x37,
+	0xf8, 0xcc, 0x5d, 0x1b, 0x71, 0x86, 0xa8, 0xae, 0xd7, 0xbb, 0xb8, 0x51, 0x9b, 0xe2, 0xa2, 0x4b,
+	0xe4, 0x17, 0x50, 0x41, 0xf6, 0x7d, 0x34, 0x16, 0xd3, 0xb5, 0x8c, 0x4f, 0xca, 0x3b, 0xf9, 0x89,
+	0xa4, 0x6c, 0x6b, 0x33, 0x5c, 0xe8, 0x18, 0xfb, 0x0d, 0x5c, 0x9c, 0xfb, 0x7b, 0x16, 0x3a, 0xaf,
+	0x51, 0x57, 0xa3, 0x56, 0xaf, 0x83, 0x83, 0xc4, 0xbe, 0x86, 0x4a, 0x81, 0xd7, 0xc1, 0x7c, 0x56,
+	0xc9, 0x2e, 0xdf, 0xf6, 0x3a, 0x18, 0x28, 0xc6, 0x7e, 0x0a, 0x95, 0x77, 0xbd, 0x76, 0x0f, 0xd3,
+	0x97, 0x54, 0xa9, 0x4d, 0x73, 0x92, 0xf2, 0xcb, 0x04, 0x08, 0x0c, 0x67, 0xbf, 0x8a, 0x2a, 0xf4,
+	0x9f, 0x1b, 0x51, 0xd8, 0xc9, 0xe9, 0xd1, 0x78, 0x0f, 0x5f, 0x16, 0x6c, 0xd9, 0xf0, 0x93, 0x3f,
+	0x41, 0x09, 0x74, 0x7f, 0xdf, 0x42, 0xe7, 0xb4, 0x87, 0x5b, 0xf5, 0xe3, 0xc4, 0xfe, 0x60, 0xdf,
+	0xe0, 0x59, 0x38, 0xde, 0xe0, 0x21, 0xad, 0xe9, 0xd0, 0x99, 0xe5, 0x4f, 0x3a, 0x21, 0x20, 0xda,
+	0xc0, 0x09, 0x50, 0xd9, 0x4f, 0x70, 0x27, 0x76, 0x0a, 0xd7, 0x8a, 0x4f, 0x4f, 0x3e, 0xbb, 0x92,
+	0xdb, 0x67, 0x54, 0xef, 0x77, 0x85, 0xf0, 0x07, 0x26, 0xc6, 0xfd, 0x8d, 0xa2, 0xf1, 0xf9, 0xd6,
+	0x44, 0x3f, 0x3e, 0x6d, 0xa1, 0xb1, 0xb6, 0xb7, 0x89, 0xdb, 0x6c, 0x6e, 0x4d, 0x3e, 0xfb, 0xa1,
+	0xdc, 0x7a, 0x22, 0x64, 0x2c, 0xac, 0x52, 0xfe, 0xd7, 0x83, 0x24, 0xda, 0x53, 0xc3, 0x8b, 0x01,
+	0x81, 0x0b, 0xb7, 0xff, 0x86, 0x85, 0x26, 0xd5, 0xaa, 0x26, 0x5e, 0xcb, 0x66, 0xfe, 0x9d, 0x51,
+	0x8b, 0x29, 0xef, 0x91, 0x5c, 0xa2, 0x35, 0x0c, 0xe8, 0x7d, 0xb9, 0xfc, 0x2e, 0x34, 0xa9, 0x3d,
+	0x82, 0x3d, 0x8b, 0x8a, 0x3b, 0x78, 0x8f, 0x0d, 0x78, 0x20, 0xff, 0xda, 0x17, 0x8c, 0x11, 0xce,
+	0x87, 0xf4, 0xbb, 0x0b, 0xef, 0xb4, 0x2e, 0xbf, 0x80, 0x66, 0xd3, 0x02, 0x87, 0x69, 0xef, 0xfe,
+	0xa3, 0xb2, 0x31, 0x30, 0xc9, 0x42, 0x60, 0x87, 0x68, 0xbc, 0x83, 0x93, 0xc8, 0x6f, 0x88, 0x4f,
+	0xb6, 0x3c, 0xda, 0x5b, 0x5a, 0xa3, 0xcc, 0xd4, 0x86, 0xc8, 0x7e, 0xc7, 0x20, 0xa4, 0xd8, 0xdb,
+	0xa8, 0xe4, 0x45, 0x2d, 0xf1, 0x4d, 0x6e, 0xe4, 0x33, 0x2d, 0xd5, 0x52, 0x51, 0x8d, 0x5a, 0x31,
+	0x50, 0x09, 0xf6, 0x22, 0xaa, 0x24, 0x38, 0xea, 0xf8, 0x81, 0x97, 0xb0, 0x1d, 0x74, 0xa2, 0x36,
+	0xc7, 0xc9, 0x2a, 0x1b, 0x02, 0x01, 0x8a, 0xc6, 0x6e, 0xa3, 0xb1, 0x66, 0xb4, 0x07, 0xbd, 0xc0,
+	0x29, 0xe5, 0xf1, 0x2a, 0x96, 0x29, 0x2f, 0x35, 0x48, 0xd9, 0x6f, 0xe0, 0x32, 0xec, 0x5f, 0xb1,
+	0xd0, 0x85, 0x0e, 0xf6, 0xe2, 0x5e, 0x84, 0xc9, 0x23, 0x00, 0x4e, 0x70, 0x40, 0x3e, 0xac, 0x53,
+	0xa6, 0xc2, 0x61, 0xd4, 0xef, 0xd0, 0xcf, 0xb9, 0xf6, 0x24, 0xef, 0xca, 0x85, 0x2c, 0x2c, 0x64,
+	0xf6, 0xc6, 0x7e, 0x15, 0x4d, 0x26, 0x49, 0xbb, 0x9e, 0x44, 0x5e, 0x82, 0x5b, 0x7b, 0xce, 0xd8,
+	0x35, 0x6b, 0xf4, 0x15, 0x66, 0x63, 0x63, 0x55, 0x30, 0xac, 0x9d, 0x23, 0xb3, 0x45, 0x03, 0x80,
+	0x2e, 0xce, 0xfd, 0xcd, 0x32, 0x9a, 0xeb, 0xdb, 0x56, 0xec, 0xe7, 0x50, 0xb9, 0xbb, 0xed, 0xc5,
+	0x62, 0x9f, 0xb8, 0x2a, 0x16, 0xa9, 0x75, 0x02, 0x7c, 0xb8, 0x3f, 0x3f, 0x2d, 0x9a, 0x50, 0x00,
+	0x30, 0x62, 0xa2, 0xb5, 0x75, 0x70, 0x1c, 0x7b, 0x2d, 0xb1, 0x79, 0x68, 0x83, 0x94, 0x82, 0x41,
+	0xe0, 0xed, 0xcf, 0x5a, 0x68, 0x9a, 0x0d, 0x58, 0xc0, 0x71, 0xaf, 0x9d, 0x90, 0x0d, 0x92, 0x7c,
+	0x94, 0x17, 0xf3, 0x98, 0x1c, 0x8c, 0x65, 0xed, 0x22, 0x97, 0x3e, 0xad, 0x43, 0x63, 0x30, 0xe5,
+	0xda, 0xf7, 0x50, 0x25, 0x4e, 0xbc, 0x28, 0xc1, 0xcd, 0x6a, 0x42, 0x55, 0xb9, 0xc9, 0x67, 0x7f,
+	0xf2, 0x78, 0x3b, 0xc7, 0x86, 0xdf, 0xc1, 0x6c, 0x97, 0xaa, 0x0b, 0x06, 0xa0, 0x78, 0xd9, 0xaf,
+	0x22, 0x14, 0xf5, 0x82, 0x7a, 0xaf, 0xd3, 0xf1, 0xa2, 0x3d, 0xae, 0xdd, 0xdd, 0x1a, 0xed, 0xf1,
+	0x40, 0xf2, 0x53, 0x8a, 0x8e, 0x82, 0x81, 0x26, 0xcf, 0xfe, 0xa4, 0x85, 0xa6, 0xd9, 0x3c, 0x10,
+	0x3d, 0x18, 0xcb, 0xb9, 0x07, 0x73, 0xe4, 0xd5, 0x2e, 0xeb, 0x22, 0xc0, 0x94, 0x68, 0x7f, 0x08,
+	0x4d, 0x36, 0xc2, 0x4e, 0xb7, 0x8d, 0xd9, 0xcb, 0x1d, 0x1f, 0xfa, 0xe5, 0xd2, 0xa1, 0xbb, 0xa4,
+	0x58, 0x80, 0xce, 0xcf, 0xfd, 0x0f, 0xa6, 0x8e, 0x23, 0x86, 0xb4, 0xfd, 0x01, 0xf4, 0x78, 0xdc,
+	0x6b, 0x34, 0x70, 0x1c, 0x6f, 0xf5, 0xda, 0xd0, 0x0b, 0x6e, 0xf9, 0x71, 0x12, 0x46, 0x7b, 0xab,
+	0x7e, 0xc7, 0x4f, 0xe8, 0x80, 0x2e, 0xd7, 0xae, 0x1c, 0xec, 0xcf, 0x3f, 0x5e, 0x1f, 0x44, 0x04,
+	0x83, 0xdb, 0xdb, 0x1e, 0x7a, 0xa2, 0x17, 0x0c, 0x66, 0xcf, 0x8e, 0x1f, 0xf3, 0x07, 0xfb, 0xf3,
+	0x4f, 0xdc, 0x1d, 0x4c, 0x06, 0x87, 0xf1, 0x70, 0xff, 0xc8, 0x42, 0xb3, 0xe2, 0xb9, 0x36, 0x70,
+	0xa7, 0xdb, 0x26, 0x4b, 0xe7, 0xe9, 0x2b, 0xc7, 0x89, 0xa1, 0x1c, 0x43, 0x3e, 0x7b, 0xb9, 0xe8,
+	0xff, 0x20, 0x0d, 0xd9, 0xfd, 0xaf, 0x16, 0xba, 0x90, 0x26, 0x3e, 0x03, 0x85, 0x2e, 0x36, 0x15,
+	0xba, 0xdb, 0xf9, 0x3e, 0xed, 0x00, 0xad, 0xee, 0xf3, 0xda, 0x80, 0x15, 0xa4, 0x80, 0xb7, 0xec,
+	0x77, 0xa2, 0xa9, 0x84, 0xff, 0xbc, 0xad, 0x94, 0x73, 0x69, 0x98, 0xd8, 0xd0, 0x70, 0x60, 0x50,
+	0x92, 0x96, 0x8d, 0x76, 0x2f, 0x4e, 0x70, 0x54, 0x6f, 0x84, 0x5d, 0xb6, 0xec, 0x4e, 0xa8, 0x96,
+	0x4b, 0x1a, 0x0e, 0x0c, 0x4a, 0xf7, 0xe7, 0xcb, 0xfd, 0xef, 0xfd, 0xff, 0x77, 0x7d, 0x45, 0xa9,
+	0x1f, 0xc5, 0x47, 0xa9, 0x7e, 0x94, 0xde, 0x50, 0xea, 0xc7, 0xcf, 0x59, 0x44, 0x8b, 0x63, 0x03,
+	0x20, 0xe6, 0xaa, 0xd1, 0x7b, 0xf3, 0x9d, 0x0e, 0xc4, 0x80, 0xa4, 0x29, 0x86, 0x5c, 0x16, 0x28,
+	0xb1, 0xee, 0xaf, 0x95, 0xd0, 0x54, 0x35, 0x48, 0xfc, 0xea, 0xd6, 0x96, 0x1f, 0xf8, 0xc9, 0x9e,
+	0xfd, 0xc5, 0x02, 0x5a, 0xec, 0x46, 0x78, 0x0b, 0x47, 0x11, 0x6e, 0x2e, 0xf7, 0x22, 0x3f, 0x68,
+	0xd5, 0x1b, 0xdb, 0xb8, 0xd9, 0x6b, 0xfb, 0x41, 0x6b, 0xa5, 0x15, 0x84, 0x12, 0x7c, 0xfd, 0x01,
+	0x6e, 0xf4, 0xe8, 0x7b, 0x65, 0xab, 0x44, 0x67, 0xb4, 0xbe, 0xaf, 0x0f, 0x27, 0xb4, 0xf6, 0xb6,
+	0x83, 0xfd, 0xf9, 0xc5, 0x21, 0x1b, 0xc1, 0xb0, 0x8f, 0x66, 0x7f, 0xae, 0x80, 0x16, 0x22, 0xfc,
+	0xd1, 0x9e, 0x7f, 0xfc, 0xb7, 0xc1, 0x96, 0xf1, 0xf6, 0x88, 0xdb, 0xfd, 0x50, 0x32, 0x6b, 0xcf,
+	0x1e, 0xec, 0xcf, 0x0f, 0xd9, 0x06, 0x86, 0x7c, 0x2e, 0x77, 0x1d, 0x4d, 0x56, 0xbb, 0x7e, 0xec,
+	0x3f, 0x20, 0x06, 0x27, 0x7c, 0x0c, 0x83, 0xc6, 0x3c, 0x2a, 0x47, 0xbd, 0x36, 0x66, 0x0b, 0x4c,
+	0xa5, 0x56, 0x21, 0xcb, 0x32, 0x10, 0x00, 0x30, 0xb8, 0xfb, 0x73, 0x64, 0x0b, 0xa2, 0x2c, 0x53,
+	0xa6, 0xac, 0x57, 0x50, 0x39, 0x22, 0x42, 0x1c, 0x2b, 0x0f, 0x9d, 0x5c, 0xeb, 0x35, 0xef, 0x04,
+	0xf9, 0x17, 0x98, 0x08, 0xf7, 0x5b, 0x05, 0x74, 0xb1, 0xda, 0xed, 0xae, 0xe1, 0x78, 0x3b, 0xd5,
+	0x8b, 0x5f, 0xb0, 0xd0, 0xcc, 0xae, 0x1f, 0x25, 0x3d, 0xaf, 0x2d, 0xac, 0x95, 0xac, 0x3f, 0xf5,
+	0x51, 0xfb, 0x43, 0xa5, 0xbd, 0x6c, 0xb0, 0xae, 0xd9, 0x07, 0xfb, 0xf3, 0x33, 0x26, 0x0c, 0x52,
+	0xe2, 0xed, 0xbf, 0x6e, 0xa1, 0x59, 0x0e, 0xba, 0x1d, 0x36, 0xb1, 0x6e, 0x0d, 0xbf, 0x9b, 0x67,
+	0x9f, 0x24, 0x73, 0x66, 0xc5, 0x4c, 0x43, 0xa1, 0xaf, 0x13, 0xee, 0x7f, 0x2f, 0xa0, 0x4b, 0x03,
+	0x78, 0xd8, 0xbf, 0x6a, 0xa1, 0x0b, 0xcc, 0x84, 0xae, 0xa1, 0x00, 0x6f, 0xf1, 0xb7, 0xf9, 0xbe,
+	0xbc, 0x7b, 0x0e, 0x64, 0x8a, 0xe3, 0xa0, 0x81, 0x6b, 0x0e, 0x59, 0x92, 0x97, 0x32, 0x44, 0x43,
+	0x66, 0x87, 0x68, 0x4f, 0x99, 0x51, 0x3d, 0xd5, 0xd3, 0xc2, 0x99, 0xf4, 0xb4, 0x9e, 0x21, 0x1a,
+	0x32, 0x3b, 0xe4, 0xfe, 0x15, 0xf4, 0xc4, 0x21, 0xec, 0x8e, 0x9e, 0x9c, 0xee, 0x87, 0xd0, 0x45,
+	0x93, 0x81, 0x18, 0x63, 0x47, 0xcf, 0x6b, 0x17, 0x8d, 0xd1, 0xa9, 0x23, 0x26, 0x36, 0x22, 0x7b,
+	0x30, 0x9d, 0x53, 0x31, 0x70, 0x8c, 0xfb, 0x2d, 0x0b, 0x4d, 0x0c, 0x61, 0xfb, 0x9c, 0x37, 0x6d,
+	0x9f, 0x95, 0x3e, 0xbb, 0x67, 0xd2, 0x6f, 0xf7, 0xbc, 0x39, 0xda, 0xd7, 0x38, 0x8e, 0xbd, 0xf3,
+	0x87, 0x16, 0x9a, 0xeb, 0xb3, 0x8f, 0xda, 0xdb, 0xe8, 0x42, 0x37, 0x6c, 0x8a, 0xed, 0xf4, 0x96,
+	0x17, 0x6f, 0x53, 0x1c, 0x7f, 0xbc, 0xe7, 0xc8, 0x97, 0x5c, 0xcf, 0xc0, 0x3f, 0xdc, 0x9f, 0x77,
+	0x24, 0x93, 0x14, 0x01, 0x64, 0x72, 0xb4, 0xbb, 0x68, 0x62, 0xcb, 0xc7, 0xed, 0xa6, 0x1a, 0x82,
+	0x23, 0x6a, 0x69, 0x37, 0x38, 0x37, 0x76, 0x35, 0x20, 0x7e, 0x81, 0x94, 0xe2, 0xfe, 0xc8, 0x42,
+	0x33, 0xd5, 0x5e, 0xb2, 0x4d, 0x74, 0x94, 0x06, 0xb5, 0xc6, 0x11, 0x13, 0x6c, 0xec, 0xb7, 0x76,
+	0x9f, 0xcb, 0x67, 0x31, 0xae, 0x13, 0x56, 0xfc, 0x8a, 0x44, 0x2a, 0xeb, 0x14, 0x08, 0x4c, 0x8c,
+	0x1d, 0xa1, 0xb1, 0xd0, 0xeb, 0x25, 0xdb, 0xcf, 0xf2, 0x47, 0x1e, 0xd1, 0x32, 0x71, 0x87, 0x3c,
+	0xce, 0xb3, 0x5c, 0xa2, 0x54, 0x19, 0x19, 0x14, 0xb8, 0x24, 0xf7, 0x35, 0x34, 0x63, 0xde, 0xbb,
+	0x1d, 0x63, 0xcc, 0x5e, 0x41, 0x45, 0x2f, 0x0a, 0xf8, 0x88, 0x9d, 0xe4, 0x04, 0xc5, 0x2a, 0xdc,
+	0x06, 0x02, 0xb7, 0xdf, 0x82, 0x26, 0xb6, 0x7a, 0xed, 0x36, 0x69, 0xc0, 0x2f, 0xb9, 0xe4, 0xb1,
+	0xe8, 0x06, 0x87, 0x83, 0xa4, 0x70, 0xff, 0xc9, 0x18, 0x3a, 0x57, 0x6b, 0xf7, 0xf0, 0xcd, 0x08,
+	0x63, 0x61, 0x0b, 0xaa, 0xa2, 0x73, 0xdd, 0x08, 0xef, 0xfa, 0xf8, 0x7e, 0x1d, 0xb7, 0x71, 0x23,
+	0x09, 0x23, 0xde, 0x9b, 0x4b, 0x9c, 0xd1, 0xb9, 0x75, 0x13, 0x0d, 0x69, 0x7a, 0xfb, 0x05, 0x34,
+	0xe3, 0x35, 0x12, 0x7f, 0x17, 0x4b, 0x0e, 0xac, 0xbb, 0x8f, 0x71, 0x0e, 0x33, 0x55, 0x03, 0x0b,
+	0x29, 0x6a, 0xfb, 0x83, 0xc8, 0x89, 0x1b, 0x5e, 0x1b, 0xdf, 0xed, 0x72, 0x51, 0x4b, 0xdb, 0xb8,
+	0xb1, 0xb3, 0x1e, 0xfa, 0x41, 0xc2, 0xed, 0x8e, 0xd7, 0x38, 0x27, 0xa7, 0x3e, 0x80, 0x0e, 0x06,
+	0x72, 0xb0, 0xff, 0xb9, 0x85, 0xae, 0x74, 0x23, 0xbc, 0x1e, 0x85, 0x9d, 0x90, 0x0c, 0xb5, 0x3e,
+	0x73, 0x18, 0x37, 0x0b, 0xbd, 0x3c, 0xa2, 0x2e, 0xc5, 0x20, 0xfd, 0x77, 0x38, 0x3f, 0x76, 0xb0,
+	0x3f, 0x7f, 0x65, 0xfd, 0xb0, 0x0e, 0xc0, 0xe1, 0xfd, 0xb3, 0xff, 0xa5, 0x85, 0xae, 0x76, 0xc3,
+	0x38, 0x39, 0xe4, 0x11, 0xca, 0xa7, 0xfa, 0x08, 0xee, 0xc1, 0xfe, 0xfc, 0xd5, 0xf5, 0x43, 0x7b,
+	0x00, 0x47, 0xf4, 0xd0, 0xbe, 0x81, 0xec, 0x84, 0x69, 0x3e, 0xf7, 0xb0, 0xdf, 0xda, 0x4e, 0x56,
+	0x82, 0x26, 0x7e, 0x40, 0xad, 0x56, 0xe5, 0xda, 0x63, 0x07, 0xfb, 0xf3, 0xf6, 0x46, 0x1f, 0x16,
+	0x32, 0x5a, 0xd8, 0x31, 0x1a, 0xbf, 0x4f, 0x7f, 0xc6, 0xce, 0x78, 0x1e, 0x37, 0xe1, 0x86, 0xd8,
+	0xb8, 0x36, 0x49, 0x0e, 0xb1, 0xfc, 0x07, 0x08, 0x49, 0xee, 0x9f, 0x4e, 0xa3, 0x39, 0x6d, 0xe2,
+	0x70, 0x4b, 0xd4, 0xf3, 0x68, 0x5a, 0x8c, 0x64, 0xa5, 0xb8, 0x55, 0x94, 0x61, 0xb2, 0xaa, 0x23,
+	0xc1, 0xa4, 0x25, 0x93, 0x46, 0xce, 0x23, 0xd6, 0x3a, 0x35, 0x69, 0xd6, 0x0d, 0x2c, 0xa4, 0xa8,
+	0xed, 0x15, 0x74, 0x9e, 0x43, 0x00, 0x77, 0xdb, 0x7e, 0xc3, 0x5b, 0x0a, 0x7b, 0x7c, 0xbe, 0x94,
+	0x6b, 0x97, 0x0e, 0xf6, 0xe7, 0xcf, 0xaf, 0xf7, 0xa3, 0x21, 0xab, 0x8d, 0xbd, 0x8a, 0x2e, 0x78,
+	0xbd, 0x24, 0x94, 0x1f, 0xef, 0x7a, 0x40, 0x74, 0x81, 0x26, 0x9d, 0x17, 0x13, 0x4c, 0x69, 0xa8,
+	0x66, 0xe0, 0x21, 0xb3, 0x95, 0xbd, 0x9e, 0xe2, 0x56, 0xc7, 0x8d, 0x30, 0x68, 0xb2, 0x21, 0x5a,
+	0x56, 0x67, 0xd8, 0x6a, 0x06, 0x0d, 0x64, 0xb6, 0xb4, 0xdb, 0x68, 0xa6, 0xe3, 0x3d, 0xb8, 0x1b,
+	0x78, 0xbb, 0x9e, 0xdf, 0x26, 0x42, 0x9c, 0xb1, 0x23, 0x4c, 0x64, 0xbd, 0xc4, 0x6f, 0x2f, 0x30,
+	0x27, 0x94, 0x85, 0x95, 0x20, 0xb9, 0x13, 0xd5, 0x13, 0x72, 0xcc, 0x60, 0xea, 0xef, 0x9a, 0xc1,
+	0x0b, 0x52, 0xbc, 0xed, 0x3b, 0xe8, 0x22, 0x5d, 0x4b, 0x96, 0xc3, 0xfb, 0xc1, 0x32, 0x6e, 0x7b,
+	0x7b, 0xe2, 0x01, 0xc6, 0xe9, 0x03, 0x3c, 0x7e, 0xb0, 0x3f, 0x7f, 0xb1, 0x9e, 0x45, 0x00, 0xd9,
+	0xed, 0x88, 0x4d, 0xd1, 0x44, 0x00, 0xde, 0xf5, 0x63, 0x3f, 0x0c, 0x98, 0x4d, 0x71, 0x42, 0xd9,
+	0x14, 0xeb, 0x83, 0xc9, 0xe0, 0x30, 0x1e, 0xf6, 0xdf, 0xb2, 0xd0, 0x85, 0xac, 0x35, 0xc4, 0xa9,
+	0xe4, 0x71, 0x15, 0x9e, 0x5a, 0x17, 0xd8, 0x88, 0xc8, 0x5c, 0xd1, 0x32, 0x3b, 0x61, 0xbf, 0x6e,
+	0xa1, 0x29, 0x4f, 0x3b, 0xfe, 0x3b, 0x28, 0x8f, 0x2d, 0x57, 0x37, 0x28, 0xd4, 0x66, 0x89, 0x3d,
+	0x4c, 0x87, 0x80, 0x21, 0xd1, 0xfe, 0x3b, 0x16, 0xba, 0x98, 0xb9, 0x40, 0x39, 0x93, 0xa7, 0xf1,
+	0x86, 0xe8, 0x20, 0xc9, 0x5e, 0x30, 0xb3, 0xbb, 0x41, 0x7c, 0x46, 0xc4, 0xbe, 0x2a, 0x6e, 0x47,
+	0x9d, 0xa9, 0x6b, 0xd6, 0xe8, 0xd6, 0x1a, 0x4d, 0x07, 0x14, 0x8c, 0x6b, 0xe7, 0xb5, 0x6d, 0x5d,
+	0x00, 0x21, 0x2d, 0xde, 0xfe, 0x92, 0x25, 0xf6, 0x75, 0xd9, 0xa3, 0xe9, 0xd3, 0xea, 0x91, 0xad,
+	0xd4, 0x04, 0xd9, 0xa1, 0x94, 0x70, 0xfb, 0xc3, 0xe8, 0xb2, 0xb7, 0x19, 0x46, 0x49, 0xe6, 0xe4,
+	0x73, 0x66, 0xe8, 0x34, 0xba, 0x7a, 0xb0, 0x3f, 0x7f, 0xb9, 0x3a, 0x90, 0x0a, 0x0e, 0xe1, 0x60,
+	0x7f, 0xc5, 0x42, 0x33, 0x89, 0x71, 0x38, 0x77, 0xce, 0xe5, 0x71, 0xea, 0x95, 0x1b, 0x87, 0x79,
+	0xf2, 0x67, 0xcf, 0x6c, 0xc2, 0x20, 0xd5, 0x01, 0xfb, 0x35, 0x34, 0xd5, 0xf5, 0x7a, 0x31, 0x26,
+	0xf7, 0x25, 0x61, 0x2f, 0x71, 0x66, 0x73, 0xd1, 0x8e, 0x13, 0xdc, 0xe5, 0x0c, 0xd9, 0xc4, 0x59,
+	0xd7, 0x44, 0x80, 0x21, 0xd0, 0xfd, 0x6f, 0x16, 0xba, 0x34, 0xe0, 0x01, 0xec, 0x5f, 0xb3, 0xd0,
+	0x45, 0xce, 0xdd, 0xc4, 0xe4, 0x63, 0xc1, 0x80, 0x2c, 0xd6, 0xb5, 0x2b, 0x7c, 0x03, 0xb9, 0x98,
+	0x89, 0x86, 0xec, 0x0e, 0xd9, 0x3f, 0xa1, 0xb4, 0x06, 0x72, 0x9c, 0x2c, 0x0f, 0xd8, 0xe7, 0xff,
+	0x4b, 0x01, 0xcd, 0xd4, 0x7a, 0x51, 0x00, 0x6c, 0x6c, 0x46, 0x7e, 0x83, 0xdc, 0x82, 0x87, 0xf4,
+	0x3e, 0xc5, 0xdf, 0x15, 0x1b, 0xbc, 0x34, 0x76, 0xde, 0x11, 0x08, 0x50, 0x34, 0xf6, 0x4d, 0x34,
+	0x19, 0x6f, 0x87, 0x51, 0x72, 0xcf, 0x0f, 0x9a, 0xe1, 0x7d, 0xbe, 0xab, 0xff, 0x84, 0xf4, 0x58,
+	0x53, 0xa8, 0x87, 0xfb, 0xf3, 0x33, 0xcb, 0xbd, 0x88, 0x9e, 0x7f, 0xd8, 0xfe, 0x04, 0x7a, 0x4b,
+	0x7b, 0x19, 0xa1, 0x76, 0x18, 0xb4, 0x38, 0x1f, 0xa6, 0xdd, 0xff, 0x38, 0xe7, 0x83, 0x56, 0x25,
+	0x26, 0x83, 0x8d, 0xd6, 0xce, 0x7e, 0x11, 0xd9, 0x5b, 0x5e, 0x9c, 0x90, 0xa7, 0x5a, 0xeb, 0xb5,
+	0x13, 0xbf, 0xdb, 0xf6, 0x71, 0xc4, 0x9d, 0xda, 0x2e, 0x73, 0x6e, 0xf6, 0x8d, 0x3e, 0x0a, 0xc8,
+	0x68, 0x45, 0x78, 0xc5, 0xed, 0xf0, 0x7e, 0x8a, 0x57, 0xd9, 0xe4, 0x55, 0xef, 0xa3, 0x80, 0x8c,
+	0x56, 0xee, 0x77, 0x26, 0xd1, 0x14, 0x33, 0x9a, 0x70, 0x05, 0xf1, 0x9f, 0x59, 0xe8, 0xc9, 0x46,
+	0x2f, 0x8a, 0x70, 0x90, 0x90, 0x01, 0xda, 0xaf, 0xe3, 0x5a, 0xa7, 0xaa, 0xe3, 0x5e, 0x3b, 0xd8,
+	0x9f, 0x7f, 0x72, 0xe9, 0x10, 0xf9, 0x70, 0x68, 0xef, 0xec, 0xef, 0x58, 0xc8, 0xe5, 0x04, 0x35,
+	0xaf, 0xb1, 0xd3, 0x8a, 0xc2, 0x5e, 0xd0, 0xec, 0x7f, 0x88, 0xc2, 0xa9, 0x3e, 0xc4, 0x9b, 0x0f,
+	0xf6, 0xe7, 0xdd, 0xa5, 0x23, 0x7b, 0x01, 0xc7, 0xe8, 0xa9, 0x7d, 0x13, 0xcd, 0x71, 0xaa, 0xeb,
+	0x0f, 0xba, 0x38, 0xf2, 0x89, 0x79, 0x82, 0x8f, 0x42, 0xe5, 0xc6, 0x9a, 0x26, 0x80, 0xfe, 0x36,
+	0xba, 0xc6, 0x5e, 0x3a, 0x2b, 0x8d, 0xdd, 0xbe, 0x8d, 0x66, 0x98, 0x49, 0x6b, 0xdd, 0x0f, 0x5a,
+	0xeb, 0x61, 0xd0, 0xe2, 0xc3, 0xf4, 0xcd, 0x42, 0xbd, 0xae, 0x1b, 0xd8, 0x87, 0x64, 0x15, 0xe4,
+	0xff, 0x6f, 0xec, 0x75, 0x31, 0xa4, 0x5a, 0xdb, 0x7f, 0xd3, 0x42, 0x76, 0x9c, 0xe0, 0xee, 0x7a,
+	0xbb, 0xd7, 0xf2, 0xf9, 0x2b, 0xe2, 0xae, 0x94, 0x39, 0x78, 0x75, 0x9a, 0x7c, 0xb5, 0xb9, 0xd4,
+	0x27, 0x11, 0x32, 0x7a, 0x71, 0x9c, 0x03, 0xe2, 0xf8, 0x1b, 0xfe, 0x80, 0xd8, 0x40, 0xd3, 0x9b,
+	0xde, 0x0e, 0x96, 0xce, 0x16, 0xce, 0xc4, 0xd0, 0x0e, 0x05, 0xd4, 0x67, 0xa1, 0xa6, 0x33, 0x01,
+	0x93, 0x27, 0xb1, 0x76, 0x90, 0xc7, 0xda, 0xf4, 0x88, 0x75, 0xa0, 0x49, 0x6c, 0x60, 0x4e, 0xc5,
+	0xb4, 0x76, 0x80, 0x89, 0x86, 0x34, 0x3d, 0x31, 0x8f, 0xdb, 0xda, 0x4a, 0x20, 0x76, 0x66, 0x94,
+	0x87, 0x9b, 0xa4, 0xc6, 0x90, 0xbf, 0x59, 0x7a, 0x34, 0x5e, 0xea, 0x13, 0x07, 0x19, 0x5d, 0x20,
+	0x47, 0x6c, 0x4c, 0xcc, 0x8a, 0x5e, 0x82, 0x9b, 0x04, 0xce, 0x8e, 0xd8, 0x93, 0xea, 0x88, 0x7d,
+	0xbd, 0x0f, 0x0b, 0x19, 0x2d, 0xec, 0xe7, 0xd0, 0x54, 0xbc, 0xe3, 0x77, 0xbb, 0x0c, 0x16, 0x3b,
+	0x53, 0x74, 0xc7, 0xa4, 0x9a, 0x42, 0x5d, 0x83, 0x83, 0x41, 0xe5, 0x7e, 0x0f, 0x21, 0x24, 0x16,
+	0x74, 0xdc, 0x25, 0x1e, 0xc7, 0x31, 0x4e, 0xd8, 0xbc, 0xe4, 0x6e, 0x19, 0xcc, 0x99, 0x46, 0x00,
+	0x41, 0xe1, 0xed, 0x1d, 0x54, 0xa6, 0x5a, 0x47, 0x3e, 0xc6, 0x38, 0x3e, 0x4c, 0xa9, 0x56, 0xc3,
+	0xac, 0xbc, 0xf4, 0x5f, 0x60, 0x32, 0xec, 0x4f, 0x59, 0x08, 0x61, 0x73, 0x49, 0xcb, 0x4b, 0x57,
+	0x51, 0xab, 0x1e, 0x79, 0x07, 0xb5, 0x19, 0xb2, 0x53, 0x2b, 0x18, 0x68, 0x62, 0xed, 0xfb, 0x68,
+	0xc2, 0x13, 0x67, 0x90, 0xd2, 0x69, 0x9c, 0x41, 0xa8, 0xf1, 0x55, 0xfc, 0x02, 0x29, 0xcc, 0xfe,
+	0x9c, 0x85, 0x66, 0x62, 0x9c, 0xf0, 0x4f, 0x45, 0x34, 0x61, 0xa7, 0x9c, 0xc7, 0xb2, 0x5c, 0x37,
+	0x78, 0x32, 0xed, 0xd6, 0x84, 0x41, 0x4a, 0xae, 0xe8, 0xca, 0x2d, 0xec, 0x35, 0x71, 0x44, 0x6d,
+	0xfb, 0xce, 0x58, 0x4e, 0x5d, 0xd1, 0x78, 0xca, 0xae, 0x68, 0x30, 0x48, 0xc9, 0x15, 0x5d, 0x59,
+	0xf3, 0xa3, 0x28, 0xe4, 0x5d, 0x99, 0xc8, 0xa9, 0x2b, 0x1a, 0x4f, 0xd9, 0x15, 0x0d, 0x06, 0x29,
+	0xb9, 0xc4, 0x8f, 0xa1, 0x4b, 0xd7, 0x77, 0xa7, 0x92, 0x87, 0x4f, 0x97, 0xd8, 0x2b, 0x70, 0x97,
+	0xdd, 0xa1, 0xb0, 0xdf, 0xc0, 0x65, 0x48, 0x13, 0x34, 0x1a, 0x68, 0x82, 0x8e, 0xd1, 0x58, 0xbc,
+	0xed, 0x11, 0x1d, 0x74, 0x32, 0x8f, 0x35, 0x8e, 0x8f, 0xd3, 0x3a, 0x65, 0xa9, 0xba, 0xc5, 0x7e,
+	0x03, 0x17, 0x65, 0x77, 0xd1, 0x78, 0xc2, 0x57, 0xd6, 0xa9, 0xbc, 0xcf, 0x3c, 0x54, 0x63, 0xe0,
+	0x3f, 0x40, 0x88, 0x21, 0x2f, 0xe2, 0xfe, 0x36, 0x0e, 0x9c, 0x69, 0xf3, 0x45, 0xdc, 0xdb, 0xc6,
+	0x01, 0x50, 0x8c, 0xbd, 0x8b, 0x26, 0x36, 0x23, 0x2f, 0x68, 0x6c, 0x63, 0x72, 0xdc, 0x2c, 0x8e,
+	0xfe, 0x69, 0xe8, 0xd3, 0x53, 0x8e, 0xca, 0x6c, 0x5f, 0xe3, 0x12, 0x40, 0xca, 0x72, 0x7f, 0x34,
+	0x83, 0x66, 0xc4, 0xca, 0xaa, 0x4c, 0x8f, 0xec, 0x6e, 0x71, 0x80, 0xe9, 0x71, 0x49, 0x47, 0x82,
+	0x49, 0x4b, 0x1a, 0x33, 0xed, 0xc6, 0xb4, 0x3c, 0xca, 0xc6, 0x75, 0x1d, 0x09, 0x26, 0xad, 0xdd,
+	0x41, 0xe5, 0x98, 0xee, 0x0a, 0xc5, 0x3c, 0xde, 0x80, 0xda, 0x30, 0xb4, 0x7b, 0x1a, 0xba, 0xb9,
+	0x30, 0x29, 0xf4, 0x7a, 0x3c, 0x75, 0x28, 0x2f, 0x9d, 0xde, 0xe1, 0xf2, 0x38, 0x47, 0xf2, 0x7e,
+	0x6b, 0x64, 0xf9, 0x14, 0xad, 0x91, 0xef, 0x27, 0xf1, 0x36, 0x0f, 0xea, 0xbd, 0xa8, 0x75, 0x72,
+	0xab, 0x27, 0x8f, 0xd0, 0x61, 0x5c, 0x40, 0xf2, 0x23, 0x4e, 0xa4, 0x6a, 0x0f, 0x62, 0x0a, 0xe2,
+	0xbd, 0x7c, 0xf7, 0x20, 0x79, 0xbc, 0x18, 0xb8, 0x1b, 0xf5, 0xd9, 0x06, 0x27, 0xce, 0xdc, 0x36,
+	0x48, 0xec, 0x5c, 0x6c, 0x82, 0x48, 0x3b, 0x57, 0xe5, 0x54, 0xed, 0x5c, 0x4b, 0x86, 0x30, 0x48,
+	0x09, 0xa7, 0xfd, 0x61, 0x73, 0x4e, 0xf6, 0x07, 0x9d, 0x6a, 0x7f, 0xea, 0x86, 0x30, 0x48, 0x09,
+	0x1f, 0x6c, 0x10, 0x9f, 0x3c, 0x1d, 0x83, 0xf8, 0x54, 0x0e, 0x06, 0xf1, 0xc3, 0x6d, 0x85, 0xd3,
+	0x23, 0xdb, 0x0a, 0x5f, 0x44, 0x76, 0x73, 0x2f, 0xf0, 0x3a, 0x7e, 0x83, 0x2f, 0x96, 0x84, 0x8a,
+	0xda, 0x20, 0x27, 0xd4, 0xe9, 0x6d, 0xb9, 0x8f, 0x02, 0x32, 0x5a, 0xd9, 0x09, 0x9a, 0xe8, 0x8a,
+	0x43, 0xea, 0xb9, 0x3c, 0x46, 0xbf, 0x38, 0xb4, 0x32, 0xaf, 0x5c, 0x32, 0xf1, 0x04, 0x04, 0xa4,
+	0x24, 0x72, 0xe9, 0xd3, 0xf1, 0x83, 0xf5, 0xb0, 0x19, 0xaf, 0xe3, 0x88, 0x5f, 0x07, 0xd5, 0x31,
+	0xb3, 0x30, 0x96, 0x99, 0x89, 0x7f, 0x2d, 0x03, 0x0f, 0x99, 0xad, 0x0e, 0xb1, 0xaf, 0xcf, 0xbd,
+	0x31, 0xec, 0xeb, 0x6f, 0x45, 0x93, 0xf4, 0x24, 0xc8, 0x47, 0x80, 0x4d, 0x9f, 0x92, 0x3a, 0xa0,
+	0xd7, 0x14, 0x18, 0x74, 0x1a, 0xf7, 0x4f, 0x2c, 0x34, 0xbb, 0xd4, 0x0e, 0x7b, 0xcd, 0x7b, 0x24,
+	0x8e, 0x9b, 0x9b, 0x03, 0x5f, 0x40, 0x13, 0x7e, 0x90, 0xe0, 0x68, 0xd7, 0x6b, 0xf3, 0x3d, 0xd7,
+	0x15, 0x3b, 0xf7, 0x0a, 0x87, 0x67, 0x18, 0xe4, 0x64, 0x1b, 0xfb, 0xeb, 0x16, 0x9a, 0x63, 0xae,
+	0xb1, 0xcb, 0x5e, 0xe2, 0xbd, 0xb7, 0x87, 0x23, 0x1f, 0x0b, 0xe7, 0xd8, 0x11, 0x17, 0xdf, 0x74,
+	0x5f, 0x85, 0x80, 0x3d, 0x65, 0xaf, 0x59, 0x4b, 0x4b, 0x86, 0xfe, 0xce, 0xb8, 0xbf, 0x58, 0x44,
+	0x8f, 0x0f, 0xe4, 0x65, 0x5f, 0x46, 0x05, 0xbf, 0xc9, 0x1f, 0x1d, 0x71, 0xbe, 0x85, 0x95, 0x26,
+	0x14, 0xfc, 0xa6, 0xbd, 0x40, 0x0f, 0x56, 0x11, 0x8e, 0x63, 0xe1, 0xa2, 0x58, 0x91, 0x67, 0x20,
+	0x0e, 0x05, 0x8d, 0x82, 0x38, 0xe4, 0xd0, 0x88, 0x33, 0x6e, 0x56, 0xa2, 0x47, 0x35, 0x1a, 0xdc,
+	0x05, 0x0c, 0x4e, 0xbc, 0x57, 0x11, 0xeb, 0x20, 0x39, 0x0e, 0xf3, 0x9d, 0x1f, 0xf2, 0x7d, 0x4d,
+	0x84, 0x33, 0xeb, 0xa5, 0xfa, 0x0d, 0x9a, 0x54, 0x7b, 0x03, 0x8d, 0x91, 0x53, 0x5b, 0xd8, 0x3c,
+	0xf1, 0x46, 0xcf, 0xf4, 0x6e, 0xca, 0x03, 0x38, 0x2f, 0xf2, 0xae, 0x22, 0x9c, 0xf4, 0xa2, 0x80,
+	0xbc, 0x5a, 0xba, 0xb5, 0x4f, 0xb0, 0x5e, 0x80, 0x84, 0x82, 0x46, 0xe1, 0xfe, 0xd3, 0x02, 0xba,
+	0x90, 0xd5, 0x75, 0xb2, 0x83, 0x8e, 0xb1, 0xde, 0x72, 0x0b, 0xe9, 0xcf, 0xe4, 0xff, 0x7e, 0xd8,
+	0x7f, 0xca, 0xb1, 0x85, 0xfd, 0x06, 0x2e, 0xd7, 0xfe, 0x19, 0xf9, 0x86, 0x0a, 0x27, 0x7c, 0x43,
+	0x92, 0x73, 0xea, 0x2d, 0x5d, 0x43, 0xa5, 0x98, 0x7c, 0xf9, 0xa2, 0xa9, 0x94, 0xd3, 0x6f, 0x44,
+	0x31, 0x84, 0xa2, 0x17, 0xf8, 0x89, 0x53, 0x32, 0x29, 0xee, 0x06, 0x7e, 0x02, 0x14, 0xe3, 0x7e,
+	0xad, 0x80, 0x2e, 0x0f, 0x7e, 0x28, 0x12, 0x65, 0x8f, 0x9a, 0xe4, 0x4c, 0x1e, 0xd3, 0x58, 0x47,
+	0xe6, 0x15, 0xef, 0x9d, 0xd6, 0x3b, 0x5c, 0x16, 0x92, 0x54, 0xb8, 0x86, 0x04, 0xc5, 0xa0, 0x75,
+	0xc4, 0x7e, 0x56, 0x0c, 0x7d, 0xea, 0xdc, 0xc3, 0x26, 0x93, 0x6c, 0xb3, 0x26, 0x31, 0xa0, 0x51,
+	0x11, 0xa3, 0x0b, 0x39, 0xb2, 0xc5, 0x5d, 0x4f, 0x06, 0xbd, 0x53, 0xa3, 0xcb, 0x6d, 0x01, 0x04,
+	0x85, 0x77, 0xdb, 0xe8, 0xa9, 0x63, 0xf4, 0x33, 0xa7, 0x98, 0x62, 0xf7, 0x7f, 0x58, 0xe8, 0x12,
+	0x0f, 0x58, 0xf8, 0x33, 0x13, 0xfd, 0xf2, 0xa7, 0x16, 0x7a, 0x62, 0xc0, 0x33, 0x9f, 0x41, 0x10,
+	0xcc, 0xc7, 0xcc, 0x20, 0x98, 0xbb, 0xa3, 0x0e, 0xe9, 0xcc, 0xe7, 0x18, 0x10, 0x0b, 0xf3, 0x7f,
+	0x2c, 0x74, 0x95, 0xb7, 0x10, 0xe7, 0x7d, 0x7e, 0x74, 0x3d, 0xc3, 0x8f, 0xfe, 0x71, 0xe3, 0xa3,
+	0xbf, 0x2f, 0x1f, 0xb3, 0x45, 0xea, 0x31, 0x06, 0x7e, 0xfb, 0xcf, 0x14, 0x90, 0x7b, 0xf8, 0x1b,
+	0x38, 0x83, 0x21, 0xf0, 0x49, 0xcb, 0x1c, 0x03, 0x1f, 0xcc, 0x65, 0x0c, 0x0c, 0x78, 0x9e, 0x01,
+	0x43, 0xe1, 0x7d, 0x68, 0x92, 0xb7, 0xbb, 0xe7, 0xed, 0x1e, 0xc7, 0xf3, 0xf7, 0x69, 0x34, 0xc1,
+	0x63, 0x99, 0x84, 0xef, 0x2f, 0xd5, 0x61, 0x39, 0x93, 0x18, 0x24, 0xd6, 0xfd, 0x9c, 0x85, 0x6c,
+	0x12, 0x3f, 0xe8, 0x45, 0x7e, 0xac, 0xe9, 0x7a, 0x23, 0x05, 0x5c, 0x89, 0x93, 0xa9, 0xb6, 0xea,
+	0xca, 0x96, 0x55, 0x0d, 0x07, 0x06, 0xa5, 0xfb, 0x45, 0xa2, 0x2c, 0xca, 0xae, 0xf0, 0xad, 0xe5,
+	0xe8, 0x67, 0x7d, 0x16, 0x95, 0x5a, 0xa1, 0xd7, 0x76, 0x0a, 0x46, 0x20, 0x6e, 0xe9, 0x66, 0xc8,
+	0xd4, 0x48, 0xc5, 0x91, 0x40, 0x80, 0xd2, 0x12, 0xcf, 0x68, 0x76, 0xcb, 0xc5, 0x9d, 0xbd, 0xa8,
+	0x76, 0xc1, 0x6d, 0xea, 0x1c, 0xe3, 0xfe, 0x76, 0x11, 0x4d, 0x13, 0xb5, 0xa1, 0x19, 0xb6, 0x72,
+	0x52, 0x5c, 0x9f, 0x42, 0xe5, 0x8f, 0x12, 0x05, 0x30, 0xbd, 0xc8, 0x53, 0xad, 0x10, 0x18, 0x8e,
+	0x98, 0xd6, 0xc7, 0x3f, 0xca, 0x75, 0x5a, 0x66, 0x1f, 0x1a, 0x51, 0x19, 0x31, 0x9e, 0x61, 0x81,
+	0x6b, 0xa8, 0x2c, 0x55, 0x80, 0x0c, 0x39, 0xe3, 0x50, 0x10, 0x92, 0x49, 0xa0, 0xf2, 0x56, 0x18,
+	0x75, 0x7a, 0x6d, 0x2f, 0x9d, 0x9f, 0xe6, 0x06, 0x03, 0x83, 0xc0, 0x93, 0x4d, 0xd6, 0xeb, 0xfa,
+	0x2f, 0xe3, 0x28, 0x66, 0x91, 0xe3, 0xc6, 0x26, 0x5b, 0x95, 0x18, 0xd0, 0xa8, 0x68, 0x9b, 0x56,
+	0x2b, 0xc2, 0x2d, 0x2f, 0x09, 0x23, 0x67, 0x2c, 0xd5, 0x46, 0x62, 0x40, 0xa3, 0xba, 0xfc, 0x6e,
+	0x34, 0xa5, 0x77, 0x7e, 0xa8, 0xb4, 0x03, 0xdf, 0xb1, 0xd0, 0xd4, 0x32, 0xee, 0xb6, 0xc3, 0x3d,
+	0x7e, 0xa5, 0xff, 0x1c, 0x2a, 0xed, 0xf8, 0x81, 0x50, 0xc2, 0x85, 0x6f, 0x6c, 0xe9, 0x25, 0x3f,
+	0x68, 0x3e, 0xdc, 0x9f, 0x9f, 0xd5, 0x69, 0x09, 0x0c, 0x28, 0x35, 0x71, 0x15, 0x8e, 0x59, 0xf4,
+	0x8d, 0x18, 0xd7, 0x72, 0xe5, 0xe0, 0x51, 0x39, 0x18, 0x24, 0x05, 0xa1, 0x6e, 0xf2, 0xa1, 0x90,
+	0x76, 0x2c, 0x16, 0x43, 0x04, 0x24, 0x05, 0xa1, 0x26, 0x66, 0xd4, 0xf7, 0x87, 0x01, 0x76, 0x4a,
+	0x26, 0xf5, 0x06, 0x87, 0x83, 0xa4, 0x70, 0xdf, 0x83, 0x78, 0x30, 0x5d, 0x4a, 0xc7, 0xb1, 0x8e,
+	0xa3, 0xe3, 0xb8, 0x1f, 0x46, 0xf6, 0xf5, 0xb6, 0x17, 0x27, 0x7e, 0x23, 0xc6, 0x5e, 0xd4, 0xd8,
+	0x66, 0xc7, 0x92, 0xa7, 0x50, 0xd9, 0xa7, 0xd7, 0x5d, 0x96, 0x39, 0x3c, 0xd9, 0x2d, 0x17, 0xc3,
+	0x1d, 0x6b, 0x0c, 0xbb, 0xff, 0xb1, 0x80, 0xb4, 0x3b, 0x9b, 0x33, 0xd8, 0xa6, 0x02, 0x63, 0x9b,
+	0x1a, 0xf1, 0xbe, 0x41, 0xbb, 0x81, 0x1a, 0x94, 0xb5, 0x66, 0x37, 0x95, 0xb5, 0xe6, 0x76, 0x6e,
+	0x12, 0x0f, 0x4f, 0x5a, 0xf3, 0x3d, 0x0b, 0x3d, 0xa1, 0x88, 0xfb, 0x2f, 0x80, 0x8f, 0x5e, 0x2d,
+	0xdf, 0x4e, 0xd2, 0x92, 0xc8, 0x66, 0xfc, 0x2b, 0x6a, 0x29, 0x43, 0x24, 0x0a, 0x74, 0x3a, 0x95,
+	0xee, 0xa0, 0x78, 0xc2, 0x74, 0x07, 0xa5, 0xc3, 0xd3, 0x1d, 0xb8, 0x3f, 0x2a, 0xa0, 0x2b, 0xfd,
+	0x4f, 0xa6, 0xc7, 0x00, 0x1f, 0xfd, 0x6c, 0xe9, 0x4d, 0xab, 0x70, 0xe2, 0x28, 0xe1, 0xe2, 0x71,
+	0xa3, 0x84, 0x65, 0x6c, 0x6e, 0xe9, 0xd4, 0x63, 0x73, 0xeb, 0xe8, 0xa2, 0x08, 0x04, 0xbc, 0x11,
+	0x46, 0x3c, 0xe6, 0x5f, 0x2c, 0xb9, 0x13, 0x9a, 0x53, 0x57, 0x16, 0x11, 0x64, 0xb7, 0x75, 0xbf,
+	0x52, 0x40, 0x17, 0xd4, 0x6b, 0x57, 0x7b, 0xa5, 0xfd, 0x09, 0x61, 0xd7, 0xc6, 0xe2, 0x3c, 0xb7,
+	0x3e, 0xa2, 0xe2, 0xd3, 0xa7, 0x64, 0xa8, 0x05, 0xae, 0xca, 0x25, 0x81, 0x94, 0x69, 0xef, 0xa9,
+	0x20, 0xeb, 0x5c, 0x02, 0xd0, 0xd3, 0x8a, 0xc5, 0xe0, 0x70, 0x6b, 0xf7, 0x7b, 0x45, 0x74, 0x5e,
+	0x7f, 0x27, 0x41, 0xd3, 0xa7, 0x2b, 0xf4, 0xf3, 0xa8, 0x94, 0xec, 0x75, 0xc5, 0x00, 0xfc, 0x0b,
+	0xe2, 0x13, 0x11, 0x5f, 0x97, 0x87, 0xfb, 0xf3, 0x97, 0x32, 0x9a, 0x10, 0x14, 0xd0, 0x46, 0xf6,
+	0xaa, 0x5c, 0x31, 0xd8, 0xa8, 0x7c, 0xce, 0x9c, 0xe1, 0x0f, 0xf7, 0xe7, 0x33, 0x32, 0x1a, 0x2e,
+	0x48, 0x4e, 0xe6, 0x3a, 0x60, 0xbf, 0x82, 0x66, 0xc8, 0xfa, 0x7d, 0xb7, 0xdb, 0xf4, 0x12, 0xea,
+	0x67, 0xe8, 0x14, 0x87, 0xf6, 0xf4, 0x90, 0x5e, 0xf2, 0xab, 0x06, 0x27, 0x48, 0x71, 0xb6, 0x77,
+	0x91, 0x4d, 0x20, 0x1b, 0x91, 0x17, 0xc4, 0xec, 0xa9, 0xfc, 0x0e, 0x9b, 0xcf, 0xc3, 0xc9, 0x93,
+	0x36, 0xdd, 0xd5, 0x3e, 0x6e, 0x90, 0x21, 0xc1, 0x7e, 0x33, 0x1a, 0x8b, 0xb0, 0x17, 0x4b, 0x9d,
+	0x42, 0xae, 0x89, 0x40, 0xa1, 0xc0, 0xb1, 0xfa, 0x22, 0x33, 0x76, 0xc4, 0x22, 0xf3, 0x7d, 0x0b,
+	0xcd, 0xa8, 0xcf, 0x74, 0x06, 0x87, 0x87, 0x8e, 0x79, 0x76, 0xb8, 0x95, 0xd7, 0x36, 0x31, 0xe0,
+	0x9c, 0xf0, 0xc3, 0x09, 0xfd, 0xf9, 0x68, 0xb2, 0x82, 0x8f, 0xeb, 0xb1, 0xeb, 0x56, 0x1e, 0x19,
+	0x64, 0x8c, 0x63, 0xdb, 0xa1, 0x41, 0xeb, 0x44, 0x61, 0x96, 0x1a, 0x50, 0xc1, 0x54, 0x98, 0x85,
+	0x06, 0x94, 0xa5, 0x30, 0x8b, 0x36, 0xf6, 0x5d, 0x74, 0xa9, 0x1b, 0x85, 0x34, 0xa7, 0xde, 0x32,
+	0xf6, 0x9a, 0x6d, 0x3f, 0x90, 0xd6, 0x67, 0xa6, 0xb7, 0x3f, 0x71, 0xb0, 0x3f, 0x7f, 0x69, 0x3d,
+	0x9b, 0x04, 0x06, 0xb5, 0x35, 0xb3, 0x32, 0x95, 0x8e, 0x91, 0x95, 0xe9, 0xf3, 0x96, 0xb6, 0x1a,
+	0xb2, 0x04, 0x00, 0x1f, 0xc8, 0xeb, 0x53, 0x66, 0xa5, 0x02, 0x38, 0x6c, 0x61, 0x1c, 0x78, 0x95,
+	0x34, 0x76, 0xc2, 0xab, 0x24, 0x95, 0xf3, 0x61, 0xfc, 0x51, 0xe6, 0x7c, 0x98, 0x78, 0x43, 0xe5,
+	0x7c, 0xf8, 0xba, 0x85, 0xce, 0x7b, 0xfd, 0xd9, 0xd6, 0xf2, 0xb9, 0xd5, 0xcc, 0x48, 0xe3, 0x56,
+	0x7b, 0x82, 0x77, 0x32, 0x2b, 0xa9, 0x1d, 0x64, 0x75, 0x85, 0x1a, 0xf6, 0x1b, 0x72, 0x57, 0xe3,
+	0xf7, 0x9b, 0x90, 0xd7, 0xb0, 0x54, 0xfb, 0x25, 0x33, 0xa9, 0xab, 0xdf, 0xa0, 0x49, 0x75, 0xbf,
+	0x59, 0x46, 0xb3, 0x69, 0xed, 0xf5, 0xf4, 0x73, 0x63, 0x7d, 0xd5, 0x42, 0xb3, 0x62, 0x95, 0x91,
+	0x7e, 0xa4, 0xec, 0xb0, 0xbc, 0x9a, 0xd3, 0xe2, 0xc6, 0xf4, 0x70, 0x99, 0xb2, 0x74, 0x23, 0x25,
+	0x0d, 0xfa, 0xe4, 0x93, 0x5c, 0x4e, 0xd2, 0xe7, 0xe0, 0x44, 0x89, 0xb2, 0xe8, 0x55, 0x5a, 0x55,
+	0xb1, 0x00, 0x9d, 0x1f, 0x49, 0x6c, 0x88, 0x1a, 0x42, 0x1d, 0xc8, 0x29, 0x0d, 0x49, 0x86, 0xca,
+	0xa2, 0x0e, 0x5a, 0x12, 0x14, 0x83, 0x26, 0xd8, 0xfe, 0x45, 0x4b, 0x19, 0x78, 0xa0, 0x17, 0x08,
+	0xff, 0xdd, 0xf7, 0xe5, 0xbd, 0x1e, 0x2a, 0xcf, 0xd8, 0x3e, 0xdb, 0x11, 0x11, 0x0b, 0x46, 0x27,
+	0x88, 0xb2, 0x70, 0xdf, 0x0f, 0x02, 0x1c, 0x39, 0xe3, 0xa6, 0xb2, 0x70, 0x8f, 0x42, 0x81, 0x63,
+	0xdd, 0xe7, 0x91, 0x0c, 0xa6, 0x26, 0xdb, 0x00, 0x0d, 0xa7, 0x5e, 0xf7, 0x92, 0xed, 0x74, 0x58,
+	0xc2, 0x0d, 0x81, 0x00, 0x45, 0xe3, 0xbe, 0x03, 0x55, 0x6e, 0xc2, 0xfa, 0xd2, 0x7a, 0x14, 0x6e,
+	0xd2, 0xe1, 0x1a, 0x1b, 0x8e, 0x43, 0x72, 0xb8, 0x0a, 0xaf, 0x1f, 0x81, 0x27, 0xa9, 0x46, 0x9d,
+	0x9b, 0x5e, 0x82, 0xef, 0x7b, 0x7b, 0xd5, 0xf5, 0x95, 0x54, 0x58, 0xc5, 0x22, 0xaa, 0x6c, 0x27,
+	0x49, 0x17, 0x64, 0x1a, 0x0d, 0xad, 0x17, 0xb7, 0x36, 0x36, 0xd6, 0x29, 0x02, 0x14, 0x0d, 0xb9,
+	0xf5, 0x92, 0x3f, 0x84, 0x75, 0x8f, 0x4e, 0x51, 0x49, 0x1d, 0x83, 0x46, 0x41, 0x04, 0xb4, 0xa2,
+	0x6e, 0x83, 0x09, 0x28, 0x9a, 0x02, 0xc8, 0xe3, 0x70, 0x01, 0x92, 0x86, 0x5a, 0x22, 0x1a, 0xbc,
+	0x43, 0x69, 0x4b, 0xc4, 0x12, 0xef, 0x8f, 0xa4, 0x70, 0x3f, 0x82, 0x66, 0x6e, 0x46, 0x5e, 0x77,
+	0xdb, 0x97, 0xe1, 0x1e, 0xcf, 0xa0, 0x71, 0xaf, 0xd9, 0xcc, 0x4a, 0x4d, 0x5c, 0x65, 0x60, 0x10,
+	0xf8, 0xe3, 0x59, 0x13, 0x5e, 0x2f, 0x22, 0xfa, 0x26, 0xd8, 0x7b, 0x7f, 0x33, 0x1a, 0xa3, 0xf9,
+	0xb4, 0xc5, 0xcb, 0x52, 0x47, 0x65, 0x0a, 0x05, 0x8e, 0xb5, 0xdf, 0x45, 0xaf, 0xf4, 0xb6, 0xf9,
+	0x85, 0x5a, 0xa5, 0xf6, 0x63, 0xda, 0xc5, 0xdb, 0x76, 0x48, 0xac, 0x3c, 0xe7, 0xee, 0xe1, 0x4d,
+	0xd6, 0x65, 0x06, 0x02, 0xde, 0x80, 0x9c, 0x34, 0xbb, 0x64, 0x4c, 0xa4, 0x6e, 0xcc, 0xe8, 0x70,
+	0xa0, 0x18, 0xfb, 0x01, 0x1a, 0xdf, 0xa6, 0x9e, 0x8f, 0xe2, 0xe0, 0x37, 0xe2, 0xe5, 0xbc, 0xec,
+	0x09, 0xf3, 0xa7, 0x54, 0x6f, 0x8c, 0xfd, 0x8e, 0x41, 0x88, 0x23, 0x0e, 0xca, 0x3c, 0x2f, 0x1a,
+	0x9b, 0x1d, 0x4b, 0x61, 0x93, 0xeb, 0x24, 0xdc, 0x41, 0xb9, 0xde, 0x87, 0x85, 0x8c, 0x16, 0xe4,
+	0x23, 0xfb, 0x41, 0x8c, 0x1b, 0xbd, 0x08, 0xf3, 0x9b, 0xd3, 0x59, 0x65, 0xcb, 0x64, 0x70, 0x90,
+	0x14, 0xee, 0xbf, 0xb6, 0x90, 0xad, 0x5c, 0x3d, 0xfd, 0xa0, 0xb5, 0x46, 0x2e, 0xbc, 0x88, 0xed,
+	0x89, 0xf5, 0x2b, 0xcb, 0xf6, 0x74, 0x4b, 0x62, 0x40, 0xa3, 0x22, 0xc9, 0x1c, 0xd9, 0xaf, 0x97,
+	0xa5, 0xa9, 0x2e, 0x07, 0xcf, 0xc4, 0x48, 0xf4, 0x89, 0xad, 0xa2, 0xb7, 0x94, 0x04, 0xd0, 0xc5,
+	0x91, 0xd1, 0xba, 0x12, 0x6c, 0xb5, 0x7b, 0x0f, 0x9a, 0x9b, 0x6a, 0xb4, 0x76, 0xa3, 0x70, 0xcb,
+	0x6f, 0xf7, 0xcd, 0xe3, 0x75, 0x06, 0x06, 0x81, 0x3f, 0xde, 0x68, 0xfd, 0x57, 0x16, 0xba, 0xb0,
+	0x12, 0x27, 0x7e, 0xb8, 0x8c, 0xe3, 0x84, 0xa8, 0x8f, 0x44, 0xc9, 0x20, 0xe6, 0xc0, 0xa3, 0xed,
+	0x17, 0xcb, 0x68, 0x96, 0x7b, 0x19, 0xf6, 0x36, 0x63, 0x9c, 0x68, 0x36, 0x0c, 0xb9, 0x0f, 0x2d,
+	0xa5, 0xf0, 0xd0, 0xd7, 0x82, 0x70, 0xe1, 0xee, 0x86, 0x8a, 0x4b, 0xd1, 0xe4, 0x52, 0x4f, 0xe1,
+	0xa1, 0xaf, 0x85, 0xfb, 0xdd, 0x22, 0x3a, 0x4f, 0x1f, 0x23, 0xb5, 0x5c, 0x7d, 0x69, 0x50, 0xae,
+	0x9d, 0x11, 0xb7, 0x22, 0x2a, 0xeb, 0x04, 0x99, 0x76, 0xbe, 0x62, 0xa1, 0x73, 0x4d, 0xf3, 0x4d,
+	0xe7, 0x73, 0x43, 0x99, 0xf5, 0x0d, 0x59, 0xd4, 0x67, 0x0a, 0x08, 0x69, 0xf9, 0xf6, 0x2f, 0x59,
+	0xe8, 0x9c, 0xd9, 0x4d, 0xa1, 0x9d, 0x9c, 0xc2, 0x4b, 0x92, 0x51, 0x17, 0x26, 0x3c, 0x86, 0x74,
+	0x17, 0xdc, 0xdf, 0x29, 0xf0, 0x4f, 0x7a, 0x1a, 0x89, 0x64, 0xec, 0xfb, 0xa8, 0x92, 0xb4, 0x63,
+	0x06, 0x74, 0x8a, 0x79, 0x58, 0xc3, 0x36, 0x56, 0xeb, 0x94, 0x9d, 0x76, 0x38, 0xe3, 0x90, 0x18,
+	0x94, 0x2c, 0x2a, 0xb8, 0x21, 0xb6, 0xc3, 0x5c, 0xcc, 0x70, 0x62, 0x97, 0xd3, 0x04, 0x2f, 0xad,
+	0x4b, 0xc1, 0x42, 0x96, 0xfb, 0x0d, 0x0b, 0x55, 0x5e, 0x0c, 0xc5, 0x3a, 0xf2, 0xe1, 0x1c, 0x8c,
+	0xdc, 0x72, 0x09, 0x96, 0x9a, 0xbf, 0xe4, 0x69, 0xbf, 0x60, 0x98, 0xb8, 0x9f, 0xd4, 0x78, 0x2f,
+	0xd0, 0x22, 0x19, 0x84, 0xd5, 0x8b, 0xe1, 0xe6, 0xc0, 0xcb, 0xd4, 0x5f, 0x2e, 0xa3, 0xe9, 0x97,
+	0xbc, 0x3d, 0x1c, 0x24, 0xde, 0xf0, 0xfb, 0x34, 0xb1, 0x1a, 0x77, 0xa9, 0xa7, 0x9a, 0x76, 0x96,
+	0x57, 0x56, 0x63, 0x85, 0x02, 0x9d, 0x4e, 0x2d, 0x68, 0x2c, 0xab, 0x4b, 0xd6, 0x52, 0xb4, 0x94,
+	0xc2, 0x43, 0x5f, 0x0b, 0xe2, 0x28, 0xc8, 0x4d, 0x73, 0xd5, 0x46, 0x23, 0xec, 0x05, 0x6c, 0x49,
+	0x4b, 0x85, 0x5f, 0xae, 0xf5, 0x51, 0x40, 0x46, 0x2b, 0x92, 0x27, 0xa5, 0x41, 0x39, 0x73, 0x13,
+	0x83, 0xce, 0xb1, 0x6c, 0xdc, 0x05, 0x39, 0x4b, 0x03, 0xe8, 0x60, 0x20, 0x07, 0xd2, 0xd3, 0x38,
+	0x09, 0x23, 0xaf, 0x85, 0x75, 0xbe, 0x63, 0xa9, 0xe0, 0xce, 0x3e, 0x0a, 0xc8, 0x68, 0x65, 0xbf,
+	0x86, 0x2a, 0xc9, 0x76, 0x84, 0xe3, 0xed, 0xb0, 0xdd, 0xe4, 0x9e, 0xc5, 0x23, 0x9a, 0x40, 0xf9,
+	0xd7, 0xdf, 0x10, 0x5c, 0xb5, 0xe1, 0x2d, 0x40, 0xa0, 0x64, 0x92, 0xf4, 0x3e, 0x31, 0x31, 0x71,
+	0xc7, 0xce, 0x44, 0x1e, 0x66, 0x23, 0x2e, 0x9d, 0x5a, 0xcd, 0x75, 0xa5, 0x8d, 0x48, 0x00, 0x2e,
+	0xc9, 0xfd, 0xad, 0x02, 0x9a, 0xd2, 0x09, 0x8f, 0xb1, 0x36, 0x7d, 0xca, 0x42, 0x53, 0x8d, 0x30,
+	0x48, 0xa2, 0xb0, 0xad, 0x32, 0x7c, 0x8e, 0xae, 0x51, 0x10, 0x56, 0xcb, 0x38, 0xf1, 0xfc, 0xb6,
+	0x76, 0x0d, 0xa0, 0x89, 0x01, 0x43, 0xa8, 0xfd, 0x45, 0x0b, 0x9d, 0x53, 0x91, 0x49, 0xea, 0x12,
+	0x21, 0xd7, 0x8e, 0xc8, 0xa5, 0xfe, 0xba, 0x29, 0x09, 0xd2, 0xa2, 0xdd, 0x4d, 0x34, 0x9b, 0xfe,
+	0xda, 0x4c, 0xab, 0xe5, 0x73, 0xbd, 0xa8, 0x6b, 0xb5, 0x71, 0x0c, 0x14, 0x43, 0x74, 0xc2, 0x8e,
+	0x17, 0xb5, 0xfc, 0x80, 0xdf, 0xa6, 0x17, 0xb5, 0x05, 0x89, 0xc3, 0x41, 0x52, 0xb8, 0x5f, 0x2f,
+	0xa3, 0xca, 0x6a, 0xd8, 0x1a, 0x7e, 0x31, 0xc1, 0xa8, 0xd4, 0x0e, 0x77, 0x7c, 0xfe, 0xa1, 0x46,
+	0xcc, 0x0e, 0xb6, 0x1a, 0xee, 0xf8, 0xcc, 0x43, 0x73, 0x82, 0x3c, 0x0d, 0xf9, 0x09, 0x94, 0x3d,
+	0x31, 0xda, 0x4d, 0x63, 0xfd, 0x96, 0x93, 0x7f, 0x90, 0x11, 0xef, 0x31, 0xfa, 0x2f, 0x4e, 0x59,
+	0xcc, 0xa4, 0x01, 0x07, 0x53, 0x32, 0x19, 0x1e, 0x33, 0x9e, 0x91, 0xad, 0x2b, 0x9f, 0x38, 0x5e,
+	0x33, 0x03, 0x98, 0x96, 0x2d, 0xca, 0x80, 0x43, 0x4a, 0xb6, 0x7e, 0x7c, 0x29, 0x9f, 0xed, 0xf1,
+	0xe5, 0x05, 0x34, 0xc3, 0x83, 0x85, 0x74, 0xb3, 0x65, 0x51, 0xf5, 0x7c, 0xc3, 0xc0, 0x42, 0x8a,
+	0xda, 0x38, 0xb6, 0x8c, 0x1f, 0x79, 0x6c, 0xf9, 0x30, 0xaa, 0xc8, 0xf1, 0xa1, 0xb4, 0x77, 0xeb,
+	0x10, 0xef, 0x0b, 0x72, 0xf6, 0xc5, 0x81, 0x17, 0x24, 0x2b, 0xcd, 0xf4, 0x0d, 0xff, 0x06, 0x83,
+	0x2f, 0x83, 0xa4, 0x70, 0x7f, 0x1a, 0x4d, 0xad, 0x79, 0x41, 0x0b, 0x37, 0xb9, 0x2a, 0x72, 0x74,
+	0x36, 0xbf, 0x3f, 0x2c, 0xa1, 0x49, 0xcd, 0x0c, 0x79, 0xfa, 0xa6, 0x32, 0x23, 0x79, 0x7b, 0x31,
+	0xc7, 0xe4, 0xed, 0xef, 0x47, 0x88, 0x04, 0x7f, 0xc4, 0xdb, 0x27, 0x4c, 0x0b, 0x4f, 0x4d, 0x16,
+	0x37, 0x24, 0x07, 0xd0, 0xb8, 0x29, 0x6f, 0xc8, 0xf2, 0x21, 0x15, 0x56, 0x3e, 0x6d, 0x69, 0x1a,
+	0xd7, 0x58, 0x1e, 0xde, 0xdf, 0xda, 0x87, 0x59, 0x10, 0x1a, 0x18, 0x73, 0x94, 0x39, 0x4c, 0x31,
+	0xdb, 0x40, 0x13, 0x11, 0x8e, 0x7b, 0x1d, 0x7c, 0xa2, 0x04, 0xee, 0xd4, 0x2f, 0x0b, 0x78, 0x7b,
+	0x90, 0x9c, 0x2e, 0x3f, 0x8f, 0xa6, 0x8d, 0x2e, 0x0c, 0xe5, 0xee, 0x12, 0xa2, 0x4c, 0x5b, 0xf7,
+	0x49, 0x7c, 0x45, 0xc8, 0xb7, 0x68, 0x6b, 0x89, 0xdb, 0xe5, 0xb7, 0x60, 0x11, 0x24, 0x0c, 0xe7,
+	0x7e, 0xb3, 0x80, 0xce, 0xaf, 0xe1, 0xce, 0x26, 0x8e, 0xc4, 0x55, 0x39, 0xb3, 0x04, 0x3f, 0x83,
+	0xc6, 0xf9, 0x6d, 0x79, 0x7a, 0x57, 0xe0, 0x74, 0x20, 0xf0, 0x34, 0x76, 0xd0, 0xdb, 0x15, 0x03,
+	0x5a, 0xc5, 0x0e, 0x7a, 0xbb, 0x18, 0x28, 0xc6, 0x7e, 0x9b, 0xe9, 0x83, 0x70, 0x25, 0x3d, 0x57,
+	0xa6, 0x44, 0xd0, 0xb2, 0x3e, 0x55, 0x5e, 0x40, 0x33, 0x3c, 0xcc, 0x5b, 0x04, 0xab, 0x97, 0xcc,
+	0x1c, 0x61, 0x4b, 0x06, 0x16, 0x52, 0xd4, 0x74, 0x5f, 0xdb, 0x0c, 0xc9, 0x98, 0xe7, 0xf7, 0xec,
+	0x6a, 0x5f, 0x63, 0x60, 0x10, 0xf8, 0x61, 0x2e, 0x22, 0xff, 0x78, 0x1c, 0x8d, 0x1d, 0xdb, 0xc1,
+	0x4d, 0x77, 0x3b, 0x2b, 0x9c, 0xc0, 0xed, 0xec, 0x45, 0x34, 0xe5, 0x07, 0x7e, 0xe2, 0x7b, 0x6d,
+	0x7a, 0xef, 0xc3, 0x5f, 0x9f, 0xc8, 0xe2, 0x30, 0xb5, 0xa2, 0xe1, 0x32, 0xf8, 0x18, 0x6d, 0xed,
+	0xf7, 0xa2, 0x32, 0x55, 0x51, 0x9d, 0xd2, 0x11, 0x47, 0x9c, 0x41, 0x5e, 0xea, 0x34, 0x40, 0x81,
+	0x25, 0x52, 0x63, 0x9c, 0xa8, 0xbd, 0x82, 0xd9, 0xa7, 0xa4, 0xc5, 0xd9, 0x29, 0x9b, 0x87, 0x84,
+	0x7a, 0x0a, 0x0f, 0x7d, 0x2d, 0x08, 0x97, 0x2d, 0xcf, 0x6f, 0xf7, 0x22, 0xac, 0xb8, 0x8c, 0x99,
+	0x5c, 0x6e, 0xa4, 0xf0, 0xd0, 0xd7, 0xc2, 0xde, 0x42, 0x53, 0x1c, 0xc6, 0xe2, 0xa8, 0xc6, 0x4f,
+	0xf8, 0x94, 0x34, 0x5e, 0xee, 0x86, 0xc6, 0x09, 0x0c, 0xbe, 0x76, 0x0f, 0xcd, 0xf9, 0x41, 0x23,
+	0x0c, 0xc8, 0xe0, 0xf7, 0x77, 0xb1, 0xca, 0x62, 0x76, 0x12, 0x61, 0x17, 0x49, 0x58, 0xca, 0x4a,
+	0x9a, 0x1d, 0xf4, 0x4b, 0x20, 0xbe, 0xac, 0x17, 0x1b, 0x21, 0xdd, 0x1d, 0x13, 0x7f, 0x17, 0x5f,
+	0x8f, 0xa2, 0x30, 0x62, 0xb2, 0x2b, 0x27, 0x94, 0x4d, 0xaf, 0x1b, 0x97, 0xb2, 0x58, 0x42, 0xb6,
+	0x24, 0xfb, 0x63, 0x68, 0xa2, 0x1b, 0x85, 0xbb, 0x7e, 0x13, 0x47, 0x0e, 0xca, 0x43, 0x07, 0x62,
+	0xf3, 0x68, 0x9d, 0xf3, 0x54, 0x4b, 0xb5, 0x80, 0x80, 0x94, 0x47, 0xa3, 0x8f, 0x79, 0x6a, 0x22,
+	0x67, 0x32, 0x0f, 0xd9, 0x66, 0xa2, 0x23, 0xb6, 0x98, 0x0b, 0x18, 0x48, 0x59, 0xee, 0xf7, 0x66,
+	0xd0, 0x8c, 0xd9, 0x4d, 0xfb, 0x13, 0x08, 0x75, 0xa3, 0x90, 0x18, 0x9c, 0xb1, 0xcc, 0xcb, 0x73,
+	0x7b, 0xd4, 0xc4, 0xec, 0x82, 0x9f, 0x88, 0x35, 0x21, 0xcb, 0xba, 0x82, 0x82, 0x26, 0xd1, 0x8e,
+	0xd0, 0xf8, 0x0e, 0x3b, 0x21, 0x70, 0x3d, 0xfc, 0xa5, 0x5c, 0x8e, 0x77, 0x5c, 0x32, 0x0d, 0x0f,
+	0xe7, 0x20, 0x10, 0x82, 0xec, 0x4d, 0x54, 0xbc, 0x8f, 0x37, 0xf3, 0xc9, 0x0a, 0x2c, 0x55, 0xce,
+	0xda, 0x38, 0xc9, 0xe6, 0x7a, 0x0f, 0x6f, 0x02, 0x61, 0x4e, 0x9e, 0xab, 0xc9, 0x1c, 0x5e, 0x9d,
+	0x52, 0x1e, 0xcf, 0x65, 0x78, 0xcf, 0xb2, 0xe7, 0xe2, 0x20, 0x10, 0x82, 0xec, 0x8f, 0xa1, 0x0a,
+	0xd9, 0xa0, 0xb6, 0xa2, 0x30, 0x48, 0x78, 0x80, 0xd3, 0xa8, 0x0a, 0xb5, 0x60, 0xc7, 0xe5, 0x52,
+	0x35, 0x4c, 0x02, 0x41, 0x89, 0x23, 0x43, 0x3a, 0x20, 0xb9, 0x28, 0xdb, 0x7e, 0x23, 0x9f, 0xc4,
+	0x0f, 0xb7, 0x39, 0x37, 0x7d, 0x48, 0x0b, 0x18, 0x48, 0x59, 0xe4, 0x5b, 0xbe, 0x12, 0x6e, 0x3a,
+	0xe3, 0x79, 0x7c, 0xcb, 0x17, 0x43, 0xe3, 0x5b, 0xbe, 0x18, 0x6e, 0x02, 0x61, 0x4e, 0xe6, 0x48,
+	0x43, 0x46, 0xd7, 0x38, 0x13, 0x79, 0xcc, 0x91, 0x74, 0xb4, 0x0e, 0xbf, 0xdc, 0x96, 0x50, 0xd0,
+	0x24, 0x92, 0x77, 0xdb, 0xe2, 0x57, 0x5b, 0x4e, 0x25, 0x8f, 0x77, 0x6b, 0x5e, 0x94, 0xb1, 0x77,
+	0x2b, 0x60, 0x20, 0x65, 0x11, 0xb9, 0x3e, 0xbf, 0xa4, 0xc8, 0x67, 0x89, 0x34, 0xaf, 0x3c, 0x98,
+	0x5c, 0x01, 0x03, 0x29, 0x8b, 0xbc, 0xef, 0x78, 0x67, 0xef, 0xbe, 0xd7, 0xde, 0x21, 0x39, 0x02,
+	0x26, 0x73, 0xa9, 0xb6, 0xb9, 0xb3, 0x77, 0x8f, 0xf1, 0xd3, 0xdf, 0xb7, 0x82, 0x82, 0x26, 0x91,
+	0x78, 0x34, 0x4c, 0xc6, 0x89, 0x97, 0xf8, 0xe4, 0xe4, 0xec, 0xb5, 0x9d, 0xe9, 0x3c, 0x72, 0x65,
+	0xd4, 0x15, 0x43, 0xe1, 0xf9, 0x47, 0x93, 0xc8, 0x29, 0x30, 0xe8, 0x42, 0x49, 0x49, 0x83, 0x2e,
+	0xb9, 0x68, 0x74, 0x66, 0xf2, 0x30, 0xdf, 0xd0, 0x3b, 0x4b, 0x2e, 0x97, 0xa5, 0xd1, 0x21, 0x00,
+	0x60, 0x22, 0xc8, 0x24, 0x6a, 0x87, 0x22, 0x62, 0x79, 0x64, 0x43, 0x48, 0x4b, 0x9f, 0x44, 0xab,
+	0x61, 0x0b, 0x08, 0x73, 0xe2, 0xc9, 0x22, 0x72, 0xa1, 0x4c, 0xe5, 0x11, 0x4e, 0x60, 0xee, 0x63,
+	0x3c, 0x35, 0x0a, 0x3b, 0x25, 0x2d, 0xc8, 0x08, 0x44, 0x0a, 0xfc, 0xc2, 0xef, 0xcf, 0x3f, 0x89,
+	0x83, 0x46, 0xd8, 0xf4, 0x83, 0xd6, 0xe2, 0x2b, 0x71, 0x18, 0xd0, 0x3f, 0x09, 0x7e, 0x90, 0xb0,
+	0x9c, 0xee, 0x22, 0x7f, 0x0a, 0xa9, 0x47, 0xa8, 0xb1, 0x39, 0xea, 0xa4, 0x33, 0xa5, 0x9f, 0x74,
+	0xbe, 0x31, 0x86, 0xa6, 0xf4, 0xe2, 0x65, 0xc7, 0x50, 0xa7, 0xe5, 0x91, 0xbb, 0x30, 0xcc, 0x91,
+	0x9b, 0x98, 0x19, 0x35, 0x4f, 0x21, 0x71, 0xc5, 0xb1, 0x92, 0xdb, 0x89, 0x53, 0x99, 0x19, 0x35,
+	0x60, 0x0c, 0x86, 0xd0, 0x21, 0x1c, 0xaa, 0xc9, 0xb9, 0x8d, 0x69, 0xea, 0x65, 0xf3, 0xdc, 0x66,
+	0xe8, 0xde, 0xcf, 0x22, 0xa4, 0xaa, 0x6c, 0x71, 0x0f, 0x32, 0x79, 0x20, 0xd4, 0xaa, 0x7f, 0x69,
+	0x54, 0xe4, 0x02, 0x9e, 0xe8, 0xb2, 0xb8, 0xc9, 0xb3, 0xf9, 0x4a, 0x5b, 0xee, 0x0d, 0x0a, 0x05,
+	0x8e, 0x25, 0x3e, 0xd5, 0xba, 0x06, 0xca, 0x93, 0xf4, 0x5e, 0x50, 0xc7, 0x0e, 0x85, 0x03, 0x83,
+	0x92, 0x74, 0x1d, 0x47, 0x51, 0x18, 0x39, 0x15, 0xb3, 0xeb, 0x54, 0x8b, 0x04, 0x86, 0xa3, 0x77,
+	0x0b, 0x29, 0x05, 0x93, 0x2e, 0x96, 0x65, 0xed, 0x6e, 0x21, 0x85, 0x87, 0xbe, 0x16, 0xe4, 0x61,
+	0xb8, 0xf3, 0xdb, 0x24, 0x0b, 0x1f, 0x1e, 0xe0, 0xb6, 0xf6, 0x19, 0xdd, 0xd8, 0x90, 0xe3, 0x3c,
+	0x62, 0xa3, 0xf6, 0xf8, 0xd6, 0x86, 0xd1, 0xec, 0x02, 0x9f, 0xb5, 0xd0, 0x45, 0x9a, 0x3f, 0x92,
+	0x9f, 0xbe, 0x65, 0x8c, 0x3f, 0xa9, 0x1d, 0x40, 0x74, 0x0a, 0xe1, 0x22, 0xba, 0x92, 0x4b, 0x90,
+	0x1b, 0x51, 0x58, 0xd4, 0xd7, 0x23, 0xbf, 0x62, 0x60, 0x62, 0x48, 0x81, 0x5a, 0x5b, 0xef, 0xc9,
+	0x69, 0xd8, 0x0b, 0x5e, 0x25, 0x93, 0x85, 0xd8, 0x24, 0x72, 0xba, 0x7d, 0xcd, 0x30, 0x70, 0xe8,
+	0xf3, 0x8f, 0x4a, 0x02, 0x21, 0x92, 0xbc, 0xeb, 0x19, 0x53, 0x97, 0xca, 0xdb, 0xd5, 0x80, 0x64,
+	0x64, 0x15, 0x09, 0x9e, 0x8a, 0xd4, 0x8a, 0x9a, 0x99, 0x95, 0xc9, 0xfd, 0x7b, 0x63, 0xe8, 0xfc,
+	0xed, 0x96, 0x1f, 0xa4, 0x8b, 0xf7, 0x64, 0x55, 0xea, 0xb6, 0x86, 0xae, 0xd4, 0x2d, 0x33, 0x21,
+	0xf1, 0x3a, 0xd8, 0xd9, 0x99, 0x90, 0x38, 0x12, 0x4c, 0x5a, 0xfb, 0xfb, 0x16, 0x7a, 0xd2, 0x6b,
+	0xb2, 0xc3, 0xb7, 0xd7, 0xe6, 0xd0, 0xaa, 0x56, 0x36, 0x97, 0x7d, 0xb8, 0x78, 0x44, 0x95, 0xb6,
+	0xff, 0xe1, 0x17, 0xaa, 0x87, 0x48, 0x65, 0xb3, 0x50, 0xe4, 0x89, 0x7d, 0xf2, 0x30, 0x52, 0x38,
+	0xb4, 0xfb, 0xf6, 0x5f, 0x46, 0xe7, 0x8c, 0x07, 0xe6, 0x37, 0xd4, 0x15, 0xe6, 0x48, 0x50, 0x37,
+	0x51, 0x90, 0xa6, 0xb5, 0x7f, 0xc7, 0x42, 0x0e, 0xbb, 0x0e, 0xcd, 0x78, 0x35, 0xcc, 0x72, 0x1f,
+	0xe6, 0xff, 0x6a, 0x96, 0x06, 0x48, 0x64, 0xaf, 0x45, 0xdd, 0x8f, 0x0e, 0x20, 0x83, 0x81, 0x5d,
+	0xbe, 0x7c, 0x07, 0xfd, 0xd8, 0x91, 0xef, 0x7d, 0xa8, 0x72, 0xc4, 0x2f, 0xa1, 0x2b, 0x87, 0xf6,
+	0x76, 0xa8, 0xd5, 0xf1, 0xdb, 0x16, 0x9a, 0xd2, 0x8b, 0x90, 0xd0, 0xcb, 0x80, 0x70, 0x07, 0x07,
+	0x77, 0x23, 0x11, 0xef, 0xa9, 0x2e, 0x03, 0x28, 0x1c, 0x56, 0x41, 0x52, 0x10, 0xea, 0x46, 0xdb,
+	0xc7, 0x59, 0x57, 0x07, 0x4b, 0x0c, 0xbe, 0x0c, 0x92, 0x82, 0x45, 0x1c, 0x91, 0xff, 0xeb, 0xb8,
+	0x11, 0x61, 0x91, 0x9d, 0x41, 0x8b, 0x38, 0x52, 0x38, 0x30, 0x28, 0x89, 0x33, 0x06, 0xbf, 0x97,
+	0x2d, 0x29, 0x67, 0x8c, 0xd4, 0x3d, 0xea, 0x6f, 0x58, 0x88, 0x67, 0x56, 0x26, 0x8e, 0x8e, 0x66,
+	0x84, 0x66, 0xca, 0xec, 0x5b, 0x5d, 0x5f, 0xc9, 0x8a, 0xd0, 0xbc, 0xc6, 0x03, 0x24, 0x53, 0xcb,
+	0xab, 0x16, 0x0c, 0x29, 0x34, 0xad, 0xe2, 0x40, 0x4d, 0x6b, 0x11, 0x55, 0xa4, 0xcb, 0x39, 0xd7,
+	0x57, 0xe4, 0x95, 0xb3, 0x74, 0x51, 0x07, 0x45, 0xe3, 0xfe, 0x8a, 0x85, 0x66, 0x68, 0x9e, 0x49,
+	0x65, 0x91, 0x7b, 0xbb, 0x8c, 0x02, 0xb1, 0x0c, 0xab, 0x2f, 0x8f, 0x02, 0x79, 0xb8, 0x3f, 0x3f,
+	0x49, 0x5b, 0xa4, 0x82, 0x42, 0x3e, 0xc0, 0xaf, 0x3d, 0x68, 0xac, 0x4a, 0x61, 0xf8, 0x2c, 0xa8,
+	0xb2, 0x9b, 0x82, 0x09, 0x28, 0x7e, 0xee, 0xab, 0x68, 0x4a, 0xcf, 0x0f, 0x44, 0xbc, 0x23, 0xba,
+	0xa4, 0x30, 0x9b, 0xe1, 0x0e, 0x2a, 0xbd, 0x23, 0xd6, 0x15, 0x0a, 0x74, 0x3a, 0xda, 0x2c, 0x54,
+	0xcd, 0x52, 0x4e, 0x15, 0xeb, 0xa1, 0xde, 0x4c, 0xfd, 0x70, 0x23, 0x84, 0x54, 0x3e, 0xc2, 0x63,
+	0xe8, 0xbb, 0x35, 0x34, 0xc6, 0x1c, 0x16, 0x98, 0xf6, 0x5c, 0xfb, 0x49, 0xf2, 0xf6, 0xd8, 0x08,
+	0x7f, 0xb8, 0x7f, 0x94, 0x86, 0xce, 0x5a, 0xd2, 0x6a, 0xeb, 0x19, 0xb9, 0xaf, 0x72, 0xaf, 0xb6,
+	0x9e, 0x21, 0xe3, 0xd1, 0x55, 0x5b, 0xcf, 0xea, 0xcc, 0xff, 0x5b, 0xd5, 0xd6, 0xdf, 0x87, 0x86,
+	0x2d, 0xbc, 0x48, 0x1d, 0xa9, 0xf5, 0x84, 0xb3, 0xca, 0x91, 0xda, 0x8c, 0x8e, 0xff, 0x6a, 0x11,
+	0x4d, 0x6a, 0x87, 0xdb, 0x21, 0xdc, 0xa1, 0xa9, 0x23, 0x42, 0x18, 0x89, 0x0b, 0x25, 0xe5, 0x88,
+	0x10, 0x46, 0x09, 0x50, 0x0c, 0x49, 0x5f, 0x40, 0xc2, 0x15, 0x71, 0x9c, 0x88, 0x40, 0x1f, 0x7e,
+	0x4d, 0xc6, 0x60, 0x20, 0xb1, 0x19, 0xf7, 0xc9, 0xa5, 0xa1, 0xee, 0x93, 0x31, 0x2a, 0x6d, 0x27,
+	0x49, 0xd7, 0x29, 0xe7, 0x71, 0x04, 0x97, 0x4e, 0xca, 0xcc, 0x17, 0x81, 0xfc, 0x04, 0xca, 0x9e,
+	0x88, 0x21, 0xfe, 0xd5, 0xce, 0x58, 0x1e, 0x62, 0xa4, 0x0f, 0x3a, 0x13, 0x43, 0x7e, 0x02, 0x65,
+	0xef, 0xfe, 0x76, 0x09, 0xcd, 0xa6, 0xad, 0xc0, 0x79, 0xbb, 0x63, 0x67, 0xf9, 0x32, 0x14, 0x1f,
+	0xa1, 0x2f, 0x83, 0xa6, 0x04, 0x97, 0x06, 0x2b, 0xc1, 0x86, 0xe3, 0x40, 0xf9, 0x28, 0xc7, 0x01,
+	0xdd, 0x41, 0x62, 0xec, 0x6c, 0x1d, 0x24, 0x3e, 0x63, 0x21, 0x14, 0x79, 0x41, 0x0b, 0xd3, 0x77,
+	0x9e, 0x4f, 0xce, 0x71, 0xed, 0x0a, 0x40, 0x72, 0x26, 0x81, 0xc5, 0x3c, 0x59, 0x96, 0x84, 0x81,
+	0x26, 0xd9, 0xfd, 0xaa, 0x85, 0x9c, 0x41, 0x0d, 0xc9, 0x40, 0xa1, 0xdb, 0x61, 0xda, 0x97, 0x82,
+	0x6e, 0x97, 0xc0, 0x70, 0xa4, 0xee, 0x1a, 0x0e, 0x9a, 0xe9, 0xba, 0x6b, 0xd7, 0x83, 0x26, 0x10,
+	0x38, 0xc9, 0xdb, 0x11, 0x27, 0xb8, 0x9b, 0x8a, 0x28, 0x2f, 0x91, 0x5d, 0x2d, 0xe3, 0x1a, 0x92,
+	0xd2, 0xba, 0x3f, 0x8d, 0x86, 0x2c, 0x9e, 0xea, 0x5e, 0x47, 0xb6, 0x48, 0x47, 0xce, 0xd2, 0x39,
+	0xd0, 0x1d, 0x7b, 0x11, 0x55, 0x22, 0x9e, 0xec, 0x30, 0xe6, 0x0b, 0x9d, 0xdc, 0xf2, 0x45, 0x16,
+	0xc4, 0x18, 0x14, 0x0d, 0xf1, 0x9d, 0x1d, 0xe7, 0xd7, 0xcb, 0x67, 0x90, 0xce, 0x60, 0xc7, 0xf0,
+	0xf5, 0x5c, 0xc9, 0x27, 0xeb, 0xce, 0xa0, 0x5c, 0x06, 0x71, 0x2a, 0x97, 0xc1, 0x4b, 0xf9, 0x88,
+	0x3b, 0x3c, 0x91, 0xc1, 0xb7, 0xca, 0xe8, 0x5c, 0x2a, 0x23, 0x61, 0xaa, 0xce, 0xb2, 0xf5, 0x48,
+	0xea, 0x2c, 0xdb, 0xb1, 0x51, 0x6b, 0x3b, 0xbf, 0x40, 0xbf, 0x3f, 0x2f, 0xbb, 0x9d, 0x57, 0x08,
+	0x66, 0xf9, 0x0d, 0x13, 0x82, 0xe9, 0xfe, 0x67, 0x0b, 0x3d, 0x3e, 0x30, 0x5f, 0x2f, 0xad, 0x47,
+	0x15, 0x99, 0x58, 0xbe, 0x5e, 0xe4, 0x9c, 0xca, 0xd3, 0x28, 0xbc, 0xa0, 0x21, 0x20, 0x2d, 0x9e,
+	0x96, 0x25, 0x48, 0xbc, 0x88, 0x1c, 0xf3, 0xc9, 0x3a, 0xcb, 0x55, 0x30, 0x56, 0x96, 0x40, 0x83,
+	0x83, 0x41, 0xe5, 0x7e, 0xdd, 0x42, 0xce, 0xa0, 0xba, 0x15, 0xc7, 0x38, 0x80, 0xfc, 0xa5, 0x54,
+	0xea, 0x83, 0xf9, 0xbe, 0xd4, 0x07, 0x29, 0x93, 0x3b, 0x27, 0xd7, 0xad, 0xdd, 0xc5, 0x23, 0x1c,
+	0x6a, 0xfe, 0x7d, 0x11, 0xcd, 0xf2, 0x2e, 0xaa, 0xb3, 0xe3, 0x3b, 0x8d, 0x84, 0x0d, 0x3f, 0x9e,
+	0x4a, 0xd8, 0x70, 0x21, 0x4d, 0xff, 0xe7, 0xd9, 0x1a, 0xde, 0x58, 0xd9, 0x1a, 0x3e, 0x67, 0xa1,
+	0x39, 0xfe, 0x8d, 0x96, 0x71, 0x17, 0x07, 0x4d, 0x1c, 0x34, 0xf6, 0x8e, 0x31, 0xde, 0x16, 0xf5,
+	0x0c, 0x8e, 0x05, 0xd3, 0xec, 0x90, 0x95, 0xc5, 0xd1, 0xbe, 0x66, 0x68, 0x22, 0x53, 0xba, 0x26,
+	0xc2, 0xf5, 0x8e, 0xbf, 0x5b, 0x40, 0x97, 0xfa, 0xba, 0x72, 0xec, 0x09, 0x90, 0x7f, 0x87, 0x94,
+	0x2f, 0x5c, 0x69, 0x08, 0x5f, 0x38, 0x62, 0x8f, 0xf1, 0x12, 0x3f, 0xde, 0xf2, 0xa5, 0x37, 0x9b,
+	0x32, 0x74, 0x08, 0x04, 0x28, 0x9a, 0x61, 0x3e, 0xd6, 0xbf, 0x1d, 0x43, 0x17, 0x33, 0x2b, 0x78,
+	0x90, 0xb2, 0x10, 0x7d, 0xdb, 0xfa, 0xbd, 0x9c, 0x4b, 0x85, 0xc8, 0xfc, 0x79, 0xa7, 0x9b, 0x8f,
+	0xe2, 0x97, 0xf4, 0x3c, 0x10, 0x6c, 0xab, 0xde, 0x3a, 0x85, 0xa2, 0x27, 0xc3, 0xa6, 0x84, 0x50,
+	0xea, 0x43, 0xe9, 0x0c, 0xd4, 0x87, 0x37, 0xfe, 0xbe, 0x9c, 0x4e, 0x8d, 0x30, 0xf6, 0x28, 0x52,
+	0x23, 0x90, 0x8b, 0x91, 0x2e, 0xbd, 0x5f, 0xc3, 0xf7, 0x54, 0x64, 0xfa, 0x84, 0xba, 0x18, 0x59,
+	0xd7, 0x91, 0x60, 0xd2, 0xba, 0x5f, 0x28, 0xa2, 0xa7, 0x8f, 0x3b, 0x36, 0xde, 0xa0, 0x99, 0xb1,
+	0x62, 0x23, 0x33, 0xd6, 0x19, 0x69, 0xd2, 0xa7, 0x92, 0x24, 0xeb, 0x57, 0xcb, 0xe8, 0xf1, 0xbe,
+	0x8f, 0x21, 0xde, 0xd9, 0xb1, 0x1c, 0x78, 0xc7, 0xc9, 0x49, 0x4b, 0x14, 0x87, 0x57, 0xaa, 0xc8,
+	0x78, 0x9d, 0x81, 0x1f, 0xee, 0xcf, 0xcf, 0xa9, 0x4c, 0xf0, 0x1c, 0x08, 0xa2, 0x11, 0x33, 0x87,
+	0x51, 0x6c, 0xca, 0x1c, 0xc6, 0x60, 0x20, 0xb1, 0xf6, 0x6b, 0xda, 0xd1, 0xb4, 0x74, 0x5a, 0x05,
+	0x0f, 0x0e, 0x73, 0x86, 0xff, 0x10, 0x9a, 0x88, 0x45, 0x05, 0x73, 0xb6, 0x20, 0xbc, 0xed, 0x98,
+	0xe9, 0x94, 0x88, 0x89, 0x54, 0x94, 0x33, 0x67, 0xcf, 0x27, 0x7e, 0x81, 0x64, 0xa9, 0xe5, 0xed,
+	0x1c, 0x1b, 0x94, 0xb7, 0xd3, 0x4e, 0x94, 0x25, 0x72, 0x3c, 0x0f, 0x6d, 0x5b, 0xa6, 0xfe, 0x60,
+	0x4c, 0x99, 0x7d, 0xa9, 0xcf, 0xa8, 0xf9, 0x29, 0x96, 0x9e, 0x23, 0xf1, 0xc8, 0xf3, 0x89, 0x90,
+	0xb9, 0x3b, 0xf9, 0x48, 0x5e, 0x12, 0x7c, 0x8d, 0xe4, 0x1c, 0x5c, 0x14, 0x68, 0x62, 0x49, 0x7e,
+	0xc0, 0x49, 0x3e, 0x52, 0xcf, 0x20, 0xbb, 0xd5, 0x2b, 0x66, 0x76, 0xab, 0xeb, 0xb9, 0x6c, 0x85,
+	0x03, 0x52, 0x5b, 0xbd, 0x82, 0xa6, 0xf4, 0x9a, 0x64, 0xa4, 0xa8, 0x8b, 0xdc, 0xca, 0xad, 0x51,
+	0x8a, 0xba, 0xf4, 0xa7, 0xe2, 0x74, 0x7f, 0xbe, 0x28, 0x15, 0x4f, 0x55, 0x62, 0xe9, 0x18, 0xf3,
+	0xbc, 0x83, 0xca, 0x1d, 0xea, 0xaa, 0x98, 0x4b, 0xb6, 0x2f, 0x1a, 0x53, 0xc4, 0x72, 0x1a, 0xc8,
+	0x57, 0x42, 0x7f, 0x02, 0x93, 0x42, 0x12, 0x81, 0x74, 0x71, 0xd4, 0xc0, 0x41, 0x22, 0x4e, 0x48,
+	0x65, 0xee, 0xf2, 0x2b, 0xa1, 0xa0, 0x51, 0x90, 0xa5, 0xbc, 0x1b, 0x85, 0x0f, 0x64, 0xbd, 0xa3,
+	0x92, 0xb9, 0x09, 0xac, 0x6b, 0x38, 0x30, 0x28, 0xed, 0x8f, 0x6b, 0x45, 0x6e, 0xca, 0xa7, 0x71,
+	0x82, 0x4d, 0x69, 0x37, 0x7a, 0x79, 0x1b, 0xf7, 0xdf, 0x4c, 0xcb, 0x31, 0x4d, 0x6d, 0x77, 0xfa,
+	0x6a, 0x68, 0x1d, 0xba, 0x1a, 0xea, 0x8b, 0x51, 0x21, 0xff, 0xc5, 0xe8, 0xbd, 0x24, 0x56, 0x8c,
+	0xcd, 0x50, 0x7e, 0xa0, 0x7b, 0x4a, 0x63, 0xbf, 0x40, 0x4e, 0x85, 0x0b, 0xbb, 0xc6, 0x12, 0x4a,
+	0x6d, 0x70, 0x5a, 0x40, 0x19, 0x83, 0x82, 0x64, 0x63, 0x7f, 0x0c, 0x4d, 0xde, 0x0f, 0xa3, 0x9d,
+	0x76, 0xe8, 0x91, 0x0c, 0x35, 0x0e, 0xca, 0xe3, 0xba, 0x40, 0xde, 0x03, 0x33, 0xc7, 0xc7, 0x7b,
+	0x8a, 0x3f, 0xe8, 0xc2, 0x48, 0x5d, 0xc7, 0x8e, 0x1f, 0x00, 0xf6, 0x9a, 0x7b, 0xfa, 0x5d, 0x4a,
+	0x59, 0x99, 0x17, 0xd6, 0x4c, 0x34, 0xa4, 0xe9, 0xe9, 0xd5, 0x40, 0x64, 0x58, 0x5b, 0x9d, 0xe9,
+	0x3c, 0x62, 0x2e, 0xfb, 0x2d, 0xb8, 0x2c, 0x37, 0x84, 0x09, 0x87, 0x94, 0x6c, 0x32, 0x6c, 0x63,
+	0x9e, 0x60, 0x3b, 0xd7, 0x61, 0x2b, 0xb2, 0x76, 0xab, 0x4f, 0x29, 0x20, 0x20, 0x05, 0x92, 0xe2,
+	0x30, 0xc2, 0x7c, 0x7c, 0xcb, 0x8f, 0x93, 0x30, 0xda, 0x63, 0x91, 0x16, 0x63, 0xaa, 0x38, 0x0c,
+	0x64, 0xe0, 0x21, 0xb3, 0x15, 0x39, 0x5e, 0xd3, 0xca, 0x8b, 0x4d, 0xae, 0x45, 0xaa, 0x62, 0x0f,
+	0x14, 0x0a, 0x1c, 0x7b, 0x58, 0xc6, 0xbc, 0x89, 0x11, 0x32, 0xe6, 0xd5, 0xd1, 0xc5, 0x34, 0x8a,
+	0x86, 0x3f, 0x39, 0x53, 0xa6, 0x5a, 0xb5, 0x9e, 0x45, 0x04, 0xd9, 0x6d, 0x49, 0x68, 0x62, 0x84,
+	0xa9, 0xa1, 0xa9, 0x2a, 0x02, 0x50, 0x86, 0x0e, 0x4d, 0x04, 0xc1, 0x00, 0x14, 0x2f, 0x63, 0xb9,
+	0x9a, 0xcc, 0xf9, 0x54, 0x22, 0xbf, 0xfd, 0xa0, 0x6a, 0x5c, 0x9f, 0x27, 0x8e, 0xa2, 0x9a, 0x67,
+	0x9b, 0x33, 0x93, 0x47, 0xad, 0xb5, 0x4c, 0xaf, 0x3d, 0x66, 0xb8, 0xd3, 0x51, 0x60, 0x88, 0xb6,
+	0x3f, 0x6b, 0xa1, 0xe9, 0xa6, 0x96, 0xca, 0x3a, 0x76, 0xce, 0xe5, 0x11, 0xca, 0xaf, 0x67, 0xc7,
+	0x56, 0xe7, 0x19, 0x1d, 0x1a, 0x83, 0x29, 0x97, 0x54, 0x58, 0xa9, 0x34, 0xa9, 0xe5, 0x24, 0xbe,
+	0x13, 0x38, 0xb3, 0x79, 0x68, 0x47, 0x7d, 0xf6, 0x18, 0x75, 0xf8, 0x5f, 0x16, 0x92, 0x40, 0x09,
+	0x25, 0x1a, 0xda, 0xa4, 0x98, 0x9d, 0x64, 0x71, 0x9d, 0xcb, 0x6b, 0x65, 0xd2, 0x56, 0x04, 0xb9,
+	0xca, 0x6a, 0x00, 0xd0, 0xa5, 0xba, 0xbf, 0x77, 0x1e, 0x4d, 0x1b, 0x57, 0x24, 0xe4, 0x2e, 0x8d,
+	0x46, 0x10, 0xd2, 0xcd, 0x6c, 0x42, 0xed, 0xf5, 0x6c, 0xee, 0x30, 0x1c, 0xa9, 0xe1, 0x77, 0xae,
+	0x6b, 0x78, 0xc6, 0x08, 0xad, 0x6b, 0xc4, 0x5b, 0x57, 0xd3, 0xdd, 0x46, 0xad, 0xf5, 0x26, 0x3c,
+	0x86, 0xb4, 0x74, 0xb2, 0x5d, 0xf0, 0x0c, 0x08, 0x6d, 0x1c, 0x51, 0x6a, 0x7e, 0x36, 0x94, 0x2c,
+	0x96, 0x4c, 0x34, 0xa4, 0xe9, 0xc9, 0x02, 0xc0, 0x63, 0x27, 0x4f, 0x14, 0x41, 0x4c, 0x17, 0x80,
+	0xaa, 0x60, 0x00, 0x8a, 0x57, 0x46, 0xd0, 0x67, 0x79, 0xa8, 0xa0, 0x4f, 0xf2, 0x6c, 0xaa, 0x36,
+	0x30, 0x65, 0x30, 0x66, 0x96, 0x38, 0x5e, 0x32, 0xd1, 0x90, 0xa6, 0x27, 0xf7, 0xcd, 0x52, 0x4b,
+	0x61, 0x7e, 0xd2, 0x72, 0xb3, 0xc8, 0xd0, 0x54, 0xaa, 0xe8, 0x5c, 0x8f, 0xda, 0x70, 0x9b, 0x02,
+	0xc9, 0x97, 0x6b, 0x29, 0xf0, 0xae, 0x89, 0x86, 0x34, 0x3d, 0x31, 0x37, 0x44, 0x64, 0x2f, 0x96,
+	0x0c, 0x98, 0xf3, 0xb4, 0x9c, 0x9e, 0xa0, 0x23, 0xc1, 0xa4, 0x25, 0x85, 0xca, 0x55, 0xfd, 0x44,
+	0xc1, 0x80, 0x79, 0x53, 0xcb, 0xc2, 0x57, 0xd5, 0x34, 0x01, 0xf4, 0xb7, 0xb1, 0xff, 0x2a, 0x9a,
+	0xd5, 0xde, 0x84, 0x5e, 0x3d, 0xf9, 0x02, 0xf5, 0xc8, 0x4e, 0xe1, 0xa0, 0x8f, 0xda, 0x7e, 0x37,
+	0x9a, 0x69, 0x84, 0xed, 0x36, 0xdd, 0x02, 0xa9, 0xaf, 0x3a, 0x2f, 0x66, 0xc7, 0xca, 0xfe, 0x19,
+	0x18, 0x48, 0x51, 0x92, 0xfc, 0x2b, 0xe1, 0x26, 0x39, 0x91, 0xe1, 0xe6, 0x4d, 0x1c, 0x60, 0x7e,
+	0x3c, 0x98, 0x36, 0xf3, 0xaf, 0xdc, 0xe9, 0xa3, 0x80, 0x8c, 0x56, 0xdc, 0x86, 0x24, 0x27, 0xdb,
+	0x4c, 0x1e, 0x49, 0xa8, 0xd3, 0x37, 0x0e, 0x47, 0x26, 0x5b, 0x8c, 0xd0, 0x18, 0x73, 0xa6, 0xcc,
+	0xa7, 0xaa, 0x9d, 0x28, 0x15, 0x6a, 0x5e, 0xcd, 0x32, 0x28, 0x70, 0x49, 0xf6, 0x27, 0x50, 0x65,
+	0xb3, 0xdd, 0xc3, 0x37, 0x23, 0x8c, 0x03, 0x67, 0x36, 0x0f, 0xb5, 0xa9, 0x26, 0xd8, 0x71, 0xc9,
+	0x72, 0x9d, 0x96, 0x08, 0x50, 0x22, 0xed, 0x37, 0xa3, 0xc9, 0x5b, 0xeb, 0x55, 0x39, 0x0a, 0xe7,
+	0xe8, 0xd7, 0x2f, 0x91, 0x26, 0xa0, 0x23, 0xc8, 0x0c, 0x93, 0xda, 0xbd, 0x9d, 0x2a, 0xc6, 0xd0,
+	0xaf, 0xac, 0x13, 0x6a, 0xea, 0x5d, 0x0b, 0x75, 0xe7, 0x7c, 0x8a, 0x9a, 0xc3, 0x41, 0x52, 0x90,
+	0x5c, 0x9e, 0x5c, 0x9d, 0xa0, 0x6b, 0xd3, 0x85, 0x93, 0xe5, 0xf2, 0x04, 0xc5, 0x02, 0x74, 0x7e,
+	0xd4, 0xf3, 0x8f, 0x99, 0xfb, 0x6e, 0xf4, 0xda, 0x6d, 0xe7, 0x22, 0x5d, 0x37, 0x95, 0xe7, 0x9f,
+	0x42, 0x81, 0x4e, 0xa7, 0x8c, 0xfe, 0x8f, 0x0d, 0x61, 0xf4, 0xd7, 0x6c, 0xf8, 0x97, 0x8e, 0x08,
+	0x19, 0xd9, 0x44, 0x97, 0xc5, 0x81, 0xa0, 0x7f, 0x92, 0x38, 0x8e, 0x61, 0x30, 0xbf, 0x7c, 0x6f,
+	0x20, 0x25, 0x1c, 0xc2, 0x85, 0x84, 0x3c, 0x79, 0xed, 0x4d, 0xe7, 0xf1, 0x3c, 0x4e, 0x36, 0xd5,
+	0xd5, 0x1a, 0x1f, 0x51, 0x34, 0xe4, 0xa9, 0xba, 0x5a, 0x03, 0xc2, 0xdc, 0xf6, 0x51, 0xc9, 0x6b,
+	0x6f, 0xc6, 0xce, 0xe5, 0x6b, 0xc5, 0x3c, 0x85, 0x28, 0x7b, 0xe3, 0x6a, 0x8d, 0xd8, 0x1b, 0xdb,
+	0x9b, 0xd4, 0x5d, 0xc7, 0xd4, 0xf6, 0x9e, 0xc8, 0x43, 0xab, 0xe8, 0x8f, 0x8c, 0x38, 0x52, 0xd5,
+	0x7b, 0x11, 0xd9, 0x3e, 0xf5, 0x80, 0xd1, 0xd5, 0x30, 0xe7, 0x49, 0xb3, 0x9a, 0xe6, 0x4a, 0x1f,
+	0x05, 0x64, 0xb4, 0x22, 0xca, 0xc6, 0x54, 0x53, 0xa8, 0x55, 0x3e, 0x8e, 0x9d, 0x2b, 0x79, 0x54,
+	0xbf, 0x1a, 0x70, 0x7f, 0xa6, 0x0c, 0x10, 0xcb, 0x9a, 0x48, 0x30, 0x3a, 0x40, 0x5d, 0x16, 0xcc,
+	0x1a, 0xc2, 0xec, 0x52, 0xc2, 0xb9, 0x9a, 0x87, 0xcb, 0x82, 0xe9, 0xff, 0xbe, 0xb4, 0xed, 0x05,
+	0x2d, 0xac, 0x5c, 0x16, 0x36, 0x32, 0xe4, 0x42, 0x66, 0x6f, 0xdc, 0x4f, 0x16, 0xa4, 0x57, 0x8b,
+	0x2c, 0x33, 0xfd, 0xaa, 0xbe, 0x9c, 0x5a, 0x79, 0x44, 0x34, 0x6a, 0xcb, 0x29, 0x3f, 0x8b, 0x4c,
+	0x0f, 0x5c, 0x4c, 0xbb, 0x72, 0x03, 0xc9, 0xa5, 0x34, 0x8a, 0x59, 0x42, 0x9b, 0x99, 0x5f, 0xcd,
+	0xed, 0x83, 0x24, 0xbb, 0xb5, 0xfb, 0xb5, 0xe2, 0xe3, 0xdd, 0x51, 0x18, 0x37, 0x0d, 0x85, 0xa1,
+	0x6b, 0x70, 0x14, 0x4f, 0xbb, 0x06, 0x87, 0xfb, 0xbf, 0x2c, 0x74, 0x29, 0xf5, 0x70, 0x7f, 0x56,
+	0x8a, 0xb1, 0x91, 0x42, 0x7c, 0x8f, 0xae, 0x0a, 0x5b, 0xbe, 0x85, 0xf8, 0x86, 0xab, 0xbe, 0xf6,
+	0xd7, 0x0a, 0x03, 0x9f, 0x9c, 0x1a, 0x24, 0xc5, 0xf0, 0xb3, 0x4e, 0xbd, 0x04, 0x8c, 0x6e, 0xd0,
+	0x2a, 0x9c, 0xb1, 0x41, 0xcb, 0xfd, 0xcd, 0x69, 0x79, 0xc3, 0x9f, 0x0a, 0x24, 0x8b, 0x50, 0xd9,
+	0x8f, 0x13, 0x3f, 0xcc, 0x31, 0x13, 0xac, 0x29, 0x81, 0x85, 0x4e, 0x53, 0x04, 0x30, 0x51, 0x44,
+	0x66, 0x40, 0x62, 0x97, 0x9c, 0x42, 0x1e, 0x32, 0x33, 0xc2, 0xa0, 0x98, 0x4c, 0x8a, 0x00, 0x26,
+	0xca, 0x7e, 0x85, 0xe9, 0x2e, 0xc5, 0x3c, 0x16, 0xf1, 0xea, 0x6a, 0x2d, 0x25, 0xcf, 0xd4, 0x61,
+	0x5e, 0x41, 0xc5, 0xb8, 0xe3, 0x3b, 0xa5, 0x3c, 0x64, 0xd5, 0xd7, 0x56, 0xb2, 0x64, 0xd5, 0xd7,
+	0x56, 0x80, 0x08, 0xa1, 0x3e, 0xc7, 0x5e, 0x67, 0xd3, 0x8b, 0x63, 0xaf, 0x29, 0xef, 0xed, 0x46,
+	0xf4, 0x39, 0xae, 0x4a, 0x7e, 0x29, 0xd1, 0xf4, 0x86, 0x42, 0x61, 0x41, 0x93, 0x6c, 0x7f, 0x0c,
+	0x8d, 0x7b, 0xdd, 0xee, 0x1a, 0xe6, 0xe7, 0xed, 0x91, 0xad, 0x66, 0x55, 0xc6, 0x2c, 0xd5, 0x03,
+	0x7a, 0x81, 0xc7, 0x51, 0x20, 0x04, 0x12, 0xd9, 0x49, 0xe4, 0xe1, 0x2d, 0x7f, 0xc7, 0x19, 0xcf,
+	0x43, 0xf6, 0x06, 0x63, 0x96, 0x25, 0x9b, 0xa3, 0x40, 0x08, 0xa4, 0x76, 0xba, 0x8e, 0x17, 0x78,
+	0x32, 0x93, 0x5c, 0x3e, 0x29, 0x37, 0xf5, 0xdc, 0x74, 0xca, 0x10, 0xb0, 0xa6, 0x0b, 0x02, 0x53,
+	0x2e, 0x29, 0x6c, 0x46, 0x98, 0xf9, 0x0f, 0xb8, 0x41, 0x76, 0xd4, 0x32, 0xaf, 0x94, 0x57, 0xea,
+	0x1d, 0x50, 0xad, 0x81, 0x61, 0x80, 0x4b, 0xb3, 0xbf, 0x61, 0xa1, 0x71, 0x96, 0x0d, 0x80, 0xd8,
+	0x1d, 0xc8, 0xb3, 0x7f, 0x24, 0x97, 0x95, 0xcd, 0x14, 0xcd, 0xb3, 0x15, 0xf0, 0xd0, 0x9d, 0x45,
+	0x19, 0x7d, 0xcb, 0xa0, 0x47, 0xe6, 0x2b, 0x10, 0x3d, 0x24, 0x56, 0x8e, 0x8e, 0x27, 0x1e, 0x8b,
+	0x5d, 0x3f, 0xeb, 0x56, 0x8e, 0xb5, 0x14, 0x0e, 0xfa, 0xa8, 0xe9, 0x94, 0x6b, 0xc9, 0x92, 0x00,
+	0xce, 0x54, 0x1e, 0x53, 0x6e, 0x50, 0x89, 0x01, 0x36, 0xe5, 0x14, 0x16, 0x34, 0xc9, 0x5a, 0x00,
+	0xfc, 0xf4, 0xa1, 0x01, 0xf0, 0xaf, 0x21, 0x14, 0x27, 0x7e, 0x63, 0xc7, 0x0f, 0x48, 0x20, 0xc9,
+	0x4c, 0x1e, 0xcb, 0x12, 0xef, 0x65, 0x5d, 0xb2, 0x65, 0x1d, 0x55, 0xbf, 0x41, 0x13, 0x49, 0xca,
+	0x3f, 0xea, 0x5f, 0x6f, 0xa8, 0x2c, 0x11, 0x3f, 0x2c, 0x22, 0xa4, 0xae, 0x53, 0xed, 0x8e, 0x2c,
+	0x0d, 0x60, 0xe5, 0x9d, 0x7d, 0x1e, 0xa9, 0x0a, 0x03, 0xb2, 0x9c, 0x40, 0x8b, 0x97, 0x13, 0xc8,
+	0x3d, 0xd5, 0xfd, 0x44, 0xaa, 0x2a, 0xc1, 0xeb, 0x96, 0x0a, 0x5b, 0x29, 0xe6, 0xa3, 0x27, 0x89,
+	0x77, 0xb6, 0xc0, 0x03, 0x55, 0x52, 0x75, 0x43, 0xd3, 0xe1, 0x2b, 0x97, 0x3f, 0x6d, 0xa1, 0x29,
+	0x9d, 0x34, 0xe3, 0x33, 0xfd, 0xac, 0xfe, 0x99, 0xf2, 0x7c, 0x1f, 0xfa, 0x17, 0xff, 0x63, 0x0b,
+	0x21, 0x72, 0x5b, 0xd3, 0xeb, 0x74, 0x3c, 0x96, 0xfb, 0x93, 0x25, 0xc3, 0xb0, 0x8e, 0x9d, 0x0c,
+	0xa3, 0x30, 0x64, 0x32, 0x8c, 0xe2, 0x50, 0xc9, 0x30, 0x4a, 0xc3, 0x27, 0xc3, 0x28, 0x0f, 0x4e,
+	0x86, 0xe1, 0x7e, 0xd9, 0x42, 0x73, 0x7d, 0xbb, 0x3c, 0x31, 0x33, 0x45, 0x61, 0x98, 0x0c, 0x88,
+	0x4b, 0x05, 0x85, 0x02, 0x9d, 0x8e, 0xe4, 0x05, 0xe0, 0xa7, 0xdb, 0x7a, 0xb7, 0xed, 0x67, 0x96,
+	0x21, 0xd8, 0x48, 0xe1, 0xa1, 0xaf, 0x85, 0xfb, 0x2f, 0x2c, 0x34, 0xa9, 0x25, 0x2f, 0x26, 0xcf,
+	0x41, 0x83, 0x93, 0xfb, 0x42, 0x86, 0x08, 0x10, 0x18, 0x8e, 0x79, 0x11, 0xb7, 0x94, 0x7b, 0xa6,
+	0xe6, 0x45, 0xdc, 0xf2, 0x99, 0x17, 0x71, 0x8b, 0x47, 0x27, 0x4b, 0x07, 0xd9, 0x62, 0xa6, 0x83,
+	0xac, 0x8c, 0x50, 0x2a, 0x1d, 0x1d, 0xa1, 0x54, 0xce, 0x8e, 0x50, 0x72, 0xef, 0xa0, 0x29, 0x16,
+	0x73, 0xfd, 0x12, 0x3e, 0xe6, 0x19, 0xf6, 0x0a, 0x1b, 0xed, 0xa9, 0x90, 0x27, 0xd2, 0x9c, 0xc0,
+	0xdd, 0x7f, 0x60, 0xa1, 0x99, 0x3a, 0x4e, 0xf8, 0x29, 0xba, 0xe1, 0xb5, 0xb1, 0xe6, 0xd1, 0x64,
+	0x0d, 0xf4, 0x68, 0xd2, 0x3d, 0x1e, 0x0a, 0x87, 0x7a, 0x3c, 0x90, 0x54, 0xe9, 0x64, 0x2a, 0x98,
+	0x5b, 0x53, 0xd1, 0xb4, 0x02, 0xad, 0xf5, 0x51, 0x40, 0x46, 0x2b, 0xf7, 0xef, 0xb3, 0xce, 0xaa,
+	0xb2, 0x1f, 0xc7, 0x71, 0x75, 0xeb, 0x99, 0x2e, 0x30, 0x23, 0xda, 0xc1, 0xfa, 0x4b, 0x8e, 0x64,
+	0xbb, 0xc2, 0xb8, 0xff, 0x9b, 0xf5, 0x75, 0xcd, 0xa7, 0x93, 0xe2, 0x98, 0x7d, 0x3d, 0x63, 0x77,
+	0x1d, 0x2d, 0x42, 0xb6, 0x78, 0x44, 0x84, 0xac, 0xe9, 0xd9, 0x53, 0x3a, 0xca, 0xb3, 0xc7, 0xfd,
+	0x12, 0x99, 0x6b, 0x7e, 0x6b, 0xf7, 0x39, 0x9e, 0xb8, 0xe0, 0xe9, 0x74, 0xc8, 0x67, 0x7a, 0x1e,
+	0x09, 0xb4, 0x9e, 0x92, 0xa4, 0x70, 0x44, 0x4a, 0x92, 0x67, 0xd0, 0x78, 0x14, 0xb6, 0x71, 0x35,
+	0x0a, 0xd2, 0xfd, 0x07, 0x02, 0x86, 0xdb, 0x20, 0xf0, 0xee, 0x2f, 0x5b, 0x68, 0x36, 0x9d, 0xf9,
+	0x2b, 0xf7, 0x38, 0x54, 0x3d, 0x2d, 0x6a, 0x71, 0xf8, 0xb4, 0xa8, 0xee, 0xdf, 0x2e, 0xa2, 0x8b,
+	0x5a, 0x16, 0x30, 0xad, 0xf6, 0xe9, 0xd1, 0x43, 0xe7, 0x55, 0x34, 0xb1, 0xe9, 0xc5, 0xb8, 0xed,
+	0x07, 0x62, 0x1b, 0xbb, 0x9d, 0x5b, 0x96, 0x32, 0xfa, 0x90, 0xea, 0x24, 0x5e, 0xe3, 0x72, 0x40,
+	0x4a, 0x24, 0x4a, 0x3a, 0x37, 0xea, 0x15, 0x4f, 0x45, 0xf6, 0xa0, 0x9b, 0xa1, 0x9b, 0xa8, 0xd2,
+	0xf4, 0x23, 0xdc, 0x90, 0xf9, 0xcb, 0x2b, 0xb5, 0x67, 0xe4, 0x95, 0xbb, 0x40, 0x90, 0xc8, 0x1a,
+	0x8d, 0xa3, 0x84, 0x83, 0x6a, 0xab, 0x2d, 0x7a, 0x65, 0xba, 0x80, 0x67, 0x95, 0x5f, 0xff, 0x83,
+	0x02, 0x9a, 0xeb, 0xcb, 0xdd, 0x66, 0x7f, 0xc1, 0x42, 0x93, 0xca, 0xc7, 0x5a, 0xd8, 0x5c, 0xea,
+	0xb9, 0xbd, 0x00, 0xcd, 0xb7, 0x5b, 0x6e, 0x94, 0x0a, 0x16, 0x83, 0x2e, 0x9c, 0x64, 0x77, 0xa1,
+	0xf9, 0x11, 0x88, 0x95, 0x1a, 0xaf, 0xe2, 0x5d, 0x2c, 0xd2, 0xe6, 0x9f, 0xe7, 0xd7, 0xdf, 0x3a,
+	0x0a, 0xd2, 0xb4, 0x66, 0x85, 0x87, 0xe2, 0xd9, 0x57, 0x78, 0x70, 0xff, 0xa8, 0x8c, 0x66, 0xd3,
+	0x1f, 0xff, 0x8d, 0x90, 0x98, 0x54, 0x24, 0xf0, 0x2c, 0x3c, 0x92, 0x04, 0x9e, 0xc5, 0x47, 0x97,
+	0xc0, 0xb3, 0x74, 0x86, 0x09, 0x3c, 0xf5, 0xe4, 0x96, 0xe5, 0x47, 0x94, 0xdc, 0x72, 0xec, 0xec,
+	0x92, 0x5b, 0xba, 0xeb, 0x08, 0x11, 0xf5, 0xb0, 0x16, 0x79, 0x41, 0x63, 0x9b, 0x66, 0x0f, 0xdb,
+	0xc6, 0x41, 0x7a, 0x89, 0xbf, 0xb7, 0x8d, 0x03, 0xa0, 0x18, 0x42, 0xd1, 0x0a, 0x37, 0xc2, 0x74,
+	0x02, 0x9c, 0x9b, 0xe1, 0x46, 0x08, 0x14, 0xe3, 0xfe, 0x09, 0x9d, 0x3e, 0xb8, 0x2b, 0xb2, 0xb1,
+	0x08, 0x6f, 0x1e, 0x55, 0x44, 0xbf, 0x3c, 0xa0, 0x88, 0xbe, 0xd8, 0x60, 0x0a, 0x03, 0x37, 0x98,
+	0x1b, 0xa8, 0x12, 0x76, 0xc5, 0xcd, 0x2b, 0xdb, 0xdd, 0x9e, 0x16, 0x73, 0xf9, 0x8e, 0x40, 0x3c,
+	0xdc, 0x9f, 0x3f, 0xaf, 0x3a, 0x20, 0xc1, 0xa0, 0x9a, 0xda, 0xef, 0x30, 0xe3, 0xc4, 0xae, 0xa5,
+	0xaf, 0x8c, 0xcf, 0xa9, 0xf6, 0x83, 0x6e, 0x8d, 0xcb, 0xc3, 0x54, 0x18, 0x18, 0xcb, 0xb1, 0xc2,
+	0xc0, 0x3d, 0x54, 0xe1, 0x4e, 0x2e, 0x27, 0xca, 0xac, 0x4f, 0x19, 0xdf, 0x15, 0x0c, 0x40, 0xf1,
+	0x4a, 0x95, 0x2e, 0x98, 0xc8, 0xb5, 0x74, 0xc1, 0xf3, 0x68, 0x9c, 0x78, 0xa0, 0x86, 0x5b, 0x5b,
+	0x4e, 0xc5, 0xa8, 0x3b, 0x38, 0x5e, 0x63, 0xe0, 0x0c, 0x9d, 0x44, 0xb4, 0x20, 0x27, 0x50, 0x2c,
+	0x32, 0x17, 0x08, 0xff, 0x1b, 0x79, 0x02, 0x95, 0x39, 0x0d, 0x62, 0xd0, 0xa8, 0x88, 0x63, 0x43,
+	0xd3, 0x8f, 0x89, 0xdf, 0x42, 0x93, 0xe7, 0x30, 0x94, 0xaa, 0xc3, 0x32, 0x87, 0x83, 0xa4, 0x20,
+	0xc9, 0x80, 0x78, 0x60, 0xeb, 0x94, 0x4a, 0x06, 0x24, 0x83, 0x5a, 0x8f, 0x48, 0x06, 0xc4, 0x5a,
+	0xba, 0x64, 0x0f, 0x26, 0xc3, 0x86, 0xe7, 0xd8, 0x30, 0xa2, 0xea, 0xac, 0x13, 0x44, 0xd5, 0x3d,
+	0x8f, 0xc6, 0xbc, 0x86, 0x16, 0x93, 0xf7, 0x94, 0x50, 0x3f, 0xaa, 0x42, 0xa1, 0x98, 0xd3, 0xc4,
+	0x31, 0x20, 0xf0, 0x26, 0xee, 0xbf, 0xb3, 0x90, 0x8e, 0xe5, 0xb3, 0x90, 0x44, 0x3a, 0x4a, 0xff,
+	0xa3, 0x54, 0x7e, 0x07, 0xe5, 0x7c, 0xa4, 0x68, 0x64, 0xbe, 0x28, 0x3a, 0x22, 0x46, 0xcd, 0x17,
+	0x95, 0x1e, 0xc8, 0x24, 0x8f, 0x98, 0xdf, 0xc1, 0xcd, 0x3b, 0x3d, 0x71, 0x16, 0x53, 0x3e, 0xe0,
+	0x1c, 0x0e, 0x92, 0xc2, 0x7d, 0x9d, 0x28, 0xcf, 0xd2, 0xee, 0xc5, 0x35, 0xfa, 0x67, 0xd0, 0x38,
+	0x0e, 0xd8, 0x47, 0xb6, 0xcc, 0x32, 0x04, 0xd7, 0x19, 0x18, 0x04, 0x9e, 0xf8, 0x92, 0x89, 0x57,
+	0x2b, 0x5c, 0x7f, 0x99, 0x56, 0x22, 0x7d, 0xc9, 0x96, 0x4d, 0x34, 0xa4, 0xe9, 0xdd, 0x5f, 0x48,
+	0x75, 0x21, 0xdc, 0xf1, 0xf1, 0xb1, 0x02, 0xbd, 0xa7, 0x3b, 0xde, 0x83, 0x6a, 0x0b, 0x9b, 0x72,
+	0xe7, 0x98, 0xd5, 0x59, 0x43, 0x80, 0x49, 0x77, 0x74, 0xc1, 0x4d, 0xf7, 0x35, 0x34, 0xc9, 0xc6,
+	0x0d, 0xb3, 0xe0, 0x11, 0xa3, 0xc8, 0x03, 0xaf, 0xd1, 0x97, 0x7f, 0xe4, 0x3a, 0x01, 0x02, 0xc3,
+	0x51, 0x9f, 0x69, 0x96, 0xd5, 0x30, 0x65, 0x4c, 0xe0, 0xb9, 0x0c, 0x39, 0x96, 0x30, 0x8b, 0x70,
+	0x0b, 0x3f, 0x70, 0x8a, 0x26, 0x33, 0x20, 0x40, 0x60, 0x38, 0xf7, 0x2d, 0x48, 0x16, 0x3f, 0x95,
+	0x09, 0x8c, 0xd2, 0x95, 0x94, 0x64, 0x02, 0x23, 0xf7, 0x65, 0x34, 0x21, 0x6a, 0xd5, 0x1d, 0x4d,
+	0x4d, 0xce, 0xf7, 0x71, 0xe0, 0xdf, 0x0a, 0xe3, 0x44, 0x14, 0xd8, 0x63, 0x21, 0x07, 0xb7, 0x57,
+	0x28, 0x0c, 0x24, 0x96, 0x5c, 0xc2, 0x4e, 0x6e, 0x6c, 0xac, 0x4a, 0xe7, 0x02, 0x40, 0x8f, 0xc5,
+	0xec, 0x1d, 0x56, 0xb7, 0x12, 0xac, 0xc7, 0xbb, 0xb1, 0x41, 0x7f, 0xf9, 0x60, 0x7f, 0xfe, 0xb1,
+	0x7a, 0x26, 0x05, 0x0c, 0x68, 0x69, 0xaf, 0xa0, 0xf3, 0x3a, 0x86, 0x57, 0x31, 0xe0, 0x86, 0x87,
+	0x4b, 0x24, 0x0e, 0xb3, 0xde, 0x8f, 0x86, 0xac, 0x36, 0x69, 0x56, 0xdc, 0x86, 0xe6, 0x14, 0xb3,
+	0x59, 0x71, 0x34, 0x64, 0xb5, 0x71, 0xbf, 0x66, 0xa1, 0xb9, 0xbe, 0x78, 0xa8, 0x63, 0x8c, 0x49,
+	0xb2, 0x1f, 0x77, 0x54, 0xa1, 0x1c, 0xb5, 0x1f, 0x13, 0x20, 0x30, 0x9c, 0xfd, 0x2e, 0x62, 0x07,
+	0xda, 0xe5, 0x36, 0xd3, 0xcb, 0x59, 0x41, 0x1c, 0xd7, 0x83, 0xdd, 0x97, 0xbd, 0x48, 0xb7, 0x11,
+	0xed, 0x12, 0x1b, 0xd1, 0xae, 0xfb, 0x36, 0x74, 0x2e, 0x15, 0x20, 0x76, 0x74, 0xa7, 0xdc, 0xdf,
+	0x2a, 0xa2, 0x29, 0xe3, 0x0a, 0xf9, 0xe8, 0xe7, 0x38, 0xbe, 0x0d, 0x28, 0x23, 0x8e, 0xa3, 0x38,
+	0x64, 0x1c, 0x87, 0x1e, 0x38, 0x53, 0x3a, 0xdd, 0xc0, 0x99, 0x72, 0x3e, 0x81, 0x33, 0x5a, 0xd0,
+	0xdf, 0xd8, 0x99, 0x05, 0xfd, 0xb9, 0xdf, 0x2f, 0xa3, 0x19, 0xb3, 0x32, 0xf8, 0x31, 0xbe, 0xe4,
+	0x5b, 0xfa, 0xbe, 0xe4, 0x90, 0x9e, 0xc1, 0xc5, 0x51, 0x3d, 0x83, 0x4b, 0xa3, 0x7a, 0x06, 0x97,
+	0x4f, 0xe0, 0x19, 0xdc, 0xef, 0xd7, 0x3b, 0x76, 0x6c, 0xbf, 0xde, 0xf7, 0x48, 0xad, 0x65, 0xdc,
+	0x88, 0x9f, 0x55, 0x9a, 0x8b, 0x6d, 0x7e, 0x06, 0x52, 0xe3, 0x38, 0x2b, 0x8d, 0xc8, 0xc4, 0x11,
+	0xba, 0x6c, 0x94, 0x99, 0x3d, 0x63, 0xf8, 0xd8, 0x94, 0xc7, 0x86, 0xc8, 0x9c, 0xf1, 0x76, 0x34,
+	0xc9, 0xc7, 0x13, 0xb5, 0xb4, 0x23, 0xd3, 0x4a, 0x5f, 0x57, 0x28, 0xd0, 0xe9, 0xc8, 0xc0, 0xe8,
+	0xaa, 0x09, 0x42, 0x7d, 0xd4, 0x27, 0x4d, 0x1f, 0xf5, 0x75, 0x13, 0x0d, 0x69, 0x7a, 0x7b, 0x9e,
+	0x9a, 0xe4, 0x23, 0xcc, 0x3d, 0xac, 0x2b, 0xdc, 0x1c, 0x1f, 0x31, 0x73, 0x7c, 0x84, 0xdd, 0x8f,
+	0xa3, 0x8b, 0x99, 0xd7, 0xd8, 0xd4, 0x53, 0x94, 0x9a, 0x69, 0x70, 0x93, 0x13, 0x68, 0xfd, 0x4c,
+	0x29, 0x81, 0x97, 0xef, 0x0d, 0xa4, 0x84, 0x43, 0xb8, 0xb8, 0xdf, 0xb4, 0xd0, 0x85, 0x2c, 0xcf,
+	0x3c, 0xa2, 0xdc, 0xa9, 0xb3, 0x51, 0xaa, 0x62, 0x7a, 0xe6, 0x21, 0x28, 0x8f, 0x5c, 0xa6, 0xd7,
+	0x50, 0xa9, 0xe9, 0x6f, 0x6d, 0x39, 0x25, 0x93, 0x62, 0xd9, 0xdf, 0xda, 0x02, 0x8a, 0x71, 0xff,
+	0x21, 0xd9, 0xa1, 0xd2, 0x57, 0x9a, 0xd4, 0x83, 0x9b, 0xea, 0x4f, 0xf9, 0x98, 0x52, 0xd2, 0x5a,
+	0x19, 0x77, 0xc1, 0xa3, 0xff, 0x03, 0x97, 0x44, 0x14, 0x1f, 0x76, 0x1f, 0x97, 0x56, 0x7c, 0xb8,
+	0x3d, 0x9d, 0x63, 0xdd, 0x5f, 0x2f, 0xa2, 0x19, 0xc3, 0xe6, 0x4f, 0x8a, 0xe9, 0x0a, 0xd3, 0x62,
+	0x2e, 0xae, 0x8a, 0x8c, 0xad, 0x56, 0x30, 0x79, 0xa0, 0x6d, 0xf1, 0x3e, 0x9d, 0xe2, 0x9b, 0xb2,
+	0x7a, 0xf3, 0xe9, 0x09, 0xe6, 0xee, 0xde, 0x5c, 0x1c, 0x0d, 0xdc, 0x56, 0x39, 0x9c, 0xb9, 0x0e,
+	0x90, 0xbb, 0x74, 0x95, 0x6e, 0x57, 0x8a, 0x02, 0x4d, 0x2c, 0xd9, 0xde, 0x77, 0x71, 0xe4, 0xd3,
+	0xcc, 0x2c, 0x25, 0xaa, 0xe0, 0xd3, 0xcd, 0xf3, 0x65, 0x0e, 0x03, 0x89, 0x75, 0x5f, 0x2f, 0xa0,
+	0x0a, 0x3d, 0x8f, 0xdd, 0x88, 0xc2, 0x0e, 0xb9, 0xf2, 0x9d, 0x8a, 0xb5, 0x3b, 0x2a, 0xfe, 0xd9,
+	0x46, 0x74, 0x1c, 0xd1, 0x6f, 0xbd, 0x78, 0x76, 0x28, 0x0d, 0x02, 0x86, 0x44, 0xbb, 0x8b, 0x26,
+	0xb6, 0x7c, 0xdc, 0x6e, 0x8a, 0xf4, 0x06, 0x23, 0xbb, 0xc0, 0xdd, 0xe0, 0xdc, 0xd8, 0x2b, 0x10,
+	0xbf, 0x40, 0x4a, 0x71, 0x3d, 0x74, 0x2e, 0x65, 0x5f, 0xcb, 0xfb, 0x72, 0xc1, 0xfd, 0x9f, 0x25,
+	0x54, 0x91, 0x49, 0x1b, 0xed, 0x77, 0x19, 0x0e, 0x03, 0xea, 0x4c, 0xcf, 0x6f, 0xfa, 0x89, 0x1d,
+	0x45, 0x12, 0xa7, 0x2e, 0xff, 0xaf, 0xa0, 0x62, 0x2f, 0x6a, 0xa7, 0x6f, 0x04, 0x49, 0xe6, 0x68,
+	0x02, 0xd7, 0x13, 0x4d, 0x16, 0xcf, 0x36, 0xd1, 0xe4, 0x35, 0x54, 0xda, 0x0c, 0x9b, 0x7b, 0xe9,
+	0x05, 0xad, 0x16, 0x36, 0xf7, 0x80, 0x62, 0x32, 0x72, 0xab, 0x96, 0x87, 0xad, 0xd5, 0x49, 0x2c,
+	0x08, 0xe4, 0x14, 0xc7, 0xc3, 0xa7, 0xa4, 0xa2, 0xf3, 0x62, 0xfd, 0xce, 0x6d, 0x02, 0x07, 0x49,
+	0x31, 0x5c, 0x65, 0x4f, 0xfb, 0x16, 0xe3, 0x4d, 0x7a, 0x4b, 0x37, 0xf5, 0xa9, 0xda, 0x5b, 0x04,
+	0x5f, 0x02, 0x3b, 0xd2, 0x96, 0x21, 0x5b, 0x67, 0xa5, 0x33, 0xad, 0x3c, 0xba, 0x74, 0xa6, 0xee,
+	0x5d, 0x74, 0x2e, 0xf5, 0x0d, 0xc5, 0xa5, 0xb2, 0x95, 0x7d, 0xa9, 0xac, 0x8a, 0x65, 0x16, 0x06,
+	0x17, 0xcb, 0x74, 0xff, 0xb1, 0x85, 0xe6, 0xfa, 0x56, 0xa5, 0xe3, 0x26, 0xfb, 0x4d, 0xab, 0x28,
+	0x85, 0x93, 0xab, 0x28, 0xc5, 0xe1, 0x54, 0x94, 0xda, 0xe6, 0xb7, 0x7f, 0x70, 0xf5, 0x4d, 0xdf,
+	0xfd, 0xc1, 0xd5, 0x37, 0xfd, 0xee, 0x0f, 0xae, 0xbe, 0xe9, 0xf5, 0x83, 0xab, 0xd6, 0xb7, 0x0f,
+	0xae, 0x5a, 0xdf, 0x3d, 0xb8, 0x6a, 0xfd, 0xee, 0xc1, 0x55, 0xeb, 0x0f, 0x0e, 0xae, 0x5a, 0x5f,
+	0xfe, 0xc3, 0xab, 0x6f, 0x7a, 0xff, 0x7b, 0xd4, 0x97, 0x5a, 0x14, 0x5f, 0x8a, 0xfe, 0xf3, 0x53,
+	0xe2, 0xbb, 0x2c, 0x76, 0x77, 0x5a, 0x24, 0x57, 0x5b, 0xbc, 0x28, 0x21, 0xe2, 0x4b, 0xfd, 0xdf,
+	0x01, 0x00, 0x82, 0x47, 0xcd, 0x2e, 0x2b, 0xe1, 0x00, 0x00,
 }
 
 func (m *ALBStatus) Marshal() (dAtA []byte, err error) {
@@ -5278,6 +6465,23 @@ func (m *BlueGreenStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weights != nil {
+		{
+			size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TrafficWeightIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TrafficWeightIndex))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.PostPromotionAnalysisRunStatus != nil {
 		{
 			size, err := m.PostPromotionAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -5343,6 +6547,32 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PauseTimeout != nil {
+		{
+			size, err := m.PauseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.TrafficRouting != nil {
+		{
+			size, err := m.TrafficRouting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.AbortScaleDownDelaySeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.AbortScaleDownDelaySeconds))
 		i--
@@ -5461,6 +6691,94 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlueGreenTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlueGreenTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlueGreenTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Weights[iNdEx]))
+			i--
+			dAtA[i] = 0x10
+		}
+	}
+	{
+		size, err := m.RolloutTrafficRouting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRateMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRateMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRateMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SlowBurnMultiplier)
+	copy(dAtA[i:], m.SlowBurnMultiplier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SlowBurnMultiplier)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.FastBurnMultiplier)
+	copy(dAtA[i:], m.FastBurnMultiplier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FastBurnMultiplier)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.LongWindow)
+	copy(dAtA[i:], m.LongWindow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LongWindow)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ShortWindow)
+	copy(dAtA[i:], m.ShortWindow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ShortWindow)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Objective)
+	copy(dAtA[i:], m.Objective)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Objective)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CanaryStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5481,6 +6799,59 @@ func (m *CanaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SkippedSteps) > 0 {
+		for iNdEx := len(m.SkippedSteps) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.SkippedSteps[iNdEx]))
+			i--
+			dAtA[i] = 0x60
+		}
+	}
+	if m.EvaluatedStepIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.EvaluatedStepIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CurrentStepTimeout != nil {
+		{
+			size, err := m.CurrentStepTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.RollbackPodHash)
+	copy(dAtA[i:], m.RollbackPodHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RollbackPodHash)))
+	i--
+	dAtA[i] = 0x4a
+	if m.BakeStartedAt != nil {
+		{
+			size, err := m.BakeStartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PostPromotionAnalysisRunStatus != nil {
+		{
+			size, err := m.PostPromotionAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.StepPluginStatuses) > 0 {
 		for iNdEx := len(m.StepPluginStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5564,6 +6935,54 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x6a
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Shadow != nil {
+		{
+			size, err := m.Shadow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x52
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -5676,6 +7095,27 @@ func (m *CanaryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BakeSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BakeSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PostPromotionAnalysis != nil {
+		{
+			size, err := m.PostPromotionAnalysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.MinPodsPerReplicaSet != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinPodsPerReplicaSet))
 		i--
@@ -6172,6 +7612,204 @@ func (m *ClusterAnalysisTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterRolloutStrategyTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRolloutStrategyTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRolloutStrategyTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterRolloutStrategyTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRolloutStrategyTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRolloutStrategyTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterWave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterWave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ComparisonAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComparisonAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComparisonAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.AnalysisName)
+	copy(dAtA[i:], m.AnalysisName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AnalysisName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ComparisonMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComparisonMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComparisonMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Goal)
+	copy(dAtA[i:], m.Goal)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Goal)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6244,6 +7882,49 @@ func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeployWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeployWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeployWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DryRun) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6272,6 +7953,39 @@ func (m *DryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ElasticsearchQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElasticsearchQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElasticsearchQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Index)
+	copy(dAtA[i:], m.Index)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Index)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Experiment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6431,6 +8145,57 @@ func (m *ExperimentAnalysisTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ExperimentComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExperimentComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExperimentComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Analyses) > 0 {
+		for iNdEx := len(m.Analyses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Analyses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExperimentCondition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6561,6 +8326,18 @@ func (m *ExperimentSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Comparison != nil {
+		{
+			size, err := m.Comparison.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -6673,6 +8450,11 @@ func (m *ExperimentStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Winner)
+	copy(dAtA[i:], m.Winner)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Winner)))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.AnalysisRuns) > 0 {
 		for iNdEx := len(m.AnalysisRuns) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6768,6 +8550,81 @@ func (m *FieldRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPCProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayAPITrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAPITrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAPITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TCPRoute)
+	copy(dAtA[i:], m.TCPRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TCPRoute)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.GRPCRoute)
+	copy(dAtA[i:], m.GRPCRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GRPCRoute)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.HTTPRoutes) > 0 {
+		for iNdEx := len(m.HTTPRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HTTPRoutes[iNdEx])
+			copy(dAtA[i:], m.HTTPRoutes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.HTTPRoute)
+	copy(dAtA[i:], m.HTTPRoute)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPRoute)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GraphiteMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6801,6 +8658,73 @@ func (m *GraphiteMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if len(m.SuccessStatusCodes) > 0 {
+		for iNdEx := len(m.SuccessStatusCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.SuccessStatusCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x28
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Scheme)
+	copy(dAtA[i:], m.Scheme)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scheme)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HeaderRoutingMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7235,6 +9159,126 @@ func (m *KayentaThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x30
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Elasticsearch != nil {
+		{
+			size, err := m.Elasticsearch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Loki != nil {
+		{
+			size, err := m.Loki.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LokiQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LokiQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LokiQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MangedRoutes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7392,6 +9436,62 @@ func (m *MeasurementRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Aborted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.CurrentPodHash)
+	copy(dAtA[i:], m.CurrentPodHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentPodHash)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Wave)
+	copy(dAtA[i:], m.Wave)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Wave)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Cluster)
+	copy(dAtA[i:], m.Cluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cluster)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Metric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7412,6 +9512,18 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnRate != nil {
+		{
+			size, err := m.BurnRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7518,6 +9630,42 @@ func (m *MetricProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Probe != nil {
+		{
+			size, err := m.Probe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Statistical != nil {
+		{
+			size, err := m.Statistical.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Plugin) > 0 {
 		keysForPlugin := make([]string, 0, len(m.Plugin))
 		for k := range m.Plugin {
@@ -7781,6 +9929,90 @@ func (m *MetricResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiClusterPromotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiClusterPromotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiClusterPromotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Wave)
+	copy(dAtA[i:], m.Wave)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Wave)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Cluster)
+	copy(dAtA[i:], m.Cluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cluster)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NewRelicMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8202,6 +10434,69 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
+func (m *ProbeMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x20
+	if m.Requests != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Requests))
+		i--
+		dAtA[i] = 0x18
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PrometheusMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8652,6 +10947,100 @@ func (m *RolloutCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutDependencyStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutDependencyStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutDependencyStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Satisfied {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutExperimentStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8672,6 +11061,26 @@ func (m *RolloutExperimentStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.PromoteWinner {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.Comparison != nil {
+		{
+			size, err := m.Comparison.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8815,6 +11224,20 @@ func (m *RolloutExperimentTemplate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Service != nil {
 		{
 			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
@@ -8954,6 +11377,68 @@ func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutShadowStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutShadowStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutShadowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.ProxyService)
+	copy(dAtA[i:], m.ProxyService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProxyService)))
+	i--
+	dAtA[i] = 0x22
+	if m.Percentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Percentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Match) > 0 {
+		for iNdEx := len(m.Match) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Match[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8974,6 +11459,62 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrategyRef != nil {
+		{
+			size, err := m.StrategyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DeployWindows) > 0 {
+		for iNdEx := len(m.DeployWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeployWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MultiCluster != nil {
+		{
+			size, err := m.MultiCluster.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.RollbackWindow != nil {
 		{
 			size, err := m.RollbackWindow.MarshalToSizedBuffer(dAtA[:i])
@@ -9111,6 +11652,62 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TrafficRoutingDryRun) > 0 {
+		for iNdEx := len(m.TrafficRoutingDryRun) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrafficRoutingDryRun[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	i--
+	if m.IgnoreDeployWindow {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe0
+	if m.MultiCluster != nil {
+		{
+			size, err := m.MultiCluster.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
 	if len(m.ALBs) > 0 {
 		for iNdEx := len(m.ALBs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9370,6 +11967,193 @@ func (m *RolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutStrategyRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9390,6 +12174,38 @@ func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Stickiness != nil {
+		{
+			size, err := m.Stickiness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x68
+	if m.GatewayAPI != nil {
+		{
+			size, err := m.GatewayAPI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.MaxTrafficWeight != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxTrafficWeight))
 		i--
@@ -9868,6 +12684,11 @@ func (m *SetMirrorRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Match) > 0 {
 		for iNdEx := len(m.Match) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9966,6 +12787,244 @@ func (m *SkyWalkingMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatisticalComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatisticalComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatisticalComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Weight))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.Direction)
+	copy(dAtA[i:], m.Direction)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Direction)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Baseline.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StatisticalMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatisticalMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatisticalMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ConfidenceLevel != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ConfidenceLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Comparisons) > 0 {
+		for iNdEx := len(m.Comparisons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Comparisons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatisticalQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatisticalQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatisticalQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Influxdb != nil {
+		{
+			size, err := m.Influxdb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Graphite != nil {
+		{
+			size, err := m.Graphite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewRelic != nil {
+		{
+			size, err := m.NewRelic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Wavefront != nil {
+		{
+			size, err := m.Wavefront.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Datadog != nil {
+		{
+			size, err := m.Datadog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Prometheus != nil {
+		{
+			size, err := m.Prometheus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StepBranch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepBranch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepBranch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.GoTo)
+	copy(dAtA[i:], m.GoTo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GoTo)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StepPluginStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10071,6 +13130,83 @@ func (m *StepPluginStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StepTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StepTimeoutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepTimeoutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepTimeoutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.TimedOut {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StepIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *StickinessConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10105,6 +13241,44 @@ func (m *StickinessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StickinessCookie) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StickinessCookie) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StickinessCookie) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxAgeSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxAgeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StringMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10242,6 +13416,53 @@ func (m *TTLStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TemplateContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TemplateService) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10360,6 +13581,11 @@ func (m *TemplateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Score != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Score))
+		i--
+		dAtA[i] = 0x60
+	}
 	i -= len(m.PodTemplateHash)
 	copy(dAtA[i:], m.PodTemplateHash)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
@@ -10445,6 +13671,89 @@ func (m *TraefikTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrafficRoutingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficRoutingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficRoutingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Diff)
+	copy(dAtA[i:], m.Diff)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TrafficStickiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficStickiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficStickiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x12
+	if m.Cookie != nil {
+		{
+			size, err := m.Cookie.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TrafficWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11248,6 +14557,13 @@ func (m *BlueGreenStatus) Size() (n int) {
 		l = m.PostPromotionAnalysisRunStatus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TrafficWeightIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.TrafficWeightIndex))
+	}
+	if m.Weights != nil {
+		l = m.Weights.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11301,6 +14617,49 @@ func (m *BlueGreenStrategy) Size() (n int) {
 	if m.AbortScaleDownDelaySeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.AbortScaleDownDelaySeconds))
 	}
+	if m.TrafficRouting != nil {
+		l = m.TrafficRouting.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PauseTimeout != nil {
+		l = m.PauseTimeout.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *BlueGreenTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RolloutTrafficRouting.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *BurnRateMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Objective)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ShortWindow)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LongWindow)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FastBurnMultiplier)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SlowBurnMultiplier)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11332,6 +14691,28 @@ func (m *CanaryStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.PostPromotionAnalysisRunStatus != nil {
+		l = m.PostPromotionAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BakeStartedAt != nil {
+		l = m.BakeStartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RollbackPodHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CurrentStepTimeout != nil {
+		l = m.CurrentStepTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.EvaluatedStepIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.EvaluatedStepIndex))
+	}
+	if len(m.SkippedSteps) > 0 {
+		for _, e := range m.SkippedSteps {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
 	return n
 }
 
@@ -11372,6 +14753,24 @@ func (m *CanaryStep) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Shadow != nil {
+		l = m.Shadow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11436,6 +14835,13 @@ func (m *CanaryStrategy) Size() (n int) {
 	if m.MinPodsPerReplicaSet != nil {
 		n += 2 + sovGenerated(uint64(*m.MinPodsPerReplicaSet))
 	}
+	if m.PostPromotionAnalysis != nil {
+		l = m.PostPromotionAnalysis.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.BakeSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.BakeSeconds))
+	}
 	return n
 }
 
@@ -11567,6 +14973,82 @@ func (m *ClusterAnalysisTemplateList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterRolloutStrategyTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterRolloutStrategyTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterWave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ComparisonAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AnalysisName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ComparisonMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Goal)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Weight != nil {
+		n += 1 + sovGenerated(uint64(*m.Weight))
+	}
+	return n
+}
+
 func (m *DatadogMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11594,6 +15076,23 @@ func (m *DatadogMetric) Size() (n int) {
 	return n
 }
 
+func (m *DeployWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *DryRun) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11605,6 +15104,19 @@ func (m *DryRun) Size() (n int) {
 	return n
 }
 
+func (m *ElasticsearchQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Experiment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11658,6 +15170,27 @@ func (m *ExperimentAnalysisTemplateRef) Size() (n int) {
 	return n
 }
 
+func (m *ExperimentComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Analyses) > 0 {
+		for _, e := range m.Analyses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ExperimentCondition) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11737,6 +15270,10 @@ func (m *ExperimentSpec) Size() (n int) {
 	}
 	l = m.AnalysisRunMetadata.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Comparison != nil {
+		l = m.Comparison.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11772,6 +15309,8 @@ func (m *ExperimentStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Winner)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11786,6 +15325,38 @@ func (m *FieldRef) Size() (n int) {
 	return n
 }
 
+func (m *GRPCProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GatewayAPITrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HTTPRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.HTTPRoutes) > 0 {
+		for _, s := range m.HTTPRoutes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.GRPCRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TCPRoute)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GraphiteMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11799,6 +15370,33 @@ func (m *GraphiteMetric) Size() (n int) {
 	return n
 }
 
+func (m *HTTPProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SuccessStatusCodes) > 0 {
+		for _, e := range m.SuccessStatusCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	n += 2
+	return n
+}
+
 func (m *HeaderRoutingMatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11962,6 +15560,48 @@ func (m *KayentaThreshold) Size() (n int) {
 	return n
 }
 
+func (m *LogMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Loki != nil {
+		l = m.Loki.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Elasticsearch != nil {
+		l = m.Elasticsearch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Authentication.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	n += 2
+	return n
+}
+
+func (m *LokiQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *MangedRoutes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12020,6 +15660,26 @@ func (m *MeasurementRetention) Size() (n int) {
 	return n
 }
 
+func (m *MemberClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cluster)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Wave)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CurrentPodHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Metric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12054,6 +15714,10 @@ func (m *Metric) Size() (n int) {
 	}
 	l = m.Provider.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.BurnRate != nil {
+		l = m.BurnRate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12119,6 +15783,18 @@ func (m *MetricProvider) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Statistical != nil {
+		l = m.Statistical.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Probe != nil {
+		l = m.Probe.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12158,6 +15834,40 @@ func (m *MetricResult) Size() (n int) {
 	return n
 }
 
+func (m *MultiClusterPromotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cluster)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Wave)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *NewRelicMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12323,6 +16033,30 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	return n
 }
 
+func (m *ProbeMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Port))
+	if m.Requests != nil {
+		n += 1 + sovGenerated(uint64(*m.Requests))
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *PrometheusMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12486,6 +16220,41 @@ func (m *RolloutCondition) Size() (n int) {
 	return n
 }
 
+func (m *RolloutDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutDependencyStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutExperimentStep) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12514,6 +16283,11 @@ func (m *RolloutExperimentStep) Size() (n int) {
 	}
 	l = m.AnalysisRunMetadata.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Comparison != nil {
+		l = m.Comparison.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -12564,6 +16338,12 @@ func (m *RolloutExperimentTemplate) Size() (n int) {
 		l = m.Service.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12597,6 +16377,30 @@ func (m *RolloutPause) Size() (n int) {
 	return n
 }
 
+func (m *RolloutShadowStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Match) > 0 {
+		for _, e := range m.Match {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Percentage != nil {
+		n += 1 + sovGenerated(uint64(*m.Percentage))
+	}
+	l = len(m.ProxyService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Analysis.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12639,6 +16443,26 @@ func (m *RolloutSpec) Size() (n int) {
 		l = m.RollbackWindow.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.MultiCluster != nil {
+		l = m.MultiCluster.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DeployWindows) > 0 {
+		for _, e := range m.DeployWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StrategyRef != nil {
+		l = m.StrategyRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12712,6 +16536,23 @@ func (m *RolloutStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.MultiCluster != nil {
+		l = m.MultiCluster.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.TrafficRoutingDryRun) > 0 {
+		for _, e := range m.TrafficRoutingDryRun {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12732,6 +16573,71 @@ func (m *RolloutStrategy) Size() (n int) {
 	return n
 }
 
+func (m *RolloutStrategyRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutStrategyTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutStrategyTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutStrategyTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Strategy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12791,6 +16697,15 @@ func (m *RolloutTrafficRouting) Size() (n int) {
 	if m.MaxTrafficWeight != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxTrafficWeight))
 	}
+	if m.GatewayAPI != nil {
+		l = m.GatewayAPI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.Stickiness != nil {
+		l = m.Stickiness.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12925,6 +16840,8 @@ func (m *SetMirrorRoute) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
 	if m.Percentage != nil {
 		n += 1 + sovGenerated(uint64(*m.Percentage))
 	}
@@ -12961,6 +16878,92 @@ func (m *SkyWalkingMetric) Size() (n int) {
 	return n
 }
 
+func (m *StatisticalComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Baseline.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Canary.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Direction)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Weight != nil {
+		n += 1 + sovGenerated(uint64(*m.Weight))
+	}
+	return n
+}
+
+func (m *StatisticalMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Comparisons) > 0 {
+		for _, e := range m.Comparisons {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ConfidenceLevel != nil {
+		n += 1 + sovGenerated(uint64(*m.ConfidenceLevel))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StatisticalQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Datadog != nil {
+		l = m.Datadog.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Wavefront != nil {
+		l = m.Wavefront.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NewRelic != nil {
+		l = m.NewRelic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Graphite != nil {
+		l = m.Graphite.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Influxdb != nil {
+		l = m.Influxdb.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *StepBranch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GoTo)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *StepPluginStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12999,6 +17002,32 @@ func (m *StepPluginStatus) Size() (n int) {
 	return n
 }
 
+func (m *StepTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StepTimeoutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.StepIndex))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *StickinessConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13010,6 +17039,22 @@ func (m *StickinessConfig) Size() (n int) {
 	return n
 }
 
+func (m *StickinessCookie) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxAgeSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxAgeSeconds))
+	}
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *StringMatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13069,6 +17114,25 @@ func (m *TTLStrategy) Size() (n int) {
 	return n
 }
 
+func (m *TemplateContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TemplateService) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13132,6 +17196,9 @@ func (m *TemplateStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.PodTemplateHash)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Score != nil {
+		n += 1 + sovGenerated(uint64(*m.Score))
+	}
 	return n
 }
 
@@ -13146,6 +17213,38 @@ func (m *TraefikTrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *TrafficRoutingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Diff)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TrafficStickiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cookie != nil {
+		l = m.Cookie.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *TrafficWeights) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13646,6 +17745,8 @@ func (this *BlueGreenStatus) String() string {
 		`ScaleUpPreviewCheckPoint:` + fmt.Sprintf("%v", this.ScaleUpPreviewCheckPoint) + `,`,
 		`PrePromotionAnalysisRunStatus:` + strings.Replace(this.PrePromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
 		`PostPromotionAnalysisRunStatus:` + strings.Replace(this.PostPromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`TrafficWeightIndex:` + valueToStringGenerated(this.TrafficWeightIndex) + `,`,
+		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13669,6 +17770,33 @@ func (this *BlueGreenStrategy) String() string {
 		`PreviewMetadata:` + strings.Replace(this.PreviewMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`ActiveMetadata:` + strings.Replace(this.ActiveMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
+		`TrafficRouting:` + strings.Replace(this.TrafficRouting.String(), "BlueGreenTrafficRouting", "BlueGreenTrafficRouting", 1) + `,`,
+		`PauseTimeout:` + strings.Replace(this.PauseTimeout.String(), "StepTimeout", "StepTimeout", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlueGreenTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlueGreenTrafficRouting{`,
+		`RolloutTrafficRouting:` + strings.Replace(strings.Replace(this.RolloutTrafficRouting.String(), "RolloutTrafficRouting", "RolloutTrafficRouting", 1), `&`, ``, 1) + `,`,
+		`Weights:` + fmt.Sprintf("%v", this.Weights) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BurnRateMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BurnRateMetric{`,
+		`Objective:` + fmt.Sprintf("%v", this.Objective) + `,`,
+		`ShortWindow:` + fmt.Sprintf("%v", this.ShortWindow) + `,`,
+		`LongWindow:` + fmt.Sprintf("%v", this.LongWindow) + `,`,
+		`FastBurnMultiplier:` + fmt.Sprintf("%v", this.FastBurnMultiplier) + `,`,
+		`SlowBurnMultiplier:` + fmt.Sprintf("%v", this.SlowBurnMultiplier) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13689,6 +17817,12 @@ func (this *CanaryStatus) String() string {
 		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`StablePingPong:` + fmt.Sprintf("%v", this.StablePingPong) + `,`,
 		`StepPluginStatuses:` + repeatedStringForStepPluginStatuses + `,`,
+		`PostPromotionAnalysisRunStatus:` + strings.Replace(this.PostPromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`BakeStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.BakeStartedAt), "Time", "v1.Time", 1) + `,`,
+		`RollbackPodHash:` + fmt.Sprintf("%v", this.RollbackPodHash) + `,`,
+		`CurrentStepTimeout:` + strings.Replace(this.CurrentStepTimeout.String(), "StepTimeoutStatus", "StepTimeoutStatus", 1) + `,`,
+		`EvaluatedStepIndex:` + valueToStringGenerated(this.EvaluatedStepIndex) + `,`,
+		`SkippedSteps:` + fmt.Sprintf("%v", this.SkippedSteps) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13697,6 +17831,11 @@ func (this *CanaryStep) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForBranches := "[]StepBranch{"
+	for _, f := range this.Branches {
+		repeatedStringForBranches += strings.Replace(strings.Replace(f.String(), "StepBranch", "StepBranch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBranches += "}"
 	s := strings.Join([]string{`&CanaryStep{`,
 		`SetWeight:` + valueToStringGenerated(this.SetWeight) + `,`,
 		`Pause:` + strings.Replace(this.Pause.String(), "RolloutPause", "RolloutPause", 1) + `,`,
@@ -13706,6 +17845,11 @@ func (this *CanaryStep) String() string {
 		`SetHeaderRoute:` + strings.Replace(this.SetHeaderRoute.String(), "SetHeaderRoute", "SetHeaderRoute", 1) + `,`,
 		`SetMirrorRoute:` + strings.Replace(this.SetMirrorRoute.String(), "SetMirrorRoute", "SetMirrorRoute", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginStep", "PluginStep", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Shadow:` + strings.Replace(this.Shadow.String(), "RolloutShadowStep", "RolloutShadowStep", 1) + `,`,
+		`Timeout:` + strings.Replace(this.Timeout.String(), "StepTimeout", "StepTimeout", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Branches:` + repeatedStringForBranches + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13736,6 +17880,8 @@ func (this *CanaryStrategy) String() string {
 		`DynamicStableScale:` + fmt.Sprintf("%v", this.DynamicStableScale) + `,`,
 		`PingPong:` + strings.Replace(this.PingPong.String(), "PingPongSpec", "PingPongSpec", 1) + `,`,
 		`MinPodsPerReplicaSet:` + valueToStringGenerated(this.MinPodsPerReplicaSet) + `,`,
+		`PostPromotionAnalysis:` + strings.Replace(this.PostPromotionAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`BakeSeconds:` + valueToStringGenerated(this.BakeSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13839,6 +17985,67 @@ func (this *ClusterAnalysisTemplateList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterRolloutStrategyTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterRolloutStrategyTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutStrategyTemplateSpec", "RolloutStrategyTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutStrategyTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterRolloutStrategyTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterRolloutStrategyTemplate", "ClusterRolloutStrategyTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterRolloutStrategyTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterWave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterWave{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComparisonAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ComparisonAnalysis{`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`AnalysisName:` + fmt.Sprintf("%v", this.AnalysisName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComparisonMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ComparisonMetric{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Goal:` + fmt.Sprintf("%v", this.Goal) + `,`,
+		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DatadogMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -13864,6 +18071,19 @@ func (this *DatadogMetric) String() string {
 	}, "")
 	return s
 }
+func (this *DeployWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeployWindow{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DryRun) String() string {
 	if this == nil {
 		return "nil"
@@ -13874,6 +18094,17 @@ func (this *DryRun) String() string {
 	}, "")
 	return s
 }
+func (this *ElasticsearchQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ElasticsearchQuery{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Experiment) String() string {
 	if this == nil {
 		return "nil"
@@ -13918,6 +18149,27 @@ func (this *ExperimentAnalysisTemplateRef) String() string {
 	}, "")
 	return s
 }
+func (this *ExperimentComparison) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAnalyses := "[]ComparisonAnalysis{"
+	for _, f := range this.Analyses {
+		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "ComparisonAnalysis", "ComparisonAnalysis", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalyses += "}"
+	repeatedStringForMetrics := "[]ComparisonMetric{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "ComparisonMetric", "ComparisonMetric", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	s := strings.Join([]string{`&ExperimentComparison{`,
+		`Analyses:` + repeatedStringForAnalyses + `,`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExperimentCondition) String() string {
 	if this == nil {
 		return "nil"
@@ -13983,6 +18235,7 @@ func (this *ExperimentSpec) String() string {
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
 		`AnalysisRunMetadata:` + strings.Replace(strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1), `&`, ``, 1) + `,`,
+		`Comparison:` + strings.Replace(this.Comparison.String(), "ExperimentComparison", "ExperimentComparison", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14013,6 +18266,7 @@ func (this *ExperimentStatus) String() string {
 		`AvailableAt:` + strings.Replace(fmt.Sprintf("%v", this.AvailableAt), "Time", "v1.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`AnalysisRuns:` + repeatedStringForAnalysisRuns + `,`,
+		`Winner:` + fmt.Sprintf("%v", this.Winner) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14027,6 +18281,29 @@ func (this *FieldRef) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCProbe{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAPITrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAPITrafficRouting{`,
+		`HTTPRoute:` + fmt.Sprintf("%v", this.HTTPRoute) + `,`,
+		`HTTPRoutes:` + fmt.Sprintf("%v", this.HTTPRoutes) + `,`,
+		`GRPCRoute:` + fmt.Sprintf("%v", this.GRPCRoute) + `,`,
+		`TCPRoute:` + fmt.Sprintf("%v", this.TCPRoute) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GraphiteMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14038,6 +18315,26 @@ func (this *GraphiteMetric) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPProbe{`,
+		`Scheme:` + fmt.Sprintf("%v", this.Scheme) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`SuccessStatusCodes:` + fmt.Sprintf("%v", this.SuccessStatusCodes) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HeaderRoutingMatch) String() string {
 	if this == nil {
 		return "nil"
@@ -14168,6 +18465,38 @@ func (this *KayentaThreshold) String() string {
 	}, "")
 	return s
 }
+func (this *LogMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&LogMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Loki:` + strings.Replace(this.Loki.String(), "LokiQuery", "LokiQuery", 1) + `,`,
+		`Elasticsearch:` + strings.Replace(this.Elasticsearch.String(), "ElasticsearchQuery", "ElasticsearchQuery", 1) + `,`,
+		`Authentication:` + strings.Replace(strings.Replace(this.Authentication.String(), "Authentication", "Authentication", 1), `&`, ``, 1) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LokiQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LokiQuery{`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MangedRoutes) String() string {
 	if this == nil {
 		return "nil"
@@ -14215,6 +18544,21 @@ func (this *MeasurementRetention) String() string {
 	}, "")
 	return s
 }
+func (this *MemberClusterStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemberClusterStatus{`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`CurrentPodHash:` + fmt.Sprintf("%v", this.CurrentPodHash) + `,`,
+		`Aborted:` + fmt.Sprintf("%v", this.Aborted) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Metric) String() string {
 	if this == nil {
 		return "nil"
@@ -14230,6 +18574,7 @@ func (this *Metric) String() string {
 		`InconclusiveLimit:` + strings.Replace(fmt.Sprintf("%v", this.InconclusiveLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`ConsecutiveErrorLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveErrorLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "MetricProvider", "MetricProvider", 1), `&`, ``, 1) + `,`,
+		`BurnRate:` + strings.Replace(this.BurnRate.String(), "BurnRateMetric", "BurnRateMetric", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14261,6 +18606,9 @@ func (this *MetricProvider) String() string {
 		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbMetric", "InfluxdbMetric", 1) + `,`,
 		`SkyWalking:` + strings.Replace(this.SkyWalking.String(), "SkyWalkingMetric", "SkyWalkingMetric", 1) + `,`,
 		`Plugin:` + mapStringForPlugin + `,`,
+		`Statistical:` + strings.Replace(this.Statistical.String(), "StatisticalMetric", "StatisticalMetric", 1) + `,`,
+		`Probe:` + strings.Replace(this.Probe.String(), "ProbeMetric", "ProbeMetric", 1) + `,`,
+		`Log:` + strings.Replace(this.Log.String(), "LogMetric", "LogMetric", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14301,6 +18649,38 @@ func (this *MetricResult) String() string {
 	}, "")
 	return s
 }
+func (this *MultiClusterPromotion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]ClusterWave{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "ClusterWave", "ClusterWave", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&MultiClusterPromotion{`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiClusterStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMembers := "[]MemberClusterStatus{"
+	for _, f := range this.Members {
+		repeatedStringForMembers += strings.Replace(strings.Replace(f.String(), "MemberClusterStatus", "MemberClusterStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMembers += "}"
+	s := strings.Join([]string{`&MultiClusterStatus{`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`Members:` + repeatedStringForMembers + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NewRelicMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14447,6 +18827,21 @@ func (this *PreferredDuringSchedulingIgnoredDuringExecution) String() string {
 	}, "")
 	return s
 }
+func (this *ProbeMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProbeMetric{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`Requests:` + valueToStringGenerated(this.Requests) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProbe", "HTTPProbe", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProbe", "GRPCProbe", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PrometheusMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14583,6 +18978,33 @@ func (this *RolloutCondition) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutDependencyStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutDependencyStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Satisfied:` + fmt.Sprintf("%v", this.Satisfied) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutExperimentStep) String() string {
 	if this == nil {
 		return "nil"
@@ -14608,6 +19030,8 @@ func (this *RolloutExperimentStep) String() string {
 		`Analyses:` + repeatedStringForAnalyses + `,`,
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`AnalysisRunMetadata:` + strings.Replace(strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1), `&`, ``, 1) + `,`,
+		`Comparison:` + strings.Replace(this.Comparison.String(), "ExperimentComparison", "ExperimentComparison", 1) + `,`,
+		`PromoteWinner:` + fmt.Sprintf("%v", this.PromoteWinner) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14635,6 +19059,11 @@ func (this *RolloutExperimentTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForContainers := "[]TemplateContainer{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "TemplateContainer", "TemplateContainer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
 	s := strings.Join([]string{`&RolloutExperimentTemplate{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`SpecRef:` + fmt.Sprintf("%v", this.SpecRef) + `,`,
@@ -14643,6 +19072,7 @@ func (this *RolloutExperimentTemplate) String() string {
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
 		`Service:` + strings.Replace(this.Service.String(), "TemplateService", "TemplateService", 1) + `,`,
+		`Containers:` + repeatedStringForContainers + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14673,10 +19103,39 @@ func (this *RolloutPause) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutShadowStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMatch := "[]RouteMatch{"
+	for _, f := range this.Match {
+		repeatedStringForMatch += strings.Replace(strings.Replace(f.String(), "RouteMatch", "RouteMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMatch += "}"
+	s := strings.Join([]string{`&RolloutShadowStep{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Match:` + repeatedStringForMatch + `,`,
+		`Percentage:` + valueToStringGenerated(this.Percentage) + `,`,
+		`ProxyService:` + fmt.Sprintf("%v", this.ProxyService) + `,`,
+		`Analysis:` + strings.Replace(strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDeployWindows := "[]DeployWindow{"
+	for _, f := range this.DeployWindows {
+		repeatedStringForDeployWindows += strings.Replace(strings.Replace(f.String(), "DeployWindow", "DeployWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDeployWindows += "}"
+	repeatedStringForDependsOn := "[]RolloutDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "RolloutDependency", "RolloutDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&RolloutSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
@@ -14691,6 +19150,10 @@ func (this *RolloutSpec) String() string {
 		`Analysis:` + strings.Replace(this.Analysis.String(), "AnalysisRunStrategy", "AnalysisRunStrategy", 1) + `,`,
 		`ProgressDeadlineAbort:` + fmt.Sprintf("%v", this.ProgressDeadlineAbort) + `,`,
 		`RollbackWindow:` + strings.Replace(this.RollbackWindow.String(), "RollbackWindowSpec", "RollbackWindowSpec", 1) + `,`,
+		`MultiCluster:` + strings.Replace(this.MultiCluster.String(), "MultiClusterPromotion", "MultiClusterPromotion", 1) + `,`,
+		`DeployWindows:` + repeatedStringForDeployWindows + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
+		`StrategyRef:` + strings.Replace(this.StrategyRef.String(), "RolloutStrategyRef", "RolloutStrategyRef", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14714,6 +19177,16 @@ func (this *RolloutStatus) String() string {
 		repeatedStringForALBs += strings.Replace(strings.Replace(f.String(), "ALBStatus", "ALBStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForALBs += "}"
+	repeatedStringForDependencies := "[]RolloutDependencyStatus{"
+	for _, f := range this.Dependencies {
+		repeatedStringForDependencies += strings.Replace(strings.Replace(f.String(), "RolloutDependencyStatus", "RolloutDependencyStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependencies += "}"
+	repeatedStringForTrafficRoutingDryRun := "[]TrafficRoutingChange{"
+	for _, f := range this.TrafficRoutingDryRun {
+		repeatedStringForTrafficRoutingDryRun += strings.Replace(strings.Replace(f.String(), "TrafficRoutingChange", "TrafficRoutingChange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTrafficRoutingDryRun += "}"
 	s := strings.Join([]string{`&RolloutStatus{`,
 		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
 		`PauseConditions:` + repeatedStringForPauseConditions + `,`,
@@ -14741,6 +19214,10 @@ func (this *RolloutStatus) String() string {
 		`WorkloadObservedGeneration:` + fmt.Sprintf("%v", this.WorkloadObservedGeneration) + `,`,
 		`ALB:` + strings.Replace(this.ALB.String(), "ALBStatus", "ALBStatus", 1) + `,`,
 		`ALBs:` + repeatedStringForALBs + `,`,
+		`MultiCluster:` + strings.Replace(this.MultiCluster.String(), "MultiClusterStatus", "MultiClusterStatus", 1) + `,`,
+		`IgnoreDeployWindow:` + fmt.Sprintf("%v", this.IgnoreDeployWindow) + `,`,
+		`Dependencies:` + repeatedStringForDependencies + `,`,
+		`TrafficRoutingDryRun:` + repeatedStringForTrafficRoutingDryRun + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14756,6 +19233,66 @@ func (this *RolloutStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutStrategyRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&RolloutStrategyRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStrategyTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutStrategyTemplateSpec", "RolloutStrategyTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutStrategyTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutStrategyTemplate", "RolloutStrategyTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutStrategyTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&RolloutStrategyTemplateSpec{`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`Strategy:` + strings.Replace(strings.Replace(this.Strategy.String(), "RolloutStrategy", "RolloutStrategy", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -14787,6 +19324,9 @@ func (this *RolloutTrafficRouting) String() string {
 		`Apisix:` + strings.Replace(this.Apisix.String(), "ApisixTrafficRouting", "ApisixTrafficRouting", 1) + `,`,
 		`Plugins:` + mapStringForPlugins + `,`,
 		`MaxTrafficWeight:` + valueToStringGenerated(this.MaxTrafficWeight) + `,`,
+		`GatewayAPI:` + strings.Replace(this.GatewayAPI.String(), "GatewayAPITrafficRouting", "GatewayAPITrafficRouting", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Stickiness:` + strings.Replace(this.Stickiness.String(), "TrafficStickiness", "TrafficStickiness", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14903,6 +19443,7 @@ func (this *SetMirrorRoute) String() string {
 	s := strings.Join([]string{`&SetMirrorRoute{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Match:` + repeatedStringForMatch + `,`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
 		`Percentage:` + valueToStringGenerated(this.Percentage) + `,`,
 		`}`,
 	}, "")
@@ -14932,6 +19473,63 @@ func (this *SkyWalkingMetric) String() string {
 	}, "")
 	return s
 }
+func (this *StatisticalComparison) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatisticalComparison{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Baseline:` + strings.Replace(strings.Replace(this.Baseline.String(), "StatisticalQuery", "StatisticalQuery", 1), `&`, ``, 1) + `,`,
+		`Canary:` + strings.Replace(strings.Replace(this.Canary.String(), "StatisticalQuery", "StatisticalQuery", 1), `&`, ``, 1) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatisticalMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForComparisons := "[]StatisticalComparison{"
+	for _, f := range this.Comparisons {
+		repeatedStringForComparisons += strings.Replace(strings.Replace(f.String(), "StatisticalComparison", "StatisticalComparison", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForComparisons += "}"
+	s := strings.Join([]string{`&StatisticalMetric{`,
+		`Comparisons:` + repeatedStringForComparisons + `,`,
+		`ConfidenceLevel:` + valueToStringGenerated(this.ConfidenceLevel) + `,`,
+		`Threshold:` + strings.Replace(strings.Replace(this.Threshold.String(), "KayentaThreshold", "KayentaThreshold", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatisticalQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatisticalQuery{`,
+		`Prometheus:` + strings.Replace(this.Prometheus.String(), "PrometheusMetric", "PrometheusMetric", 1) + `,`,
+		`Datadog:` + strings.Replace(this.Datadog.String(), "DatadogMetric", "DatadogMetric", 1) + `,`,
+		`Wavefront:` + strings.Replace(this.Wavefront.String(), "WavefrontMetric", "WavefrontMetric", 1) + `,`,
+		`NewRelic:` + strings.Replace(this.NewRelic.String(), "NewRelicMetric", "NewRelicMetric", 1) + `,`,
+		`Graphite:` + strings.Replace(this.Graphite.String(), "GraphiteMetric", "GraphiteMetric", 1) + `,`,
+		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbMetric", "InfluxdbMetric", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepBranch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepBranch{`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`GoTo:` + fmt.Sprintf("%v", this.GoTo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StepPluginStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -14953,6 +19551,29 @@ func (this *StepPluginStatus) String() string {
 	}, "")
 	return s
 }
+func (this *StepTimeout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepTimeout{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepTimeoutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepTimeoutStatus{`,
+		`StepIndex:` + fmt.Sprintf("%v", this.StepIndex) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`TimedOut:` + fmt.Sprintf("%v", this.TimedOut) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StickinessConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -14964,6 +19585,18 @@ func (this *StickinessConfig) String() string {
 	}, "")
 	return s
 }
+func (this *StickinessCookie) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StickinessCookie{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MaxAgeSeconds:` + valueToStringGenerated(this.MaxAgeSeconds) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StringMatch) String() string {
 	if this == nil {
 		return "nil"
@@ -15009,6 +19642,23 @@ func (this *TTLStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *TemplateContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEnv := "[]EnvVar{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEnv += "}"
+	s := strings.Join([]string{`&TemplateContainer{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TemplateService) String() string {
 	if this == nil {
 		return "nil"
@@ -15050,6 +19700,7 @@ func (this *TemplateStatus) String() string {
 		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
 		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
 		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`Score:` + valueToStringGenerated(this.Score) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15064,6 +19715,30 @@ func (this *TraefikTrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *TrafficRoutingChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TrafficRoutingChange{`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TrafficStickiness) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TrafficStickiness{`,
+		`Cookie:` + strings.Replace(this.Cookie.String(), "StickinessCookie", "StickinessCookie", 1) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TrafficWeights) String() string {
 	if this == nil {
 		return "nil"
@@ -19029,6 +23704,62 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficWeightIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrafficWeightIndex = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weights == nil {
+				m.Weights = &TrafficWeights{}
+			}
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19479,59 +24210,9 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AbortScaleDownDelaySeconds = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19558,16 +24239,16 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentStepAnalysisRunStatus == nil {
-				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &BlueGreenTrafficRouting{}
 			}
-			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19594,48 +24275,66 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentBackgroundAnalysisRunStatus == nil {
-				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			if m.PauseTimeout == nil {
+				m.PauseTimeout = &StepTimeout{}
 			}
-			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PauseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlueGreenTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlueGreenTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlueGreenTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutTrafficRouting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19662,79 +24361,86 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Weights == nil {
-				m.Weights = &TrafficWeights{}
-			}
-			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RolloutTrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StablePingPong", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StablePingPong = PingPongType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepPluginStatuses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StepPluginStatuses = append(m.StepPluginStatuses, StepPluginStatus{})
-			if err := m.StepPluginStatuses[len(m.StepPluginStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19756,7 +24462,7 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStep) Unmarshal(dAtA []byte) error {
+func (m *BurnRateMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19779,109 +24485,17 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnRateMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnRateMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SetWeight = &v
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pause == nil {
-				m.Pause = &RolloutPause{}
-			}
-			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Experiment == nil {
-				m.Experiment = &RolloutExperimentStep{}
-			}
-			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objective", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19891,33 +24505,29 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysis{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Objective = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortWindow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19927,33 +24537,29 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetCanaryScale == nil {
-				m.SetCanaryScale = &SetCanaryScale{}
-			}
-			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShortWindow = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetHeaderRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongWindow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19963,33 +24569,29 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetHeaderRoute == nil {
-				m.SetHeaderRoute = &SetHeaderRoute{}
-			}
-			if err := m.SetHeaderRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LongWindow = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetMirrorRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FastBurnMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19999,33 +24601,29 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetMirrorRoute == nil {
-				m.SetMirrorRoute = &SetMirrorRoute{}
-			}
-			if err := m.SetMirrorRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FastBurnMultiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowBurnMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20035,27 +24633,23 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginStep{}
-			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlowBurnMultiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20078,7 +24672,7 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20101,17 +24695,17 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20121,29 +24715,33 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryService = string(dAtA[iNdEx:postIndex])
+			if m.CurrentStepAnalysisRunStatus == nil {
+				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20153,29 +24751,33 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableService = string(dAtA[iNdEx:postIndex])
+			if m.CurrentBackgroundAnalysisRunStatus == nil {
+				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20185,29 +24787,27 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, CanaryStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20234,18 +24834,18 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TrafficRouting == nil {
-				m.TrafficRouting = &RolloutTrafficRouting{}
+			if m.Weights == nil {
+				m.Weights = &TrafficWeights{}
 			}
-			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StablePingPong", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20255,31 +24855,27 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
-			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StablePingPong = PingPongType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepPluginStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20306,16 +24902,14 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxSurge == nil {
-				m.MaxSurge = &intstr.IntOrString{}
-			}
-			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StepPluginStatuses = append(m.StepPluginStatuses, StepPluginStatus{})
+			if err := m.StepPluginStatuses[len(m.StepPluginStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysisRunStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20342,16 +24936,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysisBackground{}
+			if m.PostPromotionAnalysisRunStatus == nil {
+				m.PostPromotionAnalysisRunStatus = &RolloutAnalysisRunStatus{}
 			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PostPromotionAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BakeStartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20378,18 +24972,18 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AntiAffinity == nil {
-				m.AntiAffinity = &AntiAffinity{}
+			if m.BakeStartedAt == nil {
+				m.BakeStartedAt = &v1.Time{}
 			}
-			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BakeStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackPodHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20399,31 +24993,27 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryMetadata == nil {
-				m.CanaryMetadata = &PodTemplateMetadata{}
-			}
-			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollbackPodHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20450,16 +25040,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableMetadata == nil {
-				m.StableMetadata = &PodTemplateMetadata{}
+			if m.CurrentStepTimeout == nil {
+				m.CurrentStepTimeout = &StepTimeoutStatus{}
 			}
-			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentStepTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluatedStepIndex", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -20476,123 +25066,83 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ScaleDownDelaySeconds = &v
+			m.EvaluatedStepIndex = &v
 		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelayRevisionLimit = &v
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AbortScaleDownDelaySeconds = &v
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicStableScale", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.SkippedSteps = append(m.SkippedSteps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
 				}
-			}
-			m.DynamicStableScale = bool(v != 0)
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingPong", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PingPong == nil {
-				m.PingPong = &PingPongSpec{}
-			}
-			if err := m.PingPong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPodsPerReplicaSet", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.SkippedSteps) == 0 {
+					m.SkippedSteps = make([]int32, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SkippedSteps = append(m.SkippedSteps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSteps", wireType)
 			}
-			m.MinPodsPerReplicaSet = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20614,7 +25164,7 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
+func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20637,17 +25187,37 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetWeight = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20657,27 +25227,31 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			if m.Pause == nil {
+				m.Pause = &RolloutPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricDataQueries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20704,66 +25278,18 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricDataQueries = append(m.MetricDataQueries, CloudWatchMetricDataQuery{})
-			if err := m.MetricDataQueries[len(m.MetricDataQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Experiment == nil {
+				m.Experiment = &RolloutExperimentStep{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricDataQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricDataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20773,29 +25299,33 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20805,30 +25335,33 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Expression = &s
+			if m.SetCanaryScale == nil {
+				m.SetCanaryScale = &SetCanaryScale{}
+			}
+			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetHeaderRoute", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20838,28 +25371,31 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Label = &s
+			if m.SetHeaderRoute == nil {
+				m.SetHeaderRoute = &SetHeaderRoute{}
+			}
+			if err := m.SetHeaderRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricStat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetMirrorRoute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20886,16 +25422,16 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricStat == nil {
-				m.MetricStat = &CloudWatchMetricStat{}
+			if m.SetMirrorRoute == nil {
+				m.SetMirrorRoute = &SetMirrorRoute{}
 			}
-			if err := m.MetricStat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetMirrorRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20922,18 +25458,18 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Period == nil {
-				m.Period = &intstr.IntOrString{}
+			if m.Plugin == nil {
+				m.Plugin = &PluginStep{}
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20943,66 +25479,27 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ReturnData = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStat: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStat: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shadow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21029,13 +25526,16 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metric.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Shadow == nil {
+				m.Shadow = &RolloutShadowStep{}
+			}
+			if err := m.Shadow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21062,13 +25562,16 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &StepTimeout{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21096,13 +25599,13 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stat = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21112,23 +25615,25 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
+			m.Branches = append(m.Branches, StepBranch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21151,7 +25656,7 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
+func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21174,17 +25679,17 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21194,29 +25699,27 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dimensions = append(m.Dimensions, CloudWatchMetricStatMetricDimension{})
-			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21244,13 +25747,13 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			m.StableService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21260,80 +25763,31 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Steps = append(m.Steps, CanaryStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21343,29 +25797,33 @@ func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &RolloutTrafficRouting{}
+			}
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21375,77 +25833,31 @@ func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21472,13 +25884,16 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
+			}
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21505,63 +25920,16 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysisBackground{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21588,13 +25956,16 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AntiAffinity == nil {
+				m.AntiAffinity = &AntiAffinity{}
+			}
+			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21621,66 +25992,18 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterAnalysisTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.CanaryMetadata == nil {
+				m.CanaryMetadata = &PodTemplateMetadata{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21690,29 +26013,33 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			if m.StableMetadata == nil {
+				m.StableMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21722,27 +26049,75 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ScaleDownDelaySeconds = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ScaleDownDelayRevisionLimit = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbortScaleDownDelaySeconds = &v
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicStableScale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicStableScale = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingPong", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21769,109 +26144,18 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]string)
+			if m.PingPong == nil {
+				m.PingPong = &PingPongSpec{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.PingPong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPodsPerReplicaSet", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21881,29 +26165,17 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Formula = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.MinPodsPerReplicaSet = &v
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21913,29 +26185,33 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiVersion = string(dAtA[iNdEx:postIndex])
+			if m.PostPromotionAnalysis == nil {
+				m.PostPromotionAnalysis = &RolloutAnalysis{}
+			}
+			if err := m.PostPromotionAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BakeSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21945,24 +26221,12 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Aggregator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BakeSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21984,7 +26248,7 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DryRun) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22007,15 +26271,15 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22043,127 +26307,11 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Experiment) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricDataQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22190,7 +26338,8 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MetricDataQueries = append(m.MetricDataQueries, CloudWatchMetricDataQuery{})
+			if err := m.MetricDataQueries[len(m.MetricDataQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22215,7 +26364,7 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22238,15 +26387,15 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricDataQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricDataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22274,11 +26423,11 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22306,11 +26455,12 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Expression = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22338,13 +26488,14 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Label = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricStat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22354,24 +26505,85 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.MetricStat == nil {
+				m.MetricStat = &CloudWatchMetricStat{}
+			}
+			if err := m.MetricStat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = &intstr.IntOrString{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ReturnData = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22393,7 +26605,7 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22416,17 +26628,17 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22436,29 +26648,30 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Metric.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22468,29 +26681,30 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22500,17 +26714,29 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22520,46 +26746,24 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22581,7 +26785,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22604,17 +26808,17 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricStatMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricStatMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22624,27 +26828,29 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
+			m.Dimensions = append(m.Dimensions, CloudWatchMetricStatMetricDimension{})
+			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22672,13 +26878,13 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22688,61 +26894,78 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22770,11 +26993,11 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22802,7 +27025,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22825,7 +27048,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentList) Unmarshal(dAtA []byte) error {
+func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22848,15 +27071,15 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22883,13 +27106,13 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22916,8 +27139,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Experiment{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22942,7 +27164,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
+func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22965,15 +27187,15 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23000,208 +27222,13 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, TemplateSpec{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineSeconds = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminate = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelaySeconds = &v
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23228,7 +27255,8 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterAnalysisTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23253,7 +27281,7 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
+func (m *ClusterRolloutStrategyTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23276,149 +27304,15 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
-			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AvailableAt == nil {
-				m.AvailableAt = &v1.Time{}
-			}
-			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23445,14 +27339,13 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ExperimentCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23479,8 +27372,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23505,7 +27397,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldRef) Unmarshal(dAtA []byte) error {
+func (m *ClusterRolloutStrategyTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23528,17 +27420,17 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23548,23 +27440,58 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterRolloutStrategyTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23587,7 +27514,7 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
+func (m *ClusterWave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23610,15 +27537,15 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23646,11 +27573,11 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23678,7 +27605,7 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23701,7 +27628,7 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
+func (m *ComparisonAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23724,15 +27651,15 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparisonAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparisonAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23760,13 +27687,13 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeaderName = string(dAtA[iNdEx:postIndex])
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23776,27 +27703,23 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeaderValue == nil {
-				m.HeaderValue = &StringMatch{}
-			}
-			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AnalysisName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23819,7 +27742,7 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
+func (m *ComparisonMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23842,15 +27765,15 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparisonMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparisonMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23878,11 +27801,11 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23910,8 +27833,28 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Goal = ComparisonGoal(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23933,7 +27876,7 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23956,15 +27899,15 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23992,11 +27935,11 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24024,13 +27967,13 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24040,79 +27983,124 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.Queries == nil {
+				m.Queries = make(map[string]string)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24122,33 +28110,29 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VirtualService == nil {
-				m.VirtualService = &IstioVirtualService{}
-			}
-			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Formula = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24158,33 +28142,29 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationRule == nil {
-				m.DestinationRule = &IstioDestinationRule{}
-			}
-			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24194,25 +28174,23 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
-			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Aggregator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24235,7 +28213,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
+func (m *DeployWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24258,15 +28236,15 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeployWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeployWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24294,11 +28272,11 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kind = DeployWindowKind(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24326,13 +28304,13 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24342,31 +28320,29 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
-			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24376,25 +28352,23 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
-			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24417,7 +28391,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobMetric) Unmarshal(dAtA []byte) error {
+func (m *DryRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24440,50 +28414,17 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24493,24 +28434,23 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24533,7 +28473,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *ElasticsearchQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24556,15 +28496,15 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ElasticsearchQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ElasticsearchQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24592,11 +28532,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24624,13 +28564,63 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Experiment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24640,29 +28630,30 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24672,27 +28663,111 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24720,11 +28795,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24752,13 +28827,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24768,30 +28843,29 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24801,25 +28875,23 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, KayentaScope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24842,7 +28914,7 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaScope) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24865,10 +28937,10 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24905,9 +28977,9 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24917,28 +28989,47 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24965,10 +29056,31 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24990,7 +29102,7 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
+func (m *ExperimentComparison) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25013,36 +29125,17 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentComparison: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentComparison: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
-			}
-			m.Pass = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Pass |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
 			}
-			m.Marginal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25052,66 +29145,31 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Marginal |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Analyses = append(m.Analyses, ComparisonAnalysis{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25121,23 +29179,25 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Metrics = append(m.Metrics, ComparisonMetric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25160,7 +29220,7 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Measurement) Unmarshal(dAtA []byte) error {
+func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25183,15 +29243,15 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25219,11 +29279,11 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25251,11 +29311,11 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25282,16 +29342,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25318,16 +29375,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25355,140 +29409,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25498,27 +29425,23 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResumeAt == nil {
-				m.ResumeAt = &v1.Time{}
-			}
-			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25541,7 +29464,7 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
+func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25564,17 +29487,17 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25584,29 +29507,30 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25616,11 +29540,26 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Experiment{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25642,7 +29581,7 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metric) Unmarshal(dAtA []byte) error {
+func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25665,17 +29604,17 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25685,27 +29624,29 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, TemplateSpec{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25733,13 +29674,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25749,29 +29690,17 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ProgressDeadlineSeconds = &v
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25781,33 +29710,17 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Terminate = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25817,29 +29730,31 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25849,27 +29764,15 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ScaleDownDelaySeconds = &v
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25896,16 +29799,14 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailureLimit == nil {
-				m.FailureLimit = &intstr.IntOrString{}
-			}
-			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25932,16 +29833,14 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InconclusiveLimit == nil {
-				m.InconclusiveLimit = &intstr.IntOrString{}
-			}
-			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25968,16 +29867,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsecutiveErrorLimit == nil {
-				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
-			}
-			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26004,7 +29900,10 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Comparison == nil {
+				m.Comparison = &ExperimentComparison{}
+			}
+			if err := m.Comparison.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26029,7 +29928,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricProvider) Unmarshal(dAtA []byte) error {
+func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26052,17 +29951,17 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26072,33 +29971,29 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prometheus == nil {
-				m.Prometheus = &PrometheusMetric{}
-			}
-			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26108,31 +30003,27 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kayenta == nil {
-				m.Kayenta = &KayentaMetric{}
-			}
-			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26159,16 +30050,14 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Web == nil {
-				m.Web = &WebMetric{}
-			}
-			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
+			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26195,16 +30084,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datadog == nil {
-				m.Datadog = &DatadogMetric{}
+			if m.AvailableAt == nil {
+				m.AvailableAt = &v1.Time{}
 			}
-			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26231,16 +30120,14 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wavefront == nil {
-				m.Wavefront = &WavefrontMetric{}
-			}
-			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, ExperimentCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26267,18 +30154,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRelic == nil {
-				m.NewRelic = &NewRelicMetric{}
-			}
-			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26288,33 +30173,79 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &JobMetric{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26324,33 +30255,79 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CloudWatch == nil {
-				m.CloudWatch = &CloudWatchMetric{}
-			}
-			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26360,33 +30337,79 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Graphite == nil {
-				m.Graphite = &GraphiteMetric{}
-			}
-			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayAPITrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoute", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26396,33 +30419,29 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Influxdb == nil {
-				m.Influxdb = &InfluxdbMetric{}
-			}
-			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HTTPRoute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoutes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26432,33 +30451,29 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkyWalking == nil {
-				m.SkyWalking = &SkyWalkingMetric{}
-			}
-			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HTTPRoutes = append(m.HTTPRoutes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCRoute", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26468,119 +30483,55 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = make(map[string]encoding_json.RawMessage)
+			m.GRPCRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoute", wireType)
 			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TCPRoute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26603,7 +30554,7 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricResult) Unmarshal(dAtA []byte) error {
+func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26626,15 +30577,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26662,11 +30613,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26694,13 +30645,63 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPProbe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPProbe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPProbe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26710,29 +30711,27 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Measurements = append(m.Measurements, Measurement{})
-			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Scheme = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26760,89 +30759,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Method = WebMetricMethod(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
-			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
-			}
-			m.Failed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
-			}
-			m.Inconclusive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Error = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26852,53 +30775,27 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
-			}
-			m.ConsecutiveError = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsecutiveError |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = bool(v != 0)
-		case 12:
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26925,14 +30822,14 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowGenerated
@@ -26942,43 +30839,51 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.SuccessStatusCodes = append(m.SuccessStatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SuccessStatusCodes) == 0 {
+					m.SuccessStatusCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -26988,41 +30893,36 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						v |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.SuccessStatusCodes = append(m.SuccessStatusCodes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessStatusCodes", wireType)
 			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27044,7 +30944,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27067,15 +30967,15 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27103,13 +31003,13 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.HeaderName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27119,44 +31019,28 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if m.HeaderValue == nil {
+				m.HeaderValue = &StringMatch{}
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Timeout = &v
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27178,7 +31062,7 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27201,15 +31085,15 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27237,11 +31121,11 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27269,13 +31153,63 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27285,122 +31219,27 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalIngressAnnotations == nil {
-				m.AdditionalIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.AdditionalIngressAnnotations[mapkey] = mapvalue
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27428,13 +31267,13 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27444,124 +31283,29 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryIngressAnnotations == nil {
-				m.CanaryIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CanaryIngressAnnotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -27578,7 +31322,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27601,17 +31345,17 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27621,29 +31365,33 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			if m.VirtualService == nil {
+				m.VirtualService = &IstioVirtualService{}
+			}
+			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27653,61 +31401,33 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.DestinationRule == nil {
+				m.DestinationRule = &IstioDestinationRule{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClientSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27717,23 +31437,25 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
+			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27756,7 +31478,7 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27779,15 +31501,15 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27815,11 +31537,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27847,13 +31569,13 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27863,29 +31585,31 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
+			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27895,23 +31619,25 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
+			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27934,7 +31660,7 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27957,17 +31683,17 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27977,27 +31703,28 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28024,7 +31751,7 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28049,7 +31776,7 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28072,15 +31799,15 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28108,11 +31835,11 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PingService = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28140,61 +31867,107 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PongService = string(dAtA[iNdEx:postIndex])
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PluginStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28222,13 +31995,13 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28238,24 +32011,57 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, KayentaScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -28279,7 +32085,7 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28302,15 +32108,47 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28337,107 +32175,13 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28464,103 +32208,9 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28583,7 +32233,7 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28606,17 +32256,17 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
 			}
-			m.Weight = 0
+			m.Pass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28626,7 +32276,26 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				m.Pass |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			}
+			m.Marginal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Marginal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28652,7 +32321,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+func (m *LogMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28675,10 +32344,10 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -28715,9 +32384,9 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loki", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28727,27 +32396,31 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if m.Loki == nil {
+				m.Loki = &LokiQuery{}
+			}
+			if err := m.Loki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elasticsearch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28774,15 +32447,18 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Elasticsearch == nil {
+				m.Elasticsearch = &ElasticsearchQuery{}
+			}
+			if err := m.Elasticsearch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28792,17 +32468,30 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Timeout = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28812,27 +32501,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -28852,11 +32521,11 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28866,28 +32535,31 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RangeQuery == nil {
-				m.RangeQuery = &PrometheusRangeQueryArgs{}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28909,7 +32581,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
+func (m *LokiQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28932,15 +32604,15 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
+			return fmt.Errorf("proto: LokiQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LokiQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28968,43 +32640,11 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.End = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29032,7 +32672,7 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = DurationString(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29055,57 +32695,7 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
+func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29128,17 +32718,17 @@ func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollbackWindowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollbackWindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Revisions = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29148,11 +32738,24 @@ func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revisions |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29174,7 +32777,7 @@ func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Rollout) Unmarshal(dAtA []byte) error {
+func (m *Measurement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29197,17 +32800,17 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29217,30 +32820,29 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29250,28 +32852,27 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29298,63 +32899,16 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29381,16 +32935,18 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, AnalysisTemplateRef{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29400,29 +32956,27 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29449,48 +33003,107 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29517,7 +33130,10 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResumeAt == nil {
+				m.ResumeAt = &v1.Time{}
+			}
+			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29542,7 +33158,7 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29565,17 +33181,17 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29585,30 +33201,29 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int32
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29618,12 +33233,11 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingStep = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29645,7 +33259,7 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *MemberClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29668,15 +33282,15 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberClusterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29704,11 +33318,11 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29736,11 +33350,11 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Wave = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29768,22 +33382,106 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPodHash", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
+			m.CurrentPodHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aborted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -29791,7 +33489,7 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
+func (m *Metric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29814,15 +33512,15 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29850,11 +33548,11 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29882,13 +33580,13 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29898,28 +33596,27 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29946,13 +33643,16 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29980,11 +33680,11 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30012,61 +33712,11 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30093,16 +33743,18 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FailureLimit == nil {
+				m.FailureLimit = &intstr.IntOrString{}
+			}
+			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30112,27 +33764,31 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			if m.InconclusiveLimit == nil {
+				m.InconclusiveLimit = &intstr.IntOrString{}
+			}
+			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30159,14 +33815,16 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsecutiveErrorLimit == nil {
+				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
+			}
+			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30193,14 +33851,13 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30227,7 +33884,10 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BurnRate == nil {
+				m.BurnRate = &BurnRateMetric{}
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30252,7 +33912,7 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30275,17 +33935,17 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30295,29 +33955,33 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30327,47 +33991,31 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if m.Kayenta == nil {
+				m.Kayenta = &KayentaMetric{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClusterScope = bool(v != 0)
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30394,16 +34042,18 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Web == nil {
+				m.Web = &WebMetric{}
+			}
+			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30413,67 +34063,33 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiredForCompletion = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30483,29 +34099,33 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
+			}
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30515,29 +34135,33 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30547,15 +34171,31 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &JobMetric{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30582,13 +34222,16 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CloudWatch == nil {
+				m.CloudWatch = &CloudWatchMetric{}
+			}
+			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30615,18 +34258,18 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
+			if m.Graphite == nil {
+				m.Graphite = &GraphiteMetric{}
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30636,15 +34279,31 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Weight = &v
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbMetric{}
+			}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30671,66 +34330,144 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &TemplateService{}
+			if m.SkyWalking == nil {
+				m.SkyWalking = &SkyWalkingMetric{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Plugin == nil {
+				m.Plugin = make(map[string]encoding_json.RawMessage)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistical", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30757,13 +34494,16 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Statistical == nil {
+				m.Statistical = &StatisticalMetric{}
+			}
+			if err := m.Statistical.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Probe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30790,64 +34530,16 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Rollout{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Probe == nil {
+				m.Probe = &ProbeMetric{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Probe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutPause) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30874,10 +34566,10 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &intstr.IntOrString{}
+			if m.Log == nil {
+				m.Log = &LogMetric{}
 			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30902,7 +34594,7 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
+func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30925,17 +34617,17 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30945,17 +34637,29 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30965,31 +34669,27 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31016,15 +34716,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Measurements = append(m.Measurements, Measurement{})
+			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.MinReadySeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31034,16 +34735,29 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinReadySeconds |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31053,30 +34767,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
-			}
-			var v int32
+			m.Successful = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31086,17 +34786,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Successful |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RevisionHistoryLimit = &v
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var v int
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31106,17 +34805,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
 			}
-			var v int32
+			m.Inconclusive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31126,17 +34824,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Inconclusive |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ProgressDeadlineSeconds = &v
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			m.Error = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31146,33 +34843,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Error |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RestartAt == nil {
-				m.RestartAt = &v1.Time{}
-			}
-			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
 			}
-			var msglen int
+			m.ConsecutiveError = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31182,31 +34862,34 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsecutiveError |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkloadRef == nil {
-				m.WorkloadRef = &ObjectRef{}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 11:
+			m.DryRun = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31233,36 +34916,157 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &AnalysisRunStrategy{}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ProgressDeadlineAbort = bool(v != 0)
-		case 13:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiClusterPromotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiClusterPromotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiClusterPromotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31289,10 +35093,8 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollbackWindow == nil {
-				m.RollbackWindow = &RollbackWindowSpec{}
-			}
-			if err := m.RollbackWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waves = append(m.Waves, ClusterWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31317,7 +35119,7 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
+func (m *MultiClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31340,17 +35142,17 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiClusterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31360,17 +35162,29 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Abort = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseConditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31380,49 +35194,27 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PauseConditions = append(m.PauseConditions, PauseCondition{})
-			if err := m.PauseConditions[len(m.PauseConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Wave = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPause", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ControllerPause = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31449,16 +35241,64 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbortedAt == nil {
-				m.AbortedAt = &v1.Time{}
-			}
-			if err := m.AbortedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, MemberClusterStatus{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPodHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31486,11 +35326,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentPodHash = string(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31518,13 +35358,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentStepHash = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.Replicas = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31534,16 +35374,67 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			m.Timeout = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UpdatedReplicas = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31553,16 +35444,29 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ReadyReplicas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31572,16 +35476,29 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.AvailableReplicas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31591,54 +35508,122 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AvailableReplicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepIndex", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.CurrentStepIndex = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if m.AdditionalIngressAnnotations == nil {
+				m.AdditionalIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.CollisionCount = &v
-		case 13:
+			m.AdditionalIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31666,11 +35651,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
+			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31697,49 +35682,159 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, RolloutCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			if m.CanaryIngressAnnotations == nil {
+				m.CanaryIngressAnnotations = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 16:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31749,47 +35844,27 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
-			}
-			m.HPAReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HPAReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31817,11 +35892,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31849,13 +35924,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableRS = string(dAtA[iNdEx:postIndex])
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31865,51 +35940,77 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartedAt == nil {
-				m.RestartedAt = &v1.Time{}
-			}
-			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PromoteFull = bool(v != 0)
-		case 22:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31937,11 +36038,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31969,11 +36070,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32001,13 +36102,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32017,72 +36118,34 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBStatus{}
-			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScaleDown = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ALBs = append(m.ALBs, ALBStatus{})
-			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -32094,7 +36157,7 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
+func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32117,17 +36180,17 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32137,31 +36200,27 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlueGreen == nil {
-				m.BlueGreen = &BlueGreenStrategy{}
-			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32188,10 +36247,7 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Canary == nil {
-				m.Canary = &CanaryStrategy{}
-			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32216,7 +36272,7 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32239,17 +36295,17 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32259,33 +36315,29 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Istio == nil {
-				m.Istio = &IstioTrafficRouting{}
-			}
-			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PingService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32295,105 +36347,79 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nginx == nil {
-				m.Nginx = &NginxTrafficRouting{}
-			}
-			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PongService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBTrafficRouting{}
-			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SMI == nil {
-				m.SMI = &SMITrafficRouting{}
-			}
-			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32403,33 +36429,29 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ambassador == nil {
-				m.Ambassador = &AmbassadorTrafficRouting{}
-			}
-			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32439,101 +36461,79 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AppMesh == nil {
-				m.AppMesh = &AppMeshTrafficRouting{}
-			}
-			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Traefik == nil {
-				m.Traefik = &TraefikTrafficRouting{}
-			}
-			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
-			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32560,16 +36560,107 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apisix == nil {
-				m.Apisix = &ApisixTrafficRouting{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32596,11 +36687,11 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugins == nil {
-				m.Plugins = make(map[string]encoding_json.RawMessage)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := []byte{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -32649,7 +36740,7 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapbyteLen uint64
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -32659,25 +36750,24 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postbytesIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32693,13 +36783,6562 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbeMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Requests = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPProbe{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProbe{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeQuery == nil {
+				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			}
+			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackWindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackWindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			m.Revisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revisions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, AnalysisTemplateRef{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingStep = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutDependencyStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutDependencyStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutDependencyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Satisfied = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparison", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Comparison == nil {
+				m.Comparison = &ExperimentComparison{}
+			}
+			if err := m.Comparison.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteWinner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PromoteWinner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &TemplateService{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, TemplateContainer{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Rollout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &intstr.IntOrString{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutShadowStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutShadowStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutShadowStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = append(m.Match, RouteMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Percentage = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
+			}
+			m.MinReadySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReadySeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartAt == nil {
+				m.RestartAt = &v1.Time{}
+			}
+			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkloadRef == nil {
+				m.WorkloadRef = &ObjectRef{}
+			}
+			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &AnalysisRunStrategy{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineAbort = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackWindow == nil {
+				m.RollbackWindow = &RollbackWindowSpec{}
+			}
+			if err := m.RollbackWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCluster", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiCluster == nil {
+				m.MultiCluster = &MultiClusterPromotion{}
+			}
+			if err := m.MultiCluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployWindows = append(m.DeployWindows, DeployWindow{})
+			if err := m.DeployWindows[len(m.DeployWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, RolloutDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StrategyRef == nil {
+				m.StrategyRef = &RolloutStrategyRef{}
+			}
+			if err := m.StrategyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Abort = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseConditions = append(m.PauseConditions, PauseCondition{})
+			if err := m.PauseConditions[len(m.PauseConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerPause = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbortedAt == nil {
+				m.AbortedAt = &v1.Time{}
+			}
+			if err := m.AbortedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPodHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPodHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentStepHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			}
+			m.AvailableReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CurrentStepIndex = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollisionCount = &v
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, RolloutCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
+			}
+			m.HPAReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HPAReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableRS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartedAt == nil {
+				m.RestartedAt = &v1.Time{}
+			}
+			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PromoteFull = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ALB == nil {
+				m.ALB = &ALBStatus{}
+			}
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ALBs = append(m.ALBs, ALBStatus{})
+			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiCluster", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiCluster == nil {
+				m.MultiCluster = &MultiClusterStatus{}
+			}
+			if err := m.MultiCluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDeployWindow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreDeployWindow = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, RolloutDependencyStatus{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRoutingDryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrafficRoutingDryRun = append(m.TrafficRoutingDryRun, TrafficRoutingChange{})
+			if err := m.TrafficRoutingDryRun[len(m.TrafficRoutingDryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlueGreen == nil {
+				m.BlueGreen = &BlueGreenStrategy{}
+			}
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Canary == nil {
+				m.Canary = &CanaryStrategy{}
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RolloutStrategyTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyTemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Istio == nil {
+				m.Istio = &IstioTrafficRouting{}
+			}
+			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nginx == nil {
+				m.Nginx = &NginxTrafficRouting{}
+			}
+			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ALB == nil {
+				m.ALB = &ALBTrafficRouting{}
+			}
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SMI == nil {
+				m.SMI = &SMITrafficRouting{}
+			}
+			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ambassador == nil {
+				m.Ambassador = &AmbassadorTrafficRouting{}
+			}
+			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppMesh == nil {
+				m.AppMesh = &AppMeshTrafficRouting{}
+			}
+			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Traefik == nil {
+				m.Traefik = &TraefikTrafficRouting{}
+			}
+			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
+			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apisix == nil {
+				m.Apisix = &ApisixTrafficRouting{}
+			}
+			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugins == nil {
+				m.Plugins = make(map[string]encoding_json.RawMessage)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxTrafficWeight = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAPI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayAPI == nil {
+				m.GatewayAPI = &GatewayAPITrafficRouting{}
+			}
+			if err := m.GatewayAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stickiness == nil {
+				m.Stickiness = &TrafficStickiness{}
+			}
+			if err := m.Stickiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Method == nil {
+				m.Method = &StringMatch{}
+			}
+			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Path == nil {
+				m.Path = &StringMatch{}
+			}
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]StringMatch)
+			}
+			var mapkey string
+			mapvalue := &StringMatch{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StringMatch{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			}
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32709,12 +43348,24 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MaxTrafficWeight = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32736,7 +43387,7 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteMatch) Unmarshal(dAtA []byte) error {
+func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32759,17 +43410,17 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretKeyRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32779,69 +43430,29 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Method == nil {
-				m.Method = &StringMatch{}
-			}
-			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Path == nil {
-				m.Path = &StringMatch{}
-			}
-			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32851,120 +43462,23 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]StringMatch)
-			}
-			var mapkey string
-			mapvalue := &StringMatch{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StringMatch{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Headers[mapkey] = *mapvalue
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32987,7 +43501,7 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunSummary) Unmarshal(dAtA []byte) error {
+func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33010,17 +43524,17 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.Count = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33030,16 +43544,17 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Weight = &v
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			m.Successful = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33049,16 +43564,17 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Replicas = &v
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
 			}
-			m.Failed = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33068,16 +43584,67 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			m.MatchTrafficWeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Inconclusive = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33087,16 +43654,29 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Error = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33106,11 +43686,26 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = append(m.Match, HeaderRoutingMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33132,7 +43727,7 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33155,15 +43750,15 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMirrorRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMirrorRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33191,11 +43786,45 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootService = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = append(m.Match, RouteMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33223,8 +43852,28 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Percentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33246,7 +43895,7 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
+func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33269,15 +43918,15 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sigv4Config: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sigv4Config: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33305,11 +43954,11 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33337,13 +43986,13 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
-			m.Step = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33353,14 +44002,109 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Step |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkyWalkingMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkyWalkingMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33388,11 +44132,11 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33420,7 +44164,7 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33443,7 +44187,7 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
+func (m *StatisticalComparison) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33466,10 +44210,10 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretKeyRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatisticalComparison: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatisticalComparison: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33506,9 +44250,9 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33518,79 +44262,30 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33600,17 +44295,30 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Weight = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33620,17 +44328,29 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = StatisticalDirection(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33640,12 +44360,12 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MatchTrafficWeight = bool(v != 0)
+			m.Weight = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33667,7 +44387,7 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
+func (m *StatisticalMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33690,17 +44410,17 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatisticalMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatisticalMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparisons", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33710,27 +44430,49 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Comparisons = append(m.Comparisons, StatisticalComparison{})
+			if err := m.Comparisons[len(m.Comparisons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidenceLevel", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConfidenceLevel = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33757,8 +44499,7 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = append(m.Match, HeaderRoutingMatch{})
-			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33783,7 +44524,7 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
+func (m *StatisticalQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33806,17 +44547,17 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetMirrorRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatisticalQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMirrorRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatisticalQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33826,27 +44567,31 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33873,16 +44618,18 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = append(m.Match, RouteMatch{})
-			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
+			}
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33892,67 +44639,33 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Percentage = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Sigv4Config: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sigv4Config: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33962,29 +44675,33 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33994,29 +44711,33 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			if m.Graphite == nil {
+				m.Graphite = &GraphiteMetric{}
+			}
+			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34026,23 +44747,27 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbMetric{}
+			}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34065,7 +44790,7 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
+func (m *StepBranch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34088,15 +44813,15 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SkyWalkingMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepBranch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SkyWalkingMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepBranch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34124,43 +44849,11 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34188,7 +44881,7 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.GoTo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34525,32 +45218,493 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Backoff = DurationString(dAtA[iNdEx:postIndex])
+			m.Backoff = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = append(m.Status[:0], dAtA[iNdEx:postIndex]...)
+			if m.Status == nil {
+				m.Status = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = StepTimeoutAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StepTimeoutStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StepTimeoutStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StepTimeoutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepIndex", wireType)
+			}
+			m.StepIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickinessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickinessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StickinessCookie) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickinessCookie: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickinessCookie: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
-			}
-			m.Executions = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Executions |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34560,17 +45714,17 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
-		case 12:
+			m.MaxAgeSeconds = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34580,25 +45734,23 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status[:0], dAtA[iNdEx:postIndex]...)
-			if m.Status == nil {
-				m.Status = []byte{}
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34621,95 +45773,6 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StickinessConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StickinessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enabled = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
-			}
-			m.DurationSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *StringMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35136,6 +46199,154 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TemplateContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v12.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TemplateService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35765,6 +46976,26 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Score = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35868,6 +47099,302 @@ func (m *TraefikTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrafficRoutingChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficRoutingChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficRoutingChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrafficStickiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficStickiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficStickiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cookie", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cookie == nil {
+				m.Cookie = &StickinessCookie{}
+			}
+			if err := m.Cookie.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
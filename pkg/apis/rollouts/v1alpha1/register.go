@@ -18,6 +18,9 @@ var (
 	AnalysisTemplateGVR        = SchemeGroupVersion.WithResource("analysistemplates")
 	ClusterAnalysisTemplateGVR = SchemeGroupVersion.WithResource("clusteranalysistemplates")
 	ExperimentGVR              = SchemeGroupVersion.WithResource("experiments")
+
+	RolloutStrategyTemplateGVR        = SchemeGroupVersion.WithResource("rolloutstrategytemplates")
+	ClusterRolloutStrategyTemplateGVR = SchemeGroupVersion.WithResource("clusterrolloutstrategytemplates")
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -48,6 +51,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAnalysisTemplateList{},
 		&AnalysisRun{},
 		&AnalysisRunList{},
+		&RolloutStrategyTemplate{},
+		&RolloutStrategyTemplateList{},
+		&ClusterRolloutStrategyTemplate{},
+		&ClusterRolloutStrategyTemplateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutStrategyTemplate holds a deployment strategy shared by the rollouts of a namespace
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutstrategytemplates,shortName=rst
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type RolloutStrategyTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutStrategyTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutStrategyTemplateList is a list of RolloutStrategyTemplate resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutStrategyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutStrategyTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterRolloutStrategyTemplate holds a deployment strategy shared by the rollouts of all namespaces
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterrolloutstrategytemplates,shortName=crst,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type ClusterRolloutStrategyTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutStrategyTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ClusterRolloutStrategyTemplateList is a list of ClusterRolloutStrategyTemplate resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRolloutStrategyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterRolloutStrategyTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutStrategyTemplateSpec is the specification of a RolloutStrategyTemplate or ClusterRolloutStrategyTemplate resource
+type RolloutStrategyTemplateSpec struct {
+	// Args are the arguments of the template, which are substituted for their {{args.<name>}} placeholders in
+	// the strategy. The value of an argument is its default.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,1,rep,name=args"`
+	// Strategy is the deployment strategy of the rollouts referencing the template
+	Strategy RolloutStrategy `json:"strategy" protobuf:"bytes,2,opt,name=strategy"`
+}
+
+// RolloutStrategyRef references the RolloutStrategyTemplate or ClusterRolloutStrategyTemplate holding the strategy of a rollout
+type RolloutStrategyRef struct {
+	// Name is the name of the template
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ClusterScope references a ClusterRolloutStrategyTemplate instead of a RolloutStrategyTemplate of the namespace
+	// of the rollout
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Args are the values of the arguments of the template
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=args"`
+}
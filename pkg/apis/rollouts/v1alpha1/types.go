@@ -37,6 +37,7 @@ type Rollout struct {
 type RolloutSpec struct {
 	TemplateResolvedFromRef bool `json:"-"`
 	SelectorResolvedFromRef bool `json:"-"`
+	StrategyResolvedFromRef bool `json:"-"`
 	// Number of desired pods. This is a pointer to distinguish between explicit
 	// zero and not specified. Defaults to 1.
 	// +optional
@@ -82,6 +83,19 @@ type RolloutSpec struct {
 	RestartAt *metav1.Time `json:"restartAt,omitempty" protobuf:"bytes,9,opt,name=restartAt"`
 	// Analysis configuration for the analysis runs to retain
 	Analysis *AnalysisRunStrategy `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+	// MultiCluster promotes the rollout through ordered waves of member clusters
+	// +optional
+	MultiCluster *MultiClusterPromotion `json:"multiCluster,omitempty" protobuf:"bytes,14,opt,name=multiCluster"`
+	// DeployWindows restrict the times at which an update of the rollout is allowed to progress
+	// +optional
+	DeployWindows []DeployWindow `json:"deployWindows,omitempty" protobuf:"bytes,15,rep,name=deployWindows"`
+	// DependsOn are the rollouts an update of the rollout waits for before it progresses
+	// +optional
+	DependsOn []RolloutDependency `json:"dependsOn,omitempty" protobuf:"bytes,16,rep,name=dependsOn"`
+	// StrategyRef references the RolloutStrategyTemplate or ClusterRolloutStrategyTemplate holding the strategy of
+	// the rollout, in place of the strategy field
+	// +optional
+	StrategyRef *RolloutStrategyRef `json:"strategyRef,omitempty" protobuf:"bytes,17,opt,name=strategyRef"`
 }
 
 func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
@@ -94,6 +108,11 @@ func (s *RolloutSpec) SetResolvedTemplate(template corev1.PodTemplateSpec) {
 	s.Template = template
 }
 
+func (s *RolloutSpec) SetResolvedStrategy(strategy RolloutStrategy) {
+	s.StrategyResolvedFromRef = true
+	s.Strategy = strategy
+}
+
 func (s *RolloutSpec) EmptyTemplate() bool {
 	if len(s.Template.Labels) > 0 {
 		return false
@@ -107,7 +126,7 @@ func (s *RolloutSpec) EmptyTemplate() bool {
 func (s *RolloutSpec) MarshalJSON() ([]byte, error) {
 	type Alias RolloutSpec
 
-	if s.TemplateResolvedFromRef || s.SelectorResolvedFromRef {
+	if s.TemplateResolvedFromRef || s.SelectorResolvedFromRef || s.StrategyResolvedFromRef {
 		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&struct {
 			Alias `json:",inline"`
 		}{
@@ -122,6 +141,9 @@ func (s *RolloutSpec) MarshalJSON() ([]byte, error) {
 		if s.SelectorResolvedFromRef {
 			unstructured.RemoveNestedField(obj, "selector")
 		}
+		if s.StrategyResolvedFromRef {
+			unstructured.RemoveNestedField(obj, "strategy")
+		}
 
 		return json.Marshal(obj)
 	}
@@ -220,6 +242,24 @@ type BlueGreenStrategy struct {
 	// Default is 30 second
 	// +optional
 	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty" protobuf:"varint,14,opt,name=abortScaleDownDelaySeconds"`
+	// TrafficRouting shifts traffic from the active to the preview ReplicaSet in steps through a traffic
+	// provider before the active service selector is switched
+	// +optional
+	TrafficRouting *BlueGreenTrafficRouting `json:"trafficRouting,omitempty" protobuf:"bytes,15,opt,name=trafficRouting"`
+	// PauseTimeout defines how long the rollout may stay paused before it is promoted, and the action taken once
+	// the pause exceeds it
+	// +optional
+	PauseTimeout *StepTimeout `json:"pauseTimeout,omitempty" protobuf:"bytes,16,opt,name=pauseTimeout"`
+}
+
+// BlueGreenTrafficRouting defines the traffic provider and the weights used to shift traffic to the preview
+// ReplicaSet of a blue-green rollout
+type BlueGreenTrafficRouting struct {
+	RolloutTrafficRouting `json:",inline" protobuf:"bytes,1,opt,name=rolloutTrafficRouting"`
+	// Weights are the percentages of traffic sent to the preview ReplicaSet, in order, before the active
+	// service selector is switched. When postPromotionAnalysis is set, it runs at every weight and has to
+	// succeed before the next weight is set.
+	Weights []int32 `json:"weights" protobuf:"varint,2,rep,name=weights"`
 }
 
 // AntiAffinity defines which inter-pod scheduling rule to use for anti-affinity injection
@@ -316,6 +356,14 @@ type CanaryStrategy struct {
 	// Assuming the desired number of pods in a stable or canary ReplicaSet is not zero, then make sure it is at least
 	// MinPodsPerReplicaSet for High Availability. Only applicable for TrafficRoutedCanary
 	MinPodsPerReplicaSet *int32 `json:"minPodsPerReplicaSet,omitempty" protobuf:"varint,16,opt,name=minPodsPerReplicaSet"`
+	// PostPromotionAnalysis configuration to run analysis once the update is fully promoted. The
+	// rollout is rolled back to the previous stable ReplicaSet when the analysis fails.
+	// +optional
+	PostPromotionAnalysis *RolloutAnalysis `json:"postPromotionAnalysis,omitempty" protobuf:"bytes,17,opt,name=postPromotionAnalysis"`
+	// BakeSeconds is how long the post promotion analysis watches the update once it is fully
+	// promoted, after which the analysis is terminated. Defaults to until the analysis completes.
+	// +optional
+	BakeSeconds *int32 `json:"bakeSeconds,omitempty" protobuf:"varint,18,opt,name=bakeSeconds"`
 }
 
 // PingPongSpec holds the ping and pong service name.
@@ -389,6 +437,39 @@ type RolloutTrafficRouting struct {
 
 	// MaxTrafficWeight The total weight of traffic. If unspecified, it defaults to 100
 	MaxTrafficWeight *int32 `json:"maxTrafficWeight,omitempty" protobuf:"varint,11,opt,name=maxTrafficWeight"`
+	// GatewayAPI holds specific configuration to use Kubernetes Gateway API routes to route traffic
+	GatewayAPI *GatewayAPITrafficRouting `json:"gatewayAPI,omitempty" protobuf:"bytes,12,opt,name=gatewayAPI"`
+	// DryRun computes the changes of the traffic routing objects and records them in the logs, the events
+	// and the status of the rollout instead of applying them. The weights are not verified.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,13,opt,name=dryRun"`
+	// Stickiness pins the users to the stable or the canary version while the traffic is split between both,
+	// so that they do not switch versions from one request to the next. Supported by Istio, Nginx and Traefik
+	// +optional
+	Stickiness *TrafficStickiness `json:"stickiness,omitempty" protobuf:"bytes,14,opt,name=stickiness"`
+}
+
+// TrafficStickiness defines how the traffic router pins the users to the stable or the canary version
+type TrafficStickiness struct {
+	// Cookie pins the users with a cookie which the traffic router sets on the responses
+	// +optional
+	Cookie *StickinessCookie `json:"cookie,omitempty" protobuf:"bytes,1,opt,name=cookie"`
+	// Header pins the users with a header which the traffic router sets on the responses, for the clients
+	// which do not keep cookies and send the header back instead. Only supported by Istio
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+}
+
+// StickinessCookie defines the cookie which pins the users to a version
+type StickinessCookie struct {
+	// Name of the cookie
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MaxAgeSeconds is the lifetime of the cookie. The cookie lasts for the browser session if unset
+	// +optional
+	MaxAgeSeconds *int64 `json:"maxAgeSeconds,omitempty" protobuf:"varint,2,opt,name=maxAgeSeconds"`
+	// Path of the cookie. Defaults to /
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
 }
 
 type MangedRoutes struct {
@@ -417,6 +498,22 @@ type ApisixRoute struct {
 	Rules []string `json:"rules,omitempty" protobuf:"bytes,2,rep,name=rules"`
 }
 
+// GatewayAPITrafficRouting defines the configuration required to use Kubernetes Gateway API routes as traffic router
+type GatewayAPITrafficRouting struct {
+	// HTTPRoute refers to the name of an `HTTPRoute` resource in the same namespace as the `Rollout`
+	// +optional
+	HTTPRoute string `json:"httpRoute,omitempty" protobuf:"bytes,1,opt,name=httpRoute"`
+	// HTTPRoutes refers to the names of `HTTPRoute` resources in the same namespace as the `Rollout` when several routes are used
+	// +optional
+	HTTPRoutes []string `json:"httpRoutes,omitempty" protobuf:"bytes,2,rep,name=httpRoutes"`
+	// GRPCRoute refers to the name of a `GRPCRoute` resource in the same namespace as the `Rollout`
+	// +optional
+	GRPCRoute string `json:"grpcRoute,omitempty" protobuf:"bytes,3,opt,name=grpcRoute"`
+	// TCPRoute refers to the name of a `TCPRoute` resource in the same namespace as the `Rollout`
+	// +optional
+	TCPRoute string `json:"tcpRoute,omitempty" protobuf:"bytes,4,opt,name=tcpRoute"`
+}
+
 // AmbassadorTrafficRouting defines the configuration required to use Ambassador as traffic
 // router
 type AmbassadorTrafficRouting struct {
@@ -548,6 +645,13 @@ type RolloutExperimentStep struct {
 	// AnalysisRunMetadata labels and annotations that will be added to the AnalysisRuns
 	// +optional
 	AnalysisRunMetadata AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,5,opt,name=analysisRunMetadata"`
+	// Comparison compares the templates on the measurements of the analyses to select the winner of the experiment
+	// +optional
+	Comparison *ExperimentComparison `json:"comparison,omitempty" protobuf:"bytes,6,opt,name=comparison"`
+	// PromoteWinner promotes the pod template of the winning template as the canary once the experiment
+	// completed successfully. The rollout pauses if the experiment has no winner
+	// +optional
+	PromoteWinner bool `json:"promoteWinner,omitempty" protobuf:"varint,7,opt,name=promoteWinner"`
 }
 
 type RolloutExperimentStepAnalysisTemplateRef struct {
@@ -586,6 +690,25 @@ type RolloutExperimentTemplate struct {
 	Weight *int32 `json:"weight,omitempty" protobuf:"varint,6,opt,name=weight"`
 	// Service controls the optionally generated service
 	Service *TemplateService `json:"service,omitempty" protobuf:"bytes,7,opt,name=service"`
+	// Containers override the containers of the pod template referenced by SpecRef, to run variants of it
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Containers []TemplateContainer `json:"containers,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,8,rep,name=containers"`
+}
+
+// TemplateContainer overrides a container of the pod template of an experiment template
+type TemplateContainer struct {
+	// Name is the name of the container
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Image overrides the image of the container
+	// +optional
+	Image string `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
+	// Env adds or overrides environment variables of the container
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=env"`
 }
 
 // PodTemplateMetadata extra labels to add to the template
@@ -641,6 +764,73 @@ type CanaryStep struct {
 	SetMirrorRoute *SetMirrorRoute `json:"setMirrorRoute,omitempty" protobuf:"bytes,8,opt,name=setMirrorRoute"`
 	// Plugin defines a plugin to execute for a step
 	Plugin *PluginStep `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
+	// Name of the step, which the rollouts depending on this rollout can refer to
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,10,opt,name=name"`
+	// Shadow mirrors the traffic to a response-diff proxy comparing the responses of the canary with the
+	// responses of the stable, and runs an analysis on the mismatches found by the proxy
+	// +optional
+	Shadow *RolloutShadowStep `json:"shadow,omitempty" protobuf:"bytes,11,opt,name=shadow"`
+	// Timeout defines how long the step may take to complete, and the action taken once the step exceeds it
+	// +optional
+	Timeout *StepTimeout `json:"timeout,omitempty" protobuf:"bytes,12,opt,name=timeout"`
+	// When is an expression which is evaluated once the rollout reaches the step. The step is skipped when the
+	// expression evaluates to false
+	// +optional
+	When string `json:"when,omitempty" protobuf:"bytes,13,opt,name=when"`
+	// Branches are evaluated in order once the step completes. The rollout continues at the step of the first branch
+	// whose expression evaluates to true, instead of the next step. An inconclusive analysis of the step completes
+	// the step when one of the branches matches, instead of pausing the rollout
+	// +optional
+	Branches []StepBranch `json:"branches,omitempty" protobuf:"bytes,14,rep,name=branches"`
+}
+
+// StepBranch makes the rollout continue at another step once a step completes
+type StepBranch struct {
+	// When is the expression which selects the branch
+	When string `json:"when" protobuf:"bytes,1,opt,name=when"`
+	// GoTo is the name of the step the rollout continues at. The step needs to come after the step of the branch
+	GoTo string `json:"goTo" protobuf:"bytes,2,opt,name=goTo"`
+}
+
+// StepTimeoutAction is the action taken when a step exceeds its timeout
+type StepTimeoutAction string
+
+const (
+	// StepTimeoutActionAbort aborts the rollout
+	StepTimeoutActionAbort StepTimeoutAction = "Abort"
+	// StepTimeoutActionPause pauses the rollout until it is resumed
+	StepTimeoutActionPause StepTimeoutAction = "Pause"
+	// StepTimeoutActionSkip skips the step, or promotes a paused blue-green rollout
+	StepTimeoutActionSkip StepTimeoutAction = "Skip"
+)
+
+// StepTimeout defines how long a step may take to complete, and the action taken once the step exceeds it
+type StepTimeout struct {
+	// Duration after which the step times out, e.g. 30m. Supported units: s, m, h
+	Duration DurationString `json:"duration" protobuf:"bytes,1,opt,name=duration,casttype=DurationString"`
+	// Action taken when the step times out. One of Abort, Pause or Skip. Defaults to Abort
+	// +kubebuilder:validation:Enum=Abort;Pause;Skip
+	// +optional
+	Action StepTimeoutAction `json:"action,omitempty" protobuf:"bytes,2,opt,name=action,casttype=StepTimeoutAction"`
+}
+
+// RolloutShadowStep mirrors the matching requests to a response-diff proxy while the analysis of the step runs. The
+// proxy sends the mirrored requests to the stable and the canary and compares their responses. The mirror route is
+// removed once the step completes.
+type RolloutShadowStep struct {
+	// Name of the managed route which mirrors the requests to the proxy. The route needs to be included in the
+	// `spec.strategy.canary.trafficRouting.managedRoutes` field
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Match contains the rules matching the requests to mirror
+	Match []RouteMatch `json:"match" protobuf:"bytes,2,rep,name=match"`
+	// Percentage of the matching requests to mirror. Defaults to 100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,3,opt,name=percentage"`
+	// ProxyService is the name of the Service of the response-diff proxy the requests are mirrored to
+	ProxyService string `json:"proxyService" protobuf:"bytes,4,opt,name=proxyService"`
+	// Analysis defines the AnalysisRun measuring the mismatches of the responses. The step completes once it is successful
+	Analysis RolloutAnalysis `json:"analysis" protobuf:"bytes,5,opt,name=analysis"`
 }
 
 type PluginStep struct {
@@ -662,8 +852,9 @@ type SetMirrorRoute struct {
 	// +optional
 	Match []RouteMatch `json:"match,omitempty" protobuf:"bytes,2,opt,name=match"`
 
-	// Services The list of services to mirror the traffic to if the method, path, headers match
-	//Service string `json:"service" protobuf:"bytes,3,opt,name=service"`
+	// Service is the name of the service to mirror the traffic to. Defaults to the canary service
+	// +optional
+	Service string `json:"service,omitempty" protobuf:"bytes,3,opt,name=service"`
 	// Percentage What percent of the traffic that matched the rules should be mirrored
 	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,4,opt,name=percentage"`
 }
@@ -806,6 +997,8 @@ const (
 	RolloutTypePostPromotionLabel = "PostPromotion"
 	// RolloutCanaryStepIndexLabel indicates which step created this analysisRun
 	RolloutCanaryStepIndexLabel = "step-index"
+	// RolloutBlueGreenWeightIndexLabel indicates which blue-green traffic routing weight created this analysisRun
+	RolloutBlueGreenWeightIndexLabel = "weight-index"
 )
 
 // RolloutPause defines a pause stage for a rollout
@@ -862,6 +1055,16 @@ const (
 	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
 	// PauseReasonBlueGreenPause pause rollout before promoting rollout
 	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonClusterWave pauses rollout until the previous cluster waves are Healthy
+	PauseReasonClusterWave PauseReason = "ClusterWave"
+	// PauseReasonOutsideDeployWindow pauses rollout until the deploy windows allow the update to progress
+	PauseReasonOutsideDeployWindow PauseReason = "OutsideDeployWindow"
+	// PauseReasonDependency pauses rollout until the rollouts it depends on are far enough in their update
+	PauseReasonDependency PauseReason = "Dependency"
+	// PauseReasonQueued pauses rollout until the progression budgets let its update through
+	PauseReasonQueued PauseReason = "Queued"
+	// PauseReasonStepTimeout pauses rollout when a canary step exceeded its timeout
+	PauseReasonStepTimeout PauseReason = "StepTimeout"
 )
 
 // PauseCondition the reason for a pause and when it started
@@ -882,6 +1085,8 @@ const (
 	RolloutPhaseProgressing RolloutPhase = "Progressing"
 	// RolloutPhasePaused indicates a rollout is not yet healthy and will not make progress until unpaused
 	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhaseQueued indicates a rollout update waits for the progression budgets to let it through
+	RolloutPhaseQueued RolloutPhase = "Queued"
 )
 
 // RolloutStatus is the status for a Rollout resource
@@ -969,6 +1174,133 @@ type RolloutStatus struct {
 	ALB *ALBStatus `json:"alb,omitempty" protobuf:"bytes,25,opt,name=alb"`
 	/// ALBs keeps information regarding multiple ALBs and TargetGroups in a multi ingress scenario
 	ALBs []ALBStatus `json:"albs,omitempty" protobuf:"bytes,26,opt,name=albs"`
+	// MultiCluster keeps the last observed state of the rollout in the member clusters
+	// +optional
+	MultiCluster *MultiClusterStatus `json:"multiCluster,omitempty" protobuf:"bytes,27,opt,name=multiCluster"`
+	// IgnoreDeployWindow indicates the current update should progress regardless of the deploy windows
+	// +optional
+	IgnoreDeployWindow bool `json:"ignoreDeployWindow,omitempty" protobuf:"varint,28,opt,name=ignoreDeployWindow"`
+	// Dependencies keeps the last observed state of the rollouts the rollout depends on
+	// +optional
+	Dependencies []RolloutDependencyStatus `json:"dependencies,omitempty" protobuf:"bytes,29,rep,name=dependencies"`
+	// TrafficRoutingDryRun are the changes of the traffic routing objects which were computed in the last
+	// reconciliation but not applied, since the traffic routing of the rollout is in dry-run mode
+	// +optional
+	TrafficRoutingDryRun []TrafficRoutingChange `json:"trafficRoutingDryRun,omitempty" protobuf:"bytes,30,rep,name=trafficRoutingDryRun"`
+}
+
+// TrafficRoutingChange is a change of a traffic routing object which was not applied in dry-run mode
+type TrafficRoutingChange struct {
+	// Operation is the operation which was not made: Create, Update, Patch or Delete, or the method of
+	// a traffic router plugin
+	Operation string `json:"operation" protobuf:"bytes,1,opt,name=operation"`
+	// Kind is the kind of the object, e.g. VirtualService
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the name of the object
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Diff is the JSON merge patch of the object for an update or a patch, the object for a create, or the
+	// arguments of the method of a plugin
+	// +optional
+	Diff string `json:"diff,omitempty" protobuf:"bytes,4,opt,name=diff"`
+}
+
+// DeployWindowKind is the kind of a deploy window
+type DeployWindowKind string
+
+const (
+	// DeployWindowKindAllow only allows updates to progress during the window
+	DeployWindowKindAllow DeployWindowKind = "allow"
+	// DeployWindowKindDeny prevents updates from progressing during the window
+	DeployWindowKindDeny DeployWindowKind = "deny"
+)
+
+// DeployWindow is a recurring time window during which updates of a rollout are allowed or denied.
+// When allow windows are defined, an update only progresses while one of them is active.
+// An active deny window always takes precedence over the allow windows.
+type DeployWindow struct {
+	// Kind is either allow or deny
+	Kind DeployWindowKind `json:"kind" protobuf:"bytes,1,opt,name=kind,casttype=DeployWindowKind"`
+	// Schedule is the cron expression at which the window starts (e.g. "0 22 * * 5")
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is how long the window lasts (e.g. "1h", "48h")
+	Duration string `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone is the IANA time zone of the schedule (e.g. "Europe/Paris"). Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+}
+
+// RolloutDependency is a rollout the update of another rollout waits for. By default the update
+// waits until the dependency is Healthy, which is once its own update is fully promoted.
+type RolloutDependency struct {
+	// Name of the rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace of the rollout. Defaults to the namespace of the depending rollout.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Step is the name of a canary step of the dependency. When set, the update only waits until the
+	// update of the dependency is past that step instead of waiting until it is Healthy.
+	// +optional
+	Step string `json:"step,omitempty" protobuf:"bytes,3,opt,name=step"`
+}
+
+// RolloutDependencyStatus is the last observed state of a rollout dependency
+type RolloutDependencyStatus struct {
+	// Name of the rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace of the rollout
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// Step is the name of the canary step the dependency must be past
+	// +optional
+	Step string `json:"step,omitempty" protobuf:"bytes,3,opt,name=step"`
+	// Phase is the phase of the dependency
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty" protobuf:"bytes,4,opt,name=phase,casttype=RolloutPhase"`
+	// Satisfied indicates the dependency no longer holds back the update
+	Satisfied bool `json:"satisfied" protobuf:"varint,5,opt,name=satisfied"`
+	// Message provides details on why the dependency is not satisfied
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// MultiClusterPromotion defines the ordered waves of member clusters a rollout is promoted through
+type MultiClusterPromotion struct {
+	// Waves is the ordered list of cluster waves. The rollout in a cluster only starts updating to a
+	// new pod template once the same pod template hash is Healthy in every cluster of the previous waves
+	Waves []ClusterWave `json:"waves" protobuf:"bytes,1,rep,name=waves"`
+}
+
+// ClusterWave is a group of member clusters which are promoted at the same time
+type ClusterWave struct {
+	// Name of the wave
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Clusters are the names of the member clusters in the wave, as registered in the member cluster secrets
+	Clusters []string `json:"clusters" protobuf:"bytes,2,rep,name=clusters"`
+}
+
+// MultiClusterStatus contains the state of the rollout across the member clusters
+type MultiClusterStatus struct {
+	// Cluster is the name of the cluster the controller is running in
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,1,opt,name=cluster"`
+	// Wave is the name of the wave the cluster belongs to
+	Wave string `json:"wave,omitempty" protobuf:"bytes,2,opt,name=wave"`
+	// Members is the last observed state of the rollout in the other member clusters
+	Members []MemberClusterStatus `json:"members,omitempty" protobuf:"bytes,3,rep,name=members"`
+}
+
+// MemberClusterStatus is the state of the rollout in a member cluster
+type MemberClusterStatus struct {
+	// Cluster is the name of the member cluster
+	Cluster string `json:"cluster" protobuf:"bytes,1,opt,name=cluster"`
+	// Wave is the name of the wave the member cluster belongs to
+	Wave string `json:"wave" protobuf:"bytes,2,opt,name=wave"`
+	// Phase is the phase of the rollout in the member cluster
+	Phase RolloutPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=RolloutPhase"`
+	// CurrentPodHash is the pod template hash the rollout in the member cluster is updating to
+	CurrentPodHash string `json:"currentPodHash,omitempty" protobuf:"bytes,4,opt,name=currentPodHash"`
+	// Aborted indicates the update was aborted in the member cluster
+	Aborted bool `json:"aborted,omitempty" protobuf:"varint,5,opt,name=aborted"`
+	// Message provides details on the state of the rollout, or why the member cluster could not be reached
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
 }
 
 // BlueGreenStatus status fields that only pertain to the blueGreen rollout
@@ -986,6 +1318,13 @@ type BlueGreenStatus struct {
 	PrePromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"prePromotionAnalysisRunStatus,omitempty" protobuf:"bytes,4,opt,name=prePromotionAnalysisRunStatus"`
 	// PostPromotionAnalysisRunStatus indicates the status of the current post promotion analysis run
 	PostPromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"postPromotionAnalysisRunStatus,omitempty" protobuf:"bytes,5,opt,name=postPromotionAnalysisRunStatus"`
+	// TrafficWeightIndex indicates which of the traffic routing weights is set on the traffic provider.
+	// It equals the number of weights once the traffic shift is complete. Only valid when using traffic routing
+	// +optional
+	TrafficWeightIndex *int32 `json:"trafficWeightIndex,omitempty" protobuf:"varint,6,opt,name=trafficWeightIndex"`
+	// Weights records the weights which have been set on traffic provider. Only valid when using traffic routing
+	// +optional
+	Weights *TrafficWeights `json:"weights,omitempty" protobuf:"bytes,7,opt,name=weights"`
 }
 
 // CanaryStatus status fields that only pertain to the canary rollout
@@ -1002,6 +1341,35 @@ type CanaryStatus struct {
 	StablePingPong PingPongType `json:"stablePingPong,omitempty" protobuf:"bytes,5,opt,name=stablePingPong"`
 	// StepPluginStatuses holds the status of the step plugins executed
 	StepPluginStatuses []StepPluginStatus `json:"stepPluginStatuses,omitempty" protobuf:"bytes,6,rep,name=stepPluginStatuses"`
+	// PostPromotionAnalysisRunStatus indicates the status of the current post promotion analysis run
+	PostPromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"postPromotionAnalysisRunStatus,omitempty" protobuf:"bytes,7,opt,name=postPromotionAnalysisRunStatus"`
+	// BakeStartedAt indicates when the update was fully promoted and its bake period started
+	BakeStartedAt *metav1.Time `json:"bakeStartedAt,omitempty" protobuf:"bytes,8,opt,name=bakeStartedAt"`
+	// RollbackPodHash is the pod template hash of the ReplicaSet the rollout was rolled back to after
+	// the post promotion analysis of an update failed
+	RollbackPodHash string `json:"rollbackPodHash,omitempty" protobuf:"bytes,9,opt,name=rollbackPodHash"`
+	// CurrentStepTimeout tracks the timeout of the current step, when the step has a timeout
+	// +optional
+	CurrentStepTimeout *StepTimeoutStatus `json:"currentStepTimeout,omitempty" protobuf:"bytes,10,opt,name=currentStepTimeout"`
+	// EvaluatedStepIndex is the index of the step whose when expression was last evaluated. The expression of a
+	// step is evaluated once, when the rollout reaches the step
+	// +optional
+	EvaluatedStepIndex *int32 `json:"evaluatedStepIndex,omitempty" protobuf:"varint,11,opt,name=evaluatedStepIndex"`
+	// SkippedSteps are the indexes of the steps of the current update which were skipped, because their when
+	// expression evaluated to false or because a branch jumped over them
+	// +optional
+	SkippedSteps []int32 `json:"skippedSteps,omitempty" protobuf:"varint,12,rep,name=skippedSteps"`
+}
+
+// StepTimeoutStatus tracks the timeout of a canary step
+type StepTimeoutStatus struct {
+	// StepIndex is the index of the step
+	StepIndex int32 `json:"stepIndex" protobuf:"varint,1,opt,name=stepIndex"`
+	// StartedAt indicates when the timeout of the step started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,2,opt,name=startedAt"`
+	// TimedOut indicates the step exceeded its timeout
+	// +optional
+	TimedOut bool `json:"timedOut,omitempty" protobuf:"varint,3,opt,name=timedOut"`
 }
 
 type PingPongType string
@@ -1154,6 +1522,15 @@ const (
 	// RolloutHealthy means that rollout is in a completed state and is healthy. Which means that all the pods have been updated
 	// and are passing their health checks and are ready to serve traffic.
 	RolloutHealthy RolloutConditionType = "Healthy"
+	// RolloutRolledBack means that the rollout was rolled back to the previous stable ReplicaSet
+	// after the post promotion analysis of an update failed.
+	RolloutRolledBack RolloutConditionType = "RolledBack"
+	// RolloutWeightVerified means that the traffic router verified the traffic weights. It is only added
+	// while weights are not yet verified, and a weight verify timeout is configured.
+	RolloutWeightVerified RolloutConditionType = "WeightVerified"
+	// RolloutStepTimedOut means that a canary step or the pause of a blue-green rollout exceeded its timeout
+	// during the current update.
+	RolloutStepTimedOut RolloutConditionType = "StepTimedOut"
 )
 
 // RolloutCondition describes the state of a rollout at a certain point.
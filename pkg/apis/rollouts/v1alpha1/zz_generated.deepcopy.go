@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	json "encoding/json"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -692,6 +693,16 @@ func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
 		*out = new(RolloutAnalysisRunStatus)
 		**out = **in
 	}
+	if in.TrafficWeightIndex != nil {
+		in, out := &in.TrafficWeightIndex, &out.TrafficWeightIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = new(TrafficWeights)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -763,6 +774,16 @@ func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TrafficRouting != nil {
+		in, out := &in.TrafficRouting, &out.TrafficRouting
+		*out = new(BlueGreenTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PauseTimeout != nil {
+		in, out := &in.PauseTimeout, &out.PauseTimeout
+		*out = new(StepTimeout)
+		**out = **in
+	}
 	return
 }
 
@@ -776,6 +797,44 @@ func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenTrafficRouting) DeepCopyInto(out *BlueGreenTrafficRouting) {
+	*out = *in
+	in.RolloutTrafficRouting.DeepCopyInto(&out.RolloutTrafficRouting)
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenTrafficRouting.
+func (in *BlueGreenTrafficRouting) DeepCopy() *BlueGreenTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnRateMetric) DeepCopyInto(out *BurnRateMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnRateMetric.
+func (in *BurnRateMetric) DeepCopy() *BurnRateMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(BurnRateMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 	*out = *in
@@ -801,6 +860,30 @@ func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PostPromotionAnalysisRunStatus != nil {
+		in, out := &in.PostPromotionAnalysisRunStatus, &out.PostPromotionAnalysisRunStatus
+		*out = new(RolloutAnalysisRunStatus)
+		**out = **in
+	}
+	if in.BakeStartedAt != nil {
+		in, out := &in.BakeStartedAt, &out.BakeStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentStepTimeout != nil {
+		in, out := &in.CurrentStepTimeout, &out.CurrentStepTimeout
+		*out = new(StepTimeoutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvaluatedStepIndex != nil {
+		in, out := &in.EvaluatedStepIndex, &out.EvaluatedStepIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SkippedSteps != nil {
+		in, out := &in.SkippedSteps, &out.SkippedSteps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -857,6 +940,21 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(PluginStep)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(RolloutShadowStep)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(StepTimeout)
+		**out = **in
+	}
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]StepBranch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -940,6 +1038,16 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PostPromotionAnalysis != nil {
+		in, out := &in.PostPromotionAnalysis, &out.PostPromotionAnalysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BakeSeconds != nil {
+		in, out := &in.BakeSeconds, &out.BakeSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1137,6 +1245,124 @@ func (in *ClusterAnalysisTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutStrategyTemplate) DeepCopyInto(out *ClusterRolloutStrategyTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStrategyTemplate.
+func (in *ClusterRolloutStrategyTemplate) DeepCopy() *ClusterRolloutStrategyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutStrategyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutStrategyTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopyInto(out *ClusterRolloutStrategyTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRolloutStrategyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStrategyTemplateList.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopy() *ClusterRolloutStrategyTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutStrategyTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterWave) DeepCopyInto(out *ClusterWave) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWave.
+func (in *ClusterWave) DeepCopy() *ClusterWave {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComparisonAnalysis) DeepCopyInto(out *ComparisonAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComparisonAnalysis.
+func (in *ComparisonAnalysis) DeepCopy() *ComparisonAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ComparisonAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComparisonMetric) DeepCopyInto(out *ComparisonMetric) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComparisonMetric.
+func (in *ComparisonMetric) DeepCopy() *ComparisonMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ComparisonMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -1160,6 +1386,22 @@ func (in *DatadogMetric) DeepCopy() *DatadogMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployWindow) DeepCopyInto(out *DeployWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployWindow.
+func (in *DeployWindow) DeepCopy() *DeployWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DryRun) DeepCopyInto(out *DryRun) {
 	*out = *in
@@ -1176,6 +1418,22 @@ func (in *DryRun) DeepCopy() *DryRun {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchQuery) DeepCopyInto(out *ElasticsearchQuery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchQuery.
+func (in *ElasticsearchQuery) DeepCopy() *ElasticsearchQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Experiment) DeepCopyInto(out *Experiment) {
 	*out = *in
@@ -1243,6 +1501,34 @@ func (in *ExperimentAnalysisTemplateRef) DeepCopy() *ExperimentAnalysisTemplateR
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentComparison) DeepCopyInto(out *ExperimentComparison) {
+	*out = *in
+	if in.Analyses != nil {
+		in, out := &in.Analyses, &out.Analyses
+		*out = make([]ComparisonAnalysis, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]ComparisonMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentComparison.
+func (in *ExperimentComparison) DeepCopy() *ExperimentComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentCondition) DeepCopyInto(out *ExperimentCondition) {
 	*out = *in
@@ -1332,6 +1618,11 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		copy(*out, *in)
 	}
 	in.AnalysisRunMetadata.DeepCopyInto(&out.AnalysisRunMetadata)
+	if in.Comparison != nil {
+		in, out := &in.Comparison, &out.Comparison
+		*out = new(ExperimentComparison)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1401,81 +1692,144 @@ func (in *FieldRef) DeepCopy() *FieldRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GraphiteMetric) DeepCopyInto(out *GraphiteMetric) {
+func (in *GRPCProbe) DeepCopyInto(out *GRPCProbe) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphiteMetric.
-func (in *GraphiteMetric) DeepCopy() *GraphiteMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCProbe.
+func (in *GRPCProbe) DeepCopy() *GRPCProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(GraphiteMetric)
+	out := new(GRPCProbe)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HeaderRoutingMatch) DeepCopyInto(out *HeaderRoutingMatch) {
+func (in *GatewayAPITrafficRouting) DeepCopyInto(out *GatewayAPITrafficRouting) {
 	*out = *in
-	if in.HeaderValue != nil {
-		in, out := &in.HeaderValue, &out.HeaderValue
-		*out = new(StringMatch)
-		**out = **in
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderRoutingMatch.
-func (in *HeaderRoutingMatch) DeepCopy() *HeaderRoutingMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPITrafficRouting.
+func (in *GatewayAPITrafficRouting) DeepCopy() *GatewayAPITrafficRouting {
 	if in == nil {
 		return nil
 	}
-	out := new(HeaderRoutingMatch)
+	out := new(GatewayAPITrafficRouting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfluxdbMetric) DeepCopyInto(out *InfluxdbMetric) {
+func (in *GraphiteMetric) DeepCopyInto(out *GraphiteMetric) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxdbMetric.
-func (in *InfluxdbMetric) DeepCopy() *InfluxdbMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphiteMetric.
+func (in *GraphiteMetric) DeepCopy() *GraphiteMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(InfluxdbMetric)
+	out := new(GraphiteMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IstioDestinationRule) DeepCopyInto(out *IstioDestinationRule) {
+func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessStatusCodes != nil {
+		in, out := &in.SuccessStatusCodes, &out.SuccessStatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioDestinationRule.
-func (in *IstioDestinationRule) DeepCopy() *IstioDestinationRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
+func (in *HTTPProbe) DeepCopy() *HTTPProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(IstioDestinationRule)
+	out := new(HTTPProbe)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IstioTrafficRouting) DeepCopyInto(out *IstioTrafficRouting) {
+func (in *HeaderRoutingMatch) DeepCopyInto(out *HeaderRoutingMatch) {
 	*out = *in
-	if in.VirtualService != nil {
-		in, out := &in.VirtualService, &out.VirtualService
-		*out = new(IstioVirtualService)
-		(*in).DeepCopyInto(*out)
+	if in.HeaderValue != nil {
+		in, out := &in.HeaderValue, &out.HeaderValue
+		*out = new(StringMatch)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderRoutingMatch.
+func (in *HeaderRoutingMatch) DeepCopy() *HeaderRoutingMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderRoutingMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfluxdbMetric) DeepCopyInto(out *InfluxdbMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxdbMetric.
+func (in *InfluxdbMetric) DeepCopy() *InfluxdbMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(InfluxdbMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioDestinationRule) DeepCopyInto(out *IstioDestinationRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioDestinationRule.
+func (in *IstioDestinationRule) DeepCopy() *IstioDestinationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioDestinationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioTrafficRouting) DeepCopyInto(out *IstioTrafficRouting) {
+	*out = *in
+	if in.VirtualService != nil {
+		in, out := &in.VirtualService, &out.VirtualService
+		*out = new(IstioVirtualService)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DestinationRule != nil {
 		in, out := &in.DestinationRule, &out.DestinationRule
@@ -1609,6 +1963,54 @@ func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMetric) DeepCopyInto(out *LogMetric) {
+	*out = *in
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiQuery)
+		**out = **in
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchQuery)
+		**out = **in
+	}
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetric.
+func (in *LogMetric) DeepCopy() *LogMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiQuery) DeepCopyInto(out *LokiQuery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiQuery.
+func (in *LokiQuery) DeepCopy() *LokiQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
 	*out = *in
@@ -1676,6 +2078,22 @@ func (in *MeasurementRetention) DeepCopy() *MeasurementRetention {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemberClusterStatus) DeepCopyInto(out *MemberClusterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberClusterStatus.
+func (in *MemberClusterStatus) DeepCopy() *MemberClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metric) DeepCopyInto(out *Metric) {
 	*out = *in
@@ -1700,6 +2118,11 @@ func (in *Metric) DeepCopyInto(out *Metric) {
 		**out = **in
 	}
 	in.Provider.DeepCopyInto(&out.Provider)
+	if in.BurnRate != nil {
+		in, out := &in.BurnRate, &out.BurnRate
+		*out = new(BurnRateMetric)
+		**out = **in
+	}
 	return
 }
 
@@ -1771,6 +2194,21 @@ func (in *MetricProvider) DeepCopyInto(out *MetricProvider) {
 		*out = new(SkyWalkingMetric)
 		**out = **in
 	}
+	if in.Statistical != nil {
+		in, out := &in.Statistical, &out.Statistical
+		*out = new(StatisticalMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probe != nil {
+		in, out := &in.Probe, &out.Probe
+		*out = new(ProbeMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(LogMetric)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Plugin != nil {
 		in, out := &in.Plugin, &out.Plugin
 		*out = make(map[string]json.RawMessage, len(*in))
@@ -1829,6 +2267,50 @@ func (in *MetricResult) DeepCopy() *MetricResult {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterPromotion) DeepCopyInto(out *MultiClusterPromotion) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ClusterWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterPromotion.
+func (in *MultiClusterPromotion) DeepCopy() *MultiClusterPromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterPromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterStatus) DeepCopyInto(out *MultiClusterStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]MemberClusterStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterStatus.
+func (in *MultiClusterStatus) DeepCopy() *MultiClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NewRelicMetric) DeepCopyInto(out *NewRelicMetric) {
 	*out = *in
@@ -2022,6 +2504,37 @@ func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *Preferred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeMetric) DeepCopyInto(out *ProbeMetric) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCProbe)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeMetric.
+func (in *ProbeMetric) DeepCopy() *ProbeMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
 	*out = *in
@@ -2225,6 +2738,38 @@ func (in *RolloutCondition) DeepCopy() *RolloutCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutDependency) DeepCopyInto(out *RolloutDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutDependency.
+func (in *RolloutDependency) DeepCopy() *RolloutDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutDependencyStatus) DeepCopyInto(out *RolloutDependencyStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutDependencyStatus.
+func (in *RolloutDependencyStatus) DeepCopy() *RolloutDependencyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutDependencyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutExperimentStep) DeepCopyInto(out *RolloutExperimentStep) {
 	*out = *in
@@ -2248,6 +2793,11 @@ func (in *RolloutExperimentStep) DeepCopyInto(out *RolloutExperimentStep) {
 		copy(*out, *in)
 	}
 	in.AnalysisRunMetadata.DeepCopyInto(&out.AnalysisRunMetadata)
+	if in.Comparison != nil {
+		in, out := &in.Comparison, &out.Comparison
+		*out = new(ExperimentComparison)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2308,6 +2858,13 @@ func (in *RolloutExperimentTemplate) DeepCopyInto(out *RolloutExperimentTemplate
 		*out = new(TemplateService)
 		**out = **in
 	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]TemplateContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2375,6 +2932,35 @@ func (in *RolloutPause) DeepCopy() *RolloutPause {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutShadowStep) DeepCopyInto(out *RolloutShadowStep) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]RouteMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	in.Analysis.DeepCopyInto(&out.Analysis)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutShadowStep.
+func (in *RolloutShadowStep) DeepCopy() *RolloutShadowStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutShadowStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -2419,6 +3005,26 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		*out = new(AnalysisRunStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MultiCluster != nil {
+		in, out := &in.MultiCluster, &out.MultiCluster
+		*out = new(MultiClusterPromotion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeployWindows != nil {
+		in, out := &in.DeployWindows, &out.DeployWindows
+		*out = make([]DeployWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]RolloutDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.StrategyRef != nil {
+		in, out := &in.StrategyRef, &out.StrategyRef
+		*out = new(RolloutStrategyRef)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2479,6 +3085,21 @@ func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 		*out = make([]ALBStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.MultiCluster != nil {
+		in, out := &in.MultiCluster, &out.MultiCluster
+		*out = new(MultiClusterStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]RolloutDependencyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrafficRoutingDryRun != nil {
+		in, out := &in.TrafficRoutingDryRun, &out.TrafficRoutingDryRun
+		*out = make([]TrafficRoutingChange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2518,6 +3139,113 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyRef) DeepCopyInto(out *RolloutStrategyRef) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]Argument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyRef.
+func (in *RolloutStrategyRef) DeepCopy() *RolloutStrategyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplate) DeepCopyInto(out *RolloutStrategyTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplate.
+func (in *RolloutStrategyTemplate) DeepCopy() *RolloutStrategyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutStrategyTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplateList) DeepCopyInto(out *RolloutStrategyTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutStrategyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplateList.
+func (in *RolloutStrategyTemplateList) DeepCopy() *RolloutStrategyTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutStrategyTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplateSpec) DeepCopyInto(out *RolloutStrategyTemplateSpec) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]Argument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplateSpec.
+func (in *RolloutStrategyTemplateSpec) DeepCopy() *RolloutStrategyTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 	*out = *in
@@ -2586,6 +3314,16 @@ func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(GatewayAPITrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stickiness != nil {
+		in, out := &in.Stickiness, &out.Stickiness
+		*out = new(TrafficStickiness)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2805,6 +3543,120 @@ func (in *SkyWalkingMetric) DeepCopy() *SkyWalkingMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatisticalComparison) DeepCopyInto(out *StatisticalComparison) {
+	*out = *in
+	in.Baseline.DeepCopyInto(&out.Baseline)
+	in.Canary.DeepCopyInto(&out.Canary)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatisticalComparison.
+func (in *StatisticalComparison) DeepCopy() *StatisticalComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(StatisticalComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatisticalMetric) DeepCopyInto(out *StatisticalMetric) {
+	*out = *in
+	if in.Comparisons != nil {
+		in, out := &in.Comparisons, &out.Comparisons
+		*out = make([]StatisticalComparison, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfidenceLevel != nil {
+		in, out := &in.ConfidenceLevel, &out.ConfidenceLevel
+		*out = new(int64)
+		**out = **in
+	}
+	out.Threshold = in.Threshold
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatisticalMetric.
+func (in *StatisticalMetric) DeepCopy() *StatisticalMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(StatisticalMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatisticalQuery) DeepCopyInto(out *StatisticalQuery) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wavefront != nil {
+		in, out := &in.Wavefront, &out.Wavefront
+		*out = new(WavefrontMetric)
+		**out = **in
+	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(NewRelicMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Graphite != nil {
+		in, out := &in.Graphite, &out.Graphite
+		*out = new(GraphiteMetric)
+		**out = **in
+	}
+	if in.Influxdb != nil {
+		in, out := &in.Influxdb, &out.Influxdb
+		*out = new(InfluxdbMetric)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatisticalQuery.
+func (in *StatisticalQuery) DeepCopy() *StatisticalQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(StatisticalQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepBranch) DeepCopyInto(out *StepBranch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepBranch.
+func (in *StepBranch) DeepCopy() *StepBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(StepBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepPluginStatus) DeepCopyInto(out *StepPluginStatus) {
 	*out = *in
@@ -2838,6 +3690,39 @@ func (in *StepPluginStatus) DeepCopy() *StepPluginStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepTimeout) DeepCopyInto(out *StepTimeout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepTimeout.
+func (in *StepTimeout) DeepCopy() *StepTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(StepTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepTimeoutStatus) DeepCopyInto(out *StepTimeoutStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepTimeoutStatus.
+func (in *StepTimeoutStatus) DeepCopy() *StepTimeoutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StepTimeoutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StickinessConfig) DeepCopyInto(out *StickinessConfig) {
 	*out = *in
@@ -2854,6 +3739,27 @@ func (in *StickinessConfig) DeepCopy() *StickinessConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StickinessCookie) DeepCopyInto(out *StickinessCookie) {
+	*out = *in
+	if in.MaxAgeSeconds != nil {
+		in, out := &in.MaxAgeSeconds, &out.MaxAgeSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StickinessCookie.
+func (in *StickinessCookie) DeepCopy() *StickinessCookie {
+	if in == nil {
+		return nil
+	}
+	out := new(StickinessCookie)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringMatch) DeepCopyInto(out *StringMatch) {
 	*out = *in
@@ -2938,6 +3844,29 @@ func (in *TTLStrategy) DeepCopy() *TTLStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateContainer) DeepCopyInto(out *TemplateContainer) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateContainer.
+func (in *TemplateContainer) DeepCopy() *TemplateContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateService) DeepCopyInto(out *TemplateService) {
 	*out = *in
@@ -2998,6 +3927,11 @@ func (in *TemplateStatus) DeepCopyInto(out *TemplateStatus) {
 		in, out := &in.LastTransitionTime, &out.LastTransitionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -3027,6 +3961,43 @@ func (in *TraefikTrafficRouting) DeepCopy() *TraefikTrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficRoutingChange) DeepCopyInto(out *TrafficRoutingChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficRoutingChange.
+func (in *TrafficRoutingChange) DeepCopy() *TrafficRoutingChange {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficRoutingChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficStickiness) DeepCopyInto(out *TrafficStickiness) {
+	*out = *in
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(StickinessCookie)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficStickiness.
+func (in *TrafficStickiness) DeepCopy() *TrafficStickiness {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficStickiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficWeights) DeepCopyInto(out *TrafficWeights) {
 	*out = *in
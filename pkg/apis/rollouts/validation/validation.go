@@ -20,6 +20,9 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/dependency"
+	"github.com/argoproj/argo-rollouts/utils/deploywindow"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
@@ -35,9 +38,9 @@ const (
 	// InvalidSetCanaryScaleTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
 	InvalidSetCanaryScaleTrafficPolicy = "SetCanaryScale requires TrafficRouting to be set"
 	// InvalidSetHeaderRouteTrafficPolicy indicates that TrafficRouting required for SetHeaderRoute is missing
-	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix"
+	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix and Gateway API"
 	// InvalidSetMirrorRouteTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
-	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio only"
+	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio and Gateway API"
 	// InvalidStringMatchMultipleValuePolicy indicates that SetCanaryScale, has multiple values set
 	InvalidStringMatchMultipleValuePolicy = "StringMatch match value must have exactly one of the following: exact, regex, prefix"
 	// InvalidStringMatchMissedValuePolicy indicates that SetCanaryScale, has multiple values set
@@ -64,6 +67,12 @@ const (
 	ScaleDownLimitLargerThanRevisionLimit = "This rollout's revision history limit can not be smaller than the rollout's scale down limit"
 	// InvalidTrafficRoutingMessage indicates that both canary and stable service must be set to use Traffic Routing
 	InvalidTrafficRoutingMessage = "Canary service and Stable service must to be set to use Traffic Routing"
+	// InvalidBlueGreenTrafficRoutingMessage indicates that the preview service must be set to use Traffic Routing with the blue-green strategy
+	InvalidBlueGreenTrafficRoutingMessage = "Preview service must be set to use Traffic Routing"
+	// InvalidBlueGreenTrafficRoutingProviderMessage indicates that the traffic routing of the blue-green strategy does not set any traffic provider
+	InvalidBlueGreenTrafficRoutingProviderMessage = "Traffic Routing of the blue-green strategy must set a traffic provider"
+	// InvalidBlueGreenTrafficWeightMessage indicates the blue-green traffic routing weights need to be between 0 and max weight
+	InvalidBlueGreenTrafficWeightMessage = "Traffic routing weights need to be between 0 and %d"
 	// InvalidAnalysisArgsMessage indicates that arguments provided in analysis steps are refrencing un-supported metadatafield.
 	//supported fields are "metadata.annotations", "metadata.labels", "metadata.name", "metadata.namespace", "metadata.uid"
 	InvalidAnalysisArgsMessage = "Analyses arguments must refer to valid object metadata supported by downwardAPI"
@@ -86,6 +95,62 @@ const (
 	// InvalideStepRouteNameNotFoundInManagedRoutes A step has been configured that requires managedRoutes and the route name
 	// is missing from managedRoutes
 	InvalideStepRouteNameNotFoundInManagedRoutes = "Steps define a route that does not exist in spec.strategy.canary.trafficRouting.managedRoutes"
+	// DuplicatedClusterWaveMessage indicates that a wave name is used by multiple waves
+	DuplicatedClusterWaveMessage = "Wave '%s' is defined multiple times"
+	// DuplicatedWaveClusterMessage indicates that a cluster is listed in multiple waves
+	DuplicatedWaveClusterMessage = "Cluster '%s' is listed in multiple waves"
+	// InvalidDeployWindowMessage indicates that a deploy window is invalid
+	InvalidDeployWindowMessage = "Invalid deploy window: %v"
+	// DuplicatedStepNameMessage indicates that a step name is used by multiple canary steps
+	DuplicatedStepNameMessage = "Step name '%s' is used by multiple steps"
+	// DuplicatedDependencyMessage indicates that a rollout is listed multiple times in the dependencies
+	DuplicatedDependencyMessage = "Rollout '%s' is listed multiple times in the dependencies"
+	// SelfDependencyMessage indicates that a rollout depends on itself
+	SelfDependencyMessage = "Rollout cannot depend on itself"
+	// InvalidCanaryBakeSecondsMessage indicates that canary.bakeSeconds is set without a post promotion analysis
+	InvalidCanaryBakeSecondsMessage = "Canary bakeSeconds can only be used with postPromotionAnalysis"
+	// InvalidCanaryPostPromotionAnalysisWorkloadRefMessage indicates that canary.postPromotionAnalysis cannot be used with a workloadRef
+	InvalidCanaryPostPromotionAnalysisWorkloadRefMessage = "Canary postPromotionAnalysis cannot be used with workloadRef since the rollout is rolled back by updating its template"
+	// InvalidStickinessMessage indicates that the stickiness of the traffic routing must have exactly one of cookie or header set
+	InvalidStickinessMessage = "Stickiness must have exactly one of the following set: cookie, header"
+	// InvalidStickinessTrafficRouterMessage indicates that the stickiness is not supported by the traffic router
+	InvalidStickinessTrafficRouterMessage = "Stickiness in traffic routing only supported in Istio, Nginx and Traefik"
+	// InvalidStickinessHeaderMessage indicates that the stickiness header is only supported by Istio
+	InvalidStickinessHeaderMessage = "Stickiness header only supported in Istio, use a cookie instead"
+	// InvalidStickinessIstioRoutesMessage indicates that the stickiness requires the HTTP routes of the Istio virtual services to be listed
+	InvalidStickinessIstioRoutesMessage = "Stickiness with Istio requires the HTTP routes of the virtual services to be listed in routes"
+	// InvalidExperimentPromoteWinnerMessage indicates that the winner of an experiment step is promoted without a comparison
+	InvalidExperimentPromoteWinnerMessage = "Experiment promoteWinner requires a comparison of the templates"
+	// InvalidExperimentPromoteWinnerWorkloadRefMessage indicates that the winner of an experiment step cannot be promoted with a workloadRef
+	InvalidExperimentPromoteWinnerWorkloadRefMessage = "Experiment promoteWinner cannot be used with workloadRef since the winner is promoted by updating the template of the rollout"
+	// InvalidExperimentComparisonMessage indicates that the comparison of an experiment step does not compare two templates on a metric
+	InvalidExperimentComparisonMessage = "Experiment comparison must compare at least two templates on at least one metric"
+	// InvalidExperimentComparisonTemplateMessage indicates that the comparison of an experiment step references an unknown or repeated template
+	InvalidExperimentComparisonTemplateMessage = "Experiment comparison must reference each template of the experiment step at most once"
+	// InvalidExperimentComparisonAnalysisMessage indicates that the comparison of an experiment step references an unknown analysis
+	InvalidExperimentComparisonAnalysisMessage = "Experiment comparison must reference an analysis of the experiment step"
+	// InvalidExperimentComparisonMetricWeightMessage indicates that a metric of the comparison of an experiment step has a weight lower than 1
+	InvalidExperimentComparisonMetricWeightMessage = "Experiment comparison metric weight must be at least 1"
+	// InvalidExperimentTemplateContainerMessage indicates that an experiment template overrides a container which is not in the pod template
+	InvalidExperimentTemplateContainerMessage = "Experiment template containers must reference a container of the pod template"
+	// InvalidShadowTrafficRoutingMessage indicates that TrafficRouting, required for a shadow step, is missing
+	InvalidShadowTrafficRoutingMessage = "Shadow step requires TrafficRouting, supports Istio and Gateway API"
+	// InvalidShadowMatchMessage indicates that a shadow step does not match the requests to mirror
+	InvalidShadowMatchMessage = "Shadow step must match the requests to mirror"
+	// InvalidShadowPercentageMessage indicates that the percentage of the requests mirrored by a shadow step is out of range
+	InvalidShadowPercentageMessage = "Shadow step percentage must be between 1 and 100"
+	// InvalidShadowAnalysisMessage indicates that a shadow step does not run an analysis
+	InvalidShadowAnalysisMessage = "Shadow step analysis must reference at least one template"
+	// InvalidStepTimeoutDurationMessage indicates that the duration of a step timeout is not a positive duration
+	InvalidStepTimeoutDurationMessage = "Step timeout duration must be a positive duration, e.g. 30m"
+	// InvalidStepTimeoutActionMessage indicates that the action of a step timeout is unknown
+	InvalidStepTimeoutActionMessage = "Step timeout action must be one of Abort, Pause or Skip"
+	// InvalidPauseTimeoutMessage indicates that a blue-green rollout with a pause timeout is never paused by the controller
+	InvalidPauseTimeoutMessage = "pauseTimeout requires autoPromotionEnabled to be false or autoPromotionSeconds to be set"
+	// InvalidStepConditionMessage indicates that the when expression of a step or of a branch does not compile
+	InvalidStepConditionMessage = "Step expression is invalid: %v"
+	// InvalidStepBranchGoToMessage indicates that a branch does not jump forward to a named step
+	InvalidStepBranchGoToMessage = "Step branch must go to the name of a step which comes after the step of the branch"
 )
 
 // allowAllPodValidationOptions allows all pod options to be true for the purposes of rollout pod
@@ -176,10 +241,80 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 	}
 
 	allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, ValidateRolloutMultiCluster(spec.MultiCluster, fldPath.Child("multiCluster"))...)
+	allErrs = append(allErrs, ValidateRolloutDeployWindows(spec.DeployWindows, fldPath.Child("deployWindows"))...)
+	allErrs = append(allErrs, ValidateRolloutDependencies(rollout, fldPath.Child("dependsOn"))...)
 
 	return allErrs
 }
 
+// ValidateRolloutMultiCluster checks that every wave has a unique name and at least one cluster, and
+// that every cluster belongs to a single wave
+func ValidateRolloutMultiCluster(mc *v1alpha1.MultiClusterPromotion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if mc == nil {
+		return allErrs
+	}
+	if len(mc.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), fmt.Sprintf(MissingFieldMessage, ".spec.multiCluster.waves")))
+	}
+	waves := map[string]bool{}
+	clusters := map[string]bool{}
+	for i, wave := range mc.Waves {
+		waveFldPath := fldPath.Child("waves").Index(i)
+		if wave.Name == "" {
+			allErrs = append(allErrs, field.Required(waveFldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, ".spec.multiCluster.waves.name")))
+		} else if waves[wave.Name] {
+			allErrs = append(allErrs, field.Invalid(waveFldPath.Child("name"), wave.Name, fmt.Sprintf(DuplicatedClusterWaveMessage, wave.Name)))
+		}
+		waves[wave.Name] = true
+		if len(wave.Clusters) == 0 {
+			allErrs = append(allErrs, field.Required(waveFldPath.Child("clusters"), fmt.Sprintf(MissingFieldMessage, ".spec.multiCluster.waves.clusters")))
+		}
+		for j, cluster := range wave.Clusters {
+			if cluster == "" {
+				allErrs = append(allErrs, field.Required(waveFldPath.Child("clusters").Index(j), fmt.Sprintf(MissingFieldMessage, ".spec.multiCluster.waves.clusters")))
+			} else if clusters[cluster] {
+				allErrs = append(allErrs, field.Invalid(waveFldPath.Child("clusters").Index(j), cluster, fmt.Sprintf(DuplicatedWaveClusterMessage, cluster)))
+			}
+			clusters[cluster] = true
+		}
+	}
+	return allErrs
+}
+
+// ValidateRolloutDeployWindows checks that the kind, schedule, duration and time zone of every deploy window are valid
+func ValidateRolloutDeployWindows(windows []v1alpha1.DeployWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, w := range windows {
+		if err := deploywindow.Validate(w); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), w, fmt.Sprintf(InvalidDeployWindowMessage, err)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateRolloutDependencies checks that every dependency names another rollout, which is only listed once
+func ValidateRolloutDependencies(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	deps := map[string]bool{}
+	for i, dep := range rollout.Spec.DependsOn {
+		depFldPath := fldPath.Index(i)
+		if dep.Name == "" {
+			allErrs = append(allErrs, field.Required(depFldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, ".spec.dependsOn.name")))
+			continue
+		}
+		key := dependency.Key(dep, rollout.Namespace)
+		if key == rollout.Namespace+"/"+rollout.Name {
+			allErrs = append(allErrs, field.Invalid(depFldPath.Child("name"), dep.Name, SelfDependencyMessage))
+		} else if deps[key] {
+			allErrs = append(allErrs, field.Invalid(depFldPath.Child("name"), dep.Name, fmt.Sprintf(DuplicatedDependencyMessage, key)))
+		}
+		deps[key] = true
+	}
+	return allErrs
+}
+
 // removeSecurityContextPrivileged removes the privileged value on containers for the purposes of
 // validation. This is necessary because the k8s ValidateSecurityContext library which we reuse,
 // calls k8s.io/kubernetes/pkg/capabilities.Get(), which determines the security capabilities at a
@@ -225,6 +360,176 @@ func ValidateRolloutStrategyBlueGreen(rollout *v1alpha1.Rollout, fldPath *field.
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelayRevisionLimit"), *blueGreen.ScaleDownDelayRevisionLimit, ScaleDownLimitLargerThanRevisionLimit))
 	}
 	allErrs = append(allErrs, ValidateRolloutStrategyAntiAffinity(blueGreen.AntiAffinity, fldPath.Child("antiAffinity"))...)
+	if blueGreen.TrafficRouting != nil {
+		if blueGreen.PreviewService == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("previewService"), blueGreen.PreviewService, InvalidBlueGreenTrafficRoutingMessage))
+		}
+		if !hasTrafficRoutingProvider(&blueGreen.TrafficRouting.RolloutTrafficRouting) {
+			allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting"), InvalidBlueGreenTrafficRoutingProviderMessage))
+		}
+		maxTrafficWeight := int32(100)
+		if blueGreen.TrafficRouting.MaxTrafficWeight != nil {
+			maxTrafficWeight = *blueGreen.TrafficRouting.MaxTrafficWeight
+		}
+		for i, weight := range blueGreen.TrafficRouting.Weights {
+			if weight < 0 || weight > maxTrafficWeight {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting").Child("weights").Index(i), weight, fmt.Sprintf(InvalidBlueGreenTrafficWeightMessage, maxTrafficWeight)))
+			}
+		}
+		allErrs = append(allErrs, ValidateTrafficStickiness(&blueGreen.TrafficRouting.RolloutTrafficRouting, fldPath.Child("trafficRouting").Child("stickiness"))...)
+	}
+	if blueGreen.PauseTimeout != nil {
+		autoPromotionEnabled := blueGreen.AutoPromotionEnabled == nil || *blueGreen.AutoPromotionEnabled
+		if autoPromotionEnabled && blueGreen.AutoPromotionSeconds == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pauseTimeout"), blueGreen.PauseTimeout.Duration, InvalidPauseTimeoutMessage))
+		}
+		allErrs = append(allErrs, ValidateStepTimeout(blueGreen.PauseTimeout, fldPath.Child("pauseTimeout"))...)
+	}
+	return allErrs
+}
+
+// hasTrafficRoutingProvider returns whether the traffic routing sets a traffic provider or plugin
+func hasTrafficRoutingProvider(trafficRouting *v1alpha1.RolloutTrafficRouting) bool {
+	return trafficRouting.Istio != nil ||
+		trafficRouting.Nginx != nil ||
+		trafficRouting.ALB != nil ||
+		trafficRouting.SMI != nil ||
+		trafficRouting.Ambassador != nil ||
+		trafficRouting.AppMesh != nil ||
+		trafficRouting.Traefik != nil ||
+		trafficRouting.Apisix != nil ||
+		trafficRouting.GatewayAPI != nil ||
+		len(trafficRouting.Plugins) > 0
+}
+
+// ValidateStepTimeout validates the duration and the action of the timeout of a step
+func ValidateStepTimeout(timeout *v1alpha1.StepTimeout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if duration, err := timeout.Duration.Duration(); err != nil || duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), timeout.Duration, InvalidStepTimeoutDurationMessage))
+	}
+	switch timeout.Action {
+	case "", v1alpha1.StepTimeoutActionAbort, v1alpha1.StepTimeoutActionPause, v1alpha1.StepTimeoutActionSkip:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("action"), timeout.Action, InvalidStepTimeoutActionMessage))
+	}
+	return allErrs
+}
+
+// ValidateStepConditions validates that the when expressions of the step and of its branches compile, and that the
+// branches jump forward to a named step
+func ValidateStepConditions(rollout *v1alpha1.Rollout, index int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	step := rollout.Spec.Strategy.Canary.Steps[index]
+	if step.When != "" {
+		if err := evaluate.ValidateStepCondition(step.When); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("when"), step.When, fmt.Sprintf(InvalidStepConditionMessage, err)))
+		}
+	}
+	for i, branch := range step.Branches {
+		branchFldPath := fldPath.Child("branches").Index(i)
+		if branch.When == "" {
+			allErrs = append(allErrs, field.Required(branchFldPath.Child("when"), "Step branch must have a when expression"))
+		} else if err := evaluate.ValidateStepCondition(branch.When); err != nil {
+			allErrs = append(allErrs, field.Invalid(branchFldPath.Child("when"), branch.When, fmt.Sprintf(InvalidStepConditionMessage, err)))
+		}
+		if branch.GoTo == "" || dependency.GetStepIndex(rollout, branch.GoTo) <= index {
+			allErrs = append(allErrs, field.Invalid(branchFldPath.Child("goTo"), branch.GoTo, InvalidStepBranchGoToMessage))
+		}
+	}
+	return allErrs
+}
+
+// ValidateTrafficStickiness validates that the stickiness of the traffic routing is supported by its traffic router
+func ValidateTrafficStickiness(trafficRouting *v1alpha1.RolloutTrafficRouting, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	stickiness := trafficRouting.Stickiness
+	if stickiness == nil {
+		return allErrs
+	}
+	if (stickiness.Cookie == nil) == (stickiness.Header == "") {
+		allErrs = append(allErrs, field.Invalid(fldPath, stickiness, InvalidStickinessMessage))
+	}
+	if trafficRouting.Istio == nil && trafficRouting.Nginx == nil && trafficRouting.Traefik == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, stickiness, InvalidStickinessTrafficRouterMessage))
+	}
+	if stickiness.Header != "" && trafficRouting.Istio == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("header"), stickiness.Header, InvalidStickinessHeaderMessage))
+	}
+	if stickiness.Cookie != nil && stickiness.Cookie.MaxAgeSeconds != nil && *stickiness.Cookie.MaxAgeSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cookie").Child("maxAgeSeconds"), *stickiness.Cookie.MaxAgeSeconds, InvalidDurationMessage))
+	}
+	if istio := trafficRouting.Istio; istio != nil {
+		var virtualServices []v1alpha1.IstioVirtualService
+		if istio.VirtualService != nil {
+			virtualServices = append(virtualServices, *istio.VirtualService)
+		}
+		virtualServices = append(virtualServices, istio.VirtualServices...)
+		for _, virtualService := range virtualServices {
+			if len(virtualService.Routes) == 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath, stickiness, InvalidStickinessIstioRoutesMessage))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
+// ValidateExperimentStepComparison validates the comparison of the templates of an experiment step, the promotion of
+// its winner and the container overrides of its templates
+func ValidateExperimentStepComparison(rollout *v1alpha1.Rollout, experiment *v1alpha1.RolloutExperimentStep, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	containerNames := map[string]bool{}
+	for _, container := range rollout.Spec.Template.Spec.Containers {
+		containerNames[container.Name] = true
+	}
+	templateNames := map[string]bool{}
+	for i, template := range experiment.Templates {
+		templateNames[template.Name] = true
+		if len(containerNames) == 0 {
+			continue
+		}
+		for j, container := range template.Containers {
+			if !containerNames[container.Name] {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("templates").Index(i).Child("containers").Index(j).Child("name"), container.Name, InvalidExperimentTemplateContainerMessage))
+			}
+		}
+	}
+
+	comparison := experiment.Comparison
+	if comparison == nil {
+		if experiment.PromoteWinner {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("promoteWinner"), experiment.PromoteWinner, InvalidExperimentPromoteWinnerMessage))
+		}
+		return allErrs
+	}
+	if experiment.PromoteWinner && rollout.Spec.WorkloadRef != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("promoteWinner"), rollout.Spec.WorkloadRef.Name, InvalidExperimentPromoteWinnerWorkloadRefMessage))
+	}
+	comparisonFldPath := fldPath.Child("comparison")
+	if len(comparison.Analyses) < 2 || len(comparison.Metrics) == 0 {
+		allErrs = append(allErrs, field.Invalid(comparisonFldPath, comparison, InvalidExperimentComparisonMessage))
+	}
+	analysisNames := map[string]bool{}
+	for _, analysis := range experiment.Analyses {
+		analysisNames[analysis.Name] = true
+	}
+	comparedTemplates := map[string]bool{}
+	for i, analysis := range comparison.Analyses {
+		analysisFldPath := comparisonFldPath.Child("analyses").Index(i)
+		if !templateNames[analysis.TemplateName] || comparedTemplates[analysis.TemplateName] {
+			allErrs = append(allErrs, field.Invalid(analysisFldPath.Child("templateName"), analysis.TemplateName, InvalidExperimentComparisonTemplateMessage))
+		}
+		comparedTemplates[analysis.TemplateName] = true
+		if !analysisNames[analysis.AnalysisName] {
+			allErrs = append(allErrs, field.Invalid(analysisFldPath.Child("analysisName"), analysis.AnalysisName, InvalidExperimentComparisonAnalysisMessage))
+		}
+	}
+	for i, metric := range comparison.Metrics {
+		if metric.Weight != nil && *metric.Weight < 1 {
+			allErrs = append(allErrs, field.Invalid(comparisonFldPath.Child("metrics").Index(i).Child("weight"), *metric.Weight, InvalidExperimentComparisonMetricWeightMessage))
+		}
+	}
 	return allErrs
 }
 
@@ -245,7 +550,8 @@ func requireCanaryStableServices(rollout *v1alpha1.Rollout) bool {
 		canary.TrafficRouting.Ambassador != nil,
 		canary.TrafficRouting.Nginx != nil,
 		canary.TrafficRouting.AppMesh != nil,
-		canary.TrafficRouting.Traefik != nil:
+		canary.TrafficRouting.Traefik != nil,
+		canary.TrafficRouting.GatewayAPI != nil:
 		return true
 	default:
 		return false
@@ -302,15 +608,35 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting").Child("maxTrafficWeight"), canary.TrafficRouting.MaxTrafficWeight, InvalidCanaryMaxWeightOnlySupportInNginxAndPlugins))
 			}
 		}
+		allErrs = append(allErrs, ValidateTrafficStickiness(canary.TrafficRouting, fldPath.Child("trafficRouting").Child("stickiness"))...)
 	}
 
+	if canary.BakeSeconds != nil {
+		if canary.PostPromotionAnalysis == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bakeSeconds"), *canary.BakeSeconds, InvalidCanaryBakeSecondsMessage))
+		}
+		if *canary.BakeSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bakeSeconds"), *canary.BakeSeconds, InvalidDurationMessage))
+		}
+	}
+	if canary.PostPromotionAnalysis != nil && rollout.Spec.WorkloadRef != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("postPromotionAnalysis"), rollout.Spec.WorkloadRef.Name, InvalidCanaryPostPromotionAnalysisWorkloadRefMessage))
+	}
+
+	stepNames := map[string]bool{}
 	for i, step := range canary.Steps {
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
+		if step.Name != "" {
+			if stepNames[step.Name] {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("name"), step.Name, fmt.Sprintf(DuplicatedStepNameMessage, step.Name)))
+			}
+			stepNames[step.Name] = true
+		}
 		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil &&
-			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil {
-			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t",
-				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil)
+			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil && step.Shadow == nil {
+			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t step.Shadow: %t",
+				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil, step.Shadow == nil)
 			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidStepMessage))
 		}
 
@@ -328,7 +654,7 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetHeaderRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && trafficRouting.GatewayAPI == nil && len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setHeaderRoute"), step.SetHeaderRoute, InvalidSetHeaderRouteTrafficPolicy))
 			} else if step.SetHeaderRoute.Match != nil && len(step.SetHeaderRoute.Match) > 0 {
 				for j, match := range step.SetHeaderRoute.Match {
@@ -345,8 +671,8 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetMirrorRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || trafficRouting.Istio == nil {
-				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, "SetMirrorRoute requires TrafficRouting, supports Istio only"))
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.GatewayAPI == nil) {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, InvalidSetMirrorRouteTrafficPolicy))
 			}
 			if step.SetMirrorRoute.Match != nil && len(step.SetMirrorRoute.Match) > 0 {
 				for j, match := range step.SetMirrorRoute.Match {
@@ -364,8 +690,16 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 			}
 		}
 
+		if step.Shadow != nil {
+			allErrs = append(allErrs, ValidateShadowStep(rollout, step.Shadow, stepFldPath.Child("shadow"))...)
+		}
+		if step.Timeout != nil {
+			allErrs = append(allErrs, ValidateStepTimeout(step.Timeout, stepFldPath.Child("timeout"))...)
+		}
+		allErrs = append(allErrs, ValidateStepConditions(rollout, i, stepFldPath)...)
+
 		if rollout.Spec.Strategy.Canary.TrafficRouting != nil {
-			if step.SetHeaderRoute != nil || step.SetMirrorRoute != nil {
+			if step.SetHeaderRoute != nil || step.SetMirrorRoute != nil || step.Shadow != nil {
 				if rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes == nil {
 					message := fmt.Sprintf(MissingFieldMessage, "spec.strategy.canary.trafficRouting.managedRoutes")
 					allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting", "managedRoutes"), message))
@@ -379,6 +713,9 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 			if step.SetMirrorRoute != nil {
 				allErrs = append(allErrs, ValidateStepRouteFoundInManagedRoute(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute.Name, rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)...)
 			}
+			if step.Shadow != nil {
+				allErrs = append(allErrs, ValidateStepRouteFoundInManagedRoute(stepFldPath.Child("shadow").Child("name"), step.Shadow.Name, rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)...)
+			}
 		}
 
 		analysisRunArgs := make([]v1alpha1.AnalysisRunArgument, 0)
@@ -397,12 +734,16 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 					analysisRunArgs = append(analysisRunArgs, arg)
 				}
 			}
+			allErrs = append(allErrs, ValidateExperimentStepComparison(rollout, step.Experiment, stepFldPath.Child("experiment"))...)
 		}
 		if step.Analysis != nil {
 			for _, arg := range step.Analysis.Args {
 				analysisRunArgs = append(analysisRunArgs, arg)
 			}
 		}
+		if step.Shadow != nil {
+			analysisRunArgs = append(analysisRunArgs, step.Shadow.Analysis.Args...)
+		}
 
 		for _, arg := range analysisRunArgs {
 			if arg.ValueFrom != nil {
@@ -420,6 +761,41 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 	return allErrs
 }
 
+// ValidateShadowStep validates that the shadow step mirrors the matching requests to the response-diff proxy with a
+// traffic router supporting mirroring, and runs an analysis
+func ValidateShadowStep(rollout *v1alpha1.Rollout, shadow *v1alpha1.RolloutShadowStep, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
+	if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.GatewayAPI == nil) {
+		allErrs = append(allErrs, field.Invalid(fldPath, shadow.Name, InvalidShadowTrafficRoutingMessage))
+	}
+	if shadow.ProxyService == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("proxyService"), fmt.Sprintf(MissingFieldMessage, "proxyService")))
+	}
+	if len(shadow.Match) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("match"), InvalidShadowMatchMessage))
+	}
+	for i, match := range shadow.Match {
+		matchFld := fldPath.Child("match").Index(i)
+		if match.Method != nil {
+			allErrs = append(allErrs, hasMultipleMatchValues(match.Method, matchFld.Child("method"))...)
+		}
+		if match.Path != nil {
+			allErrs = append(allErrs, hasMultipleMatchValues(match.Path, matchFld.Child("path"))...)
+		}
+		for name, header := range match.Headers {
+			allErrs = append(allErrs, hasMultipleMatchValues(&header, matchFld.Child("headers").Key(name))...)
+		}
+	}
+	if shadow.Percentage != nil && (*shadow.Percentage < 1 || *shadow.Percentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("percentage"), *shadow.Percentage, InvalidShadowPercentageMessage))
+	}
+	if len(shadow.Analysis.Templates) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("analysis", "templates"), InvalidShadowAnalysisMessage))
+	}
+	return allErrs
+}
+
 func ValidateStepRouteFoundInManagedRoute(stepFldPath *field.Path, stepRoutName string, roManagedRoutes []v1alpha1.MangedRoutes) field.ErrorList {
 	allErrs := field.ErrorList{}
 	found := false
@@ -487,11 +863,12 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	oneOf = append(oneOf, s.Pause != nil)
 	oneOf = append(oneOf, s.Experiment != nil)
 	oneOf = append(oneOf, s.Analysis != nil)
+	oneOf = append(oneOf, s.Shadow != nil)
 	hasMultipleStepTypes := false
 	for i := range oneOf {
 		if oneOf[i] {
 			if hasMultipleStepTypes {
-				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.Analysis != nil)
+				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.Shadow: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.Analysis != nil, s.Shadow != nil)
 				allErrs = append(allErrs, field.Invalid(fldPath, errVal, InvalidStepMessage))
 				break
 			}
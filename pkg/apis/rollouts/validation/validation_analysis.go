@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+)
+
+// ValidateAnalysisTemplateSpec checks the args and metrics of an AnalysisTemplate or a
+// ClusterAnalysisTemplate. A metric which references an arg without a value can only be checked
+// once the AnalysisRun supplies the value, so it is skipped.
+func ValidateAnalysisTemplateSpec(spec v1alpha1.AnalysisTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	args := []v1alpha1.Argument{}
+	for i, arg := range spec.Args {
+		if arg.Value != nil && arg.ValueFrom != nil {
+			msg := fmt.Sprintf("arg '%s' has both Value and ValueFrom fields", arg.Name)
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("args").Index(i), arg.Name, msg))
+		} else if arg.Value != nil {
+			args = append(args, arg)
+		}
+	}
+
+	metricNames := make(map[string]bool)
+	for i, metric := range spec.Metrics {
+		metricPath := fldPath.Child("metrics").Index(i)
+		if metricNames[metric.Name] {
+			allErrs = append(allErrs, field.Duplicate(metricPath.Child("name"), metric.Name))
+		}
+		metricNames[metric.Name] = true
+		resolvedMetric, err := analysisutil.ResolveMetricArgs(metric, args)
+		if err != nil {
+			continue
+		}
+		if err := analysisutil.ValidateMetric(*resolvedMetric); err != nil {
+			allErrs = append(allErrs, field.Invalid(metricPath, metric.Name, err.Error()))
+		}
+	}
+
+	// the metrics of the nested templates are only known when they are combined into an AnalysisRun
+	if len(spec.Templates) == 0 {
+		if _, err := analysisutil.GetDryRunMetrics(spec.DryRun, spec.Metrics); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dryRun"), spec.DryRun, err.Error()))
+		}
+		if _, err := analysisutil.GetMeasurementRetentionMetrics(spec.MeasurementRetention, spec.Metrics); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("measurementRetention"), spec.MeasurementRetention, err.Error()))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestValidateAnalysisTemplateSpec(t *testing.T) {
+	count := intstr.FromString("{{args.count}}")
+	newSpec := func() v1alpha1.AnalysisTemplateSpec {
+		return v1alpha1.AnalysisTemplateSpec{
+			Args: []v1alpha1.Argument{
+				{Name: "interval", Value: pointer.String("1m")},
+				{Name: "count"},
+			},
+			Metrics: []v1alpha1.Metric{
+				{
+					Name:     "success-rate",
+					Interval: "{{args.interval}}",
+					Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+				},
+				{
+					// only checked once the count is known
+					Name:     "error-rate",
+					Count:    &count,
+					Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+				},
+			},
+		}
+	}
+	fldPath := field.NewPath("spec")
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Empty(t, ValidateAnalysisTemplateSpec(newSpec(), fldPath))
+	})
+
+	t.Run("invalid metric", func(t *testing.T) {
+		spec := newSpec()
+		spec.Args[0].Value = pointer.String("1 minute")
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.metrics[0]: Invalid value: \"success-rate\": invalid interval string: time: unknown unit \" minute\" in duration \"1 minute\"", allErrs[0].Error())
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		spec := newSpec()
+		spec.Metrics[0].Provider = v1alpha1.MetricProvider{}
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.metrics[0]: Invalid value: \"success-rate\": no provider specified", allErrs[0].Error())
+	})
+
+	t.Run("duplicate metric", func(t *testing.T) {
+		spec := newSpec()
+		spec.Metrics[1].Name = "success-rate"
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.metrics[1].name: Duplicate value: \"success-rate\"", allErrs[0].Error())
+	})
+
+	t.Run("arg with value and valueFrom", func(t *testing.T) {
+		spec := newSpec()
+		spec.Args[0].ValueFrom = &v1alpha1.ValueFrom{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "secret", Key: "interval"}}
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.args[0]: Invalid value: \"interval\": arg 'interval' has both Value and ValueFrom fields", allErrs[0].Error())
+	})
+
+	t.Run("dry-run of unknown metric", func(t *testing.T) {
+		spec := newSpec()
+		spec.DryRun = []v1alpha1.DryRun{{MetricName: "latency"}}
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, field.ErrorTypeInvalid, allErrs[0].Type)
+		assert.Equal(t, "spec.dryRun", allErrs[0].Field)
+
+		// the metric may come from a nested template
+		spec.Templates = []v1alpha1.AnalysisTemplateRef{{TemplateName: "latency"}}
+		assert.Empty(t, ValidateAnalysisTemplateSpec(spec, fldPath))
+	})
+}
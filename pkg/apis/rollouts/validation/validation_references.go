@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +20,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
 )
 
@@ -31,6 +34,8 @@ const (
 	PostPromotionAnalysis AnalysisTemplateType = "PostPromotionAnalysis"
 	InlineAnalysis        AnalysisTemplateType = "InlineAnalysis"
 	BackgroundAnalysis    AnalysisTemplateType = "BackgroundAnalysis"
+	// CanaryPostPromotionAnalysis is the analysis run once a canary update is fully promoted
+	CanaryPostPromotionAnalysis AnalysisTemplateType = "CanaryPostPromotionAnalysis"
 )
 
 type AnalysisTemplatesWithType struct {
@@ -75,11 +80,13 @@ func ValidateRolloutReferencedResources(rollout *v1alpha1.Rollout, referencedRes
 	for _, templates := range referencedResources.AnalysisTemplatesWithType {
 		allErrs = append(allErrs, ValidateAnalysisTemplatesWithType(rollout, templates)...)
 	}
+	// the traffic routing of a blue-green rollout is validated like the one of a canary rollout
+	trafficRoutingRollout := rolloututil.TrafficRoutingRollout(rollout)
 	for _, ingress := range referencedResources.Ingresses {
-		allErrs = append(allErrs, ValidateIngress(rollout, &ingress)...)
+		allErrs = append(allErrs, BlueGreenTrafficRoutingErrors(rollout, ValidateIngress(trafficRoutingRollout, &ingress))...)
 	}
 	for _, vsvc := range referencedResources.VirtualServices {
-		allErrs = append(allErrs, ValidateVirtualService(rollout, vsvc)...)
+		allErrs = append(allErrs, BlueGreenTrafficRoutingErrors(rollout, ValidateVirtualService(trafficRoutingRollout, vsvc))...)
 	}
 	for _, mapping := range referencedResources.AmbassadorMappings {
 		allErrs = append(allErrs, ValidateAmbassadorMapping(mapping)...)
@@ -90,6 +97,36 @@ func ValidateRolloutReferencedResources(rollout *v1alpha1.Rollout, referencedRes
 	return allErrs
 }
 
+const (
+	canaryTrafficRoutingField    = "spec.strategy.canary.trafficRouting"
+	blueGreenTrafficRoutingField = "spec.strategy.blueGreen.trafficRouting"
+)
+
+// BlueGreenTrafficRoutingErrors reports the errors found on the traffic routing of the canary shaped copy of a
+// blue-green rollout, as returned by rolloututil.TrafficRoutingRollout, at the traffic routing of the blue-green
+// strategy of the rollout
+func BlueGreenTrafficRoutingErrors(rollout *v1alpha1.Rollout, errs field.ErrorList) field.ErrorList {
+	if rollout.Spec.Strategy.BlueGreen == nil {
+		return errs
+	}
+	for _, err := range errs {
+		if strings.HasPrefix(err.Field, canaryTrafficRoutingField) {
+			err.Field = blueGreenTrafficRoutingField + strings.TrimPrefix(err.Field, canaryTrafficRoutingField)
+		}
+	}
+	return errs
+}
+
+// BlueGreenTrafficRoutingError is BlueGreenTrafficRoutingErrors for a single error, which is returned as is unless
+// it is a field error
+func BlueGreenTrafficRoutingError(rollout *v1alpha1.Rollout, err error) error {
+	var fieldErr *field.Error
+	if errors.As(err, &fieldErr) {
+		BlueGreenTrafficRoutingErrors(rollout, field.ErrorList{fieldErr})
+	}
+	return err
+}
+
 func ValidateService(svc ServiceWithType, rollout *v1alpha1.Rollout) field.ErrorList {
 	allErrs := field.ErrorList{}
 	fldPath := GetServiceWithTypeFieldPath(svc.Type)
@@ -175,7 +212,9 @@ func ValidateAnalysisTemplateWithType(rollout *v1alpha1.Rollout, template *v1alp
 		} else {
 			for _, metric := range resolvedMetrics {
 				effectiveCount := metric.EffectiveCount()
-				if effectiveCount == nil {
+				// the post promotion analysis of a canary is terminated at the end of the bake period
+				bakePeriod := templateType == CanaryPostPromotionAnalysis && rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.BakeSeconds != nil
+				if effectiveCount == nil && !bakePeriod {
 					msg := fmt.Sprintf("AnalysisTemplate %s has metric %s which runs indefinitely. Invalid value for count: %s", templateName, metric.Name, metric.Count)
 					allErrs = append(allErrs, field.Invalid(fldPath, templateName, msg))
 				}
@@ -545,6 +584,8 @@ func GetAnalysisTemplateWithTypeFieldPath(templateType AnalysisTemplateType, can
 		fldPath = fldPath.Child("canary", "steps").Index(canaryStepIndex).Child("analysis", "templates")
 	case BackgroundAnalysis:
 		fldPath = fldPath.Child("canary", "analysis", "templates")
+	case CanaryPostPromotionAnalysis:
+		fldPath = fldPath.Child("canary", "postPromotionAnalysis", "templates")
 	default:
 		// No path specified
 		return nil
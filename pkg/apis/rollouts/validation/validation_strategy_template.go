@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+var placeholderRegex = regexp.MustCompile(`{{([^}]*)}}`)
+
+// ValidateRolloutStrategyTemplateSpec checks the args and the strategy of a RolloutStrategyTemplate or a
+// ClusterRolloutStrategyTemplate. The strategy itself can only be fully checked once it is resolved for a rollout,
+// which supplies the values of the args.
+func ValidateRolloutStrategyTemplateSpec(spec v1alpha1.RolloutStrategyTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	argNames := make(map[string]bool)
+	for i, arg := range spec.Args {
+		argPath := fldPath.Child("args").Index(i)
+		if argNames[arg.Name] {
+			allErrs = append(allErrs, field.Duplicate(argPath.Child("name"), arg.Name))
+		}
+		argNames[arg.Name] = true
+		if arg.ValueFrom != nil {
+			msg := fmt.Sprintf("arg '%s' must have a value, valueFrom is not supported by strategy templates", arg.Name)
+			allErrs = append(allErrs, field.Invalid(argPath.Child("valueFrom"), arg.Name, msg))
+		}
+	}
+
+	strategy := spec.Strategy
+	strategyPath := fldPath.Child("strategy")
+	if strategy.BlueGreen == nil && strategy.Canary == nil {
+		allErrs = append(allErrs, field.Required(strategyPath, "strategy template must have one of the following set: canary, blueGreen"))
+	} else if strategy.BlueGreen != nil && strategy.Canary != nil {
+		errVal := fmt.Sprintf("blueGreen: %t canary: %t", strategy.BlueGreen != nil, strategy.Canary != nil)
+		allErrs = append(allErrs, field.Invalid(strategyPath, errVal, InvalidStrategyMessage))
+	}
+
+	data, err := json.Marshal(strategy)
+	if err != nil {
+		return append(allErrs, field.InternalError(strategyPath, err))
+	}
+	for _, match := range placeholderRegex.FindAllStringSubmatch(string(data), -1) {
+		tag := strings.TrimSpace(match[1])
+		if name, ok := strings.CutPrefix(tag, "args."); !ok || !argNames[name] {
+			msg := fmt.Sprintf("placeholder '{{%s}}' does not refer to an arg of the template", tag)
+			allErrs = append(allErrs, field.Invalid(strategyPath, match[0], msg))
+		}
+	}
+	return allErrs
+}
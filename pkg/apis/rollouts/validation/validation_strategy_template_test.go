@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestValidateRolloutStrategyTemplateSpec(t *testing.T) {
+	newSpec := func() v1alpha1.RolloutStrategyTemplateSpec {
+		return v1alpha1.RolloutStrategyTemplateSpec{
+			Args: []v1alpha1.Argument{
+				{Name: "service"},
+				{Name: "pause", Value: pointer.String("1m")},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					CanaryService: "{{args.service}}-canary",
+					StableService: "{{ args.service }}-stable",
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32(20)},
+						{Pause: &v1alpha1.RolloutPause{Duration: &intstr.IntOrString{Type: intstr.String, StrVal: "{{args.pause}}"}}},
+					},
+				},
+			},
+		}
+	}
+	fldPath := field.NewPath("spec")
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Empty(t, ValidateRolloutStrategyTemplateSpec(newSpec(), fldPath))
+	})
+
+	t.Run("invalid args", func(t *testing.T) {
+		spec := newSpec()
+		spec.Args = append(spec.Args, v1alpha1.Argument{
+			Name:      "pause",
+			ValueFrom: &v1alpha1.ValueFrom{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "secret", Key: "pause"}},
+		})
+		allErrs := ValidateRolloutStrategyTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, field.ErrorTypeDuplicate, allErrs[0].Type)
+		assert.Equal(t, "spec.args[2].name", allErrs[0].Field)
+		assert.Equal(t, "spec.args[2].valueFrom", allErrs[1].Field)
+	})
+
+	t.Run("unknown placeholder", func(t *testing.T) {
+		spec := newSpec()
+		spec.Strategy.Canary.Steps[0].SetWeight = nil
+		spec.Strategy.Canary.Steps[0].Pause = &v1alpha1.RolloutPause{Duration: &intstr.IntOrString{Type: intstr.String, StrVal: "{{args.duration}}"}}
+		allErrs := ValidateRolloutStrategyTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, `spec.strategy: Invalid value: "{{args.duration}}": placeholder '{{args.duration}}' does not refer to an arg of the template`, allErrs[0].Error())
+	})
+
+	t.Run("missing strategy", func(t *testing.T) {
+		spec := newSpec()
+		spec.Strategy.Canary = nil
+		allErrs := ValidateRolloutStrategyTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, field.ErrorTypeRequired, allErrs[0].Type)
+	})
+
+	t.Run("multiple strategies", func(t *testing.T) {
+		spec := newSpec()
+		spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{ActiveService: "active"}
+		allErrs := ValidateRolloutStrategyTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Contains(t, allErrs[0].Error(), InvalidStrategyMessage)
+	})
+}
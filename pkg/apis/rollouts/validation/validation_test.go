@@ -129,6 +129,46 @@ func TestValidateRolloutStrategyBlueGreen(t *testing.T) {
 	assert.Equal(t, ScaleDownLimitLargerThanRevisionLimit, allErrs[1].Detail)
 }
 
+func TestValidateRolloutStrategyBlueGreenTrafficRouting(t *testing.T) {
+	rollout := v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{
+					ActiveService: "active",
+					TrafficRouting: &v1alpha1.BlueGreenTrafficRouting{
+						RolloutTrafficRouting: v1alpha1.RolloutTrafficRouting{
+							Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+						},
+						Weights: []int32{10, 50, 101},
+					},
+				},
+			},
+		},
+	}
+
+	allErrs := ValidateRolloutStrategyBlueGreen(&rollout, field.NewPath("spec", "strategy", "blueGreen"))
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, InvalidBlueGreenTrafficRoutingMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.blueGreen.trafficRouting.weights[2]", allErrs[1].Field)
+	assert.Equal(t, fmt.Sprintf(InvalidBlueGreenTrafficWeightMessage, 100), allErrs[1].Detail)
+
+	rollout.Spec.Strategy.BlueGreen.PreviewService = "preview"
+	rollout.Spec.Strategy.BlueGreen.TrafficRouting.MaxTrafficWeight = pointer.Int32(1000)
+	allErrs = ValidateRolloutStrategyBlueGreen(&rollout, field.NewPath("spec", "strategy", "blueGreen"))
+	assert.Empty(t, allErrs)
+
+	// a traffic provider is set
+	rollout.Spec.Strategy.BlueGreen.TrafficRouting.Nginx = nil
+	allErrs = ValidateRolloutStrategyBlueGreen(&rollout, field.NewPath("spec", "strategy", "blueGreen"))
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, "spec.strategy.blueGreen.trafficRouting", allErrs[0].Field)
+	assert.Equal(t, InvalidBlueGreenTrafficRoutingProviderMessage, allErrs[0].Detail)
+
+	rollout.Spec.Strategy.BlueGreen.TrafficRouting.Plugins = map[string]json.RawMessage{"example/plugin": []byte("{}")}
+	allErrs = ValidateRolloutStrategyBlueGreen(&rollout, field.NewPath("spec", "strategy", "blueGreen"))
+	assert.Empty(t, allErrs)
+}
+
 func TestValidateRolloutStrategyCanaryMissingServiceNames(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -279,6 +319,34 @@ func TestValidateRolloutStrategyCanary(t *testing.T) {
 		assert.Empty(t, allErrs)
 	})
 
+	t.Run("bakeSeconds without postPromotionAnalysis", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(10)
+		invalidRo.Spec.Strategy.Canary.BakeSeconds = pointer.Int32(60)
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidCanaryBakeSecondsMessage, allErrs[0].Detail)
+
+		invalidRo.Spec.Strategy.Canary.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{}
+		allErrs = ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+
+		invalidRo.Spec.Strategy.Canary.BakeSeconds = pointer.Int32(0)
+		allErrs = ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
+	})
+
+	t.Run("postPromotionAnalysis with workloadRef", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(10)
+		invalidRo.Spec.Strategy.Canary.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{}
+		invalidRo.Spec.WorkloadRef = &v1alpha1.ObjectRef{Name: "guestbook"}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidCanaryPostPromotionAnalysisWorkloadRefMessage, allErrs[0].Detail)
+	})
+
 	t.Run("valid Istio missing canary and stable service", func(t *testing.T) {
 		validRo := ro.DeepCopy()
 		validRo.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(10)
@@ -507,6 +575,307 @@ func TestValidateRolloutStrategyAntiAffinity(t *testing.T) {
 	assert.Equal(t, InvalidAntiAffinityWeightMessage, allErrs[0].Detail)
 }
 
+func TestValidateRolloutMultiCluster(t *testing.T) {
+	fldPath := field.NewPath("spec", "multiCluster")
+	assert.Empty(t, ValidateRolloutMultiCluster(nil, fldPath))
+
+	mc := &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "staging", Clusters: []string{"staging-1"}},
+			{Name: "prod", Clusters: []string{"prod-1", "prod-2"}},
+		},
+	}
+	assert.Empty(t, ValidateRolloutMultiCluster(mc, fldPath))
+
+	allErrs := ValidateRolloutMultiCluster(&v1alpha1.MultiClusterPromotion{}, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, fmt.Sprintf(MissingFieldMessage, ".spec.multiCluster.waves"), allErrs[0].Detail)
+
+	mc = &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "prod", Clusters: []string{"prod-1"}},
+			{Name: "prod", Clusters: []string{"prod-1"}},
+			{Clusters: []string{}},
+		},
+	}
+	allErrs = ValidateRolloutMultiCluster(mc, fldPath)
+	assert.Len(t, allErrs, 4)
+	assert.Equal(t, fmt.Sprintf(DuplicatedClusterWaveMessage, "prod"), allErrs[0].Detail)
+	assert.Equal(t, "spec.multiCluster.waves[1].name", allErrs[0].Field)
+	assert.Equal(t, fmt.Sprintf(DuplicatedWaveClusterMessage, "prod-1"), allErrs[1].Detail)
+	assert.Equal(t, "spec.multiCluster.waves[1].clusters[0]", allErrs[1].Field)
+	assert.Equal(t, "spec.multiCluster.waves[2].name", allErrs[2].Field)
+	assert.Equal(t, "spec.multiCluster.waves[2].clusters", allErrs[3].Field)
+}
+
+func TestValidateRolloutDeployWindows(t *testing.T) {
+	fldPath := field.NewPath("spec", "deployWindows")
+	allErrs := ValidateRolloutDeployWindows([]v1alpha1.DeployWindow{
+		{Kind: v1alpha1.DeployWindowKindAllow, Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "America/New_York"},
+		{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "0 9 * * 1-5", Duration: "8h"},
+	}, fldPath)
+	assert.Empty(t, allErrs)
+
+	allErrs = ValidateRolloutDeployWindows([]v1alpha1.DeployWindow{
+		{Kind: v1alpha1.DeployWindowKindAllow, Schedule: "0 9 * * 1-5", Duration: "8h"},
+		{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "0 9 * *", Duration: "8h"},
+	}, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, "spec.deployWindows[1]", allErrs[0].Field)
+	assert.Contains(t, allErrs[0].Detail, "Invalid deploy window: invalid schedule '0 9 * *'")
+}
+
+func TestValidateRolloutDependencies(t *testing.T) {
+	fldPath := field.NewPath("spec", "dependsOn")
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"}}
+	ro.Spec.DependsOn = []v1alpha1.RolloutDependency{
+		{Name: "backend"},
+		{Name: "backend", Namespace: "shop", Step: "half"},
+	}
+	assert.Empty(t, ValidateRolloutDependencies(ro, fldPath))
+
+	ro.Spec.DependsOn = []v1alpha1.RolloutDependency{
+		{Name: "backend"},
+		{Name: "backend", Namespace: "default"},
+		{Name: "frontend"},
+		{},
+	}
+	allErrs := ValidateRolloutDependencies(ro, fldPath)
+	assert.Len(t, allErrs, 3)
+	assert.Equal(t, fmt.Sprintf(DuplicatedDependencyMessage, "default/backend"), allErrs[0].Detail)
+	assert.Equal(t, "spec.dependsOn[1].name", allErrs[0].Field)
+	assert.Equal(t, SelfDependencyMessage, allErrs[1].Detail)
+	assert.Equal(t, "spec.dependsOn[2].name", allErrs[1].Field)
+	assert.Equal(t, fmt.Sprintf(MissingFieldMessage, ".spec.dependsOn.name"), allErrs[2].Detail)
+}
+
+func TestValidateTrafficStickiness(t *testing.T) {
+	fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "stickiness")
+	trafficRouting := &v1alpha1.RolloutTrafficRouting{
+		Istio: &v1alpha1.IstioTrafficRouting{
+			VirtualService: &v1alpha1.IstioVirtualService{Name: "vsvc", Routes: []string{"primary"}},
+		},
+		Stickiness: &v1alpha1.TrafficStickiness{Header: "x-version"},
+	}
+	assert.Empty(t, ValidateTrafficStickiness(trafficRouting, fldPath))
+
+	trafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Header: "x-version", Cookie: &v1alpha1.StickinessCookie{Name: "version"}}
+	allErrs := ValidateTrafficStickiness(trafficRouting, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidStickinessMessage, allErrs[0].Detail)
+
+	trafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Cookie: &v1alpha1.StickinessCookie{Name: "version"}}
+	trafficRouting.Istio.VirtualService.Routes = nil
+	allErrs = ValidateTrafficStickiness(trafficRouting, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidStickinessIstioRoutesMessage, allErrs[0].Detail)
+
+	trafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx:      &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+		Stickiness: &v1alpha1.TrafficStickiness{Cookie: &v1alpha1.StickinessCookie{Name: "version", MaxAgeSeconds: pointer.Int64(3600)}},
+	}
+	assert.Empty(t, ValidateTrafficStickiness(trafficRouting, fldPath))
+
+	trafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Header: "x-version"}
+	allErrs = ValidateTrafficStickiness(trafficRouting, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidStickinessHeaderMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.canary.trafficRouting.stickiness.header", allErrs[0].Field)
+
+	trafficRouting = &v1alpha1.RolloutTrafficRouting{
+		SMI:        &v1alpha1.SMITrafficRouting{},
+		Stickiness: &v1alpha1.TrafficStickiness{Cookie: &v1alpha1.StickinessCookie{Name: "version", MaxAgeSeconds: pointer.Int64(0)}},
+	}
+	allErrs = ValidateTrafficStickiness(trafficRouting, fldPath)
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, InvalidStickinessTrafficRouterMessage, allErrs[0].Detail)
+	assert.Equal(t, InvalidDurationMessage, allErrs[1].Detail)
+}
+
+func TestValidateExperimentStepComparison(t *testing.T) {
+	fldPath := field.NewPath("spec", "strategy", "canary", "steps").Index(0).Child("experiment")
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app"}}
+	experiment := &v1alpha1.RolloutExperimentStep{
+		Templates: []v1alpha1.RolloutExperimentTemplate{
+			{Name: "a", SpecRef: v1alpha1.CanarySpecRef},
+			{Name: "b", SpecRef: v1alpha1.CanarySpecRef, Containers: []v1alpha1.TemplateContainer{{Name: "app", Image: "app:b"}}},
+		},
+		Analyses: []v1alpha1.RolloutExperimentStepAnalysisTemplateRef{
+			{Name: "a-metrics", TemplateName: "metrics"},
+			{Name: "b-metrics", TemplateName: "metrics"},
+		},
+		Comparison: &v1alpha1.ExperimentComparison{
+			Analyses: []v1alpha1.ComparisonAnalysis{
+				{TemplateName: "a", AnalysisName: "a-metrics"},
+				{TemplateName: "b", AnalysisName: "b-metrics"},
+			},
+			Metrics: []v1alpha1.ComparisonMetric{{Name: "conversion"}},
+		},
+		PromoteWinner: true,
+	}
+	assert.Empty(t, ValidateExperimentStepComparison(ro, experiment, fldPath))
+
+	invalid := experiment.DeepCopy()
+	invalid.Templates[1].Containers[0].Name = "sidecar"
+	invalid.Comparison.Analyses[1] = v1alpha1.ComparisonAnalysis{TemplateName: "a", AnalysisName: "c-metrics"}
+	invalid.Comparison.Metrics[0].Weight = pointer.Int32(0)
+	allErrs := ValidateExperimentStepComparison(ro, invalid, fldPath)
+	assert.Len(t, allErrs, 4)
+	assert.Equal(t, InvalidExperimentTemplateContainerMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.canary.steps[0].experiment.templates[1].containers[0].name", allErrs[0].Field)
+	assert.Equal(t, InvalidExperimentComparisonTemplateMessage, allErrs[1].Detail)
+	assert.Equal(t, InvalidExperimentComparisonAnalysisMessage, allErrs[2].Detail)
+	assert.Equal(t, InvalidExperimentComparisonMetricWeightMessage, allErrs[3].Detail)
+
+	invalid = experiment.DeepCopy()
+	invalid.Comparison.Metrics = nil
+	allErrs = ValidateExperimentStepComparison(ro, invalid, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidExperimentComparisonMessage, allErrs[0].Detail)
+
+	invalid = experiment.DeepCopy()
+	invalid.Comparison = nil
+	allErrs = ValidateExperimentStepComparison(ro, invalid, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidExperimentPromoteWinnerMessage, allErrs[0].Detail)
+
+	workloadRefRo := ro.DeepCopy()
+	workloadRefRo.Spec.Template = corev1.PodTemplateSpec{}
+	workloadRefRo.Spec.WorkloadRef = &v1alpha1.ObjectRef{Name: "deploy", Kind: "Deployment", APIVersion: "apps/v1"}
+	allErrs = ValidateExperimentStepComparison(workloadRefRo, experiment, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidExperimentPromoteWinnerWorkloadRefMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.canary.steps[0].experiment.promoteWinner", allErrs[0].Field)
+}
+
+func TestValidateShadowStep(t *testing.T) {
+	fldPath := field.NewPath("spec", "strategy", "canary", "steps").Index(0).Child("shadow")
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Istio:         &v1alpha1.IstioTrafficRouting{VirtualService: &v1alpha1.IstioVirtualService{Name: "vsvc"}},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "shadow"}},
+		},
+	}
+	shadow := &v1alpha1.RolloutShadowStep{
+		Name:         "shadow",
+		Match:        []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}},
+		Percentage:   pointer.Int32(20),
+		ProxyService: "response-diff",
+		Analysis: v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "mismatch-rate"}},
+		},
+	}
+	assert.Empty(t, ValidateShadowStep(ro, shadow, fldPath))
+
+	invalid := shadow.DeepCopy()
+	invalid.ProxyService = ""
+	invalid.Match = []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Exact: "/api", Prefix: "/"}}}
+	invalid.Percentage = pointer.Int32(0)
+	invalid.Analysis.Templates = nil
+	allErrs := ValidateShadowStep(ro, invalid, fldPath)
+	assert.Len(t, allErrs, 4)
+	assert.Equal(t, "spec.strategy.canary.steps[0].shadow.proxyService", allErrs[0].Field)
+	assert.Equal(t, InvalidStringMatchMultipleValuePolicy, allErrs[1].Detail)
+	assert.Equal(t, "spec.strategy.canary.steps[0].shadow.match[0].path", allErrs[1].Field)
+	assert.Equal(t, InvalidShadowPercentageMessage, allErrs[2].Detail)
+	assert.Equal(t, InvalidShadowAnalysisMessage, allErrs[3].Detail)
+
+	invalid = shadow.DeepCopy()
+	invalid.Match = nil
+	allErrs = ValidateShadowStep(ro, invalid, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidShadowMatchMessage, allErrs[0].Detail)
+
+	nginx := ro.DeepCopy()
+	nginx.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"}}
+	allErrs = ValidateShadowStep(nginx, shadow, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidShadowTrafficRoutingMessage, allErrs[0].Detail)
+}
+
+func TestValidateStepTimeout(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		Steps: []v1alpha1.CanaryStep{
+			{SetWeight: pointer.Int32(20), Timeout: &v1alpha1.StepTimeout{Duration: "10m"}},
+			{Pause: &v1alpha1.RolloutPause{}, Timeout: &v1alpha1.StepTimeout{Duration: "1h", Action: v1alpha1.StepTimeoutActionSkip}},
+		},
+	}
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("spec", "strategy", "canary")))
+
+	ro.Spec.Strategy.Canary.Steps[0].Timeout = &v1alpha1.StepTimeout{Duration: "-1m", Action: "Retry"}
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath("spec", "strategy", "canary"))
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, "spec.strategy.canary.steps[0].timeout.duration", allErrs[0].Field)
+	assert.Equal(t, InvalidStepTimeoutDurationMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.canary.steps[0].timeout.action", allErrs[1].Field)
+	assert.Equal(t, InvalidStepTimeoutActionMessage, allErrs[1].Detail)
+
+	bg := &v1alpha1.Rollout{}
+	bg.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{
+		ActiveService:        "active",
+		PreviewService:       "preview",
+		AutoPromotionEnabled: pointer.Bool(false),
+		PauseTimeout:         &v1alpha1.StepTimeout{Duration: "2h", Action: v1alpha1.StepTimeoutActionPause},
+	}
+	assert.Empty(t, ValidateRolloutStrategyBlueGreen(bg, field.NewPath("spec", "strategy", "blueGreen")))
+
+	bg.Spec.Strategy.BlueGreen.AutoPromotionEnabled = nil
+	bg.Spec.Strategy.BlueGreen.PauseTimeout.Duration = "2 hours"
+	allErrs = ValidateRolloutStrategyBlueGreen(bg, field.NewPath("spec", "strategy", "blueGreen"))
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, InvalidPauseTimeoutMessage, allErrs[0].Detail)
+	assert.Equal(t, InvalidStepTimeoutDurationMessage, allErrs[1].Detail)
+}
+
+func TestValidateStepConditions(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		Steps: []v1alpha1.CanaryStep{
+			{SetWeight: pointer.Int32(20)},
+			{
+				Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}}},
+				Branches: []v1alpha1.StepBranch{{When: `stepAnalysis == "Inconclusive"`, GoTo: "extended-analysis"}},
+			},
+			{Pause: &v1alpha1.RolloutPause{}, When: `!(imageTag endsWith "-hotfix")`},
+			{Name: "extended-analysis", Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}}}},
+		},
+	}
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("spec", "strategy", "canary")))
+
+	ro.Spec.Strategy.Canary.Steps[2].When = "revision >"
+	ro.Spec.Strategy.Canary.Steps[3].Branches = []v1alpha1.StepBranch{{GoTo: "extended-analysis"}}
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath("spec", "strategy", "canary"))
+	assert.Len(t, allErrs, 3)
+	assert.Equal(t, "spec.strategy.canary.steps[2].when", allErrs[0].Field)
+	assert.Contains(t, allErrs[0].Detail, "Step expression is invalid")
+	assert.Equal(t, "spec.strategy.canary.steps[3].branches[0].when", allErrs[1].Field)
+	assert.Equal(t, "spec.strategy.canary.steps[3].branches[0].goTo", allErrs[2].Field)
+	assert.Equal(t, InvalidStepBranchGoToMessage, allErrs[2].Detail)
+}
+
+func TestValidateRolloutStrategyCanaryStepNames(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		Steps: []v1alpha1.CanaryStep{
+			{Name: "half", SetWeight: pointer.Int32(50)},
+			{Name: "half", Pause: &v1alpha1.RolloutPause{}},
+		},
+	}
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath("spec", "strategy", "canary"))
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, fmt.Sprintf(DuplicatedStepNameMessage, "half"), allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.canary.steps[1].name", allErrs[0].Field)
+}
+
 func TestValidateRolloutStrategyCanarySetHeaderRoute(t *testing.T) {
 	ro := &v1alpha1.Rollout{}
 	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
@@ -825,6 +1194,32 @@ func TestValidateRolloutStrategyCanarySetMirrorRouteIstio(t *testing.T) {
 	})
 }
 
+func TestValidateRolloutStrategyCanarySetMirrorRouteGatewayAPI(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			GatewayAPI: &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{
+				Name: "test-mirror-1",
+			}},
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetMirrorRoute: &v1alpha1.SetMirrorRoute{
+				Name: "test-mirror-1",
+				Match: []v1alpha1.RouteMatch{{
+					Path: &v1alpha1.StringMatch{
+						Prefix: "/",
+					},
+				}},
+			},
+		}},
+	}
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Empty(t, allErrs)
+}
+
 func TestInvalidMaxSurgeMaxUnavailable(t *testing.T) {
 	r := func(maxSurge, maxUnavailable intstr.IntOrString) *v1alpha1.Rollout {
 		return &v1alpha1.Rollout{
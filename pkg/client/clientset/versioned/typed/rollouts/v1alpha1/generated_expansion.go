@@ -24,6 +24,10 @@ type AnalysisTemplateExpansion interface{}
 
 type ClusterAnalysisTemplateExpansion interface{}
 
+type ClusterRolloutStrategyTemplateExpansion interface{}
+
 type ExperimentExpansion interface{}
 
 type RolloutExpansion interface{}
+
+type RolloutStrategyTemplateExpansion interface{}
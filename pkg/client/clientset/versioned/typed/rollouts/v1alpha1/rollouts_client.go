@@ -31,8 +31,10 @@ type ArgoprojV1alpha1Interface interface {
 	AnalysisRunsGetter
 	AnalysisTemplatesGetter
 	ClusterAnalysisTemplatesGetter
+	ClusterRolloutStrategyTemplatesGetter
 	ExperimentsGetter
 	RolloutsGetter
+	RolloutStrategyTemplatesGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -52,6 +54,10 @@ func (c *ArgoprojV1alpha1Client) ClusterAnalysisTemplates() ClusterAnalysisTempl
 	return newClusterAnalysisTemplates(c)
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInterface {
+	return newClusterRolloutStrategyTemplates(c)
+}
+
 func (c *ArgoprojV1alpha1Client) Experiments(namespace string) ExperimentInterface {
 	return newExperiments(c, namespace)
 }
@@ -60,6 +66,10 @@ func (c *ArgoprojV1alpha1Client) Rollouts(namespace string) RolloutInterface {
 	return newRollouts(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) RolloutStrategyTemplates(namespace string) RolloutStrategyTemplateInterface {
+	return newRolloutStrategyTemplates(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
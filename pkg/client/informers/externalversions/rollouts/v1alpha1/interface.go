@@ -30,10 +30,14 @@ type Interface interface {
 	AnalysisTemplates() AnalysisTemplateInformer
 	// ClusterAnalysisTemplates returns a ClusterAnalysisTemplateInformer.
 	ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer
+	// ClusterRolloutStrategyTemplates returns a ClusterRolloutStrategyTemplateInformer.
+	ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInformer
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
 	// Rollouts returns a RolloutInformer.
 	Rollouts() RolloutInformer
+	// RolloutStrategyTemplates returns a RolloutStrategyTemplateInformer.
+	RolloutStrategyTemplates() RolloutStrategyTemplateInformer
 }
 
 type version struct {
@@ -62,6 +66,11 @@ func (v *version) ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer {
 	return &clusterAnalysisTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterRolloutStrategyTemplates returns a ClusterRolloutStrategyTemplateInformer.
+func (v *version) ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInformer {
+	return &clusterRolloutStrategyTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Experiments returns a ExperimentInformer.
 func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,3 +80,8 @@ func (v *version) Experiments() ExperimentInformer {
 func (v *version) Rollouts() RolloutInformer {
 	return &rolloutInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RolloutStrategyTemplates returns a RolloutStrategyTemplateInformer.
+func (v *version) RolloutStrategyTemplates() RolloutStrategyTemplateInformer {
+	return &rolloutStrategyTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -38,6 +38,10 @@ type AnalysisTemplateNamespaceListerExpansion interface{}
 // ClusterAnalysisTemplateLister.
 type ClusterAnalysisTemplateListerExpansion interface{}
 
+// ClusterRolloutStrategyTemplateListerExpansion allows custom methods to be added to
+// ClusterRolloutStrategyTemplateLister.
+type ClusterRolloutStrategyTemplateListerExpansion interface{}
+
 // ExperimentListerExpansion allows custom methods to be added to
 // ExperimentLister.
 type ExperimentListerExpansion interface{}
@@ -53,3 +57,11 @@ type RolloutListerExpansion interface{}
 // RolloutNamespaceListerExpansion allows custom methods to be added to
 // RolloutNamespaceLister.
 type RolloutNamespaceListerExpansion interface{}
+
+// RolloutStrategyTemplateListerExpansion allows custom methods to be added to
+// RolloutStrategyTemplateLister.
+type RolloutStrategyTemplateListerExpansion interface{}
+
+// RolloutStrategyTemplateNamespaceListerExpansion allows custom methods to be added to
+// RolloutStrategyTemplateNamespaceLister.
+type RolloutStrategyTemplateNamespaceListerExpansion interface{}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	auditutil "github.com/argoproj/argo-rollouts/utils/audit"
+)
+
+const (
+	auditExample = `
+	# Show who promoted, aborted or updated a rollout
+	%[1]s audit guestbook`
+
+	auditUsage = `This command shows the audit log of a rollout, which records the promotions, aborts, retries,
+pauses, restarts, image updates and undos made through the CLI or the dashboard, and the automated
+rollbacks made by the controller, with the user who made them. Only the last %d actions are kept.`
+
+	headerFmtString = "TIME\tACTOR\tSOURCE\tACTION\tREVISION\tSTEP\tMESSAGE\n"
+)
+
+// NewCmdAudit returns a new instance of an `rollouts audit` command
+func NewCmdAudit(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:          "audit ROLLOUT_NAME",
+		Short:        "Show the audit log of a rollout",
+		Long:         fmt.Sprintf(auditUsage, auditutil.MaxEntries),
+		Example:      o.Example(auditExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			audit, err := GetRolloutAudit(c.Context(), o.RolloutsClientset(), o.Namespace(), args[0])
+			if err != nil {
+				return err
+			}
+			printAudit(o, audit)
+			return nil
+		},
+		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
+	}
+	return cmd
+}
+
+// GetRolloutAudit returns the audit log of a rollout
+func GetRolloutAudit(ctx context.Context, rolloutsClient roclientset.Interface, namespace, name string) (*rollout.RolloutAudit, error) {
+	ro, err := rolloutsClient.ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	entries, err := auditutil.GetEntries(ro)
+	if err != nil {
+		return nil, err
+	}
+	audit := &rollout.RolloutAudit{}
+	for i := range entries {
+		entry := entries[i]
+		audit.Entries = append(audit.Entries, &rollout.AuditEntry{
+			Time:     &entry.Time,
+			Actor:    entry.Actor,
+			Source:   string(entry.Source),
+			Action:   string(entry.Action),
+			Revision: entry.Revision,
+			Step:     entry.Step,
+			Message:  entry.Message,
+		})
+	}
+	return audit, nil
+}
+
+func printAudit(o *options.ArgoRolloutsOptions, audit *rollout.RolloutAudit) {
+	if len(audit.Entries) == 0 {
+		fmt.Fprintln(o.ErrOut, "No actions recorded.")
+		return
+	}
+	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, headerFmtString)
+	for _, entry := range audit.Entries {
+		timestamp := ""
+		if entry.Time != nil {
+			timestamp = entry.Time.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			timestamp,
+			entry.Actor,
+			entry.Source,
+			entry.Action,
+			orDash(entry.Revision),
+			orDash(entry.Step),
+			orDash(entry.Message),
+		)
+	}
+	_ = w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,102 @@
+package audit
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	auditutil "github.com/argoproj/argo-rollouts/utils/audit"
+)
+
+func newRollout(auditLog string) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	if auditLog != "" {
+		ro.Annotations = map[string]string{auditutil.LogAnnotation: auditLog}
+	}
+	return ro
+}
+
+func TestAuditUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdAudit(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+}
+
+func TestAuditRolloutNotFound(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdAudit(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"does-not-exist"})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "Error: rollouts.argoproj.io \"does-not-exist\" not found\n", stderr)
+}
+
+func TestAuditNoActions(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newRollout(""))
+	defer tf.Cleanup()
+	cmd := NewCmdAudit(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, "No actions recorded.\n", stderr)
+}
+
+func TestAudit(t *testing.T) {
+	auditLog := `[
+		{"time":"2026-01-02T10:00:00Z","actor":"jane@example.com","source":"cli","action":"SetImage","revision":"1","message":"guestbook=argoproj/rollouts-demo:yellow"},
+		{"time":"2026-01-02T10:05:00Z","actor":"system:serviceaccount:argo-rollouts:argo-rollouts-dashboard","source":"dashboard","action":"Promote","revision":"2","step":"1/3"},
+		{"time":"2026-01-02T10:20:00Z","actor":"argo-rollouts-controller","source":"controller","action":"Rollback","revision":"2","step":"3/3","message":"analysis run 'guestbook-2-post' failed"}
+	]`
+	tf, o := options.NewFakeArgoRolloutsOptions(newRollout(auditLog))
+	defer tf.Cleanup()
+	cmd := NewCmdAudit(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	expectedOut := `TIME                  ACTOR                                                        SOURCE      ACTION    REVISION  STEP  MESSAGE
+2026-01-02T10:00:00Z  jane@example.com                                             cli         SetImage  1         -     guestbook=argoproj/rollouts-demo:yellow
+2026-01-02T10:05:00Z  system:serviceaccount:argo-rollouts:argo-rollouts-dashboard  dashboard   Promote   2         1/3   -
+2026-01-02T10:20:00Z  argo-rollouts-controller                                     controller  Rollback  2         3/3   analysis run 'guestbook-2-post' failed
+`
+	assert.Equal(t, expectedOut, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestAuditInvalidLog(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newRollout("not json"))
+	defer tf.Cleanup()
+	cmd := NewCmdAudit(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid rollout.argoproj.io/audit-log annotation")
+}
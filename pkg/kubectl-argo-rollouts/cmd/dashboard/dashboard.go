@@ -5,6 +5,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	"github.com/argoproj/argo-rollouts/server"
+	"github.com/argoproj/argo-rollouts/server/auth"
 	"github.com/spf13/cobra"
 )
 
@@ -14,12 +15,16 @@ var (
 	%[1]s dashboard
 
 	# Start UI dashboard on a specific port
-	%[1]s dashboard --port 8080`
+	%[1]s dashboard --port 8080
+
+	# Start UI dashboard which authenticates and authorizes its users
+	%[1]s dashboard --auth-config auth.yaml`
 )
 
 func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 	var rootPath string
 	var port int
+	var authConfig string
 	var cmd = &cobra.Command{
 		Use:     "dashboard",
 		Short:   "Start UI dashboard",
@@ -36,6 +41,16 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 				DynamicClientset:  o.DynamicClientset(),
 				RootPath:          rootPath,
 			}
+			if authConfig != "" {
+				config, err := auth.LoadConfig(authConfig)
+				if err != nil {
+					return err
+				}
+				opts.Auth, err = auth.New(*config)
+				if err != nil {
+					return err
+				}
+			}
 
 			for {
 				ctx := context.Background()
@@ -48,6 +63,7 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 	}
 	cmd.Flags().StringVar(&rootPath, "root-path", "rollouts", "changes the root path of the dashboard")
 	cmd.Flags().IntVarP(&port, "port", "p", 3100, "port to listen on")
+	cmd.Flags().StringVar(&authConfig, "auth-config", "", "file of the config which authenticates the users of the dashboard and authorizes their actions")
 
 	return cmd
 }
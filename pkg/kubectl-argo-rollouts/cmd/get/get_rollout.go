@@ -163,11 +163,69 @@ func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 	fmt.Fprintf(o.Out, tableFormat, "  Updated:", roInfo.Updated)
 	fmt.Fprintf(o.Out, tableFormat, "  Ready:", roInfo.Ready)
 	fmt.Fprintf(o.Out, tableFormat, "  Available:", roInfo.Available)
+	if roInfo.MultiCluster != nil {
+		o.PrintMultiCluster(roInfo)
+	}
+	if len(roInfo.Dependencies) > 0 {
+		o.PrintDependencies(roInfo)
+	}
 
 	fmt.Fprintf(o.Out, "\n")
 	o.PrintRolloutTree(roInfo)
 }
 
+// PrintMultiCluster prints the wave of the cluster and the state of the rollout in the member clusters
+func (o *GetOptions) PrintMultiCluster(roInfo *rollout.RolloutInfo) {
+	mc := roInfo.MultiCluster
+	fmt.Fprint(o.Out, "MultiCluster:\n")
+	fmt.Fprintf(o.Out, tableFormat, "  Cluster:", mc.Cluster)
+	if mc.Wave != "" {
+		fmt.Fprintf(o.Out, tableFormat, "  Wave:", mc.Wave)
+	}
+	if len(mc.Members) > 0 {
+		fmt.Fprint(o.Out, "  Members:\n")
+	}
+	for _, member := range mc.Members {
+		var infoCols []string
+		if member.Phase != "" {
+			infoCols = append(infoCols, string(member.Phase))
+		}
+		if member.Aborted {
+			infoCols = append(infoCols, "Aborted")
+		}
+		if member.CurrentPodHash != "" {
+			infoCols = append(infoCols, member.CurrentPodHash)
+		}
+		if member.Message != "" {
+			infoCols = append(infoCols, member.Message)
+		}
+		fmt.Fprintf(o.Out, "    %s (%s): %s\n", member.Cluster, member.Wave, strings.Join(infoCols, ", "))
+	}
+}
+
+// PrintDependencies prints the rollouts the rollout depends on and whether they still hold back the update
+func (o *GetOptions) PrintDependencies(roInfo *rollout.RolloutInfo) {
+	fmt.Fprint(o.Out, "Dependencies:\n")
+	for _, dep := range roInfo.Dependencies {
+		icon := info.IconWaiting
+		if dep.Satisfied {
+			icon = info.IconOK
+		}
+		name := fmt.Sprintf("%s/%s", dep.Namespace, dep.Name)
+		if dep.Step != "" {
+			name = fmt.Sprintf("%s (step %s)", name, dep.Step)
+		}
+		var infoCols []string
+		if dep.Phase != "" {
+			infoCols = append(infoCols, string(dep.Phase))
+		}
+		if !dep.Satisfied && dep.Message != "" {
+			infoCols = append(infoCols, dep.Message)
+		}
+		fmt.Fprintf(o.Out, "  %s %s: %s\n", o.colorize(icon), name, strings.Join(infoCols, ", "))
+	}
+}
+
 func (o *GetOptions) PrintRolloutTree(roInfo *rollout.RolloutInfo) {
 	w := ansiterm.NewTabWriter(o.Out, 0, 0, 2, ' ', 0)
 	o.PrintHeader(w)
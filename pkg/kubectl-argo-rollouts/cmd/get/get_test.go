@@ -561,3 +561,73 @@ NAME                                                     KIND         STATUS
 `, "\n")
 	assertStdout(t, expectedOut, o.IOStreams)
 }
+
+func TestGetMultiClusterRollout(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	rolloutObjs.Rollouts[0].Status.MultiCluster = &v1alpha1.MultiClusterStatus{
+		Cluster: "prod-1",
+		Wave:    "prod",
+		Members: []v1alpha1.MemberClusterStatus{
+			{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseHealthy, CurrentPodHash: "65fb5ffc84"},
+			{Cluster: "prod-2", Wave: "prod", Phase: v1alpha1.RolloutPhaseDegraded, CurrentPodHash: "65fb5ffc84", Aborted: true, Message: "RolloutAborted"},
+			{Cluster: "prod-3", Wave: "prod", Message: "Rollout not found"},
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+Replicas:
+  Desired:       5
+  Current:       6
+  Updated:       1
+  Ready:         5
+  Available:     5
+MultiCluster:
+  Cluster:       prod-1
+  Wave:          prod
+  Members:
+    staging-1 (staging): Healthy, 65fb5ffc84
+    prod-2 (prod): Degraded, Aborted, 65fb5ffc84, RolloutAborted
+    prod-3 (prod): Rollout not found
+
+`, "\n")
+	stdout := stripTrailingWhitespace(o.Out.(*bytes.Buffer).String())
+	assert.Contains(t, stdout, expectedOut)
+}
+
+func TestGetRolloutDependencies(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	rolloutObjs.Rollouts[0].Status.Dependencies = []v1alpha1.RolloutDependencyStatus{
+		{Name: "backend", Namespace: "jesse-test", Phase: v1alpha1.RolloutPhaseHealthy, Satisfied: true},
+		{Name: "payments", Namespace: "shop", Step: "half", Phase: v1alpha1.RolloutPhaseProgressing, Message: "waiting for update to be past step 'half'"},
+		{Name: "auth", Namespace: "jesse-test", Message: "rollout not found"},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+  Available:     5
+Dependencies:
+  ✔ jesse-test/backend: Healthy
+  ◷ shop/payments (step half): Progressing, waiting for update to be past step 'half'
+  ◷ jesse-test/auth: rollout not found
+
+`, "\n")
+	stdout := stripTrailingWhitespace(o.Out.(*bytes.Buffer).String())
+	assert.Contains(t, stdout, expectedOut)
+}
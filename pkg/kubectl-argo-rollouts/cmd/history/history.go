@@ -0,0 +1,180 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+)
+
+const (
+	historyExample = `
+	# List the revisions of a rollout
+	%[1]s history guestbook
+
+	# Show what changed in the latest revision
+	%[1]s history guestbook --to 0
+
+	# Show what changed between revision 3 and revision 5
+	%[1]s history guestbook --from 3 --to 5`
+
+	historyUsage = `This command lists the revisions of a rollout which still have a ReplicaSet, with their images,
+step, analysis and change cause. With --from or --to, it shows the changes to the pod template between two revisions.
+A --to of 0 is the latest revision, and an omitted --from is the revision before --to.`
+
+	headerFmtString = "REVISION\tSTATUS\tSTEP\tIMAGES\tANALYSIS\tAGE\tCHANGE-CAUSE\n"
+)
+
+// NewCmdHistory returns a new instance of an `rollouts history` command
+func NewCmdHistory(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var (
+		fromRevision = int64(0)
+		toRevision   = int64(0)
+	)
+	var cmd = &cobra.Command{
+		Use:          "history ROLLOUT_NAME",
+		Short:        "Show the revision history of a rollout",
+		Long:         historyUsage,
+		Example:      o.Example(historyExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			name := args[0]
+			ctx := c.Context()
+			rolloutsClient := o.RolloutsClientset()
+			kubeClient := o.KubeClientset()
+			if c.Flags().Changed("from") || c.Flags().Changed("to") {
+				revisionDiff, err := GetRevisionDiff(ctx, rolloutsClient, kubeClient, o.Namespace(), name, fromRevision, toRevision)
+				if err != nil {
+					return err
+				}
+				return printRevisionDiff(o, revisionDiff)
+			}
+			history, err := GetRolloutHistory(ctx, rolloutsClient, kubeClient, o.Namespace(), name)
+			if err != nil {
+				return err
+			}
+			printHistory(o, history)
+			return nil
+		},
+		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
+	}
+	cmd.Flags().Int64Var(&fromRevision, "from", fromRevision, "The revision to show the changes from. Defaults to the revision before --to.")
+	cmd.Flags().Int64Var(&toRevision, "to", toRevision, "The revision to show the changes to. Defaults to 0 (latest revision).")
+	return cmd
+}
+
+// GetRolloutHistory returns the revisions of a rollout
+func GetRolloutHistory(ctx context.Context, rolloutsClient roclientset.Interface, kubeClient kubernetes.Interface, namespace, name string) (*rollout.RolloutHistory, error) {
+	ro, allReplicaSets, err := getRolloutAndReplicaSets(ctx, rolloutsClient, kubeClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	allARs, err := rolloutsClient.ArgoprojV1alpha1().AnalysisRuns(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var allARsP []*v1alpha1.AnalysisRun
+	for i := range allARs.Items {
+		allARsP = append(allARsP, &allARs.Items[i])
+	}
+	return info.NewRolloutHistory(ro, allReplicaSets, allARsP), nil
+}
+
+// GetRevisionDiff returns the changes to the pod template of a rollout between two revisions
+func GetRevisionDiff(ctx context.Context, rolloutsClient roclientset.Interface, kubeClient kubernetes.Interface, namespace, name string, fromRevision, toRevision int64) (*rollout.RevisionDiff, error) {
+	ro, allReplicaSets, err := getRolloutAndReplicaSets(ctx, rolloutsClient, kubeClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return info.NewRevisionDiff(ro, allReplicaSets, fromRevision, toRevision)
+}
+
+func getRolloutAndReplicaSets(ctx context.Context, rolloutsClient roclientset.Interface, kubeClient kubernetes.Interface, namespace, name string) (*v1alpha1.Rollout, []*appsv1.ReplicaSet, error) {
+	ro, err := rolloutsClient.ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := metav1.ListOptions{}
+	if ro.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ro.Spec.Selector)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts.LabelSelector = selector.String()
+	}
+	rsList, err := kubeClient.AppsV1().ReplicaSets(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve replica sets from rollout %s: %v", name, err)
+	}
+	var allReplicaSets []*appsv1.ReplicaSet
+	for i := range rsList.Items {
+		allReplicaSets = append(allReplicaSets, &rsList.Items[i])
+	}
+	return ro, allReplicaSets, nil
+}
+
+func printHistory(o *options.ArgoRolloutsOptions, history *rollout.RolloutHistory) {
+	if len(history.Revisions) == 0 {
+		fmt.Fprintln(o.ErrOut, "No revisions found.")
+		return
+	}
+	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, headerFmtString)
+	for _, revision := range history.Revisions {
+		var analysis []string
+		for _, run := range revision.AnalysisRuns {
+			analysis = append(analysis, fmt.Sprintf("%s %s", run.Icon, run.Status))
+		}
+		age := ""
+		if revision.CreatedAt != nil {
+			age = info.Age(metav1.ObjectMeta{CreationTimestamp: *revision.CreatedAt})
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			revision.Revision,
+			revision.Status,
+			orDash(revision.Step),
+			orDash(strings.Join(revision.Images, ",")),
+			orDash(strings.Join(analysis, ",")),
+			age,
+			orDash(revision.ChangeCause),
+		)
+	}
+	_ = w.Flush()
+}
+
+func printRevisionDiff(o *options.ArgoRolloutsOptions, revisionDiff *rollout.RevisionDiff) error {
+	fmt.Fprintf(o.Out, "Changes from revision %d to revision %d:\n", revisionDiff.FromRevision, revisionDiff.ToRevision)
+	if revisionDiff.Patch == "{}" {
+		fmt.Fprintln(o.Out, "No changes to the pod template.")
+		return nil
+	}
+	yamlBytes, err := yaml.JSONToYAML([]byte(revisionDiff.Patch))
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.Out, string(yamlBytes))
+	return nil
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,94 @@
+package history
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func TestHistoryUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+}
+
+func TestHistoryRolloutNotFound(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"does-not-exist"})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, "Error: rollouts.argoproj.io \"does-not-exist\" not found\n", stderr)
+}
+
+func TestHistoryExperimentAnalysisRollout(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name})
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	expectedOut := `REVISION  STATUS  STEP  IMAGES                         ANALYSIS        AGE  CHANGE-CAUSE
+2         Canary  1/2   argoproj/rollouts-demo:yellow  ? Inconclusive  7d   -
+1         Stable  -     argoproj/rollouts-demo:blue    -               7d   -
+`
+	assert.Equal(t, expectedOut, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestHistoryRevisionDiff(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--to", "0"})
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "Changes from revision 30 to revision 31:\n")
+	assert.Contains(t, stdout, "image: argoproj/rollouts-demo:does-not-exist")
+	assert.Empty(t, stderr)
+}
+
+func TestHistoryRevisionDiffNotFound(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--from", "1"})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "Error: unable to find specified revision 1 in history\n", stderr)
+}
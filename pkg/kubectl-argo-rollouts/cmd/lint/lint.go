@@ -11,6 +11,8 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 	"github.com/spf13/cobra"
 	goyaml "gopkg.in/yaml.v2"
 	v1 "k8s.io/api/core/v1"
@@ -73,6 +75,9 @@ func (l *LintOptions) lintResource(path string) error {
 	var un unstructured.Unstructured
 	var refResource validation.ReferencedResources
 	var fileRollouts []v1alpha1.Rollout
+	var errList field.ErrorList
+	// strategy templates of the file by the keys of the strategy references of the rollouts
+	strategyTemplates := strategytemplate.MapGetter{}
 
 	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
 	for {
@@ -104,18 +109,37 @@ func (l *LintOptions) lintResource(path string) error {
 			}
 			fileRollouts = append(fileRollouts, ro)
 		}
+		if gvk.Group == rollouts.Group && (gvk.Kind == rollouts.RolloutStrategyTemplateKind || gvk.Kind == rollouts.ClusterRolloutStrategyTemplateKind) {
+			var template v1alpha1.RolloutStrategyTemplate
+			err := unmarshal(valueBytes, &template)
+			if err != nil {
+				return err
+			}
+			ref := v1alpha1.RolloutStrategyRef{Name: template.Name, ClusterScope: gvk.Kind == rollouts.ClusterRolloutStrategyTemplateKind}
+			strategyTemplates[strategytemplate.Key(ref, template.Namespace)] = template.Spec
+			errList = append(errList, validation.ValidateRolloutStrategyTemplateSpec(template.Spec, field.NewPath("spec"))...)
+		}
 		err = buildAllReferencedResources(un, &refResource)
 		if err != nil {
 			return err
 		}
 	}
 
-	setServiceTypeAndManagedAnnotation(fileRollouts, refResource)
-	setIngressManagedAnnotation(fileRollouts, refResource)
-	setVirtualServiceManagedAnnotation(fileRollouts, refResource)
-
-	var errList field.ErrorList
+	// the rollouts referencing a strategy template are linted with the strategy resolved from the template of the file
+	var resolvedRollouts []v1alpha1.Rollout
 	for _, rollout := range fileRollouts {
+		if err := strategytemplate.ResolveRef(&rollout, strategyTemplates); err != nil {
+			errList = append(errList, err.(*field.Error))
+			continue
+		}
+		resolvedRollouts = append(resolvedRollouts, rollout)
+	}
+
+	setServiceTypeAndManagedAnnotation(resolvedRollouts, refResource)
+	setIngressManagedAnnotation(resolvedRollouts, refResource)
+	setVirtualServiceManagedAnnotation(resolvedRollouts, refResource)
+
+	for _, rollout := range resolvedRollouts {
 		roRef := matchRolloutToReferences(rollout, refResource)
 
 		errList = append(errList, validation.ValidateRollout(&roRef.Rollout)...)
@@ -253,7 +277,8 @@ func setServiceTypeAndManagedAnnotation(rollouts []v1alpha1.Rollout, refResource
 // it will add the managed by annotations just for linting so that we can later match up resources to a rollout resources
 // for the case when we have multiple rollout resources in a single manifest.
 func setIngressManagedAnnotation(rollouts []v1alpha1.Rollout, refResource validation.ReferencedResources) {
-	for _, rollout := range rollouts {
+	for j := range rollouts {
+		rollout := rolloututil.TrafficRoutingRollout(&rollouts[j])
 		for i := range refResource.Ingresses {
 			var serviceName string
 
@@ -287,7 +312,8 @@ func setIngressManagedAnnotation(rollouts []v1alpha1.Rollout, refResource valida
 // setVirtualServiceManagedAnnotation This function finds virtual services that are listed in the rollout resources and
 // adds the ManagedByRolloutsKey to the annotations of the virtual services.
 func setVirtualServiceManagedAnnotation(ro []v1alpha1.Rollout, refResource validation.ReferencedResources) {
-	for _, rollout := range ro {
+	for j := range ro {
+		rollout := rolloututil.TrafficRoutingRollout(&ro[j])
 		for i := range refResource.VirtualServices {
 			if rollout.Spec.Strategy.Canary == nil || rollout.Spec.Strategy.Canary.TrafficRouting == nil || rollout.Spec.Strategy.Canary.TrafficRouting.Istio == nil {
 				return
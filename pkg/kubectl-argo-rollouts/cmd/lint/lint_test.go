@@ -30,6 +30,7 @@ func TestLintValidRollout(t *testing.T) {
 		"testdata/valid-nginx-basic-canary.yml",
 		"testdata/valid-istio-v1beta1-mulitiple-virtualsvcs.yml",
 		"testdata/valid-nginx-smi-with-vsvc.yaml",
+		"testdata/valid-strategy-template.yml",
 	}
 
 	for _, filename := range tests {
@@ -87,6 +88,10 @@ func TestLintInvalidRollout(t *testing.T) {
 			filename: "testdata/invalid-nginx-canary.yml",
 			errmsg:   "Error: spec.strategy.steps[1].experiment.templates[0].weight: Invalid value: 20: Experiment template weight is only available for TrafficRouting with SMI, ALB, and Istio at this time\n",
 		},
+		{
+			filename: "testdata/invalid-strategy-template.yml",
+			errmsg:   "Error: spec.strategyRef: Invalid value: \"standard-canary\": argument \"app\" was not supplied\n",
+		},
 	}
 
 	runCmd = func(filename string, errmsg string) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -37,7 +38,9 @@ func NewCmdPause(o *options.ArgoRolloutsOptions) *cobra.Command {
 				if err != nil {
 					return err
 				}
+				auditErr := o.RecordAudit(c.Context(), ro.Namespace, ro.Name, audit.ActionPause, "")
 				fmt.Fprintf(o.Out, "rollout '%s' paused\n", ro.Name)
+				o.PrintAuditError(auditErr)
 			}
 			return nil
 		},
@@ -2,11 +2,13 @@ package pause
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -62,6 +64,40 @@ func TestPauseCmd(t *testing.T) {
 	assert.Empty(t, stderr)
 }
 
+func TestPauseCmdAuditError(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		ro.Spec.Paused = true
+		return true, &ro, nil
+	})
+	fakeDynamicClient := o.DynamicClient.(*dynamicfake.FakeDynamicClient)
+	fakeDynamicClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, errors.New("intentional error")
+	})
+
+	cmd := NewCmdPause(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.Nil(t, err)
+
+	// the rollout is paused even though the action could not be recorded
+	assert.True(t, ro.Spec.Paused)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "rollout 'guestbook' paused\n", stdout)
+	assert.Equal(t, "warning: failed to record the Pause of rollout 'guestbook' in its audit log: intentional error\n", stderr)
+}
+
 func TestPauseCmdError(t *testing.T) {
 	tf, o := options.NewFakeArgoRolloutsOptions(&v1alpha1.Rollout{})
 	defer tf.Cleanup()
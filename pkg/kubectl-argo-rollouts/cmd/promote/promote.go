@@ -13,6 +13,7 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 )
 
@@ -22,12 +23,16 @@ const (
 	%[1]s promote guestbook
 
 	# Fully promote a rollout to desired version, skipping analysis, pauses, and steps
-	%[1]s promote guestbook --full`
+	%[1]s promote guestbook --full
+
+	# Let the current update of a rollout progress outside of its deploy windows
+	%[1]s promote guestbook --ignore-window`
 
 	promoteUsage = `Promote a rollout
 
 Promotes a rollout paused at a canary step, or a paused blue-green pre-promotion.
-To skip analysis, pauses and steps entirely, use '--full' to fully promote the rollout`
+To skip analysis, pauses and steps entirely, use '--full' to fully promote the rollout.
+To let the current update progress regardless of the deploy windows, use '--ignore-window'`
 )
 
 const (
@@ -37,12 +42,14 @@ const (
 	clearPauseConditionsAndControllerPausePatch = `{"status":{"pauseConditions":null, "controllerPause":false, "currentStepIndex":%d}}`
 	unpauseAndClearPauseConditionsPatch         = `{"spec":{"paused":false},"status":{"pauseConditions":null}}`
 	promoteFullPatch                            = `{"status":{"promoteFull":true}}`
+	ignoreDeployWindowPatch                     = `{"status":{"ignoreDeployWindow":true}}`
 	clearPauseConditionsPatchWithStep           = `{"status":{"pauseConditions":null, "currentStepIndex":%d}}`
 	unpauseAndClearPauseConditionsPatchWithStep = `{"spec":{"paused":false},"status":{"pauseConditions":null, "currentStepIndex":%d}}`
 
 	useBothSkipFlagsError         = "Cannot use skip-current-step and skip-all-steps flags at the same time"
 	skipFlagsWithBlueGreenError   = "Cannot skip steps of a bluegreen rollout. Run without a flags"
 	skipFlagWithNoStepCanaryError = "Cannot skip steps of a rollout without steps"
+	ignoreWindowWithNoUpdateError = "Cannot ignore the deploy windows of rollout '%s' since it has no update in progress"
 )
 
 // NewCmdPromote returns a new instance of an `rollouts promote` command
@@ -51,6 +58,7 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 		skipCurrentStep = false
 		skipAllSteps    = false
 		full            = false
+		ignoreWindow    = false
 	)
 	var cmd = &cobra.Command{
 		Use:          "promote ROLLOUT_NAME",
@@ -67,15 +75,20 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 			}
 			name := args[0]
 			rolloutIf := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(o.Namespace())
-			ro, err := PromoteRollout(rolloutIf, name, skipCurrentStep, skipAllSteps, full)
+			ro, err := PromoteRollout(rolloutIf, name, skipCurrentStep, skipAllSteps, full, ignoreWindow)
 			if err != nil {
 				return err
 			}
+			action, message := audit.PromoteAction(full, ignoreWindow)
+			auditErr := o.RecordAudit(c.Context(), ro.Namespace, ro.Name, action, message)
 			if full {
 				fmt.Fprintf(o.Out, "rollout '%s' fully promoted\n", ro.Name)
+			} else if ignoreWindow {
+				fmt.Fprintf(o.Out, "rollout '%s' promoted ignoring the deploy windows\n", ro.Name)
 			} else {
 				fmt.Fprintf(o.Out, "rollout '%s' promoted\n", ro.Name)
 			}
+			o.PrintAuditError(auditErr)
 
 			return nil
 		},
@@ -88,16 +101,32 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 	cmd.Flags().MarkDeprecated("skip-all-steps", "use --full instead")
 	cmd.Flags().MarkShorthandDeprecated("a", "use --full instead")
 	cmd.Flags().BoolVar(&full, "full", false, "Perform a full promotion, skipping analysis, pauses, and steps")
+	cmd.Flags().BoolVar(&ignoreWindow, "ignore-window", false, "Let the current update progress regardless of the deploy windows, without skipping steps")
 	return cmd
 }
 
-// PromoteRollout promotes a rollout to the next step, or to end of all steps
-func PromoteRollout(rolloutIf clientset.RolloutInterface, name string, skipCurrentStep, skipAllSteps, full bool) (*v1alpha1.Rollout, error) {
+// PromoteRollout promotes a rollout to the next step, or to end of all steps. When ignoreWindow is
+// set, the current update is only allowed to progress outside of the deploy windows, unless full is also set.
+func PromoteRollout(rolloutIf clientset.RolloutInterface, name string, skipCurrentStep, skipAllSteps, full, ignoreWindow bool) (*v1alpha1.Rollout, error) {
 	ctx := context.TODO()
 	ro, err := rolloutIf.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	if ignoreWindow {
+		if ro.Status.CurrentPodHash == ro.Status.StableRS && !full {
+			return nil, fmt.Errorf(ignoreWindowWithNoUpdateError, name)
+		}
+		if ro.Status.CurrentPodHash != ro.Status.StableRS {
+			ro, err = rolloutIf.Patch(ctx, name, types.MergePatchType, []byte(ignoreDeployWindowPatch), metav1.PatchOptions{}, "status")
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !full {
+			return ro, nil
+		}
+	}
 	if skipCurrentStep || skipAllSteps {
 		if ro.Spec.Strategy.BlueGreen != nil {
 			return nil, fmt.Errorf(skipFlagsWithBlueGreenError)
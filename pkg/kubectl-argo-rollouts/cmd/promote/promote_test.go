@@ -556,3 +556,117 @@ func TestPromoteInconclusiveStep(t *testing.T) {
 	assert.Equal(t, stdout, "rollout 'guestbook' promoted\n")
 	assert.Empty(t, stderr)
 }
+
+func TestPromoteCmdIgnoreWindow(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:       "abc123",
+			CurrentPodHash: "def456",
+			PauseConditions: []v1alpha1.PauseCondition{{
+				Reason: v1alpha1.PauseReasonOutsideDeployWindow,
+			}},
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	var patches []string
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			patches = append(patches, string(patchAction.GetPatch()))
+			if string(patchAction.GetPatch()) == ignoreDeployWindowPatch {
+				ro.Status.IgnoreDeployWindow = true
+			}
+		}
+		return true, &ro, nil
+	})
+
+	cmd := NewCmdPromote(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--ignore-window"})
+	err := cmd.Execute()
+	assert.Nil(t, err)
+
+	// only the deploy windows are overridden, the pause conditions are cleared by the controller
+	assert.Equal(t, []string{ignoreDeployWindowPatch}, patches)
+	assert.True(t, ro.Status.IgnoreDeployWindow)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, stdout, "rollout 'guestbook' promoted ignoring the deploy windows\n")
+	assert.Empty(t, stderr)
+}
+
+func TestPromoteCmdIgnoreWindowWithNoUpdate(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:       "abc123",
+			CurrentPodHash: "abc123",
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		// neither the deploy windows are overridden nor an audit entry is recorded
+		t.FailNow()
+		return true, &ro, nil
+	})
+
+	cmd := NewCmdPromote(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--ignore-window"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "Cannot ignore the deploy windows of rollout 'guestbook' since it has no update in progress")
+}
+
+func TestPromoteCmdIgnoreWindowAndFull(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:       "abc123",
+			CurrentPodHash: "def456",
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	var patches []string
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			patches = append(patches, string(patchAction.GetPatch()))
+		}
+		return true, &ro, nil
+	})
+
+	cmd := NewCmdPromote(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--ignore-window", "--full"})
+	err := cmd.Execute()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{ignoreDeployWindowPatch, promoteFullPatch}, patches)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, stdout, "rollout 'guestbook' fully promoted\n")
+}
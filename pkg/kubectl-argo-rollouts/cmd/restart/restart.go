@@ -13,6 +13,7 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -60,7 +61,9 @@ func NewCmdRestart(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			auditErr := o.RecordAudit(c.Context(), ro.Namespace, ro.Name, audit.ActionRestart, audit.RestartMessage(restartAt))
 			fmt.Fprintf(o.Out, "rollout '%s' restarts in %s\n", ro.Name, in)
+			o.PrintAuditError(auditErr)
 			return nil
 		},
 		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
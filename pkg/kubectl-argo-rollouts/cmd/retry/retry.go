@@ -13,6 +13,7 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -73,7 +74,9 @@ func NewCmdRetryRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
 				if err != nil {
 					return err
 				}
+				auditErr := o.RecordAudit(c.Context(), ro.Namespace, ro.Name, audit.ActionRetry, "")
 				fmt.Fprintf(o.Out, "rollout '%s' retried\n", ro.Name)
+				o.PrintAuditError(auditErr)
 			}
 			return nil
 		},
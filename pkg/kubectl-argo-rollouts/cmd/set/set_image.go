@@ -15,6 +15,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -61,7 +62,9 @@ func NewCmdSetImage(o *options.ArgoRolloutsOptions) *cobra.Command {
 				}
 				break
 			}
+			auditErr := o.RecordAudit(c.Context(), o.Namespace(), rollout, audit.ActionSetImage, audit.SetImageMessage(container, image))
 			fmt.Fprintf(o.Out, "%s \"%s\" image updated\n", strings.ToLower(un.GetKind()), un.GetName())
+			o.PrintAuditError(auditErr)
 			return nil
 		},
 		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
@@ -0,0 +1,244 @@
+package simulate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	goyaml "gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/rollout/simulator"
+)
+
+const (
+	simulateExample = `
+	# Simulate an update of a rollout
+	%[1]s simulate -f my-rollout.yaml
+
+	# Simulate an update of a rollout whose analysis fails at the second step
+	%[1]s simulate -f my-rollout.yaml --fail-analysis-at-step 1
+
+	# Print the simulation as JSON
+	%[1]s simulate -f my-rollout.yaml -o json`
+
+	simulateUsage = `This command runs the rollout controller against an in-memory cluster to show how an update of a rollout
+progresses: the scale of the ReplicaSets, the weights sent to the traffic router, the pauses and the analysis runs.
+The file may contain the AnalysisTemplates and ClusterAnalysisTemplates the rollout references. Analysis runs succeed,
+indefinite pauses are promoted, and the configured traffic routers are replaced by an in-memory router, unless
+failures are injected with --fail-analysis-at-step or --abort-at-step.`
+
+	headerFmtString = "TIME\tSTEP\tSTABLE\tNEW\tOLDER\tWEIGHT\tANALYSIS\tSTATUS\tEVENTS\n"
+)
+
+type SimulateOptions struct {
+	options.ArgoRolloutsOptions
+	File               string
+	Output             string
+	FailAnalysisAtStep int32
+	AbortAtStep        int32
+}
+
+// NewCmdSimulate returns a new instance of a `rollouts simulate` command
+func NewCmdSimulate(o *options.ArgoRolloutsOptions) *cobra.Command {
+	simulateOptions := SimulateOptions{
+		ArgoRolloutsOptions: *o,
+		FailAnalysisAtStep:  -1,
+		AbortAtStep:         -1,
+	}
+	var cmd = &cobra.Command{
+		Use:          "simulate",
+		Short:        "Simulate an update of a Rollout",
+		Long:         simulateUsage,
+		Example:      o.Example(simulateExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if simulateOptions.File == "" {
+				return o.UsageErr(c)
+			}
+			if simulateOptions.Output != "" && simulateOptions.Output != "json" {
+				return fmt.Errorf("unsupported output format: %s", simulateOptions.Output)
+			}
+			return simulateOptions.simulate()
+		},
+	}
+	cmd.Flags().StringVarP(&simulateOptions.File, "filename", "f", "", "File with the rollout to simulate, and the analysis templates it references")
+	cmd.Flags().StringVarP(&simulateOptions.Output, "output", "o", "", "Output format. One of: json")
+	cmd.Flags().Int32Var(&simulateOptions.FailAnalysisAtStep, "fail-analysis-at-step", simulateOptions.FailAnalysisAtStep, "Fail the analysis runs and experiments started at this canary step index (0 for rollouts without steps)")
+	cmd.Flags().Int32Var(&simulateOptions.AbortAtStep, "abort-at-step", simulateOptions.AbortAtStep, "Abort the update once it reaches this canary step index (0 for rollouts without steps)")
+	return cmd
+}
+
+func (s *SimulateOptions) simulate() error {
+	ro, templates, clusterTemplates, err := readFile(s.File)
+	if err != nil {
+		return err
+	}
+	var simulationOptions simulator.SimulationOptions
+	if s.FailAnalysisAtStep >= 0 {
+		simulationOptions.FailAnalysisAtStep = &s.FailAnalysisAtStep
+	}
+	if s.AbortAtStep >= 0 {
+		simulationOptions.AbortAtStep = &s.AbortAtStep
+	}
+
+	// the controller logs every reconciliation, which would bury the timeline
+	logger := log.StandardLogger()
+	defer func(out io.Writer, level log.Level) {
+		logger.SetOutput(out)
+		logger.SetLevel(level)
+	}(logger.Out, logger.GetLevel())
+	logger.SetOutput(s.ErrOut)
+	logger.SetLevel(log.WarnLevel)
+	if s.Log.GetLevel() > log.InfoLevel {
+		logger.SetLevel(s.Log.GetLevel())
+	}
+
+	steps, simulationErr := simulator.Simulate(ro, templates, clusterTemplates, simulationOptions)
+	if s.Output == "json" {
+		if steps == nil {
+			steps = []simulator.SimulationStep{}
+		}
+		jsonBytes, err := json.MarshalIndent(steps, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(s.Out, string(jsonBytes))
+	} else if len(steps) > 0 {
+		printSimulation(s.Out, ro, steps)
+	}
+	return simulationErr
+}
+
+// readFile returns the rollout and the analysis templates of a file
+func readFile(path string) (*v1alpha1.Rollout, []*v1alpha1.AnalysisTemplate, []*v1alpha1.ClusterAnalysisTemplate, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var fileRollouts []*v1alpha1.Rollout
+	var templates []*v1alpha1.AnalysisTemplate
+	var clusterTemplates []*v1alpha1.ClusterAnalysisTemplate
+	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
+	for {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			if err != io.EOF {
+				return nil, nil, nil, err
+			}
+			break
+		}
+		if value == nil {
+			continue
+		}
+		valueBytes, err := goyaml.Marshal(value)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		var un unstructured.Unstructured
+		if err = yaml.UnmarshalStrict(valueBytes, &un, yaml.DisallowUnknownFields); err != nil {
+			return nil, nil, nil, err
+		}
+
+		gvk := un.GroupVersionKind()
+		if gvk.Group != rollouts.Group {
+			continue
+		}
+		switch gvk.Kind {
+		case rollouts.RolloutKind:
+			var ro v1alpha1.Rollout
+			if err := yaml.UnmarshalStrict(valueBytes, &ro, yaml.DisallowUnknownFields); err != nil {
+				return nil, nil, nil, err
+			}
+			fileRollouts = append(fileRollouts, &ro)
+		case rollouts.AnalysisTemplateKind:
+			var template v1alpha1.AnalysisTemplate
+			if err := yaml.UnmarshalStrict(valueBytes, &template, yaml.DisallowUnknownFields); err != nil {
+				return nil, nil, nil, err
+			}
+			templates = append(templates, &template)
+		case rollouts.ClusterAnalysisTemplateKind:
+			var template v1alpha1.ClusterAnalysisTemplate
+			if err := yaml.UnmarshalStrict(valueBytes, &template, yaml.DisallowUnknownFields); err != nil {
+				return nil, nil, nil, err
+			}
+			clusterTemplates = append(clusterTemplates, &template)
+		}
+	}
+	if len(fileRollouts) != 1 {
+		return nil, nil, nil, errors.New("file must contain exactly one Rollout")
+	}
+	return fileRollouts[0], templates, clusterTemplates, nil
+}
+
+func printSimulation(out io.Writer, ro *v1alpha1.Rollout, steps []simulator.SimulationStep) {
+	stepCount := 0
+	if ro.Spec.Strategy.Canary != nil {
+		stepCount = len(ro.Spec.Strategy.Canary.Steps)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, headerFmtString)
+	for _, step := range steps {
+		stepStr := "-"
+		if step.StepIndex != nil && stepCount > 0 {
+			stepStr = fmt.Sprintf("%d/%d", *step.StepIndex, stepCount)
+			if step.Step != "" {
+				stepStr += " " + step.Step
+			}
+		}
+		var older []string
+		for i := range step.Older {
+			older = append(older, replicaSetString(&step.Older[i]))
+		}
+		weight := "-"
+		if step.Weight != nil {
+			weight = fmt.Sprintf("%d", *step.Weight)
+		}
+		var analysis []string
+		for _, run := range step.AnalysisRuns {
+			kind := run.Type
+			if kind == "" {
+				kind = run.Kind
+			}
+			analysis = append(analysis, fmt.Sprintf("%s:%s", kind, run.Phase))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			step.Elapsed.Duration.String(),
+			stepStr,
+			replicaSetString(step.Stable),
+			replicaSetString(step.New),
+			orDash(strings.Join(older, ",")),
+			weight,
+			orDash(strings.Join(analysis, ",")),
+			orDash(step.Phase),
+			orDash(strings.Join(step.Events, ",")),
+		)
+	}
+	_ = w.Flush()
+}
+
+func replicaSetString(rs *simulator.SimulatedReplicaSet) string {
+	if rs == nil {
+		return "-"
+	}
+	return fmt.Sprintf("rev%s:%d", rs.Revision, rs.Replicas)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+package simulate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/rollout/simulator"
+)
+
+func TestSimulateUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+}
+
+func TestSimulateCanary(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/canary.yaml"})
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Greater(t, len(lines), 2)
+	assert.Equal(t, []string{"TIME", "STEP", "STABLE", "NEW", "OLDER", "WEIGHT", "ANALYSIS", "STATUS", "EVENTS"}, strings.Fields(lines[0]))
+	assert.Contains(t, stdout, "1/5 pause          rev1:5  rev2:1  -       20      -                Paused")
+	assert.Contains(t, stdout, "Step:Successful")
+	assert.Equal(t, []string{"1m0s", "5/5", "rev2:5", "-", "-", "0", "Step:Successful", "Healthy", "ScalingReplicaSet"}, strings.Fields(lines[len(lines)-1]))
+	assert.Empty(t, stderr)
+}
+
+func TestSimulateFailAnalysisJSON(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/canary.yaml", "--fail-analysis-at-step", "2", "-o", "json"})
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	var steps []simulator.SimulationStep
+	require.NoError(t, json.Unmarshal([]byte(stdout), &steps))
+	require.NotEmpty(t, steps)
+	last := steps[len(steps)-1]
+	assert.Equal(t, "Degraded", last.Phase)
+	assert.Equal(t, "1", last.Stable.Revision)
+	assert.Equal(t, int32(0), last.New.Replicas)
+	require.Len(t, last.AnalysisRuns, 1)
+	assert.Equal(t, "Failed", last.AnalysisRuns[0].Phase)
+}
+
+func TestSimulateAbort(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/canary.yaml", "--abort-at-step", "1"})
+	err := cmd.Execute()
+
+	require.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "Aborted")
+	assert.NotContains(t, stdout, "Promoted")
+	assert.NotContains(t, stdout, "Healthy")
+}
+
+func TestSimulateInvalidFile(t *testing.T) {
+	tests := []struct {
+		args        []string
+		expectedErr string
+	}{
+		{[]string{"-f", "testdata/no-rollout.yaml"}, "Error: file must contain exactly one Rollout\n"},
+		{[]string{"-f", "testdata/canary.yaml", "-o", "yaml"}, "Error: unsupported output format: yaml\n"},
+		{[]string{"-f", "testdata/does-not-exist.yaml"}, "Error: open testdata/does-not-exist.yaml: no such file or directory\n"},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.args, " "), func(t *testing.T) {
+			tf, o := options.NewFakeArgoRolloutsOptions()
+			defer tf.Cleanup()
+			cmd := NewCmdSimulate(o)
+			cmd.PersistentPreRunE = o.PersistentPreRunE
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+
+			assert.Error(t, err)
+			stderr := o.ErrOut.(*bytes.Buffer).String()
+			assert.Equal(t, test.expectedErr, stderr)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	routils "github.com/argoproj/argo-rollouts/utils/unstructured"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -58,7 +59,9 @@ func NewCmdUndo(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			auditErr := o.RecordAudit(c.Context(), o.Namespace(), name, audit.ActionUndo, audit.UndoMessage(toRevision))
 			fmt.Fprintf(o.Out, result)
+			o.PrintAuditError(auditErr)
 			return nil
 		},
 		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
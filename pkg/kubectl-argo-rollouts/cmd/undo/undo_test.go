@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	kubetesting "k8s.io/client-go/testing"
 
@@ -44,6 +45,10 @@ func TestUndoCmd(t *testing.T) {
 	fakeClient := o.DynamicClient.(*dynamicfake.FakeDynamicClient)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
 		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			if patchAction.GetPatchType() != types.JSONPatchType {
+				// the audit log is recorded with a merge patch
+				return false, nil, nil
+			}
 			type patch struct {
 				Value corev1.PodTemplateSpec `json:"value"`
 			}
@@ -86,6 +91,10 @@ func TestUndoCmdToRevision(t *testing.T) {
 	fakeClient := o.DynamicClient.(*dynamicfake.FakeDynamicClient)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
 		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			if patchAction.GetPatchType() != types.JSONPatchType {
+				// the audit log is recorded with a merge patch
+				return false, nil, nil
+			}
 			type patch struct {
 				Value corev1.PodTemplateSpec `json:"value"`
 			}
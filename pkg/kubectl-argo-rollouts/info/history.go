@@ -0,0 +1,159 @@
+package info
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/diff"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+const (
+	// ChangeCauseAnnotation is the annotation which records who or what triggered a revision. It is
+	// copied from the rollout to the ReplicaSet of the revision when the revision is created.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+const (
+	RevisionStatusStable     = "Stable"
+	RevisionStatusCanary     = "Canary"
+	RevisionStatusPreview    = "Preview"
+	RevisionStatusAborted    = "Aborted"
+	RevisionStatusSuperseded = "Superseded"
+)
+
+// NewRolloutHistory returns every revision of the rollout which still has a ReplicaSet, newest first
+func NewRolloutHistory(ro *v1alpha1.Rollout, allReplicaSets []*appsv1.ReplicaSet, allARs []*v1alpha1.AnalysisRun) *rollout.RolloutHistory {
+	history := rollout.RolloutHistory{}
+	for _, rs := range ownedReplicaSetsByRevision(ro.UID, allReplicaSets) {
+		podTemplateHash := replicasetutil.GetPodTemplateHash(rs)
+		revisionInfo := rollout.RevisionInfo{
+			Revision:        int64(parseRevision(rs.Annotations)),
+			PodTemplateHash: podTemplateHash,
+			CreatedAt:       rs.CreationTimestamp.DeepCopy(),
+			Status:          revisionStatus(ro, podTemplateHash),
+			Step:            revisionStep(ro, podTemplateHash),
+			ChangeCause:     rs.Annotations[ChangeCauseAnnotation],
+		}
+		for _, ctr := range rs.Spec.Template.Spec.Containers {
+			revisionInfo.Images = append(revisionInfo.Images, ctr.Image)
+		}
+		var revisionARs []*v1alpha1.AnalysisRun
+		for _, run := range allARs {
+			if podTemplateHash != "" && run.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == podTemplateHash {
+				revisionARs = append(revisionARs, run)
+			}
+		}
+		revisionInfo.AnalysisRuns = getAnalysisRunInfo(ro.UID, revisionARs)
+		history.Revisions = append(history.Revisions, &revisionInfo)
+	}
+	return &history
+}
+
+// NewRevisionDiff returns the strategic merge patch which turns the pod template of fromRevision into the
+// pod template of toRevision. A toRevision of 0 is the latest revision, and a fromRevision of 0 is the
+// revision before toRevision.
+func NewRevisionDiff(ro *v1alpha1.Rollout, allReplicaSets []*appsv1.ReplicaSet, fromRevision, toRevision int64) (*rollout.RevisionDiff, error) {
+	replicaSets := ownedReplicaSetsByRevision(ro.UID, allReplicaSets)
+	if len(replicaSets) == 0 {
+		return nil, fmt.Errorf("no revision found for rollout %q", ro.Name)
+	}
+	toIndex := 0
+	if toRevision != 0 {
+		toIndex = revisionIndex(replicaSets, toRevision)
+		if toIndex < 0 {
+			return nil, fmt.Errorf("unable to find specified revision %v in history", toRevision)
+		}
+	}
+	var fromIndex int
+	if fromRevision != 0 {
+		fromIndex = revisionIndex(replicaSets, fromRevision)
+		if fromIndex < 0 {
+			return nil, fmt.Errorf("unable to find specified revision %v in history", fromRevision)
+		}
+	} else {
+		fromIndex = toIndex + 1
+		if fromIndex >= len(replicaSets) {
+			return nil, fmt.Errorf("no revision found before revision %v", parseRevision(replicaSets[toIndex].Annotations))
+		}
+	}
+
+	revisionDiff := rollout.RevisionDiff{
+		FromRevision: int64(parseRevision(replicaSets[fromIndex].Annotations)),
+		ToRevision:   int64(parseRevision(replicaSets[toIndex].Annotations)),
+	}
+	from := podTemplateWithoutHash(replicaSets[fromIndex])
+	to := podTemplateWithoutHash(replicaSets[toIndex])
+	patch, _, err := diff.CreateTwoWayMergePatch(from, to, corev1.PodTemplateSpec{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff revision %d and %d: %v", revisionDiff.FromRevision, revisionDiff.ToRevision, err)
+	}
+	revisionDiff.Patch = string(patch)
+	return &revisionDiff, nil
+}
+
+func ownedReplicaSetsByRevision(ownerUID types.UID, allReplicaSets []*appsv1.ReplicaSet) []*appsv1.ReplicaSet {
+	var owned []*appsv1.ReplicaSet
+	for _, rs := range allReplicaSets {
+		if ownerRef(rs.OwnerReferences, []types.UID{ownerUID}) != nil {
+			owned = append(owned, rs)
+		}
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		return parseRevision(owned[i].Annotations) > parseRevision(owned[j].Annotations)
+	})
+	return owned
+}
+
+func revisionIndex(replicaSets []*appsv1.ReplicaSet, revision int64) int {
+	for i, rs := range replicaSets {
+		if int64(parseRevision(rs.Annotations)) == revision {
+			return i
+		}
+	}
+	return -1
+}
+
+func podTemplateWithoutHash(rs *appsv1.ReplicaSet) *corev1.PodTemplateSpec {
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	return template
+}
+
+func revisionStatus(ro *v1alpha1.Rollout, podTemplateHash string) string {
+	isStable := ro.Status.StableRS != "" && ro.Status.StableRS == podTemplateHash
+	isCurrent := ro.Status.CurrentPodHash == podTemplateHash
+	switch {
+	case isCurrent && ro.Status.Abort && !isStable:
+		return RevisionStatusAborted
+	case isStable:
+		return RevisionStatusStable
+	case isCurrent && ro.Spec.Strategy.Canary != nil:
+		return RevisionStatusCanary
+	case isCurrent && ro.Spec.Strategy.BlueGreen != nil:
+		return RevisionStatusPreview
+	}
+	return RevisionStatusSuperseded
+}
+
+// revisionStep returns how far the revision got through the canary steps. Only the current revision
+// has a known step, and a stable current revision completed all of them.
+func revisionStep(ro *v1alpha1.Rollout, podTemplateHash string) string {
+	if ro.Spec.Strategy.Canary == nil || len(ro.Spec.Strategy.Canary.Steps) == 0 || ro.Status.CurrentPodHash != podTemplateHash {
+		return ""
+	}
+	steps := len(ro.Spec.Strategy.Canary.Steps)
+	if ro.Status.StableRS == podTemplateHash {
+		return fmt.Sprintf("%d/%d", steps, steps)
+	}
+	if ro.Status.CurrentStepIndex == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", *ro.Status.CurrentStepIndex, steps)
+}
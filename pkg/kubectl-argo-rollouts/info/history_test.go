@@ -0,0 +1,98 @@
+package info
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
+)
+
+func TestCanaryRolloutHistory(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	ro := rolloutObjs.Rollouts[0]
+	rolloutObjs.ReplicaSets[0].Annotations[ChangeCauseAnnotation] = "kubectl argo rollouts set image"
+	history := NewRolloutHistory(ro, rolloutObjs.ReplicaSets, rolloutObjs.AnalysisRuns)
+
+	require.Len(t, history.Revisions, 3)
+	canary, stable, old := history.Revisions[0], history.Revisions[1], history.Revisions[2]
+
+	assert.Equal(t, int64(31), canary.Revision)
+	assert.Equal(t, "65fb5ffc84", canary.PodTemplateHash)
+	assert.Equal(t, RevisionStatusCanary, canary.Status)
+	assert.Equal(t, fmt.Sprintf("0/%d", len(ro.Spec.Strategy.Canary.Steps)), canary.Step)
+	assert.Equal(t, []string{"argoproj/rollouts-demo:does-not-exist"}, canary.Images)
+	assert.Equal(t, rolloutObjs.ReplicaSets[0].CreationTimestamp, *canary.CreatedAt)
+
+	assert.Equal(t, int64(30), stable.Revision)
+	assert.Equal(t, RevisionStatusStable, stable.Status)
+	assert.Equal(t, "", stable.Step)
+	assert.Equal(t, []string{"argoproj/rollouts-demo:green"}, stable.Images)
+
+	assert.Equal(t, int64(29), old.Revision)
+	assert.Equal(t, RevisionStatusSuperseded, old.Status)
+
+	var changeCauses []string
+	for _, revision := range history.Revisions {
+		changeCauses = append(changeCauses, revision.ChangeCause)
+	}
+	assert.Contains(t, changeCauses, "kubectl argo rollouts set image")
+}
+
+func TestRolloutHistoryAnalysisRuns(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+	history := NewRolloutHistory(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.AnalysisRuns)
+
+	require.Len(t, history.Revisions, 2)
+	assert.Equal(t, int64(2), history.Revisions[0].Revision)
+	require.Len(t, history.Revisions[0].AnalysisRuns, 1)
+	assert.Equal(t, "rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr", history.Revisions[0].AnalysisRuns[0].ObjectMeta.Name)
+	assert.Equal(t, "Inconclusive", history.Revisions[0].AnalysisRuns[0].Status)
+	assert.Equal(t, int64(1), history.Revisions[1].Revision)
+	assert.Empty(t, history.Revisions[1].AnalysisRuns)
+}
+
+func TestAbortedRolloutHistory(t *testing.T) {
+	rolloutObjs := testdata.NewAbortedRollout()
+	history := NewRolloutHistory(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.AnalysisRuns)
+	require.NotEmpty(t, history.Revisions)
+	assert.Equal(t, RevisionStatusAborted, history.Revisions[0].Status)
+}
+
+func TestRevisionDiff(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	ro := rolloutObjs.Rollouts[0]
+
+	t.Run("Latest", func(t *testing.T) {
+		revisionDiff, err := NewRevisionDiff(ro, rolloutObjs.ReplicaSets, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(30), revisionDiff.FromRevision)
+		assert.Equal(t, int64(31), revisionDiff.ToRevision)
+
+		var patch map[string]any
+		require.NoError(t, json.Unmarshal([]byte(revisionDiff.Patch), &patch))
+		assert.Contains(t, revisionDiff.Patch, "argoproj/rollouts-demo:does-not-exist")
+		assert.NotContains(t, revisionDiff.Patch, "rollouts-pod-template-hash")
+	})
+	t.Run("Explicit", func(t *testing.T) {
+		revisionDiff, err := NewRevisionDiff(ro, rolloutObjs.ReplicaSets, 31, 29)
+		require.NoError(t, err)
+		assert.Equal(t, int64(31), revisionDiff.FromRevision)
+		assert.Equal(t, int64(29), revisionDiff.ToRevision)
+		assert.Contains(t, revisionDiff.Patch, "argoproj/rollouts-demo:asdf")
+	})
+	t.Run("Unchanged", func(t *testing.T) {
+		revisionDiff, err := NewRevisionDiff(ro, rolloutObjs.ReplicaSets, 30, 30)
+		require.NoError(t, err)
+		assert.Equal(t, "{}", revisionDiff.Patch)
+	})
+	t.Run("Missing", func(t *testing.T) {
+		_, err := NewRevisionDiff(ro, rolloutObjs.ReplicaSets, 1, 0)
+		assert.EqualError(t, err, "unable to find specified revision 1 in history")
+		_, err = NewRevisionDiff(ro, rolloutObjs.ReplicaSets, 0, 29)
+		assert.EqualError(t, err, "no revision found before revision 29")
+	})
+}
@@ -115,6 +115,10 @@ func NewRolloutInfo(
 	roInfo.Current = ro.Status.Replicas
 	roInfo.Updated = ro.Status.UpdatedReplicas
 	roInfo.Available = ro.Status.AvailableReplicas
+	roInfo.MultiCluster = ro.Status.MultiCluster
+	for i := range ro.Status.Dependencies {
+		roInfo.Dependencies = append(roInfo.Dependencies, &ro.Status.Dependencies[i])
+	}
 	return &roInfo
 }
 
@@ -126,6 +130,8 @@ func rolloutIcon(status string) string {
 		return IconWarning
 	case "Paused":
 		return IconPaused
+	case "Queued":
+		return IconWaiting
 	case "Healthy":
 		return IconOK
 	case "Degraded":
@@ -1,6 +1,7 @@
 package options
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -15,7 +16,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -38,6 +41,8 @@ type ArgoRolloutsOptions struct {
 	genericclioptions.IOStreams
 
 	Now func() metav1.Time
+
+	actor string
 }
 
 // NewArgoRolloutsOptions provides an instance of ArgoRolloutsOptions with default values
@@ -158,3 +163,30 @@ func (o *ArgoRolloutsOptions) Namespace() string {
 	}
 	return namespace
 }
+
+// Actor returns the user of the kubectl flags or kube context, which is recorded in the audit log
+// of the rollouts
+func (o *ArgoRolloutsOptions) Actor(ctx context.Context) string {
+	if o.actor == "" {
+		config, _ := o.RESTClientGetter.ToRESTConfig()
+		o.actor = audit.GetActor(ctx, o.KubeClientset(), config)
+	}
+	return o.actor
+}
+
+// RecordAudit records an action taken on a rollout of the namespace in its audit log
+func (o *ArgoRolloutsOptions) RecordAudit(ctx context.Context, namespace, name string, action audit.Action, message string) error {
+	rolloutIf := o.DynamicClientset().Resource(v1alpha1.RolloutGVR).Namespace(namespace)
+	if err := audit.Record(ctx, rolloutIf, name, o.Actor(ctx), audit.SourceCLI, action, message); err != nil {
+		return fmt.Errorf("failed to record the %s of rollout '%s' in its audit log: %w", action, name, err)
+	}
+	return nil
+}
+
+// PrintAuditError prints a warning for an action which succeeded but could not be recorded in the
+// audit log of the rollout. The action is not reverted.
+func (o *ArgoRolloutsOptions) PrintAuditError(err error) {
+	if err != nil {
+		fmt.Fprintf(o.ErrOut, "warning: %v\n", err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 // viewController is a mini controller which allows printing of live updates to rollouts
@@ -42,6 +43,9 @@ type viewController struct {
 	analysisRunLister rolloutlisters.AnalysisRunNamespaceLister
 	deploymentLister  appslisters.DeploymentNamespaceLister
 
+	// strategyTemplateGetter resolves the strategy of a rollout referencing a strategy template
+	strategyTemplateGetter strategytemplate.Getter
+
 	cacheSyncs []cache.InformerSynced
 
 	workqueue workqueue.RateLimitingInterface
@@ -106,6 +110,7 @@ func newViewController(namespace string, name string, kubeClient kubernetes.Inte
 		analysisRunLister:       rolloutsInformerFactory.Argoproj().V1alpha1().AnalysisRuns().Lister().AnalysisRuns(namespace),
 		deploymentLister:        kubeInformerFactory.Apps().V1().Deployments().Lister().Deployments(namespace),
 		workqueue:               workqueue.NewRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter()),
+		strategyTemplateGetter:  strategytemplate.NewClientGetter(context.Background(), rolloutClient),
 	}
 
 	controller.cacheSyncs = append(controller.cacheSyncs,
@@ -221,6 +226,7 @@ func (c *RolloutViewController) GetRolloutInfo() (*rollout.RolloutInfo, error) {
 		}
 	}
 
+	ro = strategytemplate.ResolvedRollout(ro, c.strategyTemplateGetter)
 	roInfo := info.NewRolloutInfo(ro, allReplicaSets, allPods, allExps, allAnalysisRuns, workloadRef)
 	return roInfo, nil
 }
@@ -0,0 +1,229 @@
+// Package responsediff implements the response-diff proxy of the shadow canary step. The proxy sends the requests
+// mirrored to it to the stable and the canary, and compares their responses.
+package responsediff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Result is the result of the comparison of the responses of the stable and the canary to a request
+type Result string
+
+const (
+	// ResultMatch is the result of responses with the same status code and body
+	ResultMatch Result = "match"
+	// ResultStatusMismatch is the result of responses with different status codes
+	ResultStatusMismatch Result = "status-mismatch"
+	// ResultBodyMismatch is the result of responses with the same status code and different bodies
+	ResultBodyMismatch Result = "body-mismatch"
+	// ResultError is the result of a request the stable or the canary failed to respond to
+	ResultError Result = "error"
+)
+
+// wildcard is the segment of an ignore path matching every field of an object or element of an array
+const wildcard = "*"
+
+// Response is the response of the stable or the canary to a request
+type Response struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Comparer compares the responses of the stable and the canary. The bodies are compared as JSON documents when both
+// bodies are valid JSON, without the fields of the ignore paths, and byte for byte otherwise.
+type Comparer struct {
+	ignorePaths [][]string
+}
+
+// NewComparer returns a comparer ignoring the fields of the paths. A path is a dot separated list of fields and array
+// indexes, optionally prefixed with `$.`, in which `*` matches every field or index, e.g. `$.items[*].updatedAt`
+func NewComparer(ignorePaths []string) (*Comparer, error) {
+	c := &Comparer{}
+	for _, path := range ignorePaths {
+		segments, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		c.ignorePaths = append(c.ignorePaths, segments)
+	}
+	return c, nil
+}
+
+// parsePath splits an ignore path in the fields and array indexes it is made of
+func parsePath(path string) ([]string, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "$"), ".")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid ignore path '%s': path is empty", path)
+	}
+	var segments []string
+	for _, field := range strings.Split(trimmed, ".") {
+		name, indexes, _ := strings.Cut(field, "[")
+		if name != "" {
+			segments = append(segments, name)
+		}
+		if indexes == "" {
+			if name == "" {
+				return nil, fmt.Errorf("invalid ignore path '%s': empty field", path)
+			}
+			continue
+		}
+		for _, index := range strings.Split(indexes, "[") {
+			index, ok := strings.CutSuffix(index, "]")
+			if !ok || index == "" {
+				return nil, fmt.Errorf("invalid ignore path '%s': invalid index '[%s'", path, index)
+			}
+			if _, err := strconv.Atoi(index); err != nil && index != wildcard {
+				return nil, fmt.Errorf("invalid ignore path '%s': invalid index '[%s]'", path, index)
+			}
+			segments = append(segments, index)
+		}
+	}
+	return segments, nil
+}
+
+// Compare compares the responses of the stable and the canary. It returns the result of the comparison and, for a
+// mismatch, a description of the first difference
+func (c *Comparer) Compare(stable, canary Response) (Result, string) {
+	if stable.StatusCode != canary.StatusCode {
+		return ResultStatusMismatch, fmt.Sprintf("status code %d of the stable differs from %d of the canary", stable.StatusCode, canary.StatusCode)
+	}
+	stableDoc, stableIsJSON := parseJSON(stable.Body)
+	canaryDoc, canaryIsJSON := parseJSON(canary.Body)
+	if !stableIsJSON || !canaryIsJSON {
+		if bytes.Equal(stable.Body, canary.Body) {
+			return ResultMatch, ""
+		}
+		return ResultBodyMismatch, "body of the stable differs from the body of the canary"
+	}
+	for _, path := range c.ignorePaths {
+		stableDoc = removePath(stableDoc, path)
+		canaryDoc = removePath(canaryDoc, path)
+	}
+	if path, differs := diffJSON("$", stableDoc, canaryDoc); differs {
+		return ResultBodyMismatch, fmt.Sprintf("%s of the stable differs from the canary", path)
+	}
+	return ResultMatch, ""
+}
+
+// parseJSON parses a JSON document, keeping the numbers as they were written
+func parseJSON(body []byte) (any, bool) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, false
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil || decoder.More() {
+		return nil, false
+	}
+	return doc, true
+}
+
+// removePath removes the fields and array elements of the path from the document
+func removePath(doc any, path []string) any {
+	if len(path) == 0 {
+		return doc
+	}
+	segment, rest := path[0], path[1:]
+	switch value := doc.(type) {
+	case map[string]any:
+		for key := range value {
+			if segment != wildcard && segment != key {
+				continue
+			}
+			if len(rest) == 0 {
+				delete(value, key)
+			} else {
+				value[key] = removePath(value[key], rest)
+			}
+		}
+	case []any:
+		if segment == wildcard {
+			if len(rest) == 0 {
+				return []any{}
+			}
+			for i := range value {
+				value[i] = removePath(value[i], rest)
+			}
+			return value
+		}
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= len(value) {
+			return value
+		}
+		if len(rest) == 0 {
+			return append(value[:i:i], value[i+1:]...)
+		}
+		value[i] = removePath(value[i], rest)
+	}
+	return doc
+}
+
+// diffJSON returns the path of the first difference between the documents
+func diffJSON(path string, stable, canary any) (string, bool) {
+	switch stableValue := stable.(type) {
+	case map[string]any:
+		canaryValue, ok := canary.(map[string]any)
+		if !ok {
+			return path, true
+		}
+		keys := make([]string, 0, len(stableValue)+len(canaryValue))
+		for key := range stableValue {
+			keys = append(keys, key)
+		}
+		for key := range canaryValue {
+			if _, ok := stableValue[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			stableField, inStable := stableValue[key]
+			canaryField, inCanary := canaryValue[key]
+			if inStable != inCanary {
+				return path + "." + key, true
+			}
+			if fieldPath, differs := diffJSON(path+"."+key, stableField, canaryField); differs {
+				return fieldPath, true
+			}
+		}
+		return "", false
+	case []any:
+		canaryValue, ok := canary.([]any)
+		if !ok || len(stableValue) != len(canaryValue) {
+			return path, true
+		}
+		for i := range stableValue {
+			if elemPath, differs := diffJSON(fmt.Sprintf("%s[%d]", path, i), stableValue[i], canaryValue[i]); differs {
+				return elemPath, true
+			}
+		}
+		return "", false
+	case json.Number:
+		canaryValue, ok := canary.(json.Number)
+		if !ok {
+			return path, true
+		}
+		if stableValue == canaryValue {
+			return "", false
+		}
+		// 1.0 and 1 are the same number
+		stableFloat, stableErr := stableValue.Float64()
+		canaryFloat, canaryErr := canaryValue.Float64()
+		if stableErr != nil || canaryErr != nil || stableFloat != canaryFloat {
+			return path, true
+		}
+		return "", false
+	default:
+		if !reflect.DeepEqual(stable, canary) {
+			return path, true
+		}
+		return "", false
+	}
+}
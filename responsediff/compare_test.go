@@ -0,0 +1,89 @@
+package responsediff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePath(t *testing.T) {
+	for path, expected := range map[string][]string{
+		"$.metadata.requestId":  {"metadata", "requestId"},
+		"metadata.requestId":    {"metadata", "requestId"},
+		"$.items[*].updatedAt":  {"items", "*", "updatedAt"},
+		"$.matrix[0][1]":        {"matrix", "0", "1"},
+		"$[2].id":               {"2", "id"},
+		"$.*.generatedAt":       {"*", "generatedAt"},
+		" $.timestamp ":         {"timestamp"},
+		"$.items[10].price.raw": {"items", "10", "price", "raw"},
+	} {
+		segments, err := parsePath(path)
+		assert.NoError(t, err, path)
+		assert.Equal(t, expected, segments, path)
+	}
+	for _, path := range []string{"", "$", "$.", "$.items..id", "$.items[a]", "$.items[0", "$.items[]"} {
+		_, err := parsePath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	comparer, err := NewComparer([]string{"$.requestId", "$.items[*].updatedAt"})
+	assert.NoError(t, err)
+
+	t.Run("Match", func(t *testing.T) {
+		result, detail := comparer.Compare(
+			Response{StatusCode: 200, Body: []byte(`{"requestId":"a","items":[{"id":1,"updatedAt":"10:00"}],"total":1.0}`)},
+			Response{StatusCode: 200, Body: []byte(`{"total":1, "items":[{"updatedAt":"10:01","id":1}],"requestId":"b"}`)},
+		)
+		assert.Equal(t, ResultMatch, result)
+		assert.Empty(t, detail)
+	})
+	t.Run("StatusMismatch", func(t *testing.T) {
+		result, detail := comparer.Compare(Response{StatusCode: 200}, Response{StatusCode: 500})
+		assert.Equal(t, ResultStatusMismatch, result)
+		assert.Equal(t, "status code 200 of the stable differs from 500 of the canary", detail)
+	})
+	t.Run("BodyMismatch", func(t *testing.T) {
+		result, detail := comparer.Compare(
+			Response{StatusCode: 200, Body: []byte(`{"items":[{"id":1,"price":10}]}`)},
+			Response{StatusCode: 200, Body: []byte(`{"items":[{"id":1,"price":12}]}`)},
+		)
+		assert.Equal(t, ResultBodyMismatch, result)
+		assert.Equal(t, "$.items[0].price of the stable differs from the canary", detail)
+	})
+	t.Run("MissingField", func(t *testing.T) {
+		result, detail := comparer.Compare(
+			Response{StatusCode: 200, Body: []byte(`{"id":1}`)},
+			Response{StatusCode: 200, Body: []byte(`{"id":1,"name":"foo"}`)},
+		)
+		assert.Equal(t, ResultBodyMismatch, result)
+		assert.Equal(t, "$.name of the stable differs from the canary", detail)
+	})
+	t.Run("ArrayLength", func(t *testing.T) {
+		result, detail := comparer.Compare(
+			Response{StatusCode: 200, Body: []byte(`{"items":[]}`)},
+			Response{StatusCode: 200, Body: []byte(`{"items":[{"id":1}]}`)},
+		)
+		assert.Equal(t, ResultBodyMismatch, result)
+		assert.Equal(t, "$.items of the stable differs from the canary", detail)
+	})
+	t.Run("NotJSON", func(t *testing.T) {
+		result, _ := comparer.Compare(Response{StatusCode: 200, Body: []byte("ok")}, Response{StatusCode: 200, Body: []byte("ok")})
+		assert.Equal(t, ResultMatch, result)
+		result, detail := comparer.Compare(Response{StatusCode: 200, Body: []byte("ok")}, Response{StatusCode: 200, Body: []byte(`{"ok":true}`)})
+		assert.Equal(t, ResultBodyMismatch, result)
+		assert.Equal(t, "body of the stable differs from the body of the canary", detail)
+	})
+}
+
+func TestRemovePath(t *testing.T) {
+	doc, _ := parseJSON([]byte(`{"items":[{"id":1,"tmp":1},{"id":2,"tmp":2}],"meta":{"a":1,"b":2},"list":[1,2,3]}`))
+	for _, path := range []string{"$.items[*].tmp", "$.meta.*", "$.list[1]", "$.unknown.field", "$.list[7]"} {
+		segments, err := parsePath(path)
+		assert.NoError(t, err)
+		doc = removePath(doc, segments)
+	}
+	expected, _ := parseJSON([]byte(`{"items":[{"id":1},{"id":2}],"meta":{},"list":[1,3]}`))
+	assert.Equal(t, expected, doc)
+}
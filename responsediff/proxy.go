@@ -0,0 +1,235 @@
+package responsediff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultTimeout is the default timeout of the requests sent to the stable and the canary
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxBodyBytes is the default maximum size of the bodies of the requests and the responses
+	DefaultMaxBodyBytes = 10 << 20
+)
+
+// hopHeaders are the headers which only apply to the connection the request was received on
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// ProxyConfig is the configuration of the response-diff proxy
+type ProxyConfig struct {
+	// StableURL is the URL of the stable service
+	StableURL string
+	// CanaryURL is the URL of the canary service
+	CanaryURL string
+	// IgnorePaths are the paths of the fields of the JSON bodies which are not compared
+	IgnorePaths []string
+	// Timeout of the requests sent to the stable and the canary
+	Timeout time.Duration
+	// StatsWindow is the duration of the window the statistics are computed over
+	StatsWindow time.Duration
+	// MaxBodyBytes is the maximum size of the bodies of the requests and the responses
+	MaxBodyBytes int64
+}
+
+// Proxy sends the requests mirrored to it to the stable and the canary, compares their responses and records the
+// results of the comparisons
+type Proxy struct {
+	stableURL    *url.URL
+	canaryURL    *url.URL
+	comparer     *Comparer
+	client       *http.Client
+	maxBodyBytes int64
+	stats        *Stats
+	registry     *prometheus.Registry
+	requests     *prometheus.CounterVec
+	log          *log.Entry
+}
+
+// NewProxy returns a response-diff proxy
+func NewProxy(cfg ProxyConfig) (*Proxy, error) {
+	stableURL, err := parseServiceURL("stable", cfg.StableURL)
+	if err != nil {
+		return nil, err
+	}
+	canaryURL, err := parseServiceURL("canary", cfg.CanaryURL)
+	if err != nil {
+		return nil, err
+	}
+	comparer, err := NewComparer(cfg.IgnorePaths)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = DefaultMaxBodyBytes
+	}
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "response_diff_requests_total",
+		Help: "Number of mirrored requests by result of the comparison of the responses of the stable and the canary",
+	}, []string{"result"})
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(requests)
+	return &Proxy{
+		stableURL: stableURL,
+		canaryURL: canaryURL,
+		comparer:  comparer,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			// the redirects are compared instead of being followed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxBodyBytes: cfg.MaxBodyBytes,
+		stats:        NewStats(cfg.StatsWindow),
+		registry:     registry,
+		requests:     requests,
+		log:          log.WithField("component", "response-diff"),
+	}, nil
+}
+
+func parseServiceURL(name, rawURL string) (*url.URL, error) {
+	if rawURL == "" {
+		return nil, fmt.Errorf("the URL of the %s is required", name)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL of the %s '%s': %w", name, rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL of the %s '%s': must be an absolute http or https URL", name, rawURL)
+	}
+	return u, nil
+}
+
+// Stats returns the statistics of the comparisons
+func (p *Proxy) Stats() *Stats {
+	return p.stats
+}
+
+// ServeHTTP sends the mirrored request to the stable and the canary, and compares their responses. The response of
+// the canary is returned, although the responses to mirrored requests are discarded by the traffic router
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, p.maxBodyBytes+1))
+	if err != nil || int64(len(body)) > p.maxBodyBytes {
+		p.record(r, ResultError, "failed to read the body of the request")
+		http.Error(w, "failed to read the body of the request", http.StatusBadRequest)
+		return
+	}
+
+	var wg sync.WaitGroup
+	var stable, canary Response
+	var stableErr, canaryErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		stable, stableErr = p.send(r.Context(), p.stableURL, r, body)
+	}()
+	go func() {
+		defer wg.Done()
+		canary, canaryErr = p.send(r.Context(), p.canaryURL, r, body)
+	}()
+	wg.Wait()
+
+	switch {
+	case stableErr != nil:
+		p.record(r, ResultError, fmt.Sprintf("stable failed to respond: %v", stableErr))
+	case canaryErr != nil:
+		p.record(r, ResultError, fmt.Sprintf("canary failed to respond: %v", canaryErr))
+	default:
+		result, detail := p.comparer.Compare(stable, canary)
+		p.record(r, result, detail)
+	}
+
+	if canaryErr != nil {
+		http.Error(w, canaryErr.Error(), http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(canary.StatusCode)
+	_, _ = w.Write(canary.Body)
+}
+
+// send sends a copy of the request to the service
+func (p *Proxy) send(ctx context.Context, target *url.URL, r *http.Request, body []byte) (Response, error) {
+	u := *target
+	u.Path = singleJoiningSlash(target.Path, r.URL.Path)
+	u.RawQuery = r.URL.RawQuery
+	req, err := http.NewRequestWithContext(ctx, r.Method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header = r.Header.Clone()
+	for _, header := range hopHeaders {
+		req.Header.Del(header)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBodyBytes+1))
+	if err != nil {
+		return Response{}, err
+	}
+	if int64(len(respBody)) > p.maxBodyBytes {
+		return Response{}, fmt.Errorf("body of the response exceeds %d bytes", p.maxBodyBytes)
+	}
+	return Response{StatusCode: resp.StatusCode, Body: respBody}, nil
+}
+
+func singleJoiningSlash(a, b string) string {
+	switch aSlash, bSlash := strings.HasSuffix(a, "/"), strings.HasPrefix(b, "/"); {
+	case aSlash && bSlash:
+		return a + b[1:]
+	case !aSlash && !bSlash:
+		return a + "/" + b
+	}
+	return a + b
+}
+
+func (p *Proxy) record(r *http.Request, result Result, detail string) {
+	p.stats.Record(result)
+	p.requests.WithLabelValues(string(result)).Inc()
+	if result != ResultMatch {
+		p.log.WithField("method", r.Method).WithField("path", r.URL.Path).WithField("result", result).Info(detail)
+	}
+}
+
+// AdminHandler returns the handler of the statistics of the comparisons, served as JSON on `/stats`, and of the
+// Prometheus metrics, served on `/metrics`
+func (p *Proxy) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(p.stats.Snapshot())
+	})
+	mux.Handle("/metrics", promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
@@ -0,0 +1,103 @@
+package responsediff
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T, body string) (*httptest.Server, *[]*http.Request) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestNewProxy(t *testing.T) {
+	_, err := NewProxy(ProxyConfig{CanaryURL: "http://canary"})
+	assert.EqualError(t, err, "the URL of the stable is required")
+	_, err = NewProxy(ProxyConfig{StableURL: "http://stable", CanaryURL: "canary:8080"})
+	assert.EqualError(t, err, "invalid URL of the canary 'canary:8080': must be an absolute http or https URL")
+	_, err = NewProxy(ProxyConfig{StableURL: "http://stable", CanaryURL: "http://canary", IgnorePaths: []string{"$."}})
+	assert.EqualError(t, err, "invalid ignore path '$.': path is empty")
+}
+
+func TestProxy(t *testing.T) {
+	stable, stableRequests := newTestServer(t, `{"id":1,"requestId":"a","price":10}`)
+	canary, _ := newTestServer(t, `{"id":1,"requestId":"b","price":12}`)
+	proxy, err := NewProxy(ProxyConfig{
+		StableURL:   stable.URL,
+		CanaryURL:   canary.URL + "/",
+		IgnorePaths: []string{"$.requestId"},
+	})
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/products?id=1", strings.NewReader(`{"id":1}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"id":1,"requestId":"b","price":12}`, w.Body.String())
+	assert.Len(t, *stableRequests, 1)
+	assert.Equal(t, "/api/products", (*stableRequests)[0].URL.Path)
+	assert.Equal(t, "id=1", (*stableRequests)[0].URL.RawQuery)
+	assert.Equal(t, http.MethodPost, (*stableRequests)[0].Method)
+
+	w = httptest.NewRecorder()
+	proxy.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	stats := httptest.NewRecorder()
+	proxy.AdminHandler().ServeHTTP(stats, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	var snapshot Snapshot
+	assert.NoError(t, json.Unmarshal(stats.Body.Bytes(), &snapshot))
+	assert.Equal(t, Snapshot{Requests: 2, Matches: 1, Mismatches: 1, BodyMismatches: 1, MismatchRate: 0.5}, snapshot)
+
+	metrics := httptest.NewRecorder()
+	proxy.AdminHandler().ServeHTTP(metrics, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, _ := io.ReadAll(metrics.Body)
+	assert.Contains(t, string(body), `response_diff_requests_total{result="body-mismatch"} 1`)
+	assert.Contains(t, string(body), `response_diff_requests_total{result="match"} 1`)
+}
+
+func TestProxyCanaryError(t *testing.T) {
+	stable, _ := newTestServer(t, `{}`)
+	canary, _ := newTestServer(t, `{}`)
+	canary.Close()
+	proxy, err := NewProxy(ProxyConfig{StableURL: stable.URL, CanaryURL: canary.URL})
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Equal(t, Snapshot{Requests: 1, Errors: 1}, proxy.Stats().Snapshot())
+}
+
+func TestStatsWindow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	stats := NewStats(time.Minute)
+	stats.now = func() time.Time { return now }
+	stats.Record(ResultStatusMismatch)
+	stats.Record(ResultMatch)
+	now = now.Add(30 * time.Second)
+	stats.Record(ResultMatch)
+	stats.Record(ResultError)
+	assert.Equal(t, Snapshot{Requests: 4, Matches: 2, Mismatches: 1, StatusMismatches: 1, Errors: 1, MismatchRate: 1.0 / 3}, stats.Snapshot())
+
+	now = now.Add(45 * time.Second)
+	assert.Equal(t, Snapshot{Requests: 2, Matches: 1, Errors: 1}, stats.Snapshot())
+	now = now.Add(time.Hour)
+	assert.Equal(t, Snapshot{}, stats.Snapshot())
+}
@@ -0,0 +1,109 @@
+package responsediff
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultStatsWindow is the default duration of the window the statistics of the comparisons are computed over
+const DefaultStatsWindow = 5 * time.Minute
+
+// Snapshot are the statistics of the comparisons of the responses over the window
+type Snapshot struct {
+	// Requests is the number of mirrored requests
+	Requests int64 `json:"requests"`
+	// Matches is the number of requests the stable and the canary responded the same to
+	Matches int64 `json:"matches"`
+	// Mismatches is the number of requests the stable and the canary responded differently to
+	Mismatches int64 `json:"mismatches"`
+	// StatusMismatches is the number of mismatches with different status codes
+	StatusMismatches int64 `json:"statusMismatches"`
+	// BodyMismatches is the number of mismatches with different bodies
+	BodyMismatches int64 `json:"bodyMismatches"`
+	// Errors is the number of requests the stable or the canary failed to respond to
+	Errors int64 `json:"errors"`
+	// MismatchRate is the ratio of the mismatches to the compared requests, or 0 when no request was compared
+	MismatchRate float64 `json:"mismatchRate"`
+}
+
+func (s *Snapshot) add(result Result) {
+	s.Requests++
+	switch result {
+	case ResultMatch:
+		s.Matches++
+	case ResultStatusMismatch:
+		s.Mismatches++
+		s.StatusMismatches++
+	case ResultBodyMismatch:
+		s.Mismatches++
+		s.BodyMismatches++
+	case ResultError:
+		s.Errors++
+	}
+}
+
+// Stats records the results of the comparisons in buckets of a second, so that the statistics are computed over a
+// sliding window
+type Stats struct {
+	mu      sync.Mutex
+	window  time.Duration
+	buckets map[int64]*Snapshot
+	now     func() time.Time
+}
+
+// NewStats returns the statistics of the comparisons over the window
+func NewStats(window time.Duration) *Stats {
+	if window <= 0 {
+		window = DefaultStatsWindow
+	}
+	return &Stats{
+		window:  window,
+		buckets: map[int64]*Snapshot{},
+		now:     time.Now,
+	}
+}
+
+// Record records the result of a comparison
+func (s *Stats) Record(result Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	second := s.now().Unix()
+	bucket, ok := s.buckets[second]
+	if !ok {
+		bucket = &Snapshot{}
+		s.buckets[second] = bucket
+		s.prune(second)
+	}
+	bucket.add(result)
+}
+
+// prune removes the buckets which are out of the window
+func (s *Stats) prune(second int64) {
+	oldest := second - int64(s.window/time.Second)
+	for bucketSecond := range s.buckets {
+		if bucketSecond <= oldest {
+			delete(s.buckets, bucketSecond)
+		}
+	}
+}
+
+// Snapshot returns the statistics of the comparisons over the window
+func (s *Stats) Snapshot() Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	second := s.now().Unix()
+	s.prune(second)
+	var snapshot Snapshot
+	for _, bucket := range s.buckets {
+		snapshot.Requests += bucket.Requests
+		snapshot.Matches += bucket.Matches
+		snapshot.Mismatches += bucket.Mismatches
+		snapshot.StatusMismatches += bucket.StatusMismatches
+		snapshot.BodyMismatches += bucket.BodyMismatches
+		snapshot.Errors += bucket.Errors
+	}
+	if compared := snapshot.Matches + snapshot.Mismatches; compared > 0 {
+		snapshot.MismatchRate = float64(snapshot.Mismatches) / float64(compared)
+	}
+	return snapshot
+}
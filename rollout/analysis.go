@@ -22,6 +22,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
 const (
@@ -54,6 +55,7 @@ func (c *Controller) getAnalysisRunsForRollout(rollout *v1alpha1.Rollout) ([]*v1
 		rollout.Status.Canary.CurrentStepAnalysisRunStatus,
 		rollout.Status.BlueGreen.PrePromotionAnalysisRunStatus,
 		rollout.Status.BlueGreen.PostPromotionAnalysisRunStatus,
+		rollout.Status.Canary.PostPromotionAnalysisRunStatus,
 	}
 	for _, arStatus := range arStatuses {
 		if arStatus == nil || seen[arStatus.Name] {
@@ -96,6 +98,11 @@ func (c *rolloutContext) reconcileAnalysisRuns() error {
 		}
 		newCurrentAnalysisRuns.CanaryBackground = backgroundAnalysisRun
 
+		postPromotionAr, err := c.reconcileCanaryPostPromotionAnalysisRun()
+		if err != nil {
+			return err
+		}
+		newCurrentAnalysisRuns.CanaryPostPromotion = postPromotionAr
 	}
 	if c.rollout.Spec.Strategy.BlueGreen != nil {
 		prePromotionAr, err := c.reconcilePrePromotionAnalysisRun()
@@ -170,7 +177,7 @@ func needsNewAnalysisRun(currentAr *v1alpha1.AnalysisRun, rollout *v1alpha1.Roll
 	// There is an additional check for the BlueGreen Pause because the prepromotion analysis always has the BlueGreen
 	// Pause and that causes controllerPause to be set. The extra check for the BlueGreen Pause ensures that a new Analysis
 	// Run is created only when the previous AnalysisRun is inconclusive
-	if rollout.Status.ControllerPause && getPauseCondition(rollout, v1alpha1.PauseReasonBlueGreenPause) == nil {
+	if isControllerPaused(rollout) && getPauseCondition(rollout, v1alpha1.PauseReasonBlueGreenPause) == nil {
 		return currentAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive
 	}
 	return rollout.Status.AbortedAt != nil
@@ -221,6 +228,12 @@ func (c *rolloutContext) reconcileAnalysisRunStatusChanges(currARs analysisutil.
 		currARs.CanaryBackground,
 		v1alpha1.RolloutTypeBackgroundRunLabel,
 	)
+
+	c.emitAnalysisRunStatusChanges(
+		c.rollout.Status.Canary.PostPromotionAnalysisRunStatus,
+		currARs.CanaryPostPromotion,
+		v1alpha1.RolloutTypePostPromotionLabel,
+	)
 }
 
 func (c *rolloutContext) reconcilePrePromotionAnalysisRun() (*v1alpha1.AnalysisRun, error) {
@@ -289,6 +302,23 @@ func (c *rolloutContext) reconcilePostPromotionAnalysisRun() (*v1alpha1.Analysis
 	}
 
 	c.log.Info("Reconciling Post Promotion Analysis")
+	weightIndex := c.blueGreenTrafficWeightIndex()
+	// the run at a previous traffic routing weight is over once the rollout moves on to the next weight
+	weightIndexLabel := ""
+	if weightIndex != nil {
+		weightIndexLabel = strconv.Itoa(int(*weightIndex))
+	}
+	if currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutBlueGreenWeightIndexLabel] != weightIndexLabel {
+		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
+		if err != nil {
+			return nil, err
+		}
+		currentAr = nil
+	}
+	if weightIndex != nil {
+		return c.reconcileTrafficWeightAnalysisRun(currentAr, *weightIndex)
+	}
+
 	// don't start post-promotion if we are not ready to, or we are still waiting for target verification
 	if skipPostPromotionAnalysisRun(c.rollout, c.newRS) || !c.areTargetsVerified() {
 		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
@@ -312,6 +342,43 @@ func (c *rolloutContext) reconcilePostPromotionAnalysisRun() (*v1alpha1.Analysis
 	return currentAr, nil
 }
 
+// reconcileCanaryPostPromotionAnalysisRun runs the post promotion analysis during the bake period
+// of an update which was fully promoted. The analysis is terminated at the end of the bake period.
+func (c *rolloutContext) reconcileCanaryPostPromotionAnalysisRun() (*v1alpha1.AnalysisRun, error) {
+	currentAr := c.currentArs.CanaryPostPromotion
+	canary := c.rollout.Spec.Strategy.Canary
+	if canary.PostPromotionAnalysis == nil || c.rollout.Status.Canary.BakeStartedAt == nil || !rolloututil.IsFullyPromoted(c.rollout) {
+		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
+		return nil, err
+	}
+
+	if bakeDeadline := getBakeDeadline(c.rollout); bakeDeadline != nil {
+		if remaining := bakeDeadline.Sub(timeutil.Now()); remaining > 0 {
+			c.enqueueRolloutAfter(c.rollout, remaining)
+		} else {
+			if currentAr == nil {
+				c.newStatus.Canary.BakeStartedAt = nil
+				return nil, nil
+			}
+			c.log.Infof("Bake period ended at %s", bakeDeadline)
+			err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
+			return currentAr, err
+		}
+	}
+
+	if currentAr == nil {
+		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+		instanceID := analysisutil.GetInstanceID(c.rollout)
+		postPromotionLabels := analysisutil.PostPromotionLabels(podHash, instanceID)
+		currentAr, err := c.createAnalysisRun(canary.PostPromotionAnalysis, "post", postPromotionLabels)
+		if err == nil {
+			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Info("Created Post Promotion AnalysisRun")
+		}
+		return currentAr, err
+	}
+	return currentAr, nil
+}
+
 func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun, error) {
 	currentAr := c.currentArs.CanaryBackground
 	if c.rollout.Spec.Strategy.Canary.Analysis == nil || len(c.rollout.Spec.Strategy.Canary.Analysis.Templates) == 0 {
@@ -367,6 +434,7 @@ func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnal
 
 func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun, error) {
 	step, index := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	stepAnalysis := rolloututil.GetStepAnalysis(step)
 	currentAr := c.currentArs.CanaryStep
 
 	if len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Status.Abort {
@@ -374,9 +442,9 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 	}
 
 	// for promotion cases
-	analysisRunFromPreviousStep := step != nil && step.Analysis != nil && currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] != strconv.Itoa(int(*index))
+	analysisRunFromPreviousStep := stepAnalysis != nil && currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] != strconv.Itoa(int(*index))
 
-	if step == nil || step.Analysis == nil || index == nil || analysisRunFromPreviousStep {
+	if stepAnalysis == nil || index == nil || analysisRunFromPreviousStep {
 		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
 		return nil, err
 	}
@@ -385,7 +453,7 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
 		instanceID := analysisutil.GetInstanceID(c.rollout)
 		stepLabels := analysisutil.StepLabels(*index, podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(step.Analysis, strconv.Itoa(int(*index)), stepLabels)
+		currentAr, err := c.createAnalysisRun(stepAnalysis, strconv.Itoa(int(*index)), stepLabels)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Infof("Created AnalysisRun for step '%d'", *index)
 		}
@@ -394,7 +462,10 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 
 	switch currentAr.Status.Phase {
 	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+		// the rollout continues at the step of the matching branch of the step, instead of pausing
+		if c.getCanaryStepBranch() == nil && !c.pauseContext.IsAborted() {
+			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+		}
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
 		c.pauseContext.AddAbort(currentAr.Status.Message)
 	}
@@ -48,21 +48,31 @@ func (c *rolloutContext) rolloutBlueGreen() error {
 
 	c.reconcileBlueGreenPause(activeSvc, previewSvc)
 
-	err = c.reconcileActiveService(activeSvc)
+	err = c.reconcileBlueGreenTrafficRouting(activeSvc)
 	if err != nil {
 		return err
 	}
 
-	err = c.awsVerifyTargetGroups(activeSvc)
+	err = c.reconcileActiveService(activeSvc)
 	if err != nil {
 		return err
 	}
 
-	err = c.reconcileAnalysisRuns()
+	err = c.awsVerifyTargetGroups(activeSvc)
 	if err != nil {
 		return err
 	}
 
+	if reason := c.heldBackReason(); reason != "" {
+		c.log.Infof("Skipping analysis: %s", reason)
+		c.keepCurrentExperimentAndAnalysisRuns()
+	} else {
+		err = c.reconcileAnalysisRuns()
+		if err != nil {
+			return err
+		}
+	}
+
 	err = c.reconcileEphemeralMetadata()
 	if err != nil {
 		return err
@@ -94,9 +104,13 @@ func (c *rolloutContext) reconcileBlueGreenReplicaSets(activeSvc *corev1.Service
 	if err != nil {
 		return err
 	}
-	_, err = c.reconcileNewReplicaSet()
-	if err != nil {
-		return err
+	if reason := c.heldBackReason(); reason != "" {
+		c.log.Infof("Skipping new ReplicaSet reconciliation: %s", reason)
+	} else {
+		_, err = c.reconcileNewReplicaSet()
+		if err != nil {
+			return err
+		}
 	}
 	// Scale down old non-active, non-stable replicasets, if we can.
 	_, err = c.reconcileOtherReplicaSets()
@@ -171,6 +185,7 @@ func (c *rolloutContext) reconcileBlueGreenPause(activeSvc, previewSvc *corev1.S
 	}
 	pauseCond := getPauseCondition(c.rollout, v1alpha1.PauseReasonBlueGreenPause)
 	if pauseCond != nil {
+		c.reconcileBlueGreenPauseTimeout(*pauseCond)
 		// We are currently paused. Check if we completed our pause duration
 		if !c.pauseContext.CompletedBlueGreenPause() {
 			c.log.Info("pause incomplete")
@@ -184,7 +199,7 @@ func (c *rolloutContext) reconcileBlueGreenPause(activeSvc, previewSvc *corev1.S
 	} else {
 		// no pause condition exists. If Status.ControllerPause is true, the user manually resumed
 		// the rollout. e.g. `kubectl argo rollouts promote ROLLOUT`
-		if !c.rollout.Status.ControllerPause {
+		if !isControllerPaused(c.rollout) {
 			c.log.Info("pausing")
 			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonBlueGreenPause)
 		}
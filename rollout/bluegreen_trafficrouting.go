@@ -0,0 +1,216 @@
+package rollout
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// blueGreenTrafficWeightIndex returns the index of the traffic routing weight of a blue-green rollout,
+// or nil if the rollout does not use traffic routing or has shifted traffic through all its weights
+func (c *rolloutContext) blueGreenTrafficWeightIndex() *int32 {
+	trafficRouting := c.rollout.Spec.Strategy.BlueGreen.TrafficRouting
+	if trafficRouting == nil {
+		return nil
+	}
+	index := int32(0)
+	if c.newStatus.BlueGreen.TrafficWeightIndex != nil {
+		index = *c.newStatus.BlueGreen.TrafficWeightIndex
+	}
+	if index >= int32(len(trafficRouting.Weights)) {
+		return nil
+	}
+	return &index
+}
+
+// completedBlueGreenTrafficShift returns true if the active service of a blue-green rollout can
+// switch to the new ReplicaSet as far as traffic routing is concerned
+func (c *rolloutContext) completedBlueGreenTrafficShift() bool {
+	return c.blueGreenTrafficWeightIndex() == nil
+}
+
+// readyForBlueGreenTrafficShift returns true if the new ReplicaSet of a blue-green rollout is fully
+// scaled and has been promoted past the pre-promotion analysis and the blue-green pause
+func (c *rolloutContext) readyForBlueGreenTrafficShift() bool {
+	if c.newRS == nil || !replicasetutil.ReadyForPause(c.rollout, c.newRS, c.allRSs) || !annotations.IsSaturated(c.rollout, c.newRS) {
+		return false
+	}
+	return c.pauseContext.CompletedBlueGreenPause() && c.completedPrePromotionAnalysis()
+}
+
+// isBlueGreenTrafficWeightSet returns true if the traffic routing weights recorded in the status are
+// the given weight to the new ReplicaSet, and the traffic router verified it when it supports it
+func isBlueGreenTrafficWeightSet(weights *v1alpha1.TrafficWeights, podHash string, weight int32) bool {
+	if weights == nil || weights.Canary.PodTemplateHash != podHash || weights.Canary.Weight != weight {
+		return false
+	}
+	return weights.Verified == nil || *weights.Verified
+}
+
+// completedBlueGreenTrafficWeight returns true if the traffic routing weight at the index was set in a
+// previous reconciliation, and the post-promotion analysis run at that weight, if any, was successful
+func (c *rolloutContext) completedBlueGreenTrafficWeight(index int32) bool {
+	blueGreen := c.rollout.Spec.Strategy.BlueGreen
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	if !isBlueGreenTrafficWeightSet(c.rollout.Status.BlueGreen.Weights, podHash, blueGreen.TrafficRouting.Weights[index]) {
+		return false
+	}
+	if blueGreen.PostPromotionAnalysis == nil {
+		return true
+	}
+	currentAr := c.currentArs.BlueGreenPostPromotion
+	return currentAr != nil &&
+		currentAr.Labels[v1alpha1.RolloutBlueGreenWeightIndexLabel] == strconv.Itoa(int(index)) &&
+		currentAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
+}
+
+// reconcileBlueGreenTrafficRouting shifts the traffic of a blue-green rollout from the active to the
+// preview ReplicaSet through the traffic routing weights, before the active service switches over
+func (c *rolloutContext) reconcileBlueGreenTrafficRouting(activeSvc *corev1.Service) error {
+	trafficRouting := c.rollout.Spec.Strategy.BlueGreen.TrafficRouting
+	if trafficRouting == nil {
+		c.newStatus.BlueGreen.TrafficWeightIndex = nil
+		c.newStatus.BlueGreen.Weights = nil
+		return nil
+	}
+
+	activeHash := activeSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey]
+	previewHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	isAborted := c.pauseContext.IsAborted()
+	// the active service selects, or is about to select, the new ReplicaSet without shifting traffic
+	isSwitched := previewHash == activeHash || c.isBlueGreenFastTracked(activeSvc)
+	if haltReason := c.haltProgress(); haltReason != "" && !isAborted && !isSwitched {
+		c.log.Infof("Skipping traffic routing reconciliation: %s", haltReason)
+		return nil
+	}
+
+	ro := rolloututil.TrafficRoutingRollout(c.rollout)
+	desiredWeight := int32(0)
+	switch {
+	case isAborted:
+		// a retried update shifts the traffic from the first weight again
+		c.newStatus.BlueGreen.TrafficWeightIndex = nil
+	case isSwitched:
+		// there is no traffic left to shift
+		index := int32(len(trafficRouting.Weights))
+		c.newStatus.BlueGreen.TrafficWeightIndex = &index
+	case !c.readyForBlueGreenTrafficShift():
+		// keep the previous weight until the new ReplicaSet is ready for the current one
+		if index := c.blueGreenTrafficWeightIndex(); index != nil && *index > 0 {
+			desiredWeight = trafficRouting.Weights[*index-1]
+		}
+	default:
+		index := int32(0)
+		if currentIndex := c.blueGreenTrafficWeightIndex(); currentIndex == nil {
+			index = int32(len(trafficRouting.Weights))
+		} else if c.completedBlueGreenTrafficWeight(*currentIndex) {
+			index = *currentIndex + 1
+			c.log.Infof("Completed traffic weight %d (weightIdx: %d)", trafficRouting.Weights[*currentIndex], *currentIndex)
+		} else {
+			index = *currentIndex
+		}
+		c.newStatus.BlueGreen.TrafficWeightIndex = &index
+		if index < int32(len(trafficRouting.Weights)) {
+			desiredWeight = trafficRouting.Weights[index]
+		} else {
+			// all the traffic goes to the new ReplicaSet right before the active service switches to it
+			desiredWeight = weightutil.MaxTrafficWeight(ro)
+		}
+	}
+
+	reconcilers, err := c.newTrafficRoutingReconciler(c)
+	if err != nil {
+		return err
+	}
+	if len(reconcilers) == 0 {
+		c.log.Info("No TrafficRouting Reconcilers found")
+		c.newStatus.BlueGreen.Weights = nil
+		return nil
+	}
+
+	c.log.Infof("Found %d TrafficRouting Reconcilers", len(reconcilers))
+	for _, reconciler := range reconcilers {
+		reconciler = trafficrouting.NewTracingReconciler(c.ctx, reconciler)
+		c.log.Infof("Reconciling TrafficRouting with type '%s'", reconciler.Type())
+
+		if isAborted || isSwitched {
+			err = reconciler.RemoveManagedRoutes()
+			if err != nil {
+				return err
+			}
+		}
+
+		err = reconciler.UpdateHash(previewHash, activeHash)
+		if err != nil {
+			return err
+		}
+
+		err = reconciler.SetWeight(desiredWeight)
+		if err != nil {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: "TrafficRoutingError"}, err.Error())
+			return err
+		}
+
+		if modified, newWeights := calculateWeightStatus(ro, previewHash, activeHash, desiredWeight); modified {
+			c.log.Infof("Previous weights: %v", c.rollout.Status.BlueGreen.Weights)
+			c.log.Infof("New weights: %v", newWeights)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.TrafficWeightUpdatedReason}, trafficWeightUpdatedMessage(c.rollout.Status.BlueGreen.Weights, newWeights))
+			c.newStatus.BlueGreen.Weights = newWeights
+		}
+
+		weightVerified, err := reconciler.VerifyWeight(desiredWeight)
+		c.newStatus.BlueGreen.Weights.Verified = weightVerified
+		if err != nil {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.WeightVerifyErrorReason}, conditions.WeightVerifyErrorMessage, err)
+			return nil // return nil instead of error since we want to continue with normal reconciliation
+		}
+		if weightVerified != nil && !*weightVerified {
+			c.log.Infof("Desired weight %d not yet verified", desiredWeight)
+			c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
+		}
+	}
+	return nil
+}
+
+// reconcileTrafficWeightAnalysisRun runs the post-promotion analysis of a blue-green rollout at the
+// traffic routing weight of the index, once the weight is set
+func (c *rolloutContext) reconcileTrafficWeightAnalysisRun(currentAr *v1alpha1.AnalysisRun, index int32) (*v1alpha1.AnalysisRun, error) {
+	blueGreen := c.rollout.Spec.Strategy.BlueGreen
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	if c.rollout.Status.StableRS == podHash || c.newStatus.BlueGreen.TrafficWeightIndex == nil {
+		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
+		return nil, err
+	}
+
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil {
+		return currentAr, nil
+	}
+
+	if needsNewAnalysisRun(currentAr, c.rollout) {
+		if !isBlueGreenTrafficWeightSet(c.newStatus.BlueGreen.Weights, podHash, blueGreen.TrafficRouting.Weights[index]) {
+			c.log.Infof("Waiting for traffic weight %d to be set before starting Post Promotion Analysis", blueGreen.TrafficRouting.Weights[index])
+			return nil, nil
+		}
+		instanceID := analysisutil.GetInstanceID(c.rollout)
+		postPromotionLabels := analysisutil.PostPromotionWeightLabels(index, podHash, instanceID)
+		currentAr, err := c.createAnalysisRun(blueGreen.PostPromotionAnalysis, fmt.Sprintf("post-%d", index), postPromotionLabels)
+		if err == nil {
+			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Infof("Created Post Promotion AnalysisRun for traffic weight %d", blueGreen.TrafficRouting.Weights[index])
+		}
+		return currentAr, err
+	}
+	return currentAr, nil
+}
@@ -0,0 +1,215 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+)
+
+// newBlueGreenTrafficRoutingRollout returns a blue-green rollout which shifts traffic through nginx
+// with weights of 20 and 50
+func newBlueGreenTrafficRoutingRollout() *v1alpha1.Rollout {
+	r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	r.Spec.Strategy.BlueGreen.TrafficRouting = &v1alpha1.BlueGreenTrafficRouting{
+		RolloutTrafficRouting: v1alpha1.RolloutTrafficRouting{
+			Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "foo-ingress"},
+		},
+		Weights: []int32{20, 50},
+	}
+	return r
+}
+
+// withStableIngress adds the stable ingress of the update from rs1 to rs2 to the fixture, along with
+// a traffic router whose weights are always verified
+func withStableIngress(f *fixture, rs1, rs2 *appsv1.ReplicaSet) {
+	activeSvc, previewSvc := f.serviceLister[0], f.serviceLister[1]
+	ing := newIngress("foo-ingress", previewSvc, activeSvc)
+	ing.Spec.Rules[0].HTTP.Paths[0].Backend.ServiceName = activeSvc.Name
+	f.kubeobjects = append(f.kubeobjects, ing)
+	f.ingressLister = append(f.ingressLister, ingressutil.NewLegacyIngress(ing))
+
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.fakeTrafficRouting = newUnmockedFakeTrafficRoutingReconciler()
+	f.fakeTrafficRouting.On("UpdateHash", rs2PodHash, rs1PodHash).Return(nil)
+	f.fakeTrafficRouting.On("VerifyWeight", mock.Anything).Return(pointer.BoolPtr(true), nil)
+}
+
+func setBlueGreenTrafficWeight(r *v1alpha1.Rollout, index, weight int32, stableHash, canaryHash string) {
+	r.Status.BlueGreen.TrafficWeightIndex = pointer.Int32(index)
+	r.Status.BlueGreen.Weights = &v1alpha1.TrafficWeights{
+		Canary:   v1alpha1.WeightDestination{Weight: weight, PodTemplateHash: canaryHash, ServiceName: "preview"},
+		Stable:   v1alpha1.WeightDestination{Weight: 100 - weight, PodTemplateHash: stableHash, ServiceName: "active"},
+		Verified: pointer.BoolPtr(true),
+	}
+}
+
+func TestBlueGreenTrafficRoutingSetsFirstWeight(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(20)).Return(nil)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(20))
+	f.fakeTrafficRouting.AssertNotCalled(t, "RemoveManagedRoutes")
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	require.NotNil(t, patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.Equal(t, int32(0), *patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	require.NotNil(t, patchedRollout.Status.BlueGreen.Weights)
+	assert.Equal(t, v1alpha1.WeightDestination{Weight: 20, PodTemplateHash: rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], ServiceName: "preview"}, patchedRollout.Status.BlueGreen.Weights.Canary)
+	assert.Equal(t, int32(80), patchedRollout.Status.BlueGreen.Weights.Stable.Weight)
+	assert.Equal(t, "active", patchedRollout.Status.BlueGreen.Weights.Stable.ServiceName)
+}
+
+func TestBlueGreenTrafficRoutingWaitsForPause(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(0)).Return(nil)
+	r2.Spec.Strategy.BlueGreen.AutoPromotionEnabled = pointer.BoolPtr(false)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(0))
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.NotEmpty(t, patchedRollout.Status.PauseConditions)
+}
+
+func TestBlueGreenTrafficRoutingAdvancesWeight(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(50)).Return(nil)
+	setBlueGreenTrafficWeight(r2, 0, 20, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(50))
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	require.NotNil(t, patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.Equal(t, int32(1), *patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.Equal(t, int32(50), patchedRollout.Status.BlueGreen.Weights.Canary.Weight)
+}
+
+func TestBlueGreenTrafficRoutingSwitchesActiveServiceAfterLastWeight(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	activeSvc := f.serviceLister[0]
+	f.fakeTrafficRouting.On("SetWeight", int32(100)).Return(nil)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	setBlueGreenTrafficWeight(r2, 1, 50, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], rs2PodHash)
+
+	f.expectPatchServiceAction(activeSvc, rs2PodHash)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(100))
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(2), *patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.Equal(t, rs2PodHash, patchedRollout.Status.BlueGreen.ActiveSelector)
+}
+
+func TestBlueGreenTrafficRoutingCreatesAnalysisRunAtWeight(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(20)).Return(nil)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	setBlueGreenTrafficWeight(r2, 0, 20, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], rs2PodHash)
+
+	at := analysisTemplate("bar")
+	r2.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+	}
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Name += "-0"
+	ar.Labels = analysisutil.PostPromotionWeightLabels(0, rs2PodHash, "")
+
+	f.objects = append(f.objects, at)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+
+	createdIndex := f.expectCreateAnalysisRunAction(ar)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdAr := f.getCreatedAnalysisRun(createdIndex)
+	assert.Equal(t, ar.Name, createdAr.Name)
+	assert.Equal(t, "0", createdAr.Labels[v1alpha1.RolloutBlueGreenWeightIndexLabel])
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, ar.Name, patchedRollout.Status.BlueGreen.PostPromotionAnalysisRunStatus.Name)
+	// the weight does not move on until the analysis run is successful
+	assert.Nil(t, patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+}
+
+func TestBlueGreenTrafficRoutingAdvancesWeightAfterAnalysisRun(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(50)).Return(nil)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	setBlueGreenTrafficWeight(r2, 0, 20, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], rs2PodHash)
+
+	at := analysisTemplate("bar")
+	r2.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+	}
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Name += "-0"
+	ar.Labels = analysisutil.PostPromotionWeightLabels(0, rs2PodHash, "")
+	ar.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+	r2.Status.BlueGreen.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseSuccessful,
+	}
+	nextAr := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	nextAr.Name += "-1"
+	nextAr.Labels = analysisutil.PostPromotionWeightLabels(1, rs2PodHash, "")
+
+	f.objects = append(f.objects, at, ar)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+
+	createdIndex := f.expectCreateAnalysisRunAction(nextAr)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(50))
+	createdAr := f.getCreatedAnalysisRun(createdIndex)
+	assert.Equal(t, nextAr.Name, createdAr.Name)
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(1), *patchedRollout.Status.BlueGreen.TrafficWeightIndex)
+	assert.Equal(t, nextAr.Name, patchedRollout.Status.BlueGreen.PostPromotionAnalysisRunStatus.Name)
+}
+
+func TestBlueGreenTrafficRoutingAbort(t *testing.T) {
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newBlueGreenTrafficRoutingRollout(), 1, 1, false)
+	defer f.Close()
+	withStableIngress(f, rs1, rs2)
+	f.fakeTrafficRouting.On("SetWeight", int32(0)).Return(nil)
+	f.fakeTrafficRouting.On("RemoveManagedRoutes").Return(nil)
+	setBlueGreenTrafficWeight(r2, 1, 50, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	r2.Status.Abort = true
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "RemoveManagedRoutes")
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(0))
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(0), patchedRollout.Status.BlueGreen.Weights.Canary.Weight)
+}
@@ -0,0 +1,142 @@
+package rollout
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/budget"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	// queuedRolloutResyncPeriod is how often a queued rollout checks again whether the progression
+	// budgets let its update through, in addition to when an update of another rollout completes
+	queuedRolloutResyncPeriod = 30 * time.Second
+
+	// progressionBudgetIndexName is the index of the rollouts whose update is updating or queued, which
+	// are the rollouts the progression budgets count
+	progressionBudgetIndexName = "byProgressionBudget"
+	updatingIndexValue         = "updating"
+	queuedIndexValue           = "queued"
+)
+
+// addProgressionBudgetIndexer indexes the rollouts with an update in progress and the queued rollouts,
+// so that the progression budgets do not go through every rollout on each reconciliation
+func addProgressionBudgetIndexer(rolloutsInformer cache.SharedIndexInformer) {
+	util.CheckErr(rolloutsInformer.AddIndexers(cache.Indexers{
+		progressionBudgetIndexName: func(obj any) ([]string, error) {
+			ro := unstructuredutil.ObjectToRollout(obj)
+			if ro == nil || !budget.IsUpdating(ro) {
+				return nil, nil
+			}
+			if budget.IsQueued(ro) {
+				return []string{updatingIndexValue, queuedIndexValue}, nil
+			}
+			return []string{updatingIndexValue}, nil
+		},
+	}))
+}
+
+// getRolloutsByProgressionBudgetIndex returns the rollouts of the given value of the progression budget index
+func getRolloutsByProgressionBudgetIndex(indexer cache.Indexer, value string) ([]*v1alpha1.Rollout, error) {
+	objs, err := indexer.ByIndex(progressionBudgetIndexName, value)
+	if err != nil {
+		return nil, err
+	}
+	rollouts := make([]*v1alpha1.Rollout, 0, len(objs))
+	for _, obj := range objs {
+		if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
+			rollouts = append(rollouts, ro)
+		}
+	}
+	return rollouts, nil
+}
+
+// reconcileProgressionBudgets queues the update while the progression budgets configured in the
+// argo-rollouts-config ConfigMap which apply to the rollout are exhausted.
+// The initial deployment and aborted updates are never queued.
+// A queued canary update with steps runs its first step and is kept from progressing past it, while
+// any other queued update is held back.
+func (c *rolloutContext) reconcileProgressionBudgets() {
+	budgets := getProgressionBudgets()
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	if len(budgets) == 0 || c.rollout.Status.StableRS == "" || podHash == c.rollout.Status.StableRS || c.pauseContext.IsAborted() {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonQueued)
+		return
+	}
+
+	var updating []*v1alpha1.Rollout
+	for _, b := range budgets {
+		if !budget.Matches(b, c.rollout) {
+			continue
+		}
+		if updating == nil {
+			var err error
+			updating, err = getRolloutsByProgressionBudgetIndex(c.rolloutsIndexer, updatingIndexValue)
+			if err != nil {
+				c.log.Warnf("Failed to get the updating rollouts of the progression budget '%s': %v", b.Name, err)
+				return
+			}
+		}
+		var others []*v1alpha1.Rollout
+		for _, ro := range updating {
+			if budget.Matches(b, ro) {
+				others = append(others, ro)
+			}
+		}
+		if !budget.IsAdmitted(b, c.rollout, others) {
+			c.queuedBy = b.Name
+			break
+		}
+	}
+	if c.queuedBy == "" {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonQueued)
+		return
+	}
+
+	c.enqueueRolloutAfter(c.rollout, queuedRolloutResyncPeriod)
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonQueued) == nil {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutQueuedReason}, conditions.RolloutQueuedMessage, c.queuedBy)
+	}
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonQueued)
+}
+
+// isQueuedAtFirstStep returns whether the update is queued by a progression budget while it is at the first step
+// of its canary steps, which it runs without progressing past it until the budget lets it through
+func (c *rolloutContext) isQueuedAtFirstStep() bool {
+	if c.queuedBy == "" {
+		return false
+	}
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	return currentStep != nil && *currentStepIndex == 0
+}
+
+func getProgressionBudgets() []config.ProgressionBudget {
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.GetProgressionBudgets()
+}
+
+// enqueueQueuedRollouts enqueues the rollouts queued by the progression budgets, after an update
+// stopped using its budget
+func (c *Controller) enqueueQueuedRollouts() {
+	rollouts, err := getRolloutsByProgressionBudgetIndex(c.rolloutsIndexer, queuedIndexValue)
+	if err != nil {
+		log.Warnf("Failed to get the queued rollouts: %v", err)
+		return
+	}
+	for _, ro := range rollouts {
+		c.enqueueRollout(ro)
+	}
+}
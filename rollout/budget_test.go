@@ -0,0 +1,179 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/budget"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+// withProgressionBudget configures a progression budget which lets a single update through, and adds
+// another rollout of the budget to the fixture
+func withProgressionBudget(t *testing.T, f *fixture, other *v1alpha1.Rollout) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: defaults.DefaultRolloutsConfigMapName, Namespace: defaults.Namespace()},
+		Data: map[string]string{"progressionBudgets": `
+- name: shared-db
+  maxProgressing: 1
+`},
+	}
+	config.UnInitializeConfig()
+	_, err := config.InitializeConfig(k8sfake.NewSimpleClientset(cm), defaults.DefaultRolloutsConfigMapName)
+	assert.NoError(t, err)
+	t.Cleanup(config.UnInitializeConfig)
+	f.rolloutLister = append(f.rolloutLister, other)
+	f.objects = append(f.objects, other)
+}
+
+func newUpdatingRollout(name string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Generation: 2},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+		Status: v1alpha1.RolloutStatus{ObservedGeneration: "2", StableRS: "abc", CurrentPodHash: "def"},
+	}
+}
+
+func TestProgressionBudgetQueuesUpdate(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 10, 0, false)
+	defer f.Close()
+	withProgressionBudget(t, f, newUpdatingRollout("bar"))
+	// the update was not evaluated by the progression budgets yet
+	r2.Status.CurrentPodHash = r2.Status.StableRS
+
+	// The new ReplicaSet is not scaled up
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonQueued, patched.Status.PauseConditions[0].Reason)
+	assert.Equal(t, v1alpha1.RolloutPhaseQueued, patched.Status.Phase)
+	assert.Contains(t, f.events, conditions.RolloutQueuedReason)
+}
+
+func TestProgressionBudgetLetsUpdateThrough(t *testing.T) {
+	// the other update is queued as well, but has a lower priority
+	other := newUpdatingRollout("bar")
+	other.Annotations = map[string]string{budget.PriorityAnnotation: "-1"}
+	other.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonQueued, StartTime: metav1.Now()}}
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 10, 0, false)
+	defer f.Close()
+	withProgressionBudget(t, f, other)
+	r2.Status.CurrentPodHash = hash.ComputePodTemplateHash(&r2.Spec.Template, r2.Status.CollisionCount)
+	r2.Status.ControllerPause = true
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonQueued, StartTime: metav1.Now()}}
+
+	f.expectPatchRolloutAction(r2)
+	f.expectUpdateReplicaSetAction(f.replicaSetLister[1])
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.NotContains(t, f.events, conditions.RolloutQueuedReason)
+}
+
+// queueUpdate marks the update of the rollout as queued by the progression budget
+func queueUpdate(r *v1alpha1.Rollout) {
+	r.Status.CurrentPodHash = hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
+	r.Status.ControllerPause = true
+	r.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonQueued, StartTime: metav1.Now()}}
+}
+
+func TestProgressionBudgetQueuedUpdateRunsFirstStep(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 10, 0, false)
+	defer f.Close()
+	withProgressionBudget(t, f, newUpdatingRollout("bar"))
+	queueUpdate(r2)
+
+	// The canary of the first step is scaled up while the update is queued
+	f.expectPatchRolloutAction(r2)
+	f.expectUpdateReplicaSetAction(f.replicaSetLister[1])
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), "pauseConditions")
+}
+
+func TestProgressionBudgetQueuedUpdateStaysAtFirstStep(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	// the canary of the first step is available
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 9, 1, false)
+	defer f.Close()
+	withProgressionBudget(t, f, newUpdatingRollout("bar"))
+	queueUpdate(r2)
+
+	// The update does not move past the first step while it is queued
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), "currentStepIndex")
+	assert.NotContains(t, f.events, conditions.RolloutStepCompletedReason)
+}
+
+func TestProgressionBudgetQueuedUpdatePastFirstStepIsHeldBack(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 10, 0, false)
+	defer f.Close()
+	withProgressionBudget(t, f, newUpdatingRollout("bar"))
+	queueUpdate(r2)
+	withStepZeroExperimentAndAnalysis(f, r2)
+	// the update reached the experiment by skipping the first step
+	r2.Spec.Strategy.Canary.Steps = append([]v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}, r2.Spec.Strategy.Canary.Steps...)
+	r2.Spec.Strategy.Canary.Analysis.StartingStep = pointer.Int32Ptr(1)
+	r2.Status.CurrentStepHash = conditions.ComputeStepHash(r2)
+	r2.Status.CurrentStepIndex = pointer.Int32Ptr(1)
+
+	// Neither the canary is scaled up nor the experiment and the analysis run of the step are created
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Empty(t, patched.Status.Canary.CurrentExperiment)
+	assert.Nil(t, patched.Status.Canary.CurrentBackgroundAnalysisRunStatus)
+}
+
+func TestEnqueueQueuedRollouts(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	queued := newUpdatingRollout("foo")
+	queued.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonQueued, StartTime: metav1.Now()}}
+	updating := newUpdatingRollout("bar")
+	completed := newUpdatingRollout("baz")
+	completed.Status.CurrentPodHash = completed.Status.StableRS
+	f.rolloutLister = append(f.rolloutLister, queued, updating, completed)
+	f.objects = append(f.objects, queued, updating, completed)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.enqueueQueuedRollouts()
+	assert.Equal(t, map[string]int{getKey(queued, t): 1}, f.enqueuedObjects)
+
+	rollouts, err := getRolloutsByProgressionBudgetIndex(c.rolloutsIndexer, updatingIndexValue)
+	assert.NoError(t, err)
+	assert.Len(t, rollouts, 2)
+}
@@ -32,6 +32,10 @@ func (c *rolloutContext) rolloutCanary() error {
 		return fmt.Errorf("failed to getAllReplicaSetsAndSyncRevision in rolloutCanary create true: %w", err)
 	}
 
+	if c.reconcileCanaryStepConditions() {
+		return c.syncRolloutStatusCanary()
+	}
+
 	err = c.podRestarter.Reconcile(c)
 	if err != nil {
 		return err
@@ -58,13 +62,19 @@ func (c *rolloutContext) rolloutCanary() error {
 		return err
 	}
 
+	if reason := c.heldBackReason(); reason != "" {
+		c.log.Infof("Update held back: %s", reason)
+		c.keepCurrentExperimentAndAnalysisRuns()
+		return c.syncRolloutStatusCanary()
+	}
+
 	err = c.reconcileExperiments()
 	if err != nil {
 		return err
 	}
 
 	err = c.reconcileAnalysisRuns()
-	if c.pauseContext.HasAddPause() {
+	if c.pauseContext.HasAddPauseBesidesHeldBack() {
 		c.log.Info("Detected pause due to inconclusive AnalysisRun")
 		return c.syncRolloutStatusCanary()
 	}
@@ -152,7 +162,7 @@ func (c *rolloutContext) reconcileCanaryPause() bool {
 		// rollout needs to be paused for the first time. If the ControllerPause is false,
 		// the controller has not paused the rollout yet and needs to do so before it
 		// can proceed.
-		if !c.rollout.Status.ControllerPause {
+		if !isControllerPaused(c.rollout) {
 			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
 		}
 		return true
@@ -317,7 +327,11 @@ func (c *rolloutContext) canProceedWithScaleDownAnnotation(oldRSs []*appsv1.Repl
 }
 
 func (c *rolloutContext) completedCurrentCanaryStep() bool {
-	if c.rollout.Spec.Paused {
+	if c.rollout.Spec.Paused || c.heldBackReason() != "" || c.isQueuedAtFirstStep() {
+		return false
+	}
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonStepTimeout) != nil {
+		// the step timed out, and the rollout waits until it is resumed
 		return false
 	}
 	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -340,10 +354,18 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 		return true
 	case currentStep.Experiment != nil:
 		experiment := c.currentEx
-		return experiment != nil && experiment.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
-	case currentStep.Analysis != nil:
+		if experiment == nil || experiment.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+			return false
+		}
+		// an experiment without a winner to promote completes once the rollout is resumed
+		return !currentStep.Experiment.PromoteWinner || experiment.Status.Winner != "" || c.isResumedWithoutWinner()
+	case currentStep.Analysis != nil, currentStep.Shadow != nil:
 		currentStepAr := c.currentArs.CanaryStep
 		analysisExistsAndCompleted := currentStepAr != nil && currentStepAr.Status.Phase.Completed()
+		if analysisExistsAndCompleted && currentStepAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive {
+			// an inconclusive analysis completes the step when one of the branches of the step matches
+			return c.getCanaryStepBranch() != nil
+		}
 		return analysisExistsAndCompleted && currentStepAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
 	case currentStep.SetHeaderRoute != nil:
 		return true
@@ -368,6 +390,10 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 	newStatus.StableRS = c.rollout.Status.StableRS
 	newStatus.CurrentStepHash = conditions.ComputeStepHash(c.rollout)
 	stepCount := int32(len(c.rollout.Spec.Strategy.Canary.Steps))
+	if newStatus.StableRS == newStatus.CurrentPodHash || c.pauseContext.IsAborted() {
+		// the revision is fully promoted or aborted, its steps are no longer traced
+		c.forgetCanaryStep()
+	}
 
 	if replicasetutil.PodTemplateOrStepsChanged(c.rollout, c.newRS) {
 		c.resetRolloutStatus(&newStatus)
@@ -382,11 +408,21 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 				newStatus.CurrentStepIndex = &stepCount
 			}
 		}
+		if stepIndex := getPromotedWinnerStepIndex(c.rollout, newStatus.CurrentPodHash); stepIndex != nil && stepCount > 0 &&
+			c.rollout.Status.CurrentPodHash != newStatus.CurrentPodHash && newStatus.CurrentPodHash != c.rollout.Status.StableRS {
+			// If we get here, the canary is the promoted winner of an experiment and resumes at the step after it
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: "SkipSteps"}, "Resuming at step %d with the promoted experiment winner", *stepIndex)
+			newStatus.CurrentStepIndex = stepIndex
+		}
 
 		newStatus = c.calculateRolloutConditions(newStatus)
 		return c.persistRolloutStatus(&newStatus)
 	}
 
+	if err := c.rollbackFailedPromotion(&newStatus); err != nil {
+		return err
+	}
+
 	if c.rollout.Status.PromoteFull || c.isRollbackWithinWindow() {
 		c.pauseContext.ClearPauseConditions()
 		c.pauseContext.RemoveAbort()
@@ -416,12 +452,38 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 		return c.persistRolloutStatus(&newStatus)
 	}
 
-	if c.completedCurrentCanaryStep() {
+	if c.skippedToStepIndex != nil {
+		// the steps whose when expression evaluated to false were skipped
+		newStatus.CurrentStepIndex = c.skippedToStepIndex
+		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
+		newStatus = c.calculateRolloutConditions(newStatus)
+		return c.persistRolloutStatus(&newStatus)
+	}
+
+	completedStep := c.completedCurrentCanaryStep()
+	if !completedStep && c.reconcileCanaryStepTimeout(&newStatus, currentStep, currentStepIndex) {
+		completedStep = true
+	}
+	c.traceCanaryStep(currentStep, currentStepIndex, newStatus.CurrentPodHash, completedStep)
+	if completedStep {
+		branchIndex := c.getCanaryStepBranch()
+		if c.pauseContext.IsAborted() {
+			// the branches of the step failed to evaluate
+			newStatus.CurrentStepIndex = currentStepIndex
+			newStatus = c.calculateRolloutConditions(newStatus)
+			return c.persistRolloutStatus(&newStatus)
+		}
 		stepStr := rolloututil.CanaryStepString(*currentStep)
+		completedStepIndex := *currentStepIndex
 		*currentStepIndex++
+		if branchIndex != nil {
+			c.branchCanaryStep(&newStatus, completedStepIndex, *branchIndex)
+			*currentStepIndex = *branchIndex
+		}
 		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
+		newStatus.Canary.CurrentStepTimeout = nil
 
-		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepCompletedReason}, conditions.RolloutStepCompletedMessage, int(*currentStepIndex), stepCount, stepStr)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepCompletedReason}, conditions.RolloutStepCompletedMessage, int(completedStepIndex+1), stepCount, stepStr)
 		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
 	}
 
@@ -479,6 +479,51 @@ func TestResetCurrentStepIndexOnPodSpecChange(t *testing.T) {
 	assert.JSONEq(t, calculatePatch(r2, expectedPatch), patch)
 }
 
+func TestResumeCurrentStepIndexOnPromotedExperimentWinner(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	steps := []v1alpha1.CanaryStep{
+		{
+			Experiment: &v1alpha1.RolloutExperimentStep{},
+		},
+		{
+			Pause: &v1alpha1.RolloutPause{},
+		},
+	}
+
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(0), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	expectedCurrentPodHash := r2.Status.CurrentPodHash
+	r2.Annotations[annotations.PromotedExperimentWinnerAnnotation] = fmt.Sprintf(`{"podTemplateHash":"%s","stepIndex":1}`, expectedCurrentPodHash)
+	r2.Status.CurrentPodHash = rs1PodHash
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	expectedPatchWithoutPodHash := `{
+		"status": {
+			"currentStepIndex":1,
+			"currentPodHash": "%s",
+			"conditions": %s
+		}
+	}`
+	newConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, r2, false, "", false)
+
+	expectedPatch := fmt.Sprintf(expectedPatchWithoutPodHash, expectedCurrentPodHash, newConditions)
+	assert.JSONEq(t, calculatePatch(r2, expectedPatch), patch)
+}
+
 func TestCanaryRolloutCreateFirstReplicasetNoSteps(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
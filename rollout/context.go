@@ -1,6 +1,8 @@
 package rollout
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -15,6 +17,8 @@ type rolloutContext struct {
 	reconcilerBase
 
 	log *log.Entry
+	// ctx carries the span of the reconciliation, in the trace of the current revision of the rollout
+	ctx context.Context
 	// rollout is the rollout being reconciled
 	rollout *v1alpha1.Rollout
 	// newRollout is the rollout after reconciliation. used to write back to informer
@@ -50,6 +54,36 @@ type rolloutContext struct {
 	// (e.g. a setWeight step, after a blue-green active switch, after stable service switch),
 	// since we do not want to continually verify weight in case it could incur rate-limiting or other expenses.
 	targetsVerified *bool
+
+	// pendingClusters are the clusters of the previous waves which are not yet Healthy with the
+	// desired pod template hash. The update is held back until they are.
+	pendingClusters []string
+
+	// outsideDeployWindow indicates the deploy windows of the rollout do not currently allow the
+	// update to progress. The update is held back until they do.
+	outsideDeployWindow bool
+
+	// pendingDependencies are the rollouts the rollout depends on which are not yet far enough in
+	// their update. The update is held back until they are.
+	pendingDependencies []string
+
+	// queuedBy is the name of the progression budget which queues the update. The update is held
+	// back until the budget lets it through, except for the first step of a canary update.
+	queuedBy string
+
+	// stepTimedOutMessage reports the timeout of the current canary step or of the pause of the blue-green
+	// rollout, when it timed out during this reconciliation
+	stepTimedOutMessage string
+
+	// skippedToStepIndex is the index of the canary step the rollout continues at, when the steps whose when
+	// expression evaluated to false were skipped during this reconciliation
+	skippedToStepIndex *int32
+
+	// stepBranchIndex is the index of the canary step the rollout continues at once the current step completes,
+	// when one of the branches of the current step matches. stepBranchEvaluated indicates the branches were
+	// evaluated during this reconciliation
+	stepBranchIndex     *int32
+	stepBranchEvaluated bool
 }
 
 func (c *rolloutContext) reconcile() error {
@@ -70,6 +104,14 @@ func (c *rolloutContext) reconcile() error {
 		return err
 	}
 
+	err = c.reconcileMultiCluster()
+	if err != nil {
+		return err
+	}
+	c.reconcileDeployWindow()
+	c.reconcileDependencies()
+	c.reconcileProgressionBudgets()
+
 	isScalingEvent, err := c.isScalingEvent()
 	if err != nil {
 		return err
@@ -115,6 +157,14 @@ func (c *rolloutContext) SetCurrentAnalysisRuns(currARs analysisutil.CurrentAnal
 				Message: currBackgroundAr.Status.Message,
 			}
 		}
+		currPostPromoAr := currARs.CanaryPostPromotion
+		if currPostPromoAr != nil {
+			c.newStatus.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+				Name:    currPostPromoAr.Name,
+				Status:  currPostPromoAr.Status.Phase,
+				Message: currPostPromoAr.Status.Message,
+			}
+		}
 		currStepAr := currARs.CanaryStep
 		if currStepAr != nil {
 			c.newStatus.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
@@ -153,5 +203,33 @@ func (c *rolloutContext) haltProgress() string {
 	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil {
 		return "inconclusive analysis"
 	}
+	return c.heldBackReason()
+}
+
+// keepCurrentExperimentAndAnalysisRuns records the current experiment and analysis runs of an update
+// which is held back, without creating the ones of its current step, so that they only start once the
+// update is let through
+func (c *rolloutContext) keepCurrentExperimentAndAnalysisRuns() {
+	if c.currentEx != nil {
+		c.SetCurrentExperiment(c.currentEx)
+	}
+	c.SetCurrentAnalysisRuns(c.currentArs)
+}
+
+// heldBackReason returns a reason on whether or not the controller holds back the update, which
+// unlike a pause also prevents a blue-green rollout from scaling up the new ReplicaSet
+func (c *rolloutContext) heldBackReason() string {
+	if len(c.pendingClusters) > 0 {
+		return "waiting for clusters " + strings.Join(c.pendingClusters, ", ")
+	}
+	if c.outsideDeployWindow {
+		return "outside of the deploy windows"
+	}
+	if len(c.pendingDependencies) > 0 {
+		return "waiting for dependencies " + strings.Join(c.pendingDependencies, ", ")
+	}
+	if c.queuedBy != "" && !c.isQueuedAtFirstStep() {
+		return "queued by progression budget " + c.queuedBy
+	}
 	return ""
 }
@@ -55,6 +55,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/budget"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -66,7 +67,9 @@ import (
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
@@ -120,6 +123,11 @@ type ControllerConfig struct {
 	IngressWorkQueue                workqueue.RateLimitingInterface
 	MetricsServer                   *metrics.MetricsServer
 	Recorder                        record.EventRecorder
+	MemberClusterStatusLister       MemberClusterStatusLister
+
+	// RolloutStrategyTemplateInformer and ClusterRolloutStrategyTemplateInformer resolve the strategy references of the rollouts
+	RolloutStrategyTemplateInformer        informers.RolloutStrategyTemplateInformer
+	ClusterRolloutStrategyTemplateInformer informers.ClusterRolloutStrategyTemplateInformer
 }
 
 // reconcilerBase is a shared datastructure containing all clients and configuration necessary to
@@ -153,6 +161,15 @@ type reconcilerBase struct {
 
 	podRestarter RolloutPodRestarter
 
+	// memberClusterStatusLister is nil unless multi-cluster promotion is enabled
+	memberClusterStatusLister MemberClusterStatusLister
+
+	// strategyTemplateGetter resolves the strategy references of the rollouts
+	strategyTemplateGetter strategytemplate.Getter
+
+	// canarySteps remembers when the rollouts started their current canary step, to trace the steps
+	canarySteps *canaryStepTimes
+
 	// used for unit testing
 	enqueueRollout              func(obj any)                                                                  //nolint:structcheck
 	enqueueRolloutAfter         func(obj any, duration time.Duration)                                          //nolint:structcheck
@@ -207,6 +224,10 @@ func NewController(cfg ControllerConfig) *Controller {
 		resyncPeriod:                  cfg.ResyncPeriod,
 		podRestarter:                  podRestarter,
 		refResolver:                   cfg.RefResolver,
+		memberClusterStatusLister:     cfg.MemberClusterStatusLister,
+		canarySteps:                   newCanaryStepTimes(),
+
+		strategyTemplateGetter: strategytemplate.NewListerGetter(cfg.RolloutStrategyTemplateInformer.Lister(), cfg.ClusterRolloutStrategyTemplateInformer.Lister()),
 	}
 
 	controller := &Controller{
@@ -232,9 +253,14 @@ func NewController(cfg ControllerConfig) *Controller {
 		RolloutsInformer:        cfg.RolloutsInformer,
 		VirtualServiceInformer:  cfg.IstioVirtualServiceInformer,
 		DestinationRuleInformer: cfg.IstioDestinationRuleInformer,
+		StrategyTemplateGetter:  base.strategyTemplateGetter,
 	})
 	controller.newTrafficRoutingReconciler = controller.NewTrafficRoutingReconciler
 
+	addDependencyIndexer(cfg.RolloutsInformer.Informer())
+	addProgressionBudgetIndexer(cfg.RolloutsInformer.Informer())
+	addStrategyRefIndexer(cfg.RolloutsInformer.Informer())
+
 	log.Info("Setting up event handlers")
 	// Set up an event handler for when rollout resources change
 	cfg.RolloutsInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -253,12 +279,13 @@ func NewController(cfg ControllerConfig) *Controller {
 			}
 		},
 		UpdateFunc: func(old, new any) {
-			oldRollout := unstructuredutil.ObjectToRollout(old)
-			newRollout := unstructuredutil.ObjectToRollout(new)
+			oldRollout := strategytemplate.ResolvedRollout(unstructuredutil.ObjectToRollout(old), controller.strategyTemplateGetter)
+			newRollout := strategytemplate.ResolvedRollout(unstructuredutil.ObjectToRollout(new), controller.strategyTemplateGetter)
 			if oldRollout != nil && newRollout != nil {
 				// Check if rollout services/destinationrules were modified, if so we enqueue the
 				// removed Service and/or DestinationRules so that the rollouts-pod-template-hash
-				// can be cleared from each
+				// can be cleared from each. Both rollouts are resolved against the current template, the
+				// resources removed by a change of the template are enqueued by the template event handler.
 				for _, key := range removedKeys("Service", oldRollout, newRollout, serviceutil.GetRolloutServiceKeys) {
 					controller.serviceWorkqueue.AddRateLimited(key)
 				}
@@ -266,10 +293,18 @@ func NewController(cfg ControllerConfig) *Controller {
 					controller.IstioController.EnqueueDestinationRule(key)
 				}
 			}
+			if newRollout != nil {
+				// the rollouts depending on this rollout wait for its progress
+				controller.enqueueDependents(newRollout)
+			}
+			if oldRollout != nil && newRollout != nil && budget.IsUpdating(oldRollout) && !budget.IsUpdating(newRollout) {
+				// the update no longer uses its progression budget, which may let a queued update through
+				controller.enqueueQueuedRollouts()
+			}
 			controller.enqueueRollout(new)
 		},
 		DeleteFunc: func(obj any) {
-			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
+			if ro := strategytemplate.ResolvedRollout(unstructuredutil.ObjectToRollout(obj), controller.strategyTemplateGetter); ro != nil {
 				logCtx := logutil.WithRollout(ro)
 				logCtx.Info("rollout deleted")
 				controller.metricsServer.Remove(ro.Namespace, ro.Name, logutil.RolloutKey)
@@ -281,11 +316,19 @@ func NewController(cfg ControllerConfig) *Controller {
 				for _, key := range istioutil.GetRolloutDesinationRuleKeys(ro) {
 					controller.IstioController.EnqueueDestinationRule(key)
 				}
+				if budget.IsUpdating(ro) {
+					controller.enqueueQueuedRollouts()
+				}
+				controller.canarySteps.forget(ro.UID)
 				controller.recorder.Eventf(ro, record.EventOptions{EventReason: conditions.RolloutDeletedReason}, conditions.RolloutDeletedMessage, ro.Name, ro.Namespace)
 			}
 		},
 	})
 
+	// Set up event handlers requeueing the rollouts referencing a changed strategy template
+	cfg.RolloutStrategyTemplateInformer.Informer().AddEventHandler(controller.newStrategyTemplateEventHandler(register.RolloutStrategyTemplateKind))
+	cfg.ClusterRolloutStrategyTemplateInformer.Informer().AddEventHandler(controller.newStrategyTemplateEventHandler(register.ClusterRolloutStrategyTemplateKind))
+
 	cfg.ReplicaSetInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
 			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
@@ -379,7 +422,7 @@ func (c *Controller) Run(ctx context.Context, threadiness int) error {
 // syncHandler compares the actual state with the desired, and attempts to
 // converge the two. It then updates the Phase block of the Rollout resource
 // with the current status of the resource.
-func (c *Controller) syncHandler(ctx context.Context, key string) error {
+func (c *Controller) syncHandler(ctx context.Context, key string) (err error) {
 	startTime := timeutil.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -412,12 +455,24 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 		logCtx.WithField("time_ms", duration.Seconds()*1e3).Info("Reconciliation completed")
 	}()
 
+	traceCtx, span := tracing.StartSpan(tracing.RolloutContext(ctx, r), "rollout.reconcile",
+		tracing.AttributeNamespace.String(r.Namespace),
+		tracing.AttributeRollout.String(r.Name),
+	)
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	resolveErr := c.refResolver.Resolve(r)
+	if resolveErr == nil {
+		resolveErr = strategytemplate.ResolveRef(r, c.strategyTemplateGetter)
+	}
 	roCtx, err := c.newRolloutContext(r)
 	if err != nil {
 		logCtx.Errorf("newRolloutContext err %v", err)
 		return err
 	}
+	roCtx.ctx = traceCtx
 	if resolveErr != nil {
 		roCtx.createInvalidRolloutCondition(resolveErr, r)
 		return resolveErr
@@ -540,6 +595,18 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 	}
 	// carry over existing recorded weights
 	roCtx.newStatus.Canary.Weights = rollout.Status.Canary.Weights
+	roCtx.newStatus.BlueGreen.Weights = rollout.Status.BlueGreen.Weights
+	roCtx.newStatus.BlueGreen.TrafficWeightIndex = rollout.Status.BlueGreen.TrafficWeightIndex
+	// carry over the bake period of the canary post promotion analysis
+	roCtx.newStatus.Canary.BakeStartedAt = rollout.Status.Canary.BakeStartedAt
+	roCtx.newStatus.Canary.RollbackPodHash = rollout.Status.Canary.RollbackPodHash
+	// carry over the evaluation of the when expressions of the canary steps
+	roCtx.newStatus.Canary.EvaluatedStepIndex = rollout.Status.Canary.EvaluatedStepIndex
+	roCtx.newStatus.Canary.SkippedSteps = rollout.Status.Canary.SkippedSteps
+	// carry over the changes of the traffic routing in dry-run mode until traffic routing is reconciled again
+	if isTrafficRoutingDryRun(rollout) {
+		roCtx.newStatus.TrafficRoutingDryRun = rollout.Status.TrafficRoutingDryRun
+	}
 	return &roCtx, nil
 }
 
@@ -598,55 +665,67 @@ func (c *rolloutContext) getRolloutReferencedResources() (*validation.Referenced
 	}
 	refResources.AnalysisTemplatesWithType = *analysisTemplates
 
+	err = c.getTrafficRoutingReferencedResources(&refResources)
+	if err != nil {
+		// the traffic routing of a blue-green rollout is resolved like the one of a canary rollout
+		return nil, validation.BlueGreenTrafficRoutingError(c.rollout, err)
+	}
+	return &refResources, nil
+}
+
+// getTrafficRoutingReferencedResources gets the ingresses, virtual services, mappings and app mesh resources referenced
+// by the traffic routing of the canary strategy, or of the blue-green strategy in the shape of a canary strategy
+func (c *rolloutContext) getTrafficRoutingReferencedResources(refResources *validation.ReferencedResources) error {
+	rollout := rolloututil.TrafficRoutingRollout(c.rollout)
+
 	// Validate Rollout Nginx Ingress Controller before referencing
-	err = validation.ValidateRolloutNginxIngressesConfig(c.rollout)
+	err := validation.ValidateRolloutNginxIngressesConfig(rollout)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate Rollout ALB Ingress Controller before referencing
-	err = validation.ValidateRolloutAlbIngressesConfig(c.rollout)
+	err = validation.ValidateRolloutAlbIngressesConfig(rollout)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	ingresses, err := c.getReferencedIngresses()
 	if err != nil {
-		return nil, err
+		return err
 	}
 	refResources.Ingresses = *ingresses
 
 	// Validate Rollout virtualServices before referencing
-	err = validation.ValidateRolloutVirtualServicesConfig(c.rollout)
+	err = validation.ValidateRolloutVirtualServicesConfig(rollout)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	virtualServices, err := c.IstioController.GetReferencedVirtualServices(c.rollout)
+	virtualServices, err := c.IstioController.GetReferencedVirtualServices(rollout)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	refResources.VirtualServices = *virtualServices
 
 	ambassadorMappings, err := c.getAmbassadorMappings()
 	if err != nil {
-		return nil, err
+		return err
 	}
 	refResources.AmbassadorMappings = ambassadorMappings
 
 	appmeshResources, err := c.getReferencedAppMeshResources()
 	if err != nil {
-		return nil, err
+		return err
 	}
 	refResources.AppMeshResources = appmeshResources
-
-	return &refResources, nil
+	return nil
 }
 
 func (c *rolloutContext) getReferencedAppMeshResources() ([]unstructured.Unstructured, error) {
 	ctx := context.TODO()
 	appmeshClient := appmesh.NewResourceClient(c.dynamicclientset)
-	rollout := c.rollout
+	rollout := rolloututil.TrafficRoutingRollout(c.rollout)
 	refResources := []unstructured.Unstructured{}
 	if rollout.Spec.Strategy.Canary != nil {
 		canary := rollout.Spec.Strategy.Canary
@@ -679,8 +758,7 @@ func (c *rolloutContext) getReferencedAppMeshResources() ([]unstructured.Unstruc
 
 func (c *rolloutContext) getAmbassadorMappings() ([]unstructured.Unstructured, error) {
 	mappings := []unstructured.Unstructured{}
-	if c.rollout.Spec.Strategy.Canary != nil {
-		canary := c.rollout.Spec.Strategy.Canary
+	if canary := rolloututil.TrafficRoutingRollout(c.rollout).Spec.Strategy.Canary; canary != nil {
 		if canary.TrafficRouting != nil && canary.TrafficRouting.Ambassador != nil {
 			a := canary.TrafficRouting.Ambassador
 			fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "ambassador", "mappings")
@@ -785,12 +863,12 @@ func (c *rolloutContext) getReferencedRolloutAnalyses() (*[]validation.AnalysisT
 	} else if c.rollout.Spec.Strategy.Canary != nil {
 		canary := c.rollout.Spec.Strategy.Canary
 		for i, step := range canary.Steps {
-			if step.Analysis != nil {
-				templates, err := c.getReferencedAnalysisTemplates(c.rollout, step.Analysis, validation.InlineAnalysis, i)
+			if stepAnalysis := rolloututil.GetStepAnalysis(&step); stepAnalysis != nil {
+				templates, err := c.getReferencedAnalysisTemplates(c.rollout, stepAnalysis, validation.InlineAnalysis, i)
 				if err != nil {
 					return nil, err
 				}
-				templates.Args = step.Analysis.Args
+				templates.Args = stepAnalysis.Args
 				analysisTemplates = append(analysisTemplates, *templates)
 			}
 		}
@@ -802,6 +880,14 @@ func (c *rolloutContext) getReferencedRolloutAnalyses() (*[]validation.AnalysisT
 			templates.Args = canary.Analysis.Args
 			analysisTemplates = append(analysisTemplates, *templates)
 		}
+		if canary.PostPromotionAnalysis != nil {
+			templates, err := c.getReferencedAnalysisTemplates(c.rollout, canary.PostPromotionAnalysis, validation.CanaryPostPromotionAnalysis, 0)
+			if err != nil {
+				return nil, err
+			}
+			templates.Args = canary.PostPromotionAnalysis.Args
+			analysisTemplates = append(analysisTemplates, *templates)
+		}
 	}
 	return &analysisTemplates, nil
 }
@@ -868,7 +954,7 @@ func (c *rolloutContext) getReferencedAnalysisTemplatesFromRef(templateRefs *[]v
 }
 
 func (c *rolloutContext) getReferencedIngresses() (*[]ingressutil.Ingress, error) {
-	canary := c.rollout.Spec.Strategy.Canary
+	canary := rolloututil.TrafficRoutingRollout(c.rollout).Spec.Strategy.Canary
 
 	if canary != nil && canary.TrafficRouting != nil {
 		if canary.TrafficRouting.ALB != nil {
@@ -92,6 +92,8 @@ type fixture struct {
 	analysisRunLister             []*v1alpha1.AnalysisRun
 	clusterAnalysisTemplateLister []*v1alpha1.ClusterAnalysisTemplate
 	analysisTemplateLister        []*v1alpha1.AnalysisTemplate
+	strategyTemplateLister        []*v1alpha1.RolloutStrategyTemplate
+	clusterStrategyTemplateLister []*v1alpha1.ClusterRolloutStrategyTemplate
 	replicaSetLister              []*appsv1.ReplicaSet
 	serviceLister                 []*corev1.Service
 	ingressLister                 []*ingressutil.Ingress
@@ -445,6 +447,44 @@ func updateCanaryRolloutStatus(r *v1alpha1.Rollout, stableRS string, availableRe
 	return newRollout
 }
 
+// newRolloutUpdateFixture returns a fixture with the rollout r1 being updated to r2, along with the
+// stable ReplicaSet rs1 of r1 and the ReplicaSet rs2 of r2, which have the given numbers of available
+// replicas. The status and conditions of r2 are set for the strategy of the rollout, a blue-green
+// rollout gets its active and preview services, and paused marks the update as paused by the controller.
+func newRolloutUpdateFixture(t *testing.T, r1 *v1alpha1.Rollout, stableAvailable, updatedAvailable int, paused bool) (*fixture, *v1alpha1.Rollout, *appsv1.ReplicaSet, *appsv1.ReplicaSet) {
+	f := newFixture(t)
+	r2 := bumpVersion(r1)
+
+	rs1 := newReplicaSetWithStatus(r1, stableAvailable, stableAvailable)
+	rs2 := newReplicaSetWithStatus(r2, updatedAvailable, updatedAvailable)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	if r1.Spec.Strategy.BlueGreen != nil {
+		r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, int32(stableAvailable), int32(updatedAvailable), int32(stableAvailable+updatedAvailable), *r1.Spec.Replicas, paused, true, false)
+		activeSvc := newService(r2.Spec.Strategy.BlueGreen.ActiveService, 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+		previewSvc := newService(r2.Spec.Strategy.BlueGreen.PreviewService, 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}, r2)
+		f.kubeobjects = append(f.kubeobjects, activeSvc, previewSvc)
+		f.serviceLister = append(f.serviceLister, activeSvc, previewSvc)
+	} else {
+		r2 = updateCanaryRolloutStatus(r2, rs1PodHash, int32(stableAvailable+updatedAvailable), int32(updatedAvailable), *r1.Spec.Replicas, paused)
+	}
+	progressingReason := conditions.ReplicaSetUpdatedReason
+	if paused {
+		progressingReason = conditions.RolloutPausedReason
+	}
+	progressingCondition, _ := newProgressingCondition(progressingReason, rs2, "")
+	conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+	pausedCondition, _ := newPausedCondition(paused)
+	conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	return f, r2, rs1, rs2
+}
+
 func updateBaseRolloutStatus(r *v1alpha1.Rollout, availableReplicas, updatedReplicas, totalReplicas, hpaReplicas int32) *v1alpha1.Rollout {
 	newRollout := r.DeepCopy()
 	newRollout.Status.Replicas = totalReplicas
@@ -601,6 +641,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		MetricsServer:                   metricsServer,
 		Recorder:                        record.NewFakeEventRecorder(),
 		RefResolver:                     &FakeWorkloadRefResolver{},
+
+		RolloutStrategyTemplateInformer:        i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		ClusterRolloutStrategyTemplateInformer: i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
 	})
 
 	c.enqueueRollout = func(obj any) {
@@ -624,7 +667,8 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		c.enqueueRollout(obj)
 	}
 	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
-		if roCtx.rollout.Spec.Strategy.Canary == nil || roCtx.rollout.Spec.Strategy.Canary.TrafficRouting == nil {
+		strategy := roCtx.rollout.Spec.Strategy
+		if (strategy.Canary == nil || strategy.Canary.TrafficRouting == nil) && (strategy.BlueGreen == nil || strategy.BlueGreen.TrafficRouting == nil) {
 			return nil, nil
 		}
 		var reconcilers = []trafficrouting.TrafficRoutingReconciler{}
@@ -662,6 +706,12 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, ar := range f.analysisRunLister {
 		i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(ar)
 	}
+	for _, st := range f.strategyTemplateLister {
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates().Informer().GetIndexer().Add(st)
+	}
+	for _, cst := range f.clusterStrategyTemplateLister {
+		i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates().Informer().GetIndexer().Add(cst)
+	}
 
 	return c, i, k8sI
 }
@@ -765,6 +815,10 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("watch", "analysistemplates") ||
 			action.Matches("list", "clusteranalysistemplates") ||
 			action.Matches("watch", "clusteranalysistemplates") ||
+			action.Matches("list", "rolloutstrategytemplates") ||
+			action.Matches("watch", "rolloutstrategytemplates") ||
+			action.Matches("list", "clusterrolloutstrategytemplates") ||
+			action.Matches("watch", "clusterrolloutstrategytemplates") ||
 			action.Matches("list", "rollouts") ||
 			action.Matches("watch", "rollouts") ||
 			action.Matches("list", "replicaSets") ||
@@ -911,6 +965,16 @@ func (f *fixture) expectPatchRolloutAction(rollout *v1alpha1.Rollout) int {
 	return len
 }
 
+func (f *fixture) expectPatchRolloutSpecAction(rollout *v1alpha1.Rollout) int {
+	rolloutSchema := schema.GroupVersionResource{
+		Resource: "rollouts",
+		Version:  "v1alpha1",
+	}
+	len := len(f.actions)
+	f.actions = append(f.actions, core.NewPatchAction(rolloutSchema, rollout.Namespace, rollout.Name, types.JSONPatchType, nil))
+	return len
+}
+
 func (f *fixture) expectPatchRolloutActionWithPatch(rollout *v1alpha1.Rollout, patch string) int {
 	expectedPatch := calculatePatch(rollout, patch)
 	serviceSchema := schema.GroupVersionResource{
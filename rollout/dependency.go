@@ -0,0 +1,100 @@
+package rollout
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/dependency"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	// dependencyIndexName is the index of the rollouts by the namespace/name keys of the rollouts they depend on
+	dependencyIndexName = "byDependency"
+)
+
+// addDependencyIndexer indexes the rollouts by the rollouts they depend on, so that a change of a
+// rollout requeues the rollouts depending on it
+func addDependencyIndexer(rolloutsInformer cache.SharedIndexInformer) {
+	util.CheckErr(rolloutsInformer.AddIndexers(cache.Indexers{
+		dependencyIndexName: func(obj any) ([]string, error) {
+			ro := unstructuredutil.ObjectToRollout(obj)
+			if ro == nil {
+				return nil, nil
+			}
+			keys := make([]string, 0, len(ro.Spec.DependsOn))
+			for _, dep := range ro.Spec.DependsOn {
+				keys = append(keys, dependency.Key(dep, ro.Namespace))
+			}
+			return keys, nil
+		},
+	}))
+}
+
+// enqueueDependents enqueues the rollouts which depend on the given rollout
+func (c *Controller) enqueueDependents(ro *v1alpha1.Rollout) {
+	objs, err := c.rolloutsIndexer.ByIndex(dependencyIndexName, ro.Namespace+"/"+ro.Name)
+	if err != nil {
+		log.Warnf("Cannot get the rollouts depending on %s/%s: %v", ro.Namespace, ro.Name, err)
+		return
+	}
+	for _, obj := range objs {
+		c.enqueueRollout(obj)
+	}
+}
+
+// reconcileDependencies records the state of the rollouts the rollout depends on in the rollout
+// status and holds the update back until every dependency is satisfied.
+// The initial deployment and aborted updates are never held back.
+func (c *rolloutContext) reconcileDependencies() {
+	deps := c.rollout.Spec.DependsOn
+	if len(deps) == 0 {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonDependency)
+		return
+	}
+
+	statuses := make([]v1alpha1.RolloutDependencyStatus, 0, len(deps))
+	for _, dep := range deps {
+		ro := c.getDependencyRollout(dependency.GetNamespace(dep, c.rollout.Namespace), dep.Name)
+		statuses = append(statuses, dependency.Evaluate(dep, c.rollout.Namespace, ro))
+	}
+	c.newStatus.Dependencies = statuses
+
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	if c.rollout.Status.StableRS == "" || podHash == c.rollout.Status.StableRS || c.pauseContext.IsAborted() {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonDependency)
+		return
+	}
+
+	c.pendingDependencies = dependency.Unsatisfied(statuses)
+	if len(c.pendingDependencies) == 0 {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonDependency)
+		return
+	}
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonDependency) == nil {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutWaitingForDependencyReason}, conditions.RolloutWaitingForDependencyMessage, strings.Join(c.pendingDependencies, ", "))
+		if cycle := dependency.FindCycle(c.rollout, c.getDependencyRollout); cycle != nil {
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: conditions.RolloutDependencyCycleReason}, conditions.RolloutDependencyCycleMessage, dependency.FormatCycle(cycle))
+		}
+	}
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonDependency)
+}
+
+// getDependencyRollout returns the rollout a dependency refers to, with its strategy resolved, or nil if
+// it does not exist or is not watched by the controller
+func (c *rolloutContext) getDependencyRollout(namespace, name string) *v1alpha1.Rollout {
+	ro, err := c.rolloutsLister.Rollouts(namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return strategytemplate.ResolvedRollout(ro, c.strategyTemplateGetter)
+}
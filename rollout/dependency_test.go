@@ -0,0 +1,106 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+// newFrontendRollout returns a canary rollout which depends on the backend rollout
+func newFrontendRollout() *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r := newCanaryRollout("frontend", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend"}}
+	return r
+}
+
+func newBackendRollout(phase v1alpha1.RolloutPhase) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: metav1.NamespaceDefault, Generation: 2},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+		Status: v1alpha1.RolloutStatus{ObservedGeneration: "2", Phase: phase},
+	}
+}
+
+func TestDependencyHoldsBackUpdate(t *testing.T) {
+	backend := newBackendRollout(v1alpha1.RolloutPhaseProgressing)
+	f, r2, _, _ := newRolloutUpdateFixture(t, newFrontendRollout(), 10, 0, false)
+	defer f.Close()
+	f.rolloutLister = append(f.rolloutLister, backend)
+	f.objects = append(f.objects, backend)
+
+	// The new ReplicaSet is not scaled up
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDependency, patched.Status.PauseConditions[0].Reason)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, patched.Status.Phase)
+	assert.Equal(t, []v1alpha1.RolloutDependencyStatus{{
+		Name:      "backend",
+		Namespace: metav1.NamespaceDefault,
+		Phase:     v1alpha1.RolloutPhaseProgressing,
+	}}, patched.Status.Dependencies)
+	assert.Contains(t, f.events, conditions.RolloutWaitingForDependencyReason)
+}
+
+func TestDependencyHoldsBackExperimentsAndAnalysis(t *testing.T) {
+	backend := newBackendRollout(v1alpha1.RolloutPhaseProgressing)
+	f, r2, _, _ := newRolloutUpdateFixture(t, newFrontendRollout(), 10, 0, false)
+	defer f.Close()
+	f.rolloutLister = append(f.rolloutLister, backend)
+	f.objects = append(f.objects, backend)
+	withStepZeroExperimentAndAnalysis(f, r2)
+
+	// Neither the experiment nor the analysis run of step 0 is created
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDependency, patched.Status.PauseConditions[0].Reason)
+	assert.Empty(t, patched.Status.Canary.CurrentExperiment)
+	assert.Nil(t, patched.Status.Canary.CurrentBackgroundAnalysisRunStatus)
+}
+
+func TestDependencyResumesUpdate(t *testing.T) {
+	backend := newBackendRollout(v1alpha1.RolloutPhaseHealthy)
+	f, r2, _, _ := newRolloutUpdateFixture(t, newFrontendRollout(), 10, 0, false)
+	defer f.Close()
+	f.rolloutLister = append(f.rolloutLister, backend)
+	f.objects = append(f.objects, backend)
+	r2.Status.ControllerPause = true
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonDependency}}
+
+	f.expectPatchRolloutAction(r2)
+	f.expectUpdateReplicaSetAction(f.replicaSetLister[1])
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.NotContains(t, f.events, conditions.RolloutWaitingForDependencyReason)
+}
+
+func TestDependencyCycle(t *testing.T) {
+	backend := newBackendRollout(v1alpha1.RolloutPhaseProgressing)
+	backend.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "frontend"}}
+	f, r2, _, _ := newRolloutUpdateFixture(t, newFrontendRollout(), 10, 0, false)
+	defer f.Close()
+	f.rolloutLister = append(f.rolloutLister, backend)
+	f.objects = append(f.objects, backend)
+
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	assert.Contains(t, f.events, conditions.RolloutDependencyCycleReason)
+}
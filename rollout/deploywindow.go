@@ -0,0 +1,58 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/deploywindow"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// reconcileDeployWindow holds the update back while the deploy windows of the rollout do not allow
+// it to progress, and requeues the rollout for when the windows need to be evaluated again.
+// The initial deployment, aborted updates and updates promoted with --ignore-window are never held back.
+// Windows which cannot be evaluated hold the update back as well.
+func (c *rolloutContext) reconcileDeployWindow() {
+	windows := c.rollout.Spec.DeployWindows
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	if len(windows) == 0 || c.rollout.Status.StableRS == "" || podHash == c.rollout.Status.StableRS ||
+		c.rollout.Status.IgnoreDeployWindow || c.pauseContext.IsAborted() {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonOutsideDeployWindow)
+		return
+	}
+
+	now := timeutil.Now()
+	allowed, next, err := deploywindow.Evaluate(windows, now)
+	if err != nil {
+		// fail closed: the update is held back until the windows can be evaluated again
+		c.log.Warnf("Failed to evaluate deploy windows: %v", err)
+		c.outsideDeployWindow = true
+		if getPauseCondition(c.rollout, v1alpha1.PauseReasonOutsideDeployWindow) == nil {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutDeployWindowErrorReason}, conditions.RolloutDeployWindowErrorMessage, err)
+		}
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonOutsideDeployWindow)
+		return
+	}
+	if !next.IsZero() {
+		c.enqueueRolloutAfter(c.rollout, next.Sub(now))
+	}
+	if allowed {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonOutsideDeployWindow)
+		return
+	}
+
+	c.outsideDeployWindow = true
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonOutsideDeployWindow) == nil {
+		// the windows can be evaluated again before they open, e.g. when a deny window ends outside of the allow windows
+		msg := conditions.RolloutOutsideDeployWindowIndefinitelyMessage
+		if opens, err := deploywindow.NextAllowed(windows, now); err == nil && !opens.IsZero() {
+			msg = fmt.Sprintf(conditions.RolloutOutsideDeployWindowMessage, opens.UTC().Format(time.RFC3339))
+		}
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutOutsideDeployWindowReason}, msg)
+	}
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonOutsideDeployWindow)
+}
@@ -0,0 +1,212 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8srecord "k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newDeployWindowRollout returns a canary rollout with an allow window during office hours
+func newDeployWindowRollout() *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.DeployWindows = []v1alpha1.DeployWindow{{
+		Kind:     v1alpha1.DeployWindowKindAllow,
+		Schedule: "0 9 * * *",
+		Duration: "8h",
+	}}
+	return r
+}
+
+// withStepZeroExperimentAndAnalysis gives the rollout a background analysis and an experiment with an
+// analysis as its first step, which would be started on the first reconcile of the update
+func withStepZeroExperimentAndAnalysis(f *fixture, r *v1alpha1.Rollout) {
+	at := analysisTemplate("bar")
+	r.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+		Experiment: &v1alpha1.RolloutExperimentStep{
+			Templates: []v1alpha1.RolloutExperimentTemplate{{
+				Name:     "stable-template",
+				SpecRef:  v1alpha1.StableSpecRef,
+				Replicas: pointer.Int32Ptr(1),
+			}},
+			Analyses: []v1alpha1.RolloutExperimentStepAnalysisTemplateRef{{
+				Name:         "test",
+				TemplateName: at.Name,
+			}},
+		},
+	}}
+	r.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+		RolloutAnalysis: v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+		},
+	}
+	r.Status.CurrentStepHash = conditions.ComputeStepHash(r)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.objects = append(f.objects, at)
+}
+
+func TestDeployWindowHoldsBackUpdate(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newDeployWindowRollout(), 10, 0, false)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	})
+
+	// The new ReplicaSet is not scaled up
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonOutsideDeployWindow, patched.Status.PauseConditions[0].Reason)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, patched.Status.Phase)
+	assert.Contains(t, f.events, conditions.RolloutOutsideDeployWindowReason)
+}
+
+func TestDeployWindowHoldsBackExperimentsAndAnalysis(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newDeployWindowRollout(), 10, 0, false)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	})
+	withStepZeroExperimentAndAnalysis(f, r2)
+
+	// Neither the experiment nor the analysis run of step 0 is created
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonOutsideDeployWindow, patched.Status.PauseConditions[0].Reason)
+	assert.Empty(t, patched.Status.Canary.CurrentExperiment)
+	assert.Nil(t, patched.Status.Canary.CurrentBackgroundAnalysisRunStatus)
+}
+
+func TestDeployWindowResumesUpdate(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newDeployWindowRollout(), 10, 0, false)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	})
+	r2.Status.ControllerPause = true
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonOutsideDeployWindow}}
+
+	f.expectPatchRolloutAction(r2)
+	f.expectUpdateReplicaSetAction(f.replicaSetLister[1])
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+}
+
+func TestDeployWindowResumesUpdateAtPauseStep(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newDeployWindowRollout(), 10, 0, true)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	})
+	r2.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}}
+	r2.Status.CurrentStepHash = conditions.ComputeStepHash(r2)
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonOutsideDeployWindow}}
+
+	// the pause of the held back update is not mistaken for a resume of the pause step
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonCanaryPauseStep, patched.Status.PauseConditions[0].Reason)
+}
+
+func TestDeployWindowIgnored(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newDeployWindowRollout(), 10, 0, false)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	})
+	r2.Status.IgnoreDeployWindow = true
+
+	f.expectUpdateReplicaSetAction(f.replicaSetLister[1])
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	// the override is kept until the update completes
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), "ignoreDeployWindow")
+	assert.NotContains(t, f.events, conditions.RolloutOutsideDeployWindowReason)
+}
+
+func TestDeployWindowHoldsBackUpdateOnEvaluationError(t *testing.T) {
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	})
+	defer timeutil.SetNowTimeFunc(time.Now)
+	r2 := bumpVersion(newDeployWindowRollout())
+	r2.Status.StableRS = "stable-hash"
+	// the allow window would be active, but its time zone is unknown: windows which cannot be
+	// evaluated are normally reported by the spec validation before the reconcile
+	r2.Spec.DeployWindows[0].TimeZone = "Mars/Olympus_Mons"
+
+	recorder := record.NewFakeEventRecorder()
+	roCtx := &rolloutContext{
+		log:            logutil.WithRollout(r2),
+		rollout:        r2,
+		pauseContext:   &pauseContext{rollout: r2},
+		reconcilerBase: reconcilerBase{recorder: recorder},
+	}
+	roCtx.reconcileDeployWindow()
+
+	assert.True(t, roCtx.outsideDeployWindow)
+	assert.Len(t, roCtx.pauseContext.addPauseReasons, 1)
+	assert.Equal(t, v1alpha1.PauseReasonOutsideDeployWindow, roCtx.pauseContext.addPauseReasons[0])
+	assert.Equal(t, []string{conditions.RolloutDeployWindowErrorReason}, recorder.Events())
+}
+
+func TestDeployWindowReportsNextOpening(t *testing.T) {
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	})
+	defer timeutil.SetNowTimeFunc(time.Now)
+	r2 := bumpVersion(newDeployWindowRollout())
+	r2.Status.StableRS = "stable-hash"
+	// the deny window is evaluated again at 20:00, while the update is only allowed from 09:00 on
+	r2.Spec.DeployWindows = append(r2.Spec.DeployWindows, v1alpha1.DeployWindow{
+		Kind:     v1alpha1.DeployWindowKindDeny,
+		Schedule: "0 20 * * *",
+		Duration: "2h",
+	})
+
+	recorder := record.NewFakeEventRecorder()
+	var requeuedAfter time.Duration
+	roCtx := &rolloutContext{
+		log:          logutil.WithRollout(r2),
+		rollout:      r2,
+		pauseContext: &pauseContext{rollout: r2},
+		reconcilerBase: reconcilerBase{
+			recorder: recorder,
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {
+				requeuedAfter = duration
+			},
+		},
+	}
+	roCtx.reconcileDeployWindow()
+
+	assert.True(t, roCtx.outsideDeployWindow)
+	assert.Equal(t, 2*time.Hour, requeuedAfter)
+	assert.Equal(t, []string{conditions.RolloutOutsideDeployWindowReason}, recorder.Events())
+	event := <-recorder.Recorder.(*k8srecord.FakeRecorder).Events
+	assert.Contains(t, event, "held back by the deploy windows until 2024-03-05T09:00:00Z")
+}
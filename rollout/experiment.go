@@ -2,17 +2,25 @@ package rollout
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	patchtypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	experimentutil "github.com/argoproj/argo-rollouts/utils/experiment"
 	"github.com/argoproj/argo-rollouts/utils/hash"
@@ -52,6 +60,7 @@ func GetExperimentFromTemplate(r *v1alpha1.Rollout, stableRS, newRS *appsv1.Repl
 			ProgressDeadlineSeconds: r.Spec.ProgressDeadlineSeconds,
 			DryRun:                  step.DryRun,
 			AnalysisRunMetadata:     step.AnalysisRunMetadata,
+			Comparison:              step.Comparison.DeepCopy(),
 		},
 	}
 
@@ -83,6 +92,7 @@ func GetExperimentFromTemplate(r *v1alpha1.Rollout, stableRS, newRS *appsv1.Repl
 			return nil, fmt.Errorf("Invalid template step SpecRef: must be canary or stable")
 		}
 		template.Template = templateRS.Spec.Template
+		applyTemplateContainers(&template.Template, templateStep.Containers)
 		template.MinReadySeconds = templateRS.Spec.MinReadySeconds
 
 		if templateStep.Selector != nil {
@@ -131,6 +141,138 @@ func GetExperimentFromTemplate(r *v1alpha1.Rollout, stableRS, newRS *appsv1.Repl
 	return experiment, nil
 }
 
+// applyTemplateContainers overrides the images and environment variables of the containers of the pod template
+func applyTemplateContainers(template *corev1.PodTemplateSpec, overrides []v1alpha1.TemplateContainer) {
+	for _, override := range overrides {
+		for i := range template.Spec.Containers {
+			container := &template.Spec.Containers[i]
+			if container.Name != override.Name {
+				continue
+			}
+			if override.Image != "" {
+				container.Image = override.Image
+			}
+			for _, env := range override.Env {
+				j := slices.IndexFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == env.Name })
+				if j >= 0 {
+					container.Env[j] = env
+				} else {
+					container.Env = append(container.Env, env)
+				}
+			}
+		}
+	}
+}
+
+// promotedExperimentWinner is the value of the PromotedExperimentWinnerAnnotation
+type promotedExperimentWinner struct {
+	PodTemplateHash string `json:"podTemplateHash"`
+	StepIndex       int32  `json:"stepIndex"`
+}
+
+// isResumedWithoutWinner returns whether the rollout was resumed after it paused since its experiment had no winner
+func (c *rolloutContext) isResumedWithoutWinner() bool {
+	return isControllerPaused(c.rollout) && getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveExperiment) == nil
+}
+
+// promoteExperimentWinner promotes the pod template of the winning template of the experiment as the canary by
+// replacing the pod template of the rollout. The update of the promoted canary resumes at the step after the
+// experiment. The rollout pauses when the experiment has no winner, and continues with the current canary once
+// it is resumed.
+func (c *rolloutContext) promoteExperimentWinner(ex *v1alpha1.Experiment, step *v1alpha1.RolloutExperimentStep, stepIndex int32) error {
+	if ex.Status.Winner == "" {
+		if !c.isResumedWithoutWinner() {
+			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveExperiment)
+		}
+		return nil
+	}
+	i := slices.IndexFunc(step.Templates, func(t v1alpha1.RolloutExperimentTemplate) bool { return t.Name == ex.Status.Winner })
+	if i < 0 {
+		return fmt.Errorf("winner '%s' of experiment '%s' is not a template of the experiment step", ex.Status.Winner, ex.Name)
+	}
+	winner := step.Templates[i]
+
+	var template corev1.PodTemplateSpec
+	switch winner.SpecRef {
+	case v1alpha1.CanarySpecRef:
+		template = *c.rollout.Spec.Template.DeepCopy()
+	case v1alpha1.StableSpecRef:
+		if c.stableRS == nil {
+			return fmt.Errorf("cannot promote winner '%s' of experiment '%s': stable ReplicaSet not found", winner.Name, ex.Name)
+		}
+		template = c.podTemplateFromReplicaSet(c.stableRS)
+	default:
+		return fmt.Errorf("cannot promote winner '%s' of experiment '%s': invalid SpecRef '%s', must be canary or stable", winner.Name, ex.Name, winner.SpecRef)
+	}
+	applyTemplateContainers(&template, winner.Containers)
+	if apiequality.Semantic.DeepEqual(template, c.rollout.Spec.Template) {
+		// the winner is the current canary
+		return nil
+	}
+
+	podHash := hash.ComputePodTemplateHash(&template, c.rollout.Status.CollisionCount)
+	promoted, err := json.Marshal(promotedExperimentWinner{PodTemplateHash: podHash, StepIndex: stepIndex + 1})
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf(conditions.ExperimentWinnerPromotedMessage, winner.Name, ex.Name)
+	auditLog, err := audit.AppendEntry(c.rollout, audit.NewEntry(c.rollout, audit.ControllerActor, audit.SourceController, audit.ActionPromoteWinner, msg))
+	if err != nil {
+		return err
+	}
+	// the test of the resourceVersion fails the patch when the rollout changed since it was read, e.g. when the
+	// winner was already promoted, and the returned error requeues the rollout
+	ops := []any{
+		map[string]any{
+			"op":    "test",
+			"path":  "/metadata/resourceVersion",
+			"value": c.rollout.ResourceVersion,
+		},
+		map[string]any{
+			"op":    "replace",
+			"path":  "/spec/template",
+			"value": template,
+		},
+	}
+	if c.rollout.Annotations == nil {
+		ops = append(ops, map[string]any{"op": "add", "path": "/metadata/annotations", "value": map[string]string{}})
+	}
+	for key, value := range map[string]string{
+		annotations.PromotedExperimentWinnerAnnotation: string(promoted),
+		audit.LogAnnotation:                            auditLog,
+	} {
+		ops = append(ops, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/annotations/" + strings.ReplaceAll(key, "/", "~1"),
+			"value": value,
+		})
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	_, err = c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Patch(context.TODO(), c.rollout.Name, patchtypes.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to promote winner '%s' of experiment '%s': %w", winner.Name, ex.Name, err)
+	}
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.ExperimentWinnerPromotedReason}, msg)
+	return nil
+}
+
+// getPromotedWinnerStepIndex returns the step the update of the canary resumes at when its pod template is the
+// promoted winner of an experiment
+func getPromotedWinnerStepIndex(rollout *v1alpha1.Rollout, podHash string) *int32 {
+	value, ok := rollout.Annotations[annotations.PromotedExperimentWinnerAnnotation]
+	if !ok {
+		return nil
+	}
+	var promoted promotedExperimentWinner
+	if err := json.Unmarshal([]byte(value), &promoted); err != nil || promoted.PodTemplateHash != podHash {
+		return nil
+	}
+	return &promoted.StepIndex
+}
+
 // getExperimentsForRollout get all experiments owned by the Rollout
 // changing steps in the Rollout Spec would cause multiple experiments to exist which is why it returns an array
 func (c *Controller) getExperimentsForRollout(rollout *v1alpha1.Rollout) ([]*v1alpha1.Experiment, error) {
@@ -189,6 +331,11 @@ func (c *rolloutContext) reconcileExperiments() error {
 			c.pauseContext.AddAbort(currentEx.Status.Message)
 		case v1alpha1.AnalysisPhaseSuccessful:
 			// Do not set current Experiment after successful experiment
+			if step.Experiment.PromoteWinner {
+				if err := c.promoteExperimentWinner(currentEx, step.Experiment, *stepIdx); err != nil {
+					return err
+				}
+			}
 		default:
 			c.SetCurrentExperiment(currentEx)
 		}
@@ -3,17 +3,22 @@ package rollout
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -484,6 +489,132 @@ func TestRolloutExperimentFinishedIncrementStep(t *testing.T) {
 	assert.JSONEq(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, generatedConditions)), patch)
 }
 
+func newPromoteWinnerRollout(winner string) (*v1alpha1.Rollout, *v1alpha1.Rollout, *appsv1.ReplicaSet, *appsv1.ReplicaSet, *v1alpha1.Experiment) {
+	steps := []v1alpha1.CanaryStep{{
+		Experiment: &v1alpha1.RolloutExperimentStep{
+			Templates: []v1alpha1.RolloutExperimentTemplate{{
+				Name:    "a",
+				SpecRef: v1alpha1.CanarySpecRef,
+			}, {
+				Name:       "b",
+				SpecRef:    v1alpha1.CanarySpecRef,
+				Containers: []v1alpha1.TemplateContainer{{Name: "container-name", Image: "foo/bar:b"}},
+			}},
+			Analyses: []v1alpha1.RolloutExperimentStepAnalysisTemplateRef{
+				{Name: "a-metrics", TemplateName: "metrics"},
+				{Name: "b-metrics", TemplateName: "metrics"},
+			},
+			Comparison: &v1alpha1.ExperimentComparison{
+				Analyses: []v1alpha1.ComparisonAnalysis{
+					{TemplateName: "a", AnalysisName: "a-metrics"},
+					{TemplateName: "b", AnalysisName: "b-metrics"},
+				},
+				Metrics: []v1alpha1.ComparisonMetric{{Name: "conversion"}},
+			},
+			PromoteWinner: true,
+		},
+	}, {
+		SetWeight: pointer.Int32Ptr(50),
+	}}
+
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(0), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 0, 1, false)
+	r2.ResourceVersion = "1"
+
+	ex, _ := GetExperimentFromTemplate(r2, rs1, rs2)
+	ex.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+	ex.Status.Winner = winner
+	now := metav1.Now()
+	ex.Status.AvailableAt = &now
+	r2.Status.Canary.CurrentExperiment = ex.Name
+	return r1, r2, rs1, rs2, ex
+}
+
+func TestRolloutPromoteExperimentWinner(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2, rs1, rs2, ex := newPromoteWinnerRollout("b")
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.experimentLister = append(f.experimentLister, ex)
+	f.objects = append(f.objects, r2, ex)
+
+	specPatchIndex := f.expectPatchRolloutSpecAction(r1)
+	f.expectPatchRolloutAction(r1)
+	f.run(getKey(r2, t))
+
+	var ops []struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(f.getPatchedRollout(specPatchIndex)), &ops))
+	assert.Equal(t, "test", ops[0].Op)
+	assert.Equal(t, "/metadata/resourceVersion", ops[0].Path)
+	assert.JSONEq(t, `"1"`, string(ops[0].Value))
+	var template corev1.PodTemplateSpec
+	assert.Equal(t, "/spec/template", ops[1].Path)
+	assert.NoError(t, json.Unmarshal(ops[1].Value, &template))
+	assert.Equal(t, "foo/bar:b", template.Spec.Containers[0].Image)
+
+	promoted := &v1alpha1.Rollout{}
+	promoted.Annotations = map[string]string{}
+	for _, op := range ops[2:] {
+		var value string
+		assert.NoError(t, json.Unmarshal(op.Value, &value))
+		promoted.Annotations[strings.ReplaceAll(strings.TrimPrefix(op.Path, "/metadata/annotations/"), "~1", "/")] = value
+	}
+	podHash := hash.ComputePodTemplateHash(&template, r2.Status.CollisionCount)
+	assert.Equal(t, pointer.Int32(1), getPromotedWinnerStepIndex(promoted, podHash))
+	assert.Nil(t, getPromotedWinnerStepIndex(promoted, rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]))
+	assert.Contains(t, promoted.Annotations[audit.LogAnnotation], string(audit.ActionPromoteWinner))
+}
+
+func TestRolloutPromoteExperimentWinnerRequeuedOnConflict(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2, rs1, rs2, ex := newPromoteWinnerRollout("b")
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	// the rollout was updated since the informer cache was synced
+	cached := r2.DeepCopy()
+	r2.ResourceVersion = "2"
+	f.rolloutLister = append(f.rolloutLister, cached)
+	f.experimentLister = append(f.experimentLister, ex)
+	f.objects = append(f.objects, r2, ex)
+
+	f.expectPatchRolloutSpecAction(r1)
+	f.runExpectError(getKey(r2, t), false)
+	assert.NotContains(t, f.events, conditions.ExperimentWinnerPromotedReason)
+}
+
+func TestRolloutPauseExperimentWithoutWinner(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2, rs1, rs2, ex := newPromoteWinnerRollout("")
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.experimentLister = append(f.experimentLister, ex)
+	f.objects = append(f.objects, r2, ex)
+
+	patchIndex := f.expectPatchRolloutAction(r1)
+	f.run(getKey(r2, t))
+	ro := v1alpha1.Rollout{}
+	assert.NoError(t, json.Unmarshal([]byte(f.getPatchedRollout(patchIndex)), &ro))
+	assert.Equal(t, v1alpha1.PauseReasonInconclusiveExperiment, ro.Status.PauseConditions[0].Reason)
+	assert.Nil(t, ro.Status.CurrentStepIndex)
+}
+
 func TestRolloutDoNotCreateExperimentWithoutStableRS(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -557,6 +688,18 @@ func TestGetExperimentFromTemplate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, rs2.Spec.Template, canary.Spec.Templates[0].Template)
 
+	r2.Spec.Strategy.Canary.Steps[0].Experiment.Templates[0].Containers = []v1alpha1.TemplateContainer{{
+		Name:  "container-name",
+		Image: "foo/bar:variant",
+		Env:   []corev1.EnvVar{{Name: "VARIANT", Value: "b"}},
+	}}
+	variant, err := GetExperimentFromTemplate(r2, rs1, rs2)
+	assert.Nil(t, err)
+	assert.Equal(t, "foo/bar:variant", variant.Spec.Templates[0].Template.Spec.Containers[0].Image)
+	assert.Equal(t, []corev1.EnvVar{{Name: "VARIANT", Value: "b"}}, variant.Spec.Templates[0].Template.Spec.Containers[0].Env)
+	assert.Equal(t, "foo/bar2", rs2.Spec.Template.Spec.Containers[0].Image)
+	r2.Spec.Strategy.Canary.Steps[0].Experiment.Templates[0].Containers = nil
+
 	r2.Spec.Strategy.Canary.Steps[0].Experiment.Templates[0].Metadata.Annotations = map[string]string{"abc": "def"}
 	r2.Spec.Strategy.Canary.Steps[0].Experiment.Templates[0].Metadata.Labels = map[string]string{"123": "456"}
 	modifiedLabelAndAnnotations, err := GetExperimentFromTemplate(r2, rs1, rs2)
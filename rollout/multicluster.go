@@ -0,0 +1,162 @@
+package rollout
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	multiclusterutil "github.com/argoproj/argo-rollouts/utils/multicluster"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// MemberClusterStatusLister returns the last observed state of a rollout in the member clusters
+type MemberClusterStatusLister interface {
+	MemberClusterStatuses(namespace, name string) []v1alpha1.MemberClusterStatus
+}
+
+// reconcileMultiCluster records the state of the member clusters in the rollout status, aborts the
+// update when it was aborted in a member cluster, rolls back the update when it was aborted in a
+// member cluster after being fully promoted in this cluster, and holds the update until the clusters
+// of the previous waves are Healthy with the same pod template hash
+func (c *rolloutContext) reconcileMultiCluster() error {
+	mc := c.rollout.Spec.MultiCluster
+	if mc == nil || c.memberClusterStatusLister == nil {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonClusterWave)
+		return nil
+	}
+
+	cluster := defaults.GetClusterName()
+	members := c.memberClusterStatusLister.MemberClusterStatuses(c.rollout.Namespace, c.rollout.Name)
+	status := &v1alpha1.MultiClusterStatus{
+		Cluster: cluster,
+		Members: members,
+	}
+	if waveIndex := multiclusterutil.GetClusterWave(mc, cluster); waveIndex >= 0 {
+		status.Wave = mc.Waves[waveIndex].Name
+	} else {
+		c.log.Warnf("Cluster '%s' is not part of any wave, the rollout is not held back by the other clusters", cluster)
+	}
+	c.newStatus.MultiCluster = status
+
+	// an aborted update is rolled back regardless of the other clusters
+	if c.pauseContext.IsAborted() {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonClusterWave)
+		return nil
+	}
+
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	aborted := multiclusterutil.GetAbortedCluster(members, podHash)
+	if podHash == c.rollout.Status.StableRS {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonClusterWave)
+		if aborted != nil {
+			return c.rollbackAbortedInMemberCluster(aborted)
+		}
+		return nil
+	}
+
+	if aborted != nil {
+		msg := fmt.Sprintf(conditions.RolloutAbortedInMemberClusterMessage, aborted.Cluster)
+		c.log.Info(msg)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: conditions.RolloutAbortedInMemberClusterReason}, msg)
+		c.pauseContext.AddAbort(msg)
+		return nil
+	}
+
+	// a rollback made by the controller is not held back, so that the rollback of an update aborted in
+	// a later wave reaches all the clusters of the earlier waves without waiting on each other
+	if c.isRollingBackStable() {
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonClusterWave)
+		return nil
+	}
+
+	c.pendingClusters = multiclusterutil.PendingClusters(mc, cluster, podHash, members)
+	if len(c.pendingClusters) > 0 {
+		if getPauseCondition(c.rollout, v1alpha1.PauseReasonClusterWave) == nil {
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutWaitingForClusterWaveReason}, conditions.RolloutWaitingForClusterWaveMessage, strings.Join(c.pendingClusters, ", "))
+		}
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonClusterWave)
+		return nil
+	}
+	c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonClusterWave)
+	return nil
+}
+
+// rollbackAbortedInMemberCluster rolls the fully promoted update back to the ReplicaSet of the previous
+// revision, since the update was aborted in a member cluster
+func (c *rolloutContext) rollbackAbortedInMemberCluster(aborted *v1alpha1.MemberClusterStatus) error {
+	previousRS := c.previousRevisionReplicaSet()
+	if c.newRS == nil || previousRS == nil {
+		c.log.Warnf("Update aborted in member cluster '%s' cannot be rolled back without a previous ReplicaSet", aborted.Cluster)
+		return nil
+	}
+	msg := fmt.Sprintf(conditions.RolloutRolledBackInMemberClusterMessage, c.newRS.Name, previousRS.Name, aborted.Cluster)
+	if err := c.rollbackToReplicaSet(previousRS, msg); err != nil {
+		return err
+	}
+	c.log.Info(msg)
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutRolledBackInMemberClusterReason}, msg)
+	return nil
+}
+
+// isRollingBackStable returns true when the update is the rollback of the stable ReplicaSet the controller
+// recorded in the audit log of the rollout, and the rollout was not updated again since
+func (c *rolloutContext) isRollingBackStable() bool {
+	if c.stableRS == nil {
+		return false
+	}
+	entries, _ := audit.GetEntries(c.rollout)
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Source != audit.SourceController || entry.Action != audit.ActionRollback {
+			continue
+		}
+		rolledBack, err := strconv.ParseInt(entry.Revision, 10, 64)
+		if err != nil {
+			return false
+		}
+		stableRevision, err := replicasetutil.Revision(c.stableRS)
+		if err != nil || stableRevision != rolledBack {
+			return false
+		}
+		// the rollback makes the restored ReplicaSet the next revision, a later update the one after
+		revision, _ := annotations.GetRevisionAnnotation(c.rollout)
+		return int64(revision) <= rolledBack+1
+	}
+	return false
+}
+
+// previousRevisionReplicaSet returns the ReplicaSet with the highest revision below the revision of the
+// new ReplicaSet, ignoring the ReplicaSets of experiments
+func (c *rolloutContext) previousRevisionReplicaSet() *appsv1.ReplicaSet {
+	if c.newRS == nil {
+		return nil
+	}
+	newRevision, err := replicasetutil.Revision(c.newRS)
+	if err != nil {
+		return nil
+	}
+	var previous *appsv1.ReplicaSet
+	var previousRevision int64
+	for _, rs := range c.allRSs {
+		if rs.Annotations != nil && rs.Annotations[v1alpha1.ExperimentNameAnnotationKey] != "" {
+			continue
+		}
+		revision, err := replicasetutil.Revision(rs)
+		if err != nil || revision >= newRevision || revision <= previousRevision {
+			continue
+		}
+		previous = rs
+		previousRevision = revision
+	}
+	return previous
+}
@@ -0,0 +1,282 @@
+package rollout
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+type fakeMemberClusterStatusLister struct {
+	statuses []v1alpha1.MemberClusterStatus
+}
+
+func (f *fakeMemberClusterStatusLister) MemberClusterStatuses(namespace, name string) []v1alpha1.MemberClusterStatus {
+	return f.statuses
+}
+
+// newMultiClusterRollout returns a canary rollout promoted to staging-1 in the staging wave, then to
+// the given clusters in the prod wave
+func newMultiClusterRollout(prodClusters ...string) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.MultiCluster = &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "staging", Clusters: []string{"staging-1"}},
+			{Name: "prod", Clusters: prodClusters},
+		},
+	}
+	return r
+}
+
+func (f *fixture) runWithMemberClusters(key string, statuses []v1alpha1.MemberClusterStatus) {
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	c.memberClusterStatusLister = &fakeMemberClusterStatusLister{statuses: statuses}
+	f.runController(key, true, false, c, i, k8sI)
+}
+
+func TestMultiClusterWaitForPreviousWave(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1", "prod-2"), 10, 0, false)
+	defer f.Close()
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	members := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseProgressing, CurrentPodHash: rs2PodHash},
+		{Cluster: "prod-2", Wave: "prod", Phase: v1alpha1.RolloutPhaseHealthy, CurrentPodHash: r2.Status.StableRS},
+	}
+	// The new ReplicaSet is not scaled up
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), members)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonClusterWave, patched.Status.PauseConditions[0].Reason)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, patched.Status.Phase)
+	assert.Equal(t, &v1alpha1.MultiClusterStatus{Cluster: "prod-1", Wave: "prod", Members: members}, patched.Status.MultiCluster)
+	assert.Contains(t, f.events, conditions.RolloutWaitingForClusterWaveReason)
+}
+
+func TestMultiClusterWaitForPreviousWaveHoldsBackExperimentsAndAnalysis(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1", "prod-2"), 10, 0, false)
+	defer f.Close()
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	withStepZeroExperimentAndAnalysis(f, r2)
+
+	members := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseProgressing, CurrentPodHash: rs2PodHash},
+	}
+	// Neither the experiment nor the analysis run of step 0 is created
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), members)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonClusterWave, patched.Status.PauseConditions[0].Reason)
+	assert.Empty(t, patched.Status.Canary.CurrentExperiment)
+	assert.Nil(t, patched.Status.Canary.CurrentBackgroundAnalysisRunStatus)
+}
+
+func TestMultiClusterPreviousWaveHealthy(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1", "prod-2"), 10, 0, false)
+	defer f.Close()
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2.Status.ControllerPause = true
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonClusterWave}}
+
+	members := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseHealthy, CurrentPodHash: rs2PodHash},
+	}
+	f.expectPatchRolloutAction(r2)
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), members)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.Equal(t, "prod", patched.Status.MultiCluster.Wave)
+}
+
+func TestMultiClusterAbortedInMemberCluster(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1", "prod-2"), 10, 0, false)
+	defer f.Close()
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	members := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseHealthy, CurrentPodHash: rs2PodHash},
+		{Cluster: "prod-2", Wave: "prod", Phase: v1alpha1.RolloutPhaseDegraded, CurrentPodHash: rs2PodHash, Aborted: true},
+	}
+	// The abort is persisted by this reconciliation, the new ReplicaSet is scaled down by the next one
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), members)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.Contains(t, f.events, conditions.RolloutAbortedInMemberClusterReason)
+	abortedCond := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutProgressing)
+	assert.Equal(t, conditions.RolloutAbortedReason, abortedCond.Reason)
+	assert.Contains(t, abortedCond.Message, fmt.Sprintf(conditions.RolloutAbortedInMemberClusterMessage, "prod-2"))
+}
+
+func TestMultiClusterFirstWave(t *testing.T) {
+	defaults.SetClusterName("staging-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1", "prod-2"), 10, 0, false)
+	defer f.Close()
+
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), nil)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.Equal(t, &v1alpha1.MultiClusterStatus{Cluster: "staging-1", Wave: "staging"}, patched.Status.MultiCluster)
+}
+
+func TestMultiClusterAbortedRolloutIsNotHeldBack(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, r2, _, rs2 := newRolloutUpdateFixture(t, newMultiClusterRollout("prod-1"), 9, 1, false)
+	defer f.Close()
+	r2.Status.Abort = true
+	now := timeutil.MetaNow()
+	r2.Status.AbortedAt = &now
+
+	// staging-1 is still updating, which does not prevent the abort from scaling up the stable ReplicaSet
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseProgressing},
+	})
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.NotContains(t, f.events, conditions.RolloutWaitingForClusterWaveReason)
+}
+
+func TestMultiClusterRollsBackPromotedUpdateAbortedInLaterWave(t *testing.T) {
+	defaults.SetClusterName("staging-1")
+	defer defaults.SetClusterName("")
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(0), intstr.FromInt(1))
+	r1.Spec.MultiCluster = &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "staging", Clusters: []string{"staging-1"}},
+			{Name: "prod", Clusters: []string{"prod-1"}},
+		},
+	}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 0, 0)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs2PodHash, 1, 1, 1, false)
+	r2.ResourceVersion = "1"
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	// the update completed in staging-1 and was aborted in prod-1, it is rolled back to rs1
+	rollbackIndex := f.expectPatchRolloutSpecAction(r2)
+	f.expectPatchRolloutAction(r2)
+	f.runWithMemberClusters(getKey(r2, t), []v1alpha1.MemberClusterStatus{
+		{Cluster: "prod-1", Wave: "prod", Phase: v1alpha1.RolloutPhaseDegraded, CurrentPodHash: rs2PodHash, Aborted: true},
+	})
+
+	var rollback []map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(f.getPatchedRollout(rollbackIndex)), &rollback))
+	assert.Equal(t, "test", rollback[0]["op"])
+	assert.Equal(t, "/spec/template", rollback[1]["path"])
+	var template corev1.PodTemplateSpec
+	templateBytes, _ := json.Marshal(rollback[1]["value"])
+	assert.NoError(t, json.Unmarshal(templateBytes, &template))
+	assert.Equal(t, rs1.Spec.Template.Spec, template.Spec)
+	assert.Contains(t, f.events, conditions.RolloutRolledBackInMemberClusterReason)
+}
+
+// newMultiClusterRollbackFixture returns a fixture of a rollout of the prod wave rolled back from rs2 to rs1,
+// while staging-1 is not yet Healthy at rs1
+func newMultiClusterRollbackFixture(t *testing.T, byController bool) (*fixture, *v1alpha1.Rollout) {
+	f := newFixture(t)
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(0), intstr.FromInt(1))
+	r1.Spec.MultiCluster = &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "staging", Clusters: []string{"staging-1"}},
+			{Name: "prod", Clusters: []string{"prod-1"}},
+		},
+	}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 0, 0)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r3 := bumpVersion(r2)
+	r3.Spec.Template = r1.Spec.Template
+	if byController {
+		auditLog, err := audit.AppendEntry(r2, audit.NewEntry(r2, audit.ControllerActor, audit.SourceController, audit.ActionRollback, "rolled back"))
+		assert.NoError(t, err)
+		r3.Annotations[audit.LogAnnotation] = auditLog
+	}
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r3 = updateCanaryRolloutStatus(r3, rs2PodHash, 1, 1, 1, false)
+	f.rolloutLister = append(f.rolloutLister, r3)
+	f.objects = append(f.objects, r3)
+	f.expectUpdateReplicaSetAction(rs1)
+	return f, r3
+}
+
+func TestMultiClusterRollbackIsNotHeldBack(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, ro := newMultiClusterRollbackFixture(t, true)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(ro)
+	f.runWithMemberClusters(getKey(ro, t), []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseProgressing, CurrentPodHash: ro.Status.StableRS},
+	})
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.NotContains(t, f.events, conditions.RolloutWaitingForClusterWaveReason)
+}
+
+func TestMultiClusterUserRollbackIsHeldBack(t *testing.T) {
+	defaults.SetClusterName("prod-1")
+	defer defaults.SetClusterName("")
+	f, ro := newMultiClusterRollbackFixture(t, false)
+	defer f.Close()
+
+	f.expectPatchRolloutAction(ro)
+	f.runWithMemberClusters(getKey(ro, t), []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging", Phase: v1alpha1.RolloutPhaseProgressing, CurrentPodHash: ro.Status.StableRS},
+	})
+
+	assert.Contains(t, f.events, conditions.RolloutWaitingForClusterWaveReason)
+}
@@ -1,6 +1,7 @@
 package rollout
 
 import (
+	"slices"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -26,6 +27,16 @@ func (pCtx *pauseContext) HasAddPause() bool {
 	return len(pCtx.addPauseReasons) > 0
 }
 
+// HasAddPauseBesidesHeldBack returns whether a pause condition is added for another reason than holding back the update
+func (pCtx *pauseContext) HasAddPauseBesidesHeldBack() bool {
+	for _, reason := range pCtx.addPauseReasons {
+		if !heldBackPauseReasons[reason] {
+			return true
+		}
+	}
+	return false
+}
+
 func (pCtx *pauseContext) IsAborted() bool {
 	if pCtx.removeAbort {
 		return false
@@ -78,6 +89,16 @@ func (pCtx *pauseContext) CalculatePauseStatus(newStatus *v1alpha1.RolloutStatus
 	newStatus.AbortedAt = nil
 
 	if pCtx.clearPauseConditions {
+		// a new update is queued as soon as it is detected, since an update which started progressing
+		// is never queued again. It keeps its place in the queue if the previous update was queued.
+		if slices.Contains(pCtx.addPauseReasons, v1alpha1.PauseReasonQueued) {
+			cond := v1alpha1.PauseCondition{Reason: v1alpha1.PauseReasonQueued, StartTime: now}
+			if prevCond := getPauseCondition(pCtx.rollout, v1alpha1.PauseReasonQueued); prevCond != nil {
+				cond.StartTime = prevCond.StartTime
+			}
+			newStatus.ControllerPause = true
+			newStatus.PauseConditions = []v1alpha1.PauseCondition{cond}
+		}
 		return
 	}
 
@@ -116,6 +137,32 @@ func (pCtx *pauseContext) CalculatePauseStatus(newStatus *v1alpha1.RolloutStatus
 	newStatus.PauseConditions = newPauseConditions
 }
 
+// heldBackPauseReasons are the pause reasons of an update the controller holds back
+var heldBackPauseReasons = map[v1alpha1.PauseReason]bool{
+	v1alpha1.PauseReasonClusterWave:         true,
+	v1alpha1.PauseReasonOutsideDeployWindow: true,
+	v1alpha1.PauseReasonDependency:          true,
+	v1alpha1.PauseReasonQueued:              true,
+}
+
+// isControllerPaused returns whether the controller paused the rollout for another reason than holding back its
+// update. A rollout paused without the pause condition of its pause step or analysis was resumed by the user,
+// which a rollout let through after it was held back must not be mistaken for.
+func isControllerPaused(rollout *v1alpha1.Rollout) bool {
+	if !rollout.Status.ControllerPause {
+		return false
+	}
+	if len(rollout.Status.PauseConditions) == 0 {
+		return true
+	}
+	for _, cond := range rollout.Status.PauseConditions {
+		if !heldBackPauseReasons[cond.Reason] {
+			return true
+		}
+	}
+	return false
+}
+
 func getPauseCondition(rollout *v1alpha1.Rollout, reason v1alpha1.PauseReason) *v1alpha1.PauseCondition {
 	for i := range rollout.Status.PauseConditions {
 		cond := rollout.Status.PauseConditions[i]
@@ -153,6 +200,13 @@ func (pCtx *pauseContext) CompletedBlueGreenPause() bool {
 		return true
 	}
 	pauseCond := getPauseCondition(rollout, v1alpha1.PauseReasonBlueGreenPause)
+	if pauseCond != nil && rollout.Spec.Strategy.BlueGreen.PauseTimeout != nil &&
+		getStepTimeoutAction(rollout.Spec.Strategy.BlueGreen.PauseTimeout) == v1alpha1.StepTimeoutActionSkip {
+		// the rollout is promoted once the pause timed out
+		if _, timedOut := blueGreenPauseTimedOut(rollout, *pauseCond); timedOut {
+			return true
+		}
+	}
 	if rollout.Spec.Strategy.BlueGreen.AutoPromotionEnabled == nil || *rollout.Spec.Strategy.BlueGreen.AutoPromotionEnabled {
 		// autoPromotion is enabled. check if we surpassed the delay
 		autoPromotionSeconds := rollout.Spec.Strategy.BlueGreen.AutoPromotionSeconds
@@ -174,7 +228,7 @@ func (pCtx *pauseContext) CompletedBlueGreenPause() bool {
 		return false
 	} else {
 		// autoPromotion is disabled. the presence of a pause condition means human has not resumed it
-		if rollout.Status.ControllerPause {
+		if isControllerPaused(rollout) {
 			return pauseCond == nil
 		}
 		// status.controllerPause has not yet been set
@@ -186,7 +240,7 @@ func (pCtx *pauseContext) CompletedCanaryPauseStep(pause v1alpha1.RolloutPause)
 	rollout := pCtx.rollout
 	pauseCondition := getPauseCondition(rollout, v1alpha1.PauseReasonCanaryPauseStep)
 
-	if rollout.Status.ControllerPause && pauseCondition == nil {
+	if isControllerPaused(rollout) && pauseCondition == nil {
 		pCtx.log.Info("Rollout has been unpaused")
 		return true
 	} else if pause.Duration != nil {
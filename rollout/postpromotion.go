@@ -0,0 +1,139 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// startBakePeriod starts the bake period of an update which was just promoted, during which a
+// failing post promotion analysis rolls the rollout back to the previous stable ReplicaSet.
+// The initial deployment and rollbacks to an older ReplicaSet are not baked.
+func (c *rolloutContext) startBakePeriod(newStatus *v1alpha1.RolloutStatus, previousStableHash string) {
+	canary := c.rollout.Spec.Strategy.Canary
+	if canary == nil || canary.PostPromotionAnalysis == nil || previousStableHash == "" {
+		return
+	}
+	if c.newRS == nil || c.stableRS == nil || !c.stableRS.CreationTimestamp.Before(&c.newRS.CreationTimestamp) {
+		return
+	}
+	now := timeutil.MetaNow()
+	newStatus.Canary.BakeStartedAt = &now
+	newStatus.Canary.RollbackPodHash = previousStableHash
+}
+
+// getBakeDeadline returns when the bake period of the update ends, or nil if the post promotion
+// analysis runs until it completes
+func getBakeDeadline(rollout *v1alpha1.Rollout) *time.Time {
+	bakeSeconds := rollout.Spec.Strategy.Canary.BakeSeconds
+	bakeStartedAt := rollout.Status.Canary.BakeStartedAt
+	if bakeSeconds == nil || bakeStartedAt == nil {
+		return nil
+	}
+	deadline := bakeStartedAt.Add(time.Duration(*bakeSeconds) * time.Second)
+	return &deadline
+}
+
+// podTemplateFromReplicaSet returns the pod template of a ReplicaSet of the rollout without the metadata
+// injected by the controller, as it was in the spec of the rollout
+func (c *rolloutContext) podTemplateFromReplicaSet(rs *appsv1.ReplicaSet) corev1.PodTemplateSpec {
+	rs, _ = replicasetutil.SyncReplicaSetEphemeralPodMetadata(rs, nil)
+	template := rs.Spec.Template
+	template.Spec.Affinity = replicasetutil.RemoveInjectedAntiAffinityRule(template.Spec.Affinity, *c.rollout)
+	delete(template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	return template
+}
+
+// rollbackFailedPromotion rolls the rollout back to the previous stable ReplicaSet when the post
+// promotion analysis of the update failed. The rollback is recorded with the RolledBack condition
+// and in the audit log of the rollout. An analysis which errored, because its metric provider
+// could not be queried beyond the consecutiveErrorLimit of a metric, does not roll back the rollout.
+func (c *rolloutContext) rollbackFailedPromotion(newStatus *v1alpha1.RolloutStatus) error {
+	ar := c.currentArs.CanaryPostPromotion
+	if ar == nil || ar.Status.Phase != v1alpha1.AnalysisPhaseFailed {
+		return nil
+	}
+	if newStatus.StableRS != newStatus.CurrentPodHash || c.newRS == nil {
+		return nil
+	}
+
+	rollbackPodHash := newStatus.Canary.RollbackPodHash
+	previousRS, _ := replicasetutil.GetReplicaSetByTemplateHash(c.allRSs, rollbackPodHash)
+	if previousRS == nil {
+		prevStatus := c.rollout.Status.Canary.PostPromotionAnalysisRunStatus
+		if prevStatus == nil || prevStatus.Status != ar.Status.Phase {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutRollbackNotPossibleReason}, conditions.RolloutRollbackNotPossibleMessage, ar.Name, rollbackPodHash)
+		}
+		return nil
+	}
+
+	msg := fmt.Sprintf(conditions.RolloutRolledBackMessage, c.newRS.Name, previousRS.Name, ar.Name)
+	if err := c.rollbackToReplicaSet(previousRS, msg); err != nil {
+		return err
+	}
+
+	condition := conditions.NewRolloutCondition(v1alpha1.RolloutRolledBack, corev1.ConditionTrue, conditions.RolloutRolledBackReason, msg)
+	if conditions.SetRolloutCondition(newStatus, *condition) {
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutRolledBackReason}, msg)
+	}
+	return nil
+}
+
+// rollbackToReplicaSet restores the pod template of the ReplicaSet in the spec of the rollout like
+// `kubectl argo rollouts undo` does, and records the rollback with the given message in the audit log
+// of the rollout
+func (c *rolloutContext) rollbackToReplicaSet(rs *appsv1.ReplicaSet, msg string) error {
+	template := c.podTemplateFromReplicaSet(rs)
+
+	// the rollback is recorded in the audit log of the rollout along with the template
+	auditLog, err := audit.AppendEntry(c.rollout, audit.NewEntry(c.rollout, audit.ControllerActor, audit.SourceController, audit.ActionRollback, msg))
+	if err != nil {
+		return err
+	}
+	auditOp := map[string]any{
+		"op":    "add",
+		"path":  "/metadata/annotations/" + strings.ReplaceAll(audit.LogAnnotation, "/", "~1"),
+		"value": auditLog,
+	}
+	if c.rollout.Annotations == nil {
+		auditOp["path"] = "/metadata/annotations"
+		auditOp["value"] = map[string]string{audit.LogAnnotation: auditLog}
+	}
+	// the test of the resourceVersion fails the patch when the rollout changed since it was read, e.g. when it
+	// was updated again or already rolled back, and the returned error requeues the rollout
+	patch, err := json.Marshal([]any{
+		map[string]any{
+			"op":    "test",
+			"path":  "/metadata/resourceVersion",
+			"value": c.rollout.ResourceVersion,
+		},
+		map[string]any{
+			"op":    "replace",
+			"path":  "/spec/template",
+			"value": template,
+		},
+		auditOp,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Patch(context.TODO(), c.rollout.Name, patchtypes.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to roll back to ReplicaSet '%s': %w", rs.Name, err)
+	}
+	return nil
+}
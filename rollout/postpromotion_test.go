@@ -0,0 +1,256 @@
+package rollout
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newPostPromotionRollout returns a canary rollout whose post-promotion analysis runs the given template
+func newPostPromotionRollout(at *v1alpha1.AnalysisTemplate) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(0), intstr.FromInt(1))
+	r.Spec.Strategy.Canary.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+	}
+	return r
+}
+
+// withBakePeriodStarted fully promotes the update of the rollout from rs1 to rs2, and starts the bake
+// period of its post-promotion analysis
+func withBakePeriodStarted(f *fixture, r2 *v1alpha1.Rollout, rs1, rs2 *appsv1.ReplicaSet, at *v1alpha1.AnalysisTemplate) {
+	rs1.CreationTimestamp = timeutil.MetaTime(time.Now().Add(-1 * time.Hour))
+	rs2.CreationTimestamp = timeutil.MetaNow()
+	r2.Status.StableRS = rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2.Status.Phase, r2.Status.Message = rolloututil.CalculateRolloutPhase(r2.Spec, r2.Status)
+	bakeStartedAt := timeutil.MetaNow()
+	r2.Status.Canary.BakeStartedAt = &bakeStartedAt
+	r2.Status.Canary.RollbackPodHash = rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.objects = append(f.objects, at)
+}
+
+func TestCanaryPostPromotionStartsBakePeriod(t *testing.T) {
+	at := analysisTemplate("bar")
+	steps := []v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Strategy.Canary.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+	}
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, r1, 0, 10, false)
+	defer f.Close()
+	rs1.CreationTimestamp = timeutil.MetaTime(time.Now().Add(-1 * time.Hour))
+	rs2.CreationTimestamp = timeutil.MetaNow()
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.objects = append(f.objects, at)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, rs2PodHash, patched.Status.StableRS)
+	assert.NotNil(t, patched.Status.Canary.BakeStartedAt)
+	assert.Equal(t, rs1PodHash, patched.Status.Canary.RollbackPodHash)
+}
+
+func TestCanaryPostPromotionCreatesAnalysisRun(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+
+	createdIndex := f.expectCreateAnalysisRunAction(ar)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdAr := f.getCreatedAnalysisRun(createdIndex)
+	assert.Equal(t, ar.Name, createdAr.Name)
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, ar.Name, patched.Status.Canary.PostPromotionAnalysisRunStatus.Name)
+}
+
+func TestCanaryPostPromotionRollsBackOnFailedAnalysis(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseFailed
+	r2.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseRunning,
+	}
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, ar)
+	r2.ResourceVersion = "1"
+
+	rollbackIndex := f.expectPatchRolloutSpecAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	var rollback []map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(f.getPatchedRollout(rollbackIndex)), &rollback))
+	assert.Len(t, rollback, 3)
+	assert.Equal(t, map[string]any{"op": "test", "path": "/metadata/resourceVersion", "value": r2.ResourceVersion}, rollback[0])
+	assert.Equal(t, "replace", rollback[1]["op"])
+	assert.Equal(t, "/spec/template", rollback[1]["path"])
+	var template corev1.PodTemplateSpec
+	templateBytes, _ := json.Marshal(rollback[1]["value"])
+	assert.NoError(t, json.Unmarshal(templateBytes, &template))
+	assert.NotContains(t, template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	assert.Equal(t, rs1.Spec.Template.Spec, template.Spec)
+	assert.Equal(t, "/metadata/annotations/rollout.argoproj.io~1audit-log", rollback[2]["path"])
+	auditLog := &v1alpha1.Rollout{}
+	auditLog.Annotations = map[string]string{audit.LogAnnotation: rollback[2]["value"].(string)}
+	entries, err := audit.GetEntries(auditLog)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, audit.ActionRollback, entries[0].Action)
+	assert.Equal(t, audit.SourceController, entries[0].Source)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutRolledBack)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, conditions.RolloutRolledBackReason, cond.Reason)
+	assert.Contains(t, cond.Message, rs2.Name)
+	assert.Contains(t, cond.Message, rs1.Name)
+	assert.Contains(t, f.events, conditions.RolloutRolledBackReason)
+}
+
+func TestCanaryPostPromotionRollbackRequeuedOnConflict(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseFailed
+	r2.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseRunning,
+	}
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, ar)
+	// the rollout was updated since the informer cache was synced
+	cached := r2.DeepCopy()
+	cached.ResourceVersion = "1"
+	f.rolloutLister = []*v1alpha1.Rollout{cached}
+	r2.ResourceVersion = "2"
+
+	f.expectPatchRolloutSpecAction(r2)
+	f.runExpectError(getKey(r2, t), false)
+	assert.NotContains(t, f.events, conditions.RolloutRolledBackReason)
+}
+
+func TestCanaryPostPromotionDoesNotRollBackOnErroredAnalysis(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseError
+	r2.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseRunning,
+	}
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, ar)
+
+	// the spec of the rollout is not patched
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutRolledBack))
+	assert.NotContains(t, f.events, conditions.RolloutRolledBackReason)
+}
+
+func TestCanaryPostPromotionCannotRollBackWithoutPreviousReplicaSet(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseFailed
+	r2.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseRunning,
+	}
+	r2.Status.Canary.RollbackPodHash = "deleted"
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, ar)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutRolledBack))
+	assert.Contains(t, f.events, conditions.RolloutRollbackNotPossibleReason)
+	assert.NotContains(t, f.events, conditions.RolloutRolledBackReason)
+}
+
+func TestCanaryPostPromotionTerminatesAnalysisAfterBakePeriod(t *testing.T) {
+	at := analysisTemplate("bar")
+	f, r2, rs1, rs2 := newRolloutUpdateFixture(t, newPostPromotionRollout(at), 0, 1, false)
+	defer f.Close()
+	withBakePeriodStarted(f, r2, rs1, rs2, at)
+
+	r2.Spec.Strategy.Canary.BakeSeconds = pointer.Int32(60)
+	bakeStartedAt := timeutil.MetaTime(time.Now().Add(-2 * time.Minute))
+	r2.Status.Canary.BakeStartedAt = &bakeStartedAt
+	ar := analysisRun(at, v1alpha1.RolloutTypePostPromotionLabel, r2)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseRunning
+	r2.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseRunning,
+	}
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, ar)
+
+	f.expectPatchAnalysisRunAction(ar)
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+}
+
+func TestCanaryPostPromotionKeepsRollbackUntilNextUpdate(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(0), intstr.FromInt(1))
+	f, r2, rs1, _ := newRolloutUpdateFixture(t, r1, 1, 0, false)
+	defer f.Close()
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	// the rollout was rolled back to rs1 and is now updated to rs2
+	r2.Status.CurrentPodHash = rs1PodHash
+	r2.Status.Canary.RollbackPodHash = rs1PodHash
+	rolledBack := conditions.NewRolloutCondition(v1alpha1.RolloutRolledBack, corev1.ConditionTrue, conditions.RolloutRolledBackReason, "rolled back")
+	conditions.SetRolloutCondition(&r2.Status, *rolledBack)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"rollbackPodHash":null`)
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutRolledBack))
+}
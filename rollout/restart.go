@@ -42,7 +42,7 @@ type RolloutPodRestarter struct {
 // checkEnqueueRollout enqueues a Rollout if the Rollout's restartedAt is within the next resync
 func (p RolloutPodRestarter) checkEnqueueRollout(roCtx *rolloutContext) {
 	logCtx := roCtx.log.WithField("Reconciler", "PodRestarter")
-	now := nowFn().UTC()
+	now := timeutil.Now().UTC()
 	if roCtx.rollout.Spec.RestartAt == nil || now.After(roCtx.rollout.Spec.RestartAt.Time) {
 		return
 	}
@@ -104,7 +104,7 @@ func (c *rolloutContext) reconcileActiveService(activeSvc *corev1.Service) error
 	if c.isBlueGreenFastTracked(activeSvc) {
 		newPodHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 	}
-	if c.pauseContext.CompletedBlueGreenPause() && c.completedPrePromotionAnalysis() {
+	if c.pauseContext.CompletedBlueGreenPause() && c.completedPrePromotionAnalysis() && c.completedBlueGreenTrafficShift() {
 		newPodHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 	}
 
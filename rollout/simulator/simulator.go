@@ -0,0 +1,1072 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynamicinformer "k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	k8srecord "k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/rollout"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/gatewayapi"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// SimulatedUpdateAnnotation is the pod template annotation the simulator changes to start an update of the rollout
+	SimulatedUpdateAnnotation = "rollout.argoproj.io/simulated-update"
+
+	// maxSimulationIterations bounds the number of reconciliations of a simulation
+	maxSimulationIterations = 1000
+	// maxSimulationDuration bounds how far the clock of a simulation advances
+	maxSimulationDuration = 7 * 24 * time.Hour
+	// maxSimulationErrors is the number of consecutive reconciliation errors which stops a simulation
+	maxSimulationErrors = 5
+)
+
+// SimulationOptions injects failures into a simulation
+type SimulationOptions struct {
+	// FailAnalysisAtStep fails the analysis runs and experiments which run while the rollout is at
+	// the canary step with this index. Rollouts without steps are always at step 0.
+	FailAnalysisAtStep *int32
+	// AbortAtStep aborts the update once the rollout reaches the canary step with this index.
+	// Rollouts without steps are always at step 0.
+	AbortAtStep *int32
+}
+
+// SimulatedReplicaSet is a ReplicaSet of a simulated rollout
+type SimulatedReplicaSet struct {
+	Name            string `json:"name"`
+	Revision        string `json:"revision"`
+	PodTemplateHash string `json:"podTemplateHash"`
+	Replicas        int32  `json:"replicas"`
+}
+
+// SimulatedAnalysisRun is an AnalysisRun or Experiment of a simulated rollout
+type SimulatedAnalysisRun struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind"`
+	Type  string `json:"type,omitempty"`
+	Phase string `json:"phase"`
+}
+
+// SimulationStep is the state of a simulated rollout after a change
+type SimulationStep struct {
+	// Elapsed is the time since the start of the update
+	Elapsed metav1.Duration `json:"elapsed"`
+	// StepIndex is the index of the current canary step
+	StepIndex *int32 `json:"stepIndex,omitempty"`
+	// Step describes the current canary step
+	Step string `json:"step,omitempty"`
+	// Stable is the ReplicaSet of the stable revision
+	Stable *SimulatedReplicaSet `json:"stable,omitempty"`
+	// New is the ReplicaSet of the updated revision, until it becomes stable
+	New *SimulatedReplicaSet `json:"new,omitempty"`
+	// Older are the ReplicaSets of other revisions which still have replicas
+	Older []SimulatedReplicaSet `json:"older,omitempty"`
+	// Weight is the weight of the canary or preview ReplicaSet last sent to the traffic router
+	Weight *int32 `json:"weight,omitempty"`
+	// AnalysisRuns are the analysis runs and experiments of the updated revision
+	AnalysisRuns []SimulatedAnalysisRun `json:"analysisRuns,omitempty"`
+	// Phase and Message are the phase of the rollout
+	Phase   string `json:"phase"`
+	Message string `json:"message,omitempty"`
+	// Events are the reasons of the events recorded since the previous step, and the actions of the simulator
+	Events []string `json:"events,omitempty"`
+}
+
+// simulationMutex serializes simulations, since they replace the clock of the controller
+var simulationMutex sync.Mutex
+
+// Simulate runs the rollout controller against fake clientsets to show how an update of the rollout
+// progresses. The rollout is first deployed as the stable revision, and then updated by changing an
+// annotation of its pod template. ReplicaSets become available as soon as they are scaled, analysis
+// runs succeed, indefinite pauses are promoted, and the configured traffic routers are replaced by
+// a Gateway API router over an HTTPRoute which only exists in the simulation.
+func Simulate(ro *v1alpha1.Rollout, templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate, opts SimulationOptions) ([]SimulationStep, error) {
+	s, err := newSimulation(ro, templates, clusterTemplates, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	simulationMutex.Lock()
+	defer simulationMutex.Unlock()
+	defer timeutil.SetNowTimeFunc(time.Now)
+	timeutil.SetNowTimeFunc(s.now)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		_ = s.controller.Run(ctx, 1)
+	}()
+	defer func() {
+		cancel()
+		s.queue.ShutDown()
+		<-stopped
+	}()
+
+	if err := s.run(ctx, false); err != nil {
+		return nil, fmt.Errorf("failed to deploy the stable revision: %w", err)
+	}
+	if err := s.update(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.run(ctx, true); err != nil {
+		return s.steps, err
+	}
+	return s.steps, nil
+}
+
+type simulation struct {
+	opts     SimulationOptions
+	key      string
+	rollout  *v1alpha1.Rollout
+	clock    time.Time
+	start    time.Time
+	wakeups  []time.Time
+	aborted  bool
+	uids     int
+	queue    *simulatedQueue
+	recorder *simulationRecorder
+
+	client        *fake.Clientset
+	kubeclient    *k8sfake.Clientset
+	dynamicClient *dynamicfake.FakeDynamicClient
+	informers     informers.SharedInformerFactory
+	kubeInf       kubeinformers.SharedInformerFactory
+	controller    *rollout.Controller
+
+	steps []SimulationStep
+}
+
+func newSimulation(ro *v1alpha1.Rollout, templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate, opts SimulationOptions) (*simulation, error) {
+	ro, err := simulatedRollout(ro)
+	if err != nil {
+		return nil, err
+	}
+	objects := []runtime.Object{ro}
+	for _, template := range templates {
+		template = template.DeepCopy()
+		template.Namespace = ro.Namespace
+		objects = append(objects, template)
+	}
+	for _, template := range clusterTemplates {
+		objects = append(objects, template.DeepCopy())
+	}
+	objects = append(objects, stubAnalysisTemplates(ro, templates, clusterTemplates)...)
+	var kubeObjects []runtime.Object
+	for _, svc := range stubServices(ro) {
+		kubeObjects = append(kubeObjects, svc)
+	}
+
+	s := &simulation{
+		opts:       opts,
+		key:        ro.Namespace + "/" + ro.Name,
+		rollout:    ro,
+		clock:      time.Now().UTC().Truncate(time.Second),
+		recorder:   &simulationRecorder{},
+		client:     fake.NewSimpleClientset(objects...),
+		kubeclient: k8sfake.NewSimpleClientset(kubeObjects...),
+	}
+	// the fake clientsets leave the UIDs of created objects empty, which the controller relies on to tell ReplicaSets apart
+	s.client.PrependReactor("create", "*", s.assignUID)
+	s.kubeclient.PrependReactor("create", "*", s.assignUID)
+	s.informers = informers.NewSharedInformerFactory(s.client, 0)
+	s.kubeInf = kubeinformers.NewSharedInformerFactory(s.kubeclient, 0)
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	vsvcGVR := istioutil.GetIstioVirtualServiceGVR()
+	destGVR := istioutil.GetIstioDestinationRuleGVR()
+	routeGVR := gatewayapi.GetHTTPRouteGVR()
+	var dynamicObjects []runtime.Object
+	if route := stubHTTPRoute(ro); route != nil {
+		dynamicObjects = append(dynamicObjects, route)
+	}
+	s.dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		vsvcGVR:  vsvcGVR.Resource + "List",
+		destGVR:  destGVR.Resource + "List",
+		routeGVR: "HTTPRouteList",
+	}, dynamicObjects...)
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(s.dynamicClient, 0)
+	ingressWrapper, err := ingressutil.NewIngressWrapper(ingressutil.IngressModeNetworking, s.kubeclient, s.kubeInf)
+	if err != nil {
+		return nil, err
+	}
+
+	s.queue = &simulatedQueue{
+		RateLimitingInterface: workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts"),
+		simulation:            s,
+		synced:                make(chan error, 1),
+	}
+	s.controller = rollout.NewController(rollout.ControllerConfig{
+		Namespace:                       ro.Namespace,
+		KubeClientSet:                   s.kubeclient,
+		ArgoProjClientset:               s.client,
+		DynamicClientSet:                s.dynamicClient,
+		ExperimentInformer:              s.informers.Argoproj().V1alpha1().Experiments(),
+		AnalysisRunInformer:             s.informers.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:        s.informers.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer: s.informers.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		ReplicaSetInformer:              s.kubeInf.Apps().V1().ReplicaSets(),
+		ServicesInformer:                s.kubeInf.Core().V1().Services(),
+		IngressWrapper:                  ingressWrapper,
+		RolloutsInformer:                s.informers.Argoproj().V1alpha1().Rollouts(),
+		IstioPrimaryDynamicClient:       s.dynamicClient,
+		IstioVirtualServiceInformer:     dynamicInformerFactory.ForResource(vsvcGVR).Informer(),
+		IstioDestinationRuleInformer:    dynamicInformerFactory.ForResource(destGVR).Informer(),
+		RolloutWorkQueue:                s.queue,
+		ServiceWorkQueue:                workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services"),
+		IngressWorkQueue:                workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses"),
+		MetricsServer: metrics.NewMetricsServer(metrics.ServerConfig{
+			K8SRequestProvider: &metrics.K8sRequestsCountProvider{},
+		}),
+		Recorder:    s.recorder,
+		RefResolver: &simulatedRefResolver{},
+		// requeues of the controller shorter than the resync period are the only way the clock advances
+		ResyncPeriod: maxSimulationDuration,
+
+		RolloutStrategyTemplateInformer:        s.informers.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		ClusterRolloutStrategyTemplateInformer: s.informers.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
+	})
+	return s, nil
+}
+
+// simulatedRollout returns the rollout to simulate, with its status cleared and its traffic routers
+// replaced by the Gateway API router
+func simulatedRollout(ro *v1alpha1.Rollout) (*v1alpha1.Rollout, error) {
+	ro = ro.DeepCopy()
+	if ro.Spec.WorkloadRef != nil {
+		return nil, fmt.Errorf("rollouts with a workloadRef cannot be simulated")
+	}
+	if ro.Namespace == "" {
+		ro.Namespace = metav1.NamespaceDefault
+	}
+	if ro.UID == "" {
+		ro.UID = types.UID("simulated-" + ro.Name)
+	}
+	ro.Generation = 1
+	ro.ResourceVersion = ""
+	ro.Status = v1alpha1.RolloutStatus{}
+	// the outcome of a simulation should not depend on when it runs
+	ro.Spec.DeployWindows = nil
+	if canary := ro.Spec.Strategy.Canary; canary != nil {
+		if canary.PingPong != nil {
+			return nil, fmt.Errorf("rollouts with pingPong cannot be simulated")
+		}
+		for _, step := range canary.Steps {
+			if step.Plugin != nil {
+				return nil, fmt.Errorf("rollouts with step plugins cannot be simulated")
+			}
+		}
+		if tr := canary.TrafficRouting; tr != nil {
+			if tr.MaxTrafficWeight != nil {
+				return nil, fmt.Errorf("rollouts with maxTrafficWeight cannot be simulated")
+			}
+			canary.TrafficRouting = simulatedTrafficRouting(ro, tr)
+			if canary.StableService == "" {
+				canary.StableService = ro.Name + "-stable"
+			}
+			if canary.CanaryService == "" {
+				canary.CanaryService = ro.Name + "-canary"
+			}
+		}
+	}
+	if blueGreen := ro.Spec.Strategy.BlueGreen; blueGreen != nil && blueGreen.TrafficRouting != nil {
+		if blueGreen.TrafficRouting.MaxTrafficWeight != nil {
+			return nil, fmt.Errorf("rollouts with maxTrafficWeight cannot be simulated")
+		}
+		blueGreen.TrafficRouting.RolloutTrafficRouting = *simulatedTrafficRouting(ro, &blueGreen.TrafficRouting.RolloutTrafficRouting)
+	}
+	return ro, nil
+}
+
+// simulatedTrafficRouting returns the traffic routing settings which replace the configured traffic routers
+func simulatedTrafficRouting(ro *v1alpha1.Rollout, tr *v1alpha1.RolloutTrafficRouting) *v1alpha1.RolloutTrafficRouting {
+	// the Gateway API router supports every traffic routing step, and only references an HTTPRoute
+	return &v1alpha1.RolloutTrafficRouting{
+		GatewayAPI:    &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: ro.Name},
+		ManagedRoutes: tr.ManagedRoutes,
+	}
+}
+
+// stubHTTPRoute returns the HTTPRoute of the simulated traffic routing, which sends all the traffic to
+// the stable service and has been accepted by its gateway, or nil if the rollout has no traffic routing
+func stubHTTPRoute(ro *v1alpha1.Rollout) *unstructured.Unstructured {
+	canary := rolloututil.TrafficRoutingRollout(ro).Spec.Strategy.Canary
+	if canary == nil || canary.TrafficRouting == nil {
+		return nil
+	}
+	route := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"rules": []any{map[string]any{
+				"backendRefs": []any{
+					map[string]any{"name": canary.StableService, "port": int64(80)},
+					map[string]any{"name": canary.CanaryService, "port": int64(80)},
+				},
+			}},
+		},
+		"status": map[string]any{
+			"parents": []any{map[string]any{
+				"conditions": []any{map[string]any{"type": "Accepted", "status": string(metav1.ConditionTrue)}},
+			}},
+		},
+	}}
+	route.SetAPIVersion(gatewayapi.GetHTTPRouteGVR().GroupVersion().String())
+	route.SetKind(gatewayapi.HTTPRouteKind)
+	route.SetName(canary.TrafficRouting.GatewayAPI.HTTPRoute)
+	route.SetNamespace(ro.Namespace)
+	return route
+}
+
+// routedWeight returns the weight of the canary or preview service in the simulated HTTPRoute, or nil
+// if the controller has not set it yet
+func (s *simulation) routedWeight(ctx context.Context, ro *v1alpha1.Rollout) (*int32, error) {
+	canary := rolloututil.TrafficRoutingRollout(ro).Spec.Strategy.Canary
+	if canary == nil || canary.TrafficRouting == nil {
+		return nil, nil
+	}
+	route, err := s.dynamicClient.Resource(gatewayapi.GetHTTPRouteGVR()).Namespace(ro.Namespace).Get(ctx, canary.TrafficRouting.GatewayAPI.HTTPRoute, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	if err != nil {
+		return nil, err
+	}
+	for _, ruleI := range rules {
+		rule, ok := ruleI.(map[string]any)
+		if !ok {
+			continue
+		}
+		backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+		for _, refI := range backendRefs {
+			ref, ok := refI.(map[string]any)
+			if !ok || ref["name"] != canary.CanaryService {
+				continue
+			}
+			if weight, ok, _ := unstructured.NestedInt64(ref, "weight"); ok {
+				return pointer.Int32(int32(weight)), nil
+			}
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+// stubServices returns the services referenced by the rollout
+func stubServices(ro *v1alpha1.Rollout) []*corev1.Service {
+	var names []string
+	if canary := ro.Spec.Strategy.Canary; canary != nil {
+		names = append(names, canary.StableService, canary.CanaryService)
+	}
+	if blueGreen := ro.Spec.Strategy.BlueGreen; blueGreen != nil {
+		names = append(names, blueGreen.ActiveService, blueGreen.PreviewService)
+	}
+	var selector map[string]string
+	if ro.Spec.Selector != nil {
+		selector = ro.Spec.Selector.MatchLabels
+	}
+	var services []*corev1.Service
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		services = append(services, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ro.Namespace},
+			Spec: corev1.ServiceSpec{
+				Selector: selector,
+				Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+			},
+		})
+	}
+	return services
+}
+
+// stubAnalysisTemplates returns the analysis templates which are referenced by the rollout but were
+// not given. Their analysis runs are never measured, so a placeholder metric is enough.
+func stubAnalysisTemplates(ro *v1alpha1.Rollout, templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) []runtime.Object {
+	given := map[string]bool{}
+	for _, template := range templates {
+		given[template.Name] = true
+	}
+	givenCluster := map[string]bool{}
+	for _, template := range clusterTemplates {
+		givenCluster[template.Name] = true
+	}
+
+	type stub struct {
+		clusterScope bool
+		args         map[string]bool
+	}
+	stubs := map[string]*stub{}
+	var names []string
+	addRefs := func(refs []v1alpha1.AnalysisTemplateRef, args []v1alpha1.AnalysisRunArgument) {
+		for _, ref := range refs {
+			if (ref.ClusterScope && givenCluster[ref.TemplateName]) || (!ref.ClusterScope && given[ref.TemplateName]) {
+				continue
+			}
+			key := fmt.Sprintf("%v/%s", ref.ClusterScope, ref.TemplateName)
+			if _, ok := stubs[key]; !ok {
+				stubs[key] = &stub{clusterScope: ref.ClusterScope, args: map[string]bool{}}
+				names = append(names, key)
+			}
+			for _, arg := range args {
+				stubs[key].args[arg.Name] = true
+			}
+		}
+	}
+	addRollout := func(analysis *v1alpha1.RolloutAnalysis) {
+		if analysis != nil {
+			addRefs(analysis.Templates, analysis.Args)
+		}
+	}
+	if canary := ro.Spec.Strategy.Canary; canary != nil {
+		if canary.Analysis != nil {
+			addRollout(&canary.Analysis.RolloutAnalysis)
+		}
+		for _, step := range canary.Steps {
+			addRollout(rolloututil.GetStepAnalysis(&step))
+			if step.Experiment != nil {
+				for _, analysis := range step.Experiment.Analyses {
+					addRefs([]v1alpha1.AnalysisTemplateRef{{TemplateName: analysis.TemplateName, ClusterScope: analysis.ClusterScope}}, analysis.Args)
+				}
+			}
+		}
+		addRollout(canary.PostPromotionAnalysis)
+	}
+	if blueGreen := ro.Spec.Strategy.BlueGreen; blueGreen != nil {
+		addRollout(blueGreen.PrePromotionAnalysis)
+		addRollout(blueGreen.PostPromotionAnalysis)
+	}
+
+	var objects []runtime.Object
+	for _, key := range names {
+		stub := stubs[key]
+		name := strings.SplitN(key, "/", 2)[1]
+		var args []v1alpha1.Argument
+		for arg := range stub.args {
+			args = append(args, v1alpha1.Argument{Name: arg})
+		}
+		sort.Slice(args, func(i, j int) bool {
+			return args[i].Name < args[j].Name
+		})
+		count := intstr.FromInt(1)
+		spec := v1alpha1.AnalysisTemplateSpec{
+			Args: args,
+			Metrics: []v1alpha1.Metric{{
+				Name:  "simulated",
+				Count: &count,
+				Provider: v1alpha1.MetricProvider{
+					Web: &v1alpha1.WebMetric{URL: "http://simulated"},
+				},
+			}},
+		}
+		if stub.clusterScope {
+			objects = append(objects, &v1alpha1.ClusterAnalysisTemplate{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec})
+		} else {
+			objects = append(objects, &v1alpha1.AnalysisTemplate{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ro.Namespace}, Spec: spec})
+		}
+	}
+	return objects
+}
+
+// assignUID gives created objects a UID, like the API server does
+func (s *simulation) assignUID(action k8stesting.Action) (bool, runtime.Object, error) {
+	createAction, ok := action.(k8stesting.CreateAction)
+	if !ok {
+		return false, nil, nil
+	}
+	if obj, err := meta.Accessor(createAction.GetObject()); err == nil && obj.GetUID() == "" {
+		s.uids++
+		obj.SetUID(types.UID(fmt.Sprintf("simulated-%d", s.uids)))
+	}
+	return false, nil, nil
+}
+
+// update changes the pod template of the rollout to start an update to a new revision
+func (s *simulation) update(ctx context.Context) error {
+	ro, err := s.client.ArgoprojV1alpha1().Rollouts(s.rollout.Namespace).Get(ctx, s.rollout.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ro.Spec.Template.Annotations == nil {
+		ro.Spec.Template.Annotations = map[string]string{}
+	}
+	ro.Spec.Template.Annotations[SimulatedUpdateAnnotation] = "true"
+	ro.Generation++
+	_, err = s.client.ArgoprojV1alpha1().Rollouts(ro.Namespace).Update(ctx, ro, metav1.UpdateOptions{})
+	s.start = s.clock
+	s.wakeups = nil
+	s.recorder.take()
+	return err
+}
+
+// run reconciles the rollout until it settles, recording the changes when record is true
+func (s *simulation) run(ctx context.Context, recordSteps bool) error {
+	var last *SimulationStep
+	var events []string
+	errors := 0
+	for i := 0; i < maxSimulationIterations; i++ {
+		if err := s.syncInformers(ctx); err != nil {
+			return err
+		}
+		if err := s.queue.sync(ctx, s.key); err != nil {
+			errors++
+			if errors >= maxSimulationErrors {
+				return fmt.Errorf("failed to reconcile the rollout: %w", err)
+			}
+			continue
+		}
+		errors = 0
+		actions, err := s.simulateWorkloads(ctx)
+		if err != nil {
+			return err
+		}
+		events = append(events, s.recorder.take()...)
+		events = append(events, actions...)
+
+		ro, err := s.client.ArgoprojV1alpha1().Rollouts(s.rollout.Namespace).Get(ctx, s.rollout.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		step, err := s.snapshot(ctx, ro)
+		if err != nil {
+			return err
+		}
+		if last == nil || !sameState(*last, *step) {
+			if recordSteps {
+				recorded := *step
+				recorded.Events = events
+				s.steps = append(s.steps, recorded)
+			}
+			last = step
+			events = nil
+			continue
+		}
+		if recordSteps && len(events) > 0 && len(s.steps) > 0 {
+			// events which did not change the state of the rollout belong to the previous step
+			s.steps[len(s.steps)-1].Events = append(s.steps[len(s.steps)-1].Events, events...)
+		}
+		events = nil
+
+		// the rollout settled
+		if s.done(ro, step) {
+			return nil
+		}
+		paused := (ro.Spec.Paused || len(ro.Status.PauseConditions) > 0) && !ro.Status.Abort
+		if paused && indefinitelyPaused(ro) {
+			if err := s.promote(ctx, ro); err != nil {
+				return err
+			}
+			events = append(events, "Promoted")
+			continue
+		}
+		if next, ok := s.nextWakeup(); ok {
+			s.clock = next
+			continue
+		}
+		if paused {
+			if err := s.promote(ctx, ro); err != nil {
+				return err
+			}
+			events = append(events, "Promoted")
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("rollout did not settle after %d reconciliations", maxSimulationIterations)
+}
+
+// done returns whether the rollout is healthy or degraded, and only the stable ReplicaSet has pods
+func (s *simulation) done(ro *v1alpha1.Rollout, step *SimulationStep) bool {
+	if step.Phase != string(v1alpha1.RolloutPhaseHealthy) && step.Phase != string(v1alpha1.RolloutPhaseDegraded) {
+		return false
+	}
+	rsList, err := s.kubeclient.AppsV1().ReplicaSets(ro.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false
+	}
+	for _, rs := range rsList.Items {
+		if replicasetutil.GetPodTemplateHash(&rs) != ro.Status.StableRS && rs.Spec.Replicas != nil && *rs.Spec.Replicas > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// indefinitelyPaused returns whether the rollout is paused until it is promoted, rather than for a duration
+func indefinitelyPaused(ro *v1alpha1.Rollout) bool {
+	if ro.Spec.Paused {
+		return true
+	}
+	for _, cond := range ro.Status.PauseConditions {
+		switch cond.Reason {
+		case v1alpha1.PauseReasonCanaryPauseStep:
+			if step, _ := replicasetutil.GetCurrentCanaryStep(ro); step != nil && step.Pause != nil && step.Pause.Duration == nil {
+				return true
+			}
+		case v1alpha1.PauseReasonBlueGreenPause:
+			blueGreen := ro.Spec.Strategy.BlueGreen
+			if blueGreen != nil && (!defaults.GetAutoPromotionEnabledOrDefault(ro) || blueGreen.AutoPromotionSeconds == 0) {
+				return true
+			}
+		case v1alpha1.PauseReasonInconclusiveAnalysis, v1alpha1.PauseReasonInconclusiveExperiment:
+			return true
+		}
+	}
+	return false
+}
+
+// nextWakeup returns the earliest requeue of the controller which is still ahead of the clock
+func (s *simulation) nextWakeup() (time.Time, bool) {
+	sort.Slice(s.wakeups, func(i, j int) bool {
+		return s.wakeups[i].Before(s.wakeups[j])
+	})
+	for len(s.wakeups) > 0 {
+		next := s.wakeups[0]
+		s.wakeups = s.wakeups[1:]
+		if next.After(s.clock) && next.Sub(s.start) <= maxSimulationDuration {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// promote resumes a paused rollout, like `kubectl argo rollouts promote`
+func (s *simulation) promote(ctx context.Context, ro *v1alpha1.Rollout) error {
+	ro = ro.DeepCopy()
+	if ro.Spec.Paused {
+		ro.Spec.Paused = false
+		ro.Generation++
+		var err error
+		ro, err = s.client.ArgoprojV1alpha1().Rollouts(ro.Namespace).Update(ctx, ro, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	ro.Status.PauseConditions = nil
+	_, err := s.client.ArgoprojV1alpha1().Rollouts(ro.Namespace).UpdateStatus(ctx, ro, metav1.UpdateOptions{})
+	return err
+}
+
+// simulateWorkloads stands in for the controllers of the ReplicaSets, AnalysisRuns and Experiments,
+// and injects the failures of the simulation. It returns the actions it took.
+func (s *simulation) simulateWorkloads(ctx context.Context) ([]string, error) {
+	var actions []string
+	namespace := s.rollout.Namespace
+	rsList, err := s.kubeclient.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		replicas := int32(0)
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+		if rs.Status.Replicas == replicas && rs.Status.AvailableReplicas == replicas && rs.Status.ObservedGeneration == rs.Generation {
+			continue
+		}
+		rs.Status = appsv1.ReplicaSetStatus{
+			Replicas:             replicas,
+			FullyLabeledReplicas: replicas,
+			ReadyReplicas:        replicas,
+			AvailableReplicas:    replicas,
+			ObservedGeneration:   rs.Generation,
+		}
+		if _, err := s.kubeclient.AppsV1().ReplicaSets(namespace).UpdateStatus(ctx, rs, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	ro, err := s.client.ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, s.rollout.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	stepIndex := int32(0)
+	if ro.Status.CurrentStepIndex != nil {
+		stepIndex = *ro.Status.CurrentStepIndex
+	}
+	updating := ro.Status.CurrentPodHash != "" && ro.Status.CurrentPodHash != ro.Status.StableRS
+	failAnalysis := updating && s.opts.FailAnalysisAtStep != nil && *s.opts.FailAnalysisAtStep == stepIndex
+
+	arList, err := s.client.ArgoprojV1alpha1().AnalysisRuns(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range arList.Items {
+		run := &arList.Items[i]
+		if run.Status.Phase.Completed() {
+			continue
+		}
+		phase := v1alpha1.AnalysisPhaseSuccessful
+		switch {
+		case run.Spec.Terminate:
+		case failAnalysis:
+			phase = v1alpha1.AnalysisPhaseFailed
+		case run.Labels[v1alpha1.RolloutTypeLabel] == v1alpha1.RolloutTypeBackgroundRunLabel:
+			// background analysis runs until it is terminated
+			phase = v1alpha1.AnalysisPhaseRunning
+		}
+		if run.Status.Phase == phase {
+			continue
+		}
+		run.Status.Phase = phase
+		if phase == v1alpha1.AnalysisPhaseFailed {
+			run.Status.Message = "simulated failure"
+			actions = append(actions, fmt.Sprintf("AnalysisRunFailed(%s)", run.Name))
+		}
+		if _, err := s.client.ArgoprojV1alpha1().AnalysisRuns(namespace).UpdateStatus(ctx, run, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	exList, err := s.client.ArgoprojV1alpha1().Experiments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range exList.Items {
+		ex := &exList.Items[i]
+		if ex.Status.Phase.Completed() {
+			continue
+		}
+		now := metav1.NewTime(s.clock)
+		switch {
+		case ex.Spec.Terminate:
+			ex.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+		case failAnalysis:
+			ex.Status.Phase = v1alpha1.AnalysisPhaseFailed
+			ex.Status.Message = "simulated failure"
+			actions = append(actions, fmt.Sprintf("ExperimentFailed(%s)", ex.Name))
+		case ex.Status.AvailableAt == nil:
+			ex.Status.Phase = v1alpha1.AnalysisPhaseRunning
+			ex.Status.AvailableAt = &now
+			if ex.Spec.Duration != "" {
+				if duration, err := ex.Spec.Duration.Duration(); err == nil {
+					s.wakeups = append(s.wakeups, s.clock.Add(duration))
+				}
+			}
+		default:
+			if ex.Spec.Duration != "" {
+				duration, err := ex.Spec.Duration.Duration()
+				if err == nil && s.clock.Before(ex.Status.AvailableAt.Add(duration)) {
+					continue
+				}
+			}
+			ex.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+		}
+		if _, err := s.client.ArgoprojV1alpha1().Experiments(namespace).UpdateStatus(ctx, ex, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if updating && !s.aborted && s.opts.AbortAtStep != nil && *s.opts.AbortAtStep == stepIndex {
+		s.aborted = true
+		ro.Status.Abort = true
+		if _, err := s.client.ArgoprojV1alpha1().Rollouts(namespace).UpdateStatus(ctx, ro, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+		actions = append(actions, "Aborted")
+	}
+	return actions, nil
+}
+
+// syncInformers replaces the contents of the informers with the objects of the fake clientsets
+func (s *simulation) syncInformers(ctx context.Context) error {
+	namespace := s.rollout.Namespace
+	ros, err := s.client.ArgoprojV1alpha1().Rollouts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var objs []any
+	for i := range ros.Items {
+		objs = append(objs, &ros.Items[i])
+	}
+	if err := replaceIndexer(s.informers.Argoproj().V1alpha1().Rollouts().Informer(), objs); err != nil {
+		return err
+	}
+
+	ars, err := s.client.ArgoprojV1alpha1().AnalysisRuns(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range ars.Items {
+		objs = append(objs, &ars.Items[i])
+	}
+	if err := replaceIndexer(s.informers.Argoproj().V1alpha1().AnalysisRuns().Informer(), objs); err != nil {
+		return err
+	}
+
+	exs, err := s.client.ArgoprojV1alpha1().Experiments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range exs.Items {
+		objs = append(objs, &exs.Items[i])
+	}
+	if err := replaceIndexer(s.informers.Argoproj().V1alpha1().Experiments().Informer(), objs); err != nil {
+		return err
+	}
+
+	ats, err := s.client.ArgoprojV1alpha1().AnalysisTemplates(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range ats.Items {
+		objs = append(objs, &ats.Items[i])
+	}
+	if err := replaceIndexer(s.informers.Argoproj().V1alpha1().AnalysisTemplates().Informer(), objs); err != nil {
+		return err
+	}
+
+	cats, err := s.client.ArgoprojV1alpha1().ClusterAnalysisTemplates().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range cats.Items {
+		objs = append(objs, &cats.Items[i])
+	}
+	if err := replaceIndexer(s.informers.Argoproj().V1alpha1().ClusterAnalysisTemplates().Informer(), objs); err != nil {
+		return err
+	}
+
+	rss, err := s.kubeclient.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range rss.Items {
+		objs = append(objs, &rss.Items[i])
+	}
+	if err := replaceIndexer(s.kubeInf.Apps().V1().ReplicaSets().Informer(), objs); err != nil {
+		return err
+	}
+
+	svcs, err := s.kubeclient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	objs = nil
+	for i := range svcs.Items {
+		objs = append(objs, &svcs.Items[i])
+	}
+	return replaceIndexer(s.kubeInf.Core().V1().Services().Informer(), objs)
+}
+
+func replaceIndexer(informer cache.SharedIndexInformer, objs []any) error {
+	return informer.GetIndexer().Replace(objs, "")
+}
+
+// snapshot returns the state of the rollout
+func (s *simulation) snapshot(ctx context.Context, ro *v1alpha1.Rollout) (*SimulationStep, error) {
+	weight, err := s.routedWeight(ctx, ro)
+	if err != nil {
+		return nil, err
+	}
+	phase, message := rolloututil.GetRolloutPhase(ro)
+	step := SimulationStep{
+		Elapsed: metav1.Duration{Duration: s.clock.Sub(s.start).Truncate(time.Second)},
+		Phase:   string(phase),
+		Message: message,
+		Weight:  weight,
+	}
+	if ro.Spec.Strategy.Canary != nil && ro.Status.CurrentStepIndex != nil {
+		step.StepIndex = ro.Status.CurrentStepIndex
+		if currentStep, _ := replicasetutil.GetCurrentCanaryStep(ro); currentStep != nil {
+			step.Step = rolloututil.CanaryStepString(*currentStep)
+		}
+	}
+
+	rsList, err := s.kubeclient.AppsV1().ReplicaSets(ro.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		podTemplateHash := replicasetutil.GetPodTemplateHash(rs)
+		simulated := &SimulatedReplicaSet{
+			Name:            rs.Name,
+			Revision:        rs.Annotations[annotations.RevisionAnnotation],
+			PodTemplateHash: podTemplateHash,
+		}
+		if rs.Spec.Replicas != nil {
+			simulated.Replicas = *rs.Spec.Replicas
+		}
+		if podTemplateHash == ro.Status.StableRS {
+			step.Stable = simulated
+		} else if podTemplateHash == ro.Status.CurrentPodHash {
+			step.New = simulated
+		} else if simulated.Replicas > 0 {
+			step.Older = append(step.Older, *simulated)
+		}
+	}
+	sort.Slice(step.Older, func(i, j int) bool {
+		return step.Older[i].Name < step.Older[j].Name
+	})
+
+	arList, err := s.client.ArgoprojV1alpha1().AnalysisRuns(ro.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range arList.Items {
+		if run.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != ro.Status.CurrentPodHash {
+			continue
+		}
+		step.AnalysisRuns = append(step.AnalysisRuns, SimulatedAnalysisRun{
+			Name:  run.Name,
+			Kind:  "AnalysisRun",
+			Type:  run.Labels[v1alpha1.RolloutTypeLabel],
+			Phase: string(run.Status.Phase),
+		})
+	}
+	exList, err := s.client.ArgoprojV1alpha1().Experiments(ro.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ex := range exList.Items {
+		if ex.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != ro.Status.CurrentPodHash {
+			continue
+		}
+		step.AnalysisRuns = append(step.AnalysisRuns, SimulatedAnalysisRun{
+			Name:  ex.Name,
+			Kind:  "Experiment",
+			Phase: string(ex.Status.Phase),
+		})
+	}
+	sort.Slice(step.AnalysisRuns, func(i, j int) bool {
+		return step.AnalysisRuns[i].Name < step.AnalysisRuns[j].Name
+	})
+	return &step, nil
+}
+
+// sameState returns whether two steps have the same state, regardless of when they happened
+func sameState(a, b SimulationStep) bool {
+	a.Elapsed, b.Elapsed = metav1.Duration{}, metav1.Duration{}
+	a.Events, b.Events = nil, nil
+	return reflect.DeepEqual(a, b)
+}
+
+func (s *simulation) now() time.Time {
+	return s.clock
+}
+
+// simulatedQueue is the work queue of the rollouts. The simulation adds the rollout to it itself and
+// waits for the controller to reconcile it, while the requeues of the controller advance the clock.
+type simulatedQueue struct {
+	workqueue.RateLimitingInterface
+	simulation *simulation
+	// failed is whether the controller failed to reconcile the rollout it is processing
+	failed bool
+	// synced receives whether the rollout was reconciled once the controller is done processing it
+	synced chan error
+}
+
+// sync has the controller reconcile the rollout with the key once
+func (q *simulatedQueue) sync(ctx context.Context, key string) error {
+	q.RateLimitingInterface.Add(key)
+	select {
+	case err := <-q.synced:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *simulatedQueue) Add(item any) {}
+
+func (q *simulatedQueue) AddRateLimited(item any) {
+	// the controller only requeues a rollout with a rate limit when it failed to reconcile it
+	q.failed = true
+}
+
+func (q *simulatedQueue) AddAfter(item any, duration time.Duration) {
+	// wake up just after the deadline, since the controller only acts once it has passed
+	q.simulation.wakeups = append(q.simulation.wakeups, q.simulation.clock.Add(duration+time.Nanosecond))
+}
+
+func (q *simulatedQueue) Done(item any) {
+	q.RateLimitingInterface.Done(item)
+	var err error
+	if q.failed {
+		err = fmt.Errorf("the controller returned an error, see its logs")
+	}
+	q.failed = false
+	q.synced <- err
+}
+
+// simulationRecorder keeps the reasons of the events recorded by the controller
+type simulationRecorder struct {
+	lock    sync.Mutex
+	reasons []string
+}
+
+func (r *simulationRecorder) Eventf(object runtime.Object, opts record.EventOptions, messageFmt string, args ...any) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.reasons = append(r.reasons, opts.EventReason)
+}
+
+func (r *simulationRecorder) Warnf(object runtime.Object, opts record.EventOptions, messageFmt string, args ...any) {
+	r.Eventf(object, opts, messageFmt, args...)
+}
+
+func (r *simulationRecorder) K8sRecorder() k8srecord.EventRecorder {
+	return &k8srecord.FakeRecorder{}
+}
+
+// take returns the reasons recorded since the last call
+func (r *simulationRecorder) take() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	reasons := r.reasons
+	r.reasons = nil
+	return reasons
+}
+
+// simulatedRefResolver resolves nothing, since simulated rollouts cannot reference workloads
+type simulatedRefResolver struct{}
+
+func (r *simulatedRefResolver) Resolve(_ *v1alpha1.Rollout) error {
+	return nil
+}
@@ -0,0 +1,302 @@
+package simulator
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newRollout(name string, replicas int32) *v1alpha1.Rollout {
+	selector := map[string]string{"foo": "bar"}
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "container-name", Image: "foo/bar"}},
+				},
+			},
+		},
+	}
+}
+
+func newCanaryRollout(name string, replicas int32, steps []v1alpha1.CanaryStep) *v1alpha1.Rollout {
+	ro := newRollout(name, replicas)
+	maxSurge, maxUnavailable := intstr.FromInt(1), intstr.FromInt(0)
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		MaxSurge:       &maxSurge,
+		MaxUnavailable: &maxUnavailable,
+		Steps:          steps,
+	}
+	return ro
+}
+
+func newBlueGreenRollout(name string, replicas int32, activeSvc, previewSvc string) *v1alpha1.Rollout {
+	ro := newRollout(name, replicas)
+	ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{
+		ActiveService:              activeSvc,
+		PreviewService:             previewSvc,
+		AbortScaleDownDelaySeconds: pointer.Int32(0),
+	}
+	return ro
+}
+
+func lastSimulationStep(t *testing.T, steps []SimulationStep) SimulationStep {
+	require.NotEmpty(t, steps)
+	return steps[len(steps)-1]
+}
+
+func hasSimulationEvent(steps []SimulationStep, event string) bool {
+	for _, step := range steps {
+		for _, e := range step.Events {
+			if e == event {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestSimulateTrafficRoutedCanary(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(20)},
+		{Pause: &v1alpha1.RolloutPause{}},
+		{SetWeight: pointer.Int32(50)},
+		{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(60)}},
+	}
+	ro := newCanaryRollout("foo", 5, steps)
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "foo-ingress"},
+	}
+	ro.Spec.Strategy.Canary.StableService = "stable"
+	ro.Spec.Strategy.Canary.CanaryService = "canary"
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{})
+	require.NoError(t, err)
+	// the rollout passed in is left untouched
+	assert.NotNil(t, ro.Spec.Strategy.Canary.TrafficRouting.Nginx)
+
+	// the state while paused after each setWeight step
+	pausedAt := map[int32]SimulationStep{}
+	for _, step := range result {
+		if step.Phase == string(v1alpha1.RolloutPhasePaused) {
+			pausedAt[*step.StepIndex] = step
+		}
+	}
+	require.Contains(t, pausedAt, int32(1))
+	assert.Equal(t, int32(20), *pausedAt[1].Weight)
+	assert.Equal(t, int32(1), pausedAt[1].New.Replicas)
+	assert.Equal(t, int32(5), pausedAt[1].Stable.Replicas)
+	require.Contains(t, pausedAt, int32(3))
+	assert.Equal(t, int32(50), *pausedAt[3].Weight)
+	assert.Equal(t, int32(3), pausedAt[3].New.Replicas)
+	assert.True(t, hasSimulationEvent(result, "Promoted"))
+
+	var resumedAfter time.Duration
+	for _, step := range result {
+		if step.StepIndex != nil && *step.StepIndex == 4 {
+			resumedAfter = step.Elapsed.Duration
+			break
+		}
+	}
+	assert.Equal(t, time.Minute, resumedAfter)
+
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseHealthy), last.Phase)
+	assert.Nil(t, last.New)
+	require.NotNil(t, last.Stable)
+	assert.Equal(t, "2", last.Stable.Revision)
+	assert.Equal(t, int32(5), last.Stable.Replicas)
+	assert.Empty(t, last.Older)
+}
+
+func TestSimulateConcurrently(t *testing.T) {
+	// simulations replace the clock of the controller one at a time, and restore it once they are done
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(50)},
+		{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(3600)}},
+	}
+	results := make([][]SimulationStep, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = Simulate(newCanaryRollout(fmt.Sprintf("foo-%d", i), 2, steps), nil, nil, SimulationOptions{})
+		}(i)
+	}
+	wg.Wait()
+	for i := range results {
+		require.NoError(t, errs[i])
+		last := lastSimulationStep(t, results[i])
+		assert.Equal(t, string(v1alpha1.RolloutPhaseHealthy), last.Phase)
+		assert.Equal(t, time.Hour, last.Elapsed.Duration.Truncate(time.Minute))
+	}
+	assert.WithinDuration(t, time.Now(), timeutil.Now(), time.Minute)
+}
+
+func TestSimulateBasicCanary(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(40)},
+		{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(30)}},
+	}
+	ro := newCanaryRollout("foo", 5, steps)
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{})
+	require.NoError(t, err)
+	for _, step := range result {
+		assert.Nil(t, step.Weight)
+		total := int32(0)
+		for _, rs := range []*SimulatedReplicaSet{step.Stable, step.New} {
+			if rs != nil {
+				total += rs.Replicas
+			}
+		}
+		for _, rs := range step.Older {
+			total += rs.Replicas
+		}
+		// maxSurge of 1
+		assert.LessOrEqual(t, total, int32(6))
+		if step.StepIndex != nil && *step.StepIndex == 1 {
+			require.NotNil(t, step.New)
+			assert.Equal(t, int32(2), step.New.Replicas)
+		}
+	}
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseHealthy), last.Phase)
+	assert.Equal(t, "2", last.Stable.Revision)
+}
+
+func TestSimulateFailAnalysis(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(20)},
+		{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}}}},
+		{SetWeight: pointer.Int32(50)},
+	}
+	ro := newCanaryRollout("foo", 5, steps)
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{FailAnalysisAtStep: pointer.Int32(1)})
+	require.NoError(t, err)
+	assert.True(t, hasSimulationEvent(result, "AnalysisRunFailed(foo-5bd454cc49-2-1)"))
+	assert.True(t, hasSimulationEvent(result, "RolloutAborted"))
+
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseDegraded), last.Phase)
+	assert.Equal(t, "1", last.Stable.Revision)
+	assert.Equal(t, int32(5), last.Stable.Replicas)
+	require.NotNil(t, last.New)
+	assert.Equal(t, int32(0), last.New.Replicas)
+	require.Len(t, last.AnalysisRuns, 1)
+	assert.Equal(t, string(v1alpha1.AnalysisPhaseFailed), last.AnalysisRuns[0].Phase)
+}
+
+func TestSimulateAbort(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(20)},
+		{Pause: &v1alpha1.RolloutPause{}},
+		{SetWeight: pointer.Int32(50)},
+	}
+	ro := newCanaryRollout("foo", 5, steps)
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{AbortAtStep: pointer.Int32(1)})
+	require.NoError(t, err)
+	assert.True(t, hasSimulationEvent(result, "Aborted"))
+	assert.False(t, hasSimulationEvent(result, "Promoted"))
+
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseDegraded), last.Phase)
+	assert.Equal(t, int32(5), last.Stable.Replicas)
+	assert.Equal(t, int32(0), last.New.Replicas)
+}
+
+func TestSimulateBlueGreen(t *testing.T) {
+	ro := newBlueGreenRollout("foo", 3, "active", "preview")
+	ro.Spec.Strategy.BlueGreen.AutoPromotionEnabled = pointer.Bool(false)
+	ro.Spec.Strategy.BlueGreen.PrePromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke-test"}},
+	}
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{})
+	require.NoError(t, err)
+	assert.True(t, hasSimulationEvent(result, "Promoted"))
+
+	paused := false
+	for _, step := range result {
+		if step.Phase == string(v1alpha1.RolloutPhasePaused) {
+			paused = true
+			require.Len(t, step.AnalysisRuns, 1)
+			assert.Equal(t, v1alpha1.RolloutTypePrePromotionLabel, step.AnalysisRuns[0].Type)
+			assert.Equal(t, int32(3), step.New.Replicas)
+		}
+	}
+	assert.True(t, paused)
+
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseHealthy), last.Phase)
+	assert.Equal(t, "2", last.Stable.Revision)
+	assert.Empty(t, last.Older)
+}
+
+func TestSimulateTrafficRoutedBlueGreen(t *testing.T) {
+	ro := newBlueGreenRollout("foo", 3, "active", "preview")
+	ro.Spec.Strategy.BlueGreen.TrafficRouting = &v1alpha1.BlueGreenTrafficRouting{
+		RolloutTrafficRouting: v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{VirtualService: &v1alpha1.IstioVirtualService{Name: "foo-vsvc"}},
+		},
+		Weights: []int32{25, 50},
+	}
+	ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}},
+	}
+
+	result, err := Simulate(ro, nil, nil, SimulationOptions{})
+	require.NoError(t, err)
+	assert.NotNil(t, ro.Spec.Strategy.BlueGreen.TrafficRouting.Istio)
+
+	var weights []int32
+	for _, step := range result {
+		if step.Weight != nil && (len(weights) == 0 || weights[len(weights)-1] != *step.Weight) {
+			weights = append(weights, *step.Weight)
+		}
+	}
+	assert.Equal(t, []int32{0, 25, 50, 100, 0}, weights)
+
+	last := lastSimulationStep(t, result)
+	assert.Equal(t, string(v1alpha1.RolloutPhaseHealthy), last.Phase)
+	assert.Equal(t, "2", last.Stable.Revision)
+}
+
+func TestSimulateUnsupportedRollout(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil)
+	ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{Kind: "Deployment", Name: "foo"}
+	_, err := Simulate(ro, nil, nil, SimulationOptions{})
+	assert.EqualError(t, err, "rollouts with a workloadRef cannot be simulated")
+}
+
+func TestSimulateMaxTrafficWeight(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(500)}})
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx:            &v1alpha1.NginxTrafficRouting{StableIngress: "foo-ingress"},
+		MaxTrafficWeight: pointer.Int32(1000),
+	}
+	_, err := Simulate(ro, nil, nil, SimulationOptions{})
+	assert.EqualError(t, err, "rollouts with maxTrafficWeight cannot be simulated")
+}
@@ -0,0 +1,208 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/dependency"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// reconcileCanaryStepConditions evaluates the when expression of the current canary step once the rollout reaches
+// the step, and skips the steps whose expression evaluates to false. It returns true when the rollout does not
+// continue with the current step, because steps were skipped or because an expression failed to evaluate and the
+// rollout was aborted.
+func (c *rolloutContext) reconcileCanaryStepConditions() bool {
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if currentStep == nil || currentStep.When == "" || c.rollout.Status.StableRS == "" || c.rollout.Spec.Paused ||
+		c.heldBackReason() != "" || c.pauseContext.IsAborted() || c.rollout.Status.PromoteFull {
+		return false
+	}
+	evaluatedStepIndex := c.rollout.Status.Canary.EvaluatedStepIndex
+	if evaluatedStepIndex != nil && *evaluatedStepIndex == *currentStepIndex {
+		return false
+	}
+
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	stepCount := int32(len(steps))
+	// the steps skipped before a retry of the update are evaluated again
+	skippedSteps := []int32{}
+	for _, skipped := range c.rollout.Status.Canary.SkippedSteps {
+		if skipped < *currentStepIndex {
+			skippedSteps = append(skippedSteps, skipped)
+		}
+	}
+	index := *currentStepIndex
+	for ; index < stepCount && steps[index].When != ""; index++ {
+		met, err := c.evaluateStepCondition(steps[index].When)
+		if err != nil {
+			c.abortStepCondition(index, err)
+			return true
+		}
+		if met {
+			break
+		}
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepSkippedReason}, conditions.RolloutStepSkippedMessage, index+1, stepCount, rolloututil.CanaryStepString(steps[index]))
+		skippedSteps = append(skippedSteps, index)
+	}
+	c.newStatus.Canary.EvaluatedStepIndex = pointer.Int32(index)
+	if index == *currentStepIndex {
+		return false
+	}
+	c.newStatus.Canary.SkippedSteps = skippedSteps
+	c.skippedToStepIndex = pointer.Int32(index)
+	return true
+}
+
+// getCanaryStepBranch returns the index of the step the rollout continues at once the current canary step
+// completes, when one of the branches of the step matches. The branches are evaluated once per reconciliation.
+func (c *rolloutContext) getCanaryStepBranch() *int32 {
+	if c.stepBranchEvaluated {
+		return c.stepBranchIndex
+	}
+	c.stepBranchEvaluated = true
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if currentStep == nil {
+		return nil
+	}
+	for _, branch := range currentStep.Branches {
+		met, err := c.evaluateStepCondition(branch.When)
+		if err != nil {
+			c.abortStepCondition(*currentStepIndex, err)
+			return nil
+		}
+		if !met {
+			continue
+		}
+		index := dependency.GetStepIndex(c.rollout, branch.GoTo)
+		if int32(index) <= *currentStepIndex {
+			// invalid branches are reported by the spec validation
+			c.log.Warnf("Step %d branches to step '%s' which does not come after it", *currentStepIndex, branch.GoTo)
+			continue
+		}
+		c.stepBranchIndex = pointer.Int32(int32(index))
+		return c.stepBranchIndex
+	}
+	return nil
+}
+
+// branchCanaryStep makes the rollout continue at the step of the branch, and records the steps the branch jumped
+// over as skipped
+func (c *rolloutContext) branchCanaryStep(newStatus *v1alpha1.RolloutStatus, currentStepIndex, branchIndex int32) {
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	stepCount := len(steps)
+	for i := currentStepIndex + 1; i < branchIndex; i++ {
+		newStatus.Canary.SkippedSteps = append(newStatus.Canary.SkippedSteps, i)
+	}
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepBranchedReason}, conditions.RolloutStepBranchedMessage,
+		currentStepIndex+1, stepCount, rolloututil.CanaryStepString(steps[currentStepIndex]), branchIndex+1, stepCount, rolloututil.CanaryStepString(steps[branchIndex]))
+}
+
+func (c *rolloutContext) abortStepCondition(index int32, err error) {
+	msg := fmt.Sprintf(conditions.RolloutStepConditionErrorMessage, index+1, len(c.rollout.Spec.Strategy.Canary.Steps), err)
+	c.log.Warn(msg)
+	c.pauseContext.AddAbort(msg)
+}
+
+func (c *rolloutContext) evaluateStepCondition(expression string) (bool, error) {
+	vars := c.stepConditionVars()
+	if strings.Contains(expression, "namespaceLabels") {
+		// the namespace is only fetched for the expressions which refer to its labels
+		ns, err := c.kubeclientset.CoreV1().Namespaces().Get(context.TODO(), c.rollout.Namespace, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to get namespace '%s': %w", c.rollout.Namespace, err)
+		}
+		vars["namespaceLabels"] = ns.Labels
+	}
+	return evaluate.EvalStepCondition(expression, vars)
+}
+
+// stepConditionVars returns the variables which the when expressions of the canary steps and of their branches are
+// evaluated against
+func (c *rolloutContext) stepConditionVars() map[string]any {
+	revision, _ := annotations.GetRevisionAnnotation(c.rollout)
+	if c.newRS != nil {
+		if rsRevision, ok := annotations.GetRevisionAnnotation(c.newRS); ok {
+			revision = rsRevision
+		}
+	}
+	images := map[string]string{}
+	imageTags := map[string]string{}
+	var image, tag string
+	for i, container := range c.rollout.Spec.Template.Spec.Containers {
+		images[container.Name] = container.Image
+		imageTags[container.Name] = imageTag(container.Image)
+		if i == 0 {
+			image, tag = container.Image, imageTags[container.Name]
+		}
+	}
+	stepAnalysis := ""
+	_, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if ar := c.currentArs.CanaryStep; ar != nil && currentStepIndex != nil &&
+		ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel] == strconv.Itoa(int(*currentStepIndex)) {
+		stepAnalysis = string(ar.Status.Phase)
+	}
+	now := timeutil.Now().UTC()
+	return map[string]any{
+		"revision":        int(revision),
+		"namespace":       c.rollout.Namespace,
+		"namespaceLabels": map[string]string{},
+		"image":           image,
+		"imageTag":        tag,
+		"images":          images,
+		"imageTags":       imageTags,
+		"analysis":        c.stepAnalysisPhases(),
+		"stepAnalysis":    stepAnalysis,
+		"now":             now,
+		"hour":            now.Hour(),
+		"weekday":         now.Weekday().String(),
+	}
+}
+
+// stepAnalysisPhases returns the phases of the step analyses of the current update, by the name of their step or
+// by the index of their step when the step has no name
+func (c *rolloutContext) stepAnalysisPhases() map[string]string {
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	phases := map[string]string{}
+	created := map[string]metav1.Time{}
+	ars := append([]*v1alpha1.AnalysisRun{c.currentArs.CanaryStep}, c.otherArs...)
+	for _, ar := range ars {
+		if ar == nil || ar.Labels[v1alpha1.RolloutTypeLabel] != v1alpha1.RolloutTypeStepLabel ||
+			ar.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash {
+			continue
+		}
+		key := ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel]
+		if index, err := strconv.Atoi(key); err == nil && index < len(steps) && steps[index].Name != "" {
+			key = steps[index].Name
+		}
+		if previous, ok := created[key]; ok && ar.CreationTimestamp.Before(&previous) {
+			// the step analysis was retried
+			continue
+		}
+		created[key] = ar.CreationTimestamp
+		phases[key] = string(ar.Status.Phase)
+	}
+	return phases
+}
+
+// imageTag returns the tag of the image, or an empty string when the image has no tag
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return ""
+}
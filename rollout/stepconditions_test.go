@@ -0,0 +1,117 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	core "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+func TestCanaryStepConditionSkipsSteps(t *testing.T) {
+	// the rollout just reached the second of the steps
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}, When: "revision > 5"},
+		{SetWeight: pointer.Int32(30), When: `weekday == "Funday"`},
+		{SetWeight: pointer.Int32(50)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 9, 1, false)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(3), *patched.Status.CurrentStepIndex)
+	assert.Equal(t, int32(3), *patched.Status.Canary.EvaluatedStepIndex)
+	assert.Equal(t, []int32{1, 2}, patched.Status.Canary.SkippedSteps)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.Equal(t, []string{conditions.RolloutStepSkippedReason, conditions.RolloutStepSkippedReason}, f.events[:2])
+}
+
+func TestCanaryStepConditionRunsStep(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}, When: `revision == 2 && namespaceLabels.env == "prod"`},
+		{SetWeight: pointer.Int32(50)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 9, 1, false)
+	defer f.Close()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault, Labels: map[string]string{"env": "prod"}}}
+	f.kubeobjects = append(f.kubeobjects, ns)
+	f.kubeactions = append(f.kubeactions, core.NewRootGetAction(schema.GroupVersionResource{Resource: "namespaces"}, ns.Name))
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(1), *patched.Status.Canary.EvaluatedStepIndex)
+	assert.Nil(t, patched.Status.Canary.SkippedSteps)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonCanaryPauseStep, patched.Status.PauseConditions[0].Reason)
+	assert.NotContains(t, f.events, conditions.RolloutStepSkippedReason)
+}
+
+func TestCanaryStepConditionError(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}, When: "unknown > 1"},
+		{SetWeight: pointer.Int32(50)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 9, 1, false)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.Equal(t, int32(0), *patched.Status.CurrentStepIndex)
+	assert.Contains(t, patched.Status.Message, "Failed to evaluate the expression of step 2/3")
+}
+
+func TestCanaryStepBranchOnInconclusiveAnalysis(t *testing.T) {
+	at := analysisTemplate("bar")
+	stepAnalysis := &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}}}
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Name: "analysis", Analysis: stepAnalysis, Branches: []v1alpha1.StepBranch{
+			{When: `analysis.analysis == "Successful"`, GoTo: "full"},
+			{When: `stepAnalysis == "Inconclusive"`, GoTo: "extended-analysis"},
+		}},
+		{SetWeight: pointer.Int32(50)},
+		{Name: "extended-analysis", Analysis: stepAnalysis},
+		{Name: "full", SetWeight: pointer.Int32(100)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	f, r2, _, _ := newRolloutUpdateFixture(t, r1, 9, 1, false)
+	defer f.Close()
+
+	ar := analysisRun(at, v1alpha1.RolloutTypeStepLabel, r2)
+	ar.Status = v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseInconclusive}
+	r2.Status.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{Name: ar.Name}
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, at, ar)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(3), *patched.Status.CurrentStepIndex)
+	assert.Equal(t, []int32{2}, patched.Status.Canary.SkippedSteps)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.Contains(t, f.events, conditions.RolloutStepBranchedReason)
+	assert.Contains(t, f.events, conditions.RolloutStepCompletedReason)
+}
@@ -43,7 +43,7 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 				continue
 			}
 
-			stepPlugin, err := spc.resolver.Resolve(pluginStatus.Index, *pluginStep.Plugin, c.log)
+			stepPlugin, err := spc.resolve(c, pluginStatus.Index, *pluginStep.Plugin)
 			if err != nil {
 				return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", pluginStatus.Index, err))
 			}
@@ -71,7 +71,7 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 			return nil
 		}
 
-		stepPlugin, err := spc.resolver.Resolve(*stepIndex, *pluginStep.Plugin, c.log)
+		stepPlugin, err := spc.resolve(c, *stepIndex, *pluginStep.Plugin)
 		if err != nil {
 			return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", *stepIndex, err))
 		}
@@ -102,7 +102,7 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 		return nil
 	}
 
-	stepPlugin, err := spc.resolver.Resolve(*currentStepIndex, *currentStep.Plugin, c.log)
+	stepPlugin, err := spc.resolve(c, *currentStepIndex, *currentStep.Plugin)
 	if err != nil {
 		return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", *currentStepIndex, err))
 	}
@@ -141,6 +141,15 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 }
 
 // handleError handles any error that should not cause the rollout reconciliation to fail
+// resolve returns the plugin of the step, which records a span for every operation
+func (spc *stepPluginContext) resolve(c *rolloutContext, index int32, pluginStep v1alpha1.PluginStep) (plugin.StepPlugin, error) {
+	stepPlugin, err := spc.resolver.Resolve(index, pluginStep, c.log)
+	if err != nil {
+		return nil, err
+	}
+	return plugin.NewTracingStepPlugin(c.ctx, index, pluginStep.Name, stepPlugin), nil
+}
+
 func (spc *stepPluginContext) handleError(c *rolloutContext, e error) error {
 	spc.hasError = true
 
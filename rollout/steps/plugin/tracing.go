@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// tracingStepPlugin records a span for every operation of a StepPlugin
+type tracingStepPlugin struct {
+	plugin StepPlugin
+	ctx    context.Context
+	index  int32
+	name   string
+}
+
+// NewTracingStepPlugin returns a StepPlugin which records a span, as a child of the span in ctx, for
+// every operation of the given step plugin
+func NewTracingStepPlugin(ctx context.Context, index int32, name string, plugin StepPlugin) StepPlugin {
+	return &tracingStepPlugin{
+		plugin: plugin,
+		ctx:    ctx,
+		index:  index,
+		name:   name,
+	}
+}
+
+func (p *tracingStepPlugin) startSpan(operation string) trace.Span {
+	_, span := tracing.StartSpan(p.ctx, "stepPlugin."+operation,
+		tracing.AttributePlugin.String(p.name),
+		tracing.AttributeStepIndex.Int64(int64(p.index)),
+	)
+	return span
+}
+
+func (p *tracingStepPlugin) Run(rollout *v1alpha1.Rollout) (*v1alpha1.StepPluginStatus, error) {
+	span := p.startSpan("Run")
+	status, err := p.plugin.Run(rollout)
+	tracing.EndSpan(span, err)
+	return status, err
+}
+
+func (p *tracingStepPlugin) Terminate(rollout *v1alpha1.Rollout) (*v1alpha1.StepPluginStatus, error) {
+	span := p.startSpan("Terminate")
+	status, err := p.plugin.Terminate(rollout)
+	tracing.EndSpan(span, err)
+	return status, err
+}
+
+func (p *tracingStepPlugin) Abort(rollout *v1alpha1.Rollout) (*v1alpha1.StepPluginStatus, error) {
+	span := p.startSpan("Abort")
+	status, err := p.plugin.Abort(rollout)
+	tracing.EndSpan(span, err)
+	return status, err
+}
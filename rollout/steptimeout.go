@@ -0,0 +1,124 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// getStepTimeoutAction returns the action taken when the step times out, which defaults to Abort
+func getStepTimeoutAction(timeout *v1alpha1.StepTimeout) v1alpha1.StepTimeoutAction {
+	if timeout.Action == "" {
+		return v1alpha1.StepTimeoutActionAbort
+	}
+	return timeout.Action
+}
+
+// reconcileCanaryStepTimeout tracks the timeout of the current step in the canary status, and takes the action of
+// the timeout once the step exceeds it. The timeout restarts when the rollout is resumed after the step timed out
+// with the Pause action. The timeout does not start while the rollout is paused or its update is held back, so
+// that it starts once the step actually runs. It returns true when the step is skipped.
+func (c *rolloutContext) reconcileCanaryStepTimeout(newStatus *v1alpha1.RolloutStatus, currentStep *v1alpha1.CanaryStep, currentStepIndex *int32) bool {
+	if currentStep == nil || currentStep.Timeout == nil {
+		return false
+	}
+	timeout, err := currentStep.Timeout.Duration.Duration()
+	if err != nil {
+		// invalid timeouts are reported by the spec validation
+		c.log.Warnf("Failed to parse the timeout of step %d: %v", *currentStepIndex, err)
+		return false
+	}
+
+	now := timeutil.MetaNow()
+	halted := c.rollout.Spec.Paused || c.heldBackReason() != "" || c.isQueuedAtFirstStep()
+	stepTimeout := c.rollout.Status.Canary.CurrentStepTimeout.DeepCopy()
+	if stepTimeout == nil || stepTimeout.StepIndex != *currentStepIndex ||
+		stepTimeout.TimedOut && getPauseCondition(c.rollout, v1alpha1.PauseReasonStepTimeout) == nil {
+		if halted {
+			newStatus.Canary.CurrentStepTimeout = nil
+			return false
+		}
+		stepTimeout = &v1alpha1.StepTimeoutStatus{StepIndex: *currentStepIndex, StartedAt: now}
+	}
+	newStatus.Canary.CurrentStepTimeout = stepTimeout
+	if stepTimeout.TimedOut || halted {
+		return false
+	}
+	if now.Time.Before(stepTimeout.StartedAt.Add(timeout)) {
+		c.checkEnqueueRolloutDuringWait(stepTimeout.StartedAt, int32(timeout/time.Second))
+		return false
+	}
+
+	stepTimeout.TimedOut = true
+	action := getStepTimeoutAction(currentStep.Timeout)
+	stepCount := len(c.rollout.Spec.Strategy.Canary.Steps)
+	msg := fmt.Sprintf(conditions.RolloutStepTimedOutMessage, *currentStepIndex+1, stepCount, rolloututil.CanaryStepString(*currentStep), timeout, action)
+	c.timeOutStep(action, msg)
+	if action == v1alpha1.StepTimeoutActionPause {
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonStepTimeout)
+	}
+	return action == v1alpha1.StepTimeoutActionSkip
+}
+
+// blueGreenPauseTimedOut returns the pause timeout of the blue-green rollout, and whether the rollout has been paused
+// for longer than it
+func blueGreenPauseTimedOut(ro *v1alpha1.Rollout, pauseCond v1alpha1.PauseCondition) (time.Duration, bool) {
+	pauseTimeout := ro.Spec.Strategy.BlueGreen.PauseTimeout
+	if pauseTimeout == nil || ro.Spec.Paused {
+		return 0, false
+	}
+	timeout, err := pauseTimeout.Duration.Duration()
+	if err != nil {
+		return 0, false
+	}
+	return timeout, !timeutil.Now().Before(pauseCond.StartTime.Add(timeout))
+}
+
+// reconcileBlueGreenPauseTimeout reports the timeout of the pause of the blue-green rollout, and aborts the rollout
+// when the action of the timeout is Abort. With the Skip action, the pause completes once it timed out, and the
+// rollout is promoted. With the Pause action, the rollout stays paused until it is promoted.
+func (c *rolloutContext) reconcileBlueGreenPauseTimeout(pauseCond v1alpha1.PauseCondition) {
+	timeout, timedOut := blueGreenPauseTimedOut(c.rollout, pauseCond)
+	if timeout == 0 {
+		return
+	}
+	if !timedOut {
+		c.checkEnqueueRolloutDuringWait(pauseCond.StartTime, int32(timeout/time.Second))
+		return
+	}
+	if cond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutStepTimedOut); cond != nil && !cond.LastTransitionTime.Before(&pauseCond.StartTime) {
+		// the timeout of the pause was already reported
+		return
+	}
+	action := getStepTimeoutAction(c.rollout.Spec.Strategy.BlueGreen.PauseTimeout)
+	c.timeOutStep(action, fmt.Sprintf(conditions.RolloutPauseTimedOutMessage, timeout, action))
+}
+
+// timeOutStep reports the timeout of a step, and aborts the rollout when the action of the timeout is Abort. The
+// other actions are taken by the callers.
+func (c *rolloutContext) timeOutStep(action v1alpha1.StepTimeoutAction, msg string) {
+	c.log.Info(msg)
+	c.stepTimedOutMessage = msg
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepTimedOutReason}, msg)
+	if action == v1alpha1.StepTimeoutActionAbort {
+		c.pauseContext.AddAbort(msg)
+	}
+}
+
+// calculateStepTimedOutCondition reports the last timeout of the current update in the StepTimedOut condition,
+// which is removed when the next update starts
+func (c *rolloutContext) calculateStepTimedOutCondition(newStatus *v1alpha1.RolloutStatus) {
+	if c.stepTimedOutMessage == "" {
+		return
+	}
+	conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutStepTimedOut)
+	cond := conditions.NewRolloutCondition(v1alpha1.RolloutStepTimedOut, corev1.ConditionTrue, conditions.RolloutStepTimedOutReason, c.stepTimedOutMessage)
+	conditions.SetRolloutCondition(newStatus, *cond)
+}
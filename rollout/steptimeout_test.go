@@ -0,0 +1,198 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newStepTimeoutRollout returns a canary rollout at a pause step with a timeout of an hour
+func newStepTimeoutRollout(action v1alpha1.StepTimeoutAction) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}, Timeout: &v1alpha1.StepTimeout{Duration: "1h", Action: action}},
+		{SetWeight: pointer.Int32(50)},
+	}
+	return newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+}
+
+func TestCanaryStepTimeoutStarts(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(""), 9, 1, true)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(1), patched.Status.Canary.CurrentStepTimeout.StepIndex)
+	assert.Equal(t, timeutil.Now().Unix(), patched.Status.Canary.CurrentStepTimeout.StartedAt.Unix())
+	assert.False(t, patched.Status.Canary.CurrentStepTimeout.TimedOut)
+	assert.False(t, patched.Status.Abort)
+}
+
+func TestCanaryStepTimeoutNotStartedWhilePaused(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(""), 9, 1, true)
+	defer f.Close()
+	r2.Spec.Paused = true
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), "currentStepTimeout")
+}
+
+func TestCanaryStepTimeoutNotStartedWhileHeldBack(t *testing.T) {
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(v1alpha1.StepTimeoutActionAbort), 9, 1, true)
+	defer f.Close()
+	timeutil.SetNowTimeFunc(func() time.Time {
+		return time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	})
+	r2.Spec.DeployWindows = []v1alpha1.DeployWindow{{
+		Kind:     v1alpha1.DeployWindowKindAllow,
+		Schedule: "0 9 * * *",
+		Duration: "8h",
+	}}
+
+	// the timeout starts once the deploy windows let the update through, rather than outside of them
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), "currentStepTimeout")
+	assert.False(t, f.getPatchedRolloutAsObject(patchIndex).Status.Abort)
+}
+
+func TestCanaryStepTimeoutAbort(t *testing.T) {
+	startedAt := metav1.NewTime(timeutil.Now().Add(-2 * time.Hour))
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(v1alpha1.StepTimeoutActionAbort), 9, 1, true)
+	defer f.Close()
+	r2.Status.Canary.CurrentStepTimeout = &v1alpha1.StepTimeoutStatus{StepIndex: 1, StartedAt: startedAt}
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.True(t, patched.Status.Canary.CurrentStepTimeout.TimedOut)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutStepTimedOut)
+	assert.NotNil(t, cond)
+	assert.Equal(t, "Rollout step 2/3 (pause) timed out after 1h0m0s, action: Abort", cond.Message)
+	progressing := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutProgressing)
+	assert.Equal(t, conditions.RolloutAbortedReason, progressing.Reason)
+	assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+}
+
+func TestCanaryStepTimeoutSkip(t *testing.T) {
+	startedAt := metav1.NewTime(timeutil.Now().Add(-2 * time.Hour))
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(v1alpha1.StepTimeoutActionSkip), 9, 1, true)
+	defer f.Close()
+	r2.Status.Canary.CurrentStepTimeout = &v1alpha1.StepTimeoutStatus{StepIndex: 1, StartedAt: startedAt}
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.Equal(t, int32(2), *patched.Status.CurrentStepIndex)
+	assert.Nil(t, patched.Status.Canary.CurrentStepTimeout)
+	assert.Nil(t, patched.Status.PauseConditions)
+	assert.NotNil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutStepTimedOut))
+	assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+}
+
+func TestCanaryStepTimeoutPause(t *testing.T) {
+	startedAt := metav1.NewTime(timeutil.Now().Add(-2 * time.Hour))
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(v1alpha1.StepTimeoutActionPause), 9, 1, true)
+	defer f.Close()
+	r2.Status.Canary.CurrentStepTimeout = &v1alpha1.StepTimeoutStatus{StepIndex: 1, StartedAt: startedAt}
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.True(t, patched.Status.Canary.CurrentStepTimeout.TimedOut)
+	assert.Len(t, patched.Status.PauseConditions, 2)
+	assert.Equal(t, v1alpha1.PauseReasonStepTimeout, patched.Status.PauseConditions[1].Reason)
+	assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+}
+
+func TestCanaryStepTimeoutRestartsWhenResumed(t *testing.T) {
+	startedAt := metav1.NewTime(timeutil.Now().Add(-2 * time.Hour))
+	f, r2, _, _ := newRolloutUpdateFixture(t, newStepTimeoutRollout(v1alpha1.StepTimeoutActionPause), 9, 1, true)
+	defer f.Close()
+	r2.Status.Canary.CurrentStepTimeout = &v1alpha1.StepTimeoutStatus{StepIndex: 1, StartedAt: startedAt}
+	r2.Status.Canary.CurrentStepTimeout.TimedOut = true
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, timeutil.Now().Unix(), patched.Status.Canary.CurrentStepTimeout.StartedAt.Unix())
+	assert.False(t, patched.Status.Canary.CurrentStepTimeout.TimedOut)
+	assert.NotContains(t, f.events, conditions.RolloutStepTimedOutReason)
+}
+
+func TestBlueGreenPauseTimeout(t *testing.T) {
+	newPausedBlueGreenFixture := func(t *testing.T, action v1alpha1.StepTimeoutAction) (*fixture, *v1alpha1.Rollout, string) {
+		r1 := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+		r1.Spec.Strategy.BlueGreen.AutoPromotionEnabled = pointer.Bool(false)
+		r1.Spec.Strategy.BlueGreen.PauseTimeout = &v1alpha1.StepTimeout{Duration: "30m", Action: action}
+		f, r2, _, rs2 := newRolloutUpdateFixture(t, r1, 1, 1, true)
+		r2.Status.PauseConditions[0].StartTime = metav1.NewTime(timeutil.Now().Add(-time.Hour))
+		r2.Status.ControllerPause = true
+		r2.Status.Phase, r2.Status.Message = rolloututil.CalculateRolloutPhase(r2.Spec, r2.Status)
+		return f, r2, rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	}
+
+	t.Run("Abort", func(t *testing.T) {
+		f, r2, _ := newPausedBlueGreenFixture(t, v1alpha1.StepTimeoutActionAbort)
+		defer f.Close()
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		assert.True(t, patched.Status.Abort)
+		cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutStepTimedOut)
+		assert.NotNil(t, cond)
+		assert.Equal(t, "Rollout pause timed out after 30m0s, action: Abort", cond.Message)
+		assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		f, r2, rs2PodHash := newPausedBlueGreenFixture(t, v1alpha1.StepTimeoutActionSkip)
+		defer f.Close()
+
+		f.expectPatchServiceAction(f.serviceLister[0], rs2PodHash)
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		assert.False(t, patched.Status.Abort)
+		assert.Nil(t, patched.Status.PauseConditions)
+		assert.Equal(t, rs2PodHash, patched.Status.BlueGreen.ActiveSelector)
+		assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+	})
+
+	t.Run("Pause", func(t *testing.T) {
+		f, r2, _ := newPausedBlueGreenFixture(t, v1alpha1.StepTimeoutActionPause)
+		defer f.Close()
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		assert.False(t, patched.Status.Abort)
+		assert.NotNil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutStepTimedOut))
+		assert.Contains(t, f.events, conditions.RolloutStepTimedOutReason)
+	})
+}
@@ -0,0 +1,106 @@
+package rollout
+
+import (
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	// strategyRefIndexName is the index of the rollouts by the keys of the strategy templates they reference
+	strategyRefIndexName = "byStrategyRef"
+)
+
+// addStrategyRefIndexer indexes the rollouts by the strategy templates they reference, so that a change of a
+// template requeues the rollouts referencing it
+func addStrategyRefIndexer(rolloutsInformer cache.SharedIndexInformer) {
+	util.CheckErr(rolloutsInformer.AddIndexers(cache.Indexers{
+		strategyRefIndexName: func(obj any) ([]string, error) {
+			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil && ro.Spec.StrategyRef != nil {
+				return []string{strategytemplate.Key(*ro.Spec.StrategyRef, ro.Namespace)}, nil
+			}
+			return nil, nil
+		},
+	}))
+}
+
+// newStrategyTemplateEventHandler returns the handler of the events of the strategy templates of the given kind,
+// which enqueues the rollouts referencing the changed template
+func (c *Controller) newStrategyTemplateEventHandler(kind string) cache.ResourceEventHandlerFuncs {
+	enqueueReferencingRollouts := func(obj any, oldSpec, newSpec *v1alpha1.RolloutStrategyTemplateSpec) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return
+		}
+		objs, err := c.rolloutsIndexer.ByIndex(strategyRefIndexName, kind+"/"+key)
+		if err != nil {
+			log.Warnf("Cannot get the rollouts referencing %s %s: %v", kind, key, err)
+			return
+		}
+		for _, obj := range objs {
+			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil && oldSpec != nil {
+				c.enqueueRemovedTemplateResources(ro, *oldSpec, newSpec)
+			}
+			c.enqueueRollout(obj)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			enqueueReferencingRollouts(obj, nil, nil)
+		},
+		UpdateFunc: func(old, new any) {
+			oldMeta, oldErr := meta.Accessor(old)
+			newMeta, newErr := meta.Accessor(new)
+			if oldErr == nil && newErr == nil && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				// Periodic resync will send update events for all known templates
+				return
+			}
+			enqueueReferencingRollouts(new, templateSpec(old), templateSpec(new))
+		},
+		DeleteFunc: func(obj any) {
+			enqueueReferencingRollouts(obj, templateSpec(obj), nil)
+		},
+	}
+}
+
+// enqueueRemovedTemplateResources enqueues the Services and DestinationRules of the strategy a rollout resolved
+// to from the old spec of its template which are no longer part of the strategy it resolves to from the new spec,
+// so that the rollouts-pod-template-hash can be cleared from each. The template was deleted when newSpec is nil.
+func (c *Controller) enqueueRemovedTemplateResources(ro *v1alpha1.Rollout, oldSpec v1alpha1.RolloutStrategyTemplateSpec, newSpec *v1alpha1.RolloutStrategyTemplateSpec) {
+	oldRollout := ro.DeepCopy()
+	if strategytemplate.Resolve(oldRollout, oldSpec) != nil {
+		return
+	}
+	newRollout := ro.DeepCopy()
+	if newSpec == nil || strategytemplate.Resolve(newRollout, *newSpec) != nil {
+		// the rollout no longer resolves to a strategy referencing any resource
+		newRollout = ro.DeepCopy()
+	}
+	for _, key := range removedKeys("Service", oldRollout, newRollout, serviceutil.GetRolloutServiceKeys) {
+		c.serviceWorkqueue.AddRateLimited(key)
+	}
+	for _, key := range removedKeys("DestinationRule", oldRollout, newRollout, istioutil.GetRolloutDesinationRuleKeys) {
+		c.IstioController.EnqueueDestinationRule(key)
+	}
+}
+
+// templateSpec returns the spec of a RolloutStrategyTemplate or ClusterRolloutStrategyTemplate from a watch
+func templateSpec(obj any) *v1alpha1.RolloutStrategyTemplateSpec {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	switch template := obj.(type) {
+	case *v1alpha1.RolloutStrategyTemplate:
+		return &template.Spec
+	case *v1alpha1.ClusterRolloutStrategyTemplate:
+		return &template.Spec
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+// newStrategyRefRollout moves the strategy of the rollout to a template the rollout references
+func newStrategyRefRollout(r *v1alpha1.Rollout) (*v1alpha1.Rollout, *v1alpha1.RolloutStrategyTemplate) {
+	template := &v1alpha1.RolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "standard",
+			Namespace: r.Namespace,
+		},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Strategy: *r.Spec.Strategy.DeepCopy(),
+		},
+	}
+	r.Spec.Strategy = v1alpha1.RolloutStrategy{}
+	r.Spec.StrategyRef = &v1alpha1.RolloutStrategyRef{Name: template.Name}
+	return r, template
+}
+
+func TestStrategyRefRolloutEnterPauseState(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			Pause: &v1alpha1.RolloutPause{},
+		},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	r2, template := newStrategyRefRollout(r2)
+	f.strategyTemplateLister = append(f.strategyTemplateLister, template)
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2, template)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonCanaryPauseStep, patched.Status.PauseConditions[0].Reason)
+}
+
+func TestStrategyRefRolloutMissingTemplate(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r, _ = newStrategyRefRollout(r)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.runExpectError(getKey(r, t), true)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.InvalidSpec)
+	assert.NotNil(t, cond)
+	assert.Equal(t, conditions.InvalidSpecReason, cond.Reason)
+	assert.Contains(t, cond.Message, "RolloutStrategyTemplate 'standard' not found")
+}
+
+func TestStrategyTemplateEventHandler(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1, template := newStrategyRefRollout(r1)
+	r2 := newCanaryRollout("bar", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r3 := newCanaryRollout("baz", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r3, _ = newStrategyRefRollout(r3)
+	r3.Spec.StrategyRef.ClusterScope = true
+	f.rolloutLister = append(f.rolloutLister, r1, r2, r3)
+	f.objects = append(f.objects, r1, r2, r3)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	handler := c.newStrategyTemplateEventHandler("RolloutStrategyTemplate")
+
+	updated := template.DeepCopy()
+	updated.ResourceVersion = "2"
+	handler.UpdateFunc(template, template)
+	assert.Empty(t, f.enqueuedObjects)
+
+	handler.UpdateFunc(template, updated)
+	assert.Equal(t, map[string]int{getKey(r1, t): 1}, f.enqueuedObjects)
+
+	clusterTemplate := &v1alpha1.ClusterRolloutStrategyTemplate{ObjectMeta: metav1.ObjectMeta{Name: "standard"}}
+	c.newStrategyTemplateEventHandler("ClusterRolloutStrategyTemplate").OnDelete(clusterTemplate)
+	assert.Equal(t, map[string]int{getKey(r1, t): 1, getKey(r3, t): 1}, f.enqueuedObjects)
+}
+
+func TestStrategyTemplateEventHandlerRemovedResources(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Strategy.Canary.CanaryService = "canary"
+	r.Spec.Strategy.Canary.StableService = "stable"
+	r, template := newStrategyRefRollout(r)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	handler := c.newStrategyTemplateEventHandler("RolloutStrategyTemplate")
+
+	updated := template.DeepCopy()
+	updated.ResourceVersion = "2"
+	updated.Spec.Strategy.Canary.CanaryService = ""
+	handler.UpdateFunc(template, updated)
+	key, _ := c.serviceWorkqueue.Get()
+	c.serviceWorkqueue.Done(key)
+	assert.Equal(t, "default/canary", key)
+	assert.Equal(t, 0, c.serviceWorkqueue.Len())
+
+	handler.OnDelete(updated)
+	key, _ = c.serviceWorkqueue.Get()
+	c.serviceWorkqueue.Done(key)
+	assert.Equal(t, "default/stable", key)
+}
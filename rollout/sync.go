@@ -29,6 +29,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
 // getAllReplicaSetsAndSyncRevision returns all the replica sets for the provided rollout (new and all old), with new RS's and rollout's revision updated.
@@ -422,6 +423,7 @@ func (c *rolloutContext) calculateBaseStatus() v1alpha1.RolloutStatus {
 	newStatus.Conditions = prevStatus.Conditions
 	newStatus.RestartedAt = c.newStatus.RestartedAt
 	newStatus.PromoteFull = (newStatus.CurrentPodHash != newStatus.StableRS) && prevStatus.PromoteFull
+	newStatus.IgnoreDeployWindow = (newStatus.CurrentPodHash != newStatus.StableRS) && prevStatus.IgnoreDeployWindow
 	return newStatus
 }
 
@@ -560,11 +562,11 @@ func (c *rolloutContext) patchCondition(r *v1alpha1.Rollout, newStatus *v1alpha1
 	return nil
 }
 
-// isIndefiniteStep returns whether or not the rollout is at an Experiment or Analysis or Pause step which should
-// not affect the progressDeadlineSeconds
+// isIndefiniteStep returns whether or not the rollout is at an Experiment or Analysis or Shadow or Pause step which
+// should not affect the progressDeadlineSeconds
 func isIndefiniteStep(r *v1alpha1.Rollout) bool {
 	currentStep, _ := replicasetutil.GetCurrentCanaryStep(r)
-	if currentStep != nil && (currentStep.Experiment != nil || currentStep.Analysis != nil || currentStep.Pause != nil) {
+	if currentStep != nil && (currentStep.Experiment != nil || currentStep.Analysis != nil || currentStep.Shadow != nil || currentStep.Pause != nil) {
 		return true
 	}
 	return false
@@ -584,6 +586,8 @@ func isWaitingForReplicaSetScaleDown(r *v1alpha1.Rollout, newRS, stableRS *appsv
 }
 
 func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutStatus) v1alpha1.RolloutStatus {
+	c.calculateWeightVerifiedCondition(&newStatus)
+	c.calculateStepTimedOutCondition(&newStatus)
 	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
 	isAborted := c.pauseContext.IsAborted()
 
@@ -801,9 +805,6 @@ func (c *rolloutContext) sendStateChangeEvents(prevStatus, newStatus *v1alpha1.R
 	}
 }
 
-// used for unit testing
-var nowFn = func() time.Time { return time.Now() }
-
 // requeueStuckRollout checks whether the provided rollout needs to be synced for a progress
 // check. It returns the time after the rollout will be requeued for the progress check, 0 if it
 // will be requeued now, or -1 if it does not need to be requeued.
@@ -834,7 +835,7 @@ func (c *rolloutContext) requeueStuckRollout(newStatus v1alpha1.RolloutStatus) t
 	//
 	// lastUpdated + progressDeadlineSeconds - now => 00:00:00 + 00:10:00 - 00:03:00 => 07:00
 	progressDeadlineSeconds := defaults.GetProgressDeadlineSecondsOrDefault(c.rollout)
-	after := currentCond.LastUpdateTime.Time.Add(time.Duration(progressDeadlineSeconds) * time.Second).Sub(nowFn())
+	after := currentCond.LastUpdateTime.Time.Add(time.Duration(progressDeadlineSeconds) * time.Second).Sub(timeutil.Now())
 	// If the remaining time is less than a second, then requeue the deployment immediately.
 	// Make it ratelimited so we stay on the safe side, eventually the Deployment should
 	// transition either to a Complete or to a TimedOut condition.
@@ -890,12 +891,24 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 	c.pauseContext.RemoveAbort()
 	c.SetRestartedAt()
 	newStatus.PromoteFull = false
+	newStatus.IgnoreDeployWindow = false
 	newStatus.BlueGreen.PrePromotionAnalysisRunStatus = nil
 	newStatus.BlueGreen.PostPromotionAnalysisRunStatus = nil
 	newStatus.BlueGreen.ScaleUpPreviewCheckPoint = false
+	newStatus.BlueGreen.TrafficWeightIndex = nil
 	newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentBackgroundAnalysisRunStatus = nil
 	newStatus.Canary.StepPluginStatuses = nil
+	newStatus.Canary.PostPromotionAnalysisRunStatus = nil
+	newStatus.Canary.BakeStartedAt = nil
+	newStatus.Canary.EvaluatedStepIndex = nil
+	newStatus.Canary.SkippedSteps = nil
+	if newStatus.Canary.RollbackPodHash != newStatus.CurrentPodHash {
+		// the rollback to the previous stable ReplicaSet is kept until another update starts
+		newStatus.Canary.RollbackPodHash = ""
+		conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutRolledBack)
+	}
+	conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutStepTimedOut)
 	newStatus.CurrentStepIndex = replicasetutil.ResetCurrentStepIndex(c.rollout)
 }
 
@@ -1024,6 +1037,7 @@ func (c *rolloutContext) promoteStable(newStatus *v1alpha1.RolloutStatus, reason
 			}
 		}
 		newStatus.StableRS = newStatus.CurrentPodHash
+		c.startBakePeriod(newStatus, previousStableHash)
 
 		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutCompletedReason},
 			conditions.RolloutCompletedMessage, revision, newStatus.CurrentPodHash, reason)
@@ -0,0 +1,87 @@
+package rollout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// canaryStepStart is when a rollout started a canary step of a revision
+type canaryStepStart struct {
+	podHash   string
+	index     int32
+	startedAt time.Time
+}
+
+// canaryStepTimes remembers when the rollouts started their current canary step, so that the span
+// of a step can be recorded with its duration once the step completes. The start times are only
+// kept in memory: steps which were started before the controller restarted are timed from the
+// first reconciliation after the restart.
+type canaryStepTimes struct {
+	lock   sync.Mutex
+	starts map[types.UID]canaryStepStart
+}
+
+func newCanaryStepTimes() *canaryStepTimes {
+	return &canaryStepTimes{
+		starts: map[types.UID]canaryStepStart{},
+	}
+}
+
+// observe returns when the rollout started the canary step, which is now if the rollout was not
+// seen at the step before
+func (t *canaryStepTimes) observe(uid types.UID, podHash string, index int32, now time.Time) time.Time {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	start, ok := t.starts[uid]
+	if !ok || start.podHash != podHash || start.index != index {
+		start = canaryStepStart{podHash: podHash, index: index, startedAt: now}
+		t.starts[uid] = start
+	}
+	return start.startedAt
+}
+
+// forget drops the start time of the canary step of the rollout
+func (t *canaryStepTimes) forget(uid types.UID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.starts, uid)
+}
+
+// forgetCanaryStep drops the start time of the canary step of the rollout, once its revision no
+// longer runs canary steps
+func (c *rolloutContext) forgetCanaryStep() {
+	if c.canarySteps != nil {
+		c.canarySteps.forget(c.rollout.UID)
+	}
+}
+
+// traceCanaryStep records the span of the current canary step of the rollout once it completes.
+// The span is a child of the root of the trace of the revision, since a step usually outlives the
+// reconciliation it completes in.
+func (c *rolloutContext) traceCanaryStep(step *v1alpha1.CanaryStep, index *int32, podHash string, completed bool) {
+	if c.canarySteps == nil || step == nil || index == nil {
+		return
+	}
+	now := timeutil.Now()
+	startedAt := c.canarySteps.observe(c.rollout.UID, podHash, *index, now)
+	if !completed {
+		return
+	}
+	// the next step starts as this one completes
+	c.canarySteps.observe(c.rollout.UID, podHash, *index+1, now)
+	tracing.RecordSpan(tracing.RolloutContext(context.Background(), c.rollout), "rollout.canaryStep", startedAt, now,
+		tracing.AttributeNamespace.String(c.rollout.Namespace),
+		tracing.AttributeRollout.String(c.rollout.Name),
+		tracing.AttributePodTemplateHash.String(podHash),
+		tracing.AttributeStepIndex.Int64(int64(*index)),
+		tracing.AttributeStep.String(rolloututil.CanaryStepString(*step)),
+	)
+}
@@ -0,0 +1,105 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// newInMemoryTracing records the spans of the test in memory
+func newInMemoryTracing(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+	return exporter
+}
+
+func TestTraceReconcile(t *testing.T) {
+	exporter := newInMemoryTracing(t)
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	traceID := trace.SpanContextFromContext(tracing.RolloutContext(context.TODO(), ro)).TraceID()
+
+	reconcile, ok := spans["rollout.reconcile"]
+	assert.True(t, ok)
+	assert.Equal(t, traceID, reconcile.SpanContext.TraceID())
+	assert.True(t, reconcile.Parent.IsRemote())
+
+	// the traffic router calls are children of the reconciliation
+	for _, name := range []string{"trafficRouting.UpdateHash", "trafficRouting.SetWeight", "trafficRouting.VerifyWeight"} {
+		span, ok := spans[name]
+		if assert.True(t, ok, name) {
+			assert.Equal(t, reconcile.SpanContext.SpanID(), span.Parent.SpanID(), name)
+			assert.Contains(t, span.Attributes, tracing.AttributeTrafficRouter.String("fake"))
+		}
+	}
+
+	// the completed step is a child of the root of the trace of the revision
+	step, ok := spans["rollout.canaryStep"]
+	if assert.True(t, ok) {
+		assert.Equal(t, traceID, step.SpanContext.TraceID())
+		assert.Equal(t, reconcile.Parent.SpanID(), step.Parent.SpanID())
+		assert.Contains(t, step.Attributes, tracing.AttributeStepIndex.Int64(0))
+		assert.Contains(t, step.Attributes, tracing.AttributeStep.String("setWeight: 10"))
+	}
+}
+
+func TestCanaryStepTimes(t *testing.T) {
+	steps := newCanaryStepTimes()
+	start := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, start, steps.observe("1234", "abc", 0, start))
+	assert.Equal(t, start, steps.observe("1234", "abc", 0, start.Add(time.Minute)))
+	// the next step, or a new revision, starts over
+	assert.Equal(t, start.Add(time.Minute), steps.observe("1234", "abc", 1, start.Add(time.Minute)))
+	assert.Equal(t, start.Add(2*time.Minute), steps.observe("1234", "def", 1, start.Add(2*time.Minute)))
+	assert.Equal(t, start, steps.observe("5678", "abc", 1, start))
+
+	// a forgotten rollout starts over, and the other rollouts are kept
+	steps.forget("1234")
+	assert.NotContains(t, steps.starts, types.UID("1234"))
+	assert.Equal(t, start.Add(3*time.Minute), steps.observe("1234", "def", 1, start.Add(3*time.Minute)))
+	assert.Equal(t, start, steps.observe("5678", "abc", 1, start.Add(3*time.Minute)))
+}
+
+func TestForgetCanaryStepOfPromotedRollout(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	r1 = updateCanaryRolloutStatus(r1, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 10, 10, 10, false)
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.rolloutLister = append(f.rolloutLister, r1)
+	f.objects = append(f.objects, r1)
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	c.canarySteps.observe(r1.UID, r1.Status.CurrentPodHash, 0, time.Now())
+	f.expectPatchRolloutAction(r1)
+	f.runController(getKey(r1, t), true, false, c, i, k8sI)
+	assert.NotContains(t, c.canarySteps.starts, r1.UID)
+}
@@ -3,9 +3,12 @@ package rollout
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/plugin"
@@ -16,6 +19,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	a6 "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/gatewayapi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
@@ -26,24 +30,40 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
 // NewTrafficRoutingReconciler identifies return the TrafficRouting Plugin that the rollout wants to modify
 func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
-	rollout := roCtx.rollout
+	rollout := rolloututil.TrafficRoutingRollout(roCtx.rollout)
 	// define an empty list of trafficReconcilers to be populated
 	// by the ones declared in the rolloutContext
 	trafficReconcilers := []trafficrouting.TrafficRoutingReconciler{}
 
-	if rollout.Spec.Strategy.Canary.TrafficRouting == nil {
+	if rollout.Spec.Strategy.Canary == nil || rollout.Spec.Strategy.Canary.TrafficRouting == nil {
 		return nil, nil
 	}
+	dynamicClient := c.dynamicclientset
+	smiClient := c.smiclientset
+	var ingressWrapper trafficrouting.IngressClient = c.ingressWrapper
+	var dryRun *trafficrouting.DryRun
+	if rollout.Spec.Strategy.Canary.TrafficRouting.DryRun {
+		// the changes of the traffic routing objects are recorded instead of applied
+		dryRun = trafficrouting.NewDryRun(roCtx.rollout, c.recorder, &roCtx.newStatus)
+		dynamicClient = dryRun.DynamicClient(dynamicClient)
+		smiClient = dryRun.SMIClient(smiClient)
+		ingressWrapper = dryRun.IngressClient(ingressWrapper)
+	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Istio != nil {
+		istioClient := c.IstioController.DynamicClientSet
+		if dryRun != nil {
+			istioClient = dryRun.DynamicClient(istioClient)
+		}
 		if c.IstioController.VirtualServiceInformer.HasSynced() {
-			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, c.IstioController.DynamicClientSet, c.recorder, c.IstioController.VirtualServiceLister, c.IstioController.DestinationRuleLister))
+			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, istioClient, c.recorder, c.IstioController.VirtualServiceLister, c.IstioController.DestinationRuleLister))
 		} else {
-			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, c.IstioController.DynamicClientSet, c.recorder, nil, nil))
+			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, istioClient, c.recorder, nil, nil))
 		}
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Nginx != nil {
@@ -52,7 +72,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 			Client:         c.kubeclientset,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
-			IngressWrapper: c.ingressWrapper,
+			IngressWrapper: ingressWrapper,
 		}))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.ALB != nil {
@@ -61,7 +81,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 			Client:         c.kubeclientset,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
-			IngressWrapper: c.ingressWrapper,
+			IngressWrapper: ingressWrapper,
 			Status:         &roCtx.newStatus,
 		})
 		if err != nil {
@@ -72,7 +92,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	if rollout.Spec.Strategy.Canary.TrafficRouting.SMI != nil {
 		smi_reconcilier, err := smi.NewReconciler(smi.ReconcilerConfig{
 			Rollout:        rollout,
-			Client:         c.smiclientset,
+			Client:         smiClient,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
 		})
@@ -82,18 +102,18 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		trafficReconcilers = append(trafficReconcilers, smi_reconcilier)
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Ambassador != nil {
-		ac := ambassador.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		ac := ambassador.NewDynamicClient(dynamicClient, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, ambassador.NewReconciler(rollout, ac, c.recorder))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh != nil {
 		trafficReconcilers = append(trafficReconcilers, appmesh.NewReconciler(appmesh.ReconcilerConfig{
 			Rollout:  rollout,
-			Client:   c.dynamicclientset,
+			Client:   dynamicClient,
 			Recorder: c.recorder,
 		}))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Traefik != nil {
-		dynamicClient := traefik.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		dynamicClient := traefik.NewDynamicClient(dynamicClient, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, traefik.NewReconciler(&traefik.ReconcilerConfig{
 			Rollout:  rollout,
 			Client:   dynamicClient,
@@ -102,7 +122,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	}
 
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Apisix != nil {
-		dynamicClient := a6util.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		dynamicClient := a6util.NewDynamicClient(dynamicClient, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, a6.NewReconciler(&a6.ReconcilerConfig{
 			Rollout:  rollout,
 			Client:   dynamicClient,
@@ -110,6 +130,20 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		}))
 	}
 
+	if rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI != nil {
+		trafficReconcilers = append(trafficReconcilers, gatewayapi.NewReconciler(&gatewayapi.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   dynamicClient,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if dryRun != nil {
+		for i := range trafficReconcilers {
+			trafficReconcilers[i] = dryRun.Reconciler(trafficReconcilers[i])
+		}
+	}
+
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Plugins != nil {
 		for pluginName := range rollout.Spec.Strategy.Canary.TrafficRouting.Plugins {
 			pluginReconciler, err := plugin.NewReconciler(&plugin.ReconcilerConfig{
@@ -121,6 +155,10 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 			if err != nil {
 				return trafficReconcilers, err
 			}
+			if dryRun != nil {
+				trafficReconcilers = append(trafficReconcilers, dryRun.PluginReconciler(pluginReconciler))
+				continue
+			}
 			trafficReconcilers = append(trafficReconcilers, pluginReconciler)
 		}
 	}
@@ -133,6 +171,17 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	return nil, nil
 }
 
+// isTrafficRoutingDryRun returns whether the traffic routing of the rollout is in dry-run mode
+func isTrafficRoutingDryRun(rollout *v1alpha1.Rollout) bool {
+	if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.TrafficRouting != nil {
+		return rollout.Spec.Strategy.Canary.TrafficRouting.DryRun
+	}
+	if rollout.Spec.Strategy.BlueGreen != nil && rollout.Spec.Strategy.BlueGreen.TrafficRouting != nil {
+		return rollout.Spec.Strategy.BlueGreen.TrafficRouting.DryRun
+	}
+	return false
+}
+
 // this currently only be used in the canary strategy
 func (c *rolloutContext) reconcileTrafficRouting() error {
 	reconcilers, err := c.newTrafficRoutingReconciler(c)
@@ -151,6 +200,7 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 	c.log.Infof("Found %d TrafficRouting Reconcilers", len(reconcilers))
 	// iterate over the list of trafficReconcilers
 	for _, reconciler := range reconcilers {
+		reconciler = trafficrouting.NewTracingReconciler(c.ctx, reconciler)
 		c.log.Infof("Reconciling TrafficRouting with type '%s'", reconciler.Type())
 
 		currentStep, index := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -218,7 +268,7 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 				// Use the previous weight since the new RS is not ready for a new weight
 				for i := *index - 1; i >= 0; i-- {
 					step := c.rollout.Spec.Strategy.Canary.Steps[i]
-					if step.SetWeight != nil {
+					if step.SetWeight != nil && !replicasetutil.IsCanaryStepSkipped(c.rollout, i) {
 						desiredWeight = *step.SetWeight
 						break
 					}
@@ -243,11 +293,23 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 					return err
 				}
 			}
+			for _, name := range staleShadowRoutes(c.rollout, currentStep) {
+				// the mirror route of a shadow step is removed once the rollout is at another step, which may not
+				// be the next one when the following steps were skipped or a branch jumped
+				if err = reconciler.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: name}); err != nil {
+					return err
+				}
+			}
 			if currentStep.SetMirrorRoute != nil {
 				if err = reconciler.SetMirrorRoute(currentStep.SetMirrorRoute); err != nil {
 					return err
 				}
 			}
+			if currentStep.Shadow != nil {
+				if err = reconciler.SetMirrorRoute(shadowMirrorRoute(currentStep.Shadow)); err != nil {
+					return err
+				}
+			}
 		}
 
 		err = reconciler.UpdateHash(canaryHash, stableHash, weightDestinations...)
@@ -300,6 +362,33 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 	return nil
 }
 
+// calculateWeightVerifiedCondition tracks in the WeightVerified condition since when the traffic router has not
+// verified the traffic weights, and reports when the weight verify timeout is exceeded
+func (c *rolloutContext) calculateWeightVerifiedCondition(newStatus *v1alpha1.RolloutStatus) {
+	timeout := defaults.GetWeightVerifyTimeout()
+	weights := newStatus.Canary.Weights
+	if c.rollout.Spec.Strategy.BlueGreen != nil {
+		weights = newStatus.BlueGreen.Weights
+	}
+	if timeout <= 0 || weights == nil || weights.Verified == nil || *weights.Verified {
+		conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified)
+		return
+	}
+	notVerifiedMsg := fmt.Sprintf(conditions.WeightNotVerifiedMessage, weights.Canary.Weight)
+	timeoutMsg := fmt.Sprintf(conditions.WeightVerifyTimeoutMessage, weights.Canary.Weight, timeout)
+	cond := conditions.GetRolloutCondition(*newStatus, v1alpha1.RolloutWeightVerified)
+	if cond == nil || (cond.Message != notVerifiedMsg && cond.Message != timeoutMsg) {
+		// the verification of another weight started
+		conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified)
+		conditions.SetRolloutCondition(newStatus, *conditions.NewRolloutCondition(v1alpha1.RolloutWeightVerified, corev1.ConditionFalse, conditions.WeightNotVerifiedReason, notVerifiedMsg))
+		return
+	}
+	if cond.Reason == conditions.WeightNotVerifiedReason && timeutil.Now().Sub(cond.LastTransitionTime.Time) > timeout {
+		conditions.SetRolloutCondition(newStatus, *conditions.NewRolloutCondition(v1alpha1.RolloutWeightVerified, corev1.ConditionFalse, conditions.WeightVerifyTimeoutReason, timeoutMsg))
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.WeightVerifyTimeoutReason}, timeoutMsg)
+	}
+}
+
 // calculateDesiredWeightOnAbortOrStableRollback returns the desired weight to use when we are either
 // aborting, or rolling back to stable RS.
 func (c *rolloutContext) calculateDesiredWeightOnAbortOrStableRollback() int32 {
@@ -388,3 +477,32 @@ func (c *rolloutContext) calculateWeightDestinationsFromExperiment() []v1alpha1.
 	}
 	return weightDestinations
 }
+
+// shadowMirrorRoute returns the mirror route of the shadow step, which mirrors the matching requests to the
+// response-diff proxy
+func shadowMirrorRoute(shadow *v1alpha1.RolloutShadowStep) *v1alpha1.SetMirrorRoute {
+	return &v1alpha1.SetMirrorRoute{
+		Name:       shadow.Name,
+		Match:      shadow.Match,
+		Service:    shadow.ProxyService,
+		Percentage: shadow.Percentage,
+	}
+}
+
+// staleShadowRoutes returns the names of the mirror routes of the shadow steps of the rollout, except the route
+// the current step mirrors the traffic on
+func staleShadowRoutes(ro *v1alpha1.Rollout, currentStep *v1alpha1.CanaryStep) []string {
+	current := ""
+	if currentStep.Shadow != nil {
+		current = currentStep.Shadow.Name
+	} else if currentStep.SetMirrorRoute != nil {
+		current = currentStep.SetMirrorRoute.Name
+	}
+	var names []string
+	for _, step := range ro.Spec.Strategy.Canary.Steps {
+		if step.Shadow != nil && step.Shadow.Name != current && !slices.Contains(names, step.Shadow.Name) {
+			names = append(names, step.Shadow.Name)
+		}
+	}
+	return names
+}
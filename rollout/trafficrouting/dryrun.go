@@ -0,0 +1,330 @@
+package trafficrouting
+
+import (
+	"context"
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const (
+	// maxEventDiffLength is the length of the diffs in the events, which are truncated since the
+	// complete diffs are in the status of the rollout
+	maxEventDiffLength = 512
+)
+
+// DryRun records the changes of the traffic routing objects of a rollout instead of applying them,
+// when the traffic routing of the rollout is in dry-run mode. The changes of a reconciliation are
+// logged and recorded in the status of the rollout, and an event is emitted for the changes which
+// were not recorded by the previous reconciliation.
+type DryRun struct {
+	rollout  *v1alpha1.Rollout
+	recorder record.EventRecorder
+	status   *v1alpha1.RolloutStatus
+	log      *log.Entry
+}
+
+// NewDryRun returns a DryRun which records the changes in the given status of the rollout
+func NewDryRun(rollout *v1alpha1.Rollout, recorder record.EventRecorder, status *v1alpha1.RolloutStatus) *DryRun {
+	status.TrafficRoutingDryRun = nil
+	return &DryRun{
+		rollout:  rollout,
+		recorder: recorder,
+		status:   status,
+		log:      logutil.WithRollout(rollout),
+	}
+}
+
+func (d *DryRun) record(change v1alpha1.TrafficRoutingChange) {
+	for _, recorded := range d.status.TrafficRoutingDryRun {
+		if recorded == change {
+			return
+		}
+	}
+	d.status.TrafficRoutingDryRun = append(d.status.TrafficRoutingDryRun, change)
+	d.log.Infof("Dry-run: %s of %s '%s' not applied: %s", change.Operation, change.Kind, change.Name, change.Diff)
+
+	for _, previous := range d.rollout.Status.TrafficRoutingDryRun {
+		if previous == change {
+			return
+		}
+	}
+	diff := change.Diff
+	if len(diff) > maxEventDiffLength {
+		diff = diff[:maxEventDiffLength] + "..."
+	}
+	d.recorder.Eventf(d.rollout, record.EventOptions{EventReason: conditions.TrafficRoutingDryRunReason}, conditions.TrafficRoutingDryRunMessage, change.Operation, change.Kind, change.Name, diff)
+}
+
+// recordObject records a change whose diff is the given object
+func (d *DryRun) recordObject(operation, kind, name string, obj any) error {
+	diff, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	d.record(v1alpha1.TrafficRoutingChange{Operation: operation, Kind: kind, Name: name, Diff: string(diff)})
+	return nil
+}
+
+// recordUpdate records an update whose diff is the JSON merge patch from the live to the desired
+// object. Updates which do not change the object are not recorded.
+func (d *DryRun) recordUpdate(kind, name string, live, desired any) error {
+	liveJSON, err := json.Marshal(live)
+	if err != nil {
+		return err
+	}
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return err
+	}
+	patch, err := jsonpatch.CreateMergePatch(liveJSON, desiredJSON)
+	if err != nil {
+		return err
+	}
+	if string(patch) == "{}" {
+		return nil
+	}
+	d.record(v1alpha1.TrafficRoutingChange{Operation: "Update", Kind: kind, Name: name, Diff: string(patch)})
+	return nil
+}
+
+// dryRunReconciler does not verify the weight of a TrafficRoutingReconciler whose changes are not
+// applied, and records the calls of the reconcilers whose clients are not dry-run
+type dryRunReconciler struct {
+	TrafficRoutingReconciler
+	dryRun *DryRun
+	// recordCalls records the calls which change the traffic routing instead of making them
+	recordCalls bool
+	routerType  string
+}
+
+// Reconciler returns a TrafficRoutingReconciler which does not verify the weight, since the changes
+// of the given reconciler, made through dry-run clients, are not applied
+func (d *DryRun) Reconciler(reconciler TrafficRoutingReconciler) TrafficRoutingReconciler {
+	return &dryRunReconciler{TrafficRoutingReconciler: reconciler, dryRun: d}
+}
+
+// PluginReconciler returns a TrafficRoutingReconciler which records the calls of a traffic router
+// plugin instead of making them, since the changes of the plugin cannot be computed
+func (d *DryRun) PluginReconciler(reconciler TrafficRoutingReconciler) TrafficRoutingReconciler {
+	return &dryRunReconciler{TrafficRoutingReconciler: reconciler, dryRun: d, recordCalls: true, routerType: reconciler.Type()}
+}
+
+func (r *dryRunReconciler) recordCall(method string, args map[string]any) error {
+	return r.dryRun.recordObject(method, "Plugin", r.routerType, args)
+}
+
+func (r *dryRunReconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	if !r.recordCalls {
+		return r.TrafficRoutingReconciler.UpdateHash(canaryHash, stableHash, additionalDestinations...)
+	}
+	return r.recordCall("UpdateHash", map[string]any{"canaryHash": canaryHash, "stableHash": stableHash, "additionalDestinations": additionalDestinations})
+}
+
+func (r *dryRunReconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	if !r.recordCalls {
+		return r.TrafficRoutingReconciler.SetWeight(desiredWeight, additionalDestinations...)
+	}
+	return r.recordCall("SetWeight", map[string]any{"desiredWeight": desiredWeight, "additionalDestinations": additionalDestinations})
+}
+
+func (r *dryRunReconciler) SetHeaderRoute(setHeaderRoute *v1alpha1.SetHeaderRoute) error {
+	if !r.recordCalls {
+		return r.TrafficRoutingReconciler.SetHeaderRoute(setHeaderRoute)
+	}
+	return r.recordCall("SetHeaderRoute", map[string]any{"setHeaderRoute": setHeaderRoute})
+}
+
+func (r *dryRunReconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	if !r.recordCalls {
+		return r.TrafficRoutingReconciler.SetMirrorRoute(setMirrorRoute)
+	}
+	return r.recordCall("SetMirrorRoute", map[string]any{"setMirrorRoute": setMirrorRoute})
+}
+
+func (r *dryRunReconciler) RemoveManagedRoutes() error {
+	if !r.recordCalls {
+		return r.TrafficRoutingReconciler.RemoveManagedRoutes()
+	}
+	return r.recordCall("RemoveManagedRoutes", map[string]any{})
+}
+
+// VerifyWeight returns nil since the weight is not applicable in dry-run mode
+func (r *dryRunReconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	return nil, nil
+}
+
+// DynamicClient returns a dynamic client which records the changes of the objects instead of making them
+func (d *DryRun) DynamicClient(client dynamic.Interface) dynamic.Interface {
+	return &dryRunDynamicClient{Interface: client, dryRun: d}
+}
+
+type dryRunDynamicClient struct {
+	dynamic.Interface
+	dryRun *DryRun
+}
+
+func (c *dryRunDynamicClient) Resource(resource schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := c.Interface.Resource(resource)
+	return &dryRunNamespaceableResourceClient{
+		dryRunResourceClient: dryRunResourceClient{ResourceInterface: client, resource: resource, dryRun: c.dryRun},
+		client:               client,
+	}
+}
+
+type dryRunNamespaceableResourceClient struct {
+	dryRunResourceClient
+	client dynamic.NamespaceableResourceInterface
+}
+
+func (c *dryRunNamespaceableResourceClient) Namespace(namespace string) dynamic.ResourceInterface {
+	return &dryRunResourceClient{ResourceInterface: c.client.Namespace(namespace), resource: c.resource, dryRun: c.dryRun}
+}
+
+type dryRunResourceClient struct {
+	dynamic.ResourceInterface
+	resource schema.GroupVersionResource
+	dryRun   *DryRun
+}
+
+// withoutServerFields returns a copy of the object without the fields set by the API server, which
+// are not part of the diffs
+func withoutServerFields(obj *unstructured.Unstructured) map[string]any {
+	obj = obj.DeepCopy()
+	for _, field := range []string{"resourceVersion", "generation", "uid", "creationTimestamp", "managedFields"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	return obj.Object
+}
+
+// typedWithoutServerFields returns a typed object as an unstructured object without the fields set
+// by the API server
+func typedWithoutServerFields(obj any) (map[string]any, error) {
+	un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return withoutServerFields(&unstructured.Unstructured{Object: un}), nil
+}
+
+func (c *dryRunResourceClient) kind(obj *unstructured.Unstructured) string {
+	if obj.GetKind() != "" {
+		return obj.GetKind()
+	}
+	return c.resource.Resource
+}
+
+func (c *dryRunResourceClient) Create(ctx context.Context, obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := c.dryRun.recordObject("Create", c.kind(obj), obj.GetName(), withoutServerFields(obj)); err != nil {
+		return nil, err
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (c *dryRunResourceClient) Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	live, err := c.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.dryRun.recordUpdate(c.kind(live), obj.GetName(), withoutServerFields(live), withoutServerFields(obj)); err != nil {
+		return nil, err
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (c *dryRunResourceClient) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return c.Update(ctx, obj, options)
+}
+
+func (c *dryRunResourceClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	live, err := c.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.dryRun.record(v1alpha1.TrafficRoutingChange{Operation: "Patch", Kind: c.kind(live), Name: name, Diff: string(data)})
+	return live, nil
+}
+
+func (c *dryRunResourceClient) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if err := c.dryRun.recordObject("Apply", c.kind(obj), name, withoutServerFields(obj)); err != nil {
+		return nil, err
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (c *dryRunResourceClient) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions) (*unstructured.Unstructured, error) {
+	return c.Apply(ctx, name, obj, options)
+}
+
+func (c *dryRunResourceClient) Delete(ctx context.Context, name string, options metav1.DeleteOptions, subresources ...string) error {
+	live, err := c.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	c.dryRun.record(v1alpha1.TrafficRoutingChange{Operation: "Delete", Kind: c.kind(live), Name: name})
+	return nil
+}
+
+func (c *dryRunResourceClient) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	c.dryRun.record(v1alpha1.TrafficRoutingChange{Operation: "DeleteCollection", Kind: c.resource.Resource, Diff: listOptions.LabelSelector})
+	return nil
+}
+
+// IngressClient is the client of the ingresses of the Nginx and ALB reconcilers
+type IngressClient interface {
+	Get(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*ingressutil.Ingress, error)
+	GetCached(namespace, name string) (*ingressutil.Ingress, error)
+	Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*ingressutil.Ingress, error)
+	Create(ctx context.Context, namespace string, ingress *ingressutil.Ingress, opts metav1.CreateOptions) (*ingressutil.Ingress, error)
+}
+
+// IngressClient returns an ingress client which records the changes of the ingresses instead of making them
+func (d *DryRun) IngressClient(client IngressClient) IngressClient {
+	return &dryRunIngressClient{IngressClient: client, dryRun: d}
+}
+
+type dryRunIngressClient struct {
+	IngressClient
+	dryRun *DryRun
+}
+
+func (c *dryRunIngressClient) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*ingressutil.Ingress, error) {
+	live, err := c.Get(ctx, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c.dryRun.record(v1alpha1.TrafficRoutingChange{Operation: "Patch", Kind: "Ingress", Name: name, Diff: string(data)})
+	return live, nil
+}
+
+func (c *dryRunIngressClient) Create(ctx context.Context, namespace string, ingress *ingressutil.Ingress, opts metav1.CreateOptions) (*ingressutil.Ingress, error) {
+	var obj map[string]any
+	var err error
+	if networkingIngress, networkingErr := ingress.GetNetworkingIngress(); networkingErr == nil {
+		obj, err = typedWithoutServerFields(networkingIngress)
+	} else if extensionsIngress, extensionsErr := ingress.GetExtensionsIngress(); extensionsErr == nil {
+		obj, err = typedWithoutServerFields(extensionsIngress)
+	} else {
+		err = extensionsErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := c.dryRun.recordObject("Create", "Ingress", ingress.GetName(), obj); err != nil {
+		return nil, err
+	}
+	return ingress.DeepCopy(), nil
+}
@@ -0,0 +1,183 @@
+package trafficrouting
+
+import (
+	"context"
+
+	smiv1alpha1 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha1"
+	smiv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	smiv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha3"
+	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	splitv1alpha1 "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha1"
+	splitv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha2"
+	splitv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const trafficSplitKind = "TrafficSplit"
+
+// SMIClient returns an SMI client which records the changes of the TrafficSplits instead of making
+// them. Only the versions of TrafficSplits supported by the SMI reconciler are dry-run.
+func (d *DryRun) SMIClient(client smiclientset.Interface) smiclientset.Interface {
+	return &dryRunSMIClient{Interface: client, dryRun: d}
+}
+
+type dryRunSMIClient struct {
+	smiclientset.Interface
+	dryRun *DryRun
+}
+
+func (c *dryRunSMIClient) SplitV1alpha1() splitv1alpha1.SplitV1alpha1Interface {
+	return &dryRunSplitV1alpha1{SplitV1alpha1Interface: c.Interface.SplitV1alpha1(), dryRun: c.dryRun}
+}
+
+func (c *dryRunSMIClient) SplitV1alpha2() splitv1alpha2.SplitV1alpha2Interface {
+	return &dryRunSplitV1alpha2{SplitV1alpha2Interface: c.Interface.SplitV1alpha2(), dryRun: c.dryRun}
+}
+
+func (c *dryRunSMIClient) SplitV1alpha3() splitv1alpha3.SplitV1alpha3Interface {
+	return &dryRunSplitV1alpha3{SplitV1alpha3Interface: c.Interface.SplitV1alpha3(), dryRun: c.dryRun}
+}
+
+// createTrafficSplit records the creation of a TrafficSplit of any version
+func createTrafficSplit[T any](d *DryRun, name string, ts *T) (*T, error) {
+	obj, err := typedWithoutServerFields(ts)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.recordObject("Create", trafficSplitKind, name, obj); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// updateTrafficSplit records the update of a TrafficSplit of any version
+func updateTrafficSplit[T any](ctx context.Context, d *DryRun, get func(context.Context, string, metav1.GetOptions) (*T, error), name string, ts *T) (*T, error) {
+	live, err := get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	liveObj, err := typedWithoutServerFields(live)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := typedWithoutServerFields(ts)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.recordUpdate(trafficSplitKind, name, liveObj, obj); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// patchTrafficSplit records the patch of a TrafficSplit of any version
+func patchTrafficSplit[T any](ctx context.Context, d *DryRun, get func(context.Context, string, metav1.GetOptions) (*T, error), name string, data []byte) (*T, error) {
+	live, err := get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	d.record(v1alpha1.TrafficRoutingChange{Operation: "Patch", Kind: trafficSplitKind, Name: name, Diff: string(data)})
+	return live, nil
+}
+
+// deleteTrafficSplit records the deletion of a TrafficSplit of any version
+func deleteTrafficSplit[T any](ctx context.Context, d *DryRun, get func(context.Context, string, metav1.GetOptions) (*T, error), name string) error {
+	if _, err := get(ctx, name, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	d.record(v1alpha1.TrafficRoutingChange{Operation: "Delete", Kind: trafficSplitKind, Name: name})
+	return nil
+}
+
+type dryRunSplitV1alpha1 struct {
+	splitv1alpha1.SplitV1alpha1Interface
+	dryRun *DryRun
+}
+
+func (c *dryRunSplitV1alpha1) TrafficSplits(namespace string) splitv1alpha1.TrafficSplitInterface {
+	return &dryRunTrafficSplitsV1alpha1{TrafficSplitInterface: c.SplitV1alpha1Interface.TrafficSplits(namespace), dryRun: c.dryRun}
+}
+
+type dryRunTrafficSplitsV1alpha1 struct {
+	splitv1alpha1.TrafficSplitInterface
+	dryRun *DryRun
+}
+
+func (c *dryRunTrafficSplitsV1alpha1) Create(ctx context.Context, ts *smiv1alpha1.TrafficSplit, opts metav1.CreateOptions) (*smiv1alpha1.TrafficSplit, error) {
+	return createTrafficSplit(c.dryRun, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha1) Update(ctx context.Context, ts *smiv1alpha1.TrafficSplit, opts metav1.UpdateOptions) (*smiv1alpha1.TrafficSplit, error) {
+	return updateTrafficSplit(ctx, c.dryRun, c.Get, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha1) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*smiv1alpha1.TrafficSplit, error) {
+	return patchTrafficSplit(ctx, c.dryRun, c.Get, name, data)
+}
+
+func (c *dryRunTrafficSplitsV1alpha1) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return deleteTrafficSplit(ctx, c.dryRun, c.Get, name)
+}
+
+type dryRunSplitV1alpha2 struct {
+	splitv1alpha2.SplitV1alpha2Interface
+	dryRun *DryRun
+}
+
+func (c *dryRunSplitV1alpha2) TrafficSplits(namespace string) splitv1alpha2.TrafficSplitInterface {
+	return &dryRunTrafficSplitsV1alpha2{TrafficSplitInterface: c.SplitV1alpha2Interface.TrafficSplits(namespace), dryRun: c.dryRun}
+}
+
+type dryRunTrafficSplitsV1alpha2 struct {
+	splitv1alpha2.TrafficSplitInterface
+	dryRun *DryRun
+}
+
+func (c *dryRunTrafficSplitsV1alpha2) Create(ctx context.Context, ts *smiv1alpha2.TrafficSplit, opts metav1.CreateOptions) (*smiv1alpha2.TrafficSplit, error) {
+	return createTrafficSplit(c.dryRun, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha2) Update(ctx context.Context, ts *smiv1alpha2.TrafficSplit, opts metav1.UpdateOptions) (*smiv1alpha2.TrafficSplit, error) {
+	return updateTrafficSplit(ctx, c.dryRun, c.Get, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha2) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*smiv1alpha2.TrafficSplit, error) {
+	return patchTrafficSplit(ctx, c.dryRun, c.Get, name, data)
+}
+
+func (c *dryRunTrafficSplitsV1alpha2) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return deleteTrafficSplit(ctx, c.dryRun, c.Get, name)
+}
+
+type dryRunSplitV1alpha3 struct {
+	splitv1alpha3.SplitV1alpha3Interface
+	dryRun *DryRun
+}
+
+func (c *dryRunSplitV1alpha3) TrafficSplits(namespace string) splitv1alpha3.TrafficSplitInterface {
+	return &dryRunTrafficSplitsV1alpha3{TrafficSplitInterface: c.SplitV1alpha3Interface.TrafficSplits(namespace), dryRun: c.dryRun}
+}
+
+type dryRunTrafficSplitsV1alpha3 struct {
+	splitv1alpha3.TrafficSplitInterface
+	dryRun *DryRun
+}
+
+func (c *dryRunTrafficSplitsV1alpha3) Create(ctx context.Context, ts *smiv1alpha3.TrafficSplit, opts metav1.CreateOptions) (*smiv1alpha3.TrafficSplit, error) {
+	return createTrafficSplit(c.dryRun, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha3) Update(ctx context.Context, ts *smiv1alpha3.TrafficSplit, opts metav1.UpdateOptions) (*smiv1alpha3.TrafficSplit, error) {
+	return updateTrafficSplit(ctx, c.dryRun, c.Get, ts.Name, ts)
+}
+
+func (c *dryRunTrafficSplitsV1alpha3) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*smiv1alpha3.TrafficSplit, error) {
+	return patchTrafficSplit(ctx, c.dryRun, c.Get, name, data)
+}
+
+func (c *dryRunTrafficSplitsV1alpha3) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return deleteTrafficSplit(ctx, c.dryRun, c.Get, name)
+}
@@ -0,0 +1,230 @@
+package trafficrouting
+
+import (
+	"context"
+	"testing"
+
+	smiv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha3"
+	smifake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+var vsvcGVR = schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1alpha3", Resource: "virtualservices"}
+
+func newDryRunRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+	}
+}
+
+func newVirtualService(weight int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"kind":       "VirtualService",
+		"metadata": map[string]any{
+			"name":            "guestbook",
+			"namespace":       metav1.NamespaceDefault,
+			"resourceVersion": "1",
+		},
+		"spec": map[string]any{
+			"http": []any{map[string]any{
+				"route": []any{
+					map[string]any{"destination": map[string]any{"host": "stable"}, "weight": 100 - weight},
+					map[string]any{"destination": map[string]any{"host": "canary"}, "weight": weight},
+				},
+			}},
+		},
+	}}
+}
+
+func assertNoMutations(t *testing.T, actions []kubetesting.Action) {
+	for _, action := range actions {
+		assert.Contains(t, []string{"get", "list", "watch"}, action.GetVerb())
+	}
+}
+
+func TestDryRunDynamicClient(t *testing.T) {
+	ro := newDryRunRollout()
+	recorder := record.NewFakeEventRecorder()
+	status := &v1alpha1.RolloutStatus{}
+	dryRun := NewDryRun(ro, recorder, status)
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newVirtualService(0))
+	vsvcIf := dryRun.DynamicClient(client).Resource(vsvcGVR).Namespace(metav1.NamespaceDefault)
+
+	desired := newVirtualService(30)
+	desired.SetResourceVersion("")
+	updated, err := vsvcIf.Update(context.TODO(), desired, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, desired, updated)
+
+	// an update without changes is not recorded
+	_, err = vsvcIf.Update(context.TODO(), newVirtualService(0), metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	_, err = vsvcIf.Patch(context.TODO(), "guestbook", types.MergePatchType, []byte(`{"metadata":{"labels":{"a":"b"}}}`), metav1.PatchOptions{})
+	assert.NoError(t, err)
+	canary := newVirtualService(0)
+	canary.SetName("guestbook-canary")
+	_, err = vsvcIf.Create(context.TODO(), canary, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, vsvcIf.Delete(context.TODO(), "guestbook", metav1.DeleteOptions{}))
+	assert.Error(t, vsvcIf.Delete(context.TODO(), "unknown", metav1.DeleteOptions{}))
+
+	assertNoMutations(t, client.Actions())
+	live, err := client.Resource(vsvcGVR).Namespace(metav1.NamespaceDefault).Get(context.TODO(), "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, newVirtualService(0), live)
+
+	assert.Len(t, status.TrafficRoutingDryRun, 4)
+	assert.Equal(t, v1alpha1.TrafficRoutingChange{
+		Operation: "Update",
+		Kind:      "VirtualService",
+		Name:      "guestbook",
+		Diff:      `{"spec":{"http":[{"route":[{"destination":{"host":"stable"},"weight":70},{"destination":{"host":"canary"},"weight":30}]}]}}`,
+	}, status.TrafficRoutingDryRun[0])
+	assert.Equal(t, v1alpha1.TrafficRoutingChange{Operation: "Patch", Kind: "VirtualService", Name: "guestbook", Diff: `{"metadata":{"labels":{"a":"b"}}}`}, status.TrafficRoutingDryRun[1])
+	assert.Equal(t, "Create", status.TrafficRoutingDryRun[2].Operation)
+	assert.Equal(t, "guestbook-canary", status.TrafficRoutingDryRun[2].Name)
+	assert.NotContains(t, status.TrafficRoutingDryRun[2].Diff, "resourceVersion")
+	assert.Equal(t, v1alpha1.TrafficRoutingChange{Operation: "Delete", Kind: "VirtualService", Name: "guestbook"}, status.TrafficRoutingDryRun[3])
+	assert.Equal(t, []string{
+		conditions.TrafficRoutingDryRunReason,
+		conditions.TrafficRoutingDryRunReason,
+		conditions.TrafficRoutingDryRunReason,
+		conditions.TrafficRoutingDryRunReason,
+	}, recorder.Events())
+}
+
+func TestDryRunEventsOnlyForNewChanges(t *testing.T) {
+	ro := newDryRunRollout()
+	ro.Status.TrafficRoutingDryRun = []v1alpha1.TrafficRoutingChange{{Operation: "Delete", Kind: "VirtualService", Name: "guestbook"}}
+	recorder := record.NewFakeEventRecorder()
+	status := ro.Status.DeepCopy()
+	dryRun := NewDryRun(ro, recorder, status)
+	assert.Nil(t, status.TrafficRoutingDryRun)
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newVirtualService(0))
+	vsvcIf := dryRun.DynamicClient(client).Resource(vsvcGVR).Namespace(metav1.NamespaceDefault)
+	assert.NoError(t, vsvcIf.Delete(context.TODO(), "guestbook", metav1.DeleteOptions{}))
+	assert.NoError(t, vsvcIf.Delete(context.TODO(), "guestbook", metav1.DeleteOptions{}))
+	assert.Equal(t, ro.Status.TrafficRoutingDryRun, status.TrafficRoutingDryRun)
+	assert.Empty(t, recorder.Events())
+
+	_, err := vsvcIf.Patch(context.TODO(), "guestbook", types.MergePatchType, []byte(`{}`), metav1.PatchOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, status.TrafficRoutingDryRun, 2)
+	assert.Equal(t, []string{conditions.TrafficRoutingDryRunReason}, recorder.Events())
+}
+
+func TestDryRunIngressClient(t *testing.T) {
+	ro := newDryRunRollout()
+	status := &v1alpha1.RolloutStatus{}
+	dryRun := NewDryRun(ro, record.NewFakeEventRecorder(), status)
+	stable := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault}}
+	kubeClient := k8sfake.NewSimpleClientset(stable)
+	ingressWrapper, err := ingressutil.NewIngressWrapper(ingressutil.IngressModeNetworking, kubeClient, k8sinformers.NewSharedInformerFactory(kubeClient, 0))
+	assert.NoError(t, err)
+	client := dryRun.IngressClient(ingressWrapper)
+
+	patch := []byte(`{"metadata":{"annotations":{"alb.ingress.kubernetes.io/actions.guestbook":"{}"}}}`)
+	_, err = client.Patch(context.TODO(), metav1.NamespaceDefault, "guestbook", types.MergePatchType, patch, metav1.PatchOptions{})
+	assert.NoError(t, err)
+	canary := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-canary", Namespace: metav1.NamespaceDefault}}
+	created, err := client.Create(context.TODO(), metav1.NamespaceDefault, ingressutil.NewIngress(canary), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "guestbook-canary", created.GetName())
+
+	assertNoMutations(t, kubeClient.Actions())
+	assert.Equal(t, []v1alpha1.TrafficRoutingChange{
+		{Operation: "Patch", Kind: "Ingress", Name: "guestbook", Diff: string(patch)},
+		{Operation: "Create", Kind: "Ingress", Name: "guestbook-canary", Diff: `{"metadata":{"name":"guestbook-canary","namespace":"default"},"spec":{},"status":{"loadBalancer":{}}}`},
+	}, status.TrafficRoutingDryRun)
+}
+
+func TestDryRunSMIClient(t *testing.T) {
+	ro := newDryRunRollout()
+	status := &v1alpha1.RolloutStatus{}
+	dryRun := NewDryRun(ro, record.NewFakeEventRecorder(), status)
+	ts := &smiv1alpha3.TrafficSplit{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+		Spec:       smiv1alpha3.TrafficSplitSpec{Service: "guestbook", Backends: []smiv1alpha3.TrafficSplitBackend{{Service: "stable", Weight: 100}}},
+	}
+	smiClient := smifake.NewSimpleClientset(ts)
+	tsIf := dryRun.SMIClient(smiClient).SplitV1alpha3().TrafficSplits(metav1.NamespaceDefault)
+
+	desired := ts.DeepCopy()
+	desired.Spec.Backends = []smiv1alpha3.TrafficSplitBackend{{Service: "stable", Weight: 80}, {Service: "canary", Weight: 20}}
+	_, err := tsIf.Update(context.TODO(), desired, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	_, err = tsIf.Patch(context.TODO(), "guestbook", types.MergePatchType, []byte(`{"spec":{"backends":[]}}`), metav1.PatchOptions{})
+	assert.NoError(t, err)
+	other := ts.DeepCopy()
+	other.Name = "other"
+	_, err = tsIf.Create(context.TODO(), other, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	assertNoMutations(t, smiClient.Actions())
+	assert.Len(t, status.TrafficRoutingDryRun, 3)
+	assert.Equal(t, v1alpha1.TrafficRoutingChange{
+		Operation: "Update",
+		Kind:      "TrafficSplit",
+		Name:      "guestbook",
+		Diff:      `{"spec":{"backends":[{"service":"stable","weight":80},{"service":"canary","weight":20}]}}`,
+	}, status.TrafficRoutingDryRun[0])
+	assert.Equal(t, "Patch", status.TrafficRoutingDryRun[1].Operation)
+	assert.Equal(t, "Create", status.TrafficRoutingDryRun[2].Operation)
+	assert.Equal(t, "other", status.TrafficRoutingDryRun[2].Name)
+}
+
+// fakePluginReconciler fails every call which changes the traffic routing
+type fakePluginReconciler struct {
+	TrafficRoutingReconciler
+}
+
+func (r *fakePluginReconciler) Type() string {
+	return "argoproj-labs/fake"
+}
+
+func TestDryRunReconciler(t *testing.T) {
+	ro := newDryRunRollout()
+	status := &v1alpha1.RolloutStatus{}
+	dryRun := NewDryRun(ro, record.NewFakeEventRecorder(), status)
+
+	reconciler := dryRun.PluginReconciler(&fakePluginReconciler{})
+	assert.Equal(t, "argoproj-labs/fake", reconciler.Type())
+	assert.NoError(t, reconciler.UpdateHash("canary-hash", "stable-hash"))
+	assert.NoError(t, reconciler.SetWeight(20))
+	assert.NoError(t, reconciler.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header"}))
+	assert.NoError(t, reconciler.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror"}))
+	assert.NoError(t, reconciler.RemoveManagedRoutes())
+	verified, err := reconciler.VerifyWeight(20)
+	assert.NoError(t, err)
+	assert.Nil(t, verified)
+
+	assert.Len(t, status.TrafficRoutingDryRun, 5)
+	assert.Equal(t, v1alpha1.TrafficRoutingChange{
+		Operation: "SetWeight",
+		Kind:      "Plugin",
+		Name:      "argoproj-labs/fake",
+		Diff:      `{"additionalDestinations":null,"desiredWeight":20}`,
+	}, status.TrafficRoutingDryRun[1])
+
+	verified, err = dryRun.Reconciler(&fakePluginReconciler{}).VerifyWeight(20)
+	assert.NoError(t, err)
+	assert.Nil(t, verified)
+}
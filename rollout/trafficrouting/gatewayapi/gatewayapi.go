@@ -0,0 +1,764 @@
+package gatewayapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "GatewayAPI"
+
+const (
+	HTTPRouteKind = "HTTPRoute"
+	GRPCRouteKind = "GRPCRoute"
+	TCPRouteKind  = "TCPRoute"
+
+	httpRoutes = "httproutes"
+	grpcRoutes = "grpcroutes"
+	tcpRoutes  = "tcproutes"
+
+	// ManagedRulesAnnotation records which rules of a route were added by the rollout, keyed by
+	// the name of the managed route and valued by the index of the rule in spec.rules
+	ManagedRulesAnnotation = "rollouts.argoproj.io/gatewayapi-managed-rules"
+
+	GatewayAPIUpdateError = "GatewayAPIUpdateError"
+)
+
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+type Reconciler struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+	Log      *logrus.Entry
+}
+
+// route identifies a single route object referenced by the rollout
+type route struct {
+	kind string
+	name string
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Rollout:  cfg.Rollout,
+		Client:   cfg.Client,
+		Recorder: cfg.Recorder,
+		Log:      logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+// GetHTTPRouteGVR returns the GroupVersionResource of the Gateway API HTTPRoute
+func GetHTTPRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultGatewayAPIGroup,
+		Version:  defaults.GetGatewayAPIVersion(),
+		Resource: httpRoutes,
+	}
+}
+
+// GetGRPCRouteGVR returns the GroupVersionResource of the Gateway API GRPCRoute
+func GetGRPCRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultGatewayAPIGroup,
+		Version:  defaults.GetGatewayAPIVersion(),
+		Resource: grpcRoutes,
+	}
+}
+
+// GetTCPRouteGVR returns the GroupVersionResource of the Gateway API TCPRoute. TCPRoute is only
+// available in the experimental channel, so it does not follow the configured Gateway API version.
+func GetTCPRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultGatewayAPIGroup,
+		Version:  defaults.DefaultGatewayAPITCPRouteVersion,
+		Resource: tcpRoutes,
+	}
+}
+
+func gvrForKind(kind string) schema.GroupVersionResource {
+	switch kind {
+	case GRPCRouteKind:
+		return GetGRPCRouteGVR()
+	case TCPRouteKind:
+		return GetTCPRouteGVR()
+	default:
+		return GetHTTPRouteGVR()
+	}
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.Recorder.Eventf(r.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
+
+// routes returns all the routes configured in the rollout's gatewayAPI traffic routing
+func (r *Reconciler) routes() []route {
+	gatewayAPI := r.Rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI
+	var routes []route
+	if gatewayAPI.HTTPRoute != "" {
+		routes = append(routes, route{kind: HTTPRouteKind, name: gatewayAPI.HTTPRoute})
+	}
+	for _, name := range gatewayAPI.HTTPRoutes {
+		if name != gatewayAPI.HTTPRoute {
+			routes = append(routes, route{kind: HTTPRouteKind, name: name})
+		}
+	}
+	if gatewayAPI.GRPCRoute != "" {
+		routes = append(routes, route{kind: GRPCRouteKind, name: gatewayAPI.GRPCRoute})
+	}
+	if gatewayAPI.TCPRoute != "" {
+		routes = append(routes, route{kind: TCPRouteKind, name: gatewayAPI.TCPRoute})
+	}
+	return routes
+}
+
+func (r *Reconciler) getRoute(ctx context.Context, rt route) (*unstructured.Unstructured, error) {
+	return r.Client.Resource(gvrForKind(rt.kind)).Namespace(r.Rollout.Namespace).Get(ctx, rt.name, metav1.GetOptions{})
+}
+
+func (r *Reconciler) updateRoute(ctx context.Context, rt route, obj *unstructured.Unstructured) error {
+	_, err := r.Client.Resource(gvrForKind(rt.kind)).Namespace(r.Rollout.Namespace).Update(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating %s %q: %s", rt.kind, rt.name, err)
+		r.sendWarningEvent(GatewayAPIUpdateError, msg)
+	}
+	return err
+}
+
+// UpdateHash is a no-op since Gateway API routes reference services rather than subsets
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	ctx := context.TODO()
+	for _, rt := range r.routes() {
+		obj, err := r.getRoute(ctx, rt)
+		if err != nil {
+			return err
+		}
+		modified, err := r.reconcileRouteWeights(obj, desiredWeight, additionalDestinations...)
+		if err != nil {
+			return fmt.Errorf("[SetWeight] failed to set weight on %s %q: %w", rt.kind, rt.name, err)
+		}
+		if !modified {
+			r.Log.Infof("%s %q not modified", rt.kind, rt.name)
+			continue
+		}
+		if err := r.updateRoute(ctx, rt, obj); err != nil {
+			return err
+		}
+		r.Log.Infof("%s %q updated to desired weight %d", rt.kind, rt.name, desiredWeight)
+	}
+	return nil
+}
+
+// reconcileRouteWeights sets the backendRef weights of every rule which references the stable service.
+// Rules which only reference the canary service (e.g. header routes) are left untouched.
+func (r *Reconciler) reconcileRouteWeights(obj *unstructured.Unstructured, desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (bool, error) {
+	canarySvc := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableSvc := r.Rollout.Spec.Strategy.Canary.StableService
+	rules, err := getRules(obj)
+	if err != nil {
+		return false, err
+	}
+
+	stableWeight := int64(weightutil.MaxTrafficWeight(r.Rollout) - desiredWeight)
+	for _, dest := range additionalDestinations {
+		stableWeight -= int64(dest.Weight)
+	}
+
+	found, modified := false, false
+	for _, ruleI := range rules {
+		rule, ok := ruleI.(map[string]any)
+		if !ok {
+			return false, fmt.Errorf("invalid format for rule")
+		}
+		backendRefs, _, err := unstructured.NestedSlice(rule, "backendRefs")
+		if err != nil {
+			return false, err
+		}
+		stableRef := findBackendRef(backendRefs, stableSvc)
+		if stableRef == nil {
+			continue
+		}
+		canaryRef := findBackendRef(backendRefs, canarySvc)
+		if canaryRef == nil {
+			return false, fmt.Errorf("rule referencing stable service %q does not reference canary service %q", stableSvc, canarySvc)
+		}
+		found = true
+
+		newBackendRefs := []any{}
+		for _, refI := range backendRefs {
+			ref, ok := refI.(map[string]any)
+			if !ok {
+				return false, fmt.Errorf("invalid format for backendRef")
+			}
+			name, _, _ := unstructured.NestedString(ref, "name")
+			var weight int64
+			switch {
+			case name == stableSvc:
+				weight = stableWeight
+			case name == canarySvc:
+				weight = int64(desiredWeight)
+			default:
+				dest := findDestination(additionalDestinations, name)
+				if dest == nil {
+					// Destination is no longer part of the rollout (e.g. a finished experiment)
+					modified = true
+					continue
+				}
+				weight = int64(dest.Weight)
+			}
+			if current, ok := toInt64(ref["weight"]); !ok || current != weight {
+				ref["weight"] = weight
+				modified = true
+			}
+			newBackendRefs = append(newBackendRefs, ref)
+		}
+		for _, dest := range additionalDestinations {
+			if findBackendRef(newBackendRefs, dest.ServiceName) != nil {
+				continue
+			}
+			ref := map[string]any{
+				"name":   dest.ServiceName,
+				"weight": int64(dest.Weight),
+			}
+			if port, ok := canaryRef["port"]; ok {
+				ref["port"] = port
+			}
+			newBackendRefs = append(newBackendRefs, ref)
+			modified = true
+		}
+		rule["backendRefs"] = newBackendRefs
+	}
+	if !found {
+		return false, fmt.Errorf("no rule references stable service %q", stableSvc)
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules"); err != nil {
+		return false, err
+	}
+	return modified, nil
+}
+
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	ctx := context.TODO()
+	for _, rt := range r.routes() {
+		if rt.kind == TCPRouteKind {
+			// TCP routes have no notion of request headers
+			continue
+		}
+		obj, err := r.getRoute(ctx, rt)
+		if err != nil {
+			return err
+		}
+		origObj := obj.DeepCopy()
+		if err := removeManagedRule(obj, headerRouting.Name); err != nil {
+			return fmt.Errorf("[SetHeaderRoute] failed to remove managed rule %q from %s %q: %w", headerRouting.Name, rt.kind, rt.name, err)
+		}
+		if headerRouting.Match != nil {
+			rule, err := r.createHeaderRule(obj, headerRouting)
+			if err != nil {
+				return fmt.Errorf("[SetHeaderRoute] failed to create header rule for %s %q: %w", rt.kind, rt.name, err)
+			}
+			if err := addManagedRule(obj, headerRouting.Name, rule); err != nil {
+				return err
+			}
+		}
+		if reflect.DeepEqual(origObj, obj) {
+			continue
+		}
+		if err := r.updateRoute(ctx, rt, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createHeaderRule builds a rule sending all requests with the matching headers to the canary service.
+// The path/method matches of the base rule are preserved so the header route is scoped the same way.
+func (r *Reconciler) createHeaderRule(obj *unstructured.Unstructured, headerRouting *v1alpha1.SetHeaderRoute) (map[string]any, error) {
+	baseRule, canaryRef, err := r.getBaseRule(obj)
+	if err != nil {
+		return nil, err
+	}
+	headers := []any{}
+	for _, hrm := range headerRouting.Match {
+		header, err := createHeaderMatch(hrm.HeaderName, hrm.HeaderValue)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, header)
+	}
+	matches, _, err := unstructured.NestedSlice(baseRule, "matches")
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		matches = []any{map[string]any{}}
+	}
+	for _, matchI := range matches {
+		match, ok := matchI.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid format for match")
+		}
+		existing, _, err := unstructured.NestedSlice(match, "headers")
+		if err != nil {
+			return nil, err
+		}
+		match["headers"] = append(existing, unstructuredDeepCopy(headers)...)
+	}
+	canaryRef = unstructuredDeepCopyMap(canaryRef)
+	delete(canaryRef, "weight")
+	rule := map[string]any{
+		"matches":     matches,
+		"backendRefs": []any{canaryRef},
+	}
+	if filters, ok := baseRule["filters"]; ok {
+		rule["filters"] = filters
+	}
+	return rule, nil
+}
+
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	ctx := context.TODO()
+	for _, rt := range r.routes() {
+		if rt.kind != HTTPRouteKind {
+			// Request mirroring is only supported by HTTP routes
+			continue
+		}
+		obj, err := r.getRoute(ctx, rt)
+		if err != nil {
+			return err
+		}
+		origObj := obj.DeepCopy()
+		if err := removeManagedRule(obj, setMirrorRoute.Name); err != nil {
+			return fmt.Errorf("[SetMirrorRoute] failed to remove managed rule %q from %s %q: %w", setMirrorRoute.Name, rt.kind, rt.name, err)
+		}
+		if setMirrorRoute.Match != nil {
+			rule, err := r.createMirrorRule(obj, setMirrorRoute)
+			if err != nil {
+				return fmt.Errorf("[SetMirrorRoute] failed to create mirror rule for %s %q: %w", rt.kind, rt.name, err)
+			}
+			if err := addManagedRule(obj, setMirrorRoute.Name, rule); err != nil {
+				return err
+			}
+		}
+		if reflect.DeepEqual(origObj, obj) {
+			continue
+		}
+		if err := r.updateRoute(ctx, rt, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createMirrorRule builds a rule which keeps routing the matched requests like the base rule does,
+// while mirroring them to the canary service, or to the service of the mirror route
+func (r *Reconciler) createMirrorRule(obj *unstructured.Unstructured, mirrorRouting *v1alpha1.SetMirrorRoute) (map[string]any, error) {
+	baseRule, canaryRef, err := r.getBaseRule(obj)
+	if err != nil {
+		return nil, err
+	}
+	matches := []any{}
+	for _, routeMatch := range mirrorRouting.Match {
+		match, err := createHTTPRouteMatch(routeMatch)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+	mirrorRef := map[string]any{
+		"name": canaryRef["name"],
+	}
+	if mirrorRouting.Service != "" {
+		mirrorRef["name"] = mirrorRouting.Service
+	}
+	if port, ok := canaryRef["port"]; ok {
+		mirrorRef["port"] = port
+	}
+	requestMirror := map[string]any{
+		"backendRef": mirrorRef,
+	}
+	if mirrorRouting.Percentage != nil && *mirrorRouting.Percentage < 100 {
+		requestMirror["percent"] = int64(*mirrorRouting.Percentage)
+	}
+	filters, _, err := unstructured.NestedSlice(baseRule, "filters")
+	if err != nil {
+		return nil, err
+	}
+	filters = append(filters, map[string]any{
+		"type":          "RequestMirror",
+		"requestMirror": requestMirror,
+	})
+	backendRefs, _, err := unstructured.NestedSlice(baseRule, "backendRefs")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"matches":     matches,
+		"filters":     filters,
+		"backendRefs": backendRefs,
+	}, nil
+}
+
+// getBaseRule returns a copy of the first non managed rule referencing the stable service, along with its canary backendRef
+func (r *Reconciler) getBaseRule(obj *unstructured.Unstructured) (map[string]any, map[string]any, error) {
+	canarySvc := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableSvc := r.Rollout.Spec.Strategy.Canary.StableService
+	rules, err := getRules(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	managed, err := getManagedRules(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, ruleI := range rules {
+		if isManagedRuleIndex(managed, i) {
+			continue
+		}
+		rule, ok := ruleI.(map[string]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid format for rule")
+		}
+		backendRefs, _, err := unstructured.NestedSlice(rule, "backendRefs")
+		if err != nil {
+			return nil, nil, err
+		}
+		if findBackendRef(backendRefs, stableSvc) == nil {
+			continue
+		}
+		canaryRef := findBackendRef(backendRefs, canarySvc)
+		if canaryRef == nil {
+			return nil, nil, fmt.Errorf("rule referencing stable service %q does not reference canary service %q", stableSvc, canarySvc)
+		}
+		return unstructuredDeepCopyMap(rule), canaryRef, nil
+	}
+	return nil, nil, fmt.Errorf("no rule references stable service %q", stableSvc)
+}
+
+func createHeaderMatch(name string, value *v1alpha1.StringMatch) (map[string]any, error) {
+	if value == nil {
+		return nil, fmt.Errorf("header %q has no value to match", name)
+	}
+	match := map[string]any{
+		"name": name,
+	}
+	switch {
+	case value.Exact != "":
+		match["type"] = "Exact"
+		match["value"] = value.Exact
+	case value.Prefix != "":
+		// Gateway API has no prefix header match, so express it as a regular expression
+		match["type"] = "RegularExpression"
+		match["value"] = "^" + regexp.QuoteMeta(value.Prefix) + ".*"
+	case value.Regex != "":
+		match["type"] = "RegularExpression"
+		match["value"] = value.Regex
+	default:
+		return nil, fmt.Errorf("header %q has no value to match", name)
+	}
+	return match, nil
+}
+
+func createHTTPRouteMatch(routeMatch v1alpha1.RouteMatch) (map[string]any, error) {
+	match := map[string]any{}
+	if routeMatch.Path != nil {
+		path := map[string]any{}
+		switch {
+		case routeMatch.Path.Exact != "":
+			path["type"] = "Exact"
+			path["value"] = routeMatch.Path.Exact
+		case routeMatch.Path.Prefix != "":
+			path["type"] = "PathPrefix"
+			path["value"] = routeMatch.Path.Prefix
+		case routeMatch.Path.Regex != "":
+			path["type"] = "RegularExpression"
+			path["value"] = routeMatch.Path.Regex
+		}
+		match["path"] = path
+	}
+	if routeMatch.Method != nil {
+		if routeMatch.Method.Exact == "" {
+			return nil, fmt.Errorf("only exact method matches are supported by the Gateway API")
+		}
+		match["method"] = routeMatch.Method.Exact
+	}
+	if len(routeMatch.Headers) > 0 {
+		names := make([]string, 0, len(routeMatch.Headers))
+		for name := range routeMatch.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		headers := []any{}
+		for _, name := range names {
+			value := routeMatch.Headers[name]
+			header, err := createHeaderMatch(name, &value)
+			if err != nil {
+				return nil, err
+			}
+			headers = append(headers, header)
+		}
+		match["headers"] = headers
+	}
+	return match, nil
+}
+
+// VerifyWeight verifies that every route has been accepted by its parent gateways at its current generation
+// and still carries the desired weights
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	verified := false
+	for _, rt := range r.routes() {
+		obj, err := r.getRoute(ctx, rt)
+		if err != nil {
+			return &verified, err
+		}
+		// Reuse the weight reconciliation to determine if the route drifted from the desired weights
+		modified, err := r.reconcileRouteWeights(obj.DeepCopy(), desiredWeight, additionalDestinations...)
+		if err != nil {
+			return &verified, err
+		}
+		if modified {
+			r.Log.Infof("%s %q weights do not match desired weight %d", rt.kind, rt.name, desiredWeight)
+			return &verified, nil
+		}
+		if !isAccepted(obj) {
+			r.Log.Infof("%s %q has not been accepted by all of its parents", rt.kind, rt.name)
+			return &verified, nil
+		}
+	}
+	verified = true
+	return &verified, nil
+}
+
+// isAccepted returns whether all the parents of the route reported an Accepted condition for the current generation
+func isAccepted(obj *unstructured.Unstructured) bool {
+	parents, _, err := unstructured.NestedSlice(obj.Object, "status", "parents")
+	if err != nil || len(parents) == 0 {
+		return false
+	}
+	for _, parentI := range parents {
+		parent, ok := parentI.(map[string]any)
+		if !ok {
+			return false
+		}
+		conditions, _, err := unstructured.NestedSlice(parent, "conditions")
+		if err != nil {
+			return false
+		}
+		accepted := false
+		for _, condI := range conditions {
+			cond, ok := condI.(map[string]any)
+			if !ok {
+				continue
+			}
+			if cond["type"] != "Accepted" || cond["status"] != string(metav1.ConditionTrue) {
+				continue
+			}
+			if generation, ok := toInt64(cond["observedGeneration"]); ok && generation < obj.GetGeneration() {
+				continue
+			}
+			accepted = true
+		}
+		if !accepted {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Reconciler) RemoveManagedRoutes() error {
+	ctx := context.TODO()
+	for _, rt := range r.routes() {
+		obj, err := r.getRoute(ctx, rt)
+		if err != nil {
+			return err
+		}
+		managed, err := getManagedRules(obj)
+		if err != nil {
+			return err
+		}
+		if len(managed) == 0 {
+			continue
+		}
+		for name := range managed {
+			if err := removeManagedRule(obj, name); err != nil {
+				return fmt.Errorf("[RemoveManagedRoutes] failed to remove managed rule %q from %s %q: %w", name, rt.kind, rt.name, err)
+			}
+		}
+		if err := r.updateRoute(ctx, rt, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+func getRules(obj *unstructured.Unstructured) ([]any, error) {
+	rules, found, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("spec.rules was not found in %s %q", obj.GetKind(), obj.GetName())
+	}
+	return rules, nil
+}
+
+// getManagedRules returns the rule indexes of the managed routes recorded on the route object
+func getManagedRules(obj *unstructured.Unstructured) (map[string]int64, error) {
+	managed := map[string]int64{}
+	value, ok := obj.GetAnnotations()[ManagedRulesAnnotation]
+	if !ok || value == "" {
+		return managed, nil
+	}
+	if err := json.Unmarshal([]byte(value), &managed); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %w", ManagedRulesAnnotation, err)
+	}
+	return managed, nil
+}
+
+func setManagedRules(obj *unstructured.Unstructured, managed map[string]int64) error {
+	annotations := obj.GetAnnotations()
+	if len(managed) == 0 {
+		delete(annotations, ManagedRulesAnnotation)
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	value, err := json.Marshal(managed)
+	if err != nil {
+		return err
+	}
+	annotations[ManagedRulesAnnotation] = string(value)
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+func isManagedRuleIndex(managed map[string]int64, idx int) bool {
+	for _, i := range managed {
+		if i == int64(idx) {
+			return true
+		}
+	}
+	return false
+}
+
+// addManagedRule appends the rule to the route and records its index under the managed route name
+func addManagedRule(obj *unstructured.Unstructured, name string, rule map[string]any) error {
+	rules, err := getRules(obj)
+	if err != nil {
+		return err
+	}
+	managed, err := getManagedRules(obj)
+	if err != nil {
+		return err
+	}
+	managed[name] = int64(len(rules))
+	rules = append(rules, rule)
+	if err := unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules"); err != nil {
+		return err
+	}
+	return setManagedRules(obj, managed)
+}
+
+// removeManagedRule removes the rule previously added under the managed route name, shifting the
+// recorded indexes of the rules which came after it
+func removeManagedRule(obj *unstructured.Unstructured, name string) error {
+	managed, err := getManagedRules(obj)
+	if err != nil {
+		return err
+	}
+	idx, ok := managed[name]
+	if !ok {
+		return nil
+	}
+	rules, err := getRules(obj)
+	if err != nil {
+		return err
+	}
+	if idx < 0 || idx >= int64(len(rules)) {
+		return fmt.Errorf("managed rule index %d is out of range", idx)
+	}
+	rules = append(rules[:idx], rules[idx+1:]...)
+	delete(managed, name)
+	for n, i := range managed {
+		if i > idx {
+			managed[n] = i - 1
+		}
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules"); err != nil {
+		return err
+	}
+	return setManagedRules(obj, managed)
+}
+
+func findBackendRef(backendRefs []any, serviceName string) map[string]any {
+	for _, refI := range backendRefs {
+		ref, ok := refI.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(ref, "name"); name == serviceName {
+			return ref
+		}
+	}
+	return nil
+}
+
+func findDestination(destinations []v1alpha1.WeightDestination, serviceName string) *v1alpha1.WeightDestination {
+	for i := range destinations {
+		if destinations[i].ServiceName == serviceName {
+			return &destinations[i]
+		}
+	}
+	return nil
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+func unstructuredDeepCopy(values []any) []any {
+	return runtime.DeepCopyJSONValue(values).([]any)
+}
+
+func unstructuredDeepCopyMap(value map[string]any) map[string]any {
+	return runtime.DeepCopyJSONValue(value).(map[string]any)
+}
@@ -0,0 +1,475 @@
+package gatewayapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const httpRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: http-route
+  namespace: default
+  generation: 2
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /api
+    backendRefs:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /static
+    backendRefs:
+    - name: static-service
+      port: 80
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 2
+`
+
+const grpcRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: grpc-route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 9000
+      weight: 100
+    - name: canary-service
+      port: 9000
+      weight: 0
+`
+
+const tcpRoute = `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: TCPRoute
+metadata:
+  name: tcp-route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 5432
+      weight: 100
+    - name: canary-service
+      port: 5432
+      weight: 0
+`
+
+func rollout(gatewayAPI *v1alpha1.GatewayAPITrafficRouting) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						GatewayAPI: gatewayAPI,
+						ManagedRoutes: []v1alpha1.MangedRoutes{
+							{Name: "header-route"},
+							{Name: "mirror-route"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout, objs ...runtime.Object) *Reconciler {
+	return NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   testutil.NewFakeDynamicClient(objs...),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+}
+
+func fetchRoute(t *testing.T, r *Reconciler, kind, name string) *unstructured.Unstructured {
+	t.Helper()
+	obj, err := r.Client.Resource(gvrForKind(kind)).Namespace("default").Get(context.TODO(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	return obj
+}
+
+func routeRules(t *testing.T, obj *unstructured.Unstructured) []any {
+	t.Helper()
+	rules, _, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	assert.NoError(t, err)
+	return rules
+}
+
+func backendWeights(t *testing.T, rule any) map[string]int64 {
+	t.Helper()
+	backendRefs, _, err := unstructured.NestedSlice(rule.(map[string]any), "backendRefs")
+	assert.NoError(t, err)
+	weights := map[string]int64{}
+	for _, refI := range backendRefs {
+		ref := refI.(map[string]any)
+		weight, _ := toInt64(ref["weight"])
+		weights[ref["name"].(string)] = weight
+	}
+	return weights
+}
+
+func TestType(t *testing.T) {
+	r := newReconciler(rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}))
+	assert.Equal(t, Type, r.Type())
+}
+
+func TestUpdateHash(t *testing.T) {
+	r := newReconciler(rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"}))
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+}
+
+func TestGetGVRs(t *testing.T) {
+	assert.Equal(t, "gateway.networking.k8s.io/v1, Resource=httproutes", GetHTTPRouteGVR().String())
+	assert.Equal(t, "gateway.networking.k8s.io/v1, Resource=grpcroutes", GetGRPCRouteGVR().String())
+	assert.Equal(t, "gateway.networking.k8s.io/v1alpha2, Resource=tcproutes", GetTCPRouteGVR().String())
+}
+
+func TestSetWeight(t *testing.T) {
+	t.Run("SetWeightAllRouteKinds", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{
+			HTTPRoute: "http-route",
+			GRPCRoute: "grpc-route",
+			TCPRoute:  "tcp-route",
+		})
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute), testutil.ObjectFromYAML(grpcRoute), testutil.ObjectFromYAML(tcpRoute))
+		err := r.SetWeight(30)
+		assert.NoError(t, err)
+
+		rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+		assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, backendWeights(t, rules[0]))
+		// rules which do not reference the stable service are left untouched
+		assert.Equal(t, map[string]int64{"static-service": 0}, backendWeights(t, rules[1]))
+		for kind, name := range map[string]string{GRPCRouteKind: "grpc-route", TCPRouteKind: "tcp-route"} {
+			rules := routeRules(t, fetchRoute(t, r, kind, name))
+			assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 30}, backendWeights(t, rules[0]))
+		}
+	})
+	t.Run("SetWeightMultipleHTTPRoutes", func(t *testing.T) {
+		second := testutil.ObjectFromYAML(httpRoute)
+		second.SetName("http-route-2")
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoutes: []string{"http-route", "http-route-2"}})
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute), second)
+		err := r.SetWeight(50)
+		assert.NoError(t, err)
+		for _, name := range []string{"http-route", "http-route-2"} {
+			rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, name))
+			assert.Equal(t, map[string]int64{"stable-service": 50, "canary-service": 50}, backendWeights(t, rules[0]))
+		}
+	})
+	t.Run("SetWeightWithAdditionalDestinations", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		err := r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 10})
+		assert.NoError(t, err)
+		rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+		assert.Equal(t, map[string]int64{"stable-service": 70, "canary-service": 20, "ex-service": 10}, backendWeights(t, rules[0]))
+
+		// experiment destination is removed once it is no longer part of the rollout
+		err = r.SetWeight(20)
+		assert.NoError(t, err)
+		rules = routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+		assert.Equal(t, map[string]int64{"stable-service": 80, "canary-service": 20}, backendWeights(t, rules[0]))
+	})
+	t.Run("SetWeightWithMaxTrafficWeight", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		maxWeight := int32(1000)
+		ro.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = &maxWeight
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		err := r.SetWeight(250)
+		assert.NoError(t, err)
+		rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+		assert.Equal(t, map[string]int64{"stable-service": 750, "canary-service": 250}, backendWeights(t, rules[0]))
+	})
+	t.Run("SetWeightRouteNotFound", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro)
+		err := r.SetWeight(30)
+		assert.Error(t, err)
+	})
+	t.Run("SetWeightNoStableRule", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		ro.Spec.Strategy.Canary.StableService = "other-service"
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		err := r.SetWeight(30)
+		assert.EqualError(t, err, `[SetWeight] failed to set weight on HTTPRoute "http-route": no rule references stable service "other-service"`)
+	})
+	t.Run("SetWeightMissingCanaryBackend", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		ro.Spec.Strategy.Canary.CanaryService = "other-service"
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		err := r.SetWeight(30)
+		assert.EqualError(t, err, `[SetWeight] failed to set weight on HTTPRoute "http-route": rule referencing stable service "stable-service" does not reference canary service "other-service"`)
+	})
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{
+		HTTPRoute: "http-route",
+		GRPCRoute: "grpc-route",
+		TCPRoute:  "tcp-route",
+	})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute), testutil.ObjectFromYAML(grpcRoute), testutil.ObjectFromYAML(tcpRoute))
+	headerRoute := &v1alpha1.SetHeaderRoute{
+		Name: "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}},
+			{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "v1."}},
+		},
+	}
+	err := r.SetHeaderRoute(headerRoute)
+	assert.NoError(t, err)
+
+	obj := fetchRoute(t, r, HTTPRouteKind, "http-route")
+	assert.Equal(t, `{"header-route":2}`, obj.GetAnnotations()[ManagedRulesAnnotation])
+	rules := routeRules(t, obj)
+	assert.Len(t, rules, 3)
+	headerRule := rules[2].(map[string]any)
+	assert.Equal(t, []any{map[string]any{"name": "canary-service", "port": float64(80)}}, headerRule["backendRefs"])
+	assert.Equal(t, []any{map[string]any{
+		"path": map[string]any{"type": "PathPrefix", "value": "/api"},
+		"headers": []any{
+			map[string]any{"name": "agent", "type": "Exact", "value": "firefox"},
+			map[string]any{"name": "version", "type": "RegularExpression", "value": `^v1\..*`},
+		},
+	}}, headerRule["matches"])
+
+	obj = fetchRoute(t, r, GRPCRouteKind, "grpc-route")
+	rules = routeRules(t, obj)
+	assert.Len(t, rules, 2)
+	// header rule only sends traffic to the canary, so setting weights leaves it alone
+	err = r.SetWeight(10)
+	assert.NoError(t, err)
+	rules = routeRules(t, fetchRoute(t, r, GRPCRouteKind, "grpc-route"))
+	assert.Equal(t, map[string]int64{"canary-service": 0}, backendWeights(t, rules[1]))
+
+	// TCP routes are not affected
+	assert.Len(t, routeRules(t, fetchRoute(t, r, TCPRouteKind, "tcp-route")), 1)
+
+	// setting the header route again replaces the existing rule
+	err = r.SetHeaderRoute(headerRoute)
+	assert.NoError(t, err)
+	assert.Len(t, routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route")), 3)
+
+	// a nil match removes the managed rule
+	err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"})
+	assert.NoError(t, err)
+	obj = fetchRoute(t, r, HTTPRouteKind, "http-route")
+	assert.Len(t, routeRules(t, obj), 2)
+	assert.NotContains(t, obj.GetAnnotations(), ManagedRulesAnnotation)
+}
+
+func TestSetHeaderRouteInvalidValue(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{}}},
+	})
+	assert.EqualError(t, err, `[SetHeaderRoute] failed to create header rule for HTTPRoute "http-route": header "agent" has no value to match`)
+}
+
+func TestInvalidRouteFormat(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	obj := testutil.ObjectFromYAML(httpRoute)
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	rule := rules[0].(map[string]any)
+	rule["matches"] = []any{"invalid"}
+	rule["backendRefs"] = append(rule["backendRefs"].([]any), "invalid")
+	assert.NoError(t, unstructured.SetNestedSlice(obj.Object, rules, "spec", "rules"))
+	r := newReconciler(ro, obj)
+
+	err := r.SetWeight(10)
+	assert.ErrorContains(t, err, "invalid format for backendRef")
+	err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}}},
+	})
+	assert.ErrorContains(t, err, "invalid format for match")
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route", GRPCRoute: "grpc-route"})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute), testutil.ObjectFromYAML(grpcRoute))
+	percentage := int32(50)
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name: "mirror-route",
+		Match: []v1alpha1.RouteMatch{{
+			Method: &v1alpha1.StringMatch{Exact: "GET"},
+			Path:   &v1alpha1.StringMatch{Prefix: "/api/v2"},
+			Headers: map[string]v1alpha1.StringMatch{
+				"agent": {Regex: "fire.*"},
+			},
+		}},
+		Percentage: &percentage,
+	})
+	assert.NoError(t, err)
+
+	rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+	assert.Len(t, rules, 3)
+	mirrorRule := rules[2].(map[string]any)
+	assert.Equal(t, []any{map[string]any{
+		"method":  "GET",
+		"path":    map[string]any{"type": "PathPrefix", "value": "/api/v2"},
+		"headers": []any{map[string]any{"name": "agent", "type": "RegularExpression", "value": "fire.*"}},
+	}}, mirrorRule["matches"])
+	assert.Equal(t, []any{map[string]any{
+		"type": "RequestMirror",
+		"requestMirror": map[string]any{
+			"backendRef": map[string]any{"name": "canary-service", "port": float64(80)},
+			"percent":    int64(50),
+		},
+	}}, mirrorRule["filters"])
+
+	// the mirror rule keeps following the weights of the base rule
+	err = r.SetWeight(40)
+	assert.NoError(t, err)
+	rules = routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+	assert.Equal(t, map[string]int64{"stable-service": 60, "canary-service": 40}, backendWeights(t, rules[2]))
+
+	// GRPC routes do not support mirroring
+	assert.Len(t, routeRules(t, fetchRoute(t, r, GRPCRouteKind, "grpc-route")), 1)
+
+	err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror-route"})
+	assert.NoError(t, err)
+	assert.Len(t, routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route")), 2)
+}
+
+func TestSetMirrorRouteService(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name: "mirror-route",
+		Match: []v1alpha1.RouteMatch{{
+			Method: &v1alpha1.StringMatch{Exact: "GET"},
+		}},
+		Service: "response-diff",
+	})
+	assert.NoError(t, err)
+
+	rules := routeRules(t, fetchRoute(t, r, HTTPRouteKind, "http-route"))
+	assert.Len(t, rules, 3)
+	assert.Equal(t, []any{map[string]any{
+		"type": "RequestMirror",
+		"requestMirror": map[string]any{
+			"backendRef": map[string]any{"name": "response-diff", "port": float64(80)},
+		},
+	}}, rules[2].(map[string]any)["filters"])
+}
+
+func TestSetMirrorRouteInvalidMethod(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:  "mirror-route",
+		Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Prefix: "G"}}},
+	})
+	assert.EqualError(t, err, `[SetMirrorRoute] failed to create mirror rule for HTTPRoute "http-route": only exact method matches are supported by the Gateway API`)
+}
+
+func TestRemoveManagedRoutes(t *testing.T) {
+	ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+	r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}}},
+	})
+	assert.NoError(t, err)
+	err = r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:  "mirror-route",
+		Match: []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Exact: "/api"}}},
+	})
+	assert.NoError(t, err)
+	obj := fetchRoute(t, r, HTTPRouteKind, "http-route")
+	assert.Len(t, routeRules(t, obj), 4)
+	assert.Equal(t, `{"header-route":2,"mirror-route":3}`, obj.GetAnnotations()[ManagedRulesAnnotation])
+
+	// removing the first managed rule shifts the index of the following one
+	err = r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"})
+	assert.NoError(t, err)
+	obj = fetchRoute(t, r, HTTPRouteKind, "http-route")
+	assert.Equal(t, `{"mirror-route":2}`, obj.GetAnnotations()[ManagedRulesAnnotation])
+
+	err = r.RemoveManagedRoutes()
+	assert.NoError(t, err)
+	obj = fetchRoute(t, r, HTTPRouteKind, "http-route")
+	assert.Len(t, routeRules(t, obj), 2)
+	assert.NotContains(t, obj.GetAnnotations(), ManagedRulesAnnotation)
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("Verified", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		verified, err := r.VerifyWeight(0)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("WeightMismatch", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro, testutil.ObjectFromYAML(httpRoute))
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("NotAcceptedForGeneration", func(t *testing.T) {
+		obj := testutil.ObjectFromYAML(httpRoute)
+		obj.SetGeneration(3)
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro, obj)
+		verified, err := r.VerifyWeight(0)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("NoParentStatus", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{GRPCRoute: "grpc-route"})
+		r := newReconciler(ro, testutil.ObjectFromYAML(grpcRoute))
+		verified, err := r.VerifyWeight(0)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("RouteNotFound", func(t *testing.T) {
+		ro := rollout(&v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"})
+		r := newReconciler(ro)
+		verified, err := r.VerifyWeight(0)
+		assert.Error(t, err)
+		assert.False(t, *verified)
+	})
+}
@@ -28,7 +28,7 @@ import (
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/queue"
-	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 const (
@@ -47,6 +47,8 @@ type IstioControllerConfig struct {
 	RolloutsInformer        informers.RolloutInformer
 	VirtualServiceInformer  cache.SharedIndexInformer
 	DestinationRuleInformer cache.SharedIndexInformer
+	// StrategyTemplateGetter resolves the strategy of the rollouts referencing a strategy template
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 type IstioController struct {
@@ -67,20 +69,10 @@ func NewIstioController(cfg IstioControllerConfig) *IstioController {
 
 	// Add a Rollout index against referenced VirtualServices and DestinationRules
 	util.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
-		virtualServiceIndexName: func(obj any) (strings []string, e error) {
-			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-				return istioutil.GetRolloutVirtualServiceKeys(ro), nil
-			}
-			return
-		},
+		virtualServiceIndexName: strategytemplate.IndexFunc(istioutil.GetRolloutVirtualServiceKeys),
 	}))
 	util.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
-		destinationRuleIndexName: func(obj any) (strings []string, e error) {
-			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-				return istioutil.GetRolloutDesinationRuleKeys(ro), nil
-			}
-			return
-		},
+		destinationRuleIndexName: strategytemplate.IndexFunc(istioutil.GetRolloutDesinationRuleKeys),
 	}))
 
 	// When a VirtualService changes, simply enqueue the referencing rollout
@@ -170,7 +162,8 @@ func (c *IstioController) EnqueueRolloutFromIstioVirtualService(vsvc any) {
 		log.Errorf("Error processing istio VirtualService from watch: %v: %v", err, vsvc)
 		return
 	}
-	rolloutToEnqueue, err := c.RolloutsInformer.Informer().GetIndexer().ByIndex(virtualServiceIndexName, fmt.Sprintf("%s/%s", acc.GetNamespace(), acc.GetName()))
+	key := fmt.Sprintf("%s/%s", acc.GetNamespace(), acc.GetName())
+	rolloutToEnqueue, err := strategytemplate.ByIndex(c.RolloutsInformer.Informer().GetIndexer(), virtualServiceIndexName, key, c.StrategyTemplateGetter, istioutil.GetRolloutVirtualServiceKeys)
 	if err != nil {
 		log.Errorf("Cannot process indexer: %s", err.Error())
 		return
@@ -0,0 +1,220 @@
+package istio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// EnvoyConfigDump is the subset of the Envoy config dump, as served by the admin endpoint of a proxy
+// (/config_dump) or the debug endpoint of istiod (/debug/config_dump?proxyID=...), which holds the routes
+type EnvoyConfigDump struct {
+	Configs []EnvoyConfig `json:"configs"`
+}
+
+// EnvoyConfig is a config of the Envoy config dump. Only the route configs are of interest
+type EnvoyConfig struct {
+	StaticRouteConfigs  []EnvoyRouteConfigDump `json:"static_route_configs,omitempty"`
+	DynamicRouteConfigs []EnvoyRouteConfigDump `json:"dynamic_route_configs,omitempty"`
+}
+
+type EnvoyRouteConfigDump struct {
+	RouteConfig EnvoyRouteConfiguration `json:"route_config"`
+}
+
+type EnvoyRouteConfiguration struct {
+	Name         string             `json:"name"`
+	VirtualHosts []EnvoyVirtualHost `json:"virtual_hosts,omitempty"`
+}
+
+type EnvoyVirtualHost struct {
+	Name   string       `json:"name"`
+	Routes []EnvoyRoute `json:"routes,omitempty"`
+}
+
+type EnvoyRoute struct {
+	Name  string            `json:"name,omitempty"`
+	Route *EnvoyRouteAction `json:"route,omitempty"`
+}
+
+type EnvoyRouteAction struct {
+	Cluster          string                 `json:"cluster,omitempty"`
+	WeightedClusters *EnvoyWeightedClusters `json:"weighted_clusters,omitempty"`
+}
+
+type EnvoyWeightedClusters struct {
+	Clusters []EnvoyClusterWeight `json:"clusters"`
+}
+
+type EnvoyClusterWeight struct {
+	Name   string `json:"name"`
+	Weight int64  `json:"weight"`
+}
+
+// clusterWeights returns the weights of the clusters the route sends traffic to and their total weight
+func (route EnvoyRoute) clusterWeights() (map[string]int64, int64) {
+	weights := map[string]int64{}
+	if route.Route == nil {
+		return weights, 0
+	}
+	if route.Route.WeightedClusters == nil {
+		if route.Route.Cluster == "" {
+			return weights, 0
+		}
+		weights[route.Route.Cluster] = 1
+		return weights, 1
+	}
+	var total int64
+	for _, cluster := range route.Route.WeightedClusters.Clusters {
+		weights[cluster.Name] += cluster.Weight
+		total += cluster.Weight
+	}
+	return weights, total
+}
+
+// envoyCluster is an Istio outbound cluster, which names are formatted as outbound|<port>|<subset>|<host>
+type envoyCluster struct {
+	subset string
+	host   string
+}
+
+func parseEnvoyCluster(name string) (envoyCluster, bool) {
+	parts := strings.Split(name, "|")
+	if len(parts) != 4 || parts[0] != "outbound" {
+		return envoyCluster{}, false
+	}
+	return envoyCluster{subset: parts[2], host: parts[3]}, true
+}
+
+// isHost returns whether the host of the cluster is the given host, which is either the short name
+// of a service in the namespace or a fully qualified host
+func (c envoyCluster) isHost(host, namespace string) bool {
+	return c.host == host || strings.HasPrefix(c.host, host+"."+namespace+".")
+}
+
+// envoyDestination identifies the clusters of a destination of the VirtualService
+type envoyDestination struct {
+	host   string
+	subset string
+}
+
+func (d envoyDestination) matches(cluster envoyCluster, namespace string) bool {
+	return cluster.isHost(d.host, namespace) && cluster.subset == d.subset
+}
+
+// getEnvoyDestinations returns the destinations of the stable and canary, either by service or by subset
+func (r *Reconciler) getEnvoyDestinations() (envoyDestination, envoyDestination, error) {
+	if dRule := r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule; dRule != nil {
+		host, err := r.getDestinationRuleHost()
+		if err != nil {
+			return envoyDestination{}, envoyDestination{}, err
+		}
+		return envoyDestination{host: host, subset: dRule.StableSubsetName}, envoyDestination{host: host, subset: dRule.CanarySubsetName}, nil
+	}
+	stableSvc, canarySvc := trafficrouting.GetStableAndCanaryServices(r.rollout, false)
+	return envoyDestination{host: stableSvc}, envoyDestination{host: canarySvc}, nil
+}
+
+// isVerifiedRoute returns whether the weights of the route are verified against. Those are the HTTP
+// routes of the VirtualServices listed in the rollout, or every route but the managed routes if none are listed
+func (r *Reconciler) isVerifiedRoute(name string) bool {
+	var routeNames []string
+	for _, virtualService := range r.getVirtualServices() {
+		routeNames = append(routeNames, virtualService.Routes...)
+	}
+	if len(routeNames) > 0 {
+		for _, routeName := range routeNames {
+			if routeName == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, managedRoute := range r.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		if managedRoute.Name == name {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyWeight verifies the weights against the routes Envoy was configured with. It reads the config dump
+// from the admin endpoint of a proxy, or from the debug endpoint of istiod, and checks that every HTTP route
+// which sends traffic to the stable or canary sends the desired share of the traffic to the canary
+// and to the additional destinations
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	url := defaults.GetIstioConfigDumpURL()
+	if !defaults.VerifyTrafficRouterWeight() || url == "" || !rolloututil.ShouldVerifyWeight(r.rollout, desiredWeight) {
+		return nil, nil
+	}
+	stable, canary, err := r.getEnvoyDestinations()
+	if err != nil {
+		return pointer.Bool(false), err
+	}
+	var configDump EnvoyConfigDump
+	if err := trafficrouting.GetDataPlaneConfig(context.TODO(), url, &configDump); err != nil {
+		return pointer.Bool(false), err
+	}
+
+	namespace := r.rollout.Namespace
+	maxWeight := weightutil.MaxTrafficWeight(r.rollout)
+	var numRoutes int
+	for _, config := range configDump.Configs {
+		for _, routeConfig := range append(config.StaticRouteConfigs, config.DynamicRouteConfigs...) {
+			for _, virtualHost := range routeConfig.RouteConfig.VirtualHosts {
+				for _, route := range virtualHost.Routes {
+					if !r.isVerifiedRoute(route.Name) {
+						continue
+					}
+					weights, total := route.clusterWeights()
+					var stableWeight, canaryWeight int64
+					destWeights := make([]int64, len(additionalDestinations))
+					for name, weight := range weights {
+						cluster, ok := parseEnvoyCluster(name)
+						if !ok {
+							continue
+						}
+						if stable.matches(cluster, namespace) {
+							stableWeight += weight
+						}
+						if canary.matches(cluster, namespace) {
+							canaryWeight += weight
+						}
+						for i, dest := range additionalDestinations {
+							if cluster.isHost(dest.ServiceName, namespace) {
+								destWeights[i] += weight
+							}
+						}
+					}
+					if stableWeight == 0 && canaryWeight == 0 {
+						// the route does not send traffic to the rollout
+						continue
+					}
+					numRoutes++
+					if !trafficrouting.IsWeightVerified(canaryWeight, total, desiredWeight, maxWeight) {
+						r.log.Infof("Route '%s' of Envoy virtual host '%s' not yet verified: canary weight %d/%d", route.Name, virtualHost.Name, canaryWeight, total)
+						return pointer.Bool(false), nil
+					}
+					for i, dest := range additionalDestinations {
+						if !trafficrouting.IsWeightVerified(destWeights[i], total, dest.Weight, maxWeight) {
+							r.log.Infof("Route '%s' of Envoy virtual host '%s' not yet verified: weight of '%s' %d/%d", route.Name, virtualHost.Name, dest.ServiceName, destWeights[i], total)
+							return pointer.Bool(false), nil
+						}
+					}
+				}
+			}
+		}
+	}
+	if numRoutes == 0 {
+		return pointer.Bool(false), fmt.Errorf("no Envoy route sending traffic to the rollout found in config dump from '%s'", url)
+	}
+	return pointer.Bool(true), nil
+}
@@ -291,10 +291,17 @@ func (r *Reconciler) reconcileVirtualService(obj *unstructured.Unstructured, vsv
 		return nil, false, err
 	}
 
+	// Pin the users to a version
+	httpRoutesI, stickinessModified, err := r.reconcileStickiness(httpRoutesI, vsvcRouteNames, desiredWeight)
+	if err != nil {
+		return nil, false, err
+	}
+	modified := len(patches) > 0 || stickinessModified
+
 	// Set HTTP Route Slice
 	if len(httpRoutes) > 0 {
 		if err := unstructured.SetNestedSlice(newObj.Object, httpRoutesI, "spec", Http); err != nil {
-			return newObj, len(patches) > 0, err
+			return newObj, modified, err
 		}
 	}
 
@@ -302,7 +309,7 @@ func (r *Reconciler) reconcileVirtualService(obj *unstructured.Unstructured, vsv
 	if len(tlsRoutes) > 0 {
 		err = unstructured.SetNestedSlice(newObj.Object, tlsRoutesI, "spec", Tls)
 		if err != nil {
-			return newObj, len(patches) > 0, err
+			return newObj, modified, err
 		}
 	}
 
@@ -311,7 +318,7 @@ func (r *Reconciler) reconcileVirtualService(obj *unstructured.Unstructured, vsv
 		err = unstructured.SetNestedSlice(newObj.Object, tcpRoutesI, "spec", Tcp)
 	}
 
-	return newObj, len(patches) > 0, err
+	return newObj, modified, err
 }
 
 func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
@@ -880,10 +887,6 @@ func routeDestination(host string, port uint32, subset string, weight int64) map
 	return routeValue
 }
 
-func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
-	return nil, nil
-}
-
 // getHttpRouteIndexesToPatch returns array indices of the httpRoutes which need to be patched when updating weights
 func getHttpRouteIndexesToPatch(routeNames []string, httpRoutes []VirtualServiceHTTPRoute) ([]int, error) {
 	//We have no routes listed in spec.strategy.canary.trafficRouting.istio.virtualService.routes so find index
@@ -1197,6 +1200,10 @@ func (r *Reconciler) reconcileVirtualServiceMirrorRoutes(virtualService v1alpha1
 	if r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule != nil {
 		canarySubset = r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName
 	}
+	if mirrorRoute.Service != "" {
+		// the traffic is mirrored to another service than the canary, e.g. the response-diff proxy of a shadow step
+		canarySvc, canarySubset = mirrorRoute.Service, ""
+	}
 
 	//Remove mirror route when there is no match rules we require a match on routes for safety so a none listed match
 	//acts like a removal of the route instead of say routing all traffic
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,9 +16,11 @@ import (
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/dynamic/dynamiclister"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	evalUtils "github.com/argoproj/argo-rollouts/utils/evaluate"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -2995,6 +2999,52 @@ spec:
 	assert.Equal(t, len(httpRoutes), 1)
 }
 
+func TestHttpReconcileMirrorRouteService(t *testing.T) {
+	ro := rolloutWithDestinationRule()
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Name = "vsvc"
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Routes = nil
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.StableSubsetName = "stable-subset"
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName = "canary-subset"
+	ro.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = []v1alpha1.MangedRoutes{{Name: "shadow"}}
+	dRule := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: istio-destrule
+  namespace: default
+spec:
+  host: rollout-service
+  subsets:
+  - name: stable-subset
+  - name: canary-subset
+`)
+	obj := unstructuredutil.StrToUnstructuredUnsafe(singleRouteSubsetVsvc)
+	client := testutil.NewFakeDynamicClient(obj, dRule)
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister)
+	client.ClearActions()
+
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name: "shadow",
+		Match: []v1alpha1.RouteMatch{{
+			Method: &v1alpha1.StringMatch{Exact: "GET"},
+		}},
+		Service: "response-diff",
+	})
+	assert.Nil(t, err)
+	iVirtualService, err := client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(r.rollout.Namespace).Get(context.TODO(), ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	httpRoutes := extractHttpRoutes(t, iVirtualService)
+	assert.Len(t, httpRoutes, 2)
+
+	// the traffic is mirrored to the service, without the subset of the canary
+	assert.Equal(t, "shadow", httpRoutes[0].Name)
+	assert.Equal(t, "response-diff", httpRoutes[0].Mirror.Host)
+	assert.Equal(t, "", httpRoutes[0].Mirror.Subset)
+	assert.Equal(t, "rollout-service", httpRoutes[0].Route[0].Destination.Host)
+	assert.Equal(t, "stable-subset", httpRoutes[0].Route[0].Destination.Subset)
+}
+
 func TestReconcileUpdateMirror(t *testing.T) {
 	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
 	ro.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes = append(ro.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes, v1alpha1.MangedRoutes{
@@ -3068,3 +3118,193 @@ func TestReconcileHeaderRouteAvoidDuplicates(t *testing.T) {
 	assert.Equal(t, httpRoutes[1].Name, "primary")
 	assert.Equal(t, httpRoutes[2].Name, "secondary")
 }
+
+func TestVerifyWeightFromEnvoyConfigDump(t *testing.T) {
+	configDump := func(canaryWeight, stableWeight int64) string {
+		return fmt.Sprintf(`{"configs": [{"dynamic_route_configs": [{"route_config": {"name": "80", "virtual_hosts": [{
+			"name": "istio-rollout.default.svc.cluster.local:80",
+			"routes": [
+				{"name": "primary", "route": {"weighted_clusters": {"clusters": [
+					{"name": "outbound|80||stable.default.svc.cluster.local", "weight": %d},
+					{"name": "outbound|80||canary.default.svc.cluster.local", "weight": %d}
+				]}}},
+				{"name": "other", "route": {"cluster": "outbound|80||other.default.svc.cluster.local"}}
+			]}]}}]}]}`, stableWeight, canaryWeight)
+	}
+	var served string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(served))
+	}))
+	defer ts.Close()
+
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentStepIndex = pointer.Int32(0)
+	ro.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}}
+	r := NewReconciler(ro, testutil.NewFakeDynamicClient(), record.NewFakeEventRecorder(), nil, nil)
+
+	// verification is disabled by default
+	verified, err := r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.Nil(t, verified)
+
+	defaults.SetVerifyTrafficRouterWeight(true)
+	defaults.SetIstioConfigDumpURL(ts.URL)
+	defer defaults.SetVerifyTrafficRouterWeight(false)
+	defer defaults.SetIstioConfigDumpURL("")
+
+	served = configDump(5, 95)
+	verified, err = r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.False(t, *verified)
+
+	served = configDump(10, 90)
+	verified, err = r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+
+	served = `{"configs": []}`
+	verified, err = r.VerifyWeight(10)
+	assert.Error(t, err)
+	assert.False(t, *verified)
+}
+
+func TestVerifyWeightFromEnvoyConfigDumpWithSubsets(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"configs": [{"dynamic_route_configs": [{"route_config": {"virtual_hosts": [{"routes": [
+			{"name": "primary", "route": {"cluster": "outbound|80|stable|istio-rollout.default.svc.cluster.local"}}
+		]}]}}]}]}`))
+	}))
+	defer ts.Close()
+	defaults.SetVerifyTrafficRouterWeight(true)
+	defaults.SetIstioConfigDumpURL(ts.URL)
+	defer defaults.SetVerifyTrafficRouterWeight(false)
+	defer defaults.SetIstioConfigDumpURL("")
+
+	ro := rolloutWithDestinationRule()
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentStepIndex = pointer.Int32(0)
+	ro.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}}
+	dRule := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: istio-destrule
+  namespace: default
+spec:
+  host: istio-rollout
+  subsets:
+  - name: stable
+  - name: canary
+`)
+	r := NewReconciler(ro, testutil.NewFakeDynamicClient(dRule), record.NewFakeEventRecorder(), nil, nil)
+
+	verified, err := r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.False(t, *verified)
+
+	verified, err = r.VerifyWeight(0)
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+}
+
+func TestHttpReconcileStickinessCookie(t *testing.T) {
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{
+		Cookie: &v1alpha1.StickinessCookie{Name: "version", MaxAgeSeconds: pointer.Int64(3600)},
+	}
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentPodHash = "def456"
+	r := &Reconciler{rollout: ro}
+	vsvcRoutes := ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Routes
+
+	modifiedObj, modified, err := r.reconcileVirtualService(unstructuredutil.StrToUnstructuredUnsafe(regularVsvc), vsvcRoutes, nil, nil, 10)
+	assert.NoError(t, err)
+	assert.True(t, modified)
+	httpRoutes := extractHttpRoutes(t, modifiedObj)
+	assert.Len(t, httpRoutes, 4)
+	assert.Equal(t, "primary-sticky-canary", httpRoutes[0].Name)
+	assert.Equal(t, `^(.*?;\s*)?version=def456(;.*)?$`, httpRoutes[0].Match[0].Headers["cookie"].Regex)
+	checkDestination(t, httpRoutes[0].Route, "canary", 100)
+	assert.Equal(t, "primary-sticky-stable", httpRoutes[1].Name)
+	assert.Equal(t, `^(.*?;\s*)?version=abc123(;.*)?$`, httpRoutes[1].Match[0].Headers["cookie"].Regex)
+	checkDestination(t, httpRoutes[1].Route, "stable", 100)
+	assertHttpRouteWeightChanges(t, httpRoutes[2], "primary", 10, 90)
+	assert.Equal(t, "secondary", httpRoutes[3].Name)
+
+	routesI, _, _ := unstructured.NestedSlice(modifiedObj.Object, "spec", "http")
+	setCookie := func(routeIdx, destinationIdx int) string {
+		destinations := routesI[routeIdx].(map[string]any)["route"].([]any)
+		value, _, _ := unstructured.NestedString(destinations[destinationIdx].(map[string]any), "headers", "response", "add", "set-cookie")
+		return value
+	}
+	assert.Equal(t, "version=def456; Path=/; Max-Age=3600", setCookie(0, 0))
+	assert.Equal(t, "version=abc123; Path=/; Max-Age=3600", setCookie(2, 0))
+	assert.Equal(t, "version=def456; Path=/; Max-Age=3600", setCookie(2, 1))
+	assert.Equal(t, "", setCookie(3, 0))
+
+	// reconciling the same weight again does not modify the routes
+	_, modified, err = r.reconcileVirtualService(modifiedObj, vsvcRoutes, nil, nil, 10)
+	assert.NoError(t, err)
+	assert.False(t, modified)
+
+	// the users are not pinned once the traffic is not split anymore
+	modifiedObj, modified, err = r.reconcileVirtualService(modifiedObj, vsvcRoutes, nil, nil, 0)
+	assert.NoError(t, err)
+	assert.True(t, modified)
+	httpRoutes = extractHttpRoutes(t, modifiedObj)
+	assert.Len(t, httpRoutes, 2)
+	assertHttpRouteWeightChanges(t, httpRoutes[0], "primary", 0, 100)
+	routesI, _, _ = unstructured.NestedSlice(modifiedObj.Object, "spec", "http")
+	assert.Equal(t, "", setCookie(0, 0))
+	assert.NotContains(t, routesI[0].(map[string]any)["route"].([]any)[0], "headers")
+}
+
+func TestHttpReconcileStickinessHeader(t *testing.T) {
+	ro := rolloutWithDestinationRule()
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{Header: "X-Version"}
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentPodHash = "def456"
+	r := &Reconciler{rollout: ro}
+	vsvcRoutes := ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Routes
+
+	obj := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: vsvc
+  namespace: default
+spec:
+  http:
+  - name: primary
+    match:
+    - uri:
+        prefix: /api
+    route:
+    - destination:
+        host: istio-rollout
+        subset: stable
+      weight: 100
+    - destination:
+        host: istio-rollout
+        subset: canary
+      weight: 0
+`)
+	modifiedObj, modified, err := r.reconcileVirtualService(obj, vsvcRoutes, nil, nil, 10)
+	assert.NoError(t, err)
+	assert.True(t, modified)
+	httpRoutes := extractHttpRoutes(t, modifiedObj)
+	assert.Len(t, httpRoutes, 3)
+	assert.Equal(t, "primary-sticky-canary", httpRoutes[0].Name)
+	assert.Equal(t, "def456", httpRoutes[0].Match[0].Headers["x-version"].Exact)
+	assert.Equal(t, "/api", httpRoutes[0].Match[0].Uri.Prefix)
+	assert.Equal(t, "canary", httpRoutes[0].Route[0].Destination.Subset)
+	assert.Equal(t, "primary-sticky-stable", httpRoutes[1].Name)
+	assert.Equal(t, "abc123", httpRoutes[1].Match[0].Headers["x-version"].Exact)
+	assert.Equal(t, "stable", httpRoutes[1].Route[0].Destination.Subset)
+
+	routesI, _, _ := unstructured.NestedSlice(modifiedObj.Object, "spec", "http")
+	destinations := routesI[2].(map[string]any)["route"].([]any)
+	value, _, _ := unstructured.NestedString(destinations[0].(map[string]any), "headers", "response", "set", "x-version")
+	assert.Equal(t, "abc123", value)
+}
@@ -0,0 +1,199 @@
+package istio
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+)
+
+const (
+	stickyCanary = "canary"
+	stickyStable = "stable"
+)
+
+// stickyRouteName returns the name of the route which sends the users of the weighted route pinned to the version
+func stickyRouteName(routeName, version string) string {
+	return fmt.Sprintf("%s-sticky-%s", routeName, version)
+}
+
+// stickyVersions returns the pod template hashes the users are pinned to, which are only set while the traffic is
+// split between the stable and the canary
+func (r *Reconciler) stickyVersions(desiredWeight int32) map[string]string {
+	stableHash := r.rollout.Status.StableRS
+	canaryHash := r.rollout.Status.CurrentPodHash
+	if !trafficrouting.IsStickinessActive(r.rollout, desiredWeight) || stableHash == "" || canaryHash == "" || stableHash == canaryHash {
+		return nil
+	}
+	return map[string]string{stickyStable: stableHash, stickyCanary: canaryHash}
+}
+
+// destinationVersion returns whether the destination of a route is the stable or the canary
+func destinationVersion(destination map[string]any, svcSubsets svcSubsets) string {
+	dest, ok := destination["destination"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	host, _ := dest["host"].(string)
+	subset, _ := dest["subset"].(string)
+	host = getHost(VirtualServiceRouteDestination{Destination: VirtualServiceDestination{Host: host}})
+	switch {
+	case host == svcSubsets.canarySvc || (subset != "" && subset == svcSubsets.canarySubset):
+		return stickyCanary
+	case host == svcSubsets.stableSvc || (subset != "" && subset == svcSubsets.stableSubset):
+		return stickyStable
+	}
+	return ""
+}
+
+// setStickyResponseHeader sets the cookie, or the header, which pins the users to the version on the responses of
+// the destination. An empty version removes it
+func setStickyResponseHeader(destination map[string]any, stickiness *v1alpha1.TrafficStickiness, version string) {
+	headers, _ := destination["headers"].(map[string]any)
+	if headers == nil {
+		headers = map[string]any{}
+	}
+	response, _ := headers["response"].(map[string]any)
+	if response == nil {
+		response = map[string]any{}
+	}
+	operation, name, value := "set", strings.ToLower(stickiness.Header), version
+	if stickiness.Cookie != nil {
+		// the cookies of the application are kept, the header is added to them
+		operation, name, value = "add", "set-cookie", trafficrouting.StickinessSetCookie(stickiness.Cookie, version)
+	}
+	values, _ := response[operation].(map[string]any)
+	if values == nil {
+		values = map[string]any{}
+	}
+	if version != "" {
+		values[name] = value
+	} else if current, ok := values[name].(string); ok && (stickiness.Cookie == nil || strings.HasPrefix(current, stickiness.Cookie.Name+"=")) {
+		delete(values, name)
+	}
+
+	setOrDelete := func(m map[string]any, key string, value map[string]any) {
+		if len(value) > 0 {
+			m[key] = value
+		} else {
+			delete(m, key)
+		}
+	}
+	setOrDelete(response, operation, values)
+	setOrDelete(headers, "response", response)
+	setOrDelete(destination, "headers", headers)
+}
+
+// stickyRouteMatch returns the matches of the weighted route, restricted to the requests of the users pinned to the version
+func stickyRouteMatch(route map[string]any, stickiness *v1alpha1.TrafficStickiness, version string) []any {
+	name, match := strings.ToLower(stickiness.Header), map[string]any{"exact": version}
+	if stickiness.Cookie != nil {
+		name, match = "cookie", map[string]any{"regex": trafficrouting.StickinessCookieRegex(stickiness.Cookie, version)}
+	}
+	routeMatches, _ := route["match"].([]any)
+	if len(routeMatches) == 0 {
+		routeMatches = []any{map[string]any{}}
+	}
+	var stickyMatches []any
+	for _, routeMatch := range routeMatches {
+		stickyMatch, ok := runtime.DeepCopyJSONValue(routeMatch).(map[string]any)
+		if !ok {
+			continue
+		}
+		headers, _ := stickyMatch["headers"].(map[string]any)
+		if headers == nil {
+			headers = map[string]any{}
+		}
+		headers[name] = match
+		stickyMatch["headers"] = headers
+		stickyMatches = append(stickyMatches, stickyMatch)
+	}
+	return stickyMatches
+}
+
+// reconcileStickiness pins the users of the weighted HTTP routes to the version they were first sent to. The stable
+// and canary destinations of the weighted routes set a cookie, or a header, with the pod template hash of their
+// version on the responses, and a route ahead of every weighted route sends the requests which carry it to that
+// version. Returns the HTTP routes and whether they were modified
+func (r *Reconciler) reconcileStickiness(httpRoutesI []any, routeNames []string, desiredWeight int32) ([]any, bool, error) {
+	stickiness := trafficrouting.GetStickiness(r.rollout)
+	if stickiness == nil || len(routeNames) == 0 || len(httpRoutesI) == 0 {
+		return httpRoutesI, false, nil
+	}
+	orig := runtime.DeepCopyJSONValue(httpRoutesI)
+	versions := r.stickyVersions(desiredWeight)
+
+	stableSvc, canarySvc := trafficrouting.GetStableAndCanaryServices(r.rollout, false)
+	svcSubsets := svcSubsets{canarySvc: canarySvc, stableSvc: stableSvc}
+	if dRule := r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule; dRule != nil {
+		svcSubsets.canarySubset = dRule.CanarySubsetName
+		svcSubsets.stableSubset = dRule.StableSubsetName
+	}
+
+	stickyRouteNames := map[string]bool{}
+	for _, routeName := range routeNames {
+		stickyRouteNames[stickyRouteName(routeName, stickyCanary)] = true
+		stickyRouteNames[stickyRouteName(routeName, stickyStable)] = true
+	}
+	isRouteName := func(name string) bool {
+		for _, routeName := range routeNames {
+			if routeName == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	var newHttpRoutesI []any
+	for _, routeI := range httpRoutesI {
+		route, ok := routeI.(map[string]any)
+		if !ok {
+			return nil, false, fmt.Errorf(invalidCasting, "http[]", "map[string]interface")
+		}
+		name, _ := route["name"].(string)
+		if stickyRouteNames[name] {
+			// the sticky routes are created again from the weighted route
+			continue
+		}
+		if !isRouteName(name) {
+			newHttpRoutesI = append(newHttpRoutesI, route)
+			continue
+		}
+		destinations, ok := route["route"].([]any)
+		if !ok {
+			return nil, false, fmt.Errorf(invalidCasting, "http[].route", "[]interface")
+		}
+		versionDestinations := map[string]map[string]any{}
+		for _, destinationI := range destinations {
+			destination, ok := destinationI.(map[string]any)
+			if !ok {
+				return nil, false, fmt.Errorf(invalidCasting, "http[].route[].destination", "map[string]interface")
+			}
+			version := destinationVersion(destination, svcSubsets)
+			if version == "" {
+				continue
+			}
+			setStickyResponseHeader(destination, stickiness, versions[version])
+			versionDestinations[version] = destination
+		}
+		for _, version := range []string{stickyCanary, stickyStable} {
+			destination, ok := versionDestinations[version]
+			if versions == nil || !ok {
+				continue
+			}
+			stickyRoute := runtime.DeepCopyJSONValue(route).(map[string]any)
+			stickyDestination := runtime.DeepCopyJSONValue(destination).(map[string]any)
+			stickyDestination["weight"] = float64(100)
+			stickyRoute["name"] = stickyRouteName(name, version)
+			stickyRoute["match"] = stickyRouteMatch(route, stickiness, versions[version])
+			stickyRoute["route"] = []any{stickyDestination}
+			newHttpRoutesI = append(newHttpRoutesI, stickyRoute)
+		}
+		newHttpRoutesI = append(newHttpRoutesI, route)
+	}
+	return newHttpRoutesI, !reflect.DeepEqual(orig, newHttpRoutesI), nil
+}
@@ -14,12 +14,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
 // Type holds this controller type
@@ -135,6 +139,11 @@ func (r *Reconciler) buildCanaryIngress(stableIngress *networkingv1.Ingress, nam
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	// Pin the users sent to the canary with a session affinity cookie while the traffic is split
+	for k, v := range r.stickinessAnnotations(annotationPrefix, desiredWeight) {
+		desiredCanaryIngress.Annotations[k] = v
+	}
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
@@ -221,6 +230,11 @@ func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.I
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	// Pin the users sent to the canary with a session affinity cookie while the traffic is split
+	for k, v := range r.stickinessAnnotations(annotationPrefix, desiredWeight) {
+		desiredCanaryIngress.Annotations[k] = v
+	}
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
@@ -232,6 +246,28 @@ func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.I
 	return ingressutil.NewLegacyIngress(desiredCanaryIngress), nil
 }
 
+// stickinessAnnotations returns the session affinity annotations of the canary ingress which keep sending the
+// users to the canary once they were sent to it. The users sent to the stable are only pinned to it when the
+// stable ingress has the same session affinity annotations, since it is not managed by the rollout
+func (r *Reconciler) stickinessAnnotations(annotationPrefix string, desiredWeight int32) map[string]string {
+	annotations := map[string]string{}
+	if !trafficrouting.IsStickinessActive(r.cfg.Rollout, desiredWeight) {
+		return annotations
+	}
+	cookie := trafficrouting.GetStickiness(r.cfg.Rollout).Cookie
+	if cookie == nil {
+		return annotations
+	}
+	annotations[fmt.Sprintf("%s/affinity", annotationPrefix)] = "cookie"
+	annotations[fmt.Sprintf("%s/affinity-canary-behavior", annotationPrefix)] = "sticky"
+	annotations[fmt.Sprintf("%s/session-cookie-name", annotationPrefix)] = cookie.Name
+	annotations[fmt.Sprintf("%s/session-cookie-path", annotationPrefix)] = trafficrouting.GetStickinessCookiePath(cookie)
+	if cookie.MaxAgeSeconds != nil {
+		annotations[fmt.Sprintf("%s/session-cookie-max-age", annotationPrefix)] = fmt.Sprintf("%d", *cookie.MaxAgeSeconds)
+	}
+	return annotations
+}
+
 // canaryIngress returns the desired state of the canary ingress
 func (r *Reconciler) canaryIngress(stableIngress *ingressutil.Ingress, name string, desiredWeight int32) (*ingressutil.Ingress, error) {
 	switch stableIngress.Mode() {
@@ -355,8 +391,63 @@ func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) erro
 	return nil
 }
 
+// Backend is the subset of an ingress-nginx backend, as served by the /configuration/backends endpoint
+// of the controller, which holds the canary configuration
+type Backend struct {
+	Name                 string               `json:"name"`
+	TrafficShapingPolicy TrafficShapingPolicy `json:"trafficShapingPolicy,omitempty"`
+	AlternativeBackends  []string             `json:"alternativeBackends,omitempty"`
+}
+
+// TrafficShapingPolicy is the canary configuration of an ingress-nginx backend
+type TrafficShapingPolicy struct {
+	Weight      int64 `json:"weight"`
+	WeightTotal int64 `json:"weightTotal"`
+}
+
+// VerifyWeight verifies the weight against the backends configuration of the ingress-nginx controller. The
+// canary service is an alternative backend of the stable backends, which traffic shaping policy holds the weight
 func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
-	return nil, nil
+	url := defaults.GetNginxBackendsURL()
+	if !defaults.VerifyTrafficRouterWeight() || url == "" || !rolloututil.ShouldVerifyWeight(r.cfg.Rollout, desiredWeight) {
+		return nil, nil
+	}
+	var backends []Backend
+	if err := trafficrouting.GetDataPlaneConfig(context.TODO(), url, &backends); err != nil {
+		return pointer.Bool(false), err
+	}
+
+	// backends are named <namespace>-<service>-<port>
+	canaryPrefix := fmt.Sprintf("%s-%s-", r.cfg.Rollout.Namespace, r.cfg.Rollout.Spec.Strategy.Canary.CanaryService)
+	canaryBackends := map[string]bool{}
+	for _, backend := range backends {
+		for _, name := range backend.AlternativeBackends {
+			if strings.HasPrefix(name, canaryPrefix) {
+				canaryBackends[name] = true
+			}
+		}
+	}
+	if len(canaryBackends) == 0 {
+		r.log.Infof("Canary backend of service '%s' not yet configured", r.cfg.Rollout.Spec.Strategy.Canary.CanaryService)
+		return pointer.Bool(false), nil
+	}
+	maxWeight := weightutil.MaxTrafficWeight(r.cfg.Rollout)
+	var numCanaryBackends int
+	for _, backend := range backends {
+		if !canaryBackends[backend.Name] {
+			continue
+		}
+		numCanaryBackends++
+		weightTotal := backend.TrafficShapingPolicy.WeightTotal
+		if weightTotal == 0 {
+			weightTotal = 100
+		}
+		if !trafficrouting.IsWeightVerified(backend.TrafficShapingPolicy.Weight, weightTotal, desiredWeight, maxWeight) {
+			r.log.Infof("Canary backend '%s' not yet verified: weight %d/%d", backend.Name, backend.TrafficShapingPolicy.Weight, weightTotal)
+			return pointer.Bool(false), nil
+		}
+	}
+	return pointer.Bool(numCanaryBackends == len(canaryBackends)), nil
 }
 
 // UpdateHash informs a traffic routing reconciler about new canary/stable pod hashes
@@ -2,6 +2,8 @@ package nginx
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"k8s.io/utils/pointer"
@@ -21,6 +23,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
@@ -614,6 +617,34 @@ func TestCanaryIngressAdditionalAnnotationsNewIngress(t *testing.T) {
 	}
 }
 
+func TestCanaryIngressStickiness(t *testing.T) {
+	r := Reconciler{
+		cfg: ReconcilerConfig{
+			Rollout: fakeRollout(stableService, canaryService, "stable-ingress", nil),
+		},
+	}
+	r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{
+		Cookie: &v1alpha1.StickinessCookie{Name: "version", MaxAgeSeconds: pointer.Int64(3600)},
+	}
+	stableIngress := ingressutil.NewIngress(networkingIngress("stable-ingress", 80, stableService))
+
+	desiredCanaryIngress, err := r.canaryIngress(stableIngress, ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), "stable-ingress"), 15)
+	assert.NoError(t, err)
+	annotations := desiredCanaryIngress.GetAnnotations()
+	assert.Equal(t, "cookie", annotations["nginx.ingress.kubernetes.io/affinity"])
+	assert.Equal(t, "sticky", annotations["nginx.ingress.kubernetes.io/affinity-canary-behavior"])
+	assert.Equal(t, "version", annotations["nginx.ingress.kubernetes.io/session-cookie-name"])
+	assert.Equal(t, "/", annotations["nginx.ingress.kubernetes.io/session-cookie-path"])
+	assert.Equal(t, "3600", annotations["nginx.ingress.kubernetes.io/session-cookie-max-age"])
+
+	// the users are not pinned to the canary once the traffic is not split anymore
+	desiredCanaryIngress, err = r.canaryIngress(stableIngress, ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), "stable-ingress"), 0)
+	assert.NoError(t, err)
+	annotations = desiredCanaryIngress.GetAnnotations()
+	assert.NotContains(t, annotations, "nginx.ingress.kubernetes.io/affinity")
+	assert.NotContains(t, annotations, "nginx.ingress.kubernetes.io/session-cookie-name")
+}
+
 func TestCanaryIngressMaxWeightInTrafficRouting(t *testing.T) {
 	maxWeights := []*int32{nil, pointer.Int32(1000)}
 	for _, maxWeight := range maxWeights {
@@ -1135,3 +1166,59 @@ func TestReconcileCanaryCreateErrorAlreadyExistsPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyWeight(t *testing.T) {
+	var served string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(served))
+	}))
+	defer ts.Close()
+	backends := func(canaryWeight int) string {
+		return fmt.Sprintf(`[
+			{"name": "default-stable-service-80", "alternativeBackends": ["default-canary-service-80"]},
+			{"name": "default-canary-service-80", "noServer": true, "trafficShapingPolicy": {"weight": %d, "weightTotal": 100}}
+		]`, canaryWeight)
+	}
+
+	ro := fakeRollout(stableService, canaryService, StableIngress, nil)
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentStepIndex = pointer.Int32(0)
+	ro.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}}
+	r := NewReconciler(ReconcilerConfig{
+		Rollout:        ro,
+		Client:         fake.NewSimpleClientset(),
+		Recorder:       record.NewFakeEventRecorder(),
+		ControllerKind: schema.GroupVersionKind{Group: "foo", Version: "v1", Kind: "Bar"},
+	})
+
+	// verification is disabled by default
+	verified, err := r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.Nil(t, verified)
+
+	defaults.SetVerifyTrafficRouterWeight(true)
+	defaults.SetNginxBackendsURL(ts.URL)
+	defer defaults.SetVerifyTrafficRouterWeight(false)
+	defer defaults.SetNginxBackendsURL("")
+
+	served = backends(10)
+	verified, err = r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+
+	served = backends(0)
+	verified, err = r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.False(t, *verified)
+
+	// the canary backend is not yet configured
+	served = `[{"name": "default-stable-service-80"}]`
+	verified, err = r.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.False(t, *verified)
+
+	served = `not json`
+	verified, err = r.VerifyWeight(10)
+	assert.Error(t, err)
+	assert.False(t, *verified)
+}
@@ -14,12 +14,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/diff"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 )
 
 const (
@@ -174,8 +177,58 @@ func NewReconciler(cfg ReconcilerConfig) (*Reconciler, error) {
 	return r, nil
 }
 
+// VerifyWeight verifies the weights against the TrafficSplit as served by the API server. SMI does not
+// expose the state of the data plane, so this only ensures the TrafficSplit was persisted with the weights
 func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
-	return nil, nil
+	if !defaults.VerifyTrafficRouterWeight() || !rolloututil.ShouldVerifyWeight(r.cfg.Rollout, desiredWeight) {
+		return nil, nil
+	}
+	trafficSplitName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.SMI.TrafficSplitName
+	if trafficSplitName == "" {
+		trafficSplitName = r.cfg.Rollout.Name
+	}
+	ts, err := r.getTrafficSplit(trafficSplitName)
+	if err != nil {
+		return pointer.Bool(false), err
+	}
+	weights := ts.backendWeights()
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	if !trafficrouting.IsWeightVerified(weights[r.cfg.Rollout.Spec.Strategy.Canary.CanaryService], total, desiredWeight, 100) {
+		r.log.Infof("TrafficSplit `%s` not yet verified: canary weight %d/%d", trafficSplitName, weights[r.cfg.Rollout.Spec.Strategy.Canary.CanaryService], total)
+		return pointer.Bool(false), nil
+	}
+	for _, dest := range additionalDestinations {
+		if !trafficrouting.IsWeightVerified(weights[dest.ServiceName], total, dest.Weight, 100) {
+			r.log.Infof("TrafficSplit `%s` not yet verified: weight of `%s` %d/%d", trafficSplitName, dest.ServiceName, weights[dest.ServiceName], total)
+			return pointer.Bool(false), nil
+		}
+	}
+	return pointer.Bool(true), nil
+}
+
+// backendWeights returns the weights of the backend services of the TrafficSplit
+func (ts VersionedTrafficSplits) backendWeights() map[string]int64 {
+	weights := map[string]int64{}
+	switch {
+	case ts.ts1 != nil:
+		for _, backend := range ts.ts1.Spec.Backends {
+			if backend.Weight != nil {
+				weights[backend.Service] += backend.Weight.Value()
+			}
+		}
+	case ts.ts2 != nil:
+		for _, backend := range ts.ts2.Spec.Backends {
+			weights[backend.Service] += int64(backend.Weight)
+		}
+	case ts.ts3 != nil:
+		for _, backend := range ts.ts3.Spec.Backends {
+			weights[backend.Service] += int64(backend.Weight)
+		}
+	}
+	return weights
 }
 
 // Type indicates this reconciler is an SMI reconciler
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	core "k8s.io/client-go/testing"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -635,3 +636,40 @@ func TestReconcileSetMirrorRoute(t *testing.T) {
 		assert.Len(t, actions, 0)
 	})
 }
+
+func TestVerifyWeight(t *testing.T) {
+	ro := fakeRollout("stable-service", "canary-service", "root-service", "traffic-split")
+	ro.Status.StableRS = "abc123"
+	ro.Status.CurrentStepIndex = pointer.Int32(0)
+	ro.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}}
+	objMeta := objectMeta("traffic-split", ro, schema.GroupVersionKind{})
+	ts1 := trafficSplitV1Alpha1(ro, objMeta, "root-service", int32(10), v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 5})
+	client := fake.NewSimpleClientset(ts1)
+	r, err := NewReconciler(ReconcilerConfig{
+		Rollout:        ro,
+		Client:         client,
+		Recorder:       record.NewFakeEventRecorder(),
+		ControllerKind: schema.GroupVersionKind{},
+	})
+	assert.Nil(t, err)
+
+	// verification is disabled by default
+	verified, err := r.VerifyWeight(10)
+	assert.Nil(t, err)
+	assert.Nil(t, verified)
+
+	defaults.SetVerifyTrafficRouterWeight(true)
+	defer defaults.SetVerifyTrafficRouterWeight(false)
+
+	verified, err = r.VerifyWeight(10, v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 5})
+	assert.Nil(t, err)
+	assert.True(t, *verified)
+
+	verified, err = r.VerifyWeight(20, v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 5})
+	assert.Nil(t, err)
+	assert.False(t, *verified)
+
+	verified, err = r.VerifyWeight(10, v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 10})
+	assert.Nil(t, err)
+	assert.False(t, *verified)
+}
@@ -0,0 +1,53 @@
+package trafficrouting
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// DefaultStickinessCookiePath is the path of the stickiness cookie when the rollout does not set one
+const DefaultStickinessCookiePath = "/"
+
+// GetStickiness returns the stickiness of the canary traffic routing, or nil if the users are not pinned to a version
+func GetStickiness(ro *v1alpha1.Rollout) *v1alpha1.TrafficStickiness {
+	if ro.Spec.Strategy.Canary == nil || ro.Spec.Strategy.Canary.TrafficRouting == nil {
+		return nil
+	}
+	return ro.Spec.Strategy.Canary.TrafficRouting.Stickiness
+}
+
+// IsStickinessActive returns whether the users are pinned to a version at the desired weight. They are
+// only pinned while the traffic is split between the stable and the canary, so that the users pinned to
+// the canary of an aborted or promoted update are sent to the stable again
+func IsStickinessActive(ro *v1alpha1.Rollout, desiredWeight int32) bool {
+	if GetStickiness(ro) == nil {
+		return false
+	}
+	return desiredWeight > 0 && desiredWeight < weightutil.MaxTrafficWeight(ro)
+}
+
+// GetStickinessCookiePath returns the path of the stickiness cookie
+func GetStickinessCookiePath(cookie *v1alpha1.StickinessCookie) string {
+	if cookie.Path == "" {
+		return DefaultStickinessCookiePath
+	}
+	return cookie.Path
+}
+
+// StickinessSetCookie returns the value of the Set-Cookie header which pins a user to the version
+func StickinessSetCookie(cookie *v1alpha1.StickinessCookie, version string) string {
+	value := fmt.Sprintf("%s=%s; Path=%s", cookie.Name, version, GetStickinessCookiePath(cookie))
+	if cookie.MaxAgeSeconds != nil {
+		value = fmt.Sprintf("%s; Max-Age=%d", value, *cookie.MaxAgeSeconds)
+	}
+	return value
+}
+
+// StickinessCookieRegex returns the regular expression which matches the Cookie header of the requests of
+// the users pinned to the version
+func StickinessCookieRegex(cookie *v1alpha1.StickinessCookie, version string) string {
+	return fmt.Sprintf(`^(.*?;\s*)?%s=%s(;.*)?$`, regexp.QuoteMeta(cookie.Name), regexp.QuoteMeta(version))
+}
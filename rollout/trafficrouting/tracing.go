@@ -0,0 +1,84 @@
+package trafficrouting
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// tracingReconciler records a span for every call of a TrafficRoutingReconciler
+type tracingReconciler struct {
+	reconciler TrafficRoutingReconciler
+	ctx        context.Context
+	// routerType is looked up once since it is an RPC for traffic router plugins
+	routerType string
+}
+
+// NewTracingReconciler returns a TrafficRoutingReconciler which records a span, as a child of the
+// span in ctx, for every call of the given reconciler
+func NewTracingReconciler(ctx context.Context, reconciler TrafficRoutingReconciler) TrafficRoutingReconciler {
+	return &tracingReconciler{
+		reconciler: reconciler,
+		ctx:        ctx,
+		routerType: reconciler.Type(),
+	}
+}
+
+func (r *tracingReconciler) startSpan(name string) trace.Span {
+	_, span := tracing.StartSpan(r.ctx, "trafficRouting."+name, tracing.AttributeTrafficRouter.String(r.routerType))
+	return span
+}
+
+func (r *tracingReconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	span := r.startSpan("UpdateHash")
+	err := r.reconciler.UpdateHash(canaryHash, stableHash, additionalDestinations...)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracingReconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	span := r.startSpan("SetWeight")
+	span.SetAttributes(tracing.AttributeWeight.Int64(int64(desiredWeight)))
+	err := r.reconciler.SetWeight(desiredWeight, additionalDestinations...)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracingReconciler) SetHeaderRoute(setHeaderRoute *v1alpha1.SetHeaderRoute) error {
+	span := r.startSpan("SetHeaderRoute")
+	err := r.reconciler.SetHeaderRoute(setHeaderRoute)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracingReconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	span := r.startSpan("SetMirrorRoute")
+	err := r.reconciler.SetMirrorRoute(setMirrorRoute)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracingReconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	span := r.startSpan("VerifyWeight")
+	span.SetAttributes(tracing.AttributeWeight.Int64(int64(desiredWeight)))
+	verified, err := r.reconciler.VerifyWeight(desiredWeight, additionalDestinations...)
+	if verified != nil {
+		span.SetAttributes(tracing.AttributeWeightVerified.Bool(*verified))
+	}
+	tracing.EndSpan(span, err)
+	return verified, err
+}
+
+func (r *tracingReconciler) RemoveManagedRoutes() error {
+	span := r.startSpan("RemoveManagedRoutes")
+	err := r.reconciler.RemoveManagedRoutes()
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracingReconciler) Type() string {
+	return r.routerType
+}
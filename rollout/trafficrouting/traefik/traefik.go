@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/dynamic"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
@@ -128,6 +129,10 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	if err != nil {
 		return err
 	}
+	err = r.setSticky(traefikService, desiredWeight)
+	if err != nil {
+		return err
+	}
 	_, err = r.Client.Update(ctx, traefikService, metav1.UpdateOptions{})
 	if err != nil {
 		msg := fmt.Sprintf("Error updating traefik service %q: %s", traefikService.GetName(), err)
@@ -136,6 +141,27 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	return err
 }
 
+// setSticky pins the users to the service they were first sent to with the sticky cookie of the weighted
+// round robin while the traffic is split between the stable and the canary
+func (r *Reconciler) setSticky(traefikService *unstructured.Unstructured, desiredWeight int32) error {
+	stickiness := trafficrouting.GetStickiness(r.Rollout)
+	if stickiness == nil || stickiness.Cookie == nil {
+		return nil
+	}
+	if !trafficrouting.IsStickinessActive(r.Rollout, desiredWeight) {
+		unstructured.RemoveNestedField(traefikService.Object, "spec", "weighted", "sticky")
+		return nil
+	}
+	cookie := map[string]any{
+		"name": stickiness.Cookie.Name,
+		"path": trafficrouting.GetStickinessCookiePath(stickiness.Cookie),
+	}
+	if stickiness.Cookie.MaxAgeSeconds != nil {
+		cookie["maxAge"] = *stickiness.Cookie.MaxAgeSeconds
+	}
+	return unstructured.SetNestedMap(traefikService.Object, cookie, "spec", "weighted", "sticky", "cookie")
+}
+
 func getService(serviceName string, services []any) (map[string]any, error) {
 	var selectedService map[string]any
 	for _, service := range services {
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/utils/pointer"
 )
 
 const traefikService = `
@@ -107,6 +108,36 @@ func TestSetWeight(t *testing.T) {
 		assert.Equal(t, int64(70), stableServiceWeight)
 		assert.Equal(t, int64(30), canaryServiceWeight)
 	})
+	t.Run("SetWeightWithStickiness", func(t *testing.T) {
+		// Given
+		cfg := ReconcilerConfig{
+			Rollout: newRollout(stableServiceName, canaryServiceName, traefikServiceName),
+			Client:  &mocks.FakeClient{},
+		}
+		cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{
+			Cookie: &v1alpha1.StickinessCookie{Name: "version", MaxAgeSeconds: pointer.Int64(3600)},
+		}
+		r := NewReconciler(&cfg)
+
+		// When
+		err := r.SetWeight(30)
+
+		// Then
+		assert.NoError(t, err)
+		cookie, isFound, err := unstructured.NestedMap(mocks.TraefikServiceObj.Object, "spec", "weighted", "sticky", "cookie")
+		assert.NoError(t, err)
+		assert.True(t, isFound)
+		assert.Equal(t, map[string]any{"name": "version", "path": "/", "maxAge": int64(3600)}, cookie)
+
+		// When
+		err = r.SetWeight(0)
+
+		// Then
+		assert.NoError(t, err)
+		_, isFound, err = unstructured.NestedMap(mocks.TraefikServiceObj.Object, "spec", "weighted", "sticky")
+		assert.NoError(t, err)
+		assert.False(t, isFound)
+	})
 	t.Run("SetWeightWithError", func(t *testing.T) {
 		// Given
 		cfg := ReconcilerConfig{
@@ -0,0 +1,48 @@
+package trafficrouting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// dataPlaneConfigTimeout is the timeout of the requests reading the configuration of a data plane
+const dataPlaneConfigTimeout = 10 * time.Second
+
+var dataPlaneHTTPClient = &http.Client{Timeout: dataPlaneConfigTimeout}
+
+// GetDataPlaneConfig reads the JSON configuration which the data plane of a traffic router serves at the url into v
+func GetDataPlaneConfig(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := dataPlaneHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("reading data plane configuration from '%s' failed with status %d", url, resp.StatusCode)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("could not parse data plane configuration from '%s': %w", url, err)
+	}
+	return nil
+}
+
+// IsWeightVerified returns whether weight out of total weight is the same share of the traffic as the
+// desired weight out of the max traffic weight
+func IsWeightVerified(weight, total int64, desiredWeight, maxWeight int32) bool {
+	if total == 0 {
+		return desiredWeight == 0
+	}
+	return weight*int64(maxWeight) == int64(desiredWeight)*total
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix"
 
+	smifake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +23,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
 	apisixMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/gatewayapi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
@@ -29,8 +31,10 @@ import (
 	traefikMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik/mocks"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -177,6 +181,130 @@ func TestReconcileTrafficRoutingVerifyWeightEndOfRollout(t *testing.T) {
 	f.runExpectError(getKey(r2, t), true)
 }
 
+// newShadowStepFixture returns a fixture of a rollout at the given step, whose second step is a shadow step
+// followed by the given steps, or by a pause step
+func newShadowStepFixture(t *testing.T, stepIndex int32, following ...v1alpha1.CanaryStep) (*fixture, *v1alpha1.Rollout, *v1alpha1.AnalysisTemplate) {
+	f := newFixture(t)
+
+	at := analysisTemplate("response-diff")
+	shadow := &v1alpha1.RolloutShadowStep{
+		Name:         "shadow",
+		Match:        []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}},
+		ProxyService: "response-diff",
+		Analysis: v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+		},
+	}
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: pointer.Int32Ptr(10),
+		},
+		{
+			Shadow: shadow,
+		},
+	}
+	if len(following) == 0 {
+		following = []v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}}
+	}
+	steps = append(steps, following...)
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(stepIndex), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+	r2.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		GatewayAPI:    &v1alpha1.GatewayAPITrafficRouting{HTTPRoute: "http-route"},
+		ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "shadow"}},
+	}
+	r2.Spec.Strategy.Canary.CanaryService = "canary"
+	r2.Spec.Strategy.Canary.StableService = "stable"
+
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	canarySelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}
+	stableSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}
+	canarySvc := newService("canary", 80, canarySelector, r2)
+	stableSvc := newService("stable", 80, stableSelector, r2)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2, canarySvc, stableSvc)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.serviceLister = append(f.serviceLister, canarySvc, stableSvc)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 11, 1, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.objects = append(f.objects, r2, at)
+
+	f.fakeTrafficRouting = newUnmockedFakeTrafficRoutingReconciler()
+	f.fakeTrafficRouting.On("UpdateHash", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetWeight", mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetMirrorRoute", mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("VerifyWeight", mock.Anything).Return(pointer.BoolPtr(true), nil)
+	return f, r2, at
+}
+
+func TestRolloutShadowStepMirrorsToProxy(t *testing.T) {
+	f, ro, at := newShadowStepFixture(t, 1)
+	defer f.Close()
+
+	createdIndex := f.expectCreateAnalysisRunAction(analysisRun(at, v1alpha1.RolloutTypeStepLabel, ro))
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	createdAr := f.getCreatedAnalysisRun(createdIndex)
+	assert.Equal(t, "1", createdAr.Labels[v1alpha1.RolloutCanaryStepIndexLabel])
+	f.fakeTrafficRouting.AssertCalled(t, "SetMirrorRoute", &v1alpha1.SetMirrorRoute{
+		Name:    "shadow",
+		Match:   []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}},
+		Service: "response-diff",
+	})
+	f.fakeTrafficRouting.AssertNumberOfCalls(t, "SetMirrorRoute", 1)
+}
+
+func TestRolloutShadowStepRemovesMirrorRoute(t *testing.T) {
+	f, ro, _ := newShadowStepFixture(t, 2)
+	defer f.Close()
+
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetMirrorRoute", &v1alpha1.SetMirrorRoute{Name: "shadow"})
+	f.fakeTrafficRouting.AssertNumberOfCalls(t, "SetMirrorRoute", 1)
+}
+
+func TestRolloutShadowStepRemovesMirrorRouteAfterSkippedStep(t *testing.T) {
+	f, ro, _ := newShadowStepFixture(t, 3,
+		v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}, When: "false"},
+		v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}},
+	)
+	defer f.Close()
+	ro.Status.Canary.SkippedSteps = []int32{2}
+
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetMirrorRoute", &v1alpha1.SetMirrorRoute{Name: "shadow"})
+	f.fakeTrafficRouting.AssertNumberOfCalls(t, "SetMirrorRoute", 1)
+}
+
+func TestRolloutShadowStepRemovesMirrorRouteAfterBranch(t *testing.T) {
+	f, ro, _ := newShadowStepFixture(t, 3,
+		v1alpha1.CanaryStep{SetWeight: pointer.Int32Ptr(50)},
+		v1alpha1.CanaryStep{Name: "final", Pause: &v1alpha1.RolloutPause{}},
+	)
+	defer f.Close()
+	ro.Spec.Strategy.Canary.Steps[1].Branches = []v1alpha1.StepBranch{{When: "true", GoTo: "final"}}
+	ro.Status.CurrentStepHash = conditions.ComputeStepHash(ro)
+	// the branch jumped over the step setting the weight
+	ro.Status.Canary.SkippedSteps = []int32{2}
+
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	f.fakeTrafficRouting.AssertCalled(t, "SetMirrorRoute", &v1alpha1.SetMirrorRoute{Name: "shadow"})
+	f.fakeTrafficRouting.AssertNumberOfCalls(t, "SetMirrorRoute", 1)
+}
+
 func TestRolloutUseDesiredWeight(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -555,6 +683,48 @@ func TestRolloutSetWeightToZeroWhenFullyRolledOut(t *testing.T) {
 	f.run(getKey(r1, t))
 }
 
+func TestNewTrafficRoutingReconcilerDryRun(t *testing.T) {
+	smiClient := smifake.NewSimpleClientset()
+	rc := Controller{reconcilerBase: reconcilerBase{
+		smiclientset: smiClient,
+		recorder:     record.NewFakeEventRecorder(),
+	}}
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}}
+	r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Strategy.Canary.StableService = "stable"
+	r.Spec.Strategy.Canary.CanaryService = "canary"
+	r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		SMI:    &v1alpha1.SMITrafficRouting{},
+		DryRun: true,
+	}
+	r.Status.TrafficRoutingDryRun = []v1alpha1.TrafficRoutingChange{{Operation: "Delete", Kind: "TrafficSplit", Name: "outdated"}}
+	roCtx := &rolloutContext{
+		rollout:   r,
+		log:       logutil.WithRollout(r),
+		newStatus: *r.Status.DeepCopy(),
+	}
+	assert.True(t, isTrafficRoutingDryRun(r))
+
+	reconcilers, err := rc.NewTrafficRoutingReconciler(roCtx)
+	assert.NoError(t, err)
+	assert.Len(t, reconcilers, 1)
+	assert.Equal(t, smi.Type, reconcilers[0].Type())
+	assert.NoError(t, reconcilers[0].SetWeight(10))
+	verified, err := reconcilers[0].VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.Nil(t, verified)
+
+	for _, action := range smiClient.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+	assert.Len(t, roCtx.newStatus.TrafficRoutingDryRun, 1)
+	change := roCtx.newStatus.TrafficRoutingDryRun[0]
+	assert.Equal(t, "Create", change.Operation)
+	assert.Equal(t, "TrafficSplit", change.Kind)
+	assert.Equal(t, "foo", change.Name)
+	assert.Contains(t, change.Diff, `{"service":"canary","weight":"10"}`)
+}
+
 func TestNewTrafficRoutingReconciler(t *testing.T) {
 	rc := Controller{}
 	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(testutil.NewFakeDynamicClient(), 0)
@@ -744,6 +914,27 @@ func TestNewTrafficRoutingReconciler(t *testing.T) {
 			assert.Equal(t, apisix.Type, networkReconciler.Type())
 		}
 	}
+	{
+		tsController := Controller{
+			reconcilerBase: reconcilerBase{
+				dynamicclientset: testutil.NewFakeDynamicClient(),
+			},
+		}
+		r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			GatewayAPI: &v1alpha1.GatewayAPITrafficRouting{
+				HTTPRoute: "http-route",
+			},
+		}
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 1)
+		assert.Equal(t, gatewayapi.Type, networkReconcilerList[0].Type())
+	}
 	{
 		// (2) Multiple Reconcilers (Nginx + SMI)
 		tsController := Controller{}
@@ -1297,3 +1488,53 @@ func TestDontWeightToZeroWhenDynamicallyRollingBackToStable(t *testing.T) {
 	rs1Updated := f.getUpdatedReplicaSet(scaleUpIndex)
 	assert.Equal(t, int32(10), *rs1Updated.Spec.Replicas)
 }
+
+func TestCalculateWeightVerifiedCondition(t *testing.T) {
+	ro := newCanaryRollout("foo", 10, nil, []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}}, pointer.Int32(0), intstr.FromInt(1), intstr.FromInt(1))
+	recorder := record.NewFakeEventRecorder()
+	roCtx := &rolloutContext{
+		rollout: ro,
+		log:     logutil.WithRollout(ro),
+		reconcilerBase: reconcilerBase{
+			recorder: recorder,
+		},
+	}
+	newStatus := v1alpha1.RolloutStatus{
+		Canary: v1alpha1.CanaryStatus{
+			Weights: &v1alpha1.TrafficWeights{
+				Canary:   v1alpha1.WeightDestination{Weight: 10},
+				Verified: pointer.Bool(false),
+			},
+		},
+	}
+
+	// no condition without a weight verify timeout
+	roCtx.calculateWeightVerifiedCondition(&newStatus)
+	assert.Nil(t, conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified))
+
+	defaults.SetWeightVerifyTimeout(time.Minute)
+	defer defaults.SetWeightVerifyTimeout(0)
+	roCtx.calculateWeightVerifiedCondition(&newStatus)
+	cond := conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified)
+	assert.Equal(t, conditions.WeightNotVerifiedReason, cond.Reason)
+	assert.Equal(t, "Traffic weight 10 not yet verified", cond.Message)
+
+	now := timeutil.Now()
+	timeutil.SetNowTimeFunc(func() time.Time { return now.Add(2 * time.Minute) })
+	defer timeutil.SetNowTimeFunc(time.Now)
+	roCtx.calculateWeightVerifiedCondition(&newStatus)
+	cond = conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified)
+	assert.Equal(t, conditions.WeightVerifyTimeoutReason, cond.Reason)
+	assert.Equal(t, "Traffic weight 10 not verified within 1m0s", cond.Message)
+	assert.Equal(t, []string{conditions.WeightVerifyTimeoutReason}, recorder.Events())
+
+	// the verification of another weight starts over
+	newStatus.Canary.Weights.Canary.Weight = 20
+	roCtx.calculateWeightVerifiedCondition(&newStatus)
+	cond = conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified)
+	assert.Equal(t, conditions.WeightNotVerifiedReason, cond.Reason)
+
+	newStatus.Canary.Weights.Verified = pointer.Bool(true)
+	roCtx.calculateWeightVerifiedCondition(&newStatus)
+	assert.Nil(t, conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutWeightVerified))
+}
@@ -0,0 +1,403 @@
+package auth
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/yaml"
+
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// Action is an action on the rollouts of a namespace which is allowed by the rules
+type Action string
+
+const (
+	ActionGet      Action = "get"
+	ActionPromote  Action = "promote"
+	ActionAbort    Action = "abort"
+	ActionRetry    Action = "retry"
+	ActionRestart  Action = "restart"
+	ActionSetImage Action = "setImage"
+	ActionUndo     Action = "undo"
+
+	// Wildcard matches all the subjects, namespaces or actions of a rule
+	Wildcard = "*"
+
+	userSubjectPrefix  = "user:"
+	groupSubjectPrefix = "group:"
+)
+
+var actions = map[Action]bool{
+	ActionGet:      true,
+	ActionPromote:  true,
+	ActionAbort:    true,
+	ActionRetry:    true,
+	ActionRestart:  true,
+	ActionSetImage: true,
+	ActionUndo:     true,
+	Wildcard:       true,
+}
+
+// methodActions are the actions of the methods of the rollout service. The methods without an
+// action are allowed to any authenticated user, and the unknown methods are denied.
+var methodActions = map[string]Action{
+	"/rollout.RolloutService/GetRolloutInfo":    ActionGet,
+	"/rollout.RolloutService/WatchRolloutInfo":  ActionGet,
+	"/rollout.RolloutService/ListRolloutInfos":  ActionGet,
+	"/rollout.RolloutService/WatchRolloutInfos": ActionGet,
+	"/rollout.RolloutService/GetRolloutHistory": ActionGet,
+	"/rollout.RolloutService/GetRevisionDiff":   ActionGet,
+	"/rollout.RolloutService/GetRolloutAudit":   ActionGet,
+	"/rollout.RolloutService/PromoteRollout":    ActionPromote,
+	"/rollout.RolloutService/AbortRollout":      ActionAbort,
+	"/rollout.RolloutService/RetryRollout":      ActionRetry,
+	"/rollout.RolloutService/RestartRollout":    ActionRestart,
+	"/rollout.RolloutService/SetRolloutImage":   ActionSetImage,
+	"/rollout.RolloutService/UndoRollout":       ActionUndo,
+	"/rollout.RolloutService/GetNamespace":      "",
+	"/rollout.RolloutService/Version":           "",
+}
+
+// Config is the authentication and authorization config of the dashboard server
+type Config struct {
+	// OIDC authenticates the bearer tokens issued by an OIDC provider
+	OIDC *OIDCConfig `json:"oidc,omitempty"`
+	// TokenFile is a file of static bearer tokens, in the format of the static token file of
+	// Kubernetes: token,user,uid,"group1,group2"
+	TokenFile string `json:"tokenFile,omitempty"`
+	// Rules allow the users and groups to take actions on the rollouts of namespaces. All the other
+	// actions are denied.
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// OIDCConfig authenticates the bearer tokens issued by an OIDC provider, whose signature is validated
+// with the keys of a local JSON Web Key Set
+type OIDCConfig struct {
+	// Issuer is the required iss claim of the tokens
+	Issuer string `json:"issuer"`
+	// Audience is the required aud claim of the tokens, usually the client ID of the dashboard
+	Audience string `json:"audience"`
+	// JWKSFile is the file of the JSON Web Key Set of the issuer
+	JWKSFile string `json:"jwksFile"`
+	// UsernameClaim is the claim of the name of the user. Defaults to sub.
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// GroupsClaim is the claim of the groups of the user. Defaults to groups.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+}
+
+// Rule allows the subjects to take the actions on the rollouts of the namespaces
+type Rule struct {
+	// Subjects are users (user:<name>), groups (group:<name>), or * for all authenticated users
+	Subjects []string `json:"subjects"`
+	// Namespaces are the namespaces of the rollouts, or * for all namespaces
+	Namespaces []string `json:"namespaces"`
+	// Actions are the allowed actions, or * for all actions
+	Actions []Action `json:"actions"`
+}
+
+// User is an authenticated user
+type User struct {
+	Name   string
+	Groups []string
+}
+
+// Auth authenticates and authorizes the requests of the dashboard server
+type Auth struct {
+	config Config
+	keys   *keySet
+	tokens map[string]*User
+}
+
+// LoadConfig loads the auth config from a YAML file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid auth config %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// New returns the auth of the config, loading its key set and token file
+func New(config Config) (*Auth, error) {
+	if config.OIDC == nil && config.TokenFile == "" {
+		return nil, errors.New("auth config requires oidc or tokenFile")
+	}
+	for i, rule := range config.Rules {
+		if err := validateRule(rule); err != nil {
+			return nil, fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+	a := &Auth{config: config}
+	if config.OIDC != nil {
+		if config.OIDC.Issuer == "" || config.OIDC.Audience == "" || config.OIDC.JWKSFile == "" {
+			return nil, errors.New("oidc requires issuer, audience and jwksFile")
+		}
+		data, err := os.ReadFile(config.OIDC.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys, err = parseKeySet(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON Web Key Set %s: %w", config.OIDC.JWKSFile, err)
+		}
+	}
+	if config.TokenFile != "" {
+		f, err := os.Open(config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		a.tokens, err = parseTokenFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token file %s: %w", config.TokenFile, err)
+		}
+	}
+	return a, nil
+}
+
+func validateRule(rule Rule) error {
+	if len(rule.Subjects) == 0 || len(rule.Namespaces) == 0 || len(rule.Actions) == 0 {
+		return errors.New("subjects, namespaces and actions are required")
+	}
+	for _, subject := range rule.Subjects {
+		if subject != Wildcard && !strings.HasPrefix(subject, userSubjectPrefix) && !strings.HasPrefix(subject, groupSubjectPrefix) {
+			return fmt.Errorf("subject '%s' must be user:<name>, group:<name> or *", subject)
+		}
+	}
+	for _, action := range rule.Actions {
+		if !actions[action] {
+			return fmt.Errorf("unknown action '%s'", action)
+		}
+	}
+	return nil
+}
+
+// parseTokenFile parses a static token file, whose lines are token,user,uid,"group1,group2"
+func parseTokenFile(r io.Reader) (map[string]*User, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	tokens := map[string]*User{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return tokens, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("line %d: token, user and uid are required", line)
+		}
+		user := &User{Name: record[1]}
+		if len(record) > 3 && record[3] != "" {
+			user.Groups = strings.Split(record[3], ",")
+		}
+		tokens[record[0]] = user
+	}
+}
+
+// Authenticate returns the user of a bearer token
+func (a *Auth) Authenticate(token string) (*User, error) {
+	if user, ok := a.tokens[token]; ok {
+		return user, nil
+	}
+	if a.config.OIDC == nil {
+		return nil, errors.New("invalid token")
+	}
+	return a.authenticateOIDC(token)
+}
+
+func (a *Auth) authenticateOIDC(token string) (*User, error) {
+	oidc := a.config.OIDC
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(a.keys.methods()))
+	if _, err := parser.ParseWithClaims(token, claims, a.keys.keyFunc); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if !claims.VerifyExpiresAt(timeutil.Now().Unix(), true) {
+		return nil, errors.New("invalid token: token is expired or has no expiry")
+	}
+	if !claims.VerifyIssuer(oidc.Issuer, true) {
+		return nil, fmt.Errorf("invalid token: issuer is not %s", oidc.Issuer)
+	}
+	if !claims.VerifyAudience(oidc.Audience, true) {
+		return nil, fmt.Errorf("invalid token: audience is not %s", oidc.Audience)
+	}
+
+	usernameClaim := oidc.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = "sub"
+	}
+	name, _ := claims[usernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid token: no %s claim", usernameClaim)
+	}
+	user := &User{Name: name}
+	groupsClaim := oidc.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	switch groups := claims[groupsClaim].(type) {
+	case string:
+		user.Groups = []string{groups}
+	case []any:
+		for _, group := range groups {
+			if group, ok := group.(string); ok {
+				user.Groups = append(user.Groups, group)
+			}
+		}
+	}
+	return user, nil
+}
+
+// Authorize returns whether a rule allows the user to take the action on the rollouts of the namespace
+func (a *Auth) Authorize(user *User, namespace string, action Action) bool {
+	for _, rule := range a.config.Rules {
+		if matchesSubject(rule, user) && matches(rule.Namespaces, namespace) && matchesAction(rule, action) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesSubject(rule Rule, user *User) bool {
+	for _, subject := range rule.Subjects {
+		switch {
+		case subject == Wildcard:
+			return true
+		case strings.HasPrefix(subject, userSubjectPrefix):
+			if strings.TrimPrefix(subject, userSubjectPrefix) == user.Name {
+				return true
+			}
+		case strings.HasPrefix(subject, groupSubjectPrefix):
+			if matches(user.Groups, strings.TrimPrefix(subject, groupSubjectPrefix)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesAction(rule Rule, action Action) bool {
+	for _, a := range rule.Actions {
+		if a == Wildcard || a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns whether the values contain the value or the wildcard
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == value || v == Wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+type userKey struct{}
+
+// UserFromContext returns the authenticated user of a request, or nil if auth is disabled
+func UserFromContext(ctx context.Context) *User {
+	user, _ := ctx.Value(userKey{}).(*User)
+	return user
+}
+
+// authorize authenticates the bearer token of a request and authorizes its method on the namespace
+// of the request. Returns the context of the request with the user.
+func (a *Auth) authorize(ctx context.Context, method string, req any) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			if strings.HasPrefix(value, "Bearer ") {
+				token = strings.TrimPrefix(value, "Bearer ")
+			}
+		}
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "bearer token required")
+	}
+	user, err := a.Authenticate(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	action, ok := methodActions[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+	if action != "" {
+		var namespace string
+		if r, ok := req.(interface{ GetNamespace() string }); ok {
+			namespace = r.GetNamespace()
+		}
+		if !a.Authorize(user, namespace, action) {
+			return nil, status.Errorf(codes.PermissionDenied, "user '%s' is not allowed to %s rollouts in namespace '%s'", user.Name, action, namespace)
+		}
+	}
+	return context.WithValue(ctx, userKey{}, user), nil
+}
+
+// UnaryServerInterceptor authorizes the unary requests of the rollout service
+func (a *Auth) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes the streaming requests of the rollout service once their
+// request is received
+func (a *Auth) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{ServerStream: ss, auth: a, method: info.FullMethod})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	auth   *Auth
+	method string
+	ctx    context.Context
+}
+
+func (s *authorizedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ctx, err := s.auth.authorize(s.ServerStream.Context(), s.method, m)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+	return nil
+}
+
+func (s *authorizedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
@@ -0,0 +1,321 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+)
+
+const (
+	testIssuer   = "https://dex.example.com"
+	testAudience = "argo-rollouts"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// newTestAuth returns an auth with an OIDC config whose key set has an RSA and an EC key, and a
+// static token file
+func newTestAuth(t *testing.T, rules ...Rule) (*Auth, *rsa.PrivateKey, *ecdsa.PrivateKey) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encodeBigInt(rsaKey.N), "e": encodeBigInt(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encodeBigInt(ecKey.X), "y": encodeBigInt(ecKey.Y)},
+			{"kty": "RSA", "kid": "enc", "use": "enc", "n": "invalid"},
+		},
+	})
+	require.NoError(t, err)
+	a, err := New(Config{
+		OIDC: &OIDCConfig{
+			Issuer:   testIssuer,
+			Audience: testAudience,
+			JWKSFile: writeFile(t, "jwks.json", string(jwks)),
+		},
+		TokenFile: writeFile(t, "tokens.csv", "ci-token,ci,1001,\"deployers,viewers\"\nreadonly-token,readonly,1002\n"),
+		Rules:     rules,
+	})
+	require.NoError(t, err)
+	return a, rsaKey, ecKey
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    testIssuer,
+		"aud":    testAudience,
+		"sub":    "jane",
+		"email":  "jane@example.com",
+		"groups": []string{"developers"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestAuthenticateStaticToken(t *testing.T) {
+	a, _, _ := newTestAuth(t)
+
+	user, err := a.Authenticate("ci-token")
+	assert.NoError(t, err)
+	assert.Equal(t, &User{Name: "ci", Groups: []string{"deployers", "viewers"}}, user)
+
+	user, err = a.Authenticate("readonly-token")
+	assert.NoError(t, err)
+	assert.Equal(t, &User{Name: "readonly"}, user)
+
+	_, err = a.Authenticate("unknown-token")
+	assert.Error(t, err)
+}
+
+func TestAuthenticateOIDC(t *testing.T) {
+	a, rsaKey, ecKey := newTestAuth(t)
+
+	user, err := a.Authenticate(signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, &User{Name: "jane", Groups: []string{"developers"}}, user)
+
+	user, err = a.Authenticate(signToken(t, jwt.SigningMethodES256, "ec", ecKey, validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, "jane", user.Name)
+
+	a.config.OIDC.UsernameClaim = "email"
+	user, err = a.Authenticate(signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, "jane@example.com", user.Name)
+	a.config.OIDC.UsernameClaim = ""
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	invalid := map[string]func() string{
+		"signed with another key": func() string {
+			return signToken(t, jwt.SigningMethodRS256, "rsa", otherKey, validClaims())
+		},
+		"unknown key": func() string {
+			return signToken(t, jwt.SigningMethodRS256, "other", rsaKey, validClaims())
+		},
+		"key of another type": func() string {
+			return signToken(t, jwt.SigningMethodRS256, "ec", rsaKey, validClaims())
+		},
+		"unsigned": func() string {
+			return signToken(t, jwt.SigningMethodNone, "rsa", jwt.UnsafeAllowNoneSignatureType, validClaims())
+		},
+		"expired": func() string {
+			claims := validClaims()
+			claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			return signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims)
+		},
+		"no expiry": func() string {
+			claims := validClaims()
+			delete(claims, "exp")
+			return signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims)
+		},
+		"other issuer": func() string {
+			claims := validClaims()
+			claims["iss"] = "https://other.example.com"
+			return signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims)
+		},
+		"other audience": func() string {
+			claims := validClaims()
+			claims["aud"] = []string{"other"}
+			return signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims)
+		},
+		"no username": func() string {
+			claims := validClaims()
+			delete(claims, "sub")
+			return signToken(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims)
+		},
+	}
+	for name, token := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := a.Authenticate(token())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{})
+	assert.EqualError(t, err, "auth config requires oidc or tokenFile")
+
+	_, err = New(Config{OIDC: &OIDCConfig{Issuer: testIssuer}})
+	assert.EqualError(t, err, "oidc requires issuer, audience and jwksFile")
+
+	_, err = New(Config{OIDC: &OIDCConfig{Issuer: testIssuer, Audience: testAudience, JWKSFile: writeFile(t, "jwks.json", `{"keys": []}`)}})
+	assert.ErrorContains(t, err, "no signing keys")
+
+	_, err = New(Config{TokenFile: writeFile(t, "tokens.csv", "token,user\n")})
+	assert.ErrorContains(t, err, "line 1: token, user and uid are required")
+
+	tokenFile := writeFile(t, "tokens.csv", "token,user,1\n")
+	_, err = New(Config{TokenFile: tokenFile, Rules: []Rule{{Subjects: []string{"jane"}, Namespaces: []string{"*"}, Actions: []Action{ActionGet}}}})
+	assert.EqualError(t, err, "invalid rule 0: subject 'jane' must be user:<name>, group:<name> or *")
+
+	_, err = New(Config{TokenFile: tokenFile, Rules: []Rule{{Subjects: []string{"*"}, Namespaces: []string{"*"}, Actions: []Action{"delete"}}}})
+	assert.EqualError(t, err, "invalid rule 0: unknown action 'delete'")
+
+	_, err = New(Config{TokenFile: tokenFile, Rules: []Rule{{Subjects: []string{"*"}}}})
+	assert.EqualError(t, err, "invalid rule 0: subjects, namespaces and actions are required")
+}
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig(writeFile(t, "auth.yaml", `
+oidc:
+  issuer: https://dex.example.com
+  audience: argo-rollouts
+  jwksFile: /etc/argo-rollouts/jwks.json
+  usernameClaim: email
+rules:
+- subjects: ["group:developers"]
+  namespaces: ["dev", "staging"]
+  actions: ["*"]
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "email", config.OIDC.UsernameClaim)
+	assert.Equal(t, []Rule{{Subjects: []string{"group:developers"}, Namespaces: []string{"dev", "staging"}, Actions: []Action{Wildcard}}}, config.Rules)
+
+	_, err = LoadConfig(writeFile(t, "auth.yaml", "rule: []\n"))
+	assert.ErrorContains(t, err, "invalid auth config")
+}
+
+func TestAuthorize(t *testing.T) {
+	a, _, _ := newTestAuth(t,
+		Rule{Subjects: []string{"*"}, Namespaces: []string{"*"}, Actions: []Action{ActionGet}},
+		Rule{Subjects: []string{"group:developers"}, Namespaces: []string{"dev"}, Actions: []Action{Wildcard}},
+		Rule{Subjects: []string{"user:jane"}, Namespaces: []string{"prod"}, Actions: []Action{ActionPromote, ActionAbort}},
+	)
+	jane := &User{Name: "jane"}
+	developer := &User{Name: "john", Groups: []string{"developers"}}
+
+	assert.True(t, a.Authorize(jane, "prod", ActionGet))
+	assert.True(t, a.Authorize(jane, "prod", ActionPromote))
+	assert.True(t, a.Authorize(jane, "prod", ActionAbort))
+	assert.False(t, a.Authorize(jane, "prod", ActionSetImage))
+	assert.False(t, a.Authorize(jane, "dev", ActionPromote))
+
+	assert.True(t, a.Authorize(developer, "dev", ActionUndo))
+	assert.False(t, a.Authorize(developer, "prod", ActionPromote))
+	assert.True(t, a.Authorize(developer, "prod", ActionGet))
+
+	a.config.Rules = nil
+	assert.False(t, a.Authorize(jane, "prod", ActionGet))
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a, _, _ := newTestAuth(t,
+		Rule{Subjects: []string{"*"}, Namespaces: []string{"*"}, Actions: []Action{ActionGet}},
+		Rule{Subjects: []string{"group:deployers"}, Namespaces: []string{"prod"}, Actions: []Action{ActionPromote}},
+	)
+	interceptor := a.UnaryServerInterceptor()
+	var handled *User
+	handler := func(ctx context.Context, req any) (any, error) {
+		handled = UserFromContext(ctx)
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string, req any) error {
+		handled = nil
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	promote := &rollout.PromoteRolloutRequest{Name: "guestbook", Namespace: "prod"}
+
+	err := call(context.Background(), "/rollout.RolloutService/PromoteRollout", promote)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = call(bearerContext("unknown-token"), "/rollout.RolloutService/PromoteRollout", promote)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = call(bearerContext("readonly-token"), "/rollout.RolloutService/PromoteRollout", promote)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = user 'readonly' is not allowed to promote rollouts in namespace 'prod'")
+	assert.Nil(t, handled)
+
+	assert.NoError(t, call(bearerContext("readonly-token"), "/rollout.RolloutService/GetRolloutInfo", &rollout.RolloutInfoQuery{Name: "guestbook", Namespace: "prod"}))
+	assert.Equal(t, "readonly", handled.Name)
+
+	assert.NoError(t, call(bearerContext("ci-token"), "/rollout.RolloutService/PromoteRollout", promote))
+	assert.Equal(t, "ci", handled.Name)
+
+	// methods without an action are allowed to authenticated users, unknown methods are denied
+	assert.NoError(t, call(bearerContext("readonly-token"), "/rollout.RolloutService/Version", nil))
+	err = call(bearerContext("ci-token"), "/rollout.RolloutService/DeleteRollout", promote)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *rollout.RolloutInfoListQuery
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	*m.(*rollout.RolloutInfoListQuery) = *s.req
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	a, _, _ := newTestAuth(t, Rule{Subjects: []string{"user:ci"}, Namespaces: []string{"prod"}, Actions: []Action{ActionGet}})
+	interceptor := a.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/rollout.RolloutService/WatchRolloutInfos"}
+	var handled *User
+	handler := func(srv any, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(&rollout.RolloutInfoListQuery{}); err != nil {
+			return err
+		}
+		handled = UserFromContext(stream.Context())
+		return nil
+	}
+
+	stream := &fakeServerStream{ctx: bearerContext("ci-token"), req: &rollout.RolloutInfoListQuery{Namespace: "prod"}}
+	assert.NoError(t, interceptor(nil, stream, info, handler))
+	assert.Equal(t, "ci", handled.Name)
+
+	handled = nil
+	stream = &fakeServerStream{ctx: bearerContext("ci-token"), req: &rollout.RolloutInfoListQuery{Namespace: "dev"}}
+	err := interceptor(nil, stream, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, handled)
+}
@@ -31,7 +31,9 @@ import (
 	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/abort"
+	auditcmd "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/audit"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/get"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/history"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/promote"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/restart"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/retry"
@@ -39,7 +41,10 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/undo"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/viewcontroller"
+	"github.com/argoproj/argo-rollouts/server/auth"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	"github.com/argoproj/argo-rollouts/utils/json"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 	versionutils "github.com/argoproj/argo-rollouts/utils/version"
 )
 
@@ -56,6 +61,8 @@ type ServerOptions struct {
 	DynamicClientset  dynamic.Interface
 	Namespace         string
 	RootPath          string
+	// Auth authenticates and authorizes the requests when set. Otherwise all requests are allowed.
+	Auth *auth.Auth
 }
 
 const (
@@ -67,6 +74,9 @@ const (
 type ArgoRolloutsServer struct {
 	Options ServerOptions
 	stopCh  chan struct{}
+	// actor is the user the server runs as, recorded in the audit log of the rollouts for the requests
+	// which carry no authenticated user
+	actor string
 }
 
 // NewServer creates an ArgoRolloutsServer
@@ -111,8 +121,16 @@ func (s *ArgoRolloutsServer) newHTTPServer(ctx context.Context, port int) *http.
 }
 
 func (s *ArgoRolloutsServer) newGRPCServer() *grpc.Server {
-	grpcS := grpc.NewServer()
-	var rolloutsServer rollout.RolloutServiceServer = NewServer(s.Options)
+	var opts []grpc.ServerOption
+	if s.Options.Auth != nil {
+		opts = append(opts,
+			grpc.UnaryInterceptor(s.Options.Auth.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(s.Options.Auth.StreamServerInterceptor()),
+		)
+	}
+	grpcS := grpc.NewServer(opts...)
+	rolloutsServer := NewServer(s.Options)
+	rolloutsServer.actor = s.actor
 	rollout.RegisterRolloutServiceServer(grpcS, rolloutsServer)
 	return grpcS
 }
@@ -131,6 +149,7 @@ func (s *ArgoRolloutsServer) checkServeErr(name string, err error) {
 
 // Run starts the server
 func (s *ArgoRolloutsServer) Run(ctx context.Context, port int, dashboard bool) {
+	s.actor = audit.GetActor(ctx, s.Options.KubeClientset, nil)
 	httpServer := s.newHTTPServer(ctx, port)
 	grpcServer := s.newGRPCServer()
 
@@ -247,11 +266,12 @@ func (s *ArgoRolloutsServer) ListRolloutInfos(ctx context.Context, q *rollout.Ro
 		return nil, err
 	}
 
+	strategyTemplateGetter := strategytemplate.NewClientGetter(ctx, s.Options.RolloutsClientset)
 	var riList []*rollout.RolloutInfo
 	for i := range rolloutList.Items {
-		cur := rolloutList.Items[i]
-		ri := info.NewRolloutInfo(&cur, nil, nil, nil, nil, nil)
-		ri.ReplicaSets = info.GetReplicaSetInfo(cur.UID, &cur, allReplicaSets, allPods)
+		cur := strategytemplate.ResolvedRollout(&rolloutList.Items[i], strategyTemplateGetter)
+		ri := info.NewRolloutInfo(cur, nil, nil, nil, nil, nil)
+		ri.ReplicaSets = info.GetReplicaSetInfo(cur.UID, cur, allReplicaSets, allPods)
 		riList = append(riList, ri)
 	}
 
@@ -261,7 +281,12 @@ func (s *ArgoRolloutsServer) ListRolloutInfos(ctx context.Context, q *rollout.Ro
 func (s *ArgoRolloutsServer) RestartRollout(ctx context.Context, q *rollout.RestartRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
 	restartAt := time.Now().UTC()
-	return restart.RestartRollout(rolloutIf, q.GetName(), &restartAt)
+	ro, err := restart.RestartRollout(rolloutIf, q.GetName(), &restartAt)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, q.GetNamespace(), q.GetName(), audit.ActionRestart, audit.RestartMessage(restartAt))
+	return ro, nil
 }
 
 // WatchRolloutInfos returns a stream of all rollouts
@@ -288,6 +313,7 @@ func (s *ArgoRolloutsServer) WatchRolloutInfos(q *rollout.RolloutInfoListQuery,
 	podsInformer := kubeInformerFactory.Core().V1().Pods().Informer()
 
 	rolloutUpdateChan := make(chan *v1alpha1.Rollout)
+	strategyTemplateGetter := strategytemplate.NewClientGetter(ctx, s.Options.RolloutsClientset)
 
 	rolloutInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
@@ -327,6 +353,7 @@ func (s *ArgoRolloutsServer) WatchRolloutInfos(q *rollout.RolloutInfoListQuery,
 			}
 
 			// get shallow rollout info
+			ro = strategytemplate.ResolvedRollout(ro, strategyTemplateGetter)
 			ri := info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil)
 			send(ri)
 		}
@@ -339,6 +366,7 @@ func (s *ArgoRolloutsServer) RolloutToRolloutInfo(ro *v1alpha1.Rollout) (*rollou
 	if err != nil {
 		return nil, err
 	}
+	ro = strategytemplate.ResolvedRollout(ro, strategytemplate.NewClientGetter(ctx, s.Options.RolloutsClientset))
 	return info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil), nil
 }
 
@@ -362,12 +390,23 @@ func (s *ArgoRolloutsServer) GetNamespace(ctx context.Context, e *empty.Empty) (
 
 func (s *ArgoRolloutsServer) PromoteRollout(ctx context.Context, q *rollout.PromoteRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
-	return promote.PromoteRollout(rolloutIf, q.GetName(), false, false, q.GetFull())
+	ro, err := promote.PromoteRollout(rolloutIf, q.GetName(), false, false, q.GetFull(), q.GetIgnoreWindow())
+	if err != nil {
+		return nil, err
+	}
+	action, message := audit.PromoteAction(q.GetFull(), q.GetIgnoreWindow())
+	s.recordAudit(ctx, q.GetNamespace(), q.GetName(), action, message)
+	return ro, nil
 }
 
 func (s *ArgoRolloutsServer) AbortRollout(ctx context.Context, q *rollout.AbortRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
-	return abort.AbortRollout(rolloutIf, q.GetName())
+	ro, err := abort.AbortRollout(rolloutIf, q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	s.recordAudit(ctx, q.GetNamespace(), q.GetName(), audit.ActionAbort, "")
+	return ro, nil
 }
 
 func (s *ArgoRolloutsServer) getRollout(namespace string, name string) (*v1alpha1.Rollout, error) {
@@ -383,6 +422,7 @@ func (s *ArgoRolloutsServer) SetRolloutImage(ctx context.Context, q *rollout.Set
 	if err != nil {
 		return nil, err
 	}
+	s.recordAudit(ctx, q.GetNamespace(), q.GetRollout(), audit.ActionSetImage, audit.SetImageMessage(q.GetContainer(), imageString))
 	return s.getRollout(q.GetNamespace(), q.GetRollout())
 }
 
@@ -392,19 +432,52 @@ func (s *ArgoRolloutsServer) UndoRollout(ctx context.Context, q *rollout.UndoRol
 	if err != nil {
 		return nil, err
 	}
+	s.recordAudit(ctx, q.GetNamespace(), q.GetRollout(), audit.ActionUndo, audit.UndoMessage(q.GetRevision()))
 	return s.getRollout(q.GetNamespace(), q.GetRollout())
 }
 
+// GetRolloutHistory returns the revisions of a rollout
+func (s *ArgoRolloutsServer) GetRolloutHistory(ctx context.Context, q *rollout.RolloutHistoryQuery) (*rollout.RolloutHistory, error) {
+	return history.GetRolloutHistory(ctx, s.Options.RolloutsClientset, s.Options.KubeClientset, q.GetNamespace(), q.GetName())
+}
+
+// GetRevisionDiff returns the changes to the pod template of a rollout between two revisions
+func (s *ArgoRolloutsServer) GetRevisionDiff(ctx context.Context, q *rollout.RevisionDiffQuery) (*rollout.RevisionDiff, error) {
+	return history.GetRevisionDiff(ctx, s.Options.RolloutsClientset, s.Options.KubeClientset, q.GetNamespace(), q.GetName(), q.GetFromRevision(), q.GetToRevision())
+}
+
 func (s *ArgoRolloutsServer) RetryRollout(ctx context.Context, q *rollout.RetryRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
 	ro, err := retry.RetryRollout(rolloutIf, q.GetName())
 	if err != nil {
 		return nil, err
 	}
-
+	s.recordAudit(ctx, q.GetNamespace(), q.GetName(), audit.ActionRetry, "")
 	return ro, nil
 }
 
+// GetRolloutAudit returns the audit log of a rollout
+func (s *ArgoRolloutsServer) GetRolloutAudit(ctx context.Context, q *rollout.RolloutAuditQuery) (*rollout.RolloutAudit, error) {
+	return auditcmd.GetRolloutAudit(ctx, s.Options.RolloutsClientset, q.GetNamespace(), q.GetName())
+}
+
+// recordAudit records an action taken from the dashboard in the audit log of the rollout. The
+// actor is the authenticated user of the request, falling back to the identity the dashboard server
+// runs as when the request carries no user.
+func (s *ArgoRolloutsServer) recordAudit(ctx context.Context, namespace, name string, action audit.Action, message string) {
+	actor := s.actor
+	if user := auth.UserFromContext(ctx); user != nil {
+		actor = user.Name
+	}
+	if actor == "" {
+		actor = audit.UnknownActor
+	}
+	rolloutIf := s.Options.DynamicClientset.Resource(v1alpha1.RolloutGVR).Namespace(namespace)
+	if err := audit.Record(ctx, rolloutIf, name, actor, audit.SourceDashboard, action, message); err != nil {
+		log.Warnf("Failed to record %s of rollout '%s' in its audit log: %v", action, name, err)
+	}
+}
+
 func (s *ArgoRolloutsServer) Version(ctx context.Context, _ *empty.Empty) (*rollout.VersionInfo, error) {
 	version := versionutils.GetVersion()
 	return &rollout.VersionInfo{
@@ -27,7 +27,7 @@ import (
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
-	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 )
 
 const (
@@ -67,6 +67,9 @@ type ControllerConfig struct {
 	ResyncPeriod time.Duration
 
 	MetricsServer *metrics.MetricsServer
+
+	// StrategyTemplateGetter resolves the strategy of the rollouts referencing a strategy template
+	StrategyTemplateGetter strategytemplate.Getter
 }
 
 // Controller describes a service controller
@@ -81,8 +84,9 @@ type Controller struct {
 	serviceWorkqueue  workqueue.RateLimitingInterface
 	resyncPeriod      time.Duration
 
-	metricServer   *metrics.MetricsServer
-	enqueueRollout func(obj any)
+	metricServer           *metrics.MetricsServer
+	enqueueRollout         func(obj any)
+	strategyTemplateGetter strategytemplate.Getter
 }
 
 // NewController returns a new service controller
@@ -100,15 +104,12 @@ func NewController(cfg ControllerConfig) *Controller {
 		serviceWorkqueue: cfg.ServiceWorkqueue,
 		resyncPeriod:     cfg.ResyncPeriod,
 		metricServer:     cfg.MetricsServer,
+
+		strategyTemplateGetter: cfg.StrategyTemplateGetter,
 	}
 
 	util.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
-		serviceIndexName: func(obj any) (strings []string, e error) {
-			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-				return serviceutil.GetRolloutServiceKeys(ro), nil
-			}
-			return []string{}, nil
-		},
+		serviceIndexName: strategytemplate.IndexFunc(serviceutil.GetRolloutServiceKeys),
 	}))
 
 	cfg.ServicesInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -182,6 +183,13 @@ func (c *Controller) syncService(ctx context.Context, key string) error {
 	if hasManagedBy {
 		rollout, err := c.argoprojclientset.ArgoprojV1alpha1().Rollouts(svc.Namespace).Get(ctx, rolloutName, metav1.GetOptions{})
 		if err == nil {
+			rollout = strategytemplate.ResolvedRollout(rollout, c.strategyTemplateGetter)
+			if rollout.Spec.StrategyRef != nil && !rollout.Spec.StrategyResolvedFromRef {
+				// The services of the rollout are not known until its strategy template is resolved.
+				// Keep the hash selector rather than sending the traffic to all the pods.
+				c.enqueueRollout(rollout)
+				return nil
+			}
 			if serviceutil.CheckRolloutForService(rollout, svc) {
 				c.enqueueRollout(rollout)
 				return nil
@@ -230,15 +238,6 @@ func generateRemovePatch(svc *corev1.Service) string {
 
 // getRolloutsByService returns all rollouts which are referencing specified service
 func (c *Controller) getRolloutsByService(namespace string, serviceName string) ([]*v1alpha1.Rollout, error) {
-	objs, err := c.rolloutsIndexer.ByIndex(serviceIndexName, fmt.Sprintf("%s/%s", namespace, serviceName))
-	if err != nil {
-		return nil, err
-	}
-	var rollouts []*v1alpha1.Rollout
-	for _, obj := range objs {
-		if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
-			rollouts = append(rollouts, ro)
-		}
-	}
-	return rollouts, nil
+	key := fmt.Sprintf("%s/%s", namespace, serviceName)
+	return strategytemplate.ByIndex(c.rolloutsIndexer, serviceIndexName, key, c.strategyTemplateGetter, serviceutil.GetRolloutServiceKeys)
 }
@@ -15,11 +15,13 @@ import (
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -57,7 +59,7 @@ func TestGenerateRemovePatch(t *testing.T) {
 	assert.Equal(t, removeSelectorAndManagedByPatch, generateRemovePatch(svc))
 }
 
-func newFakeServiceController(svc *corev1.Service, rollout *v1alpha1.Rollout) (*Controller, *k8sfake.Clientset, *fake.Clientset, map[string]int) {
+func newFakeServiceController(svc *corev1.Service, rollout *v1alpha1.Rollout, templates ...*v1alpha1.RolloutStrategyTemplate) (*Controller, *k8sfake.Clientset, *fake.Clientset, map[string]int) {
 	client := fake.NewSimpleClientset()
 	if rollout != nil {
 		client = fake.NewSimpleClientset(rollout)
@@ -84,6 +86,11 @@ func newFakeServiceController(svc *corev1.Service, rollout *v1alpha1.Rollout) (*
 		ServiceWorkqueue:  serviceWorkqueue,
 		ResyncPeriod:      0,
 		MetricsServer:     metricsServer,
+
+		StrategyTemplateGetter: strategytemplate.NewListerGetter(
+			i.Argoproj().V1alpha1().RolloutStrategyTemplates().Lister(),
+			i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates().Lister(),
+		),
 	})
 	enqueuedObjects := map[string]int{}
 	c.enqueueRollout = func(obj any) {
@@ -103,6 +110,9 @@ func newFakeServiceController(svc *corev1.Service, rollout *v1alpha1.Rollout) (*
 	if svc != nil {
 		k8sI.Core().V1().Services().Informer().GetIndexer().Add(svc)
 	}
+	for _, template := range templates {
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates().Informer().GetIndexer().Add(template)
+	}
 	if rollout != nil {
 		i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(rollout)
 	}
@@ -236,6 +246,81 @@ func TestSyncServiceReferencedByRollout(t *testing.T) {
 	assert.Equal(t, 1, enqueuedObjects["default/rollout"])
 }
 
+// TestSyncServiceReferencedByStrategyRefRollout ensures the hash selector is kept on the services of a
+// rollout whose strategy is resolved from a strategy template
+func TestSyncServiceReferencedByStrategyRefRollout(t *testing.T) {
+	template := &v1alpha1.RolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "standard",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Args: []v1alpha1.Argument{{Name: "service"}},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					CanaryService: "{{args.service}}-canary",
+					StableService: "{{args.service}}-stable",
+				},
+			},
+		},
+	}
+	rollout := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			StrategyRef: &v1alpha1.RolloutStrategyRef{
+				Name: "standard",
+				Args: []v1alpha1.Argument{{Name: "service", Value: pointer.String("test-service")}},
+			},
+		},
+	}
+
+	t.Run("ManagedBy", func(t *testing.T) {
+		svc := newService("test-service-stable", 80, map[string]string{
+			v1alpha1.DefaultRolloutUniqueLabelKey: "abc",
+		})
+		svc.Annotations = map[string]string{
+			v1alpha1.ManagedByRolloutsKey: "rollout",
+		}
+		ctrl, kubeclient, _, enqueuedObjects := newFakeServiceController(svc, rollout, template)
+
+		err := ctrl.syncService(context.Background(), "default/test-service-stable")
+		assert.NoError(t, err)
+		assert.Len(t, kubeclient.Actions(), 0)
+		assert.Equal(t, 1, enqueuedObjects["default/rollout"])
+	})
+
+	t.Run("NoManagedBy", func(t *testing.T) {
+		svc := newService("test-service-canary", 80, map[string]string{
+			v1alpha1.DefaultRolloutUniqueLabelKey: "abc",
+		})
+		ctrl, kubeclient, client, enqueuedObjects := newFakeServiceController(svc, rollout, template)
+
+		err := ctrl.syncService(context.Background(), "default/test-service-canary")
+		assert.NoError(t, err)
+		assert.Len(t, kubeclient.Actions(), 0)
+		assert.Len(t, client.Actions(), 0)
+		assert.Equal(t, 1, enqueuedObjects["default/rollout"])
+	})
+
+	t.Run("MissingTemplate", func(t *testing.T) {
+		svc := newService("test-service-stable", 80, map[string]string{
+			v1alpha1.DefaultRolloutUniqueLabelKey: "abc",
+		})
+		svc.Annotations = map[string]string{
+			v1alpha1.ManagedByRolloutsKey: "rollout",
+		}
+		ctrl, kubeclient, _, enqueuedObjects := newFakeServiceController(svc, rollout)
+
+		err := ctrl.syncService(context.Background(), "default/test-service-stable")
+		assert.NoError(t, err)
+		assert.Len(t, kubeclient.Actions(), 0)
+		assert.Equal(t, 1, enqueuedObjects["default/rollout"])
+	})
+}
+
 func TestRun(t *testing.T) {
 	// make sure we can start and top the controller
 	c, _, _, _ := newFakeServiceController(nil, nil)
@@ -151,7 +151,7 @@ func (w *When) PromoteRollout() *When {
 	if w.rollout == nil {
 		w.t.Fatal("Rollout not set")
 	}
-	_, err := promote.PromoteRollout(w.rolloutClient.ArgoprojV1alpha1().Rollouts(w.namespace), w.rollout.GetName(), false, false, false)
+	_, err := promote.PromoteRollout(w.rolloutClient.ArgoprojV1alpha1().Rollouts(w.namespace), w.rollout.GetName(), false, false, false, false)
 	w.CheckError(err)
 	w.log.Info("Promoted rollout")
 	return w
@@ -161,7 +161,7 @@ func (w *When) PromoteRolloutFull() *When {
 	if w.rollout == nil {
 		w.t.Fatal("Rollout not set")
 	}
-	_, err := promote.PromoteRollout(w.rolloutClient.ArgoprojV1alpha1().Rollouts(w.namespace), w.rollout.GetName(), false, false, true)
+	_, err := promote.PromoteRollout(w.rolloutClient.ArgoprojV1alpha1().Rollouts(w.namespace), w.rollout.GetName(), false, false, true, false)
 	w.CheckError(err)
 	w.log.Info("Promoted rollout fully")
 	return w
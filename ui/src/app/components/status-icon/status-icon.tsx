@@ -6,6 +6,7 @@ export enum RolloutStatus {
     Progressing = 'Progressing',
     Degraded = 'Degraded',
     Paused = 'Paused',
+    Queued = 'Queued',
     Healthy = 'Healthy',
 }
 
@@ -32,6 +33,11 @@ export const StatusIcon = (props: {status: RolloutStatus; showTooltip?: boolean;
             className = 'paused';
             break;
         }
+        case 'Queued': {
+            icon = 'fa-clock';
+            className = 'paused';
+            break;
+        }
         case 'Degraded': {
             icon = 'fa-times-circle';
             className = 'degraded';
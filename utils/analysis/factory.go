@@ -66,6 +66,14 @@ func PostPromotionLabels(podHash, instanceID string) map[string]string {
 
 }
 
+// PostPromotionWeightLabels returns a map[string]string of common labels for the post promotion analysis
+// run at a blue-green traffic routing weight
+func PostPromotionWeightLabels(index int32, podHash, instanceID string) map[string]string {
+	labels := PostPromotionLabels(podHash, instanceID)
+	labels[v1alpha1.RolloutBlueGreenWeightIndexLabel] = strconv.Itoa(int(index))
+	return labels
+}
+
 // PrePromotionLabels returns a map[string]string of common labels for the pre promotion analysis
 func PrePromotionLabels(podHash, instanceID string) map[string]string {
 	labels := map[string]string{
@@ -106,10 +114,18 @@ func StepLabels(index int32, podHash, instanceID string) map[string]string {
 	return labels
 }
 
+// BurnRateWindowArg is the name of the argument which a burn rate metric resolves to each of its windows
+const BurnRateWindowArg = "window"
+
 // ResolveMetricArgs resolves args for single metric in AnalysisRun
 // Returns resolved metric
 // Uses ResolveQuotedArgs to handle escaped quotes
 func ResolveMetricArgs(metric v1alpha1.Metric, args []v1alpha1.Argument) (*v1alpha1.Metric, error) {
+	if metric.BurnRate != nil && !hasArg(args, BurnRateWindowArg) {
+		// the window is left as is, to be resolved when the burn rate is measured
+		window := fmt.Sprintf("{{args.%s}}", BurnRateWindowArg)
+		args = append(args[:len(args):len(args)], v1alpha1.Argument{Name: BurnRateWindowArg, Value: &window})
+	}
 	metricBytes, err := json.Marshal(metric)
 	if err != nil {
 		return nil, err
@@ -127,6 +143,21 @@ func ResolveMetricArgs(metric v1alpha1.Metric, args []v1alpha1.Argument) (*v1alp
 	return &newMetric, nil
 }
 
+// ResolveBurnRateWindow resolves the window argument of a burn rate metric
+func ResolveBurnRateWindow(metric v1alpha1.Metric, window v1alpha1.DurationString) (*v1alpha1.Metric, error) {
+	value := string(window)
+	return ResolveMetricArgs(metric, []v1alpha1.Argument{{Name: BurnRateWindowArg, Value: &value}})
+}
+
+func hasArg(args []v1alpha1.Argument, name string) bool {
+	for _, arg := range args {
+		if arg.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateMetrics validates an analysis template spec
 func ValidateMetrics(metrics []v1alpha1.Metric) error {
 	if len(metrics) == 0 {
@@ -228,6 +259,24 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if metric.Provider.SkyWalking != nil {
 		numProviders++
 	}
+	if metric.Provider.Statistical != nil {
+		numProviders++
+		if err := validateStatisticalMetric(metric.Provider.Statistical); err != nil {
+			return err
+		}
+	}
+	if metric.Provider.Probe != nil {
+		numProviders++
+		if err := validateProbeMetric(metric.Provider.Probe); err != nil {
+			return err
+		}
+	}
+	if metric.Provider.Log != nil {
+		numProviders++
+		if err := validateLogMetric(metric.Provider.Log); err != nil {
+			return err
+		}
+	}
 	if metric.Provider.Plugin != nil && len(metric.Provider.Plugin) > 0 {
 		// We allow exactly one plugin to be specified per analysis run template
 		numProviders = numProviders + len(metric.Provider.Plugin)
@@ -238,9 +287,150 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if numProviders > 1 {
 		return fmt.Errorf("multiple providers specified")
 	}
+	if metric.BurnRate != nil {
+		if err := validateBurnRateMetric(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateBurnRateMetric(metric v1alpha1.Metric) error {
+	burnRate := metric.BurnRate
+	if metric.SuccessCondition != "" || metric.FailureCondition != "" {
+		return fmt.Errorf("burnRate cannot be used with successCondition or failureCondition")
+	}
+	if metric.Provider.Job != nil || metric.Provider.Kayenta != nil || metric.Provider.Statistical != nil || metric.Provider.Probe != nil {
+		return fmt.Errorf("burnRate requires a provider which queries an error ratio")
+	}
+	objective, err := strconv.ParseFloat(burnRate.Objective, 64)
+	if err != nil || objective <= 0 || objective >= 1 {
+		return fmt.Errorf("burnRate objective must be a number between 0 and 1")
+	}
+	shortWindow, err := burnRate.ShortWindow.Duration()
+	if err != nil {
+		return fmt.Errorf("invalid burnRate shortWindow string: %v", err)
+	}
+	longWindow, err := burnRate.LongWindow.Duration()
+	if err != nil {
+		return fmt.Errorf("invalid burnRate longWindow string: %v", err)
+	}
+	if shortWindow >= longWindow {
+		return fmt.Errorf("burnRate shortWindow must be shorter than longWindow")
+	}
+	for name, multiplier := range map[string]string{
+		"fastBurnMultiplier": burnRate.FastBurnMultiplier,
+		"slowBurnMultiplier": burnRate.SlowBurnMultiplier,
+	} {
+		if multiplier == "" {
+			continue
+		}
+		if value, err := strconv.ParseFloat(multiplier, 64); err != nil || value <= 0 {
+			return fmt.Errorf("burnRate %s must be a number > 0", name)
+		}
+	}
 	return nil
 }
 
+func validateStatisticalMetric(stat *v1alpha1.StatisticalMetric) error {
+	if len(stat.Comparisons) == 0 {
+		return fmt.Errorf("statistical metric must have at least one comparison")
+	}
+	if stat.ConfidenceLevel != nil && (*stat.ConfidenceLevel <= 0 || *stat.ConfidenceLevel >= 100) {
+		return fmt.Errorf("statistical confidenceLevel must be between 0 and 100")
+	}
+	if stat.Threshold.Marginal > stat.Threshold.Pass {
+		return fmt.Errorf("statistical threshold marginal must be <= pass")
+	}
+	names := map[string]bool{}
+	for _, comparison := range stat.Comparisons {
+		if comparison.Name == "" {
+			return fmt.Errorf("statistical comparison name must be specified")
+		}
+		if names[comparison.Name] {
+			return fmt.Errorf("statistical comparison '%s' is defined multiple times", comparison.Name)
+		}
+		names[comparison.Name] = true
+		if comparison.Weight != nil && *comparison.Weight < 0 {
+			return fmt.Errorf("statistical comparison '%s' weight must be >= 0", comparison.Name)
+		}
+		if n := countStatisticalQueryProviders(comparison.Baseline); n != 1 {
+			return fmt.Errorf("statistical comparison '%s' baseline must specify exactly one provider", comparison.Name)
+		}
+		if n := countStatisticalQueryProviders(comparison.Canary); n != 1 {
+			return fmt.Errorf("statistical comparison '%s' canary must specify exactly one provider", comparison.Name)
+		}
+	}
+	return nil
+}
+
+const (
+	// maxProbeRequests is the maximum number of requests a probe fires per measurement
+	maxProbeRequests = 100
+	// maxProbeTimeoutSeconds is the maximum timeout of each request of a probe
+	maxProbeTimeoutSeconds = 30
+)
+
+func validateProbeMetric(probe *v1alpha1.ProbeMetric) error {
+	if probe.Port <= 0 || probe.Port > 65535 {
+		return fmt.Errorf("probe port must be between 1 and 65535")
+	}
+	if probe.Requests != nil && (*probe.Requests <= 0 || *probe.Requests > maxProbeRequests) {
+		return fmt.Errorf("probe requests must be between 1 and %d", maxProbeRequests)
+	}
+	if probe.TimeoutSeconds < 0 || probe.TimeoutSeconds > maxProbeTimeoutSeconds {
+		return fmt.Errorf("probe timeoutSeconds must be between 0 and %d", maxProbeTimeoutSeconds)
+	}
+	if probe.HTTP != nil && probe.GRPC != nil {
+		return fmt.Errorf("probe can specify either http or grpc, not both")
+	}
+	if probe.HTTP != nil && probe.HTTP.Scheme != "" && probe.HTTP.Scheme != "HTTP" && probe.HTTP.Scheme != "HTTPS" {
+		return fmt.Errorf("probe http scheme must be HTTP or HTTPS")
+	}
+	return nil
+}
+
+func validateLogMetric(logMetric *v1alpha1.LogMetric) error {
+	if logMetric.Address == "" {
+		return fmt.Errorf("log address must be specified")
+	}
+	if (logMetric.Loki == nil) == (logMetric.Elasticsearch == nil) {
+		return fmt.Errorf("log metric must specify either loki or elasticsearch")
+	}
+	if logMetric.Loki != nil {
+		if logMetric.Loki.Query == "" {
+			return fmt.Errorf("log loki query must be specified")
+		}
+		if (v1alpha1.Sigv4Config{}) != logMetric.Authentication.Sigv4 {
+			return fmt.Errorf("log sigv4 authentication is only supported with elasticsearch")
+		}
+	}
+	if logMetric.Elasticsearch != nil && (logMetric.Elasticsearch.Index == "" || logMetric.Elasticsearch.Query == "") {
+		return fmt.Errorf("log elasticsearch index and query must be specified")
+	}
+	if logMetric.TimeoutSeconds < 0 {
+		return fmt.Errorf("log timeoutSeconds must be >= 0")
+	}
+	return nil
+}
+
+func countStatisticalQueryProviders(query v1alpha1.StatisticalQuery) int {
+	numProviders := 0
+	for _, set := range []bool{
+		query.Prometheus != nil,
+		query.Datadog != nil,
+		query.Wavefront != nil,
+		query.NewRelic != nil,
+		query.Graphite != nil,
+		query.Influxdb != nil,
+	} {
+		if set {
+			numProviders++
+		}
+	}
+	return numProviders
+}
+
 func extractValueFromRollout(r *v1alpha1.Rollout, path string) (string, error) {
 	j, _ := json.Marshal(r)
 	m := any(nil)
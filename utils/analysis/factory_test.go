@@ -154,6 +154,18 @@ func TestPostPromotionLabels(t *testing.T) {
 	assert.Equal(t, expected, generated)
 }
 
+func TestPostPromotionWeightLabels(t *testing.T) {
+	podHash := "abcd123"
+	expected := map[string]string{
+		v1alpha1.LabelKeyControllerInstanceID:     "test",
+		v1alpha1.RolloutTypeLabel:                 v1alpha1.RolloutTypePostPromotionLabel,
+		v1alpha1.DefaultRolloutUniqueLabelKey:     podHash,
+		v1alpha1.RolloutBlueGreenWeightIndexLabel: "2",
+	}
+	generated := PostPromotionWeightLabels(2, podHash, "test")
+	assert.Equal(t, expected, generated)
+}
+
 func TestStepLabels(t *testing.T) {
 	podHash := "abcd123"
 	expected := map[string]string{
@@ -407,6 +419,145 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: multiple providers specified")
 	})
+	t.Run("Validate statistical metric", func(t *testing.T) {
+		query := v1alpha1.StatisticalQuery{Prometheus: &v1alpha1.PrometheusMetric{Query: "up"}}
+		newMetrics := func(stat v1alpha1.StatisticalMetric) []v1alpha1.Metric {
+			return []v1alpha1.Metric{{
+				Name:     "judgement",
+				Provider: v1alpha1.MetricProvider{Statistical: &stat},
+			}}
+		}
+		valid := v1alpha1.StatisticalMetric{
+			Comparisons: []v1alpha1.StatisticalComparison{{Name: "latency", Baseline: query, Canary: query}},
+			Threshold:   v1alpha1.KayentaThreshold{Pass: 90, Marginal: 75},
+		}
+		assert.NoError(t, ValidateMetrics(newMetrics(valid)))
+
+		noComparisons := *valid.DeepCopy()
+		noComparisons.Comparisons = nil
+		assert.EqualError(t, ValidateMetrics(newMetrics(noComparisons)), "metrics[0]: statistical metric must have at least one comparison")
+
+		invalidConfidence := *valid.DeepCopy()
+		invalidConfidence.ConfidenceLevel = pointer.Int64(100)
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidConfidence)), "metrics[0]: statistical confidenceLevel must be between 0 and 100")
+
+		invalidThreshold := *valid.DeepCopy()
+		invalidThreshold.Threshold.Marginal = 95
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidThreshold)), "metrics[0]: statistical threshold marginal must be <= pass")
+
+		duplicate := *valid.DeepCopy()
+		duplicate.Comparisons = append(duplicate.Comparisons, duplicate.Comparisons[0])
+		assert.EqualError(t, ValidateMetrics(newMetrics(duplicate)), "metrics[0]: statistical comparison 'latency' is defined multiple times")
+
+		noBaseline := *valid.DeepCopy()
+		noBaseline.Comparisons[0].Baseline = v1alpha1.StatisticalQuery{}
+		assert.EqualError(t, ValidateMetrics(newMetrics(noBaseline)), "metrics[0]: statistical comparison 'latency' baseline must specify exactly one provider")
+
+		multipleCanary := *valid.DeepCopy()
+		multipleCanary.Comparisons[0].Canary.Datadog = &v1alpha1.DatadogMetric{}
+		assert.EqualError(t, ValidateMetrics(newMetrics(multipleCanary)), "metrics[0]: statistical comparison 'latency' canary must specify exactly one provider")
+	})
+	t.Run("Validate probe metric", func(t *testing.T) {
+		newMetrics := func(probe v1alpha1.ProbeMetric) []v1alpha1.Metric {
+			return []v1alpha1.Metric{{
+				Name:     "health",
+				Provider: v1alpha1.MetricProvider{Probe: &probe},
+			}}
+		}
+		valid := v1alpha1.ProbeMetric{Port: 8080, HTTP: &v1alpha1.HTTPProbe{Path: "/healthz"}}
+		assert.NoError(t, ValidateMetrics(newMetrics(valid)))
+
+		noPort := *valid.DeepCopy()
+		noPort.Port = 0
+		assert.EqualError(t, ValidateMetrics(newMetrics(noPort)), "metrics[0]: probe port must be between 1 and 65535")
+
+		noRequests := *valid.DeepCopy()
+		noRequests.Requests = pointer.Int32(0)
+		assert.EqualError(t, ValidateMetrics(newMetrics(noRequests)), "metrics[0]: probe requests must be between 1 and 100")
+
+		tooManyRequests := *valid.DeepCopy()
+		tooManyRequests.Requests = pointer.Int32(101)
+		assert.EqualError(t, ValidateMetrics(newMetrics(tooManyRequests)), "metrics[0]: probe requests must be between 1 and 100")
+
+		longTimeout := *valid.DeepCopy()
+		longTimeout.TimeoutSeconds = 31
+		assert.EqualError(t, ValidateMetrics(newMetrics(longTimeout)), "metrics[0]: probe timeoutSeconds must be between 0 and 30")
+
+		both := *valid.DeepCopy()
+		both.GRPC = &v1alpha1.GRPCProbe{}
+		assert.EqualError(t, ValidateMetrics(newMetrics(both)), "metrics[0]: probe can specify either http or grpc, not both")
+
+		invalidScheme := *valid.DeepCopy()
+		invalidScheme.HTTP.Scheme = "FTP"
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidScheme)), "metrics[0]: probe http scheme must be HTTP or HTTPS")
+	})
+	t.Run("Validate log metric", func(t *testing.T) {
+		newMetrics := func(logMetric v1alpha1.LogMetric) []v1alpha1.Metric {
+			return []v1alpha1.Metric{{
+				Name:     "error-logs",
+				Provider: v1alpha1.MetricProvider{Log: &logMetric},
+			}}
+		}
+		loki := v1alpha1.LogMetric{Address: "http://loki:3100", Loki: &v1alpha1.LokiQuery{Query: `sum(count_over_time({app="guestbook"} |= "error" [5m]))`}}
+		assert.NoError(t, ValidateMetrics(newMetrics(loki)))
+		elasticsearch := v1alpha1.LogMetric{Address: "http://elasticsearch:9200", Elasticsearch: &v1alpha1.ElasticsearchQuery{Index: "logs-*", Query: `{"query": {"match": {"level": "error"}}}`}}
+		assert.NoError(t, ValidateMetrics(newMetrics(elasticsearch)))
+
+		noAddress := *loki.DeepCopy()
+		noAddress.Address = ""
+		assert.EqualError(t, ValidateMetrics(newMetrics(noAddress)), "metrics[0]: log address must be specified")
+
+		both := *loki.DeepCopy()
+		both.Elasticsearch = elasticsearch.Elasticsearch
+		assert.EqualError(t, ValidateMetrics(newMetrics(both)), "metrics[0]: log metric must specify either loki or elasticsearch")
+
+		noQuery := *loki.DeepCopy()
+		noQuery.Loki.Query = ""
+		assert.EqualError(t, ValidateMetrics(newMetrics(noQuery)), "metrics[0]: log loki query must be specified")
+
+		lokiSigv4 := *loki.DeepCopy()
+		lokiSigv4.Authentication.Sigv4.Region = "us-east-1"
+		assert.EqualError(t, ValidateMetrics(newMetrics(lokiSigv4)), "metrics[0]: log sigv4 authentication is only supported with elasticsearch")
+
+		noIndex := *elasticsearch.DeepCopy()
+		noIndex.Elasticsearch.Index = ""
+		assert.EqualError(t, ValidateMetrics(newMetrics(noIndex)), "metrics[0]: log elasticsearch index and query must be specified")
+	})
+	t.Run("Validate burn rate metric", func(t *testing.T) {
+		newMetrics := func(burnRate v1alpha1.BurnRateMetric) []v1alpha1.Metric {
+			return []v1alpha1.Metric{{
+				Name:     "error-budget",
+				BurnRate: &burnRate,
+				Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+			}}
+		}
+		valid := v1alpha1.BurnRateMetric{Objective: "0.999", ShortWindow: "5m", LongWindow: "1h"}
+		assert.NoError(t, ValidateMetrics(newMetrics(valid)))
+
+		withCondition := newMetrics(valid)
+		withCondition[0].SuccessCondition = "result[0] < 1"
+		assert.EqualError(t, ValidateMetrics(withCondition), "metrics[0]: burnRate cannot be used with successCondition or failureCondition")
+
+		withJob := newMetrics(valid)
+		withJob[0].Provider = v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}
+		assert.EqualError(t, ValidateMetrics(withJob), "metrics[0]: burnRate requires a provider which queries an error ratio")
+
+		invalidObjective := valid
+		invalidObjective.Objective = "99.9"
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidObjective)), "metrics[0]: burnRate objective must be a number between 0 and 1")
+
+		invalidWindow := valid
+		invalidWindow.LongWindow = "1 hour"
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidWindow)), "metrics[0]: invalid burnRate longWindow string: time: unknown unit \" hour\" in duration \"1 hour\"")
+
+		swappedWindows := valid
+		swappedWindows.ShortWindow, swappedWindows.LongWindow = valid.LongWindow, valid.ShortWindow
+		assert.EqualError(t, ValidateMetrics(newMetrics(swappedWindows)), "metrics[0]: burnRate shortWindow must be shorter than longWindow")
+
+		invalidMultiplier := valid
+		invalidMultiplier.SlowBurnMultiplier = "-1"
+		assert.EqualError(t, ValidateMetrics(newMetrics(invalidMultiplier)), "metrics[0]: burnRate slowBurnMultiplier must be a number > 0")
+	})
 }
 
 // TestResolveMetricArgs verifies that metric arguments are resolved
@@ -430,6 +581,31 @@ func TestResolveMetricArgs(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("result < %s", arg2), newMetric2.SuccessCondition)
 }
 
+// TestResolveMetricArgsBurnRateWindow verifies that the window argument of a burn rate metric is only
+// resolved to each of its windows
+func TestResolveMetricArgsBurnRateWindow(t *testing.T) {
+	service := "checkout"
+	args := []v1alpha1.Argument{{Name: "service", Value: &service}}
+	metric := v1alpha1.Metric{
+		Name:     "error-budget",
+		BurnRate: &v1alpha1.BurnRateMetric{Objective: "0.999", ShortWindow: "5m", LongWindow: "1h"},
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{Query: "errors{service=\"{{args.service}}\"}[{{args.window}}]"},
+		},
+	}
+	newMetric, err := ResolveMetricArgs(metric, args)
+	assert.NoError(t, err)
+	assert.Equal(t, "errors{service=\"checkout\"}[{{args.window}}]", newMetric.Provider.Prometheus.Query)
+
+	windowMetric, err := ResolveBurnRateWindow(*newMetric, newMetric.BurnRate.ShortWindow)
+	assert.NoError(t, err)
+	assert.Equal(t, "errors{service=\"checkout\"}[5m]", windowMetric.Provider.Prometheus.Query)
+
+	metric.BurnRate = nil
+	_, err = ResolveMetricArgs(metric, args)
+	assert.EqualError(t, err, "failed to resolve {{args.window}}")
+}
+
 // TestResolveMetricArgsWithQuotes verifies that metric arguments with quotes are resolved
 func TestResolveMetricArgsWithQuotes(t *testing.T) {
 	arg := "foo \"bar\" baz"
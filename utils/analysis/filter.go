@@ -37,6 +37,8 @@ func FilterCurrentRolloutAnalysisRuns(analysisRuns []*v1alpha1.AnalysisRun, r *v
 				currArs.CanaryStep = ar
 			case getArName(r.Status.Canary.CurrentBackgroundAnalysisRunStatus):
 				currArs.CanaryBackground = ar
+			case getArName(r.Status.Canary.PostPromotionAnalysisRunStatus):
+				currArs.CanaryPostPromotion = ar
 			case getArName(r.Status.BlueGreen.PrePromotionAnalysisRunStatus):
 				currArs.BlueGreenPrePromotion = ar
 			case getArName(r.Status.BlueGreen.PostPromotionAnalysisRunStatus):
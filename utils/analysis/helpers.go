@@ -24,6 +24,7 @@ type CurrentAnalysisRuns struct {
 	BlueGreenPostPromotion *v1alpha1.AnalysisRun
 	CanaryStep             *v1alpha1.AnalysisRun
 	CanaryBackground       *v1alpha1.AnalysisRun
+	CanaryPostPromotion    *v1alpha1.AnalysisRun
 }
 
 func (c CurrentAnalysisRuns) ToArray() []*v1alpha1.AnalysisRun {
@@ -40,6 +41,9 @@ func (c CurrentAnalysisRuns) ToArray() []*v1alpha1.AnalysisRun {
 	if c.CanaryBackground != nil {
 		currentAnalysisRuns = append(currentAnalysisRuns, c.CanaryBackground)
 	}
+	if c.CanaryPostPromotion != nil {
+		currentAnalysisRuns = append(currentAnalysisRuns, c.CanaryPostPromotion)
+	}
 	return currentAnalysisRuns
 }
 
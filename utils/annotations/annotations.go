@@ -28,6 +28,9 @@ const (
 	DesiredReplicasAnnotation = RolloutLabel + "/desired-replicas"
 	// WorkloadGenerationAnnotation is the generation of the referenced workload
 	WorkloadGenerationAnnotation = RolloutLabel + "/workload-generation"
+	// PromotedExperimentWinnerAnnotation records the pod template hash of the winning template of an experiment
+	// promoted as the canary, and the step the rollout resumes at once the promoted canary is created
+	PromotedExperimentWinnerAnnotation = RolloutLabel + "/promoted-experiment-winner"
 	// NotificationEngineAnnotation the annotation notification engine uses to determine if it should notify
 	NotificationEngineAnnotation = "notified.notifications.argoproj.io"
 )
@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// LogAnnotation is the annotation of a rollout which holds its audit log, a JSON list of the
+	// actions taken on the rollout, oldest first. The log is advisory: anyone who can update the
+	// rollout can edit it.
+	LogAnnotation = annotations.RolloutLabel + "/audit-log"
+	// MaxEntries is the number of entries kept in the audit log of a rollout. Older entries are dropped.
+	MaxEntries = 30
+
+	// UnknownActor is the actor of the actions whose user could not be determined
+	UnknownActor = "unknown"
+	// ControllerActor is the actor of the actions taken by the controller
+	ControllerActor = "argo-rollouts-controller"
+)
+
+// Source is where an action was taken from
+type Source string
+
+const (
+	SourceCLI        Source = "cli"
+	SourceDashboard  Source = "dashboard"
+	SourceController Source = "controller"
+)
+
+// Action is an action taken on a rollout
+type Action string
+
+const (
+	ActionPromote       Action = "Promote"
+	ActionPromoteFull   Action = "PromoteFull"
+	ActionAbort         Action = "Abort"
+	ActionRetry         Action = "Retry"
+	ActionPause         Action = "Pause"
+	ActionRestart       Action = "Restart"
+	ActionSetImage      Action = "SetImage"
+	ActionUndo          Action = "Undo"
+	ActionRollback      Action = "Rollback"
+	ActionPromoteWinner Action = "PromoteWinner"
+)
+
+// Entry is an action taken on a rollout recorded in its audit log
+type Entry struct {
+	// Time is when the action was taken
+	Time metav1.Time `json:"time"`
+	// Actor is the user which took the action
+	Actor string `json:"actor"`
+	// Source is where the action was taken from
+	Source Source `json:"source"`
+	// Action is the action which was taken
+	Action Action `json:"action"`
+	// Revision is the revision of the rollout when the action was taken
+	Revision string `json:"revision,omitempty"`
+	// Step is the step of the canary rollout when the action was taken, e.g. 2/5
+	Step string `json:"step,omitempty"`
+	// Message describes the action, e.g. the image which was set
+	Message string `json:"message,omitempty"`
+}
+
+// NewEntry returns an entry of an action taken on the rollout at its current revision and step
+func NewEntry(ro *v1alpha1.Rollout, actor string, source Source, action Action, message string) Entry {
+	entry := Entry{
+		Time:     timeutil.MetaNow(),
+		Actor:    actor,
+		Source:   source,
+		Action:   action,
+		Revision: ro.Annotations[annotations.RevisionAnnotation],
+		Message:  message,
+	}
+	if ro.Spec.Strategy.Canary != nil && ro.Status.CurrentStepIndex != nil {
+		entry.Step = fmt.Sprintf("%d/%d", *ro.Status.CurrentStepIndex, len(ro.Spec.Strategy.Canary.Steps))
+	}
+	return entry
+}
+
+// PromoteAction returns the action and message of a promotion
+func PromoteAction(full, ignoreWindow bool) (Action, string) {
+	action := ActionPromote
+	if full {
+		action = ActionPromoteFull
+	}
+	if ignoreWindow {
+		return action, "ignoring the deploy windows"
+	}
+	return action, ""
+}
+
+// RestartMessage returns the message of a restart of the pods at the given time
+func RestartMessage(restartAt time.Time) string {
+	return fmt.Sprintf("restart at %s", restartAt.UTC().Format(time.RFC3339))
+}
+
+// SetImageMessage returns the message of an update of the image of a container
+func SetImageMessage(container, image string) string {
+	return fmt.Sprintf("%s=%s", container, image)
+}
+
+// UndoMessage returns the message of a rollback to a revision, where 0 is the previous revision
+func UndoMessage(toRevision int64) string {
+	if toRevision == 0 {
+		return "to the previous revision"
+	}
+	return fmt.Sprintf("to revision %d", toRevision)
+}
+
+// GetEntries returns the audit log of the rollout, oldest first
+func GetEntries(ro metav1.Object) ([]Entry, error) {
+	value, ok := ro.GetAnnotations()[LogAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var entries []Entry
+	if err := json.Unmarshal([]byte(value), &entries); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", LogAnnotation, err)
+	}
+	return entries, nil
+}
+
+// AppendEntry returns the value of the audit log annotation of the rollout with the entry appended.
+// An invalid audit log is replaced, and only the last MaxEntries entries are kept.
+func AppendEntry(ro metav1.Object, entry Entry) (string, error) {
+	entries, _ := GetEntries(ro)
+	entries = append(entries, entry)
+	if len(entries) > MaxEntries {
+		entries = entries[len(entries)-MaxEntries:]
+	}
+	value, err := json.Marshal(entries)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// Record records an action taken by the actor in the audit log of the rollout, at the current
+// revision and step of the rollout. The rollout is patched with its resource version, so that
+// actions recorded at the same time are not lost. A dynamic client is used like for the other
+// updates made by the CLI, so that newer fields of the rollout are preserved. The action was
+// already taken when it is recorded, so callers report the error rather than fail the action.
+func Record(ctx context.Context, rolloutIf dynamic.ResourceInterface, name, actor string, source Source, action Action, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		un, err := rolloutIf.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		var ro v1alpha1.Rollout
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &ro); err != nil {
+			return err
+		}
+		value, err := AppendEntry(&ro, NewEntry(&ro, actor, source, action, message))
+		if err != nil {
+			return err
+		}
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"resourceVersion": ro.ResourceVersion,
+				"annotations": map[string]string{
+					LogAnnotation: value,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = rolloutIf.Patch(ctx, name, patchtypes.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// GetActor returns the user of the client, which is the impersonated user if any. Returns
+// UnknownActor when the user cannot be determined, e.g. when the Kubernetes API does not support
+// self subject reviews.
+func GetActor(ctx context.Context, kubeClient kubernetes.Interface, config *rest.Config) string {
+	if config != nil && config.Impersonate.UserName != "" {
+		return config.Impersonate.UserName
+	}
+	review, err := kubeClient.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil || review.Status.UserInfo.Username == "" {
+		return UnknownActor
+	}
+	return review.Status.UserInfo.Username
+}
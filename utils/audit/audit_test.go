@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Rollout",
+			APIVersion: "argoproj.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "guestbook",
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: map[string]string{"rollout.argoproj.io/revision": "3"},
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}, {Pause: &v1alpha1.RolloutPause{}}},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentStepIndex: pointer.Int32(1),
+		},
+	}
+}
+
+func TestNewEntry(t *testing.T) {
+	ro := newRollout()
+	entry := NewEntry(ro, "jane", SourceCLI, ActionPromote, "")
+	assert.Equal(t, "jane", entry.Actor)
+	assert.Equal(t, SourceCLI, entry.Source)
+	assert.Equal(t, ActionPromote, entry.Action)
+	assert.Equal(t, "3", entry.Revision)
+	assert.Equal(t, "1/2", entry.Step)
+
+	ro.Spec.Strategy.Canary = nil
+	ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{}
+	entry = NewEntry(ro, "jane", SourceCLI, ActionPromote, "")
+	assert.Equal(t, "", entry.Step)
+}
+
+func TestAppendEntry(t *testing.T) {
+	ro := newRollout()
+	for i := 0; i < MaxEntries+5; i++ {
+		value, err := AppendEntry(ro, Entry{Actor: "jane", Action: ActionRestart, Message: fmt.Sprintf("%d", i)})
+		assert.NoError(t, err)
+		ro.Annotations[LogAnnotation] = value
+	}
+	entries, err := GetEntries(ro)
+	assert.NoError(t, err)
+	assert.Len(t, entries, MaxEntries)
+	assert.Equal(t, "5", entries[0].Message)
+	assert.Equal(t, fmt.Sprintf("%d", MaxEntries+4), entries[MaxEntries-1].Message)
+
+	// an invalid audit log is replaced
+	ro.Annotations[LogAnnotation] = "not json"
+	_, err = GetEntries(ro)
+	assert.Error(t, err)
+	value, err := AppendEntry(ro, Entry{Actor: "jane", Action: ActionAbort})
+	assert.NoError(t, err)
+	ro.Annotations[LogAnnotation] = value
+	entries, err = GetEntries(ro)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestRecord(t *testing.T) {
+	ro := newRollout()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), mustToUnstructured(t, ro))
+	rolloutIf := client.Resource(v1alpha1.RolloutGVR).Namespace(ro.Namespace)
+
+	assert.NoError(t, Record(context.TODO(), rolloutIf, ro.Name, "jane", SourceCLI, ActionAbort, ""))
+	assert.NoError(t, Record(context.TODO(), rolloutIf, ro.Name, "john", SourceDashboard, ActionRetry, ""))
+
+	un, err := rolloutIf.Get(context.TODO(), ro.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	entries, err := GetEntries(un)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "jane", entries[0].Actor)
+	assert.Equal(t, ActionAbort, entries[0].Action)
+	assert.Equal(t, "john", entries[1].Actor)
+	assert.Equal(t, SourceDashboard, entries[1].Source)
+	assert.Equal(t, "3", entries[1].Revision)
+	assert.Equal(t, "1/2", entries[1].Step)
+}
+
+func TestRecordRetriesOnConflict(t *testing.T) {
+	ro := newRollout()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), mustToUnstructured(t, ro))
+	conflicts := 0
+	client.PrependReactor("patch", "*", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		if conflicts == 0 {
+			conflicts++
+			return true, nil, k8serrors.NewConflict(schema.GroupResource{Resource: "rollouts"}, ro.Name, fmt.Errorf("modified"))
+		}
+		return false, nil, nil
+	})
+	rolloutIf := client.Resource(v1alpha1.RolloutGVR).Namespace(ro.Namespace)
+
+	assert.NoError(t, Record(context.TODO(), rolloutIf, ro.Name, "jane", SourceCLI, ActionAbort, ""))
+	assert.Equal(t, 1, conflicts)
+	un, err := rolloutIf.Get(context.TODO(), ro.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	entries, err := GetEntries(un)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestGetActor(t *testing.T) {
+	kubeClient := k8sfake.NewSimpleClientset()
+	assert.Equal(t, UnknownActor, GetActor(context.TODO(), kubeClient, nil))
+
+	kubeClient.PrependReactor("create", "selfsubjectreviews", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		review := &authenticationv1.SelfSubjectReview{}
+		review.Status.UserInfo.Username = "jane@example.com"
+		return true, review, nil
+	})
+	assert.Equal(t, "jane@example.com", GetActor(context.TODO(), kubeClient, &rest.Config{}))
+
+	config := &rest.Config{Impersonate: rest.ImpersonationConfig{UserName: "john@example.com"}}
+	assert.Equal(t, "john@example.com", GetActor(context.TODO(), kubeClient, config))
+}
+
+func TestMessages(t *testing.T) {
+	action, message := PromoteAction(false, false)
+	assert.Equal(t, ActionPromote, action)
+	assert.Equal(t, "", message)
+	action, message = PromoteAction(true, true)
+	assert.Equal(t, ActionPromoteFull, action)
+	assert.Equal(t, "ignoring the deploy windows", message)
+
+	assert.Equal(t, "restart at 2026-01-02T10:00:00Z", RestartMessage(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "guestbook=argoproj/rollouts-demo:yellow", SetImageMessage("guestbook", "argoproj/rollouts-demo:yellow"))
+	assert.Equal(t, "to the previous revision", UndoMessage(0))
+	assert.Equal(t, "to revision 2", UndoMessage(2))
+}
+
+func mustToUnstructured(t *testing.T, ro *v1alpha1.Rollout) runtime.Object {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ro)
+	assert.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
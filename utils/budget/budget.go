@@ -0,0 +1,144 @@
+package budget
+
+import (
+	"sort"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+const (
+	// PriorityAnnotation is the annotation of the priority of a rollout in the queue of the progression
+	// budgets. Queued rollouts with a higher priority are let through first. Defaults to 0.
+	PriorityAnnotation = "rollouts.argoproj.io/priority"
+)
+
+// Matches returns whether the progression budget applies to the rollout
+func Matches(budget config.ProgressionBudget, ro *v1alpha1.Rollout) bool {
+	if len(budget.Namespaces) > 0 && !contains(budget.Namespaces, ro.Namespace) {
+		return false
+	}
+	if budget.Selector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(budget.Selector)
+	if err != nil {
+		// invalid selectors are reported when the config is loaded
+		return false
+	}
+	return selector.Matches(labels.Set(ro.Labels))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUpdating returns whether the rollout has an update in progress, which is not the initial
+// deployment and was not aborted. A spec change the controller has yet to observe is assumed to be
+// an update.
+func IsUpdating(ro *v1alpha1.Rollout) bool {
+	if ro.Status.StableRS == "" || ro.Status.Abort {
+		return false
+	}
+	if ro.Status.ObservedGeneration != strconv.FormatInt(ro.Generation, 10) {
+		return true
+	}
+	return ro.Status.CurrentPodHash != ro.Status.StableRS
+}
+
+// IsQueued returns whether the update of the rollout is queued by a progression budget
+func IsQueued(ro *v1alpha1.Rollout) bool {
+	return getQueuedCondition(ro) != nil
+}
+
+func getQueuedCondition(ro *v1alpha1.Rollout) *v1alpha1.PauseCondition {
+	for i := range ro.Status.PauseConditions {
+		if ro.Status.PauseConditions[i].Reason == v1alpha1.PauseReasonQueued {
+			return &ro.Status.PauseConditions[i]
+		}
+	}
+	return nil
+}
+
+// GetPriority returns the priority of the rollout in the queue
+func GetPriority(ro *v1alpha1.Rollout) int {
+	priority, err := strconv.Atoi(ro.Annotations[PriorityAnnotation])
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+// IsAdmitted returns whether the update of the rollout is let through by the progression budget.
+// An update which already started progressing is never queued again. Otherwise, the update is let
+// through when the queue of the budget, ordered by priority and then by the time the rollouts were
+// queued, has it among the rollouts fitting in the remaining budget. The other rollouts are the
+// rollouts the budget applies to.
+func IsAdmitted(budget config.ProgressionBudget, ro *v1alpha1.Rollout, others []*v1alpha1.Rollout) bool {
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	if !IsQueued(ro) && ro.Status.CurrentPodHash == podHash {
+		return true
+	}
+
+	active := 0
+	queue := []*v1alpha1.Rollout{ro}
+	for _, other := range others {
+		if other.Namespace == ro.Namespace && other.Name == ro.Name {
+			continue
+		}
+		if !IsUpdating(other) {
+			continue
+		}
+		if IsQueued(other) {
+			queue = append(queue, other)
+		} else {
+			// includes the updates which were not evaluated yet, which are queued on their first
+			// reconciliation if the budget is exhausted
+			active++
+		}
+	}
+	available := int(budget.MaxProgressing) - active
+	if available <= 0 {
+		return false
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queuedBefore(queue[i], queue[j])
+	})
+	for i := 0; i < available && i < len(queue); i++ {
+		if queue[i] == ro {
+			return true
+		}
+	}
+	return false
+}
+
+// queuedBefore orders the queue by priority, then by the time the rollouts were queued, and then by
+// name. A rollout which is not queued yet comes after the queued rollouts of the same priority.
+func queuedBefore(a, b *v1alpha1.Rollout) bool {
+	if pa, pb := GetPriority(a), GetPriority(b); pa != pb {
+		return pa > pb
+	}
+	ca, cb := getQueuedCondition(a), getQueuedCondition(b)
+	switch {
+	case ca != nil && cb == nil:
+		return true
+	case ca == nil && cb != nil:
+		return false
+	case ca != nil && cb != nil && !ca.StartTime.Equal(&cb.StartTime):
+		return ca.StartTime.Before(&cb.StartTime)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
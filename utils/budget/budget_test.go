@@ -0,0 +1,156 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+func newRollout(namespace, name string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Generation: 2,
+			Labels:     map[string]string{"team": "payments"},
+		},
+		Status: v1alpha1.RolloutStatus{
+			ObservedGeneration: "2",
+			StableRS:           "stable",
+			CurrentPodHash:     "stable",
+		},
+	}
+}
+
+// newUpdatingRollout returns a rollout with an update which is not evaluated by the budget yet
+func newUpdatingRollout(namespace, name string) *v1alpha1.Rollout {
+	ro := newRollout(namespace, name)
+	ro.Generation = 3
+	ro.Spec.Template.Labels = map[string]string{"app": name}
+	return ro
+}
+
+// newProgressingRollout returns a rollout with an update which is let through by the budget
+func newProgressingRollout(namespace, name string) *v1alpha1.Rollout {
+	ro := newUpdatingRollout(namespace, name)
+	ro.Status.ObservedGeneration = "3"
+	ro.Status.CurrentPodHash = hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	return ro
+}
+
+func newQueuedRollout(namespace, name string, queuedAt time.Time) *v1alpha1.Rollout {
+	ro := newProgressingRollout(namespace, name)
+	ro.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonQueued,
+		StartTime: metav1.NewTime(queuedAt),
+	}}
+	return ro
+}
+
+func TestMatches(t *testing.T) {
+	ro := newRollout("default", "foo")
+	assert.True(t, Matches(config.ProgressionBudget{Name: "all"}, ro))
+	assert.True(t, Matches(config.ProgressionBudget{Name: "ns", Namespaces: []string{"other", "default"}}, ro))
+	assert.False(t, Matches(config.ProgressionBudget{Name: "ns", Namespaces: []string{"other"}}, ro))
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}
+	assert.True(t, Matches(config.ProgressionBudget{Name: "team", Selector: selector}, ro))
+	assert.False(t, Matches(config.ProgressionBudget{Name: "team", Namespaces: []string{"other"}, Selector: selector}, ro))
+	selector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "search"}}
+	assert.False(t, Matches(config.ProgressionBudget{Name: "team", Selector: selector}, ro))
+}
+
+func TestIsUpdating(t *testing.T) {
+	ro := newRollout("default", "foo")
+	assert.False(t, IsUpdating(ro))
+
+	ro.Generation = 3
+	assert.True(t, IsUpdating(ro))
+
+	ro = newProgressingRollout("default", "foo")
+	assert.True(t, IsUpdating(ro))
+	ro.Status.Abort = true
+	assert.False(t, IsUpdating(ro))
+
+	ro = newProgressingRollout("default", "foo")
+	ro.Status.StableRS = ""
+	assert.False(t, IsUpdating(ro))
+}
+
+func TestGetPriority(t *testing.T) {
+	ro := newRollout("default", "foo")
+	assert.Equal(t, 0, GetPriority(ro))
+	ro.Annotations = map[string]string{PriorityAnnotation: "10"}
+	assert.Equal(t, 10, GetPriority(ro))
+	ro.Annotations[PriorityAnnotation] = "-5"
+	assert.Equal(t, -5, GetPriority(ro))
+	ro.Annotations[PriorityAnnotation] = "high"
+	assert.Equal(t, 0, GetPriority(ro))
+}
+
+func TestIsAdmitted(t *testing.T) {
+	budget := config.ProgressionBudget{Name: "shared-db", MaxProgressing: 1}
+	now := time.Now()
+
+	t.Run("AlreadyProgressing", func(t *testing.T) {
+		ro := newProgressingRollout("default", "foo")
+		others := []*v1alpha1.Rollout{newProgressingRollout("default", "bar")}
+		assert.True(t, IsAdmitted(budget, ro, others))
+	})
+
+	t.Run("BudgetAvailable", func(t *testing.T) {
+		ro := newUpdatingRollout("default", "foo")
+		others := []*v1alpha1.Rollout{ro, newRollout("default", "bar")}
+		assert.True(t, IsAdmitted(budget, ro, others))
+	})
+
+	t.Run("BudgetExhausted", func(t *testing.T) {
+		ro := newUpdatingRollout("default", "foo")
+		others := []*v1alpha1.Rollout{newProgressingRollout("default", "bar")}
+		assert.False(t, IsAdmitted(budget, ro, others))
+
+		// updates which are not evaluated yet use the budget as well
+		others = []*v1alpha1.Rollout{newUpdatingRollout("default", "bar")}
+		assert.False(t, IsAdmitted(budget, ro, others))
+	})
+
+	t.Run("QueuedFirst", func(t *testing.T) {
+		ro := newQueuedRollout("default", "foo", now)
+		others := []*v1alpha1.Rollout{newQueuedRollout("default", "bar", now.Add(time.Minute))}
+		assert.True(t, IsAdmitted(budget, ro, others))
+		assert.False(t, IsAdmitted(budget, others[0], []*v1alpha1.Rollout{ro}))
+	})
+
+	t.Run("QueuedBeforeNotYetQueued", func(t *testing.T) {
+		ro := newUpdatingRollout("default", "foo")
+		others := []*v1alpha1.Rollout{newQueuedRollout("default", "bar", now)}
+		assert.False(t, IsAdmitted(budget, ro, others))
+	})
+
+	t.Run("Priority", func(t *testing.T) {
+		ro := newQueuedRollout("default", "foo", now.Add(time.Minute))
+		ro.Annotations = map[string]string{PriorityAnnotation: "10"}
+		others := []*v1alpha1.Rollout{newQueuedRollout("default", "bar", now)}
+		assert.True(t, IsAdmitted(budget, ro, others))
+		assert.False(t, IsAdmitted(budget, others[0], []*v1alpha1.Rollout{ro}))
+	})
+
+	t.Run("RemainingBudget", func(t *testing.T) {
+		budget := config.ProgressionBudget{Name: "shared-db", MaxProgressing: 3}
+		ro := newQueuedRollout("default", "foo", now.Add(2*time.Minute))
+		others := []*v1alpha1.Rollout{
+			newProgressingRollout("default", "active"),
+			newQueuedRollout("default", "bar", now),
+			newQueuedRollout("default", "baz", now.Add(time.Minute)),
+		}
+		assert.False(t, IsAdmitted(budget, ro, others))
+		assert.True(t, IsAdmitted(budget, others[1], append(others, ro)))
+		assert.True(t, IsAdmitted(budget, others[2], append(others, ro)))
+	})
+}
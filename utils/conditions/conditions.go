@@ -85,6 +85,55 @@ const (
 	// RolloutAbortedMessage indicates that the rollout was aborted
 	RolloutAbortedMessage = "Rollout aborted update to revision %d"
 
+	// RolloutAbortedInMemberClusterReason indicates that the rollout was aborted because the update was aborted in a member cluster
+	RolloutAbortedInMemberClusterReason = "RolloutAbortedInMemberCluster"
+	// RolloutAbortedInMemberClusterMessage indicates that the rollout was aborted because the update was aborted in a member cluster
+	RolloutAbortedInMemberClusterMessage = "Rollout aborted since the update was aborted in member cluster '%s'"
+	// RolloutRolledBackInMemberClusterReason indicates that the rollout was rolled back because the update it completed was aborted in a member cluster
+	RolloutRolledBackInMemberClusterReason = "RolloutRolledBackInMemberCluster"
+	// RolloutRolledBackInMemberClusterMessage indicates that the rollout was rolled back because the update it completed was aborted in a member cluster
+	RolloutRolledBackInMemberClusterMessage = "Rollout rolled back from ReplicaSet '%s' to '%s' since the update was aborted in member cluster '%s'"
+
+	// RolloutWaitingForClusterWaveReason indicates that the rollout waits for the clusters of the previous waves
+	RolloutWaitingForClusterWaveReason = "RolloutWaitingForClusterWave"
+	// RolloutWaitingForClusterWaveMessage indicates that the rollout waits for the clusters of the previous waves
+	RolloutWaitingForClusterWaveMessage = "Rollout waiting for clusters %s to be Healthy"
+	// RolloutOutsideDeployWindowReason indicates that the rollout is held back by its deploy windows
+	RolloutOutsideDeployWindowReason = "RolloutOutsideDeployWindow"
+	// RolloutOutsideDeployWindowMessage indicates that the rollout is held back by its deploy windows
+	RolloutOutsideDeployWindowMessage = "Rollout update is held back by the deploy windows until %s"
+	// RolloutOutsideDeployWindowIndefinitelyMessage indicates that the rollout is held back by deploy windows
+	// which do not allow the update to progress in the foreseeable future
+	RolloutOutsideDeployWindowIndefinitelyMessage = "Rollout update is held back by the deploy windows, which do not open in the foreseeable future"
+	// RolloutDeployWindowErrorReason indicates that the rollout is held back since its deploy windows cannot be evaluated
+	RolloutDeployWindowErrorReason = "RolloutDeployWindowError"
+	// RolloutDeployWindowErrorMessage indicates that the rollout is held back since its deploy windows cannot be evaluated
+	RolloutDeployWindowErrorMessage = "Rollout update is held back since the deploy windows cannot be evaluated: %v"
+	// RolloutWaitingForDependencyReason indicates that the rollout waits for the rollouts it depends on
+	RolloutWaitingForDependencyReason = "RolloutWaitingForDependency"
+	// RolloutWaitingForDependencyMessage indicates that the rollout waits for the rollouts it depends on
+	RolloutWaitingForDependencyMessage = "Rollout waiting for dependencies %s"
+	// RolloutDependencyCycleReason indicates that the rollout depends on itself through its dependencies
+	RolloutDependencyCycleReason = "RolloutDependencyCycle"
+	// RolloutDependencyCycleMessage indicates that the rollout depends on itself through its dependencies
+	RolloutDependencyCycleMessage = "Rollout is part of the dependency cycle %s and cannot progress"
+	// RolloutQueuedReason indicates that the rollout update is queued by a progression budget
+	RolloutQueuedReason = "RolloutQueued"
+	// RolloutQueuedMessage indicates that the rollout update is queued by a progression budget
+	RolloutQueuedMessage = "Rollout update is queued by the progression budget '%s'"
+	// RolloutRolledBackReason indicates that the rollout was rolled back after the post-promotion analysis of an update failed
+	RolloutRolledBackReason = "RolloutRolledBack"
+	// RolloutRolledBackMessage indicates that the rollout was rolled back after the post-promotion analysis of an update failed
+	RolloutRolledBackMessage = "Rollout rolled back from ReplicaSet '%s' to '%s' since the post-promotion analysis run '%s' failed"
+	// RolloutRollbackNotPossibleReason indicates that the post-promotion analysis of an update failed without a ReplicaSet to roll back to
+	RolloutRollbackNotPossibleReason = "RolloutRollbackNotPossible"
+	// RolloutRollbackNotPossibleMessage indicates that the post-promotion analysis of an update failed without a ReplicaSet to roll back to
+	RolloutRollbackNotPossibleMessage = "Rollout cannot be rolled back after the post-promotion analysis run '%s' failed since the previous stable ReplicaSet with pod template hash '%s' no longer exists"
+	// ExperimentWinnerPromotedReason indicates that the winning template of an experiment was promoted as the canary
+	ExperimentWinnerPromotedReason = "ExperimentWinnerPromoted"
+	// ExperimentWinnerPromotedMessage indicates that the winning template of an experiment was promoted as the canary
+	ExperimentWinnerPromotedMessage = "Promoted template '%s' of experiment '%s' as the canary"
+
 	// RolloutRetryReason indicates that the rollout is retrying after being aborted
 	RolloutRetryReason = "RolloutRetry"
 	// RolloutRetryMessage indicates that the rollout is retrying after being aborted
@@ -113,10 +162,26 @@ const (
 	// RolloutStepCompleted indicates when a canary step has completed
 	RolloutStepCompletedReason  = "RolloutStepCompleted"
 	RolloutStepCompletedMessage = "Rollout step %d/%d completed (%s)"
+	// RolloutStepTimedOut indicates when a canary step or the pause of a blue-green rollout exceeded its timeout
+	RolloutStepTimedOutReason   = "RolloutStepTimedOut"
+	RolloutStepTimedOutMessage  = "Rollout step %d/%d (%s) timed out after %s, action: %s"
+	RolloutPauseTimedOutMessage = "Rollout pause timed out after %s, action: %s"
+	// RolloutStepSkipped indicates when a canary step was skipped because its when expression evaluated to false
+	RolloutStepSkippedReason  = "RolloutStepSkipped"
+	RolloutStepSkippedMessage = "Rollout step %d/%d (%s) skipped"
+	// RolloutStepBranched indicates when a branch of a canary step made the rollout continue at another step
+	RolloutStepBranchedReason  = "RolloutStepBranched"
+	RolloutStepBranchedMessage = "Rollout step %d/%d (%s) branched to step %d/%d (%s)"
+	// RolloutStepConditionErrorMessage is the abort message when the expression of a canary step failed to evaluate
+	RolloutStepConditionErrorMessage = "Failed to evaluate the expression of step %d/%d: %v"
 
 	// TrafficWeightUpdated is emitted any time traffic weight is modified
 	TrafficWeightUpdatedReason  = "TrafficWeightUpdated"
 	TrafficWeightUpdatedMessage = "Traffic weight updated %s"
+	// TrafficRoutingDryRun is emitted any time a change of a traffic routing object is not applied
+	// since traffic routing is in dry-run mode
+	TrafficRoutingDryRunReason  = "TrafficRoutingDryRun"
+	TrafficRoutingDryRunMessage = "Dry-run: %s of %s '%s' not applied: %s"
 
 	// NewRSAvailableReason is added in a rollout when its newest replica set is made available
 	// ie. the number of new pods that have passed readiness checks and run for at least minReadySeconds
@@ -182,6 +247,12 @@ const (
 	// WeightVerifyErrorReason is emitted when there is an error verifying the set weight
 	WeightVerifyErrorReason  = "WeightVerifyError"
 	WeightVerifyErrorMessage = "Failed to verify weight: %s"
+	// WeightNotVerifiedReason is added in a rollout while the traffic router has not yet verified the traffic weights
+	WeightNotVerifiedReason  = "WeightNotVerified"
+	WeightNotVerifiedMessage = "Traffic weight %d not yet verified"
+	// WeightVerifyTimeoutReason is added in a rollout when the traffic router did not verify the traffic weights within the weight verify timeout
+	WeightVerifyTimeoutReason  = "WeightVerifyTimeout"
+	WeightVerifyTimeoutMessage = "Traffic weight %d not verified within %s"
 	// LoadBalancerNotFoundReason is emitted when load balancer can not be found
 	LoadBalancerNotFoundReason  = "LoadBalancerNotFound"
 	LoadBalancerNotFoundMessage = "Failed to find load balancer: %s"
@@ -33,6 +33,18 @@ const (
 	ExperimentSelectAllMessage = "This experiment is selecting all pods at index %d. A non-empty selector is required."
 	// ExperimentMinReadyLongerThanDeadlineMessage indicates the MinReadySeconds is longer than ProgressDeadlineSeconds
 	ExperimentMinReadyLongerThanDeadlineMessage = "MinReadySeconds cannot be longer than ProgressDeadlineSeconds. Check template index %d"
+	// ExperimentComparisonTooFewTemplatesMessage indicates the comparison has less than two templates
+	ExperimentComparisonTooFewTemplatesMessage = "Experiment %s must compare at least two templates"
+	// ExperimentComparisonNoMetricsMessage indicates the comparison has no metrics
+	ExperimentComparisonNoMetricsMessage = "Experiment %s must compare the templates on at least one metric"
+	// ExperimentComparisonTemplateNotFoundMessage indicates the comparison references a template which does not exist
+	ExperimentComparisonTemplateNotFoundMessage = "Experiment %s compares template '%s' which is not in templates"
+	// ExperimentComparisonTemplateRepeatedMessage indicates the comparison references a template more than once
+	ExperimentComparisonTemplateRepeatedMessage = "Experiment %s compares template '%s' more than once"
+	// ExperimentComparisonAnalysisNotFoundMessage indicates the comparison references an analysis which does not exist
+	ExperimentComparisonAnalysisNotFoundMessage = "Experiment %s measures template '%s' with analysis '%s' which is not in analyses"
+	// ExperimentComparisonMetricWeightMessage indicates a metric of the comparison has a weight lower than 1
+	ExperimentComparisonMetricWeightMessage = "Experiment %s has comparison metric '%s' with a weight lower than 1"
 )
 
 // NewExperimentConditions takes arguments to create new Condition
@@ -166,5 +178,45 @@ func VerifyExperimentSpec(experiment *v1alpha1.Experiment, prevCond *v1alpha1.Ex
 		}
 		templateNameSet[template.Name] = true
 	}
+	if message := verifyExperimentComparison(experiment, templateNameSet); message != "" {
+		return newInvalidSpecExperimentCondition(prevCond, InvalidSpecReason, message)
+	}
 	return nil
 }
+
+// verifyExperimentComparison checks that the comparison of the experiment references its templates and analyses
+func verifyExperimentComparison(experiment *v1alpha1.Experiment, templateNameSet map[string]bool) string {
+	comparison := experiment.Spec.Comparison
+	if comparison == nil {
+		return ""
+	}
+	if len(comparison.Analyses) < 2 {
+		return fmt.Sprintf(ExperimentComparisonTooFewTemplatesMessage, experiment.Name)
+	}
+	if len(comparison.Metrics) == 0 {
+		return fmt.Sprintf(ExperimentComparisonNoMetricsMessage, experiment.Name)
+	}
+	analysisNameSet := make(map[string]bool)
+	for _, analysis := range experiment.Spec.Analyses {
+		analysisNameSet[analysis.Name] = true
+	}
+	comparedTemplates := make(map[string]bool)
+	for _, analysis := range comparison.Analyses {
+		if !templateNameSet[analysis.TemplateName] {
+			return fmt.Sprintf(ExperimentComparisonTemplateNotFoundMessage, experiment.Name, analysis.TemplateName)
+		}
+		if comparedTemplates[analysis.TemplateName] {
+			return fmt.Sprintf(ExperimentComparisonTemplateRepeatedMessage, experiment.Name, analysis.TemplateName)
+		}
+		comparedTemplates[analysis.TemplateName] = true
+		if !analysisNameSet[analysis.AnalysisName] {
+			return fmt.Sprintf(ExperimentComparisonAnalysisNotFoundMessage, experiment.Name, analysis.TemplateName, analysis.AnalysisName)
+		}
+	}
+	for _, metric := range comparison.Metrics {
+		if metric.Weight != nil && *metric.Weight < 1 {
+			return fmt.Sprintf(ExperimentComparisonMetricWeightMessage, experiment.Name, metric.Name)
+		}
+	}
+	return ""
+}
@@ -376,3 +376,90 @@ func TestVerifyExperimentSpecBaseCases(t *testing.T) {
 	assert.Equal(t, InvalidSpecReason, sameInvalidSpec.Reason)
 	assert.NotEqual(t, prevLastUpdateTime, sameInvalidSpec.LastUpdateTime)
 }
+
+func TestVerifyExperimentSpecComparison(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"key": "value"}}
+	ex := &v1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+		Spec: v1alpha1.ExperimentSpec{
+			Templates: []v1alpha1.TemplateSpec{
+				{Name: "a", Selector: selector},
+				{Name: "b", Selector: selector},
+			},
+			Analyses: []v1alpha1.ExperimentAnalysisTemplateRef{
+				{Name: "a-metrics", TemplateName: "metrics"},
+				{Name: "b-metrics", TemplateName: "metrics"},
+			},
+			Comparison: &v1alpha1.ExperimentComparison{
+				Analyses: []v1alpha1.ComparisonAnalysis{
+					{TemplateName: "a", AnalysisName: "a-metrics"},
+					{TemplateName: "b", AnalysisName: "b-metrics"},
+				},
+				Metrics: []v1alpha1.ComparisonMetric{{Name: "conversion"}},
+			},
+		},
+	}
+	assert.Nil(t, VerifyExperimentSpec(ex, nil))
+
+	tests := []struct {
+		name    string
+		modify  func(comparison *v1alpha1.ExperimentComparison)
+		message string
+	}{
+		{
+			name: "single template",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				comparison.Analyses = comparison.Analyses[:1]
+			},
+			message: fmt.Sprintf(ExperimentComparisonTooFewTemplatesMessage, "foo"),
+		},
+		{
+			name: "no metrics",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				comparison.Metrics = nil
+			},
+			message: fmt.Sprintf(ExperimentComparisonNoMetricsMessage, "foo"),
+		},
+		{
+			name: "unknown template",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				comparison.Analyses[1].TemplateName = "c"
+			},
+			message: fmt.Sprintf(ExperimentComparisonTemplateNotFoundMessage, "foo", "c"),
+		},
+		{
+			name: "repeated template",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				comparison.Analyses[1].TemplateName = "a"
+			},
+			message: fmt.Sprintf(ExperimentComparisonTemplateRepeatedMessage, "foo", "a"),
+		},
+		{
+			name: "unknown analysis",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				comparison.Analyses[1].AnalysisName = "c-metrics"
+			},
+			message: fmt.Sprintf(ExperimentComparisonAnalysisNotFoundMessage, "foo", "b", "c-metrics"),
+		},
+		{
+			name: "zero weight",
+			modify: func(comparison *v1alpha1.ExperimentComparison) {
+				weight := int32(0)
+				comparison.Metrics[0].Weight = &weight
+			},
+			message: fmt.Sprintf(ExperimentComparisonMetricWeightMessage, "foo", "conversion"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			invalid := ex.DeepCopy()
+			test.modify(invalid.Spec.Comparison)
+			cond := VerifyExperimentSpec(invalid, nil)
+			assert.NotNil(t, cond)
+			assert.Equal(t, InvalidSpecReason, cond.Reason)
+			assert.Equal(t, test.message, cond.Message)
+		})
+	}
+}
@@ -19,9 +19,23 @@ import (
 
 // Config is the in memory representation of the configmap with some additional fields/functions for ease of use.
 type Config struct {
-	configMap *v1.ConfigMap
-	plugins   []types.PluginItem
-	lock      *sync.RWMutex
+	configMap          *v1.ConfigMap
+	plugins            []types.PluginItem
+	progressionBudgets []ProgressionBudget
+	lock               *sync.RWMutex
+}
+
+// ProgressionBudget caps how many of the rollouts it applies to may have an update progressing at
+// the same time. The updates of the other rollouts are queued until the budget lets them through.
+type ProgressionBudget struct {
+	// Name of the budget
+	Name string `json:"name" yaml:"name"`
+	// Namespaces the budget applies to. Defaults to all namespaces.
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	// Selector of the labels of the rollouts the budget applies to. Defaults to all rollouts.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" yaml:"selector,omitempty"`
+	// MaxProgressing is the maximum number of rollouts with an update progressing at the same time
+	MaxProgressing int32 `json:"maxProgressing" yaml:"maxProgressing"`
 }
 
 var configMemoryCache *Config
@@ -69,11 +83,17 @@ func InitializeConfig(k8sClientset kubernetes.Interface, configMapName string) (
 		stepPlugins[i].Type = types.PluginTypeStep
 	}
 
+	var progressionBudgets []ProgressionBudget
+	if err = yaml.Unmarshal([]byte(configMapCluster.Data["progressionBudgets"]), &progressionBudgets); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal progression budgets while initializing: %w", err)
+	}
+
 	mutex.Lock()
 	configMemoryCache = &Config{
-		configMap: configMapCluster,
-		plugins:   slices.Concat(trafficRouterPlugins, metricProviderPlugins, stepPlugins),
-		lock:      &sync.RWMutex{},
+		configMap:          configMapCluster,
+		plugins:            slices.Concat(trafficRouterPlugins, metricProviderPlugins, stepPlugins),
+		progressionBudgets: progressionBudgets,
+		lock:               &sync.RWMutex{},
 	}
 	mutex.Unlock()
 
@@ -121,6 +141,13 @@ func (c *Config) GetPlugin(name string, pluginType types.PluginType) *types.Plug
 	return nil
 }
 
+// GetProgressionBudgets returns the progression budgets of the rollouts
+func (c *Config) GetProgressionBudgets() []ProgressionBudget {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return append([]ProgressionBudget{}, c.progressionBudgets...)
+}
+
 func (c *Config) ValidateConfig() error {
 	for _, pluginItem := range c.GetAllPlugins() {
 		matches := re.FindAllStringSubmatch(pluginItem.Name, -1)
@@ -128,6 +155,24 @@ func (c *Config) ValidateConfig() error {
 			return fmt.Errorf("plugin repository (%s) must be in the format of <namespace>/<name>", pluginItem.Name)
 		}
 	}
+	names := map[string]bool{}
+	for _, budget := range c.GetProgressionBudgets() {
+		if budget.Name == "" {
+			return fmt.Errorf("progression budget name must be set")
+		}
+		if names[budget.Name] {
+			return fmt.Errorf("progression budget (%s) is defined multiple times", budget.Name)
+		}
+		names[budget.Name] = true
+		if budget.MaxProgressing < 1 {
+			return fmt.Errorf("progression budget (%s) maxProgressing must be at least 1", budget.Name)
+		}
+		if budget.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(budget.Selector); err != nil {
+				return fmt.Errorf("progression budget (%s) has an invalid selector: %w", budget.Name, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -35,6 +35,10 @@ const (
 	// DefaultConsecutiveErrorLimit is the default number times a metric can error in sequence before
 	// erroring the entire metric.
 	DefaultConsecutiveErrorLimit int32 = 4
+	// DefaultFastBurnMultiplier is the default fast burn rate above which a burn rate metric can fail
+	DefaultFastBurnMultiplier = "14.4"
+	// DefaultSlowBurnMultiplier is the default slow burn rate above which a burn rate metric can fail
+	DefaultSlowBurnMultiplier = "6"
 	// DefaultQPS is the default Queries Per Second (QPS) for client side throttling to the K8s API server
 	DefaultQPS float32 = 40.0
 	// DefaultBurst is the default value for Burst for client side throttling to the K8s API server
@@ -63,10 +67,17 @@ const (
 	DefaultTraefikVersion               = "traefik.containo.us/v1alpha1"
 	DefaultApisixAPIGroup               = "apisix.apache.org"
 	DefaultApisixVersion                = "apisix.apache.org/v2"
+	DefaultGatewayAPIGroup              = "gateway.networking.k8s.io"
+	DefaultGatewayAPIVersion            = "v1"
+	DefaultGatewayAPITCPRouteVersion    = "v1alpha2"
 )
 
 var (
 	defaultVerifyTargetGroup     = false
+	verifyTrafficRouterWeight    = false
+	weightVerifyTimeout          time.Duration
+	istioConfigDumpURL           = ""
+	nginxBackendsURL             = ""
 	traefikAPIGroup              = DefaultTraefikAPIGroup
 	traefikVersion               = DefaultTraefikVersion
 	istioAPIVersion              = DefaultIstioVersion
@@ -74,6 +85,8 @@ var (
 	smiAPIVersion                = DefaultSMITrafficSplitVersion
 	targetGroupBindingAPIVersion = DefaultTargetGroupBindingAPIVersion
 	appmeshCRDVersion            = DefaultAppMeshCRDVersion
+	gatewayAPIVersion            = DefaultGatewayAPIVersion
+	clusterName                  = ""
 	defaultMetricCleanupDelay    = DefaultMetricCleanupDelay
 	defaultDescribeTagsLimit     = DefaultDescribeTagsLimit
 )
@@ -250,6 +263,20 @@ func GetConsecutiveErrorLimitOrDefault(metric *v1alpha1.Metric) int32 {
 	return DefaultConsecutiveErrorLimit
 }
 
+func GetFastBurnMultiplierOrDefault(burnRate *v1alpha1.BurnRateMetric) string {
+	if burnRate.FastBurnMultiplier != "" {
+		return burnRate.FastBurnMultiplier
+	}
+	return DefaultFastBurnMultiplier
+}
+
+func GetSlowBurnMultiplierOrDefault(burnRate *v1alpha1.BurnRateMetric) string {
+	if burnRate.SlowBurnMultiplier != "" {
+		return burnRate.SlowBurnMultiplier
+	}
+	return DefaultSlowBurnMultiplier
+}
+
 func Namespace() string {
 	// This way assumes you've set the POD_NAMESPACE environment variable using the downward API.
 	// This check has to be done first for backwards compatibility with the way InClusterConfig was originally set up
@@ -275,6 +302,47 @@ func VerifyTargetGroup() bool {
 	return defaultVerifyTargetGroup
 }
 
+// SetVerifyTrafficRouterWeight sets whether the Istio, Nginx and SMI traffic routers verify the weights
+func SetVerifyTrafficRouterWeight(b bool) {
+	verifyTrafficRouterWeight = b
+}
+
+// VerifyTrafficRouterWeight returns whether the Istio, Nginx and SMI traffic routers verify the weights
+func VerifyTrafficRouterWeight() bool {
+	return verifyTrafficRouterWeight
+}
+
+// SetWeightVerifyTimeout sets the duration after which weights which are not yet verified are reported
+// in the WeightVerified condition of the rollout. A zero duration disables the timeout
+func SetWeightVerifyTimeout(timeout time.Duration) {
+	weightVerifyTimeout = timeout
+}
+
+// GetWeightVerifyTimeout returns the duration after which weights which are not yet verified are reported
+func GetWeightVerifyTimeout() time.Duration {
+	return weightVerifyTimeout
+}
+
+// SetIstioConfigDumpURL sets the URL of the Envoy config dump the Istio weights are verified against
+func SetIstioConfigDumpURL(url string) {
+	istioConfigDumpURL = url
+}
+
+// GetIstioConfigDumpURL returns the URL of the Envoy config dump the Istio weights are verified against
+func GetIstioConfigDumpURL() string {
+	return istioConfigDumpURL
+}
+
+// SetNginxBackendsURL sets the URL of the ingress-nginx backends configuration the Nginx weights are verified against
+func SetNginxBackendsURL(url string) {
+	nginxBackendsURL = url
+}
+
+// GetNginxBackendsURL returns the URL of the ingress-nginx backends configuration the Nginx weights are verified against
+func GetNginxBackendsURL() string {
+	return nginxBackendsURL
+}
+
 func SetIstioAPIVersion(apiVersion string) {
 	istioAPIVersion = apiVersion
 }
@@ -323,6 +391,24 @@ func GetTraefikAPIGroup() string {
 	return traefikAPIGroup
 }
 
+func SetGatewayAPIVersion(apiVersion string) {
+	gatewayAPIVersion = apiVersion
+}
+
+func GetGatewayAPIVersion() string {
+	return gatewayAPIVersion
+}
+
+// SetClusterName sets the name under which the cluster the controller is running in is known to the member clusters
+func SetClusterName(name string) {
+	clusterName = name
+}
+
+// GetClusterName returns the name of the cluster the controller is running in
+func GetClusterName() string {
+	return clusterName
+}
+
 func SetTargetGroupBindingAPIVersion(apiVersion string) {
 	targetGroupBindingAPIVersion = apiVersion
 }
@@ -377,6 +377,19 @@ func TestGetConsecutiveErrorLimitOrDefault(t *testing.T) {
 	assert.Equal(t, DefaultConsecutiveErrorLimit, GetConsecutiveErrorLimitOrDefault(metricDefaultValue))
 }
 
+func TestGetBurnMultipliersOrDefault(t *testing.T) {
+	burnRateNonDefaultValue := &v1alpha1.BurnRateMetric{
+		FastBurnMultiplier: "10",
+		SlowBurnMultiplier: "2",
+	}
+	assert.Equal(t, "10", GetFastBurnMultiplierOrDefault(burnRateNonDefaultValue))
+	assert.Equal(t, "2", GetSlowBurnMultiplierOrDefault(burnRateNonDefaultValue))
+
+	burnRateDefaultValue := &v1alpha1.BurnRateMetric{}
+	assert.Equal(t, DefaultFastBurnMultiplier, GetFastBurnMultiplierOrDefault(burnRateDefaultValue))
+	assert.Equal(t, DefaultSlowBurnMultiplier, GetSlowBurnMultiplierOrDefault(burnRateDefaultValue))
+}
+
 func TestSetDefaults(t *testing.T) {
 	SetVerifyTargetGroup(true)
 	assert.True(t, VerifyTargetGroup())
@@ -408,6 +421,17 @@ func TestSetDefaults(t *testing.T) {
 	SetTraefikVersion(DefaultTraefikVersion)
 	assert.Equal(t, DefaultTraefikVersion, GetTraefikVersion())
 
+	SetGatewayAPIVersion("v1beta1")
+	assert.Equal(t, "v1beta1", GetGatewayAPIVersion())
+	SetGatewayAPIVersion(DefaultGatewayAPIVersion)
+	assert.Equal(t, DefaultGatewayAPIVersion, GetGatewayAPIVersion())
+
+	assert.Equal(t, "", GetClusterName())
+	SetClusterName("us-east-1")
+	assert.Equal(t, "us-east-1", GetClusterName())
+	SetClusterName("")
+	assert.Equal(t, "", GetClusterName())
+
 	SetTargetGroupBindingAPIVersion("v1alpha9")
 	assert.Equal(t, "v1alpha9", GetTargetGroupBindingAPIVersion())
 	SetTargetGroupBindingAPIVersion(DefaultTargetGroupBindingAPIVersion)
@@ -0,0 +1,124 @@
+package dependency
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+// RolloutGetter returns the rollout with the given namespace and name, or nil if it does not exist
+type RolloutGetter func(namespace, name string) *v1alpha1.Rollout
+
+// GetNamespace returns the namespace of the rollout the dependency refers to
+func GetNamespace(dep v1alpha1.RolloutDependency, namespace string) string {
+	if dep.Namespace != "" {
+		return dep.Namespace
+	}
+	return namespace
+}
+
+// Key returns the namespace/name key of the rollout the dependency refers to
+func Key(dep v1alpha1.RolloutDependency, namespace string) string {
+	return GetNamespace(dep, namespace) + "/" + dep.Name
+}
+
+// GetStepIndex returns the index of the canary step with the given name, or -1 if there is none
+func GetStepIndex(ro *v1alpha1.Rollout, step string) int {
+	if ro.Spec.Strategy.Canary == nil {
+		return -1
+	}
+	for i, s := range ro.Spec.Strategy.Canary.Steps {
+		if s.Name == step {
+			return i
+		}
+	}
+	return -1
+}
+
+// Evaluate returns the state of the dependency of a rollout in the given namespace. The dependency
+// is satisfied once the rollout it refers to is Healthy or, when a step is required, once the
+// update of the rollout is past that step.
+func Evaluate(dep v1alpha1.RolloutDependency, namespace string, ro *v1alpha1.Rollout) v1alpha1.RolloutDependencyStatus {
+	status := v1alpha1.RolloutDependencyStatus{
+		Name:      dep.Name,
+		Namespace: GetNamespace(dep, namespace),
+		Step:      dep.Step,
+	}
+	if ro == nil {
+		status.Message = "rollout not found"
+		return status
+	}
+	phase, message := rolloututil.GetRolloutPhase(ro)
+	status.Phase = phase
+	if phase == v1alpha1.RolloutPhaseHealthy {
+		status.Satisfied = true
+		return status
+	}
+	if dep.Step == "" {
+		status.Message = message
+		return status
+	}
+
+	stepIndex := GetStepIndex(ro, dep.Step)
+	if stepIndex < 0 {
+		status.Message = fmt.Sprintf("rollout has no canary step named '%s'", dep.Step)
+		return status
+	}
+	// the step index of a spec which is not yet observed is the one of the previous update
+	observed := ro.Status.ObservedGeneration == strconv.FormatInt(ro.Generation, 10)
+	currentStepIndex := ro.Status.CurrentStepIndex
+	if observed && !ro.Status.Abort && currentStepIndex != nil && int(*currentStepIndex) > stepIndex {
+		status.Satisfied = true
+		return status
+	}
+	status.Message = fmt.Sprintf("waiting for update to be past step '%s'", dep.Step)
+	return status
+}
+
+// Unsatisfied returns the namespace/name keys of the dependencies which are not satisfied
+func Unsatisfied(statuses []v1alpha1.RolloutDependencyStatus) []string {
+	var keys []string
+	for _, s := range statuses {
+		if !s.Satisfied {
+			keys = append(keys, s.Namespace+"/"+s.Name)
+		}
+	}
+	return keys
+}
+
+// FindCycle returns the namespace/name keys of a chain of dependencies which leads from the rollout
+// back to itself, or nil if the rollout is not part of a dependency cycle
+func FindCycle(ro *v1alpha1.Rollout, getRollout RolloutGetter) []string {
+	start := ro.Namespace + "/" + ro.Name
+	visited := map[string]bool{}
+	var visit func(ro *v1alpha1.Rollout, path []string) []string
+	visit = func(ro *v1alpha1.Rollout, path []string) []string {
+		for _, dep := range ro.Spec.DependsOn {
+			key := Key(dep, ro.Namespace)
+			if key == start {
+				return append(path, key)
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			next := getRollout(GetNamespace(dep, ro.Namespace), dep.Name)
+			if next == nil {
+				continue
+			}
+			if cycle := visit(next, append(path, key)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(ro, []string{start})
+}
+
+// FormatCycle formats a dependency cycle, e.g. "default/frontend -> default/backend -> default/frontend"
+func FormatCycle(cycle []string) string {
+	return strings.Join(cycle, " -> ")
+}
@@ -0,0 +1,110 @@
+package dependency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newRollout(namespace, name string, deps ...v1alpha1.RolloutDependency) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 3},
+		Spec: v1alpha1.RolloutSpec{
+			DependsOn: deps,
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32(20)},
+						{Name: "half", SetWeight: pointer.Int32(50)},
+						{Pause: &v1alpha1.RolloutPause{}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			ObservedGeneration: "3",
+			Phase:              v1alpha1.RolloutPhaseProgressing,
+			Message:            "more replicas need to be updated",
+			CurrentStepIndex:   pointer.Int32(1),
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	dep := v1alpha1.RolloutDependency{Name: "backend"}
+
+	status := Evaluate(dep, "default", nil)
+	assert.Equal(t, v1alpha1.RolloutDependencyStatus{Name: "backend", Namespace: "default", Message: "rollout not found"}, status)
+
+	ro := newRollout("default", "backend")
+	status = Evaluate(dep, "default", ro)
+	assert.False(t, status.Satisfied)
+	assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status.Phase)
+	assert.Equal(t, "more replicas need to be updated", status.Message)
+
+	ro.Status.Phase = v1alpha1.RolloutPhaseHealthy
+	status = Evaluate(dep, "default", ro)
+	assert.True(t, status.Satisfied)
+	assert.Empty(t, status.Message)
+
+	// a spec update which is not yet observed is not Healthy
+	ro.Generation = 4
+	assert.False(t, Evaluate(dep, "default", ro).Satisfied)
+}
+
+func TestEvaluateStep(t *testing.T) {
+	dep := v1alpha1.RolloutDependency{Name: "backend", Namespace: "shop", Step: "half"}
+	ro := newRollout("shop", "backend")
+
+	status := Evaluate(dep, "default", ro)
+	assert.False(t, status.Satisfied)
+	assert.Equal(t, "shop", status.Namespace)
+	assert.Equal(t, "waiting for update to be past step 'half'", status.Message)
+
+	ro.Status.CurrentStepIndex = pointer.Int32(2)
+	assert.True(t, Evaluate(dep, "default", ro).Satisfied)
+
+	// the step index is the one of the previous update until the spec update is observed
+	ro.Generation = 4
+	assert.False(t, Evaluate(dep, "default", ro).Satisfied)
+	ro.Generation = 3
+
+	ro.Status.Abort = true
+	assert.False(t, Evaluate(dep, "default", ro).Satisfied)
+
+	dep.Step = "unknown"
+	assert.Equal(t, "rollout has no canary step named 'unknown'", Evaluate(dep, "default", ro).Message)
+}
+
+func TestUnsatisfied(t *testing.T) {
+	statuses := []v1alpha1.RolloutDependencyStatus{
+		{Name: "backend", Namespace: "default", Satisfied: true},
+		{Name: "payments", Namespace: "shop"},
+	}
+	assert.Equal(t, []string{"shop/payments"}, Unsatisfied(statuses))
+	assert.Nil(t, Unsatisfied(statuses[:1]))
+}
+
+func TestFindCycle(t *testing.T) {
+	rollouts := map[string]*v1alpha1.Rollout{}
+	add := func(ro *v1alpha1.Rollout) {
+		rollouts[ro.Namespace+"/"+ro.Name] = ro
+	}
+	getRollout := func(namespace, name string) *v1alpha1.Rollout {
+		return rollouts[namespace+"/"+name]
+	}
+	frontend := newRollout("default", "frontend", v1alpha1.RolloutDependency{Name: "backend"}, v1alpha1.RolloutDependency{Name: "missing"})
+	add(frontend)
+	add(newRollout("default", "backend", v1alpha1.RolloutDependency{Name: "payments", Namespace: "shop"}))
+	add(newRollout("shop", "payments"))
+	assert.Nil(t, FindCycle(frontend, getRollout))
+
+	add(newRollout("shop", "payments", v1alpha1.RolloutDependency{Name: "frontend", Namespace: "default"}))
+	cycle := FindCycle(frontend, getRollout)
+	assert.Equal(t, []string{"default/frontend", "default/backend", "shop/payments", "default/frontend"}, cycle)
+	assert.Equal(t, "default/frontend -> default/backend -> shop/payments -> default/frontend", FormatCycle(cycle))
+}
@@ -0,0 +1,126 @@
+package deploywindow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// maxEvaluations bounds the number of evaluations NextAllowed walks through when looking for the
+// next time at which the windows allow an update
+const maxEvaluations = 1000
+
+// window is a parsed deploy window
+type window struct {
+	kind     v1alpha1.DeployWindowKind
+	schedule cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+func parse(w v1alpha1.DeployWindow) (*window, error) {
+	if w.Kind != v1alpha1.DeployWindowKindAllow && w.Kind != v1alpha1.DeployWindowKindDeny {
+		return nil, fmt.Errorf("kind must be either '%s' or '%s'", v1alpha1.DeployWindowKindAllow, v1alpha1.DeployWindowKindDeny)
+	}
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s': %v", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration '%s': %v", w.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("duration '%s' must be positive", w.Duration)
+	}
+	location := time.UTC
+	if w.TimeZone != "" {
+		location, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %v", w.TimeZone, err)
+		}
+	}
+	return &window{
+		kind:     w.Kind,
+		schedule: schedule,
+		duration: duration,
+		location: location,
+	}, nil
+}
+
+// activeUntil returns when the window ends if it is active at the given time
+func (w *window) activeUntil(now time.Time) (time.Time, bool) {
+	// the first start after now-duration is the only one which can still be active
+	start := w.schedule.Next(now.In(w.location).Add(-w.duration))
+	if start.After(now) {
+		return time.Time{}, false
+	}
+	return start.Add(w.duration), true
+}
+
+// Validate returns an error if the deploy window is invalid
+func Validate(w v1alpha1.DeployWindow) error {
+	_, err := parse(w)
+	return err
+}
+
+// Evaluate returns whether the deploy windows allow an update to progress at the given time, along
+// with the next time at which the windows need to be evaluated again. An update is denied while a
+// deny window is active, or while none of the allow windows is active.
+func Evaluate(windows []v1alpha1.DeployWindow, now time.Time) (bool, time.Time, error) {
+	var next time.Time
+	updateNext := func(t time.Time) {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	hasAllow, inAllow, inDeny := false, false, false
+	for _, w := range windows {
+		parsed, err := parse(w)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		end, active := parsed.activeUntil(now)
+		if active {
+			updateNext(end)
+		}
+		updateNext(parsed.schedule.Next(now.In(parsed.location)))
+		switch parsed.kind {
+		case v1alpha1.DeployWindowKindAllow:
+			hasAllow = true
+			inAllow = inAllow || active
+		case v1alpha1.DeployWindowKindDeny:
+			inDeny = inDeny || active
+		}
+	}
+	if inDeny {
+		return false, next, nil
+	}
+	return !hasAllow || inAllow, next, nil
+}
+
+// NextAllowed returns the first time from the given time on at which the deploy windows allow an
+// update to progress. The windows are evaluated at each time they need to be evaluated again, as
+// computed by Evaluate from their schedules, and a zero time is returned if the windows never allow
+// an update within these evaluations.
+func NextAllowed(windows []v1alpha1.DeployWindow, now time.Time) (time.Time, error) {
+	t := now
+	for i := 0; i < maxEvaluations; i++ {
+		allowed, next, err := Evaluate(windows, t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if allowed {
+			return t, nil
+		}
+		if next.IsZero() {
+			break
+		}
+		t = next
+	}
+	return time.Time{}, nil
+}
@@ -0,0 +1,167 @@
+package deploywindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	valid := v1alpha1.DeployWindow{Kind: v1alpha1.DeployWindowKindAllow, Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "Europe/Paris"}
+	assert.NoError(t, Validate(valid))
+
+	w := valid
+	w.Kind = "maybe"
+	assert.EqualError(t, Validate(w), "kind must be either 'allow' or 'deny'")
+
+	w = valid
+	w.Schedule = "every day"
+	assert.ErrorContains(t, Validate(w), "invalid schedule 'every day'")
+
+	w = valid
+	w.Duration = "forever"
+	assert.ErrorContains(t, Validate(w), "invalid duration 'forever'")
+
+	w = valid
+	w.Duration = "-1h"
+	assert.EqualError(t, Validate(w), "duration '-1h' must be positive")
+
+	w = valid
+	w.TimeZone = "Mars/Olympus"
+	assert.ErrorContains(t, Validate(w), "invalid time zone 'Mars/Olympus'")
+}
+
+func TestEvaluate(t *testing.T) {
+	// office hours in Paris, on weekdays
+	officeHours := v1alpha1.DeployWindow{Kind: v1alpha1.DeployWindowKindAllow, Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "Europe/Paris"}
+	// no deploys on friday afternoon
+	fridayFreeze := v1alpha1.DeployWindow{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "0 14 * * 5", Duration: "3h", TimeZone: "Europe/Paris"}
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		windows []v1alpha1.DeployWindow
+		now     time.Time
+		allowed bool
+		next    time.Time
+	}{
+		{
+			name:    "no windows",
+			now:     time.Date(2024, 3, 4, 10, 0, 0, 0, paris),
+			allowed: true,
+		},
+		{
+			name:    "inside allow window",
+			windows: []v1alpha1.DeployWindow{officeHours},
+			now:     time.Date(2024, 3, 4, 10, 0, 0, 0, paris),
+			allowed: true,
+			next:    time.Date(2024, 3, 4, 17, 0, 0, 0, paris),
+		},
+		{
+			name:    "outside allow window",
+			windows: []v1alpha1.DeployWindow{officeHours},
+			now:     time.Date(2024, 3, 4, 18, 0, 0, 0, paris),
+			allowed: false,
+			next:    time.Date(2024, 3, 5, 9, 0, 0, 0, paris),
+		},
+		{
+			name:    "allow window in another time zone",
+			windows: []v1alpha1.DeployWindow{officeHours},
+			now:     time.Date(2024, 3, 4, 8, 30, 0, 0, time.UTC),
+			allowed: true,
+			next:    time.Date(2024, 3, 4, 17, 0, 0, 0, paris),
+		},
+		{
+			name:    "deny window takes precedence",
+			windows: []v1alpha1.DeployWindow{officeHours, fridayFreeze},
+			now:     time.Date(2024, 3, 8, 15, 0, 0, 0, paris),
+			allowed: false,
+			next:    time.Date(2024, 3, 8, 17, 0, 0, 0, paris),
+		},
+		{
+			name:    "before deny window",
+			windows: []v1alpha1.DeployWindow{fridayFreeze},
+			now:     time.Date(2024, 3, 8, 13, 0, 0, 0, paris),
+			allowed: true,
+			next:    time.Date(2024, 3, 8, 14, 0, 0, 0, paris),
+		},
+		{
+			name:    "end of deny window is exclusive",
+			windows: []v1alpha1.DeployWindow{fridayFreeze},
+			now:     time.Date(2024, 3, 8, 17, 0, 0, 0, paris),
+			allowed: true,
+			next:    time.Date(2024, 3, 15, 14, 0, 0, 0, paris),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowed, next, err := Evaluate(test.windows, test.now)
+			assert.NoError(t, err)
+			assert.Equal(t, test.allowed, allowed)
+			assert.True(t, test.next.Equal(next), "expected next %s, got %s", test.next, next)
+		})
+	}
+
+	_, _, err = Evaluate([]v1alpha1.DeployWindow{{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "* * *", Duration: "1h"}}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestNextAllowed(t *testing.T) {
+	officeHours := v1alpha1.DeployWindow{Kind: v1alpha1.DeployWindowKindAllow, Schedule: "0 9 * * 1-5", Duration: "8h", TimeZone: "Europe/Paris"}
+	fridayFreeze := v1alpha1.DeployWindow{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "0 14 * * 5", Duration: "3h", TimeZone: "Europe/Paris"}
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		windows []v1alpha1.DeployWindow
+		now     time.Time
+		allowed time.Time
+	}{
+		{
+			name:    "inside allow window",
+			windows: []v1alpha1.DeployWindow{officeHours},
+			now:     time.Date(2024, 3, 4, 10, 0, 0, 0, paris),
+			allowed: time.Date(2024, 3, 4, 10, 0, 0, 0, paris),
+		},
+		{
+			name:    "outside allow window",
+			windows: []v1alpha1.DeployWindow{officeHours},
+			now:     time.Date(2024, 3, 4, 18, 0, 0, 0, paris),
+			allowed: time.Date(2024, 3, 5, 9, 0, 0, 0, paris),
+		},
+		{
+			// the freeze ends with the office hours, the update is held back over the weekend
+			name:    "deny window ending with the allow window",
+			windows: []v1alpha1.DeployWindow{officeHours, fridayFreeze},
+			now:     time.Date(2024, 3, 8, 15, 0, 0, 0, paris),
+			allowed: time.Date(2024, 3, 11, 9, 0, 0, 0, paris),
+		},
+		{
+			name:    "deny window",
+			windows: []v1alpha1.DeployWindow{fridayFreeze},
+			now:     time.Date(2024, 3, 8, 15, 0, 0, 0, paris),
+			allowed: time.Date(2024, 3, 8, 17, 0, 0, 0, paris),
+		},
+		{
+			// a deny window which overlaps itself never ends
+			name:    "never allowed",
+			windows: []v1alpha1.DeployWindow{{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "0 * * * *", Duration: "2h"}},
+			now:     time.Date(2024, 3, 8, 15, 0, 0, 0, paris),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowed, err := NextAllowed(test.windows, test.now)
+			assert.NoError(t, err)
+			assert.True(t, test.allowed.Equal(allowed), "expected %s, got %s", test.allowed, allowed)
+		})
+	}
+
+	_, err = NextAllowed([]v1alpha1.DeployWindow{{Kind: v1alpha1.DeployWindowKindDeny, Schedule: "* * *", Duration: "1h"}}, time.Now())
+	assert.Error(t, err)
+}
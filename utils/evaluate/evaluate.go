@@ -129,6 +129,53 @@ func EvalCondition(resultValue any, condition string) (bool, error) {
 	}
 }
 
+// EvalStepCondition evaluates the when expression of a canary step, or of one of its branches, against the
+// variables of the rollout
+func EvalStepCondition(condition string, vars map[string]any) (bool, error) {
+	var err error
+
+	env := map[string]any{
+		"asInt":   asInt,
+		"asFloat": asFloat,
+	}
+	for k, v := range vars {
+		env[k] = v
+	}
+
+	unwrapFileErr := func(e error) error {
+		if fileErr, ok := err.(*file.Error); ok {
+			e = errors.New(fileErr.Message)
+		}
+		return e
+	}
+
+	program, err := expr.Compile(condition, expr.Env(env))
+	if err != nil {
+		return false, unwrapFileErr(err)
+	}
+
+	output, err := expr.Run(program, env)
+	if err != nil {
+		return false, unwrapFileErr(err)
+	}
+
+	switch val := output.(type) {
+	case bool:
+		return val, nil
+	default:
+		return false, fmt.Errorf("expected bool, but got %T", val)
+	}
+}
+
+// ValidateStepCondition checks the syntax of the when expression of a canary step, or of one of its branches
+func ValidateStepCondition(condition string) error {
+	_, err := expr.Compile(condition)
+	if fileErr, ok := err.(*file.Error); ok {
+		return errors.New(fileErr.Message)
+	}
+	return err
+}
+
 func isInf(f float64) bool {
 	return math.IsInf(f, 0)
 }
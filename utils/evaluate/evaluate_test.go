@@ -348,3 +348,32 @@ func TestEvalTimeWithInvalidExpression(t *testing.T) {
 	assert.Equal(t, time.Time{}, status)
 	assert.Error(t, err)
 }
+
+func TestEvalStepCondition(t *testing.T) {
+	vars := map[string]any{
+		"revision":  2,
+		"imageTag":  "1.2.3-hotfix",
+		"hour":      14,
+		"analysis":  map[string]string{"canary-analysis": "Inconclusive"},
+		"imageTags": map[string]string{"app": "1.2.3-hotfix"},
+	}
+
+	met, err := EvalStepCondition(`imageTag endsWith "-hotfix" && revision > 1`, vars)
+	assert.NoError(t, err)
+	assert.True(t, met)
+
+	met, err = EvalStepCondition(`analysis["canary-analysis"] == "Successful" || hour >= 18`, vars)
+	assert.NoError(t, err)
+	assert.False(t, met)
+
+	_, err = EvalStepCondition(`imageTag`, vars)
+	assert.EqualError(t, err, "expected bool, but got string")
+
+	_, err = EvalStepCondition(`revision >`, vars)
+	assert.Error(t, err)
+}
+
+func TestValidateStepCondition(t *testing.T) {
+	assert.NoError(t, ValidateStepCondition(`imageTag matches "hotfix" && namespaceLabels.env != "prod"`))
+	assert.Error(t, ValidateStepCondition(`revision >`))
+}
@@ -0,0 +1,120 @@
+package multicluster
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// MemberClusterSecretLabel is the label of the secrets holding the kubeconfigs of the member clusters.
+	// Every key of the secret data is the name of a member cluster and its value is the kubeconfig of the cluster.
+	MemberClusterSecretLabel = "rollouts.argoproj.io/member-cluster"
+)
+
+// MemberClusterSecretSelector returns the label selector of the member cluster secrets
+func MemberClusterSecretSelector() labels.Selector {
+	return labels.SelectorFromSet(labels.Set{MemberClusterSecretLabel: "true"})
+}
+
+// GetMemberClusterKubeConfigs returns the kubeconfigs of the member clusters held by the given secrets keyed by
+// cluster name. When several secrets hold the kubeconfig of the same cluster, the secret whose name sorts last wins.
+func GetMemberClusterKubeConfigs(secrets []*corev1.Secret) map[string][]byte {
+	sorted := append([]*corev1.Secret{}, secrets...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	kubeConfigs := map[string][]byte{}
+	for _, secret := range sorted {
+		for cluster, kubeConfig := range secret.Data {
+			kubeConfigs[cluster] = kubeConfig
+		}
+	}
+	return kubeConfigs
+}
+
+// NewRestConfig builds the client config of a member cluster out of its kubeconfig
+func NewRestConfig(kubeConfig []byte) (*rest.Config, error) {
+	if len(kubeConfig) == 0 {
+		return nil, errors.New("kubeconfig is empty")
+	}
+	rawConfig, err := clientcmd.Load(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("kubeconfig cannot be loaded: %v", err)
+	}
+	if err := clientcmd.Validate(*rawConfig); err != nil {
+		return nil, fmt.Errorf("kubeconfig is not valid: %v", err)
+	}
+	return clientcmd.NewDefaultClientConfig(*rawConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// GetClusterWave returns the index of the wave the cluster belongs to, or -1 if the cluster is not part of any wave
+func GetClusterWave(mc *v1alpha1.MultiClusterPromotion, cluster string) int {
+	if mc == nil || cluster == "" {
+		return -1
+	}
+	for i, wave := range mc.Waves {
+		for _, c := range wave.Clusters {
+			if c == cluster {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// GetMemberClusters returns the clusters of all the waves except the given cluster, along with the name of their wave
+func GetMemberClusters(mc *v1alpha1.MultiClusterPromotion, cluster string) []v1alpha1.MemberClusterStatus {
+	if mc == nil {
+		return nil
+	}
+	var members []v1alpha1.MemberClusterStatus
+	for _, wave := range mc.Waves {
+		for _, c := range wave.Clusters {
+			if c != cluster {
+				members = append(members, v1alpha1.MemberClusterStatus{Cluster: c, Wave: wave.Name})
+			}
+		}
+	}
+	return members
+}
+
+// PendingClusters returns the clusters of the waves before the cluster's wave in which the rollout
+// is not yet Healthy at the given pod template hash
+func PendingClusters(mc *v1alpha1.MultiClusterPromotion, cluster string, podHash string, members []v1alpha1.MemberClusterStatus) []string {
+	waveIndex := GetClusterWave(mc, cluster)
+	if waveIndex <= 0 {
+		return nil
+	}
+	observed := map[string]v1alpha1.MemberClusterStatus{}
+	for _, m := range members {
+		observed[m.Cluster] = m
+	}
+	var pending []string
+	for _, wave := range mc.Waves[:waveIndex] {
+		for _, c := range wave.Clusters {
+			m, ok := observed[c]
+			if !ok || m.Phase != v1alpha1.RolloutPhaseHealthy || m.CurrentPodHash != podHash {
+				pending = append(pending, c)
+			}
+		}
+	}
+	return pending
+}
+
+// GetAbortedCluster returns the first member cluster in which the update to the given pod template hash was aborted
+func GetAbortedCluster(members []v1alpha1.MemberClusterStatus, podHash string) *v1alpha1.MemberClusterStatus {
+	for i := range members {
+		if members[i].Aborted && members[i].CurrentPodHash == podHash {
+			return &members[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package multicluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging-1
+  cluster:
+    server: https://staging-1.example.com
+contexts:
+- name: staging-1
+  context:
+    cluster: staging-1
+    user: staging-1
+current-context: staging-1
+users:
+- name: staging-1
+  user:
+    token: abc
+`
+
+func makeSecret(name, namespace string, labels map[string]string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Data: data,
+	}
+}
+
+func newMultiCluster() *v1alpha1.MultiClusterPromotion {
+	return &v1alpha1.MultiClusterPromotion{
+		Waves: []v1alpha1.ClusterWave{
+			{Name: "staging", Clusters: []string{"staging-1"}},
+			{Name: "prod", Clusters: []string{"prod-1", "prod-2"}},
+		},
+	}
+}
+
+func TestMemberClusterSecretSelector(t *testing.T) {
+	selector := MemberClusterSecretSelector()
+	assert.True(t, selector.Matches(labels.Set{MemberClusterSecretLabel: "true"}))
+	assert.False(t, selector.Matches(labels.Set{MemberClusterSecretLabel: "false"}))
+	assert.False(t, selector.Matches(labels.Set{}))
+}
+
+func TestGetMemberClusterKubeConfigs(t *testing.T) {
+	memberLabels := map[string]string{MemberClusterSecretLabel: "true"}
+	kubeConfigs := GetMemberClusterKubeConfigs([]*corev1.Secret{
+		makeSecret("staging", "argo-rollouts", memberLabels, map[string][]byte{"staging-1": []byte("kubeconfig-staging-1")}),
+		makeSecret("prod", "argo-rollouts", memberLabels, map[string][]byte{"prod-1": []byte("kubeconfig-prod-1"), "prod-2": []byte("kubeconfig-prod-2")}),
+		makeSecret("a-prod", "argo-rollouts", memberLabels, map[string][]byte{"prod-1": []byte("kubeconfig-prod-1-old")}),
+	})
+	assert.Equal(t, map[string][]byte{
+		"staging-1": []byte("kubeconfig-staging-1"),
+		"prod-1":    []byte("kubeconfig-prod-1"),
+		"prod-2":    []byte("kubeconfig-prod-2"),
+	}, kubeConfigs)
+}
+
+func TestNewRestConfig(t *testing.T) {
+	_, err := NewRestConfig(nil)
+	assert.EqualError(t, err, "kubeconfig is empty")
+
+	_, err = NewRestConfig([]byte("not a kubeconfig"))
+	assert.Error(t, err)
+
+	config, err := NewRestConfig([]byte(testKubeConfig))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://staging-1.example.com", config.Host)
+	assert.Equal(t, "abc", config.BearerToken)
+}
+
+func TestGetClusterWave(t *testing.T) {
+	mc := newMultiCluster()
+	assert.Equal(t, 0, GetClusterWave(mc, "staging-1"))
+	assert.Equal(t, 1, GetClusterWave(mc, "prod-2"))
+	assert.Equal(t, -1, GetClusterWave(mc, "dev-1"))
+	assert.Equal(t, -1, GetClusterWave(mc, ""))
+	assert.Equal(t, -1, GetClusterWave(nil, "staging-1"))
+}
+
+func TestGetMemberClusters(t *testing.T) {
+	assert.Equal(t, []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Wave: "staging"},
+		{Cluster: "prod-2", Wave: "prod"},
+	}, GetMemberClusters(newMultiCluster(), "prod-1"))
+	assert.Nil(t, GetMemberClusters(nil, "prod-1"))
+}
+
+func TestPendingClusters(t *testing.T) {
+	mc := newMultiCluster()
+	healthy := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Phase: v1alpha1.RolloutPhaseHealthy, CurrentPodHash: "abc"},
+	}
+	progressing := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", Phase: v1alpha1.RolloutPhaseProgressing, CurrentPodHash: "abc"},
+	}
+
+	// the first wave never waits
+	assert.Nil(t, PendingClusters(mc, "staging-1", "abc", nil))
+	// clusters outside of the waves never wait
+	assert.Nil(t, PendingClusters(mc, "dev-1", "abc", nil))
+	assert.Equal(t, []string{"staging-1"}, PendingClusters(mc, "prod-1", "abc", nil))
+	assert.Equal(t, []string{"staging-1"}, PendingClusters(mc, "prod-1", "abc", progressing))
+	assert.Equal(t, []string{"staging-1"}, PendingClusters(mc, "prod-1", "def", healthy))
+	assert.Nil(t, PendingClusters(mc, "prod-1", "abc", healthy))
+}
+
+func TestGetAbortedCluster(t *testing.T) {
+	members := []v1alpha1.MemberClusterStatus{
+		{Cluster: "staging-1", CurrentPodHash: "abc"},
+		{Cluster: "prod-2", CurrentPodHash: "abc", Aborted: true},
+	}
+	assert.Equal(t, "prod-2", GetAbortedCluster(members, "abc").Cluster)
+	assert.Nil(t, GetAbortedCluster(members, "def"))
+	assert.Nil(t, GetAbortedCluster(nil, "abc"))
+}
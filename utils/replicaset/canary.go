@@ -492,13 +492,24 @@ func GetCurrentSetWeight(rollout *v1alpha1.Rollout) int32 {
 
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := rollout.Spec.Strategy.Canary.Steps[i]
-		if step.SetWeight != nil {
+		if step.SetWeight != nil && !IsCanaryStepSkipped(rollout, i) {
 			return *step.SetWeight
 		}
 	}
 	return 0
 }
 
+// IsCanaryStepSkipped returns whether the step of the current update was skipped, because its when expression
+// evaluated to false or because a branch jumped over it
+func IsCanaryStepSkipped(rollout *v1alpha1.Rollout, index int32) bool {
+	for _, skipped := range rollout.Status.Canary.SkippedSteps {
+		if skipped == index {
+			return true
+		}
+	}
+	return false
+}
+
 // UseSetCanaryScale will return a SetCanaryScale if specified and should be used, returns nil otherwise.
 // TrafficRouting is required to be set for SetCanaryScale to be applicable.
 // If MatchTrafficWeight is set after a previous SetCanaryScale step, it will likewise be ignored.
@@ -521,7 +532,7 @@ func UseSetCanaryScale(rollout *v1alpha1.Rollout) *v1alpha1.SetCanaryScale {
 	}
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := rollout.Spec.Strategy.Canary.Steps[i]
-		if step.SetCanaryScale == nil {
+		if step.SetCanaryScale == nil || IsCanaryStepSkipped(rollout, i) {
 			continue
 		}
 		if step.SetCanaryScale.MatchTrafficWeight {
@@ -577,7 +588,7 @@ func GetCurrentExperimentStep(r *v1alpha1.Rollout) *v1alpha1.RolloutExperimentSt
 
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := r.Spec.Strategy.Canary.Steps[i]
-		if step.Experiment != nil {
+		if step.Experiment != nil && !IsCanaryStepSkipped(r, i) {
 			return step.Experiment
 		}
 	}
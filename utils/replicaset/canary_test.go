@@ -1085,6 +1085,22 @@ func TestGetCurrentSetWeight(t *testing.T) {
 
 }
 
+func TestGetCurrentSetWeightWithSkippedSteps(t *testing.T) {
+	rollout := newRollout(10, 10, intstr.FromInt(0), intstr.FromInt(1), "", "", nil, nil)
+	rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{SetWeight: pointer.Int32(50), When: "hour < 12"},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+	rollout.Status.CurrentStepIndex = pointer.Int32(2)
+	assert.Equal(t, int32(50), GetCurrentSetWeight(rollout))
+
+	rollout.Status.Canary.SkippedSteps = []int32{1}
+	assert.True(t, IsCanaryStepSkipped(rollout, 1))
+	assert.False(t, IsCanaryStepSkipped(rollout, 2))
+	assert.Equal(t, int32(10), GetCurrentSetWeight(rollout))
+}
+
 func TestAtDesiredReplicaCountsForCanary(t *testing.T) {
 
 	t.Run("we are at desired replica counts and availability", func(t *testing.T) {
@@ -14,6 +14,26 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 )
 
+// TrafficRoutingRollout returns the rollout in the shape of a canary rollout as far as traffic routing is
+// concerned. A blue-green rollout with traffic routing is returned as a copy whose stable and canary services are
+// the active and preview services, which lets the traffic routing reconcilers and validations, which only know
+// about the canary strategy, handle the traffic of a blue-green rollout. Any other rollout is returned as is.
+func TrafficRoutingRollout(rollout *v1alpha1.Rollout) *v1alpha1.Rollout {
+	if rollout.Spec.Strategy.BlueGreen == nil || rollout.Spec.Strategy.BlueGreen.TrafficRouting == nil {
+		return rollout
+	}
+	ro := rollout.DeepCopy()
+	blueGreen := ro.Spec.Strategy.BlueGreen
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		StableService:  blueGreen.ActiveService,
+		CanaryService:  blueGreen.PreviewService,
+		TrafficRouting: &blueGreen.TrafficRouting.RolloutTrafficRouting,
+	}
+	ro.Spec.Strategy.BlueGreen = nil
+	ro.Status.Canary.Weights = ro.Status.BlueGreen.Weights
+	return ro
+}
+
 // IsFullyPromoted returns whether or not the given rollout is in a fully promoted state.
 // (versus being in the middle of an update). This is determined by checking if stable hash == desired hash
 func IsFullyPromoted(ro *v1alpha1.Rollout) bool {
@@ -102,6 +122,11 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 	if ro.Spec.Paused {
 		return v1alpha1.RolloutPhasePaused, "manually paused"
 	}
+	for _, pauseCond := range ro.Status.PauseConditions {
+		if pauseCond.Reason == v1alpha1.PauseReasonQueued {
+			return v1alpha1.RolloutPhaseQueued, "waiting for the progression budget"
+		}
+	}
 	for _, pauseCond := range ro.Status.PauseConditions {
 		return v1alpha1.RolloutPhasePaused, string(pauseCond.Reason)
 	}
@@ -140,6 +165,16 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 		if ro.Status.StableRS == "" || !IsFullyPromoted(&ro) {
 			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
 		}
+		if ro.Spec.Strategy.Canary.PostPromotionAnalysis != nil && ro.Status.Canary.BakeStartedAt != nil {
+			// the update is baking and is rolled back if the post-promotion analysis fails
+			arStatus := ro.Status.Canary.PostPromotionAnalysisRunStatus
+			if arStatus == nil || !arStatus.Status.Completed() {
+				return v1alpha1.RolloutPhaseProgressing, "waiting for post-promotion analysis to complete"
+			}
+			if arStatus.Status == v1alpha1.AnalysisPhaseFailed || arStatus.Status == v1alpha1.AnalysisPhaseError {
+				return v1alpha1.RolloutPhaseProgressing, "rolling back after the post-promotion analysis failed"
+			}
+		}
 	}
 	return v1alpha1.RolloutPhaseHealthy, ""
 }
@@ -154,6 +189,19 @@ func waitingForBlueGreenPostPromotionAnalysis(ro *v1alpha1.Rollout) bool {
 	return false
 }
 
+// GetStepAnalysis returns the analysis run by the step, which is the analysis of an analysis or a shadow step
+func GetStepAnalysis(step *v1alpha1.CanaryStep) *v1alpha1.RolloutAnalysis {
+	switch {
+	case step == nil:
+		return nil
+	case step.Analysis != nil:
+		return step.Analysis
+	case step.Shadow != nil:
+		return &step.Shadow.Analysis
+	}
+	return nil
+}
+
 // CanaryStepString returns a string representation of a canary step
 func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.SetWeight != nil {
@@ -172,6 +220,9 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.Analysis != nil {
 		return "analysis"
 	}
+	if c.Shadow != nil {
+		return fmt.Sprintf("shadow: %s", c.Shadow.ProxyService)
+	}
 	if c.SetCanaryScale != nil {
 		if c.SetCanaryScale.Weight != nil {
 			return fmt.Sprintf("setCanaryScale{weight: %d}", *c.SetCanaryScale.Weight)
@@ -183,6 +183,31 @@ func TestRolloutStatusProgressing(t *testing.T) {
 		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
 		assert.Equal(t, "waiting for post-promotion verification to complete", message)
 	}
+	{
+		ro := newCanaryRollout()
+		ro.Spec.Strategy.Canary.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{}
+		ro.Status.StableRS = "def5678"
+		ro.Status.CurrentPodHash = "def5678"
+		ro.Status.CurrentStepIndex = pointer.Int32Ptr(3)
+		ro.Status.Replicas = 5
+		ro.Status.UpdatedReplicas = 5
+		ro.Status.AvailableReplicas = 5
+		bakeStartedAt := metav1.Now()
+		ro.Status.Canary.BakeStartedAt = &bakeStartedAt
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "waiting for post-promotion analysis to complete", message)
+
+		ro.Status.Canary.PostPromotionAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{Status: v1alpha1.AnalysisPhaseFailed}
+		status, message = GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "rolling back after the post-promotion analysis failed", message)
+
+		ro.Status.Canary.PostPromotionAnalysisRunStatus.Status = v1alpha1.AnalysisPhaseSuccessful
+		status, message = GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseHealthy, status)
+		assert.Equal(t, "", message)
+	}
 	{
 		// Scenario when a newly created rollout has partially filled in status (with hashes)
 		// but no updated replica count
@@ -503,3 +528,31 @@ func Test_isGenerationObserved(t *testing.T) {
 		})
 	}
 }
+
+func TestTrafficRoutingRollout(t *testing.T) {
+	ro := newBlueGreenRollout()
+	assert.Same(t, ro, TrafficRoutingRollout(ro))
+
+	ro.Spec.Strategy.BlueGreen.ActiveService = "active"
+	ro.Spec.Strategy.BlueGreen.PreviewService = "preview"
+	ro.Spec.Strategy.BlueGreen.TrafficRouting = &v1alpha1.BlueGreenTrafficRouting{
+		RolloutTrafficRouting: v1alpha1.RolloutTrafficRouting{
+			Nginx:            &v1alpha1.NginxTrafficRouting{StableIngress: "foo-ingress"},
+			MaxTrafficWeight: pointer.Int32(1000),
+		},
+		Weights: []int32{100},
+	}
+	ro.Status.BlueGreen.Weights = &v1alpha1.TrafficWeights{Canary: v1alpha1.WeightDestination{Weight: 100}}
+
+	canaryRollout := TrafficRoutingRollout(ro)
+	assert.Nil(t, canaryRollout.Spec.Strategy.BlueGreen)
+	assert.NotNil(t, canaryRollout.Spec.Strategy.Canary)
+	assert.Equal(t, "active", canaryRollout.Spec.Strategy.Canary.StableService)
+	assert.Equal(t, "preview", canaryRollout.Spec.Strategy.Canary.CanaryService)
+	assert.Equal(t, "foo-ingress", canaryRollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngress)
+	assert.Equal(t, int32(1000), *canaryRollout.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight)
+	assert.Equal(t, ro.Status.BlueGreen.Weights, canaryRollout.Status.Canary.Weights)
+	// the rollout itself is left untouched
+	assert.Nil(t, ro.Spec.Strategy.Canary)
+	assert.NotNil(t, ro.Spec.Strategy.BlueGreen)
+}
@@ -0,0 +1,257 @@
+package strategytemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	templateutil "github.com/argoproj/argo-rollouts/utils/template"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+// Getter gets the spec of the template a strategy reference of a rollout in the given namespace refers to. It
+// returns a NotFound error when the template does not exist.
+type Getter interface {
+	GetTemplateSpec(ref v1alpha1.RolloutStrategyRef, namespace string) (*v1alpha1.RolloutStrategyTemplateSpec, error)
+}
+
+type listerGetter struct {
+	lister        listers.RolloutStrategyTemplateLister
+	clusterLister listers.ClusterRolloutStrategyTemplateLister
+}
+
+// NewListerGetter returns a Getter reading the templates from the informer caches
+func NewListerGetter(lister listers.RolloutStrategyTemplateLister, clusterLister listers.ClusterRolloutStrategyTemplateLister) Getter {
+	return &listerGetter{lister: lister, clusterLister: clusterLister}
+}
+
+func (g *listerGetter) GetTemplateSpec(ref v1alpha1.RolloutStrategyRef, namespace string) (*v1alpha1.RolloutStrategyTemplateSpec, error) {
+	if ref.ClusterScope {
+		template, err := g.clusterLister.Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &template.Spec, nil
+	}
+	template, err := g.lister.RolloutStrategyTemplates(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &template.Spec, nil
+}
+
+type clientGetter struct {
+	ctx    context.Context
+	client clientset.Interface
+}
+
+// NewClientGetter returns a Getter reading the templates from the API server
+func NewClientGetter(ctx context.Context, client clientset.Interface) Getter {
+	return &clientGetter{ctx: ctx, client: client}
+}
+
+func (g *clientGetter) GetTemplateSpec(ref v1alpha1.RolloutStrategyRef, namespace string) (*v1alpha1.RolloutStrategyTemplateSpec, error) {
+	if ref.ClusterScope {
+		template, err := g.client.ArgoprojV1alpha1().ClusterRolloutStrategyTemplates().Get(g.ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &template.Spec, nil
+	}
+	template, err := g.client.ArgoprojV1alpha1().RolloutStrategyTemplates(namespace).Get(g.ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &template.Spec, nil
+}
+
+// MapGetter is a Getter of the template specs it holds by their keys
+type MapGetter map[string]v1alpha1.RolloutStrategyTemplateSpec
+
+func (g MapGetter) GetTemplateSpec(ref v1alpha1.RolloutStrategyRef, namespace string) (*v1alpha1.RolloutStrategyTemplateSpec, error) {
+	spec, ok := g[Key(ref, namespace)]
+	if !ok {
+		resource := rollouts.RolloutStrategyTemplatePlural
+		if ref.ClusterScope {
+			resource = rollouts.ClusterRolloutStrategyTemplatePlural
+		}
+		return nil, k8serrors.NewNotFound(v1alpha1.Resource(resource), ref.Name)
+	}
+	return &spec, nil
+}
+
+// Key returns the key of the template the strategy reference of a rollout in the namespace refers to. It matches
+// the namespace/name key of the template, prefixed with its kind.
+func Key(ref v1alpha1.RolloutStrategyRef, namespace string) string {
+	if ref.ClusterScope {
+		return rollouts.ClusterRolloutStrategyTemplateKind + "/" + ref.Name
+	}
+	return rollouts.RolloutStrategyTemplateKind + "/" + namespace + "/" + ref.Name
+}
+
+// Kind returns the kind of the template a strategy reference refers to
+func Kind(ref v1alpha1.RolloutStrategyRef) string {
+	if ref.ClusterScope {
+		return rollouts.ClusterRolloutStrategyTemplateKind
+	}
+	return rollouts.RolloutStrategyTemplateKind
+}
+
+// ResolveRef resolves the strategy of a rollout referencing a RolloutStrategyTemplate or a
+// ClusterRolloutStrategyTemplate from the template returned by the getter. It returns a *field.Error when the
+// template does not exist or cannot be resolved with the args of the reference, and the error of the getter
+// otherwise.
+func ResolveRef(rollout *v1alpha1.Rollout, getter Getter) error {
+	ref := rollout.Spec.StrategyRef
+	if ref == nil {
+		return nil
+	}
+	fldPath := field.NewPath("spec", "strategyRef")
+	spec, err := getter.GetTemplateSpec(*ref, rollout.Namespace)
+	if k8serrors.IsNotFound(err) {
+		return field.Invalid(fldPath.Child("name"), ref.Name, fmt.Sprintf("%s '%s' not found", Kind(*ref), ref.Name))
+	}
+	if err != nil {
+		return err
+	}
+	if err := Resolve(rollout, *spec); err != nil {
+		return field.Invalid(fldPath, ref.Name, err.Error())
+	}
+	return nil
+}
+
+// ResolvedRollout returns a copy of the rollout with the strategy resolved from the template it references, for
+// the readers of a rollout which need its strategy but do not own the rollout. The rollout itself is returned when
+// it does not reference a template, or when the reference cannot be resolved.
+func ResolvedRollout(rollout *v1alpha1.Rollout, getter Getter) *v1alpha1.Rollout {
+	if rollout == nil || rollout.Spec.StrategyRef == nil || getter == nil {
+		return rollout
+	}
+	resolved := rollout.DeepCopy()
+	if err := ResolveRef(resolved, getter); err != nil {
+		return rollout
+	}
+	return resolved
+}
+
+// ReferencingIndexValue is the value under which the rollouts referencing a strategy template are indexed by
+// IndexFunc. The template of a rollout may change after the rollout was indexed, so the resources of their
+// strategies are only known when the index is read.
+const ReferencingIndexValue = "strategyRef"
+
+// IndexFunc returns an index function of the rollouts by the keys keysFunc returns for their strategy. The
+// rollouts referencing a strategy template are indexed by ReferencingIndexValue instead, and found by ByIndex.
+func IndexFunc(keysFunc func(*v1alpha1.Rollout) []string) cache.IndexFunc {
+	return func(obj any) ([]string, error) {
+		ro := unstructuredutil.ObjectToRollout(obj)
+		if ro == nil {
+			return []string{}, nil
+		}
+		if ro.Spec.StrategyRef != nil {
+			return []string{ReferencingIndexValue}, nil
+		}
+		return keysFunc(ro), nil
+	}
+}
+
+// ByIndex returns the rollouts of an index built by IndexFunc whose strategy has the key, along with the
+// rollouts referencing a strategy template whose resolved strategy has the key. The strategies of the returned
+// rollouts are resolved.
+func ByIndex(indexer cache.Indexer, indexName, key string, getter Getter, keysFunc func(*v1alpha1.Rollout) []string) ([]*v1alpha1.Rollout, error) {
+	objs, err := indexer.ByIndex(indexName, key)
+	if err != nil {
+		return nil, err
+	}
+	var rollouts []*v1alpha1.Rollout
+	for _, obj := range objs {
+		if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
+			rollouts = append(rollouts, ro)
+		}
+	}
+	referencing, err := indexer.ByIndex(indexName, ReferencingIndexValue)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range referencing {
+		ro := ResolvedRollout(unstructuredutil.ObjectToRollout(obj), getter)
+		if ro != nil && slices.Contains(keysFunc(ro), key) {
+			rollouts = append(rollouts, ro)
+		}
+	}
+	return rollouts, nil
+}
+
+// IsEmptyStrategy returns true if the strategy is neither canary nor blue-green
+func IsEmptyStrategy(strategy v1alpha1.RolloutStrategy) bool {
+	return strategy.Canary == nil && strategy.BlueGreen == nil
+}
+
+// Resolve sets the strategy of the rollout to the strategy of the template it references, with the arguments of
+// the reference substituted for the {{args.<name>}} placeholders of the template
+func Resolve(rollout *v1alpha1.Rollout, spec v1alpha1.RolloutStrategyTemplateSpec) error {
+	// When the strategy is resolved for the first time, StrategyResolvedFromRef = false.
+	// In this case, the strategy must not be set
+	if !rollout.Spec.StrategyResolvedFromRef && !IsEmptyStrategy(rollout.Spec.Strategy) {
+		return fmt.Errorf("strategy must be empty for strategy reference rollout")
+	}
+	args, err := mergeArgs(rollout.Spec.StrategyRef.Args, spec.Args)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(spec.Strategy)
+	if err != nil {
+		return err
+	}
+	resolved, err := templateutil.ResolveQuotedArgs(string(data), args)
+	if err != nil {
+		return err
+	}
+	var strategy v1alpha1.RolloutStrategy
+	if err := json.Unmarshal([]byte(resolved), &strategy); err != nil {
+		return err
+	}
+	rollout.Spec.SetResolvedStrategy(strategy)
+	return nil
+}
+
+// mergeArgs overrides the default values of the template arguments with the values of the reference arguments
+func mergeArgs(refArgs, templateArgs []v1alpha1.Argument) ([]v1alpha1.Argument, error) {
+	args := append(templateArgs[:0:0], templateArgs...)
+	for _, arg := range refArgs {
+		if arg.ValueFrom != nil {
+			return nil, fmt.Errorf("argument \"%s\" must have a value", arg.Name)
+		}
+		i := findArg(arg.Name, args)
+		if i < 0 {
+			return nil, fmt.Errorf("argument \"%s\" is not an argument of the template", arg.Name)
+		}
+		if arg.Value != nil {
+			args[i].Value = arg.Value
+		}
+	}
+	for _, arg := range args {
+		if arg.Value == nil {
+			return nil, fmt.Errorf("argument \"%s\" was not supplied", arg.Name)
+		}
+	}
+	return args, nil
+}
+
+func findArg(name string, args []v1alpha1.Argument) int {
+	for i, arg := range args {
+		if arg.Name == name {
+			return i
+		}
+	}
+	return -1
+}
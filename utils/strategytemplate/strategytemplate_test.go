@@ -0,0 +1,181 @@
+package strategytemplate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newTemplateSpec() v1alpha1.RolloutStrategyTemplateSpec {
+	return v1alpha1.RolloutStrategyTemplateSpec{
+		Args: []v1alpha1.Argument{
+			{Name: "service"},
+			{Name: "pause", Value: pointer.String("1m")},
+		},
+		Strategy: v1alpha1.RolloutStrategy{
+			Canary: &v1alpha1.CanaryStrategy{
+				CanaryService: "{{args.service}}-canary",
+				Steps: []v1alpha1.CanaryStep{
+					{SetWeight: pointer.Int32(20)},
+					{Pause: &v1alpha1.RolloutPause{Duration: &intstr.IntOrString{Type: intstr.String, StrVal: "{{args.pause}}"}}},
+				},
+			},
+		},
+	}
+}
+
+func newRollout(args ...v1alpha1.Argument) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			StrategyRef: &v1alpha1.RolloutStrategyRef{Name: "standard", Args: args},
+		},
+	}
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "RolloutStrategyTemplate/default/standard", Key(v1alpha1.RolloutStrategyRef{Name: "standard"}, "default"))
+	assert.Equal(t, "ClusterRolloutStrategyTemplate/standard", Key(v1alpha1.RolloutStrategyRef{Name: "standard", ClusterScope: true}, "default"))
+}
+
+func TestResolve(t *testing.T) {
+	t.Run("Substitutes Args", func(t *testing.T) {
+		ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+		require.NoError(t, Resolve(ro, newTemplateSpec()))
+		assert.True(t, ro.Spec.StrategyResolvedFromRef)
+		assert.Equal(t, "web-canary", ro.Spec.Strategy.Canary.CanaryService)
+		assert.Equal(t, "1m", ro.Spec.Strategy.Canary.Steps[1].Pause.Duration.StrVal)
+	})
+	t.Run("Overrides Default", func(t *testing.T) {
+		ro := newRollout(
+			v1alpha1.Argument{Name: "service", Value: pointer.String("web")},
+			v1alpha1.Argument{Name: "pause", Value: pointer.String("5m")},
+		)
+		require.NoError(t, Resolve(ro, newTemplateSpec()))
+		assert.Equal(t, "5m", ro.Spec.Strategy.Canary.Steps[1].Pause.Duration.StrVal)
+	})
+	t.Run("Missing Arg", func(t *testing.T) {
+		err := Resolve(newRollout(), newTemplateSpec())
+		assert.EqualError(t, err, `argument "service" was not supplied`)
+	})
+	t.Run("Unknown Arg", func(t *testing.T) {
+		ro := newRollout(
+			v1alpha1.Argument{Name: "service", Value: pointer.String("web")},
+			v1alpha1.Argument{Name: "weight", Value: pointer.String("10")},
+		)
+		assert.EqualError(t, Resolve(ro, newTemplateSpec()), `argument "weight" is not an argument of the template`)
+	})
+	t.Run("Strategy Set", func(t *testing.T) {
+		ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+		ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{ActiveService: "active"}
+		assert.EqualError(t, Resolve(ro, newTemplateSpec()), "strategy must be empty for strategy reference rollout")
+	})
+	t.Run("Escapes Values", func(t *testing.T) {
+		ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String(`we"b`)})
+		require.NoError(t, Resolve(ro, newTemplateSpec()))
+		assert.Equal(t, `we"b-canary`, ro.Spec.Strategy.Canary.CanaryService)
+	})
+}
+
+func TestResolvedStrategyNotMarshalled(t *testing.T) {
+	ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+	require.NoError(t, Resolve(ro, newTemplateSpec()))
+	data, err := json.Marshal(ro)
+	require.NoError(t, err)
+	var obj map[string]any
+	require.NoError(t, json.Unmarshal(data, &obj))
+	spec := obj["spec"].(map[string]any)
+	assert.NotContains(t, spec, "strategy")
+	assert.Contains(t, spec, "strategyRef")
+}
+
+func TestResolveRef(t *testing.T) {
+	getter := MapGetter{"RolloutStrategyTemplate/default/standard": newTemplateSpec()}
+	t.Run("Resolves", func(t *testing.T) {
+		ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+		ro.Namespace = "default"
+		require.NoError(t, ResolveRef(ro, getter))
+		assert.Equal(t, "web-canary", ro.Spec.Strategy.Canary.CanaryService)
+	})
+	t.Run("Not Found", func(t *testing.T) {
+		ro := newRollout()
+		ro.Namespace = "other"
+		err := ResolveRef(ro, getter)
+		var fieldErr *field.Error
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "spec.strategyRef.name", fieldErr.Field)
+		assert.Equal(t, "RolloutStrategyTemplate 'standard' not found", fieldErr.Detail)
+	})
+	t.Run("Invalid Args", func(t *testing.T) {
+		ro := newRollout()
+		ro.Namespace = "default"
+		err := ResolveRef(ro, getter)
+		var fieldErr *field.Error
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "spec.strategyRef", fieldErr.Field)
+		assert.Equal(t, `argument "service" was not supplied`, fieldErr.Detail)
+	})
+}
+
+func TestResolvedRollout(t *testing.T) {
+	getter := MapGetter{"RolloutStrategyTemplate/default/standard": newTemplateSpec()}
+	ro := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+	ro.Namespace = "default"
+
+	resolved := ResolvedRollout(ro, getter)
+	assert.Equal(t, "web-canary", resolved.Spec.Strategy.Canary.CanaryService)
+	assert.Nil(t, ro.Spec.Strategy.Canary, "the rollout must not be modified")
+
+	ro.Spec.StrategyRef.Name = "missing"
+	assert.Same(t, ro, ResolvedRollout(ro, getter))
+	assert.Same(t, ro, ResolvedRollout(ro, nil))
+}
+
+func TestByIndex(t *testing.T) {
+	canaryServiceKeys := func(ro *v1alpha1.Rollout) []string {
+		if ro.Spec.Strategy.Canary == nil {
+			return nil
+		}
+		return []string{ro.Namespace + "/" + ro.Spec.Strategy.Canary.CanaryService}
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"byService": IndexFunc(canaryServiceKeys)})
+
+	inline := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "inline", Namespace: "default"},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{CanaryService: "web-canary"}},
+		},
+	}
+	referencing := newRollout(v1alpha1.Argument{Name: "service", Value: pointer.String("web")})
+	referencing.Name, referencing.Namespace = "referencing", "default"
+	require.NoError(t, indexer.Add(inline))
+	require.NoError(t, indexer.Add(referencing))
+
+	getter := MapGetter{"RolloutStrategyTemplate/default/standard": newTemplateSpec()}
+	rollouts, err := ByIndex(indexer, "byService", "default/web-canary", getter, canaryServiceKeys)
+	require.NoError(t, err)
+	require.Len(t, rollouts, 2)
+	assert.Same(t, inline, rollouts[0])
+	assert.Equal(t, "referencing", rollouts[1].Name)
+	assert.Equal(t, "web-canary", rollouts[1].Spec.Strategy.Canary.CanaryService)
+
+	// A change of the template is seen without reindexing the rollout
+	template := newTemplateSpec()
+	template.Strategy.Canary.CanaryService = "{{args.service}}-preview"
+	getter["RolloutStrategyTemplate/default/standard"] = template
+	rollouts, err = ByIndex(indexer, "byService", "default/web-canary", getter, canaryServiceKeys)
+	require.NoError(t, err)
+	assert.Equal(t, []*v1alpha1.Rollout{inline}, rollouts)
+	rollouts, err = ByIndex(indexer, "byService", "default/web-preview", getter, canaryServiceKeys)
+	require.NoError(t, err)
+	require.Len(t, rollouts, 1)
+	assert.Equal(t, "referencing", rollouts[0].Name)
+}
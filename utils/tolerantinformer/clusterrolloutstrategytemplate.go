@@ -0,0 +1,68 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantClusterRolloutStrategyTemplateInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.ClusterRolloutStrategyTemplateInformer {
+	return &tolerantClusterRolloutStrategyTemplateInformer{
+		delegate: factory.ForResource(v1alpha1.ClusterRolloutStrategyTemplateGVR),
+	}
+}
+
+type tolerantClusterRolloutStrategyTemplateInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantClusterRolloutStrategyTemplateInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantClusterRolloutStrategyTemplateInformer) Lister() rolloutlisters.ClusterRolloutStrategyTemplateLister {
+	return &tolerantClusterRolloutStrategyTemplateLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantClusterRolloutStrategyTemplateLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantClusterRolloutStrategyTemplateLister) List(selector labels.Selector) ([]*v1alpha1.ClusterRolloutStrategyTemplate, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToClusterRolloutStrategyTemplates(objects)
+}
+
+func (t *tolerantClusterRolloutStrategyTemplateLister) Get(name string) (*v1alpha1.ClusterRolloutStrategyTemplate, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.ClusterRolloutStrategyTemplate{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func convertObjectsToClusterRolloutStrategyTemplates(objects []runtime.Object) ([]*v1alpha1.ClusterRolloutStrategyTemplate, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.ClusterRolloutStrategyTemplate, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.ClusterRolloutStrategyTemplate{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
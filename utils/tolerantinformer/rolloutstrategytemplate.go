@@ -0,0 +1,86 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantRolloutStrategyTemplateInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.RolloutStrategyTemplateInformer {
+	return &tolerantRolloutStrategyTemplateInformer{
+		delegate: factory.ForResource(v1alpha1.RolloutStrategyTemplateGVR),
+	}
+}
+
+type tolerantRolloutStrategyTemplateInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantRolloutStrategyTemplateInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantRolloutStrategyTemplateInformer) Lister() rolloutlisters.RolloutStrategyTemplateLister {
+	return &tolerantRolloutStrategyTemplateLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantRolloutStrategyTemplateLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantRolloutStrategyTemplateLister) List(selector labels.Selector) ([]*v1alpha1.RolloutStrategyTemplate, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutStrategyTemplates(objects)
+}
+
+func (t *tolerantRolloutStrategyTemplateLister) RolloutStrategyTemplates(namespace string) rolloutlisters.RolloutStrategyTemplateNamespaceLister {
+	return &tolerantRolloutStrategyTemplateNamespaceLister{
+		delegate: t.delegate.ByNamespace(namespace),
+	}
+}
+
+type tolerantRolloutStrategyTemplateNamespaceLister struct {
+	delegate cache.GenericNamespaceLister
+}
+
+func (t *tolerantRolloutStrategyTemplateNamespaceLister) Get(name string) (*v1alpha1.RolloutStrategyTemplate, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.RolloutStrategyTemplate{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func (t *tolerantRolloutStrategyTemplateNamespaceLister) List(selector labels.Selector) ([]*v1alpha1.RolloutStrategyTemplate, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutStrategyTemplates(objects)
+}
+
+func convertObjectsToRolloutStrategyTemplates(objects []runtime.Object) ([]*v1alpha1.RolloutStrategyTemplate, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.RolloutStrategyTemplate, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.RolloutStrategyTemplate{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
@@ -0,0 +1,153 @@
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/version"
+)
+
+const (
+	// TracerName is the name of the tracer of the controller
+	TracerName = "github.com/argoproj/argo-rollouts"
+	// ServiceName is the service name the spans of the controller are exported with
+	ServiceName = "argo-rollouts"
+)
+
+// Attribute keys of the spans of the controller
+const (
+	AttributeNamespace       = attribute.Key("k8s.namespace.name")
+	AttributeRollout         = attribute.Key("argo_rollouts.rollout")
+	AttributeAnalysisRun     = attribute.Key("argo_rollouts.analysisrun")
+	AttributeExperiment      = attribute.Key("argo_rollouts.experiment")
+	AttributePodTemplateHash = attribute.Key("argo_rollouts.pod_template_hash")
+	AttributeStepIndex       = attribute.Key("argo_rollouts.step.index")
+	AttributeStep            = attribute.Key("argo_rollouts.step")
+	AttributeTrafficRouter   = attribute.Key("argo_rollouts.traffic_router")
+	AttributeWeight          = attribute.Key("argo_rollouts.weight")
+	AttributeWeightVerified  = attribute.Key("argo_rollouts.weight.verified")
+	AttributeMetric          = attribute.Key("argo_rollouts.metric")
+	AttributeMetricProvider  = attribute.Key("argo_rollouts.metric.provider")
+	AttributeMeasurement     = attribute.Key("argo_rollouts.measurement.phase")
+	AttributePlugin          = attribute.Key("argo_rollouts.plugin")
+)
+
+// InitTracerProvider sets up the global tracer provider to export spans with OTLP over gRPC to the
+// collector at address. The returned function flushes the pending spans and stops the exporter.
+func InitTracerProvider(ctx context.Context, address string, insecure bool) (func(context.Context) error, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(address)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(version.GetVersion().Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the controller from the global tracer provider. Spans are not
+// recorded unless InitTracerProvider was called.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span which is a child of the span in ctx
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordSpan records a span, as a child of the span in ctx, of something which already happened
+// between start and end
+func RecordSpan(ctx context.Context, name string, start, end time.Time, attrs ...attribute.KeyValue) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, span := Tracer().Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End(trace.WithTimestamp(end))
+}
+
+// EndSpan records err, if any, on the span and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RevisionContext returns a context whose parent span belongs to the trace of the given revision of
+// a rollout. The trace id is derived from the rollout UID and the pod template hash of the revision,
+// so every reconciliation of the revision, and the analysis runs and experiments created for it,
+// end up in the same trace without having to persist anything on the objects. The parent span is a
+// remote span which is never exported, so the trace is shown with a missing root span.
+func RevisionContext(ctx context.Context, uid types.UID, podTemplateHash string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	sum := sha256.Sum256([]byte(string(uid) + "/" + podTemplateHash))
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// RolloutContext returns a context in the trace of the current revision of the rollout
+func RolloutContext(ctx context.Context, ro *v1alpha1.Rollout) context.Context {
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	return RevisionContext(ctx, ro.UID, podHash)
+}
+
+// ObjectContext returns a context in the trace of the rollout revision an analysis run or an
+// experiment was created for. Analysis runs of an experiment are in the trace of the experiment, and
+// objects which were not created by a rollout or an experiment get a trace of their own.
+func ObjectContext(ctx context.Context, obj metav1.Object) context.Context {
+	owner := metav1.GetControllerOf(obj)
+	switch {
+	case owner != nil && owner.Kind == rollouts.RolloutKind:
+		return RevisionContext(ctx, owner.UID, obj.GetLabels()[v1alpha1.DefaultRolloutUniqueLabelKey])
+	case owner != nil && owner.Kind == rollouts.ExperimentKind:
+		return RevisionContext(ctx, owner.UID, "")
+	default:
+		return RevisionContext(ctx, obj.GetUID(), "")
+	}
+}
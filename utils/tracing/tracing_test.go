@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// collector is a stand-in for an OTLP collector which keeps the spans it receives
+type collector struct {
+	collectortrace.UnimplementedTraceServiceServer
+	lock  sync.Mutex
+	spans []*tracev1.ResourceSpans
+}
+
+func (c *collector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.spans = append(c.spans, req.ResourceSpans...)
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func startCollector(t *testing.T) (*collector, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	c := &collector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, c)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return c, lis.Addr().String()
+}
+
+func TestInitTracerProvider(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+	c, address := startCollector(t)
+
+	ctx := context.Background()
+	shutdown, err := InitTracerProvider(ctx, address, true)
+	require.NoError(t, err)
+
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", UID: "1234"}}
+	_, span := StartSpan(RolloutContext(ctx, ro), "rollout.reconcile", AttributeRollout.String(ro.Name))
+	EndSpan(span, nil)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	require.NoError(t, shutdown(shutdownCtx))
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	require.Len(t, c.spans, 1)
+	var serviceName string
+	for _, attr := range c.spans[0].Resource.Attributes {
+		if attr.Key == "service.name" {
+			serviceName = attr.Value.GetStringValue()
+		}
+	}
+	assert.Equal(t, ServiceName, serviceName)
+	require.Len(t, c.spans[0].ScopeSpans, 1)
+	spans := c.spans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 1)
+	assert.Equal(t, "rollout.reconcile", spans[0].Name)
+	traceID := trace.SpanContextFromContext(RolloutContext(ctx, ro)).TraceID()
+	assert.Equal(t, traceID[:], spans[0].TraceId)
+}
+
+func TestRevisionContext(t *testing.T) {
+	sc := trace.SpanContextFromContext(RevisionContext(context.Background(), "1234", "abc"))
+	assert.True(t, sc.IsValid())
+	assert.True(t, sc.IsRemote())
+	assert.True(t, sc.IsSampled())
+
+	// the trace is the same across reconciliations of the revision and differs between revisions
+	assert.Equal(t, sc, trace.SpanContextFromContext(RevisionContext(context.TODO(), "1234", "abc")))
+	assert.NotEqual(t, sc.TraceID(), trace.SpanContextFromContext(RevisionContext(context.Background(), "1234", "def")).TraceID())
+	assert.NotEqual(t, sc.TraceID(), trace.SpanContextFromContext(RevisionContext(context.Background(), "5678", "abc")).TraceID())
+}
+
+func TestObjectContext(t *testing.T) {
+	traceID := func(ctx context.Context) trace.TraceID {
+		return trace.SpanContextFromContext(ctx).TraceID()
+	}
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", UID: "1234"}}
+	ex := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{
+		Name:            "guestbook-abc-1",
+		UID:             "5678",
+		Labels:          map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "abc"},
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+	}}
+	exRun := &v1alpha1.AnalysisRun{ObjectMeta: metav1.ObjectMeta{
+		Name:            "guestbook-abc-1-analysis",
+		UID:             "9012",
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ex, v1alpha1.SchemeGroupVersion.WithKind("Experiment"))},
+	}}
+	run := &v1alpha1.AnalysisRun{ObjectMeta: metav1.ObjectMeta{Name: "standalone", UID: "3456"}}
+
+	assert.Equal(t, traceID(RevisionContext(context.TODO(), "1234", "abc")), traceID(ObjectContext(context.TODO(), ex)))
+	assert.Equal(t, traceID(RevisionContext(context.TODO(), "5678", "")), traceID(ObjectContext(context.TODO(), exRun)))
+	assert.Equal(t, traceID(RevisionContext(context.TODO(), "3456", "")), traceID(ObjectContext(context.TODO(), run)))
+}
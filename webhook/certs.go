@@ -0,0 +1,203 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// CACertKey is the key of the CA certificate in the secret of the webhook certificate
+	CACertKey = "ca.crt"
+	// certificateValidity is how long a generated certificate is valid for
+	certificateValidity = 10 * 365 * 24 * time.Hour
+	// certificateRenewBefore is how long before it expires a generated certificate is renewed
+	certificateRenewBefore = 30 * 24 * time.Hour
+)
+
+// CertificateOptions are the options to bootstrap the certificate of the webhook server
+type CertificateOptions struct {
+	// Namespace is the namespace of the webhook service and of the certificate secret
+	Namespace string
+	// ServiceName is the name of the service the API server calls the webhook through
+	ServiceName string
+	// SecretName is the name of the secret which stores the certificate, shared by all the replicas
+	SecretName string
+	// WebhookConfigurationName is the name of the ValidatingWebhookConfiguration to inject the CA into
+	WebhookConfigurationName string
+}
+
+// BootstrapCertificate returns the serving certificate of the webhook server from the certificate
+// secret, or generates a self-signed one and stores it if the secret does not exist yet or the
+// certificate is about to expire. The CA of the certificate is then injected into the webhooks of
+// the ValidatingWebhookConfiguration.
+func BootstrapCertificate(ctx context.Context, kubeclientset kubernetes.Interface, o CertificateOptions) (*tls.Certificate, error) {
+	secret, err := getOrCreateCertificateSecret(ctx, kubeclientset, o)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, err
+	}
+	if err := injectCABundle(ctx, kubeclientset, o.WebhookConfigurationName, secret.Data[CACertKey]); err != nil {
+		return nil, err
+	}
+	return &certificate, nil
+}
+
+func getOrCreateCertificateSecret(ctx context.Context, kubeclientset kubernetes.Interface, o CertificateOptions) (*corev1.Secret, error) {
+	secretsIf := kubeclientset.CoreV1().Secrets(o.Namespace)
+	secret, err := secretsIf.Get(ctx, o.SecretName, metav1.GetOptions{})
+	notFound := k8serrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return nil, err
+	}
+	if !notFound && isCertificateValid(secret.Data[corev1.TLSCertKey], dnsNames(o)[0]) {
+		return secret, nil
+	}
+
+	log.Infof("Generating webhook certificate in secret '%s'", o.SecretName)
+	certPEM, keyPEM, caPEM, err := generateCertificate(dnsNames(o), timeutil.Now().Add(certificateValidity))
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+		CACertKey:               caPEM,
+	}
+	if notFound {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: o.SecretName, Namespace: o.Namespace},
+			Type:       corev1.SecretTypeTLS,
+			Data:       data,
+		}
+		created, err := secretsIf.Create(ctx, secret, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// another replica generated the certificate first
+			return secretsIf.Get(ctx, o.SecretName, metav1.GetOptions{})
+		}
+		return created, err
+	}
+	secret.Data = data
+	updated, err := secretsIf.Update(ctx, secret, metav1.UpdateOptions{})
+	if k8serrors.IsConflict(err) {
+		// another replica renewed the certificate first
+		return secretsIf.Get(ctx, o.SecretName, metav1.GetOptions{})
+	}
+	return updated, err
+}
+
+func dnsNames(o CertificateOptions) []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc", o.ServiceName, o.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", o.ServiceName, o.Namespace),
+		fmt.Sprintf("%s.%s", o.ServiceName, o.Namespace),
+		o.ServiceName,
+	}
+}
+
+// isCertificateValid returns true if the PEM encoded certificate is valid for the DNS name and is not
+// about to expire
+func isCertificateValid(certPEM []byte, dnsName string) bool {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	return cert.VerifyHostname(dnsName) == nil && timeutil.Now().Add(certificateRenewBefore).Before(cert.NotAfter)
+}
+
+// generateCertificate generates a self-signed CA, and a serving certificate for the DNS names signed
+// by the CA, and returns them PEM encoded along with the key of the serving certificate
+func generateCertificate(dnsNames []string, notAfter time.Time) ([]byte, []byte, []byte, error) {
+	notBefore := timeutil.Now().Add(-time.Hour)
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "argo-rollouts-webhook-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	return certPEM, keyPEM, caPEM, nil
+}
+
+// injectCABundle sets the CA bundle of the webhooks of the ValidatingWebhookConfiguration, so that
+// the API server trusts the certificate of the webhook server
+func injectCABundle(ctx context.Context, kubeclientset kubernetes.Interface, webhookConfigurationName string, caPEM []byte) error {
+	webhookConfigurationsIf := kubeclientset.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	webhookConfiguration, err := webhookConfigurationsIf.Get(ctx, webhookConfigurationName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	modified := false
+	for i := range webhookConfiguration.Webhooks {
+		if !bytes.Equal(webhookConfiguration.Webhooks[i].ClientConfig.CABundle, caPEM) {
+			webhookConfiguration.Webhooks[i].ClientConfig.CABundle = caPEM
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+	log.Infof("Injecting CA bundle into ValidatingWebhookConfiguration '%s'", webhookConfigurationName)
+	_, err = webhookConfigurationsIf.Update(ctx, webhookConfiguration, metav1.UpdateOptions{})
+	return err
+}
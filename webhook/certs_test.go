@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func TestGenerateCertificate(t *testing.T) {
+	o := CertificateOptions{Namespace: "argo-rollouts", ServiceName: "argo-rollouts-webhook"}
+	certPEM, _, caPEM, err := generateCertificate(dnsNames(o), timeutil.Now().Add(certificateValidity))
+	assert.NoError(t, err)
+	assert.True(t, isCertificateValid(certPEM, "argo-rollouts-webhook.argo-rollouts.svc"))
+	assert.False(t, isCertificateValid(certPEM, "other.argo-rollouts.svc"))
+	assert.False(t, isCertificateValid([]byte("invalid"), "argo-rollouts-webhook.argo-rollouts.svc"))
+
+	// the certificate is signed by the CA
+	roots := x509.NewCertPool()
+	assert.True(t, roots.AppendCertsFromPEM(caPEM))
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	assert.NoError(t, err)
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: "argo-rollouts-webhook.argo-rollouts.svc", Roots: roots})
+	assert.NoError(t, err)
+
+	// a certificate about to expire is renewed
+	certPEM, _, _, err = generateCertificate(dnsNames(o), timeutil.Now().Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, isCertificateValid(certPEM, "argo-rollouts-webhook.argo-rollouts.svc"))
+}
+
+func TestBootstrapCertificate(t *testing.T) {
+	ctx := context.Background()
+	o := CertificateOptions{
+		Namespace:                "argo-rollouts",
+		ServiceName:              "argo-rollouts-webhook",
+		SecretName:               "argo-rollouts-webhook-certs",
+		WebhookConfigurationName: "argo-rollouts-validating-webhook",
+	}
+	webhookConfiguration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: o.WebhookConfigurationName},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{Name: "rollouts.argoproj.io"},
+			{Name: "analysistemplates.argoproj.io"},
+		},
+	}
+	kubeclientset := k8sfake.NewSimpleClientset(webhookConfiguration)
+
+	certificate, err := BootstrapCertificate(ctx, kubeclientset, o)
+	assert.NoError(t, err)
+	assert.NotNil(t, certificate)
+
+	secret, err := kubeclientset.CoreV1().Secrets(o.Namespace).Get(ctx, o.SecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	assert.NotEmpty(t, secret.Data[CACertKey])
+
+	webhookConfiguration, err = kubeclientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, o.WebhookConfigurationName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	for _, webhook := range webhookConfiguration.Webhooks {
+		assert.Equal(t, secret.Data[CACertKey], webhook.ClientConfig.CABundle)
+	}
+
+	// the certificate of the secret is reused by the other replicas
+	kubeclientset.ClearActions()
+	_, err = BootstrapCertificate(ctx, kubeclientset, o)
+	assert.NoError(t, err)
+	for _, action := range kubeclientset.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+}
@@ -0,0 +1,245 @@
+package webhook
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+// getReferencedResources gets the services, analysis templates, ingresses and virtual services a
+// rollout references. Unlike the controller, which reports a missing reference as an invalid spec,
+// the webhook skips it: the referenced object may well be created right after the rollout.
+func (s *WebhookServer) getReferencedResources(ctx context.Context, rollout *v1alpha1.Rollout) (*validation.ReferencedResources, error) {
+	refResources := validation.ReferencedResources{}
+	var err error
+
+	// the labels of a workload referenced rollout are only known once its template is resolved
+	if rollout.Spec.WorkloadRef == nil {
+		refResources.ServiceWithType, err = s.getReferencedServices(ctx, rollout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	refResources.AnalysisTemplatesWithType, err = s.getReferencedRolloutAnalyses(ctx, rollout)
+	if err != nil {
+		return nil, err
+	}
+	// the traffic routing of a blue-green rollout is resolved like the one of a canary rollout
+	trafficRoutingRollout := rolloututil.TrafficRoutingRollout(rollout)
+	refResources.Ingresses, err = s.getReferencedIngresses(ctx, trafficRoutingRollout)
+	if err != nil {
+		return nil, err
+	}
+	refResources.VirtualServices, err = s.getReferencedVirtualServices(ctx, trafficRoutingRollout)
+	if err != nil {
+		return nil, err
+	}
+	return &refResources, nil
+}
+
+// ignoreNotFound returns nil if the error is a not found error
+func ignoreNotFound(err error) error {
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *WebhookServer) getReferencedServices(ctx context.Context, rollout *v1alpha1.Rollout) ([]validation.ServiceWithType, error) {
+	serviceNames := map[validation.ServiceType]string{}
+	if blueGreen := rollout.Spec.Strategy.BlueGreen; blueGreen != nil {
+		serviceNames[validation.ActiveService] = blueGreen.ActiveService
+		serviceNames[validation.PreviewService] = blueGreen.PreviewService
+	} else if canary := rollout.Spec.Strategy.Canary; canary != nil {
+		serviceNames[validation.StableService] = canary.StableService
+		serviceNames[validation.CanaryService] = canary.CanaryService
+		if canary.PingPong != nil {
+			serviceNames[validation.PingService] = canary.PingPong.PingService
+			serviceNames[validation.PongService] = canary.PingPong.PongService
+		}
+	}
+
+	services := []validation.ServiceWithType{}
+	for _, serviceType := range []validation.ServiceType{validation.ActiveService, validation.PreviewService, validation.StableService, validation.CanaryService, validation.PingService, validation.PongService} {
+		serviceName := serviceNames[serviceType]
+		if serviceName == "" {
+			continue
+		}
+		service, err := s.Options.KubeClientset.CoreV1().Services(rollout.Namespace).Get(ctx, serviceName, metav1.GetOptions{})
+		if err != nil {
+			if err = ignoreNotFound(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		services = append(services, validation.ServiceWithType{Service: service, Type: serviceType})
+	}
+	return services, nil
+}
+
+func (s *WebhookServer) getReferencedRolloutAnalyses(ctx context.Context, rollout *v1alpha1.Rollout) ([]validation.AnalysisTemplatesWithType, error) {
+	analysisTemplates := []validation.AnalysisTemplatesWithType{}
+	addTemplates := func(rolloutAnalysis *v1alpha1.RolloutAnalysis, templateType validation.AnalysisTemplateType, canaryStepIndex int) error {
+		templates, clusterTemplates, err := s.getReferencedAnalysisTemplates(ctx, rollout.Namespace, rolloutAnalysis.Templates)
+		if err != nil {
+			return err
+		}
+		if len(templates) == 0 && len(clusterTemplates) == 0 {
+			return nil
+		}
+		analysisTemplates = append(analysisTemplates, validation.AnalysisTemplatesWithType{
+			AnalysisTemplates:        templates,
+			ClusterAnalysisTemplates: clusterTemplates,
+			TemplateType:             templateType,
+			CanaryStepIndex:          canaryStepIndex,
+			Args:                     rolloutAnalysis.Args,
+		})
+		return nil
+	}
+
+	if blueGreen := rollout.Spec.Strategy.BlueGreen; blueGreen != nil {
+		if blueGreen.PrePromotionAnalysis != nil {
+			if err := addTemplates(blueGreen.PrePromotionAnalysis, validation.PrePromotionAnalysis, 0); err != nil {
+				return nil, err
+			}
+		}
+		if blueGreen.PostPromotionAnalysis != nil {
+			if err := addTemplates(blueGreen.PostPromotionAnalysis, validation.PostPromotionAnalysis, 0); err != nil {
+				return nil, err
+			}
+		}
+	} else if canary := rollout.Spec.Strategy.Canary; canary != nil {
+		for i, step := range canary.Steps {
+			if step.Analysis != nil {
+				if err := addTemplates(step.Analysis, validation.InlineAnalysis, i); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if canary.Analysis != nil {
+			if err := addTemplates(&canary.Analysis.RolloutAnalysis, validation.BackgroundAnalysis, 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return analysisTemplates, nil
+}
+
+// getReferencedAnalysisTemplates gets the analysis templates and cluster analysis templates the
+// template refs reference, along with the templates they reference in turn
+func (s *WebhookServer) getReferencedAnalysisTemplates(ctx context.Context, namespace string, templateRefs []v1alpha1.AnalysisTemplateRef) ([]*v1alpha1.AnalysisTemplate, []*v1alpha1.ClusterAnalysisTemplate, error) {
+	templates := []*v1alpha1.AnalysisTemplate{}
+	clusterTemplates := []*v1alpha1.ClusterAnalysisTemplate{}
+	for _, templateRef := range templateRefs {
+		var nestedRefs []v1alpha1.AnalysisTemplateRef
+		if templateRef.ClusterScope {
+			template, err := s.Options.RolloutsClientset.ArgoprojV1alpha1().ClusterAnalysisTemplates().Get(ctx, templateRef.TemplateName, metav1.GetOptions{})
+			if err != nil {
+				if err = ignoreNotFound(err); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			clusterTemplates = append(clusterTemplates, template)
+			nestedRefs = template.Spec.Templates
+		} else {
+			template, err := s.Options.RolloutsClientset.ArgoprojV1alpha1().AnalysisTemplates(namespace).Get(ctx, templateRef.TemplateName, metav1.GetOptions{})
+			if err != nil {
+				if err = ignoreNotFound(err); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			templates = append(templates, template)
+			nestedRefs = template.Spec.Templates
+		}
+		if len(nestedRefs) > 0 {
+			nestedTemplates, nestedClusterTemplates, err := s.getReferencedAnalysisTemplates(ctx, namespace, nestedRefs)
+			if err != nil {
+				return nil, nil, err
+			}
+			templates = append(templates, nestedTemplates...)
+			clusterTemplates = append(clusterTemplates, nestedClusterTemplates...)
+		}
+	}
+	uniqueTemplates, uniqueClusterTemplates := analysisutil.FilterUniqueTemplates(templates, clusterTemplates)
+	return uniqueTemplates, uniqueClusterTemplates, nil
+}
+
+func (s *WebhookServer) getReferencedIngresses(ctx context.Context, rollout *v1alpha1.Rollout) ([]ingressutil.Ingress, error) {
+	canary := rollout.Spec.Strategy.Canary
+	if canary == nil || canary.TrafficRouting == nil {
+		return nil, nil
+	}
+	var ingressNames []string
+	if nginx := canary.TrafficRouting.Nginx; nginx != nil {
+		if validation.ValidateRolloutNginxIngressesConfig(rollout) != nil {
+			return nil, nil
+		}
+		ingressNames = nginx.StableIngresses
+		if len(ingressNames) == 0 {
+			ingressNames = []string{nginx.StableIngress}
+		}
+	} else if alb := canary.TrafficRouting.ALB; alb != nil {
+		if validation.ValidateRolloutAlbIngressesConfig(rollout) != nil {
+			return nil, nil
+		}
+		ingressNames = alb.Ingresses
+		if len(ingressNames) == 0 {
+			ingressNames = []string{alb.Ingress}
+		}
+	}
+
+	ingresses := []ingressutil.Ingress{}
+	for _, ingressName := range ingressNames {
+		ingress, err := s.Options.KubeClientset.NetworkingV1().Ingresses(rollout.Namespace).Get(ctx, ingressName, metav1.GetOptions{})
+		if err != nil {
+			if err = ignoreNotFound(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ingresses = append(ingresses, *ingressutil.NewIngress(ingress))
+	}
+	return ingresses, nil
+}
+
+func (s *WebhookServer) getReferencedVirtualServices(ctx context.Context, rollout *v1alpha1.Rollout) ([]unstructured.Unstructured, error) {
+	canary := rollout.Spec.Strategy.Canary
+	if canary == nil || canary.TrafficRouting == nil || canary.TrafficRouting.Istio == nil {
+		return nil, nil
+	}
+	if validation.ValidateRolloutVirtualServicesConfig(rollout) != nil {
+		return nil, nil
+	}
+	vsvcs := canary.TrafficRouting.Istio.VirtualServices
+	if !istioutil.MultipleVirtualServiceConfigured(rollout) {
+		vsvcs = []v1alpha1.IstioVirtualService{*canary.TrafficRouting.Istio.VirtualService}
+	}
+
+	virtualServices := []unstructured.Unstructured{}
+	for _, vsvc := range vsvcs {
+		vsvcNamespace, vsvcName := istioutil.GetVirtualServiceNamespaceName(vsvc.Name)
+		if vsvcNamespace == "" {
+			vsvcNamespace = rollout.Namespace
+		}
+		virtualService, err := s.Options.DynamicClientset.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(vsvcNamespace).Get(ctx, vsvcName, metav1.GetOptions{})
+		if err != nil {
+			if err = ignoreNotFound(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		virtualServices = append(virtualServices, *virtualService)
+	}
+	return virtualServices, nil
+}
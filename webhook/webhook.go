@@ -0,0 +1,265 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+	rolloutclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/strategytemplate"
+)
+
+const (
+	// ValidatePath is the endpoint the API server sends the admission reviews of Argo Rollouts objects to
+	ValidatePath = "/validate"
+	// HealthzPath is the endpoint to probe if the webhook server is running
+	HealthzPath = "/healthz"
+	// DefaultPort is the default port of the webhook server
+	DefaultPort = 8443
+)
+
+// ServerOptions are the options of the validating admission webhook server
+type ServerOptions struct {
+	KubeClientset     kubernetes.Interface
+	RolloutsClientset rolloutclientset.Interface
+	DynamicClientset  dynamic.Interface
+	// DryRun admits invalid objects with a warning instead of rejecting them
+	DryRun bool
+}
+
+// WebhookServer validates Rollouts, AnalysisTemplates, ClusterAnalysisTemplates, RolloutStrategyTemplates,
+// ClusterRolloutStrategyTemplates and Experiments at admission, with the same validation the controllers
+// run during reconciliation
+type WebhookServer struct {
+	Options ServerOptions
+}
+
+// NewServer creates a WebhookServer
+func NewServer(o ServerOptions) *WebhookServer {
+	return &WebhookServer{Options: o}
+}
+
+// Run serves the admission reviews over TLS until the context is done
+func (s *WebhookServer) Run(ctx context.Context, port int, certificate tls.Certificate) error {
+	mux := http.NewServeMux()
+	mux.Handle(ValidatePath, s)
+	mux.HandleFunc(HealthzPath, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "ok")
+	})
+	httpServer := &http.Server{
+		Addr:      fmt.Sprintf("0.0.0.0:%d", port),
+		Handler:   mux,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12},
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		httpServer.Shutdown(shutdownCtx)
+	}()
+
+	log.Infof("Starting webhook server at %s (dry-run: %t)", httpServer.Addr, s.Options.DryRun)
+	if err := httpServer.ListenAndServeTLS("", ""); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *WebhookServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+
+	response := s.Validate(req.Context(), review.Request)
+	response.UID = review.Request.UID
+	review.Response = response
+	review.Request = nil
+	responseBytes, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(responseBytes)
+}
+
+// Validate admits the object of the request if it is valid. In dry-run mode, an invalid object is
+// admitted with a warning for each error.
+func (s *WebhookServer) Validate(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	logCtx := log.WithField("kind", req.Kind.Kind).WithField("namespace", req.Namespace).WithField("name", req.Name)
+	allErrs, err := s.validate(ctx, req)
+	if err != nil {
+		logCtx.Warnf("Failed to validate: %v", err)
+		if s.Options.DryRun {
+			// dry-run mode never blocks, not even when the object could not be validated
+			return &admissionv1.AdmissionResponse{Allowed: true, Warnings: []string{fmt.Sprintf("failed to validate: %v", err)}}
+		}
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &metav1.Status{Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest, Message: err.Error()},
+		}
+	}
+	if len(allErrs) == 0 {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	message := allErrs.ToAggregate().Error()
+	if s.Options.DryRun {
+		logCtx.Infof("Admitting invalid object in dry-run mode: %s", message)
+		warnings := make([]string, 0, len(allErrs))
+		for _, fieldErr := range allErrs {
+			warnings = append(warnings, fieldErr.Error())
+		}
+		return &admissionv1.AdmissionResponse{Allowed: true, Warnings: warnings}
+	}
+	logCtx.Infof("Rejecting invalid object: %s", message)
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &metav1.Status{Status: metav1.StatusFailure, Code: http.StatusUnprocessableEntity, Reason: metav1.StatusReasonInvalid, Message: message},
+	}
+}
+
+func (s *WebhookServer) validate(ctx context.Context, req *admissionv1.AdmissionRequest) (field.ErrorList, error) {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil, nil
+	}
+	// The controllers update the metadata of objects whose spec they already reported as invalid,
+	// so an update which does not change the spec is always admitted
+	isUpdate := req.Operation == admissionv1.Update
+	switch req.Kind.Kind {
+	case "Rollout":
+		rollout, oldRollout := &v1alpha1.Rollout{}, &v1alpha1.Rollout{}
+		if err := unmarshalObjects(req, rollout, oldRollout); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(rollout.Spec, oldRollout.Spec) {
+			return nil, nil
+		}
+		return s.validateRollout(ctx, rollout)
+	case "AnalysisTemplate":
+		template, oldTemplate := &v1alpha1.AnalysisTemplate{}, &v1alpha1.AnalysisTemplate{}
+		if err := unmarshalObjects(req, template, oldTemplate); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return nil, nil
+		}
+		return validation.ValidateAnalysisTemplateSpec(template.Spec, field.NewPath("spec")), nil
+	case "ClusterAnalysisTemplate":
+		template, oldTemplate := &v1alpha1.ClusterAnalysisTemplate{}, &v1alpha1.ClusterAnalysisTemplate{}
+		if err := unmarshalObjects(req, template, oldTemplate); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return nil, nil
+		}
+		return validation.ValidateAnalysisTemplateSpec(template.Spec, field.NewPath("spec")), nil
+	case "RolloutStrategyTemplate":
+		template, oldTemplate := &v1alpha1.RolloutStrategyTemplate{}, &v1alpha1.RolloutStrategyTemplate{}
+		if err := unmarshalObjects(req, template, oldTemplate); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return nil, nil
+		}
+		return validation.ValidateRolloutStrategyTemplateSpec(template.Spec, field.NewPath("spec")), nil
+	case "ClusterRolloutStrategyTemplate":
+		template, oldTemplate := &v1alpha1.ClusterRolloutStrategyTemplate{}, &v1alpha1.ClusterRolloutStrategyTemplate{}
+		if err := unmarshalObjects(req, template, oldTemplate); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return nil, nil
+		}
+		return validation.ValidateRolloutStrategyTemplateSpec(template.Spec, field.NewPath("spec")), nil
+	case "Experiment":
+		experiment, oldExperiment := &v1alpha1.Experiment{}, &v1alpha1.Experiment{}
+		if err := unmarshalObjects(req, experiment, oldExperiment); err != nil {
+			return nil, err
+		}
+		if isUpdate && reflect.DeepEqual(experiment.Spec, oldExperiment.Spec) {
+			return nil, nil
+		}
+		return validateExperiment(experiment), nil
+	}
+	return nil, fmt.Errorf("unsupported kind %s", req.Kind.Kind)
+}
+
+// unmarshalObjects unmarshals the object of the request, and the old object of an update
+func unmarshalObjects(req *admissionv1.AdmissionRequest, obj, oldObj any) error {
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return err
+	}
+	if len(req.OldObject.Raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(req.OldObject.Raw, oldObj)
+}
+
+func (s *WebhookServer) validateRollout(ctx context.Context, rollout *v1alpha1.Rollout) (field.ErrorList, error) {
+	if ref := rollout.Spec.StrategyRef; ref != nil {
+		// the strategy of the rollout is only known once it is resolved from its template. Like any
+		// other reference, a template which does not exist yet is skipped, and so is the validation
+		// of the rollout since it has no strategy without its template.
+		getter := strategytemplate.NewClientGetter(ctx, s.Options.RolloutsClientset)
+		if _, err := getter.GetTemplateSpec(*ref, rollout.Namespace); k8serrors.IsNotFound(err) {
+			log.WithField("namespace", rollout.Namespace).WithField("name", rollout.Name).Infof("Skipping validation of rollout referencing %s '%s' which does not exist", strategytemplate.Kind(*ref), ref.Name)
+			return nil, nil
+		}
+		err := strategytemplate.ResolveRef(rollout, getter)
+		var fieldErr *field.Error
+		if errors.As(err, &fieldErr) {
+			return field.ErrorList{fieldErr}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	allErrs := validation.ValidateRollout(rollout)
+	for _, err := range []error{
+		validation.ValidateRolloutNginxIngressesConfig(rollout),
+		validation.ValidateRolloutAlbIngressesConfig(rollout),
+		validation.ValidateRolloutVirtualServicesConfig(rollout),
+	} {
+		if fieldErr, ok := err.(*field.Error); ok {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+	refResources, err := s.getReferencedResources(ctx, rollout)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, validation.ValidateRolloutReferencedResources(rollout, *refResources)...)
+	return allErrs, nil
+}
+
+func validateExperiment(experiment *v1alpha1.Experiment) field.ErrorList {
+	if cond := conditions.VerifyExperimentSpec(experiment, nil); cond != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec"), experiment.Name, cond.Message)}
+	}
+	return nil
+}
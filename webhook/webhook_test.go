@@ -0,0 +1,381 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+)
+
+func newServer(dryRun bool, kubeObjects []runtime.Object, rolloutObjects []runtime.Object) *WebhookServer {
+	return NewServer(ServerOptions{
+		KubeClientset:     k8sfake.NewSimpleClientset(kubeObjects...),
+		RolloutsClientset: fake.NewSimpleClientset(rolloutObjects...),
+		DynamicClientset:  dynamicfake.NewSimpleDynamicClient(scheme.Scheme),
+		DryRun:            dryRun,
+	})
+}
+
+func newRollout() *v1alpha1.Rollout {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}}
+	return &v1alpha1.Rollout{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(1),
+			Selector: selector,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector.MatchLabels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "guestbook", Image: "guestbook:v1"}},
+				},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "guestbook-stable",
+					CanaryService: "guestbook-canary",
+					Steps:         []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(20)}},
+				},
+			},
+		},
+	}
+}
+
+func newService(name string, selector map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		Spec:       corev1.ServiceSpec{Selector: selector},
+	}
+}
+
+func newRequest(t *testing.T, kind string, operation admissionv1.Operation, obj, oldObj any) *admissionv1.AdmissionRequest {
+	req := &admissionv1.AdmissionRequest{
+		UID:       types.UID("uid"),
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: kind},
+		Namespace: metav1.NamespaceDefault,
+		Operation: operation,
+	}
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	req.Object = runtime.RawExtension{Raw: raw}
+	if oldObj != nil {
+		raw, err := json.Marshal(oldObj)
+		assert.NoError(t, err)
+		req.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	return req
+}
+
+func TestValidateRollout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		s := newServer(false, nil, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, newRollout(), nil))
+		assert.True(t, resp.Allowed)
+		assert.Empty(t, resp.Warnings)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(120)
+		s := newServer(false, nil, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, int32(http.StatusUnprocessableEntity), resp.Result.Code)
+		assert.Equal(t, metav1.StatusReasonInvalid, resp.Result.Reason)
+		assert.Contains(t, resp.Result.Message, "spec.strategy.steps[0].setWeight")
+	})
+
+	t.Run("dry-run", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(120)
+		s := newServer(true, nil, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.True(t, resp.Allowed)
+		assert.Len(t, resp.Warnings, 1)
+		assert.Contains(t, resp.Warnings[0], "spec.strategy.steps[0].setWeight")
+	})
+
+	t.Run("update without spec change", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(120)
+		updated := ro.DeepCopy()
+		updated.Annotations = map[string]string{"rollout.argoproj.io/revision": "2"}
+		s := newServer(false, nil, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Update, updated, ro))
+		assert.True(t, resp.Allowed)
+
+		updated.Spec.Replicas = pointer.Int32(2)
+		resp = s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Update, updated, ro))
+		assert.False(t, resp.Allowed)
+	})
+
+	t.Run("referenced service", func(t *testing.T) {
+		// the canary service is skipped as it does not exist yet
+		stableSvc := newService("guestbook-stable", map[string]string{"app": "other"})
+		s := newServer(false, []runtime.Object{stableSvc}, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, newRollout(), nil))
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, "spec.strategy.canary.stableService: Invalid value: \"guestbook-stable\": Service \"guestbook-stable\" has unmatch label \"app\" in rollout", resp.Result.Message)
+
+		stableSvc.Spec.Selector["app"] = "guestbook"
+		s = newServer(false, []runtime.Object{stableSvc}, nil)
+		resp = s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, newRollout(), nil))
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("referenced analysis template", func(t *testing.T) {
+		template := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "success-rate", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.AnalysisTemplateSpec{
+				Args: []v1alpha1.Argument{{Name: "service-name"}},
+				Metrics: []v1alpha1.Metric{{
+					Name:     "success-rate",
+					Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+				}},
+			},
+		}
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.Steps = append(ro.Spec.Strategy.Canary.Steps, v1alpha1.CanaryStep{
+			Analysis: &v1alpha1.RolloutAnalysis{
+				Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}},
+			},
+		})
+		s := newServer(false, nil, []runtime.Object{template})
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, "spec.strategy.canary.steps[1].analysis.templates")
+
+		ro.Spec.Strategy.Canary.Steps[1].Analysis.Args = []v1alpha1.AnalysisRunArgument{{Name: "service-name", Value: "guestbook"}}
+		resp = s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("referenced blue-green ingress", func(t *testing.T) {
+		ingress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{Name: "other"},
+							},
+						}},
+					}},
+				}},
+			},
+		}
+		ro := newRollout()
+		ro.Spec.Strategy = v1alpha1.RolloutStrategy{
+			BlueGreen: &v1alpha1.BlueGreenStrategy{
+				ActiveService:  "guestbook-active",
+				PreviewService: "guestbook-preview",
+				TrafficRouting: &v1alpha1.BlueGreenTrafficRouting{
+					RolloutTrafficRouting: v1alpha1.RolloutTrafficRouting{
+						Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "guestbook"},
+					},
+					Weights: []int32{20},
+				},
+			},
+		}
+		s := newServer(false, []runtime.Object{ingress}, nil)
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, "spec.strategy.blueGreen.trafficRouting.nginx.stableIngress: Invalid value: \"guestbook\": ingress `guestbook` has no rules using service guestbook-active backend", resp.Result.Message)
+
+		ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name = "guestbook-active"
+		s = newServer(false, []runtime.Object{ingress}, nil)
+		resp = s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.True(t, resp.Allowed)
+	})
+}
+
+func TestValidateAnalysisTemplate(t *testing.T) {
+	template := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "success-rate", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:     "success-rate",
+				Interval: "1 minute",
+				Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+			}},
+		},
+	}
+	s := newServer(false, nil, nil)
+	resp := s.Validate(context.Background(), newRequest(t, "AnalysisTemplate", admissionv1.Create, template, nil))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "spec.metrics[0]: Invalid value: \"success-rate\": invalid interval string")
+
+	clusterTemplate := &v1alpha1.ClusterAnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "success-rate"},
+		Spec:       template.Spec,
+	}
+	resp = s.Validate(context.Background(), newRequest(t, "ClusterAnalysisTemplate", admissionv1.Create, clusterTemplate, nil))
+	assert.False(t, resp.Allowed)
+
+	clusterTemplate.Spec.Metrics[0].Interval = "1m"
+	resp = s.Validate(context.Background(), newRequest(t, "ClusterAnalysisTemplate", admissionv1.Create, clusterTemplate, nil))
+	assert.True(t, resp.Allowed)
+}
+
+func TestValidateRolloutStrategyRef(t *testing.T) {
+	ctx := context.Background()
+	newStrategyRefRollout := func() *v1alpha1.Rollout {
+		ro := newRollout()
+		ro.Spec.Strategy = v1alpha1.RolloutStrategy{}
+		ro.Spec.StrategyRef = &v1alpha1.RolloutStrategyRef{
+			Name: "standard",
+			Args: []v1alpha1.Argument{{Name: "weight", Value: pointer.String("20")}},
+		}
+		return ro
+	}
+	template := &v1alpha1.RolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Args:     []v1alpha1.Argument{{Name: "weight"}},
+			Strategy: newRollout().Spec.Strategy,
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		s := newServer(false, nil, []runtime.Object{template})
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, newStrategyRefRollout(), nil))
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("invalid strategy", func(t *testing.T) {
+		invalidTemplate := template.DeepCopy()
+		invalidTemplate.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(120)
+		s := newServer(false, nil, []runtime.Object{invalidTemplate})
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, newStrategyRefRollout(), nil))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, "spec.strategy.steps[0].setWeight")
+	})
+
+	t.Run("missing arg", func(t *testing.T) {
+		ro := newStrategyRefRollout()
+		ro.Spec.StrategyRef.Args = nil
+		s := newServer(false, nil, []runtime.Object{template})
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, `spec.strategyRef: Invalid value: "standard": argument "weight" was not supplied`)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		ro := newStrategyRefRollout()
+		ro.Spec.StrategyRef.ClusterScope = true
+		s := newServer(false, nil, []runtime.Object{template})
+		// the template may well be created right after the rollout
+		resp := s.Validate(ctx, newRequest(t, "Rollout", admissionv1.Create, ro, nil))
+		assert.True(t, resp.Allowed)
+		assert.Empty(t, resp.Warnings)
+	})
+}
+
+func TestValidateRolloutStrategyTemplate(t *testing.T) {
+	template := &v1alpha1.RolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{ActiveService: "{{args.service}}"},
+			},
+		},
+	}
+	s := newServer(false, nil, nil)
+	resp := s.Validate(context.Background(), newRequest(t, "RolloutStrategyTemplate", admissionv1.Create, template, nil))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "placeholder '{{args.service}}' does not refer to an arg of the template")
+
+	clusterTemplate := &v1alpha1.ClusterRolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard"},
+		Spec:       template.Spec,
+	}
+	clusterTemplate.Spec.Args = []v1alpha1.Argument{{Name: "service"}}
+	resp = s.Validate(context.Background(), newRequest(t, "ClusterRolloutStrategyTemplate", admissionv1.Create, clusterTemplate, nil))
+	assert.True(t, resp.Allowed)
+}
+
+func TestValidateExperiment(t *testing.T) {
+	experiment := &v1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.ExperimentSpec{
+			Templates: []v1alpha1.TemplateSpec{{
+				Name:     "baseline",
+				Selector: &metav1.LabelSelector{},
+			}},
+		},
+	}
+	s := newServer(false, nil, nil)
+	resp := s.Validate(context.Background(), newRequest(t, "Experiment", admissionv1.Create, experiment, nil))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "spec: Invalid value: \"guestbook\"")
+
+	experiment.Spec.Templates[0].Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}}
+	resp = s.Validate(context.Background(), newRequest(t, "Experiment", admissionv1.Create, experiment, nil))
+	assert.True(t, resp.Allowed)
+}
+
+func TestValidateUnsupported(t *testing.T) {
+	s := newServer(false, nil, nil)
+	req := newRequest(t, "Deployment", admissionv1.Create, map[string]any{}, nil)
+	resp := s.Validate(context.Background(), req)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, int32(http.StatusBadRequest), resp.Result.Code)
+
+	// deletes are never validated
+	req.Operation = admissionv1.Delete
+	resp = s.Validate(context.Background(), req)
+	assert.True(t, resp.Allowed)
+
+	// dry-run mode admits objects which cannot be validated
+	req.Operation = admissionv1.Create
+	resp = newServer(true, nil, nil).Validate(context.Background(), req)
+	assert.True(t, resp.Allowed)
+	assert.Len(t, resp.Warnings, 1)
+	assert.Contains(t, resp.Warnings[0], "failed to validate")
+}
+
+func TestServeHTTP(t *testing.T) {
+	ro := newRollout()
+	ro.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32(120)
+	review := admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request:  newRequest(t, "Rollout", admissionv1.Create, ro, nil),
+	}
+	body, err := json.Marshal(review)
+	assert.NoError(t, err)
+
+	s := newServer(false, nil, nil)
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	response := admissionv1.AdmissionReview{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Nil(t, response.Request)
+	assert.Equal(t, types.UID("uid"), response.Response.UID)
+	assert.False(t, response.Response.Allowed)
+
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader([]byte("{}"))))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}